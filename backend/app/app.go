@@ -54,6 +54,7 @@ type App struct {
 	borg                     borg.Borg
 	backupScheduleChangedCh  chan struct{}
 	pruningScheduleChangedCh chan struct{}
+	checkScheduleChangedCh   chan struct{}
 	eventEmitter             types.EventEmitter
 	shouldQuit               bool
 
@@ -89,6 +90,7 @@ func NewApp(
 		borg:                     borg.NewBorg(config.BorgExePath, config.BorgMountExePath, log, sshPrivateKeys, nil),
 		backupScheduleChangedCh:  make(chan struct{}),
 		pruningScheduleChangedCh: make(chan struct{}),
+		checkScheduleChangedCh:   make(chan struct{}),
 		eventEmitter:             eventEmitter,
 		shouldQuit:               false,
 		keyring:                  keyring.NewService(log, config),
@@ -309,10 +311,10 @@ func (a *App) Startup(ctx context.Context, systray *application.SystemTray, tray
 	cloudRepositoryService := repository.NewCloudRepositoryClient(a.log, a.state, a.config)
 	cloudRepositoryService.Init(a.db, cloudRepositoryRPCClient)
 
-	a.repositoryService.Init(a.ctx, a.db, a.eventEmitter, a.borg, cloudRepositoryService, a.keyring, a.analyticsService.Service)
+	a.repositoryService.Init(a.ctx, a.db, a.eventEmitter, a.checkScheduleChangedCh, a.borg, cloudRepositoryService, a.keyring, a.analyticsService.Service)
 
 	// Initialize backup profile service with repository service dependency
-	a.backupProfileService.Init(a.ctx, a.db, a.eventEmitter, a.backupScheduleChangedCh, a.pruningScheduleChangedCh, a.repositoryService, a.analyticsService.Service)
+	a.backupProfileService.Init(a.ctx, a.db, a.eventEmitter, a.backupScheduleChangedCh, a.pruningScheduleChangedCh, a.repositoryService, a.borg, a.analyticsService.Service)
 
 	// Initialize tray service with app as controller for window/quit operations
 	a.trayService.Init(a.backupProfileService.Service, a, systray, trayMenu)
@@ -353,8 +355,13 @@ func (a *App) Startup(ctx context.Context, systray *application.SystemTray, tray
 	// Schedule backups
 	go a.backupProfileService.StartScheduleChangeListener()
 	go a.backupProfileService.StartPruneScheduleChangeListener()
+	go a.backupProfileService.StartResumeListener()
+	go a.backupProfileService.StartRunConditionListener()
+	go a.repositoryService.StartCheckScheduleChangeListener(a.ctx)
+	go a.repositoryService.StartDriveListener(a.ctx)
 	a.backupScheduleChangedCh <- struct{}{}  // Trigger initial backup schedule check
 	a.pruningScheduleChangedCh <- struct{}{} // Trigger initial pruning schedule check
+	a.checkScheduleChangedCh <- struct{}{}   // Trigger initial check schedule check
 
 	// Setup tray menu
 	a.trayService.BuildMenu()
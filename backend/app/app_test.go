@@ -84,6 +84,7 @@ func NewTestApp(t *testing.T) (*App, *borgmocks.MockBorg, *typesmocks.MockEventE
 		a.ctx,
 		db,
 		mockEventEmitter,
+		a.checkScheduleChangedCh,
 		mockBorg,
 		cloudRepositoryClient,
 		testKeyring,
@@ -91,7 +92,7 @@ func NewTestApp(t *testing.T) (*App, *borgmocks.MockBorg, *typesmocks.MockEventE
 	)
 
 	// Initialize backup profile service with repository service dependency
-	a.backupProfileService.Init(a.ctx, db, mockEventEmitter, a.backupScheduleChangedCh, a.pruningScheduleChangedCh, a.repositoryService, analytics.NoopTracker{})
+	a.backupProfileService.Init(a.ctx, db, mockEventEmitter, a.backupScheduleChangedCh, a.pruningScheduleChangedCh, a.repositoryService, mockBorg, analytics.NoopTracker{})
 
 	// Add cleanup function to test to ensure context is cancelled
 	t.Cleanup(func() {
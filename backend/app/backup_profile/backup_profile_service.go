@@ -6,21 +6,29 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/loomi-labs/arco/backend/app/analytics"
+	"github.com/loomi-labs/arco/backend/app/database"
 	"github.com/loomi-labs/arco/backend/app/state"
 	"github.com/loomi-labs/arco/backend/app/types"
+	"github.com/loomi-labs/arco/backend/borg"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/ent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/repository"
 	"github.com/loomi-labs/arco/backend/ent/schema"
+	"github.com/loomi-labs/arco/backend/platform"
+	backendtypes "github.com/loomi-labs/arco/backend/types"
 	"github.com/loomi-labs/arco/backend/util"
 	"github.com/negrel/assert"
 	"github.com/wailsapp/wails/v3/pkg/application"
@@ -37,13 +45,17 @@ type Service struct {
 	backupScheduleChangedCh  chan struct{}
 	pruningScheduleChangedCh chan struct{}
 	repositoryService        RepositoryServiceInterface
+	borgClient               borg.Borg
 	analytics                analytics.Tracker
+	conditions               platform.SystemConditions
+	pendingMu                sync.Mutex
+	pendingBackups           map[types.BackupId]pendingBackup
 	ctx                      context.Context
 }
 
 // RepositoryServiceInterface defines the methods needed from repository service
 type RepositoryServiceInterface interface {
-	QueueBackup(ctx context.Context, backupId types.BackupId) (string, error)
+	QueueScheduledBackup(ctx context.Context, backupId types.BackupId) (string, error)
 	QueuePrune(ctx context.Context, backupId types.BackupId) (string, error)
 	QueueArchiveDelete(ctx context.Context, archiveId int) (string, error)
 }
@@ -57,21 +69,24 @@ type ServiceInternal struct {
 func NewService(log *zap.SugaredLogger, state *state.State, config *types.Config) *ServiceInternal {
 	return &ServiceInternal{
 		Service: &Service{
-			log:    log,
-			state:  state,
-			config: config,
+			log:            log,
+			state:          state,
+			config:         config,
+			conditions:     platform.NewSystemConditions(),
+			pendingBackups: make(map[types.BackupId]pendingBackup),
 		},
 	}
 }
 
 // Init initializes the service with remaining dependencies
-func (si *ServiceInternal) Init(ctx context.Context, db *ent.Client, eventEmitter types.EventEmitter, backupScheduleChangedCh, pruningScheduleChangedCh chan struct{}, repositoryService RepositoryServiceInterface, analyticsService analytics.Tracker) {
+func (si *ServiceInternal) Init(ctx context.Context, db *ent.Client, eventEmitter types.EventEmitter, backupScheduleChangedCh, pruningScheduleChangedCh chan struct{}, repositoryService RepositoryServiceInterface, borgClient borg.Borg, analyticsService analytics.Tracker) {
 	si.ctx = ctx
 	si.db = db
 	si.eventEmitter = eventEmitter
 	si.backupScheduleChangedCh = backupScheduleChangedCh
 	si.pruningScheduleChangedCh = pruningScheduleChangedCh
 	si.repositoryService = repositoryService
+	si.borgClient = borgClient
 	si.analytics = analyticsService
 }
 
@@ -94,6 +109,7 @@ func (s *Service) getBackupProfileEnt(ctx context.Context, id int) (*ent.BackupP
 		WithRepositories().
 		WithBackupSchedule().
 		WithPruningRule().
+		WithHooks(orderBackupHooks).
 		Where(backupprofile.ID(id)).
 		Only(ctx)
 }
@@ -113,6 +129,7 @@ func (s *Service) GetBackupProfiles(ctx context.Context) ([]*BackupProfile, erro
 		WithRepositories().
 		WithBackupSchedule().
 		WithPruningRule().
+		WithHooks(orderBackupHooks).
 		Order(func(sel *sql.Selector) {
 			// Order by name, case-insensitive
 			sel.OrderExpr(sql.Expr(fmt.Sprintf("%s COLLATE NOCASE", backupprofile.FieldName)))
@@ -194,13 +211,19 @@ func (s *Service) NewBackupProfile(ctx context.Context) (*BackupProfile, error)
 	// We only care about the hour, minute, second and nanosecond (in local time for display purpose)
 	firstDayOfMonthAtNine := time.Date(time.Now().Year(), 1, 1, 9, 0, 0, 0, time.Local)
 	schedule := &BackupSchedule{
-		Mode:            backupschedule.ModeMinuteInterval,
-		IntervalMinutes: 60,
-		DailyAt:         firstDayOfMonthAtNine,
-		Weekday:         backupschedule.WeekdayMonday,
-		WeeklyAt:        firstDayOfMonthAtNine,
-		Monthday:        1,
-		MonthlyAt:       firstDayOfMonthAtNine,
+		Mode:                   backupschedule.ModeMinuteInterval,
+		IntervalMinutes:        60,
+		DailyAt:                firstDayOfMonthAtNine,
+		Weekday:                backupschedule.WeekdayMonday,
+		WeeklyAt:               firstDayOfMonthAtNine,
+		Monthday:               1,
+		MonthlyAt:              firstDayOfMonthAtNine,
+		CronExpressions:        []string{},
+		CatchUpPolicy:          backupschedule.CatchUpPolicyImmediately,
+		CatchUpDelayMinutes:    10,
+		AllowedHours:           []backendtypes.TimeWindow{},
+		BlackoutPeriods:        []backendtypes.TimeWindow{},
+		DriveActionAfterBackup: backupschedule.DriveActionAfterBackupKeep,
 	}
 
 	pruningRule := &PruningRule{
@@ -219,7 +242,10 @@ func (s *Service) NewBackupProfile(ctx context.Context) (*BackupProfile, error)
 		Prefix:                   "",
 		BackupPaths:              make([]string, 0),
 		ExcludePaths:             make([]string, 0),
+		Patterns:                 make([]string, 0),
 		ExcludeCaches:            true,
+		ExcludeIfPresent:         make([]string, 0),
+		ContentStdinName:         backupprofile.DefaultContentStdinName,
 		Icon:                     selectedIcon,
 		CompressionMode:          backupprofile.CompressionModeLz4, // Default compression
 		CompressionLevel:         nil,                              // lz4 doesn't use levels
@@ -240,16 +266,11 @@ func (s *Service) GetDirectorySuggestions() []string {
 }
 
 func (s *Service) DoesPathExist(path string) bool {
-	_, err := os.Stat(util.ExpandPath(path))
-	return err == nil
+	return util.DoesPathExist(path)
 }
 
 func (s *Service) IsDirectory(path string) bool {
-	info, err := os.Stat(util.ExpandPath(path))
-	if err != nil {
-		return false
-	}
-	return info.IsDir()
+	return util.IsDirectory(path)
 }
 
 func (s *Service) IsDirectoryEmpty(path string) bool {
@@ -343,16 +364,49 @@ func (s *Service) CreateBackupProfile(ctx context.Context, backup BackupProfile,
 		return nil, fmt.Errorf("invalid compression settings: %w", err)
 	}
 
+	// Validate content command
+	if err := validateContentCommand(&backup); err != nil {
+		return nil, fmt.Errorf("invalid content command: %w", err)
+	}
+
+	// Validate pattern rules
+	if err := validatePatterns(&backup); err != nil {
+		return nil, fmt.Errorf("invalid pattern rules: %w", err)
+	}
+
+	// Validate marker files
+	if err := validateExcludeIfPresent(&backup); err != nil {
+		return nil, fmt.Errorf("invalid marker files: %w", err)
+	}
+
+	// Validate advanced create options
+	if err := validateCreateOptions(backup); err != nil {
+		return nil, fmt.Errorf("invalid create options: %w", err)
+	}
+
 	profile, err := s.db.BackupProfile.
 		Create().
 		SetName(backup.Name).
 		SetPrefix(backup.Prefix).
 		SetBackupPaths(backup.BackupPaths).
 		SetExcludePaths(backup.ExcludePaths).
+		SetPatterns(backup.Patterns).
 		SetExcludeCaches(backup.ExcludeCaches).
+		SetExcludeIfPresent(backup.ExcludeIfPresent).
+		SetUseIgnoreFiles(backup.UseIgnoreFiles).
+		SetContentCommand(backup.ContentCommand).
+		SetContentStdinName(backup.ContentStdinName).
 		SetIcon(backup.Icon).
 		SetCompressionMode(backup.CompressionMode).
 		SetNillableCompressionLevel(backup.CompressionLevel).
+		SetOneFileSystem(backup.OneFileSystem).
+		SetNumericIds(backup.NumericIds).
+		SetNoFlags(backup.NoFlags).
+		SetNoAcls(backup.NoAcls).
+		SetNoXattrs(backup.NoXattrs).
+		SetSparse(backup.Sparse).
+		SetReadSpecial(backup.ReadSpecial).
+		SetNillableCheckpointInterval(backup.CheckpointInterval).
 		SetAdvancedSectionCollapsed(backup.AdvancedSectionCollapsed).
 		AddRepositoryIDs(repositoryIds...).
 		Save(ctx)
@@ -379,18 +433,57 @@ func (s *Service) UpdateBackupProfile(ctx context.Context, backup BackupProfile)
 		return fmt.Errorf("invalid compression settings: %w", err)
 	}
 
+	// Validate content command
+	if err := validateContentCommand(&backup); err != nil {
+		return fmt.Errorf("invalid content command: %w", err)
+	}
+
+	// Validate pattern rules
+	if err := validatePatterns(&backup); err != nil {
+		return fmt.Errorf("invalid pattern rules: %w", err)
+	}
+
+	// Validate marker files
+	if err := validateExcludeIfPresent(&backup); err != nil {
+		return fmt.Errorf("invalid marker files: %w", err)
+	}
+
+	// Validate advanced create options
+	if err := validateCreateOptions(backup); err != nil {
+		return fmt.Errorf("invalid create options: %w", err)
+	}
+
 	update := s.db.BackupProfile.
 		UpdateOneID(backup.ID).
 		SetName(backup.Name).
 		SetIcon(backup.Icon).
 		SetBackupPaths(backup.BackupPaths).
 		SetExcludePaths(backup.ExcludePaths).
+		SetPatterns(backup.Patterns).
 		SetExcludeCaches(backup.ExcludeCaches).
+		SetExcludeIfPresent(backup.ExcludeIfPresent).
+		SetUseIgnoreFiles(backup.UseIgnoreFiles).
+		SetContentCommand(backup.ContentCommand).
+		SetContentStdinName(backup.ContentStdinName).
 		SetDataSectionCollapsed(backup.DataSectionCollapsed).
 		SetScheduleSectionCollapsed(backup.ScheduleSectionCollapsed).
 		SetCompressionMode(backup.CompressionMode).
+		SetOneFileSystem(backup.OneFileSystem).
+		SetNumericIds(backup.NumericIds).
+		SetNoFlags(backup.NoFlags).
+		SetNoAcls(backup.NoAcls).
+		SetNoXattrs(backup.NoXattrs).
+		SetSparse(backup.Sparse).
+		SetReadSpecial(backup.ReadSpecial).
 		SetAdvancedSectionCollapsed(backup.AdvancedSectionCollapsed)
 
+	// Use ClearCheckpointInterval to go back to borg's default (SetNillableCheckpointInterval(nil) is a no-op)
+	if backup.CheckpointInterval == nil {
+		update = update.ClearCheckpointInterval()
+	} else {
+		update = update.SetCheckpointInterval(*backup.CheckpointInterval)
+	}
+
 	// Use ClearCompressionLevel for modes that don't support it (SetNillableCompressionLevel(nil) is a no-op)
 	if backup.CompressionMode == backupprofile.CompressionModeNone ||
 		backup.CompressionMode == backupprofile.CompressionModeLz4 {
@@ -610,6 +703,24 @@ func applyScheduleDefaultsEnt(schedule *ent.BackupSchedule) {
 	if schedule.MonthlyAt.IsZero() {
 		schedule.MonthlyAt = defaultTime
 	}
+	if schedule.CatchUpPolicy == "" {
+		schedule.CatchUpPolicy = backupschedule.CatchUpPolicyImmediately
+	}
+	if schedule.CatchUpDelayMinutes == 0 {
+		schedule.CatchUpDelayMinutes = 10
+	}
+	if schedule.DriveActionAfterBackup == "" {
+		schedule.DriveActionAfterBackup = backupschedule.DriveActionAfterBackupKeep
+	}
+
+	// Remove empty cron expressions
+	expressions := make([]string, 0, len(schedule.CronExpressions))
+	for _, expr := range schedule.CronExpressions {
+		if expr = strings.TrimSpace(expr); expr != "" {
+			expressions = append(expressions, expr)
+		}
+	}
+	schedule.CronExpressions = expressions
 }
 
 func (s *Service) SaveBackupSchedule(ctx context.Context, backupProfileId int, schedule BackupSchedule) error {
@@ -633,7 +744,7 @@ func (s *Service) SaveBackupSchedule(ctx context.Context, backupProfileId int, s
 
 	var nextRun *time.Time
 	if entSchedule.Mode != backupschedule.ModeDisabled {
-		nr, err := getNextBackupTime(entSchedule, time.Now())
+		nr, err := plannedBackupTime(entSchedule, time.Now())
 		if err != nil {
 			return err
 		}
@@ -652,8 +763,20 @@ func (s *Service) SaveBackupSchedule(ctx context.Context, backupProfileId int, s
 			SetWeekday(entSchedule.Weekday).
 			SetMonthlyAt(entSchedule.MonthlyAt).
 			SetMonthday(entSchedule.Monthday).
+			SetCronExpressions(entSchedule.CronExpressions).
+			SetCatchUpPolicy(entSchedule.CatchUpPolicy).
+			SetCatchUpDelayMinutes(entSchedule.CatchUpDelayMinutes).
+			SetJitterMinutes(entSchedule.JitterMinutes).
+			SetAllowedHours(entSchedule.AllowedHours).
+			SetBlackoutPeriods(entSchedule.BlackoutPeriods).
+			SetRequireAcPower(entSchedule.RequireAcPower).
+			SetRequireUnmeteredNetwork(entSchedule.RequireUnmeteredNetwork).
+			SetRequireReachableRepository(entSchedule.RequireReachableRepository).
+			SetRunOnDriveConnect(entSchedule.RunOnDriveConnect).
+			SetDriveActionAfterBackup(entSchedule.DriveActionAfterBackup).
 			ClearNextRun().
 			SetNillableNextRun(nextRun).
+			ClearCatchUpAt().
 			Exec(ctx)
 	}
 	return s.db.BackupSchedule.
@@ -665,11 +788,50 @@ func (s *Service) SaveBackupSchedule(ctx context.Context, backupProfileId int, s
 		SetWeekday(entSchedule.Weekday).
 		SetMonthlyAt(entSchedule.MonthlyAt).
 		SetMonthday(entSchedule.Monthday).
+		SetCronExpressions(entSchedule.CronExpressions).
+		SetCatchUpPolicy(entSchedule.CatchUpPolicy).
+		SetCatchUpDelayMinutes(entSchedule.CatchUpDelayMinutes).
+		SetJitterMinutes(entSchedule.JitterMinutes).
+		SetAllowedHours(entSchedule.AllowedHours).
+		SetBlackoutPeriods(entSchedule.BlackoutPeriods).
+		SetRequireAcPower(entSchedule.RequireAcPower).
+		SetRequireUnmeteredNetwork(entSchedule.RequireUnmeteredNetwork).
+		SetRequireReachableRepository(entSchedule.RequireReachableRepository).
+		SetRunOnDriveConnect(entSchedule.RunOnDriveConnect).
+		SetDriveActionAfterBackup(entSchedule.DriveActionAfterBackup).
 		SetNillableNextRun(nextRun).
 		SetBackupProfileID(backupProfileId).
 		Exec(ctx)
 }
 
+// maxScheduleValidationRuns is the maximum number of run times returned by ValidateBackupSchedule
+const maxScheduleValidationRuns = 100
+
+// ScheduleValidation is the result of validating a backup schedule
+type ScheduleValidation struct {
+	Error    string      `json:"error"`    // Empty if the schedule is valid
+	NextRuns []time.Time `json:"nextRuns"` // Next run times of a valid schedule
+}
+
+// ValidateBackupSchedule validates a backup schedule without saving it and returns its next count run times,
+// so schedules like cron expressions can be previewed while they are edited.
+func (s *Service) ValidateBackupSchedule(schedule BackupSchedule, count int) (*ScheduleValidation, error) {
+	if count < 1 || count > maxScheduleValidationRuns {
+		return nil, fmt.Errorf("count must be between 1 and %d, got %d", maxScheduleValidationRuns, count)
+	}
+	entSchedule := schedule.ToEnt()
+	applyScheduleDefaultsEnt(entSchedule)
+	if entSchedule.Mode == backupschedule.ModeDisabled {
+		return &ScheduleValidation{NextRuns: []time.Time{}}, nil
+	}
+
+	nextRuns, err := nextBackupTimes(entSchedule, time.Now(), count)
+	if err != nil {
+		return &ScheduleValidation{Error: err.Error(), NextRuns: []time.Time{}}, nil
+	}
+	return &ScheduleValidation{NextRuns: nextRuns}, nil
+}
+
 func (s *Service) sendBackupScheduleChanged() {
 	if s.backupScheduleChangedCh == nil {
 		return
@@ -780,6 +942,134 @@ func (s *Service) sendPruningRuleChanged() {
 	s.pruningScheduleChangedCh <- struct{}{}
 }
 
+/***********************************/
+/********** Backup Hooks ***********/
+/***********************************/
+
+// SaveBackupHooks replaces the hooks of a backup profile.
+// Hooks of the same type are executed in the given order.
+// Hooks that already exist (matched by ID) keep the result of their last run.
+func (s *Service) SaveBackupHooks(ctx context.Context, backupProfileId int, hooks []BackupHook) ([]BackupHook, error) {
+	s.mustHaveDB()
+	s.log.Debug(fmt.Sprintf("Saving %d backup hooks for backup profile %d", len(hooks), backupProfileId))
+
+	if _, err := s.db.BackupProfile.Get(ctx, backupProfileId); err != nil {
+		return nil, err
+	}
+
+	for i := range hooks {
+		applyBackupHookDefaults(&hooks[i])
+		if err := validateBackupHook(hooks[i]); err != nil {
+			return nil, fmt.Errorf("invalid hook %q: %w", hooks[i].Command, err)
+		}
+	}
+
+	saved, err := database.WithTxData(ctx, s.db, func(tx *ent.Tx) ([]*ent.BackupHook, error) {
+		existingIds, err := tx.BackupHook.Query().
+			Where(backuphook.HasBackupProfileWith(backupprofile.ID(backupProfileId))).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		existing := make(map[int]bool, len(existingIds))
+		for _, id := range existingIds {
+			existing[id] = true
+		}
+
+		positions := make(map[backuphook.Type]int)
+		keepIds := make([]int, 0, len(hooks))
+		saved := make([]*ent.BackupHook, 0, len(hooks))
+		for _, hook := range hooks {
+			position := positions[hook.Type]
+			positions[hook.Type]++
+
+			var savedHook *ent.BackupHook
+			if existing[hook.ID] {
+				// Each existing hook can only be updated once
+				delete(existing, hook.ID)
+				savedHook, err = tx.BackupHook.
+					UpdateOneID(hook.ID).
+					SetType(hook.Type).
+					SetRunOn(hook.RunOn).
+					SetCommand(hook.Command).
+					SetPosition(position).
+					SetTimeoutSeconds(hook.TimeoutSeconds).
+					SetAbortOnFailure(hook.AbortOnFailure).
+					Save(ctx)
+			} else {
+				savedHook, err = tx.BackupHook.
+					Create().
+					SetType(hook.Type).
+					SetRunOn(hook.RunOn).
+					SetCommand(hook.Command).
+					SetPosition(position).
+					SetTimeoutSeconds(hook.TimeoutSeconds).
+					SetAbortOnFailure(hook.AbortOnFailure).
+					SetBackupProfileID(backupProfileId).
+					Save(ctx)
+			}
+			if err != nil {
+				return nil, err
+			}
+			keepIds = append(keepIds, savedHook.ID)
+			saved = append(saved, savedHook)
+		}
+
+		// Remove the hooks that are not part of the new list
+		_, err = tx.BackupHook.
+			Delete().
+			Where(
+				backuphook.HasBackupProfileWith(backupprofile.ID(backupProfileId)),
+				backuphook.IDNotIn(keepIds...),
+			).
+			Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return saved, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toBackupHooks(saved), nil
+}
+
+// orderBackupHooks sorts hooks in the order in which they are executed
+func orderBackupHooks(q *ent.BackupHookQuery) {
+	q.Order(ent.Asc(backuphook.FieldType), ent.Asc(backuphook.FieldPosition), ent.Asc(backuphook.FieldID))
+}
+
+// applyBackupHookDefaults sets default values for fields that are not set by the frontend
+func applyBackupHookDefaults(hook *BackupHook) {
+	hook.Command = strings.TrimSpace(hook.Command)
+	if hook.RunOn == "" {
+		hook.RunOn = backuphook.DefaultRunOn
+	}
+	if hook.TimeoutSeconds == 0 {
+		hook.TimeoutSeconds = backuphook.DefaultTimeoutSeconds
+	}
+}
+
+// validateBackupHook validates a hook before it is saved
+func validateBackupHook(hook BackupHook) error {
+	if hook.Command == "" {
+		return fmt.Errorf("command must not be empty")
+	}
+	if err := backuphook.TypeValidator(hook.Type); err != nil {
+		return err
+	}
+	if err := backuphook.RunOnValidator(hook.RunOn); err != nil {
+		return err
+	}
+	if hook.TimeoutSeconds < 0 {
+		return fmt.Errorf("timeout must be positive, got %d seconds", hook.TimeoutSeconds)
+	}
+	if hook.Type == backuphook.TypePostBackup && hook.AbortOnFailure {
+		return fmt.Errorf("only pre-backup hooks can abort the backup")
+	}
+	return nil
+}
+
 /***********************************/
 /********** Custom Types ***********/
 /***********************************/
@@ -792,19 +1082,30 @@ type RepositorySummary struct {
 
 // BackupSchedule is a standalone view of ent.BackupSchedule without back-edges
 type BackupSchedule struct {
-	ID              int                    `json:"id"`
-	CreatedAt       time.Time              `json:"createdAt"`
-	UpdatedAt       time.Time              `json:"updatedAt"`
-	Mode            backupschedule.Mode    `json:"mode"`
-	IntervalMinutes uint16                 `json:"intervalMinutes"`
-	DailyAt         time.Time              `json:"dailyAt"`
-	Weekday         backupschedule.Weekday `json:"weekday"`
-	WeeklyAt        time.Time              `json:"weeklyAt"`
-	Monthday        uint8                  `json:"monthday"`
-	MonthlyAt       time.Time              `json:"monthlyAt"`
-	NextRun         time.Time              `json:"nextRun"`
-	LastRun         *time.Time             `json:"lastRun"`
-	LastRunStatus   *string                `json:"lastRunStatus"`
+	ID                         int                                   `json:"id"`
+	CreatedAt                  time.Time                             `json:"createdAt"`
+	UpdatedAt                  time.Time                             `json:"updatedAt"`
+	Mode                       backupschedule.Mode                   `json:"mode"`
+	IntervalMinutes            uint16                                `json:"intervalMinutes"`
+	DailyAt                    time.Time                             `json:"dailyAt"`
+	Weekday                    backupschedule.Weekday                `json:"weekday"`
+	WeeklyAt                   time.Time                             `json:"weeklyAt"`
+	Monthday                   uint8                                 `json:"monthday"`
+	MonthlyAt                  time.Time                             `json:"monthlyAt"`
+	CronExpressions            []string                              `json:"cronExpressions"`
+	CatchUpPolicy              backupschedule.CatchUpPolicy          `json:"catchUpPolicy"`
+	CatchUpDelayMinutes        uint16                                `json:"catchUpDelayMinutes"`
+	JitterMinutes              uint16                                `json:"jitterMinutes"`
+	AllowedHours               []backendtypes.TimeWindow             `json:"allowedHours"`
+	BlackoutPeriods            []backendtypes.TimeWindow             `json:"blackoutPeriods"`
+	RequireAcPower             bool                                  `json:"requireAcPower"`
+	RequireUnmeteredNetwork    bool                                  `json:"requireUnmeteredNetwork"`
+	RequireReachableRepository bool                                  `json:"requireReachableRepository"`
+	RunOnDriveConnect          bool                                  `json:"runOnDriveConnect"`
+	DriveActionAfterBackup     backupschedule.DriveActionAfterBackup `json:"driveActionAfterBackup"`
+	NextRun                    time.Time                             `json:"nextRun"`
+	LastRun                    *time.Time                            `json:"lastRun"`
+	LastRunStatus              *string                               `json:"lastRunStatus"`
 }
 
 // PruningRule is a standalone view of ent.PruningRule without back-edges
@@ -824,6 +1125,22 @@ type PruningRule struct {
 	LastRunStatus  *string    `json:"lastRunStatus"`
 }
 
+// BackupHook is a standalone view of ent.BackupHook without back-edges
+type BackupHook struct {
+	ID             int              `json:"id"`
+	CreatedAt      time.Time        `json:"createdAt"`
+	UpdatedAt      time.Time        `json:"updatedAt"`
+	Type           backuphook.Type  `json:"type"`
+	RunOn          backuphook.RunOn `json:"runOn"`
+	Command        string           `json:"command"`
+	Position       int              `json:"position"`
+	TimeoutSeconds int              `json:"timeoutSeconds"`
+	AbortOnFailure bool             `json:"abortOnFailure"`
+	LastRunAt      *time.Time       `json:"lastRunAt"`
+	LastExitCode   *int             `json:"lastExitCode"`
+	LastOutput     *string          `json:"lastOutput"`
+}
+
 // BackupProfile is a flattened view of ent.BackupProfile with edges as direct properties.
 type BackupProfile struct {
 	ID                       int                           `json:"id"`
@@ -833,10 +1150,23 @@ type BackupProfile struct {
 	Prefix                   string                        `json:"prefix"`
 	BackupPaths              []string                      `json:"backupPaths"`
 	ExcludePaths             []string                      `json:"excludePaths"`
+	Patterns                 []string                      `json:"patterns"`
 	ExcludeCaches            bool                          `json:"excludeCaches"`
+	ExcludeIfPresent         []string                      `json:"excludeIfPresent"`
+	UseIgnoreFiles           bool                          `json:"useIgnoreFiles"`
+	ContentCommand           string                        `json:"contentCommand"`
+	ContentStdinName         string                        `json:"contentStdinName"`
 	Icon                     backupprofile.Icon            `json:"icon"`
 	CompressionMode          backupprofile.CompressionMode `json:"compressionMode"`
 	CompressionLevel         *int                          `json:"compressionLevel"`
+	OneFileSystem            bool                          `json:"oneFileSystem"`
+	NumericIds               bool                          `json:"numericIds"`
+	NoFlags                  bool                          `json:"noFlags"`
+	NoAcls                   bool                          `json:"noAcls"`
+	NoXattrs                 bool                          `json:"noXattrs"`
+	Sparse                   bool                          `json:"sparse"`
+	ReadSpecial              bool                          `json:"readSpecial"`
+	CheckpointInterval       *int                          `json:"checkpointInterval"`
 	DataSectionCollapsed     bool                          `json:"dataSectionCollapsed"`
 	ScheduleSectionCollapsed bool                          `json:"scheduleSectionCollapsed"`
 	AdvancedSectionCollapsed bool                          `json:"advancedSectionCollapsed"`
@@ -845,6 +1175,7 @@ type BackupProfile struct {
 	Repositories   []RepositorySummary `json:"repositories"`
 	BackupSchedule *BackupSchedule     `json:"backupSchedule"`
 	PruningRule    *PruningRule        `json:"pruningRule"`
+	Hooks          []BackupHook        `json:"hooks"`
 
 	// Computed fields
 	ArchiveCount int                `json:"archiveCount"`
@@ -858,19 +1189,30 @@ func toBackupSchedule(es *ent.BackupSchedule) *BackupSchedule {
 		return nil
 	}
 	return &BackupSchedule{
-		ID:              es.ID,
-		CreatedAt:       es.CreatedAt,
-		UpdatedAt:       es.UpdatedAt,
-		Mode:            es.Mode,
-		IntervalMinutes: es.IntervalMinutes,
-		DailyAt:         es.DailyAt,
-		Weekday:         es.Weekday,
-		WeeklyAt:        es.WeeklyAt,
-		Monthday:        es.Monthday,
-		MonthlyAt:       es.MonthlyAt,
-		NextRun:         es.NextRun,
-		LastRun:         es.LastRun,
-		LastRunStatus:   es.LastRunStatus,
+		ID:                         es.ID,
+		CreatedAt:                  es.CreatedAt,
+		UpdatedAt:                  es.UpdatedAt,
+		Mode:                       es.Mode,
+		IntervalMinutes:            es.IntervalMinutes,
+		DailyAt:                    es.DailyAt,
+		Weekday:                    es.Weekday,
+		WeeklyAt:                   es.WeeklyAt,
+		Monthday:                   es.Monthday,
+		MonthlyAt:                  es.MonthlyAt,
+		CronExpressions:            es.CronExpressions,
+		CatchUpPolicy:              es.CatchUpPolicy,
+		CatchUpDelayMinutes:        es.CatchUpDelayMinutes,
+		JitterMinutes:              es.JitterMinutes,
+		AllowedHours:               es.AllowedHours,
+		BlackoutPeriods:            es.BlackoutPeriods,
+		RequireAcPower:             es.RequireAcPower,
+		RequireUnmeteredNetwork:    es.RequireUnmeteredNetwork,
+		RequireReachableRepository: es.RequireReachableRepository,
+		RunOnDriveConnect:          es.RunOnDriveConnect,
+		DriveActionAfterBackup:     es.DriveActionAfterBackup,
+		NextRun:                    es.NextRun,
+		LastRun:                    es.LastRun,
+		LastRunStatus:              es.LastRunStatus,
 	}
 }
 
@@ -896,6 +1238,28 @@ func toPruningRule(ep *ent.PruningRule) *PruningRule {
 	}
 }
 
+// toBackupHooks converts ent.BackupHooks to the custom BackupHook type
+func toBackupHooks(hooks []*ent.BackupHook) []BackupHook {
+	result := make([]BackupHook, 0, len(hooks))
+	for _, h := range hooks {
+		result = append(result, BackupHook{
+			ID:             h.ID,
+			CreatedAt:      h.CreatedAt,
+			UpdatedAt:      h.UpdatedAt,
+			Type:           h.Type,
+			RunOn:          h.RunOn,
+			Command:        h.Command,
+			Position:       h.Position,
+			TimeoutSeconds: h.TimeoutSeconds,
+			AbortOnFailure: h.AbortOnFailure,
+			LastRunAt:      h.LastRunAt,
+			LastExitCode:   h.LastExitCode,
+			LastOutput:     h.LastOutput,
+		})
+	}
+	return result
+}
+
 // toBackupProfile converts an ent.BackupProfile to the custom BackupProfile type
 func (s *Service) toBackupProfile(ctx context.Context, ep *ent.BackupProfile) (*BackupProfile, error) {
 	// Convert repositories to summaries
@@ -924,16 +1288,30 @@ func (s *Service) toBackupProfile(ctx context.Context, ep *ent.BackupProfile) (*
 		Prefix:                   ep.Prefix,
 		BackupPaths:              ep.BackupPaths,
 		ExcludePaths:             ep.ExcludePaths,
+		Patterns:                 ep.Patterns,
 		ExcludeCaches:            ep.ExcludeCaches,
+		ExcludeIfPresent:         ep.ExcludeIfPresent,
+		UseIgnoreFiles:           ep.UseIgnoreFiles,
+		ContentCommand:           ep.ContentCommand,
+		ContentStdinName:         ep.ContentStdinName,
 		Icon:                     ep.Icon,
 		CompressionMode:          ep.CompressionMode,
 		CompressionLevel:         ep.CompressionLevel,
+		OneFileSystem:            ep.OneFileSystem,
+		NumericIds:               ep.NumericIds,
+		NoFlags:                  ep.NoFlags,
+		NoAcls:                   ep.NoAcls,
+		NoXattrs:                 ep.NoXattrs,
+		Sparse:                   ep.Sparse,
+		ReadSpecial:              ep.ReadSpecial,
+		CheckpointInterval:       ep.CheckpointInterval,
 		DataSectionCollapsed:     ep.DataSectionCollapsed,
 		ScheduleSectionCollapsed: ep.ScheduleSectionCollapsed,
 		AdvancedSectionCollapsed: ep.AdvancedSectionCollapsed,
 		Repositories:             repos,
 		BackupSchedule:           toBackupSchedule(ep.Edges.BackupSchedule),
 		PruningRule:              toPruningRule(ep.Edges.PruningRule),
+		Hooks:                    toBackupHooks(ep.Edges.Hooks),
 		ArchiveCount:             archiveCount,
 		LastBackup:               s.getLastBackup(ctx, ep.ID),
 		LastAttempt:              s.getLastAttempt(ctx, ep.ID),
@@ -1040,19 +1418,30 @@ func (s *BackupSchedule) ToEnt() *ent.BackupSchedule {
 		return nil
 	}
 	return &ent.BackupSchedule{
-		ID:              s.ID,
-		CreatedAt:       s.CreatedAt,
-		UpdatedAt:       s.UpdatedAt,
-		Mode:            s.Mode,
-		IntervalMinutes: s.IntervalMinutes,
-		DailyAt:         s.DailyAt,
-		Weekday:         s.Weekday,
-		WeeklyAt:        s.WeeklyAt,
-		Monthday:        s.Monthday,
-		MonthlyAt:       s.MonthlyAt,
-		NextRun:         s.NextRun,
-		LastRun:         s.LastRun,
-		LastRunStatus:   s.LastRunStatus,
+		ID:                         s.ID,
+		CreatedAt:                  s.CreatedAt,
+		UpdatedAt:                  s.UpdatedAt,
+		Mode:                       s.Mode,
+		IntervalMinutes:            s.IntervalMinutes,
+		DailyAt:                    s.DailyAt,
+		Weekday:                    s.Weekday,
+		WeeklyAt:                   s.WeeklyAt,
+		Monthday:                   s.Monthday,
+		MonthlyAt:                  s.MonthlyAt,
+		CronExpressions:            s.CronExpressions,
+		CatchUpPolicy:              s.CatchUpPolicy,
+		CatchUpDelayMinutes:        s.CatchUpDelayMinutes,
+		JitterMinutes:              s.JitterMinutes,
+		AllowedHours:               s.AllowedHours,
+		BlackoutPeriods:            s.BlackoutPeriods,
+		RequireAcPower:             s.RequireAcPower,
+		RequireUnmeteredNetwork:    s.RequireUnmeteredNetwork,
+		RequireReachableRepository: s.RequireReachableRepository,
+		RunOnDriveConnect:          s.RunOnDriveConnect,
+		DriveActionAfterBackup:     s.DriveActionAfterBackup,
+		NextRun:                    s.NextRun,
+		LastRun:                    s.LastRun,
+		LastRunStatus:              s.LastRunStatus,
 	}
 }
 
@@ -1078,6 +1467,66 @@ func (p *PruningRule) ToEnt() *ent.PruningRule {
 	}
 }
 
+/***********************************/
+/********** Content Command ********/
+/***********************************/
+
+// validateContentCommand validates the command whose output is backed up and normalizes its archive path
+func validateContentCommand(backup *BackupProfile) error {
+	backup.ContentCommand = strings.TrimSpace(backup.ContentCommand)
+	backup.ContentStdinName = strings.TrimSpace(backup.ContentStdinName)
+	if backup.ContentStdinName == "" {
+		backup.ContentStdinName = backupprofile.DefaultContentStdinName
+	}
+	if backup.ContentCommand == "" {
+		return nil
+	}
+
+	// The output is stored as a file relative to the archive root
+	name := backup.ContentStdinName
+	if path.IsAbs(name) || path.Clean(name) != name || name == "." || strings.HasPrefix(name, "../") || name == ".." {
+		return fmt.Errorf("archive path %q must be a relative path without '.' or '..' segments", name)
+	}
+	return nil
+}
+
+/***********************************/
+/********** Create Options *********/
+/***********************************/
+
+// validateCreateOptions validates the advanced options of borg create
+func validateCreateOptions(backup BackupProfile) error {
+	if backup.CheckpointInterval != nil {
+		interval := *backup.CheckpointInterval
+		if interval < schema.ValBackupProfileMinCheckpointInterval || interval > schema.ValBackupProfileMaxCheckpointInterval {
+			return fmt.Errorf("checkpoint interval must be between %d and %d seconds, got %d",
+				schema.ValBackupProfileMinCheckpointInterval, schema.ValBackupProfileMaxCheckpointInterval, interval)
+		}
+	}
+	return nil
+}
+
+// createOptions returns the selection of paths and the advanced options of borg create of the backup profile
+func (b BackupProfile) createOptions() borgtypes.CreateOptions {
+	return borgtypes.CreateOptions{
+		ExcludePaths:       b.ExcludePaths,
+		Patterns:           b.Patterns,
+		ExcludeCaches:      b.ExcludeCaches,
+		ExcludeIfPresent:   b.ExcludeIfPresent,
+		UseIgnoreFiles:     b.UseIgnoreFiles,
+		CompressionMode:    b.CompressionMode,
+		CompressionLevel:   b.CompressionLevel,
+		OneFileSystem:      b.OneFileSystem,
+		NumericIds:         b.NumericIds,
+		NoFlags:            b.NoFlags,
+		NoAcls:             b.NoAcls,
+		NoXattrs:           b.NoXattrs,
+		Sparse:             b.Sparse,
+		ReadSpecial:        b.ReadSpecial,
+		CheckpointInterval: b.CheckpointInterval,
+	}
+}
+
 /***********************************/
 /********** Compression ************/
 /***********************************/
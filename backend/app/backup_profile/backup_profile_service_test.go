@@ -8,11 +8,15 @@ import (
 	"github.com/loomi-labs/arco/backend/app/analytics"
 	"github.com/loomi-labs/arco/backend/app/state"
 	"github.com/loomi-labs/arco/backend/app/types"
+	"github.com/loomi-labs/arco/backend/borg/mocks"
 	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/enttest"
+	backendtypes "github.com/loomi-labs/arco/backend/types"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
 
 	_ "github.com/mattn/go-sqlite3"
@@ -31,11 +35,45 @@ TestBackupProfileService_SaveBackupSchedule
 * SaveBackupSchedule with invalid monthly schedule
 * SaveBackupSchedule with hourly and daily schedule
 * SaveBackupSchedule with hourly and weekly schedule
+* SaveBackupSchedule with cron schedule
+* SaveBackupSchedule with invalid cron schedule
+* SaveBackupSchedule with cron schedule without expressions
+* SaveBackupSchedule with delayed catch-up policy
+* SaveBackupSchedule with invalid catch-up policy
+* SaveBackupSchedule with catch-up delay out of range
+* SaveBackupSchedule with jitter, allowed hours and blackout period
+* SaveBackupSchedule with invalid blackout period
+* SaveBackupSchedule with jitter out of range
+* SaveBackupSchedule with run conditions
+* SaveBackupSchedule with drive trigger
+* SaveBackupSchedule with invalid drive action
 * SaveBackupSchedule with hourly and monthly schedule
 * SaveBackupSchedule with daily and weekly schedule
 * SaveBackupSchedule with daily and monthly schedule
 * SaveBackupSchedule with weekly and monthly schedule
 
+TestBackupProfileService_ValidateBackupSchedule
+* ValidateBackupSchedule with cron schedule
+* ValidateBackupSchedule with invalid cron schedule
+* ValidateBackupSchedule with cron schedule that never runs
+* ValidateBackupSchedule with invalid count
+
+TestBackupProfileService_SaveBackupHooks
+* SaveBackupHooks with pre- and post-backup hooks
+* SaveBackupHooks replaces existing hooks
+* SaveBackupHooks with empty command
+* SaveBackupHooks with abort on failure for post-backup hook
+
+TestBackupProfileService_ContentCommand
+* CreateBackupProfile with content command
+* CreateBackupProfile with absolute stdin name
+* UpdateBackupProfile with content command and default stdin name
+
+TestBackupProfileService_CreateOptions
+* CreateBackupProfile with create options
+* CreateBackupProfile with too short checkpoint interval
+* UpdateBackupProfile resets checkpoint interval
+
 TestBackupProfileService_GetPrefixSuggestions
 * GetPrefixSuggestions with empty prefix
 * GetPrefixSuggestions with alphanumeric prefix
@@ -64,6 +102,10 @@ func (m *mockRepositoryService) QueueBackup(ctx context.Context, backupId types.
 	return "mock-operation-id", nil
 }
 
+func (m *mockRepositoryService) QueueScheduledBackup(ctx context.Context, backupId types.BackupId) (string, error) {
+	return "mock-operation-id", nil
+}
+
 func (m *mockRepositoryService) QueueBackups(ctx context.Context, backupIds []types.BackupId) ([]string, error) {
 	ids := make([]string, len(backupIds))
 	for i := range backupIds {
@@ -112,10 +154,11 @@ func newTestBackupProfileService(t *testing.T) (*Service, *ent.Client, context.C
 	// Create mock services
 	mockRepoService := &mockRepositoryService{}
 	mockEmitter := &mockEventEmitter{}
+	mockBorg := mocks.NewMockBorg(gomock.NewController(t))
 
 	// Create service
 	serviceInternal := NewService(sugarLog, st, config)
-	serviceInternal.Init(ctx, db, mockEmitter, make(chan struct{}, 1), make(chan struct{}, 1), mockRepoService, analytics.NoopTracker{})
+	serviceInternal.Init(ctx, db, mockEmitter, make(chan struct{}, 1), make(chan struct{}, 1), mockRepoService, mockBorg, analytics.NoopTracker{})
 
 	return serviceInternal.Service, db, ctx
 }
@@ -169,6 +212,31 @@ func TestBackupProfileService_SaveBackupSchedule(t *testing.T) {
 		if !overrides.MonthlyAt.IsZero() {
 			bs.MonthlyAt = overrides.MonthlyAt
 		}
+		if overrides.CronExpressions != nil {
+			bs.CronExpressions = overrides.CronExpressions
+		}
+		if overrides.CatchUpPolicy != "" {
+			bs.CatchUpPolicy = overrides.CatchUpPolicy
+		}
+		if overrides.CatchUpDelayMinutes != 0 {
+			bs.CatchUpDelayMinutes = overrides.CatchUpDelayMinutes
+		}
+		if overrides.JitterMinutes != 0 {
+			bs.JitterMinutes = overrides.JitterMinutes
+		}
+		if overrides.AllowedHours != nil {
+			bs.AllowedHours = overrides.AllowedHours
+		}
+		if overrides.BlackoutPeriods != nil {
+			bs.BlackoutPeriods = overrides.BlackoutPeriods
+		}
+		bs.RequireAcPower = overrides.RequireAcPower
+		bs.RequireUnmeteredNetwork = overrides.RequireUnmeteredNetwork
+		bs.RequireReachableRepository = overrides.RequireReachableRepository
+		bs.RunOnDriveConnect = overrides.RunOnDriveConnect
+		if overrides.DriveActionAfterBackup != "" {
+			bs.DriveActionAfterBackup = overrides.DriveActionAfterBackup
+		}
 		return *bs
 	}
 
@@ -242,6 +310,66 @@ func TestBackupProfileService_SaveBackupSchedule(t *testing.T) {
 			schedule: BackupSchedule{Mode: backupschedule.ModeWeekly, Weekday: backupschedule.WeekdayMonday, WeeklyAt: now, Monthday: []uint8{1}[0], MonthlyAt: now},
 			wantErr:  false,
 		},
+		{
+			name:     "SaveBackupSchedule with cron schedule",
+			schedule: BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12,18 * * mon-fri", "30 9 L * *"}},
+			wantErr:  false,
+		},
+		{
+			name:     "SaveBackupSchedule with invalid cron schedule",
+			schedule: BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 25 * * *"}},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with cron schedule without expressions",
+			schedule: BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{" "}},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with delayed catch-up policy",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: now, CatchUpPolicy: backupschedule.CatchUpPolicyDelayed, CatchUpDelayMinutes: 30},
+			wantErr:  false,
+		},
+		{
+			name:     "SaveBackupSchedule with invalid catch-up policy",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: now, CatchUpPolicy: "later"},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with jitter, allowed hours and blackout period",
+			schedule: BackupSchedule{Mode: backupschedule.ModeMinuteInterval, JitterMinutes: 15, AllowedHours: []backendtypes.TimeWindow{{Start: "18:00", End: "08:00"}}, BlackoutPeriods: []backendtypes.TimeWindow{{Start: "00:00", End: "02:00", Weekdays: []time.Weekday{time.Sunday}}}},
+			wantErr:  false,
+		},
+		{
+			name:     "SaveBackupSchedule with invalid blackout period",
+			schedule: BackupSchedule{Mode: backupschedule.ModeMinuteInterval, BlackoutPeriods: []backendtypes.TimeWindow{{Start: "09:00", End: "25:00"}}},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with jitter out of range",
+			schedule: BackupSchedule{Mode: backupschedule.ModeMinuteInterval, JitterMinutes: 721},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with run conditions",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: now, RequireAcPower: true, RequireUnmeteredNetwork: true, RequireReachableRepository: true},
+			wantErr:  false,
+		},
+		{
+			name:     "SaveBackupSchedule with drive trigger",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDisabled, RunOnDriveConnect: true, DriveActionAfterBackup: backupschedule.DriveActionAfterBackupEject},
+			wantErr:  false,
+		},
+		{
+			name:     "SaveBackupSchedule with invalid drive action",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDisabled, RunOnDriveConnect: true, DriveActionAfterBackup: "format"},
+			wantErr:  true,
+		},
+		{
+			name:     "SaveBackupSchedule with catch-up delay out of range",
+			schedule: BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: now, CatchUpPolicy: backupschedule.CatchUpPolicyDelayed, CatchUpDelayMinutes: 1441},
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -269,12 +397,322 @@ func TestBackupProfileService_SaveBackupSchedule(t *testing.T) {
 				//assert.Equalf(t, newBackupSchedule(tt.schedule).WeeklyAt.Unix(), updatedSchedule.WeeklyAt.Unix(), "Expected weekly at %v, got %v", newBackupSchedule(tt.schedule).WeeklyAt, updatedSchedule.WeeklyAt)
 				assert.Equalf(t, newBackupSchedule(tt.schedule).Monthday, updatedSchedule.Monthday, "Expected monthday %d, got %d", newBackupSchedule(tt.schedule).Monthday, updatedSchedule.Monthday)
 				//assert.Equalf(t, newBackupSchedule(tt.schedule).MonthlyAt.Unix(), updatedSchedule.MonthlyAt.Unix(), "Expected monthly at %v, got %v", newBackupSchedule(tt.schedule).MonthlyAt, updatedSchedule.MonthlyAt)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).CronExpressions, updatedSchedule.CronExpressions, "Expected cron expressions %v, got %v", newBackupSchedule(tt.schedule).CronExpressions, updatedSchedule.CronExpressions)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).CatchUpPolicy, updatedSchedule.CatchUpPolicy, "Expected catch-up policy %s, got %s", newBackupSchedule(tt.schedule).CatchUpPolicy, updatedSchedule.CatchUpPolicy)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).CatchUpDelayMinutes, updatedSchedule.CatchUpDelayMinutes, "Expected catch-up delay %d, got %d", newBackupSchedule(tt.schedule).CatchUpDelayMinutes, updatedSchedule.CatchUpDelayMinutes)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).JitterMinutes, updatedSchedule.JitterMinutes, "Expected jitter %d, got %d", newBackupSchedule(tt.schedule).JitterMinutes, updatedSchedule.JitterMinutes)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).BlackoutPeriods, updatedSchedule.BlackoutPeriods, "Expected blackout periods %v, got %v", newBackupSchedule(tt.schedule).BlackoutPeriods, updatedSchedule.BlackoutPeriods)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).RequireAcPower, updatedSchedule.RequireAcPower, "Expected require AC power %t, got %t", newBackupSchedule(tt.schedule).RequireAcPower, updatedSchedule.RequireAcPower)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).RequireUnmeteredNetwork, updatedSchedule.RequireUnmeteredNetwork, "Expected require unmetered network %t, got %t", newBackupSchedule(tt.schedule).RequireUnmeteredNetwork, updatedSchedule.RequireUnmeteredNetwork)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).RequireReachableRepository, updatedSchedule.RequireReachableRepository, "Expected require reachable repository %t, got %t", newBackupSchedule(tt.schedule).RequireReachableRepository, updatedSchedule.RequireReachableRepository)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).RunOnDriveConnect, updatedSchedule.RunOnDriveConnect, "Expected run on drive connect %t, got %t", newBackupSchedule(tt.schedule).RunOnDriveConnect, updatedSchedule.RunOnDriveConnect)
+				assert.Equalf(t, newBackupSchedule(tt.schedule).DriveActionAfterBackup, updatedSchedule.DriveActionAfterBackup, "Expected drive action %s, got %s", newBackupSchedule(tt.schedule).DriveActionAfterBackup, updatedSchedule.DriveActionAfterBackup)
 				assert.Equalf(t, 1, cnt, "Expected 1 backup schedule, got %d", cnt)
 			}
 		})
 	}
 }
 
+func TestBackupProfileService_ValidateBackupSchedule(t *testing.T) {
+	service, _, _ := newTestBackupProfileService(t)
+
+	t.Run("ValidateBackupSchedule with cron schedule", func(t *testing.T) {
+		// ACT
+		validation, err := service.ValidateBackupSchedule(BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12,18 * * mon-fri"}}, 5)
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Empty(t, validation.Error)
+		assert.Len(t, validation.NextRuns, 5)
+		for i, run := range validation.NextRuns {
+			assert.Contains(t, []int{12, 18}, run.Hour())
+			assert.NotContains(t, []time.Weekday{time.Saturday, time.Sunday}, run.Weekday())
+			if i > 0 {
+				assert.True(t, run.After(validation.NextRuns[i-1]))
+			}
+		}
+	})
+
+	t.Run("ValidateBackupSchedule with invalid cron schedule", func(t *testing.T) {
+		// ACT
+		validation, err := service.ValidateBackupSchedule(BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12 * * someday"}}, 5)
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.NotEmpty(t, validation.Error)
+		assert.Empty(t, validation.NextRuns)
+	})
+
+	t.Run("ValidateBackupSchedule with cron schedule that never runs", func(t *testing.T) {
+		// ACT
+		validation, err := service.ValidateBackupSchedule(BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 0 31 2 *"}}, 5)
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Equal(t, "schedule never runs", validation.Error)
+	})
+
+	t.Run("ValidateBackupSchedule with invalid count", func(t *testing.T) {
+		// ACT
+		_, err := service.ValidateBackupSchedule(BackupSchedule{Mode: backupschedule.ModeDaily}, 0)
+
+		// ASSERT
+		assert.Error(t, err)
+	})
+}
+
+func TestBackupProfileService_SaveBackupHooks(t *testing.T) {
+	var service *Service
+	var db *ent.Client
+	var ctx context.Context
+	var profile *BackupProfile
+
+	setup := func(t *testing.T) {
+		service, db, ctx = newTestBackupProfileService(t)
+
+		p, err := service.NewBackupProfile(ctx)
+		assert.NoError(t, err, "Failed to create new backup profile")
+		p.Name = "Test profile"
+		p.Prefix = "test-"
+
+		r, err := db.Repository.Create().
+			SetName("TestRepo").
+			SetURL("/tmp").
+			Save(ctx)
+		assert.NoError(t, err, "Failed to create new repository")
+
+		profile, err = service.CreateBackupProfile(ctx, *p, []int{r.ID})
+		assert.NoError(t, err, "Failed to save backup profile")
+	}
+
+	t.Run("SaveBackupHooks with pre- and post-backup hooks", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+
+		// ACT
+		hooks, err := service.SaveBackupHooks(ctx, profile.ID, []BackupHook{
+			{Type: backuphook.TypePreBackup, Command: "pg_dump db > /tmp/db.sql", AbortOnFailure: true},
+			{Type: backuphook.TypePostBackup, Command: "docker start db"},
+			{Type: backuphook.TypePreBackup, Command: "  docker stop db  "},
+		})
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Len(t, hooks, 3)
+		assert.Equal(t, 0, hooks[0].Position)
+		assert.Equal(t, 0, hooks[1].Position)
+		assert.Equal(t, 1, hooks[2].Position, "positions are counted per hook type")
+		assert.Equal(t, "docker stop db", hooks[2].Command)
+		assert.Equal(t, backuphook.RunOnAlways, hooks[1].RunOn)
+		assert.Equal(t, backuphook.DefaultTimeoutSeconds, hooks[1].TimeoutSeconds)
+
+		saved, err := service.GetBackupProfile(ctx, profile.ID)
+		assert.NoError(t, err)
+		assert.Len(t, saved.Hooks, 3)
+	})
+
+	t.Run("SaveBackupHooks replaces existing hooks", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		hooks, err := service.SaveBackupHooks(ctx, profile.ID, []BackupHook{
+			{Type: backuphook.TypePreBackup, Command: "first"},
+			{Type: backuphook.TypePreBackup, Command: "second"},
+		})
+		assert.NoError(t, err)
+
+		// ACT
+		updated, err := service.SaveBackupHooks(ctx, profile.ID, []BackupHook{
+			{ID: hooks[1].ID, Type: backuphook.TypePreBackup, Command: "second updated"},
+		})
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Len(t, updated, 1)
+		assert.Equal(t, hooks[1].ID, updated[0].ID)
+		assert.Equal(t, 0, updated[0].Position)
+		assert.Equal(t, 1, db.BackupHook.Query().CountX(ctx))
+	})
+
+	t.Run("SaveBackupHooks with empty command", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+
+		// ACT
+		_, err := service.SaveBackupHooks(ctx, profile.ID, []BackupHook{
+			{Type: backuphook.TypePreBackup, Command: "   "},
+		})
+
+		// ASSERT
+		assert.Error(t, err)
+		assert.Equal(t, 0, db.BackupHook.Query().CountX(ctx))
+	})
+
+	t.Run("SaveBackupHooks with abort on failure for post-backup hook", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+
+		// ACT
+		_, err := service.SaveBackupHooks(ctx, profile.ID, []BackupHook{
+			{Type: backuphook.TypePostBackup, Command: "true", AbortOnFailure: true},
+		})
+
+		// ASSERT
+		assert.Error(t, err)
+	})
+}
+
+func TestBackupProfileService_ContentCommand(t *testing.T) {
+	var service *Service
+	var db *ent.Client
+	var ctx context.Context
+	var profile *BackupProfile
+	var repoID int
+
+	setup := func(t *testing.T) {
+		service, db, ctx = newTestBackupProfileService(t)
+
+		p, err := service.NewBackupProfile(ctx)
+		assert.NoError(t, err, "Failed to create new backup profile")
+		p.Name = "Test profile"
+		p.Prefix = "test-"
+		profile = p
+
+		r, err := db.Repository.Create().
+			SetName("TestRepo").
+			SetURL("/tmp").
+			Save(ctx)
+		assert.NoError(t, err, "Failed to create new repository")
+		repoID = r.ID
+	}
+
+	t.Run("CreateBackupProfile with content command", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		profile.ContentCommand = "  pg_dump mydb  "
+		profile.ContentStdinName = "db/mydb.sql"
+
+		// ACT
+		created, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.Equal(t, "pg_dump mydb", created.ContentCommand)
+		assert.Equal(t, "db/mydb.sql", created.ContentStdinName)
+	})
+
+	t.Run("CreateBackupProfile with absolute stdin name", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		profile.ContentCommand = "pg_dump mydb"
+		profile.ContentStdinName = "/mydb.sql"
+
+		// ACT
+		_, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+
+		// ASSERT
+		assert.Error(t, err)
+		assert.Equal(t, 0, db.BackupProfile.Query().CountX(ctx))
+	})
+
+	t.Run("UpdateBackupProfile with content command and default stdin name", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		created, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+		assert.NoError(t, err)
+		created.ContentCommand = "pg_dump mydb"
+		created.ContentStdinName = ""
+
+		// ACT
+		err = service.UpdateBackupProfile(ctx, *created)
+
+		// ASSERT
+		assert.NoError(t, err)
+		updated := db.BackupProfile.GetX(ctx, created.ID)
+		assert.Equal(t, "pg_dump mydb", updated.ContentCommand)
+		assert.Equal(t, "stdin", updated.ContentStdinName)
+	})
+}
+
+func TestBackupProfileService_CreateOptions(t *testing.T) {
+	var service *Service
+	var db *ent.Client
+	var ctx context.Context
+	var profile *BackupProfile
+	var repoID int
+
+	setup := func(t *testing.T) {
+		service, db, ctx = newTestBackupProfileService(t)
+
+		p, err := service.NewBackupProfile(ctx)
+		assert.NoError(t, err, "Failed to create new backup profile")
+		p.Name = "Test profile"
+		p.Prefix = "test-"
+		profile = p
+
+		r, err := db.Repository.Create().
+			SetName("TestRepo").
+			SetURL("/tmp").
+			Save(ctx)
+		assert.NoError(t, err, "Failed to create new repository")
+		repoID = r.ID
+	}
+
+	t.Run("CreateBackupProfile with create options", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		interval := 600
+		profile.OneFileSystem = true
+		profile.NumericIds = true
+		profile.NoXattrs = true
+		profile.CheckpointInterval = &interval
+
+		// ACT
+		created, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+
+		// ASSERT
+		assert.NoError(t, err)
+		assert.True(t, created.OneFileSystem)
+		assert.True(t, created.NumericIds)
+		assert.True(t, created.NoXattrs)
+		assert.False(t, created.ReadSpecial)
+		assert.Equal(t, &interval, created.CheckpointInterval)
+	})
+
+	t.Run("CreateBackupProfile with too short checkpoint interval", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		interval := 10
+		profile.CheckpointInterval = &interval
+
+		// ACT
+		_, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+
+		// ASSERT
+		assert.Error(t, err)
+		assert.Equal(t, 0, db.BackupProfile.Query().CountX(ctx))
+	})
+
+	t.Run("UpdateBackupProfile resets checkpoint interval", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		interval := 600
+		profile.CheckpointInterval = &interval
+		created, err := service.CreateBackupProfile(ctx, *profile, []int{repoID})
+		assert.NoError(t, err)
+		created.CheckpointInterval = nil
+		created.Sparse = true
+
+		// ACT
+		err = service.UpdateBackupProfile(ctx, *created)
+
+		// ASSERT
+		assert.NoError(t, err)
+		updated := db.BackupProfile.GetX(ctx, created.ID)
+		assert.Nil(t, updated.CheckpointInterval)
+		assert.True(t, updated.Sparse)
+	})
+}
+
 func TestBackupProfileService_GetPrefixSuggestion(t *testing.T) {
 	service, _, ctx := newTestBackupProfileService(t)
 
@@ -0,0 +1,232 @@
+package backup_profile
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/util"
+)
+
+/***********************************/
+/********** Pattern Rules **********/
+/***********************************/
+
+// PathEvaluation tells whether a path would be part of a backup and why
+type PathEvaluation struct {
+	Path     string `json:"path"`
+	Included bool   `json:"included"`
+	Rule     string `json:"rule"`     // Rule that decided the result, empty if no rule matched
+	RulePath string `json:"rulePath"` // Path matched by the rule, a parent directory if it was excluded with "!"
+	Reason   string `json:"reason"`
+}
+
+// EvaluatePath evaluates a path against the backup paths, pattern rules, exclude paths, ignore files and marker files
+// of a backup profile. The profile does not have to be saved, so rules can be tested while they are edited.
+func (s *Service) EvaluatePath(backup BackupProfile, p string) (*PathEvaluation, error) {
+	if strings.TrimSpace(p) == "" {
+		return nil, fmt.Errorf("path must not be empty")
+	}
+	p = path.Clean(util.ExpandPath(strings.TrimSpace(p)))
+	if !path.IsAbs(p) {
+		return nil, fmt.Errorf("path %q must be absolute", p)
+	}
+
+	evaluator, err := newPathEvaluator(backup)
+	if err != nil {
+		return nil, err
+	}
+	return evaluator.evaluate(p), nil
+}
+
+// pathEvaluator evaluates paths against the selection of a backup profile.
+// The rules are parsed once and the ignore files and marker files of a directory are only read once,
+// so that it can evaluate many paths (e.g. all excluded paths of a preview).
+type pathEvaluator struct {
+	backup      BackupProfile
+	roots       []string
+	rules       []borgtypes.PatternRule
+	ignoreRules map[string][]borgtypes.PatternRule // Rules of the ignore file of a directory, nil if it has none
+	dirTags     map[string]string                  // Flag that excludes a directory, empty if it is not tagged
+}
+
+func newPathEvaluator(backup BackupProfile) (*pathEvaluator, error) {
+	rules, err := borgtypes.ParseSelectionRules(backup.Patterns, backup.ExcludePaths)
+	if err != nil {
+		return nil, err
+	}
+	roots := make([]string, 0, len(backup.BackupPaths))
+	for _, root := range backup.BackupPaths {
+		roots = append(roots, path.Clean(util.ExpandPath(root)))
+	}
+	return &pathEvaluator{
+		backup:      backup,
+		roots:       roots,
+		rules:       rules,
+		ignoreRules: make(map[string][]borgtypes.PatternRule),
+		dirTags:     make(map[string]string),
+	}, nil
+}
+
+// evaluate evaluates a clean absolute path
+func (e *pathEvaluator) evaluate(p string) *PathEvaluation {
+	// Borg walks every backup path, the path is part of the backup if any of them includes it
+	var result *PathEvaluation
+	for _, root := range e.roots {
+		if p != root && !strings.HasPrefix(p, strings.TrimSuffix(root, "/")+"/") {
+			continue
+		}
+		rootRules := e.rules
+		if e.backup.UseIgnoreFiles {
+			rootRules = append(slices.Clone(e.rules), e.findIgnoreFileRules(root, p)...)
+		}
+		evaluation := toPathEvaluation(p, borgtypes.EvaluatePatternRules(rootRules, root, p))
+
+		// Borg doesn't enter tagged directories, unless a rule excluded a parent directory first
+		if dir, rule := e.findTaggedDir(root, p); dir != "" && (evaluation.Included || isParentDir(dir, evaluation.RulePath)) {
+			evaluation = &PathEvaluation{
+				Path:     p,
+				Included: false,
+				Rule:     rule,
+				RulePath: dir,
+				Reason:   fmt.Sprintf("Excluded because %s is tagged by %q", dir, rule),
+			}
+		}
+		if evaluation.Included {
+			return evaluation
+		}
+		if result == nil {
+			result = evaluation
+		}
+	}
+	if result == nil {
+		return &PathEvaluation{Path: p, Included: false, Reason: "Path is not inside any of the backup paths"}
+	}
+	return result
+}
+
+// findIgnoreFileRules returns the rules of the ignore files that apply to a path, deeper ignore files first
+func (e *pathEvaluator) findIgnoreFileRules(root, p string) []borgtypes.PatternRule {
+	var rules []borgtypes.PatternRule
+	dirs := parentDirs(root, p)
+	for i := len(dirs) - 1; i >= 0; i-- {
+		dirRules, ok := e.ignoreRules[dirs[i]]
+		if !ok {
+			file := path.Join(dirs[i], borgtypes.IgnoreFileName)
+			if content, err := os.ReadFile(file); err == nil {
+				dirRules = borgtypes.ParseIgnoreFile(file, string(content))
+			}
+			e.ignoreRules[dirs[i]] = dirRules
+		}
+		rules = append(rules, dirRules...)
+	}
+	return rules
+}
+
+// findTaggedDir returns the first directory from the root down to the path that borg skips because of a marker file.
+// It returns the directory and the flag that excludes it.
+func (e *pathEvaluator) findTaggedDir(root, p string) (string, string) {
+	dirs := parentDirs(root, p)
+	if util.IsDirectory(p) {
+		dirs = append(dirs, p)
+	}
+
+	for _, dir := range dirs {
+		tag, ok := e.dirTags[dir]
+		if !ok {
+			tag = findDirTag(e.backup, dir)
+			e.dirTags[dir] = tag
+		}
+		if tag != "" {
+			return dir, tag
+		}
+	}
+	return "", ""
+}
+
+// findDirTag returns the flag that makes borg skip the directory because of a marker file, empty if there is none
+func findDirTag(backup BackupProfile, dir string) string {
+	if backup.ExcludeCaches && borgtypes.IsCacheDir(dir) {
+		return "--exclude-caches"
+	}
+	for _, marker := range backup.ExcludeIfPresent {
+		if util.DoesPathExist(path.Join(dir, marker)) {
+			return fmt.Sprintf("--exclude-if-present %s", marker)
+		}
+	}
+	return ""
+}
+
+// parentDirs returns the directories from the root down to the parent directory of the path
+func parentDirs(root, p string) []string {
+	var dirs []string
+	for dir := p; dir != root && isParentDir(root, dir); {
+		dir = path.Dir(dir)
+		dirs = append([]string{dir}, dirs...)
+	}
+	return dirs
+}
+
+// isParentDir returns true if dir is a parent directory of p
+func isParentDir(dir, p string) bool {
+	return p != dir && strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
+
+func toPathEvaluation(p string, evaluation borgtypes.PatternEvaluation) *PathEvaluation {
+	result := &PathEvaluation{Path: p, Included: evaluation.Included, RulePath: evaluation.Path}
+	if evaluation.Rule == nil {
+		result.Reason = "Included because no rule matches"
+		return result
+	}
+
+	// Rules from ignore files are shown with the file and line they come from
+	result.Rule = evaluation.Rule.Line
+	if evaluation.Rule.Source != "" {
+		result.Rule = evaluation.Rule.Source
+	}
+	switch {
+	case evaluation.Path != p:
+		result.Reason = fmt.Sprintf("Excluded because the parent directory %s matches %q", evaluation.Path, result.Rule)
+	case evaluation.Included:
+		result.Reason = fmt.Sprintf("Included by %q", result.Rule)
+	default:
+		result.Reason = fmt.Sprintf("Excluded by %q", result.Rule)
+	}
+	return result
+}
+
+// validateExcludeIfPresent removes empty and duplicate marker file names and validates them
+func validateExcludeIfPresent(backup *BackupProfile) error {
+	markers := make([]string, 0, len(backup.ExcludeIfPresent))
+	for _, marker := range backup.ExcludeIfPresent {
+		marker = strings.TrimSpace(marker)
+		if marker == "" || slices.Contains(markers, marker) {
+			continue
+		}
+		if strings.Contains(marker, "/") || marker == "." || marker == ".." {
+			return fmt.Errorf("marker %q must be a file name", marker)
+		}
+		markers = append(markers, marker)
+	}
+	backup.ExcludeIfPresent = markers
+	return nil
+}
+
+// validatePatterns removes empty lines from the pattern rules and validates them
+func validatePatterns(backup *BackupProfile) error {
+	patterns := make([]string, 0, len(backup.Patterns))
+	for _, pattern := range backup.Patterns {
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	backup.Patterns = patterns
+
+	if _, err := borgtypes.ParsePatternRules(patterns); err != nil {
+		return err
+	}
+	return nil
+}
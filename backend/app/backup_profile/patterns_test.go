@@ -0,0 +1,183 @@
+package backup_profile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - patterns.go
+
+TestBackupProfileService_EvaluatePath
+* EvaluatePath outside of backup paths
+* EvaluatePath excluded by pattern rule
+* EvaluatePath included by pattern rule before exclude path
+* EvaluatePath excluded by exclude path
+* EvaluatePath with invalid pattern rule
+
+TestBackupProfileService_EvaluatePathWithMarkers
+* EvaluatePath excluded by marker file
+* EvaluatePath excluded by cache directory tag
+* EvaluatePath excluded by ignore file
+* EvaluatePath ignores ignore files if disabled
+
+TestValidatePatterns
+* Remove empty lines
+* Reject invalid rule
+
+TestValidateExcludeIfPresent
+* Remove empty and duplicate markers
+* Reject marker with path
+
+*/
+
+func TestBackupProfileService_EvaluatePath(t *testing.T) {
+	service, _, _ := newTestBackupProfileService(t)
+	profile := BackupProfile{
+		BackupPaths:  []string{"/home/user", "/etc"},
+		ExcludePaths: []string{"/home/user/.cache"},
+		Patterns:     []string{"+ sh:/home/user/.cache/keep", "! sh:**/node_modules"},
+	}
+
+	t.Run("EvaluatePath outside of backup paths", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, "/var/log/syslog")
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Empty(t, evaluation.Rule)
+	})
+
+	t.Run("EvaluatePath excluded by pattern rule", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, "/home/user/app/node_modules/lib/index.js")
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, "! sh:**/node_modules", evaluation.Rule)
+		assert.Equal(t, "/home/user/app/node_modules", evaluation.RulePath)
+	})
+
+	t.Run("EvaluatePath included by pattern rule before exclude path", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, "/home/user/.cache/keep/data")
+
+		assert.NoError(t, err)
+		assert.True(t, evaluation.Included)
+		assert.Equal(t, "+ sh:/home/user/.cache/keep", evaluation.Rule)
+	})
+
+	t.Run("EvaluatePath excluded by exclude path", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, "/home/user/.cache/thumbnails")
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, "--exclude /home/user/.cache", evaluation.Rule)
+	})
+
+	t.Run("EvaluatePath with invalid pattern rule", func(t *testing.T) {
+		invalid := profile
+		invalid.Patterns = []string{"/home/user"}
+
+		_, err := service.EvaluatePath(invalid, "/home/user/notes.txt")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestBackupProfileService_EvaluatePathWithMarkers(t *testing.T) {
+	service, _, _ := newTestBackupProfileService(t)
+
+	root := t.TempDir()
+	writeFile := func(t *testing.T, name, content string) {
+		p := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		assert.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+	writeFile(t, "project/.arcoignore", "node_modules\n")
+	writeFile(t, "project/node_modules/lib/index.js", "")
+	writeFile(t, "vm/.nobackup", "")
+	writeFile(t, "vm/disk.img", "")
+	writeFile(t, "thumbnails/CACHEDIR.TAG", "Signature: 8a477f597d28d172789f06886806bc55\n")
+	writeFile(t, "thumbnails/a.png", "")
+
+	profile := BackupProfile{
+		BackupPaths:      []string{root},
+		ExcludeCaches:    true,
+		ExcludeIfPresent: []string{".nobackup"},
+		UseIgnoreFiles:   true,
+	}
+
+	t.Run("EvaluatePath excluded by marker file", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, filepath.Join(root, "vm/disk.img"))
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, "--exclude-if-present .nobackup", evaluation.Rule)
+		assert.Equal(t, filepath.Join(root, "vm"), evaluation.RulePath)
+	})
+
+	t.Run("EvaluatePath excluded by cache directory tag", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, filepath.Join(root, "thumbnails/a.png"))
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, "--exclude-caches", evaluation.Rule)
+	})
+
+	t.Run("EvaluatePath excluded by ignore file", func(t *testing.T) {
+		evaluation, err := service.EvaluatePath(profile, filepath.Join(root, "project/node_modules/lib/index.js"))
+
+		assert.NoError(t, err)
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, filepath.Join(root, "project/.arcoignore")+":1: node_modules", evaluation.Rule)
+	})
+
+	t.Run("EvaluatePath ignores ignore files if disabled", func(t *testing.T) {
+		withoutIgnoreFiles := profile
+		withoutIgnoreFiles.UseIgnoreFiles = false
+
+		evaluation, err := service.EvaluatePath(withoutIgnoreFiles, filepath.Join(root, "project/node_modules/lib/index.js"))
+
+		assert.NoError(t, err)
+		assert.True(t, evaluation.Included)
+	})
+}
+
+func TestValidatePatterns(t *testing.T) {
+	t.Run("Remove empty lines", func(t *testing.T) {
+		profile := BackupProfile{Patterns: []string{"  - sh:**/*.tmp ", "", "# comment"}}
+
+		err := validatePatterns(&profile)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"- sh:**/*.tmp", "# comment"}, profile.Patterns)
+	})
+
+	t.Run("Reject invalid rule", func(t *testing.T) {
+		profile := BackupProfile{Patterns: []string{"R /home"}}
+
+		err := validatePatterns(&profile)
+
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateExcludeIfPresent(t *testing.T) {
+	t.Run("Remove empty and duplicate markers", func(t *testing.T) {
+		profile := BackupProfile{ExcludeIfPresent: []string{" .nobackup ", "", ".nobackup", ".skip"}}
+
+		err := validateExcludeIfPresent(&profile)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{".nobackup", ".skip"}, profile.ExcludeIfPresent)
+	})
+
+	t.Run("Reject marker with path", func(t *testing.T) {
+		profile := BackupProfile{ExcludeIfPresent: []string{"dir/.nobackup"}}
+
+		err := validateExcludeIfPresent(&profile)
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,225 @@
+package backup_profile
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+)
+
+/***********************************/
+/********** Backup Preview *********/
+/***********************************/
+
+// previewTopCount is the number of largest files and directories returned by a preview
+const previewTopCount = 10
+
+// previewMaxDirDepth is the number of directory levels below a backup path whose sizes are summed up by a preview.
+// Deeper directories are counted in their ancestors only, so the number of tracked directories stays small.
+const previewMaxDirDepth = 3
+
+// BackupPreview is the result of a dry-run of a backup profile
+type BackupPreview struct {
+	TotalFiles         int              `json:"totalFiles"`
+	EstimatedBytes     int64            `json:"estimatedBytes"` // Size of the files before compression and deduplication
+	ExcludedPaths      int              `json:"excludedPaths"`
+	LargestDirectories []PreviewPath    `json:"largestDirectories"` // Only directories up to three levels below a backup path
+	LargestFiles       []PreviewPath    `json:"largestFiles"`
+	MatchedExcludes    []MatchedExclude `json:"matchedExcludes"`
+}
+
+// PreviewPath is a file or directory of a backup preview with its (cumulative) size
+type PreviewPath struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// MatchedExclude is a rule that excluded paths during a backup preview
+type MatchedExclude struct {
+	Rule        string `json:"rule"`
+	Count       int    `json:"count"`
+	ExamplePath string `json:"examplePath"`
+}
+
+// PreviewBackup runs a dry-run of the backup profile and returns the number of files, the estimated size, the largest
+// files and directories and the rules that excluded paths. The profile does not have to be saved.
+func (s *Service) PreviewBackup(ctx context.Context, backup BackupProfile) (*BackupPreview, error) {
+	if len(backup.BackupPaths) == 0 {
+		return nil, fmt.Errorf("backup profile has no backup paths")
+	}
+	evaluator, err := newPathEvaluator(backup)
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect the paths while borg streams them
+	aggregator := newPreviewAggregator(evaluator)
+	statusCh := make(chan borgtypes.FileStatus, 100)
+	collectDone := make(chan struct{})
+	go func() {
+		defer close(collectDone)
+		for fileStatus := range statusCh {
+			aggregator.add(fileStatus)
+		}
+	}()
+
+	status := s.borgClient.DryRunCreate(ctx, backup.BackupPaths, backup.createOptions(), statusCh)
+	<-collectDone
+	if status != nil && !status.IsCompletedWithSuccess() {
+		if status.HasBeenCanceled {
+			return nil, fmt.Errorf("backup preview was canceled")
+		}
+		return nil, fmt.Errorf("failed to preview backup: %s", status.GetError())
+	}
+
+	return aggregator.result(), nil
+}
+
+// previewAggregator sums up the file statuses of a dry-run
+type previewAggregator struct {
+	evaluator *pathEvaluator // Attributes excluded paths to rules, prepared once for all paths of the preview
+	preview   BackupPreview
+	files     largestPathHeap  // Only the largest files are kept
+	dirSizes  map[string]int64 // Only directories up to previewMaxDirDepth levels below a backup path
+	excludes  map[string]*MatchedExclude
+}
+
+func newPreviewAggregator(evaluator *pathEvaluator) *previewAggregator {
+	return &previewAggregator{
+		evaluator: evaluator,
+		dirSizes:  make(map[string]int64),
+		excludes:  make(map[string]*MatchedExclude),
+	}
+}
+
+func (a *previewAggregator) add(fileStatus borgtypes.FileStatus) {
+	p := path.Clean(fileStatus.Path)
+	if fileStatus.Status == "x" {
+		a.addExcluded(p)
+		return
+	}
+
+	info, err := os.Lstat(p)
+	if err != nil || info.IsDir() {
+		return
+	}
+	a.preview.TotalFiles++
+	if !info.Mode().IsRegular() {
+		return
+	}
+	a.preview.EstimatedBytes += info.Size()
+	a.files.add(PreviewPath{Path: p, Size: info.Size()})
+
+	// Add the size to the directories between the backup path and the file (up to the maximum depth)
+	if root := a.findBackupPath(p); root != "" {
+		dirs := parentDirs(root, p)
+		for _, dir := range dirs[1:min(len(dirs), previewMaxDirDepth+1)] {
+			a.dirSizes[dir] += info.Size()
+		}
+	}
+}
+
+// addExcluded attributes an excluded path to the rule that excluded it
+func (a *previewAggregator) addExcluded(p string) {
+	a.preview.ExcludedPaths++
+
+	rule := "unknown rule"
+	if evaluation := a.evaluator.evaluate(p); !evaluation.Included && evaluation.Rule != "" {
+		rule = evaluation.Rule
+	}
+	if exclude, ok := a.excludes[rule]; ok {
+		exclude.Count++
+		return
+	}
+	a.excludes[rule] = &MatchedExclude{Rule: rule, Count: 1, ExamplePath: p}
+}
+
+// findBackupPath returns the backup path that contains the path
+func (a *previewAggregator) findBackupPath(p string) string {
+	for _, root := range a.evaluator.roots {
+		if isWithinDir(root, p) {
+			return root
+		}
+	}
+	return ""
+}
+
+func (a *previewAggregator) result() *BackupPreview {
+	preview := a.preview
+
+	dirs := make([]PreviewPath, 0, len(a.dirSizes))
+	for dir, size := range a.dirSizes {
+		dirs = append(dirs, PreviewPath{Path: dir, Size: size})
+	}
+	preview.LargestDirectories = largestPaths(dirs)
+	preview.LargestFiles = largestPaths(a.files)
+
+	preview.MatchedExcludes = make([]MatchedExclude, 0, len(a.excludes))
+	for _, exclude := range a.excludes {
+		preview.MatchedExcludes = append(preview.MatchedExcludes, *exclude)
+	}
+	slices.SortFunc(preview.MatchedExcludes, func(x, y MatchedExclude) int {
+		if x.Count != y.Count {
+			return y.Count - x.Count
+		}
+		return strings.Compare(x.Rule, y.Rule)
+	})
+	return &preview
+}
+
+// compareBySize orders paths from largest to smallest (by path if the sizes are equal)
+func compareBySize(x, y PreviewPath) int {
+	if x.Size != y.Size {
+		if x.Size > y.Size {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(x.Path, y.Path)
+}
+
+// largestPaths returns the largest paths sorted by size
+func largestPaths(paths []PreviewPath) []PreviewPath {
+	slices.SortFunc(paths, compareBySize)
+	if len(paths) > previewTopCount {
+		paths = paths[:previewTopCount]
+	}
+	return slices.Clone(paths)
+}
+
+// largestPathHeap keeps the previewTopCount largest paths.
+// It is a min-heap, so the smallest of the kept paths is replaced when a larger one is added.
+type largestPathHeap []PreviewPath
+
+func (h largestPathHeap) Len() int           { return len(h) }
+func (h largestPathHeap) Less(i, j int) bool { return compareBySize(h[i], h[j]) > 0 }
+func (h largestPathHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *largestPathHeap) Push(x any)        { *h = append(*h, x.(PreviewPath)) }
+func (h *largestPathHeap) Pop() any {
+	old := *h
+	p := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return p
+}
+
+// add adds the path if it is one of the largest paths
+func (h *largestPathHeap) add(p PreviewPath) {
+	if h.Len() < previewTopCount {
+		heap.Push(h, p)
+		return
+	}
+	if compareBySize(p, (*h)[0]) < 0 {
+		(*h)[0] = p
+		heap.Fix(h, 0)
+	}
+}
+
+// isWithinDir returns true if p is dir or below it
+func isWithinDir(dir, p string) bool {
+	return p == dir || isParentDir(dir, p)
+}
@@ -0,0 +1,198 @@
+package backup_profile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/borg/mocks"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+/*
+TEST CASES - preview.go
+
+TestBackupProfileService_PreviewBackup
+* PreviewBackup sums up files and sizes
+* PreviewBackup returns largest files and directories
+* PreviewBackup groups excluded paths by rule
+* PreviewBackup with failed dry-run
+* PreviewBackup without backup paths
+
+TestLargestPaths
+* Sort by size and limit the number of paths
+
+TestLargestPathHeap
+* Keep only the largest paths
+
+TestPreviewAggregator_DirectoryDepth
+* Sum up directories only up to the maximum depth
+
+*/
+
+func TestBackupProfileService_PreviewBackup(t *testing.T) {
+	service, _, ctx := newTestBackupProfileService(t)
+	mockBorg := service.borgClient.(*mocks.MockBorg)
+
+	root := t.TempDir()
+	writeFile := func(t *testing.T, name string, size int) string {
+		p := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(strings.Repeat("a", size)), 0o644))
+		return p
+	}
+	small := writeFile(t, "docs/notes.txt", 10)
+	image := writeFile(t, "vm/disk.img", 1000)
+	movie := writeFile(t, "videos/movie.mkv", 500)
+	nodeModules := filepath.Join(root, "app/node_modules")
+	require.NoError(t, os.MkdirAll(nodeModules, 0o755))
+	tmp := writeFile(t, "app/build.tmp", 5)
+
+	profile := BackupProfile{
+		BackupPaths:  []string{root},
+		ExcludePaths: []string{"*.tmp"},
+		Patterns:     []string{"! sh:**/node_modules"},
+	}
+
+	// expectDryRun makes the mocked dry-run send the file statuses
+	expectDryRun := func(statuses []borgtypes.FileStatus, status *borgtypes.Status) {
+		mockBorg.EXPECT().
+			DryRunCreate(gomock.Any(), profile.BackupPaths, borgtypes.CreateOptions{ExcludePaths: profile.ExcludePaths, Patterns: profile.Patterns}, gomock.Any()).
+			DoAndReturn(func(_, _, _ any, ch chan borgtypes.FileStatus) *borgtypes.Status {
+				for _, fs := range statuses {
+					ch <- fs
+				}
+				close(ch)
+				return status
+			})
+	}
+
+	statuses := []borgtypes.FileStatus{
+		{Status: "-", Path: root},
+		{Status: "-", Path: filepath.Join(root, "docs")},
+		{Status: "-", Path: small},
+		{Status: "-", Path: filepath.Join(root, "vm")},
+		{Status: "-", Path: image},
+		{Status: "-", Path: filepath.Join(root, "videos")},
+		{Status: "-", Path: movie},
+		{Status: "x", Path: nodeModules},
+		{Status: "x", Path: tmp},
+	}
+
+	t.Run("PreviewBackup sums up files and sizes", func(t *testing.T) {
+		expectDryRun(statuses, &borgtypes.Status{})
+
+		preview, err := service.PreviewBackup(ctx, profile)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, preview.TotalFiles)
+		assert.Equal(t, int64(1510), preview.EstimatedBytes)
+		assert.Equal(t, 2, preview.ExcludedPaths)
+	})
+
+	t.Run("PreviewBackup returns largest files and directories", func(t *testing.T) {
+		expectDryRun(statuses, &borgtypes.Status{})
+
+		preview, err := service.PreviewBackup(ctx, profile)
+
+		require.NoError(t, err)
+		assert.Equal(t, []PreviewPath{
+			{Path: image, Size: 1000},
+			{Path: movie, Size: 500},
+			{Path: small, Size: 10},
+		}, preview.LargestFiles)
+		assert.Equal(t, []PreviewPath{
+			{Path: filepath.Join(root, "vm"), Size: 1000},
+			{Path: filepath.Join(root, "videos"), Size: 500},
+			{Path: filepath.Join(root, "docs"), Size: 10},
+		}, preview.LargestDirectories)
+	})
+
+	t.Run("PreviewBackup groups excluded paths by rule", func(t *testing.T) {
+		expectDryRun(statuses, &borgtypes.Status{})
+
+		preview, err := service.PreviewBackup(ctx, profile)
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []MatchedExclude{
+			{Rule: "! sh:**/node_modules", Count: 1, ExamplePath: nodeModules},
+			{Rule: "--exclude *.tmp", Count: 1, ExamplePath: tmp},
+		}, preview.MatchedExcludes)
+	})
+
+	t.Run("PreviewBackup with failed dry-run", func(t *testing.T) {
+		expectDryRun(nil, &borgtypes.Status{Error: borgtypes.ErrorCommandError})
+
+		_, err := service.PreviewBackup(ctx, profile)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("PreviewBackup without backup paths", func(t *testing.T) {
+		_, err := service.PreviewBackup(ctx, BackupProfile{})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestLargestPaths(t *testing.T) {
+	t.Run("Sort by size and limit the number of paths", func(t *testing.T) {
+		var paths []PreviewPath
+		for i := 0; i < previewTopCount+5; i++ {
+			paths = append(paths, PreviewPath{Path: filepath.Join("/data", strings.Repeat("a", i+1)), Size: int64(i)})
+		}
+
+		largest := largestPaths(paths)
+
+		assert.Len(t, largest, previewTopCount)
+		assert.Equal(t, int64(previewTopCount+4), largest[0].Size)
+		assert.Equal(t, int64(5), largest[previewTopCount-1].Size)
+	})
+}
+
+func TestLargestPathHeap(t *testing.T) {
+	t.Run("Keep only the largest paths", func(t *testing.T) {
+		var h largestPathHeap
+		for i := 0; i < previewTopCount*3; i++ {
+			// Add the sizes in a mixed order
+			size := int64((i * 7) % (previewTopCount * 3))
+			h.add(PreviewPath{Path: filepath.Join("/data", strings.Repeat("a", i+1)), Size: size})
+		}
+
+		largest := largestPaths(h)
+
+		assert.Len(t, largest, previewTopCount)
+		assert.Equal(t, int64(previewTopCount*3-1), largest[0].Size)
+		assert.Equal(t, int64(previewTopCount*2), largest[previewTopCount-1].Size)
+	})
+}
+
+func TestPreviewAggregator_DirectoryDepth(t *testing.T) {
+	t.Run("Sum up directories only up to the maximum depth", func(t *testing.T) {
+		root := t.TempDir()
+		dirs := []string{root}
+		for i := 0; i < previewMaxDirDepth+2; i++ {
+			dirs = append(dirs, filepath.Join(dirs[len(dirs)-1], fmt.Sprintf("level%d", i+1)))
+		}
+		deepest := dirs[len(dirs)-1]
+		require.NoError(t, os.MkdirAll(deepest, 0o755))
+		file := filepath.Join(deepest, "file.txt")
+		require.NoError(t, os.WriteFile(file, []byte("data"), 0o644))
+
+		evaluator, err := newPathEvaluator(BackupProfile{BackupPaths: []string{root}})
+		require.NoError(t, err)
+		aggregator := newPreviewAggregator(evaluator)
+
+		aggregator.add(borgtypes.FileStatus{Status: "-", Path: file})
+
+		assert.Len(t, aggregator.dirSizes, previewMaxDirDepth)
+		for _, dir := range dirs[1 : previewMaxDirDepth+1] {
+			assert.Equal(t, int64(4), aggregator.dirSizes[dir], dir)
+		}
+	})
+}
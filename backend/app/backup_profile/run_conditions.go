@@ -0,0 +1,141 @@
+package backup_profile
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/util"
+)
+
+// Run conditions that are not met, recorded as "skipped: <condition>" in the last run status of the schedule
+const (
+	conditionOnBattery   = "on battery"
+	conditionMetered     = "on metered network"
+	conditionUnreachable = "repository not reachable"
+)
+
+// conditionRetryInterval is how often a waiting backup checks its run conditions again.
+// Changes of the power source and network connection are reported right away, but the reachability of a repository is not.
+const conditionRetryInterval = 5 * time.Minute
+
+// reachabilityTimeout limits how long to wait for the host of a remote repository
+const reachabilityTimeout = 5 * time.Second
+
+// unmetRunCondition returns the first run condition of the schedule that is not met for the repository, or an empty string.
+// Conditions that can't be determined (e.g. UPower isn't running) don't hold back a backup.
+func (s *Service) unmetRunCondition(ctx context.Context, bs *ent.BackupSchedule, repositoryId int) (string, error) {
+	if bs.RequireAcPower {
+		onBattery, err := s.conditions.OnBattery(ctx)
+		if err != nil {
+			s.log.Debugf("Ignoring AC power condition: %s", err)
+		} else if onBattery {
+			return conditionOnBattery, nil
+		}
+	}
+	if bs.RequireUnmeteredNetwork {
+		metered, err := s.conditions.Metered(ctx)
+		if err != nil {
+			s.log.Debugf("Ignoring unmetered network condition: %s", err)
+		} else if metered {
+			return conditionMetered, nil
+		}
+	}
+	if bs.RequireReachableRepository {
+		repo, err := s.db.Repository.Get(ctx, repositoryId)
+		if err != nil {
+			return "", fmt.Errorf("failed to get repository %d: %w", repositoryId, err)
+		}
+		if err := repositoryReachable(ctx, repo.URL); err != nil {
+			s.log.Debugf("Repository %d is not reachable: %s", repositoryId, err)
+			return conditionUnreachable, nil
+		}
+	}
+	return "", nil
+}
+
+// repositoryReachable checks if the host of a remote repository accepts connections or if the path of a local repository exists
+func repositoryReachable(ctx context.Context, repoURL string) error {
+	host, port, isRemote := repositoryAddress(repoURL)
+	if !isRemote {
+		_, err := os.Stat(util.ExpandPath(repoURL))
+		return err
+	}
+
+	dialer := net.Dialer{Timeout: reachabilityTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", resolveSSHAddress(ctx, host, port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+// repositoryAddress returns the host and port of a remote repository, the port is empty if the URL doesn't contain one.
+// It returns false for local repositories.
+//
+// ssh://user@host:port/./path/to/repo -> host, port
+// user@host:~/path/to/repo -> host, ""
+func repositoryAddress(repoURL string) (string, string, bool) {
+	if strings.HasPrefix(repoURL, "ssh://") {
+		parsedURL, err := url.Parse(repoURL)
+		if err != nil || parsedURL.Hostname() == "" {
+			return "", "", false
+		}
+		return parsedURL.Hostname(), parsedURL.Port(), true
+	}
+	if strings.HasPrefix(repoURL, "/") || strings.HasPrefix(repoURL, "~") {
+		return "", "", false
+	}
+	userHost, _, found := strings.Cut(repoURL, ":")
+	if !found {
+		return "", "", false
+	}
+	_, host, _ := strings.Cut(userHost, "@")
+	if host == "" {
+		host = userHost
+	}
+	return host, "", true
+}
+
+// resolveSSHAddress returns the address ssh connects to for the host, so that aliases of the ssh config
+// (HostName, Port) are honored. Without ssh the host is used as it is.
+func resolveSSHAddress(ctx context.Context, host string, port string) string {
+	args := []string{"-G"}
+	if port != "" {
+		args = append(args, "-p", port)
+	}
+	ctx, cancel := context.WithTimeout(ctx, reachabilityTimeout)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, "ssh", append(args, "--", host)...).Output()
+	if err != nil {
+		return sshAddressFromConfig("", host, port)
+	}
+	return sshAddressFromConfig(string(out), host, port)
+}
+
+// sshAddressFromConfig returns the address of the hostname and port in the output of ssh -G.
+// The host and port (default 22) are used for options that are missing.
+func sshAddressFromConfig(config string, host string, port string) string {
+	for _, line := range strings.Split(config, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found {
+			continue
+		}
+		switch key {
+		case "hostname":
+			host = value
+		case "port":
+			port = value
+		}
+	}
+	if port == "" {
+		port = "22"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -0,0 +1,188 @@
+package backup_profile
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+
+TEST CASES - run_conditions.go
+
+TestRepositoryAddress
+* ssh URL with port
+* ssh URL without port
+* scp-like URL
+* Local path
+* Local path in the home directory
+
+TestSSHAddressFromConfig
+* Host alias with hostname and port
+* No ssh config
+* Port of the URL without ssh config
+
+TestUnmetRunCondition
+* No conditions
+* AC power required - on battery
+* AC power required - on AC power
+* AC power required - power source unknown
+* Unmetered network required - metered
+* Reachable repository required - local path exists
+* Reachable repository required - local path doesn't exist
+
+*/
+
+// fakeConditions reports fixed system conditions instead of asking UPower and NetworkManager
+type fakeConditions struct {
+	onBattery bool
+	metered   bool
+	err       error
+	changed   chan struct{}
+}
+
+func (f *fakeConditions) OnBattery(_ context.Context) (bool, error) {
+	return f.onBattery, f.err
+}
+
+func (f *fakeConditions) Metered(_ context.Context) (bool, error) {
+	return f.metered, f.err
+}
+
+func (f *fakeConditions) Watch(_ context.Context) (<-chan struct{}, error) {
+	return f.changed, nil
+}
+
+func TestRepositoryAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		wantHost   string
+		wantPort   string
+		wantRemote bool
+	}{
+		{name: "ssh URL with port", url: "ssh://user@example.com:2222/./repo", wantHost: "example.com", wantPort: "2222", wantRemote: true},
+		{name: "ssh URL without port", url: "ssh://user@example.com/./repo", wantHost: "example.com", wantRemote: true},
+		{name: "scp-like URL", url: "user@example.com:~/repo", wantHost: "example.com", wantRemote: true},
+		{name: "Local path", url: "/mnt/backup/repo", wantRemote: false},
+		{name: "Local path in the home directory", url: "~/backup:old/repo", wantRemote: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, remote := repositoryAddress(tt.url)
+
+			assert.Equal(t, tt.wantRemote, remote)
+			assert.Equal(t, tt.wantHost, host)
+			assert.Equal(t, tt.wantPort, port)
+		})
+	}
+}
+
+func TestSSHAddressFromConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		host   string
+		port   string
+		want   string
+	}{
+		{name: "Host alias with hostname and port", config: "user me\nhostname backup.example.com\nport 2222\n", host: "nas", want: "backup.example.com:2222"},
+		{name: "No ssh config", config: "", host: "example.com", want: "example.com:22"},
+		{name: "Port of the URL without ssh config", config: "", host: "example.com", port: "2200", want: "example.com:2200"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sshAddressFromConfig(tt.config, tt.host, tt.port))
+		})
+	}
+}
+
+func TestUnmetRunCondition(t *testing.T) {
+	existingPath := t.TempDir()
+	missingPath := filepath.Join(existingPath, "not-mounted")
+
+	tests := []struct {
+		name       string
+		schedule   ent.BackupSchedule
+		conditions fakeConditions
+		repoURL    string
+		want       string
+	}{
+		{
+			name:       "No conditions",
+			schedule:   ent.BackupSchedule{},
+			conditions: fakeConditions{onBattery: true, metered: true},
+			repoURL:    missingPath,
+			want:       "",
+		},
+		{
+			name:       "AC power required - on battery",
+			schedule:   ent.BackupSchedule{RequireAcPower: true},
+			conditions: fakeConditions{onBattery: true},
+			repoURL:    existingPath,
+			want:       conditionOnBattery,
+		},
+		{
+			name:       "AC power required - on AC power",
+			schedule:   ent.BackupSchedule{RequireAcPower: true},
+			conditions: fakeConditions{onBattery: false},
+			repoURL:    existingPath,
+			want:       "",
+		},
+		{
+			name:       "AC power required - power source unknown",
+			schedule:   ent.BackupSchedule{RequireAcPower: true},
+			conditions: fakeConditions{onBattery: true, err: errors.New("UPower is not running")},
+			repoURL:    existingPath,
+			want:       "",
+		},
+		{
+			name:       "Unmetered network required - metered",
+			schedule:   ent.BackupSchedule{RequireAcPower: true, RequireUnmeteredNetwork: true},
+			conditions: fakeConditions{metered: true},
+			repoURL:    existingPath,
+			want:       conditionMetered,
+		},
+		{
+			name:       "Reachable repository required - local path exists",
+			schedule:   ent.BackupSchedule{RequireReachableRepository: true},
+			conditions: fakeConditions{},
+			repoURL:    existingPath,
+			want:       "",
+		},
+		{
+			name:       "Reachable repository required - local path doesn't exist",
+			schedule:   ent.BackupSchedule{RequireReachableRepository: true},
+			conditions: fakeConditions{},
+			repoURL:    missingPath,
+			want:       conditionUnreachable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			service, db, ctx := newTestBackupProfileService(t)
+			service.conditions = &tt.conditions
+			repo, err := db.Repository.Create().
+				SetName("TestRepo").
+				SetURL(tt.repoURL).
+				Save(ctx)
+			require.NoError(t, err)
+
+			// ACT
+			condition, err := service.unmetRunCondition(ctx, &tt.schedule, repo.ID)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, condition)
+		})
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/loomi-labs/arco/backend/app/schedule"
 	"github.com/loomi-labs/arco/backend/app/types"
 	"github.com/loomi-labs/arco/backend/ent"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/pruningrule"
+	"github.com/loomi-labs/arco/backend/platform"
 	"github.com/negrel/assert"
 )
 
@@ -46,6 +48,49 @@ func (s *Service) StartScheduleChangeListener() {
 	}
 }
 
+// StartResumeListener reschedules all backups whenever the system resumes from sleep.
+// Timers don't advance while the system is asleep, so runs that were missed in the meantime are caught up
+// according to the catch-up policy of their schedule.
+func (si *ServiceInternal) StartResumeListener() {
+	resumed, err := platform.WatchResume(si.ctx)
+	if err != nil {
+		si.log.Infof("Not watching for resume from sleep: %s", err)
+		return
+	}
+
+	for {
+		select {
+		case <-si.ctx.Done():
+			si.log.Debug("Resume listener stopped due to context cancellation")
+			return
+		case <-resumed:
+			si.log.Info("System resumed from sleep, rescheduling backups")
+			si.sendBackupScheduleChanged()
+		}
+	}
+}
+
+// StartRunConditionListener checks the run conditions of waiting backups again whenever the power source
+// or the network connection changes
+func (si *ServiceInternal) StartRunConditionListener() {
+	changed, err := si.conditions.Watch(si.ctx)
+	if err != nil {
+		si.log.Infof("Not watching the power source and network connection: %s", err)
+		return
+	}
+
+	for {
+		select {
+		case <-si.ctx.Done():
+			si.log.Debug("Run condition listener stopped due to context cancellation")
+			return
+		case <-changed:
+			si.log.Info("Power source or network connection changed, checking waiting backups")
+			si.retryPendingBackups()
+		}
+	}
+}
+
 /***********************************/
 /********** Backup Scheduling ******/
 /***********************************/
@@ -53,6 +98,9 @@ func (s *Service) StartScheduleChangeListener() {
 func (s *Service) scheduleBackups(ctx context.Context) []*time.Timer {
 	s.log.Info("Scheduling backups")
 
+	// Waiting backups are scheduled again with the missed run of their schedule
+	s.clearPendingBackups()
+
 	allBs, err := s.getBackupSchedules(ctx)
 	if err != nil {
 		s.log.Errorf("Failed to get backup schedules: %s", err)
@@ -63,10 +111,23 @@ func (s *Service) scheduleBackups(ctx context.Context) []*time.Timer {
 	var timers []*time.Timer
 	for _, bs := range allBs {
 		backupProfileId := bs.Edges.BackupProfile.ID
+		repositories := bs.Edges.BackupProfile.Edges.Repositories
+
+		assert.NotNil(repositories, "repositories is nil")
+
+		// The run was missed if it is in the past (e.g. the system was asleep or Arco wasn't running).
+		// A run that was already missed or deferred before has a catch-up time and is kept as it is.
+		if bs.NextRun.Before(time.Now()) && bs.CatchUpAt == nil {
+			missed, err := s.handleMissedBackup(ctx, bs)
+			if err != nil {
+				s.log.Errorf("Failed to handle missed backup: %s", err)
+				s.state.AddNotification(ctx, fmt.Sprintf("Failed to handle missed backup: %s", err), types.LevelError)
+				continue
+			}
+			bs = missed
+		}
 
-		assert.NotNil(bs.Edges.BackupProfile.Edges.Repositories, "repositories is nil")
-
-		for _, r := range bs.Edges.BackupProfile.Edges.Repositories {
+		for _, r := range repositories {
 			backupId := types.BackupId{
 				BackupProfileId: backupProfileId,
 				RepositoryId:    r.ID,
@@ -80,45 +141,54 @@ func (s *Service) scheduleBackups(ctx context.Context) []*time.Timer {
 
 func (s *Service) scheduleBackup(bs *ent.BackupSchedule, backupId types.BackupId) *time.Timer {
 	// Calculate the duration until the next backup
-	durationUntilNextBackup := time.Until(bs.NextRun)
-	if durationUntilNextBackup < 0 {
-		// If the duration is negative, schedule the backup immediately
-		durationUntilNextBackup = 0
-	}
+	durationUntilNextBackup := durationUntilBackup(bs, time.Now())
 
 	// Schedule the backup
-	updatedAt := bs.UpdatedAt
+	scheduleId, nextRun := bs.ID, bs.NextRun
 	timer := time.AfterFunc(durationUntilNextBackup, func() {
-		s.runScheduledBackup(bs, backupId, updatedAt)
+		s.runScheduledBackup(scheduleId, backupId, nextRun)
 	})
 	s.log.Info(fmt.Sprintf("Scheduled backup %s in %s", backupId, durationUntilNextBackup))
 	return timer
 }
 
-func (s *Service) runScheduledBackup(bs *ent.BackupSchedule, backupId types.BackupId, updatedAt time.Time) {
-	// Check if the backup schedule still exists
-	// This is necessary because the backup schedule might have been deleted or modified (modified -> deleted and recreated)
-	exist, err := s.db.BackupSchedule.
+func (s *Service) runScheduledBackup(scheduleId int, backupId types.BackupId, nextRun time.Time) {
+	// Check if the run is still planned
+	// This is necessary because the backup schedule might have been deleted or modified, which moves its next run.
+	// The next run is used instead of updated_at, which also changes when the backup of another repository is deferred.
+	bs, err := s.db.BackupSchedule.
 		Query().
 		Where(backupschedule.And(
-			backupschedule.ID(bs.ID),
-			backupschedule.UpdatedAtEQ(updatedAt),
+			backupschedule.ID(scheduleId),
+			backupschedule.NextRunEQ(nextRun),
 		)).
-		Exist(s.ctx)
+		Only(s.ctx)
 	if err != nil {
-		s.log.Error(fmt.Sprintf("Failed to check if backup schedule exists: %s", err))
+		if ent.IsNotFound(err) {
+			s.log.Infof("Backup schedule %d does not exist anymore or was modified, skipping", scheduleId)
+			return
+		}
+		s.log.Error(fmt.Sprintf("Failed to get backup schedule: %s", err))
 		s.state.AddNotification(s.ctx, fmt.Sprintf("Failed to run scheduled backup: %s", err), types.LevelError)
 		return
 	}
-	if !exist {
-		s.log.Infof("Backup schedule %d does not exist anymore, skipping", bs.ID)
+
+	// Wait until the run conditions of the schedule are met
+	condition, err := s.unmetRunCondition(s.ctx, bs, backupId.RepositoryId)
+	if err != nil {
+		s.log.Error(fmt.Sprintf("Failed to check run conditions: %s", err))
+		s.state.AddNotification(s.ctx, fmt.Sprintf("Failed to run scheduled backup: %s", err), types.LevelError)
+		return
+	}
+	if condition != "" {
+		s.deferScheduledBackup(bs, backupId, condition)
 		return
 	}
 
 	// Run the backup
 	s.log.Infof("Running scheduled backup for %s", backupId)
 	var lastRunStatus string
-	_, err = s.repositoryService.QueueBackup(s.ctx, backupId)
+	_, err = s.repositoryService.QueueScheduledBackup(s.ctx, backupId)
 	if err != nil {
 		lastRunStatus = fmt.Sprintf("error: %s", err)
 		s.log.Error(fmt.Sprintf("Failed to run scheduled backup: %s", err))
@@ -135,6 +205,126 @@ func (s *Service) runScheduledBackup(bs *ent.BackupSchedule, backupId types.Back
 	}
 }
 
+// durationUntilBackup returns how long to wait for the next run of the backup schedule.
+// A run that is past its next run time is started at its catch-up time.
+func durationUntilBackup(bs *ent.BackupSchedule, now time.Time) time.Duration {
+	if duration := bs.NextRun.Sub(now); duration > 0 {
+		return duration
+	}
+	if bs.CatchUpAt != nil {
+		return max(bs.CatchUpAt.Sub(now), 0)
+	}
+	return 0
+}
+
+// lastRunStatusSkippedMissed is the last run status of a schedule whose missed run was skipped
+const lastRunStatusSkippedMissed = "skipped: missed run"
+
+// handleMissedBackup applies the catch-up policy of the schedule to a missed run.
+// The catch-up time is stored, so rescheduling the backups doesn't delay the run again.
+func (s *Service) handleMissedBackup(ctx context.Context, bs *ent.BackupSchedule) (*ent.BackupSchedule, error) {
+	catchUpAt := time.Now()
+	switch bs.CatchUpPolicy {
+	case backupschedule.CatchUpPolicySkip:
+		return s.skipMissedBackup(ctx, bs)
+	case backupschedule.CatchUpPolicyDelayed:
+		catchUpAt = catchUpAt.Add(time.Duration(bs.CatchUpDelayMinutes) * time.Minute)
+	case backupschedule.CatchUpPolicyImmediately:
+	}
+	s.log.Infof("Catching up missed backup of schedule %d in %s", bs.ID, time.Until(catchUpAt))
+	return bs.Update().
+		SetCatchUpAt(catchUpAt).
+		Save(ctx)
+}
+
+// skipMissedBackup moves a missed run to the next run time of the schedule without running a backup
+func (s *Service) skipMissedBackup(ctx context.Context, bs *ent.BackupSchedule) (*ent.BackupSchedule, error) {
+	nextRun, err := plannedBackupTime(bs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	s.log.Infof("Skipping missed backup of schedule %d, next run in %s", bs.ID, time.Until(nextRun))
+	return bs.Update().
+		SetNextRun(nextRun).
+		SetLastRunStatus(lastRunStatusSkippedMissed).
+		Save(ctx)
+}
+
+// pendingBackup is a scheduled backup that waits for the run conditions of its schedule
+type pendingBackup struct {
+	scheduleId int
+	nextRun    time.Time // Run of the schedule the backup waits for
+	retry      *time.Timer
+}
+
+// deferScheduledBackup records why a scheduled backup can't run yet and waits until its run conditions may be met.
+// The next run of the schedule is not advanced, so the backup runs as soon as the conditions are met.
+// The run gets a catch-up time, so it is not treated as missed when the backups are rescheduled.
+func (s *Service) deferScheduledBackup(bs *ent.BackupSchedule, backupId types.BackupId, condition string) {
+	s.log.Infof("Deferring scheduled backup for %s: %s", backupId, condition)
+	update := bs.Update().
+		SetLastRunStatus(fmt.Sprintf("skipped: %s", condition))
+	if bs.CatchUpAt == nil {
+		update.SetCatchUpAt(time.Now())
+	}
+	err := update.Exec(s.ctx)
+	if err != nil {
+		s.log.Error(fmt.Sprintf("Failed to save backup run: %s", err))
+		s.state.AddNotification(s.ctx, fmt.Sprintf("Failed to save backup run: %s", err), types.LevelError)
+		return
+	}
+
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	if pending, ok := s.pendingBackups[backupId]; ok {
+		pending.retry.Stop()
+	}
+	s.pendingBackups[backupId] = pendingBackup{
+		scheduleId: bs.ID,
+		nextRun:    bs.NextRun,
+		retry: time.AfterFunc(conditionRetryInterval, func() {
+			s.retryPendingBackup(backupId)
+		}),
+	}
+}
+
+// retryPendingBackups checks the run conditions of all waiting backups again
+func (s *Service) retryPendingBackups() {
+	s.pendingMu.Lock()
+	backupIds := make([]types.BackupId, 0, len(s.pendingBackups))
+	for backupId := range s.pendingBackups {
+		backupIds = append(backupIds, backupId)
+	}
+	s.pendingMu.Unlock()
+
+	for _, backupId := range backupIds {
+		go s.retryPendingBackup(backupId)
+	}
+}
+
+// retryPendingBackup runs a waiting backup if its run conditions are met now, otherwise it keeps waiting
+func (s *Service) retryPendingBackup(backupId types.BackupId) {
+	s.pendingMu.Lock()
+	pending, ok := s.pendingBackups[backupId]
+	delete(s.pendingBackups, backupId)
+	s.pendingMu.Unlock()
+	if !ok {
+		return
+	}
+	pending.retry.Stop()
+	s.runScheduledBackup(pending.scheduleId, backupId, pending.nextRun)
+}
+
+// clearPendingBackups stops waiting for the run conditions of all backups
+func (s *Service) clearPendingBackups() {
+	s.pendingMu.Lock()
+	defer s.pendingMu.Unlock()
+	for backupId, pending := range s.pendingBackups {
+		pending.retry.Stop()
+		delete(s.pendingBackups, backupId)
+	}
+}
+
 func (s *Service) updateBackupSchedule(bs *ent.BackupSchedule, lastRunStatus string) (*ent.BackupSchedule, error) {
 	lastRunTime := time.Now()
 	update := bs.Update()
@@ -142,12 +332,13 @@ func (s *Service) updateBackupSchedule(bs *ent.BackupSchedule, lastRunStatus str
 	if lastRunStatus != "" {
 		update.SetNillableLastRunStatus(&lastRunStatus)
 	}
-	nextBackupTime, err := getNextBackupTime(bs, lastRunTime)
+	nextRun, err := plannedBackupTime(bs, lastRunTime)
 	if err != nil {
 		return nil, err
 	}
-	s.log.Debugf("Next run in %s", time.Until(nextBackupTime))
-	update.SetNextRun(nextBackupTime)
+	s.log.Debugf("Next run in %s", time.Until(nextRun))
+	update.SetNextRun(nextRun)
+	update.ClearCatchUpAt()
 	return update.Save(s.ctx)
 }
 
@@ -181,101 +372,83 @@ func weekdayToTimeWeekday(weekday backupschedule.Weekday) time.Weekday {
 	return time.Monday
 }
 
-// getNextBackupTime calculates the next time a backup should run based on the schedule
-func getNextBackupTime(bs *ent.BackupSchedule, fromTime time.Time) (time.Time, error) {
-	// Make sure we are working with UTC time (we don't care about the timezone)
-	fromTime = fromTime.In(time.UTC)
+// toSchedule returns the schedule of the backup schedule, restricted to the runs its start window allows.
+// The time based modes use UTC (we don't care about the timezone), cron expressions and the start window are evaluated in local time.
+func toSchedule(bs *ent.BackupSchedule) (schedule.Schedule, error) {
+	s, err := toModeSchedule(bs)
+	if err != nil {
+		return nil, err
+	}
+	window := startWindow(bs)
+	if err := window.Validate(); err != nil {
+		return nil, err
+	}
+	return schedule.Restrict(s, window, time.Local), nil
+}
+
+// toModeSchedule converts the mode of the backup schedule to a schedule
+func toModeSchedule(bs *ent.BackupSchedule) (schedule.Schedule, error) {
 	switch bs.Mode {
 	case backupschedule.ModeMinuteInterval:
-		if bs.IntervalMinutes == 0 {
-			return time.Time{}, fmt.Errorf("interval_minutes must be greater than 0")
-		}
-		interval := time.Duration(bs.IntervalMinutes) * time.Minute
-		return fromTime.Add(interval), nil
+		return schedule.Interval(time.Duration(bs.IntervalMinutes) * time.Minute)
 	case backupschedule.ModeDaily:
 		dailyAt := bs.DailyAt.In(time.UTC)
-		// Calculate the wanted duration from the beginning of the day
-		wantedDuration :=
-			time.Duration(dailyAt.Hour())*time.Hour + // hours
-				time.Duration(dailyAt.Minute())*time.Minute // minutes
-
-		// Calculate the duration from the beginning of the day for the fromTime
-		fromTimeDuration :=
-			time.Duration(fromTime.Hour())*time.Hour + // hours
-				time.Duration(fromTime.Minute())*time.Minute // minutes
-
-		diff := wantedDuration - fromTimeDuration
-		if diff <= 0 {
-			// If the difference is negative, we already passed the time for today
-			// so we return the time for tomorrow
-			return fromTime.Add(diff).AddDate(0, 0, 1), nil
-		}
-		// Otherwise we just wait the difference
-		return fromTime.Add(diff), nil
+		return schedule.NewCron([]string{fmt.Sprintf("%d %d * * *", dailyAt.Minute(), dailyAt.Hour())}, time.UTC)
 	case backupschedule.ModeWeekly:
 		weeklyAt := bs.WeeklyAt.In(time.UTC)
-		// Calculate the wanted duration from the beginning of the week
-		wantedDuration :=
-			time.Duration(weekdayToTimeWeekday(bs.Weekday))*24*time.Hour + // days
-				time.Duration(weeklyAt.Hour())*time.Hour + // hours
-				time.Duration(weeklyAt.Minute())*time.Minute // minutes
-
-		// Calculate the duration from the beginning of the week for the fromTime
-		fromTimeDuration :=
-			time.Duration(fromTime.Weekday())*24*time.Hour + // days
-				time.Duration(fromTime.Hour())*time.Hour + // hours
-				time.Duration(fromTime.Minute())*time.Minute // minutes
-
-		diff := wantedDuration - fromTimeDuration
-		if diff <= 0 {
-			// If the difference is negative, we already passed the time for this week
-			// so we return the time for next week
-			return fromTime.Add(diff).AddDate(0, 0, 7), nil
-		}
-		// Otherwise we just wait the difference
-		return fromTime.Add(diff), nil
+		return schedule.NewCron([]string{fmt.Sprintf("%d %d * * %d", weeklyAt.Minute(), weeklyAt.Hour(), weekdayToTimeWeekday(bs.Weekday))}, time.UTC)
 	case backupschedule.ModeMonthly:
-		monthday := bs.Monthday
 		monthlyAt := bs.MonthlyAt.In(time.UTC)
+		return schedule.Monthly(int(bs.Monthday), monthlyAt.Hour(), monthlyAt.Minute(), time.UTC)
+	case backupschedule.ModeCron:
+		return schedule.NewCron(bs.CronExpressions, time.Local)
+	case backupschedule.ModeDisabled:
+	}
+	return nil, fmt.Errorf("no valid schedule found")
+}
 
-		// If we are in February and the monthday is 29 or 30, we use the last day of the month
-		if fromTime.Month() == time.February && monthday > 28 {
-			// Check if the year is a leap year
-			year := fromTime.Year()
-			if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
-				// If it is a leap year, we use 29
-				if monthday > 29 {
-					monthday = 29
-				}
-			} else {
-				// If it is not a leap year, we use 28
-				monthday = 28
-			}
-		}
+// nextBackupTime calculates the next time a backup should run based on the schedule
+func nextBackupTime(bs *ent.BackupSchedule, fromTime time.Time) (time.Time, error) {
+	nextRuns, err := nextBackupTimes(bs, fromTime, 1)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return nextRuns[0], nil
+}
 
-		// Calculate the wanted duration from the beginning of the month
-		wantedDuration :=
-			time.Duration(monthday-1)*24*time.Hour + // days
-				time.Duration(monthlyAt.Hour())*time.Hour + // hours
-				time.Duration(monthlyAt.Minute())*time.Minute // minutes
-
-		// Calculate the duration from the beginning of the month for the fromTime
-		fromTimeDuration :=
-			time.Duration(fromTime.Day()-1)*24*time.Hour + // days
-				time.Duration(fromTime.Hour())*time.Hour + // hours
-				time.Duration(fromTime.Minute())*time.Minute // minutes
-
-		diff := wantedDuration - fromTimeDuration
-		if diff <= 0 {
-			// If the difference is negative, we already passed the time for this month
-			// so we return the time for next month
-			return fromTime.Add(diff).AddDate(0, 1, 0), nil
-		}
-		// Otherwise we just wait the difference
-		return fromTime.Add(diff), nil
-	case backupschedule.ModeDisabled:
+// plannedBackupTime calculates the next run time with the random jitter of the schedule, as it is stored in next_run
+func plannedBackupTime(bs *ent.BackupSchedule, fromTime time.Time) (time.Time, error) {
+	nextRun, err := nextBackupTime(bs, fromTime)
+	if err != nil || bs.JitterMinutes == 0 {
+		return nextRun, err
+	}
+	// The jitter must not move the run into a blackout period
+	jittered := schedule.Jitter(nextRun, time.Duration(bs.JitterMinutes)*time.Minute)
+	if next := startWindow(bs).NextStart(jittered.In(time.Local)); !next.IsZero() {
+		return next, nil
+	}
+	return nextRun, nil
+}
+
+// startWindow returns the allowed hours and blackout periods of the backup schedule
+func startWindow(bs *ent.BackupSchedule) schedule.StartWindow {
+	return schedule.StartWindow{
+		AllowedHours:    bs.AllowedHours,
+		BlackoutPeriods: bs.BlackoutPeriods,
+	}
+}
+
+// nextBackupTimes calculates the next count times a backup should run based on the schedule
+func nextBackupTimes(bs *ent.BackupSchedule, fromTime time.Time, count int) ([]time.Time, error) {
+	s, err := toSchedule(bs)
+	if err != nil {
+		return nil, err
+	}
+	nextRuns := schedule.NextRuns(s, fromTime, count)
+	if len(nextRuns) == 0 {
+		return nil, fmt.Errorf("schedule never runs")
 	}
-	return time.Time{}, fmt.Errorf("no valid schedule found")
+	return nextRuns, nil
 }
 
 /***********************************/
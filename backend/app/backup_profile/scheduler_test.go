@@ -1,34 +1,68 @@
 package backup_profile
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/loomi-labs/arco/backend/app/types"
 	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
+	backendtypes "github.com/loomi-labs/arco/backend/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 /*
 
-TEST CASES - schedule.go
+TEST CASES - scheduler.go
 
 TestScheduler
-* getNextBackupTime - minute_interval 0min - error
-* getNextBackupTime - minute_interval 60min - from now
-* getNextBackupTime - minute_interval 10min - from now
-* getNextBackupTime - minute_interval 30min - from 2024-01-01 00:00
-* getNextBackupTime daily at 10:15 - from today at 9:00
-* getNextBackupTime daily at 10:15 - from today at 11:00
-* getNextBackupTime daily at 10:30 - from 2024-01-01 00:00
-* getNextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 9:00
-* getNextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 11:00
-* getNextBackupTime monthly at 10:15 on the 5th - from the 5th at 9:00
-* getNextBackupTime monthly at 10:15 on the 5th - from the 5th at 11:00
-* getNextBackupTime monthly at 10:15 on the 1th - from 2024-01-01 00:00
-* getNextBackupTime monthly at 10:15 on the 30th - from 2024-01-01 00:00
-* getNextBackupTime monthly at 10:15 on the 29th - from 2024-02-01 00:00 (february has 29 days)
-* getNextBackupTime monthly at 10:15 on the 30th - from 2024-02-01 00:00 (february has 29 days in 2024)
+* nextBackupTime - minute_interval 0min - error
+* nextBackupTime - minute_interval 60min - from now
+* nextBackupTime - minute_interval 10min - from now
+* nextBackupTime - minute_interval 30min - from 2024-01-01 00:00
+* nextBackupTime daily at 10:15 - from today at 9:00
+* nextBackupTime daily at 10:15 - from today at 11:00
+* nextBackupTime daily at 10:30 - from 2024-01-01 00:00
+* nextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 9:00
+* nextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 11:00
+* nextBackupTime monthly at 10:15 on the 5th - from the 5th at 9:00
+* nextBackupTime monthly at 10:15 on the 5th - from the 5th at 11:00
+* nextBackupTime monthly at 10:15 on the 1th - from 2024-01-01 00:00
+* nextBackupTime monthly at 10:15 on the 30th - from 2024-01-01 00:00
+* nextBackupTime monthly at 10:15 on the 29th - from 2024-02-01 00:00 (february has 29 days)
+* nextBackupTime monthly at 10:15 on the 30th - from 2024-02-01 00:00 (february has 29 days in 2024)
+* nextBackupTime monthly at 10:15 on the 31st - from 2024-04-01 00:00 (april has 30 days)
+* nextBackupTime cron weekdays at 12:00 and 18:00 - from Friday at 18:00
+* nextBackupTime cron on the last day of the month - from 2024-02-01 00:00
+* nextBackupTime cron at 12:00 with blackout period from 11:00 to 13:00 - delayed to 13:00
+* nextBackupTime cron with invalid allowed hours - error
+* nextBackupTime cron with invalid expression - error
+
+TestPlannedBackupTime
+* plannedBackupTime - jitter within the maximum
+* plannedBackupTime - jitter doesn't move the run into a blackout period
+
+TestDurationUntilBackup
+* durationUntilBackup - next run in the future
+* durationUntilBackup - missed run with catch-up time in the future
+* durationUntilBackup - missed run with catch-up time in the past
+* durationUntilBackup - missed run without catch-up time
+
+TestScheduleBackups
+* scheduleBackups - skip missed run
+* scheduleBackups - catch up missed run immediately
+* scheduleBackups - catch up missed run with delayed policy
+* scheduleBackups - keep catch-up time when rescheduling
+* scheduleBackups - don't skip deferred run
+
+TestRunScheduledBackup
+* runScheduledBackup - on battery - backup waits
+* runScheduledBackup - on battery - backup runs after switching to AC power
+* runScheduledBackup - waiting backup is dropped when backups are rescheduled
+* runScheduledBackup - waiting backup doesn't stop the backup of another repository
 
 * delete backup profile
 
@@ -77,124 +111,491 @@ func TestScheduler(t *testing.T) {
 		wantErr  bool
 	}{
 		{
-			name:     "getNextBackupTime - minute_interval 0min - error",
+			name:     "nextBackupTime - minute_interval 0min - error",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMinuteInterval, IntervalMinutes: 0},
 			fromTime: now,
 			wantTime: time.Time{},
 			wantErr:  true,
 		},
 		{
-			name:     "getNextBackupTime - minute_interval 60min - from now",
+			name:     "nextBackupTime - minute_interval 60min - from now",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMinuteInterval, IntervalMinutes: 60},
 			fromTime: now,
 			wantTime: now.Add(time.Hour),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime - minute_interval 10min - from now",
+			name:     "nextBackupTime - minute_interval 10min - from now",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMinuteInterval, IntervalMinutes: 10},
 			fromTime: now,
 			wantTime: now.Add(10 * time.Minute),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime - minute_interval 30min - from 2024-01-01 00:00",
+			name:     "nextBackupTime - minute_interval 30min - from 2024-01-01 00:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMinuteInterval, IntervalMinutes: 30},
 			fromTime: firstOfJanuary2024,
 			wantTime: parseX("2024-01-01 00:30:00"),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime daily at 10:15 - from today at 9:00",
+			name:     "nextBackupTime daily at 10:15 - from today at 9:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: hourMinutePtr(now, 10, 15)},
 			fromTime: hourMinute(now, 9, 0),
 			wantTime: hourMinute(now, 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime daily at 10:15 - from today at 11:00",
+			name:     "nextBackupTime daily at 10:15 - from today at 11:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: hourMinutePtr(now, 10, 15)},
 			fromTime: hourMinute(now, 11, 0),
 			wantTime: hourMinute(now.AddDate(0, 0, 1), 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime daily at 10:30 - from 2024-01-01 00:00",
+			name:     "nextBackupTime daily at 10:30 - from 2024-01-01 00:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeDaily, DailyAt: hourMinutePtr(firstOfJanuary2024, 10, 30)},
 			fromTime: firstOfJanuary2024,
 			wantTime: parseX("2024-01-01 10:30:00"),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 9:00",
+			name:     "nextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 9:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeWeekly, WeeklyAt: hourMinutePtr(now, 10, 15), Weekday: backupschedule.WeekdayWednesday},
 			fromTime: weekdayHourMinute(now, time.Wednesday, 9, 0),
 			wantTime: weekdayHourMinute(now, time.Wednesday, 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 11:00",
+			name:     "nextBackupTime weekly at 10:15 on Wednesday - from Wednesday at 11:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeWeekly, WeeklyAt: hourMinutePtr(now, 10, 15), Weekday: backupschedule.WeekdayWednesday},
 			fromTime: weekdayHourMinute(now, time.Wednesday, 11, 0),
 			wantTime: weekdayHourMinute(now.AddDate(0, 0, 7), time.Wednesday, 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 5th - from the 5th at 9:00",
+			name:     "nextBackupTime monthly at 10:15 on the 5th - from the 5th at 9:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{5}[0]},
 			fromTime: monthdayHourMinute(now, 5, 9, 0),
 			wantTime: monthdayHourMinute(now, 5, 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 5th - from the 5th at 11:00",
+			name:     "nextBackupTime monthly at 10:15 on the 5th - from the 5th at 11:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{5}[0]},
 			fromTime: monthdayHourMinute(now, 5, 11, 0),
 			wantTime: monthdayHourMinute(now.AddDate(0, 1, 0), 5, 10, 15),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 1th - from 2024-01-01 00:00",
+			name:     "nextBackupTime monthly at 10:15 on the 1th - from 2024-01-01 00:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{1}[0]},
 			fromTime: firstOfJanuary2024,
 			wantTime: parseX("2024-01-01 10:15:00"),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 30th - from 2024-01-01 00:00",
+			name:     "nextBackupTime monthly at 10:15 on the 30th - from 2024-01-01 00:00",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{30}[0]},
 			fromTime: firstOfJanuary2024,
 			wantTime: parseX("2024-01-30 10:15:00"),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 29th - from 2024-02-01 00:00 (february has 29 days)",
+			name:     "nextBackupTime monthly at 10:15 on the 29th - from 2024-02-01 00:00 (february has 29 days)",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{29}[0]},
 			fromTime: parseX("2024-02-01 00:00:00"),
 			wantTime: parseX("2024-02-29 10:15:00"),
 			wantErr:  false,
 		},
 		{
-			name:     "getNextBackupTime monthly at 10:15 on the 30th - from 2024-02-01 00:00 (february has 29 days in 2024)",
+			name:     "nextBackupTime monthly at 10:15 on the 30th - from 2024-02-01 00:00 (february has 29 days in 2024)",
 			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{30}[0]},
 			fromTime: parseX("2024-02-01 00:00:00"),
 			wantTime: parseX("2024-02-29 10:15:00"),
 			wantErr:  false,
 		},
+		{
+			name:     "nextBackupTime monthly at 10:15 on the 31st - from 2024-04-01 00:00 (april has 30 days)",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeMonthly, MonthlyAt: hourMinutePtr(now, 10, 15), Monthday: []uint8{31}[0]},
+			fromTime: parseX("2024-04-01 00:00:00"),
+			wantTime: parseX("2024-04-30 10:15:00"),
+			wantErr:  false,
+		},
+		{
+			name:     "nextBackupTime cron weekdays at 12:00 and 18:00 - from Friday at 18:00",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12,18 * * mon-fri"}},
+			fromTime: time.Date(2024, 1, 5, 18, 0, 0, 0, time.Local),
+			wantTime: time.Date(2024, 1, 8, 12, 0, 0, 0, time.Local),
+			wantErr:  false,
+		},
+		{
+			name:     "nextBackupTime cron on the last day of the month - from 2024-02-01 00:00",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 9 L * *"}},
+			fromTime: time.Date(2024, 2, 1, 0, 0, 0, 0, time.Local),
+			wantTime: time.Date(2024, 2, 29, 9, 0, 0, 0, time.Local),
+			wantErr:  false,
+		},
+		{
+			name:     "nextBackupTime cron at 12:00 with blackout period from 11:00 to 13:00 - delayed to 13:00",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12 * * *"}, BlackoutPeriods: []backendtypes.TimeWindow{{Start: "11:00", End: "13:00"}}},
+			fromTime: time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local),
+			wantTime: time.Date(2024, 1, 1, 13, 0, 0, 0, time.Local),
+			wantErr:  false,
+		},
+		{
+			name:     "nextBackupTime cron with invalid allowed hours - error",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12 * * *"}, AllowedHours: []backendtypes.TimeWindow{{Start: "8", End: "18:00"}}},
+			fromTime: now,
+			wantTime: time.Time{},
+			wantErr:  true,
+		},
+		{
+			name:     "nextBackupTime cron with invalid expression - error",
+			schedule: ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12 * *"}},
+			fromTime: now,
+			wantTime: time.Time{},
+			wantErr:  true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			// ACT
-			nextTime, err := getNextBackupTime(&tt.schedule, tt.fromTime)
+			nextTime, err := nextBackupTime(&tt.schedule, tt.fromTime)
 
 			// ASSERT
 			if tt.wantErr {
 				assert.Error(t, err, "Expected error, got nil")
 			} else {
 				assert.NoError(t, err, "Expected no error, got %v", err)
-				assert.Equal(t, tt.wantTime, nextTime, "getNextBackupTime() = %v, want %v", nextTime, tt.wantTime)
+				assert.Equal(t, tt.wantTime, nextTime, "nextBackupTime() = %v, want %v", nextTime, tt.wantTime)
 			}
 		})
 	}
 }
+
+func TestPlannedBackupTime(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	nominal := time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local)
+
+	t.Run("plannedBackupTime - jitter within the maximum", func(t *testing.T) {
+		bs := &ent.BackupSchedule{Mode: backupschedule.ModeCron, CronExpressions: []string{"0 12 * * *"}, JitterMinutes: 30}
+
+		for i := 0; i < 20; i++ {
+			// ACT
+			planned, err := plannedBackupTime(bs, from)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.False(t, planned.Before(nominal), "Planned time %v is before %v", planned, nominal)
+			assert.True(t, planned.Before(nominal.Add(30*time.Minute)), "Planned time %v is too late", planned)
+		}
+	})
+
+	t.Run("plannedBackupTime - jitter doesn't move the run into a blackout period", func(t *testing.T) {
+		bs := &ent.BackupSchedule{
+			Mode:            backupschedule.ModeCron,
+			CronExpressions: []string{"0 12 * * *"},
+			JitterMinutes:   30,
+			BlackoutPeriods: []backendtypes.TimeWindow{{Start: "12:00", End: "14:00"}},
+		}
+
+		for i := 0; i < 20; i++ {
+			// ACT
+			planned, err := plannedBackupTime(bs, from)
+
+			// ASSERT
+			require.NoError(t, err)
+			assert.False(t, planned.Before(nominal.Add(2*time.Hour)), "Planned time %v is in the blackout period", planned)
+			assert.True(t, planned.Before(nominal.Add(2*time.Hour+30*time.Minute)), "Planned time %v is too late", planned)
+		}
+	})
+}
+
+func TestDurationUntilBackup(t *testing.T) {
+	now := parseX("2024-01-01 12:00:00")
+	catchUpAt := parseX("2024-01-01 12:05:00")
+	caughtUpAt := parseX("2024-01-01 11:55:00")
+
+	tests := []struct {
+		name     string
+		schedule ent.BackupSchedule
+		want     time.Duration
+	}{
+		{
+			name:     "durationUntilBackup - next run in the future",
+			schedule: ent.BackupSchedule{NextRun: parseX("2024-01-01 12:30:00"), CatchUpPolicy: backupschedule.CatchUpPolicyDelayed, CatchUpDelayMinutes: 10},
+			want:     30 * time.Minute,
+		},
+		{
+			name:     "durationUntilBackup - missed run with catch-up time in the future",
+			schedule: ent.BackupSchedule{NextRun: parseX("2024-01-01 09:00:00"), CatchUpAt: &catchUpAt, CatchUpPolicy: backupschedule.CatchUpPolicyDelayed, CatchUpDelayMinutes: 10},
+			want:     5 * time.Minute,
+		},
+		{
+			name:     "durationUntilBackup - missed run with catch-up time in the past",
+			schedule: ent.BackupSchedule{NextRun: parseX("2024-01-01 09:00:00"), CatchUpAt: &caughtUpAt, CatchUpPolicy: backupschedule.CatchUpPolicyDelayed, CatchUpDelayMinutes: 10},
+			want:     0,
+		},
+		{
+			name:     "durationUntilBackup - missed run without catch-up time",
+			schedule: ent.BackupSchedule{NextRun: parseX("2024-01-01 09:00:00"), CatchUpPolicy: backupschedule.CatchUpPolicyImmediately, CatchUpDelayMinutes: 10},
+			want:     0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ACT
+			got := durationUntilBackup(&tt.schedule, now)
+
+			// ASSERT
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScheduleBackups(t *testing.T) {
+	var service *Service
+	var db *ent.Client
+	var ctx context.Context
+	var missedRun = time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	setup := func(t *testing.T, policy backupschedule.CatchUpPolicy) {
+		service, db, ctx = newTestBackupProfileService(t)
+
+		p, err := service.NewBackupProfile(ctx)
+		require.NoError(t, err)
+		p.Name = "Test profile"
+		p.Prefix = "test-"
+		p.BackupSchedule.Mode = backupschedule.ModeDaily
+		p.BackupSchedule.CatchUpPolicy = policy
+
+		r, err := db.Repository.Create().
+			SetName("TestRepo").
+			SetURL("/tmp").
+			Save(ctx)
+		require.NoError(t, err)
+
+		profile, err := service.CreateBackupProfile(ctx, *p, []int{r.ID})
+		require.NoError(t, err)
+		require.NoError(t, service.SaveBackupSchedule(ctx, profile.ID, *p.BackupSchedule))
+
+		// Pretend the system was asleep when the backup should have run
+		db.BackupSchedule.
+			Update().
+			Where(backupschedule.HasBackupProfileWith(backupprofile.ID(profile.ID))).
+			SetNextRun(missedRun).
+			ExecX(ctx)
+	}
+
+	t.Run("scheduleBackups - skip missed run", func(t *testing.T) {
+		// ARRANGE
+		setup(t, backupschedule.CatchUpPolicySkip)
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		bs := db.BackupSchedule.Query().OnlyX(ctx)
+		assert.Len(t, timers, 1)
+		assert.True(t, bs.NextRun.After(time.Now()), "Expected next run in the future, got %v", bs.NextRun)
+		assert.Nil(t, bs.LastRun, "A skipped run must not count as a run")
+		require.NotNil(t, bs.LastRunStatus)
+		assert.Equal(t, lastRunStatusSkippedMissed, *bs.LastRunStatus)
+	})
+
+	t.Run("scheduleBackups - catch up missed run immediately", func(t *testing.T) {
+		// ARRANGE
+		setup(t, backupschedule.CatchUpPolicyImmediately)
+		before := time.Now()
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		bs := db.BackupSchedule.Query().OnlyX(ctx)
+		assert.Len(t, timers, 1)
+		assert.True(t, bs.NextRun.Equal(missedRun), "Expected the missed run to be kept, got %v", bs.NextRun)
+		require.NotNil(t, bs.CatchUpAt)
+		assert.False(t, bs.CatchUpAt.Before(before.Truncate(time.Second)), "Expected the catch-up time to be now, got %v", bs.CatchUpAt)
+		assert.Nil(t, bs.LastRunStatus)
+	})
+
+	t.Run("scheduleBackups - catch up missed run with delayed policy", func(t *testing.T) {
+		// ARRANGE
+		setup(t, backupschedule.CatchUpPolicyDelayed)
+		before := time.Now()
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		bs := db.BackupSchedule.Query().OnlyX(ctx)
+		assert.Len(t, timers, 1)
+		assert.True(t, bs.NextRun.Equal(missedRun), "Expected the missed run to be kept, got %v", bs.NextRun)
+		require.NotNil(t, bs.CatchUpAt)
+		delay := time.Duration(bs.CatchUpDelayMinutes) * time.Minute
+		assert.False(t, bs.CatchUpAt.Before(before.Add(delay).Truncate(time.Second)), "Expected the catch-up time after the delay, got %v", bs.CatchUpAt)
+		assert.Nil(t, bs.LastRunStatus)
+	})
+
+	t.Run("scheduleBackups - keep catch-up time when rescheduling", func(t *testing.T) {
+		// ARRANGE
+		setup(t, backupschedule.CatchUpPolicyDelayed)
+		for _, timer := range service.scheduleBackups(ctx) {
+			timer.Stop()
+		}
+		first := db.BackupSchedule.Query().OnlyX(ctx)
+		require.NotNil(t, first.CatchUpAt)
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		bs := db.BackupSchedule.Query().OnlyX(ctx)
+		require.NotNil(t, bs.CatchUpAt)
+		assert.True(t, bs.CatchUpAt.Equal(*first.CatchUpAt), "Expected the catch-up time %v, got %v", first.CatchUpAt, bs.CatchUpAt)
+	})
+
+	t.Run("scheduleBackups - don't skip deferred run", func(t *testing.T) {
+		// ARRANGE
+		setup(t, backupschedule.CatchUpPolicySkip)
+		db.BackupSchedule.Update().SetCatchUpAt(missedRun).ExecX(ctx)
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		bs := db.BackupSchedule.Query().OnlyX(ctx)
+		assert.Len(t, timers, 1)
+		assert.True(t, bs.NextRun.Equal(missedRun), "Expected the deferred run to be kept, got %v", bs.NextRun)
+		assert.Nil(t, bs.LastRunStatus)
+	})
+}
+
+func TestRunScheduledBackup(t *testing.T) {
+	var service *Service
+	var db *ent.Client
+	var ctx context.Context
+	var conditions *fakeConditions
+	var bs *ent.BackupSchedule
+	var backupId types.BackupId
+
+	setup := func(t *testing.T) {
+		service, db, ctx = newTestBackupProfileService(t)
+		conditions = &fakeConditions{onBattery: true}
+		service.conditions = conditions
+		t.Cleanup(service.clearPendingBackups)
+
+		p, err := service.NewBackupProfile(ctx)
+		require.NoError(t, err)
+		p.Name = "Test profile"
+		p.Prefix = "test-"
+		p.BackupSchedule.Mode = backupschedule.ModeDaily
+		p.BackupSchedule.RequireAcPower = true
+
+		r, err := db.Repository.Create().
+			SetName("TestRepo").
+			SetURL("/tmp").
+			Save(ctx)
+		require.NoError(t, err)
+
+		profile, err := service.CreateBackupProfile(ctx, *p, []int{r.ID})
+		require.NoError(t, err)
+		require.NoError(t, service.SaveBackupSchedule(ctx, profile.ID, *p.BackupSchedule))
+
+		bs = db.BackupSchedule.Query().OnlyX(ctx)
+		backupId = types.BackupId{BackupProfileId: profile.ID, RepositoryId: r.ID}
+	}
+
+	t.Run("runScheduledBackup - on battery - backup waits", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+
+		// ACT
+		service.runScheduledBackup(bs.ID, backupId, bs.NextRun)
+
+		// ASSERT
+		updated := db.BackupSchedule.Query().OnlyX(ctx)
+		require.NotNil(t, updated.LastRunStatus)
+		assert.Equal(t, "skipped: on battery", *updated.LastRunStatus)
+		assert.Nil(t, updated.LastRun, "A deferred backup must not count as a run")
+		assert.True(t, updated.NextRun.Equal(bs.NextRun), "Expected the next run to be kept, got %v", updated.NextRun)
+		assert.NotNil(t, updated.CatchUpAt, "Expected the deferred run to get a catch-up time")
+		assert.Contains(t, service.pendingBackups, backupId)
+	})
+
+	t.Run("runScheduledBackup - on battery - backup runs after switching to AC power", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		service.runScheduledBackup(bs.ID, backupId, bs.NextRun)
+		conditions.onBattery = false
+
+		// ACT
+		service.retryPendingBackup(backupId)
+
+		// ASSERT
+		updated := db.BackupSchedule.Query().OnlyX(ctx)
+		require.NotNil(t, updated.LastRunStatus)
+		assert.Equal(t, "started", *updated.LastRunStatus)
+		assert.NotNil(t, updated.LastRun)
+		assert.Nil(t, updated.CatchUpAt)
+		assert.NotContains(t, service.pendingBackups, backupId)
+	})
+
+	t.Run("runScheduledBackup - waiting backup is dropped when backups are rescheduled", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		service.runScheduledBackup(bs.ID, backupId, bs.NextRun)
+
+		// ACT
+		timers := service.scheduleBackups(ctx)
+		for _, timer := range timers {
+			timer.Stop()
+		}
+
+		// ASSERT
+		assert.Len(t, timers, 1)
+		assert.Empty(t, service.pendingBackups)
+	})
+
+	t.Run("runScheduledBackup - waiting backup doesn't stop the backup of another repository", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		r, err := db.Repository.Create().
+			SetName("OtherRepo").
+			SetURL("/tmp/other").
+			AddBackupProfileIDs(backupId.BackupProfileId).
+			Save(ctx)
+		require.NoError(t, err)
+		otherId := types.BackupId{BackupProfileId: backupId.BackupProfileId, RepositoryId: r.ID}
+		service.runScheduledBackup(bs.ID, backupId, bs.NextRun)
+		conditions.onBattery = false
+
+		// ACT
+		service.runScheduledBackup(bs.ID, otherId, bs.NextRun)
+
+		// ASSERT
+		updated := db.BackupSchedule.Query().OnlyX(ctx)
+		require.NotNil(t, updated.LastRunStatus)
+		assert.Equal(t, "started", *updated.LastRunStatus)
+		assert.NotNil(t, updated.LastRun)
+	})
+}
@@ -55,6 +55,11 @@ var errorTypes = []notification.Type{
 	notification.TypeFailedPruningRun,
 	notification.TypeFailedQuickCheck,
 	notification.TypeFailedFullCheck,
+	notification.TypeFailedRestoreRun,
+	notification.TypeFailedExportRun,
+	notification.TypeFailedImportRun,
+	notification.TypeOverdueQuickCheck,
+	notification.TypeOverdueFullCheck,
 }
 
 // GetUnseenErrors returns all unseen error notifications with their related entities
@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/loomi-labs/arco/backend/ent/notification"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+/*
+TEST CASES - service.go
+
+TestGetUnseenErrors
+* Failed backup
+* Failed restore
+* Failed export
+* Failed import
+* Restore warning is not an error
+
+*/
+
+func TestGetUnseenErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		typ       notification.Type
+		wantError bool
+	}{
+		{name: "Failed backup", typ: notification.TypeFailedBackupRun, wantError: true},
+		{name: "Failed restore", typ: notification.TypeFailedRestoreRun, wantError: true},
+		{name: "Failed export", typ: notification.TypeFailedExportRun, wantError: true},
+		{name: "Failed import", typ: notification.TypeFailedImportRun, wantError: true},
+		{name: "Restore warning is not an error", typ: notification.TypeWarningRestoreRun, wantError: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			db := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+			t.Cleanup(func() { db.Close() })
+			ctx := context.Background()
+			s := NewService(zap.NewNop().Sugar())
+			s.Init(db, nil)
+
+			repo := db.Repository.Create().
+				SetName("test-repo").
+				SetURL("/tmp/test-repo").
+				SaveX(ctx)
+			profile := db.BackupProfile.Create().
+				SetName("test-backup").
+				SetPrefix("test-").
+				SetIcon("home").
+				AddRepositoryIDs(repo.ID).
+				SaveX(ctx)
+			created := db.Notification.Create().
+				SetMessage("something went wrong").
+				SetType(tt.typ).
+				SetBackupProfileID(profile.ID).
+				SetRepositoryID(repo.ID).
+				SaveX(ctx)
+
+			// ACT
+			errors, err := s.GetUnseenErrors(ctx)
+
+			// ASSERT
+			require.NoError(t, err)
+			if !tt.wantError {
+				assert.Empty(t, errors)
+				return
+			}
+			require.Len(t, errors, 1)
+			assert.Equal(t, created.ID, errors[0].ID)
+			assert.Equal(t, string(tt.typ), errors[0].Type)
+			assert.Equal(t, repo.Name, errors[0].RepositoryName)
+			assert.Equal(t, profile.Name, errors[0].BackupProfileName)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// ============================================================================
+// ARCHIVE DIRECTORY CACHE
+// ============================================================================
+
+// archiveDirectoryCacheSize is the number of archives whose directory index is kept in memory
+const archiveDirectoryCacheSize = 3
+
+// archiveDirectoryCache keeps the directory index of the most recently browsed archives in memory.
+// Archives are immutable, so paging through a directory or navigating to any other directory
+// of a cached archive does not have to call borg again.
+type archiveDirectoryCache struct {
+	mu      sync.Mutex
+	entries []archiveDirectoryCacheEntry // Most recently used first
+}
+
+type archiveDirectoryCacheEntry struct {
+	archiveID   int
+	archiveName string
+	index       *archiveDirectoryIndex
+}
+
+// get returns the cached index of an archive
+func (c *archiveDirectoryCache) get(archiveID int, archiveName string) (*archiveDirectoryIndex, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, entry := range c.entries {
+		if entry.archiveID == archiveID && entry.archiveName == archiveName {
+			// Move to the front
+			copy(c.entries[1:i+1], c.entries[:i])
+			c.entries[0] = entry
+			return entry.index, true
+		}
+	}
+	return nil, false
+}
+
+// set adds the index of an archive and evicts the least recently used one if the cache is full
+func (c *archiveDirectoryCache) set(archiveID int, archiveName string, index *archiveDirectoryIndex) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries := []archiveDirectoryCacheEntry{{archiveID: archiveID, archiveName: archiveName, index: index}}
+	for _, entry := range c.entries {
+		if entry.archiveID == archiveID {
+			// Replaces the index of the same (possibly renamed) archive
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	c.entries = entries[:min(len(entries), archiveDirectoryCacheSize)]
+}
+
+// ============================================================================
+// DIRECTORY LISTING
+// ============================================================================
+
+// normalizeArchivePath converts a path to the format borg uses inside archives (no leading or trailing slash)
+func normalizeArchivePath(p string) string {
+	p = strings.Trim(p, "/")
+	if p == "" {
+		return ""
+	}
+	return strings.TrimPrefix(path.Clean(p), "/")
+}
+
+// splitArchivePath splits a normalized archive path into its parent directory and its name
+func splitArchivePath(p string) (string, string) {
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return "", p
+	}
+	return p[:i], p[i+1:]
+}
+
+// archiveDirectoryIndex holds the direct children of every directory of an archive.
+// It is built from a single listing of the whole archive, one item at a time.
+// Directories that are not stored in the archive themselves (e.g. "home" for a backup of /home/user)
+// are derived from the paths of their contents. The size of a directory is the size of all its contents.
+type archiveDirectoryIndex struct {
+	children map[string]map[string]*ArchiveDirectoryEntry // Directory path -> child name -> entry
+	sorted   map[string][]ArchiveDirectoryEntry
+}
+
+func newArchiveDirectoryIndex() *archiveDirectoryIndex {
+	return &archiveDirectoryIndex{
+		children: make(map[string]map[string]*ArchiveDirectoryEntry),
+	}
+}
+
+// child returns the entry of name inside dirPath and creates it if needed
+func (d *archiveDirectoryIndex) child(dirPath, name string) *ArchiveDirectoryEntry {
+	children, ok := d.children[dirPath]
+	if !ok {
+		children = make(map[string]*ArchiveDirectoryEntry)
+		d.children[dirPath] = children
+	}
+	entry, ok := children[name]
+	if !ok {
+		entryPath := name
+		if dirPath != "" {
+			entryPath = dirPath + "/" + name
+		}
+		entry = &ArchiveDirectoryEntry{
+			Name: name,
+			Path: entryPath,
+		}
+		children[name] = entry
+	}
+	return entry
+}
+
+// add adds an item to its parent directory and counts it in every directory above it
+func (d *archiveDirectoryIndex) add(item borgtypes.ArchiveItem) {
+	itemPath := normalizeArchivePath(item.Path)
+	if itemPath == "" {
+		return
+	}
+
+	// Item is a child of its parent directory
+	parent, name := splitArchivePath(itemPath)
+	entry := d.child(parent, name)
+	entry.Type = item.Type
+	entry.Mode = item.Mode
+	entry.ModTime = time.Time(item.MTime)
+	entry.LinkTarget = item.LinkTarget
+	if item.IsDir() {
+		entry.IsDir = true
+	} else {
+		entry.Size = int64(item.Size)
+	}
+
+	// Item is located somewhere inside every directory above it
+	for dirPath := parent; dirPath != ""; {
+		grandParent, dirName := splitArchivePath(dirPath)
+		dir := d.child(grandParent, dirName)
+		dir.IsDir = true
+		dir.Size += int64(item.Size)
+		dir.ItemCount++
+		dirPath = grandParent
+	}
+}
+
+// finish sorts the children of every directory; directories are sorted before files, both by name.
+// No items can be added afterwards.
+func (d *archiveDirectoryIndex) finish() {
+	d.sorted = make(map[string][]ArchiveDirectoryEntry, len(d.children))
+	for dirPath, children := range d.children {
+		entries := make([]ArchiveDirectoryEntry, 0, len(children))
+		for _, child := range children {
+			if child.IsDir && child.Type == "" {
+				child.Type = "d"
+			}
+			entries = append(entries, *child)
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].IsDir != entries[j].IsDir {
+				return entries[i].IsDir
+			}
+			return entries[i].Name < entries[j].Name
+		})
+		d.sorted[dirPath] = entries
+	}
+	d.children = nil
+}
+
+// entries returns the direct children of dirPath
+func (d *archiveDirectoryIndex) entries(dirPath string) []ArchiveDirectoryEntry {
+	return d.sorted[dirPath]
+}
+
+// buildDirectoryListing returns the direct children of dirPath
+func buildDirectoryListing(items []borgtypes.ArchiveItem, dirPath string) []ArchiveDirectoryEntry {
+	index := newArchiveDirectoryIndex()
+	for _, item := range items {
+		index.add(item)
+	}
+	index.finish()
+	return index.entries(dirPath)
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/app/keyring"
+	"github.com/loomi-labs/arco/backend/borg/mocks"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestBuildDirectoryListing(t *testing.T) {
+	// ARRANGE
+	items := []borgtypes.ArchiveItem{
+		{Type: "d", Path: "home/user/docs"},
+		{Type: "-", Path: "home/user/docs/b.txt", Size: 20},
+		{Type: "-", Path: "home/user/docs/a.txt", Size: 10},
+		{Type: "d", Path: "home/user/docs/sub"},
+		{Type: "-", Path: "home/user/docs/sub/c.txt", Size: 5},
+		{Type: "l", Path: "home/user/docs/link", LinkTarget: "a.txt"},
+	}
+
+	// ACT
+	root := buildDirectoryListing(items, "")
+	docs := buildDirectoryListing(items, "home/user/docs")
+
+	// ASSERT
+	// "home" is not stored in the archive but derived from its contents
+	assert.Len(t, root, 1)
+	assert.Equal(t, "home", root[0].Name)
+	assert.True(t, root[0].IsDir)
+	assert.Equal(t, "d", root[0].Type)
+	assert.Equal(t, int64(35), root[0].Size)
+	assert.Equal(t, 6, root[0].ItemCount)
+
+	names := make([]string, 0, len(docs))
+	for _, entry := range docs {
+		names = append(names, entry.Name)
+	}
+	assert.Equal(t, []string{"sub", "a.txt", "b.txt", "link"}, names, "directories should be listed before files")
+	assert.Equal(t, "home/user/docs/sub", docs[0].Path)
+	assert.Equal(t, int64(5), docs[0].Size)
+	assert.Equal(t, 1, docs[0].ItemCount)
+	assert.Equal(t, int64(10), docs[1].Size)
+	assert.Equal(t, "a.txt", docs[3].LinkTarget)
+}
+
+func TestArchiveDirectoryCache(t *testing.T) {
+	cache := &archiveDirectoryCache{}
+
+	_, ok := cache.get(1, "archive-1")
+	assert.False(t, ok, "empty cache should not return an index")
+
+	for id := 1; id <= archiveDirectoryCacheSize; id++ {
+		cache.set(id, fmt.Sprintf("archive-%d", id), newArchiveDirectoryIndex())
+	}
+	_, ok = cache.get(1, "archive-1")
+	assert.True(t, ok, "every archive up to the cache size should be kept")
+
+	// Archive 2 is now the least recently used one
+	cache.set(archiveDirectoryCacheSize+1, "new", newArchiveDirectoryIndex())
+	_, ok = cache.get(2, "archive-2")
+	assert.False(t, ok, "least recently used archive should be evicted")
+	_, ok = cache.get(1, "archive-1")
+	assert.True(t, ok)
+
+	_, ok = cache.get(1, "renamed")
+	assert.False(t, ok, "renamed archives must be listed again")
+}
+
+func TestGetArchiveDirectory_ListsArchiveOnce(t *testing.T) {
+	// ARRANGE
+	db := enttest.Open(t, "sqlite3", "file:archivedirectory?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	repo := createTestRepository(t, db, ctx, 1)
+	arch := db.Archive.Create().
+		SetName("archive").
+		SetBorgID("borg-id").
+		SetDuration(1).
+		SetRepositoryID(repo.ID).
+		SaveX(ctx)
+
+	testKeyring := keyring.NewTestService(log)
+	assert.NoError(t, testKeyring.SetRepositoryPassword(repo.ID, "password"))
+
+	ctrl := gomock.NewController(t)
+	mockBorgClient := mocks.NewMockBorg(ctrl)
+	mockBorgClient.EXPECT().StreamFiles(gomock.Any(), repo.URL, "archive", "password", gomock.Nil(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _, _ string, _ []string, ch chan borgtypes.ArchiveItem) *borgtypes.Status {
+			defer close(ch)
+			ch <- borgtypes.ArchiveItem{Type: "d", Path: "home/user"}
+			ch <- borgtypes.ArchiveItem{Type: "-", Path: "home/user/a.txt", Size: 10}
+			ch <- borgtypes.ArchiveItem{Type: "-", Path: "home/user/b.txt", Size: 20}
+			return &borgtypes.Status{}
+		}).
+		Times(1)
+
+	s := &Service{log: log, db: db, borgClient: mockBorgClient, keyring: testKeyring, archiveDirs: &archiveDirectoryCache{}}
+
+	// ACT
+	page1, err1 := s.GetArchiveDirectory(ctx, &ArchiveDirectoryRequest{ArchiveId: arch.ID, Path: "/home/user", Page: 1, PageSize: 1})
+	page2, err2 := s.GetArchiveDirectory(ctx, &ArchiveDirectoryRequest{ArchiveId: arch.ID, Path: "/home/user", Page: 2, PageSize: 1})
+	root, err3 := s.GetArchiveDirectory(ctx, &ArchiveDirectoryRequest{ArchiveId: arch.ID, Path: "/", Page: 1, PageSize: 10})
+
+	// ASSERT
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.NoError(t, err3)
+	assert.Equal(t, 2, page1.Total)
+	assert.Equal(t, "a.txt", page1.Entries[0].Name)
+	assert.Equal(t, "b.txt", page2.Entries[0].Name)
+	assert.Len(t, root.Entries, 1)
+	assert.Equal(t, int64(30), root.Entries[0].Size)
+}
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/loomi-labs/arco/backend/app/types"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+)
+
+const (
+	// archiveStatsBatchSize is the maximum number of archives whose stats are fetched with one borg info call
+	archiveStatsBatchSize = 20
+	// maxArchiveStatsFailures is the number of failed attempts after which the stats of an archive are not fetched anymore
+	maxArchiveStatsFailures = 3
+)
+
+// updateArchiveStats fetches the stats (file count and sizes) of the newest archives of a repository that have no stats yet.
+// The stats of an archive are fetched once since they only change if the deduplicated size shifts after other archives are deleted.
+// A single borg info call fetches the stats of up to archiveStatsBatchSize archives; the remaining archives are handled by the next sync.
+// Returns the number of updated archives.
+func (e *borgOperationExecutor) updateArchiveStats(ctx context.Context, repoID int) (int, error) {
+	repo, err := e.db.Repository.Get(ctx, repoID)
+	if err != nil {
+		return 0, fmt.Errorf("repository %d not found: %w", repoID, err)
+	}
+
+	archives, err := e.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(repoID)),
+			archive.NfilesIsNil(),
+			archive.StatsFailuresLT(maxArchiveStatsFailures),
+		).
+		Order(ent.Desc(archive.FieldCreatedAt)).
+		Limit(archiveStatsBatchSize).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query archives without stats: %w", err)
+	}
+	if len(archives) == 0 {
+		return 0, nil
+	}
+
+	// borg info selects archives by timestamp, so the call covers every archive that is at least as new as the oldest one of the batch
+	last, err := e.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(repoID)),
+			archive.CreatedAtGTE(archives[len(archives)-1].CreatedAt),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count archives: %w", err)
+	}
+
+	// Get password from keyring
+	password, err := e.keyring.GetRepositoryPassword(repo.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get repository password: %w", err)
+	}
+
+	infos, status := e.borgClient.ArchivesInfo(ctx, repo.URL, password, last)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		e.log.Warnw("Failed to get archive stats", "archives", len(archives), "repoID", repoID, "error", status.GetError())
+		return 0, addArchiveStatsFailure(ctx, archives...)
+	}
+
+	stats := make(map[string]borgtypes.ArchiveStats, len(infos))
+	for _, info := range infos {
+		stats[info.Name] = info.Stats
+	}
+
+	updated := 0
+	var missing []*ent.Archive
+	for _, arch := range archives {
+		s, ok := stats[arch.Name]
+		if !ok {
+			missing = append(missing, arch)
+			continue
+		}
+		if err := setArchiveStats(ctx, arch, s); err != nil {
+			return updated, fmt.Errorf("failed to save stats of archive %s: %w", arch.Name, err)
+		}
+		updated++
+	}
+	if len(missing) > 0 {
+		e.log.Warnw("Borg info returned no stats for some archives", "archives", len(missing), "repoID", repoID)
+		if err := addArchiveStatsFailure(ctx, missing...); err != nil {
+			return updated, err
+		}
+	}
+
+	if updated > 0 {
+		e.log.Debugw("Updated archive stats", "archives", updated, "repoID", repoID)
+		e.eventEmitter.EmitEvent(ctx, types.EventArchivesChangedString(repoID))
+	}
+	return updated, nil
+}
+
+// updateNewArchiveStats fetches the stats of a single archive that was just created
+func (e *borgOperationExecutor) updateNewArchiveStats(ctx context.Context, repo *ent.Repository, password, archiveName string) error {
+	arch, err := e.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(repo.ID)),
+			archive.Name(archiveName),
+		).
+		Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get archive %s: %w", archiveName, err)
+	}
+
+	info, status := e.borgClient.ArchiveInfo(ctx, repo.URL, arch.Name, password)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		e.log.Warnw("Failed to get archive stats", "archive", arch.Name, "repoID", repo.ID, "error", status.GetError())
+		return addArchiveStatsFailure(ctx, arch)
+	}
+
+	if err := setArchiveStats(ctx, arch, info.Stats); err != nil {
+		return fmt.Errorf("failed to save stats of archive %s: %w", arch.Name, err)
+	}
+	e.eventEmitter.EmitEvent(ctx, types.EventArchivesChangedString(repo.ID))
+	return nil
+}
+
+// addArchiveStatsFailure records a failed attempt to fetch the stats of the given archives
+func addArchiveStatsFailure(ctx context.Context, archives ...*ent.Archive) error {
+	for _, arch := range archives {
+		if err := arch.Update().AddStatsFailures(1).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record stats failure of archive %s: %w", arch.Name, err)
+		}
+	}
+	return nil
+}
+
+// setArchiveStats stores the stats reported by borg info on an archive
+func setArchiveStats(ctx context.Context, arch *ent.Archive, stats borgtypes.ArchiveStats) error {
+	return arch.Update().
+		SetNfiles(stats.NFiles).
+		SetOriginalSize(stats.OriginalSize).
+		SetCompressedSize(stats.CompressedSize).
+		SetDeduplicatedSize(stats.DeduplicatedSize).
+		Exec(ctx)
+}
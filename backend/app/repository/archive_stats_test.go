@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/keyring"
+	typesmocks "github.com/loomi-labs/arco/backend/app/types/mocks"
+	"github.com/loomi-labs/arco/backend/borg/mocks"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestUpdateArchiveStats(t *testing.T) {
+	// ARRANGE
+	db := enttest.Open(t, "sqlite3", "file:archivestats?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	repo := createTestRepository(t, db, ctx, 1)
+	now := time.Now()
+	withoutStats, err := db.Archive.Create().
+		SetName("new-archive").
+		SetBorgID("borg-id-1").
+		SetDuration(1).
+		SetCreatedAt(now).
+		SetRepositoryID(repo.ID).
+		Save(ctx)
+	assert.NoError(t, err)
+	withStats, err := db.Archive.Create().
+		SetName("old-archive").
+		SetBorgID("borg-id-2").
+		SetDuration(1).
+		SetCreatedAt(now.Add(-time.Hour)).
+		SetRepositoryID(repo.ID).
+		SetNfiles(1).
+		SetOriginalSize(1).
+		SetCompressedSize(1).
+		SetDeduplicatedSize(1).
+		Save(ctx)
+	assert.NoError(t, err)
+	missing, err := db.Archive.Create().
+		SetName("missing-archive").
+		SetBorgID("borg-id-3").
+		SetDuration(1).
+		SetCreatedAt(now.Add(-2 * time.Hour)).
+		SetRepositoryID(repo.ID).
+		Save(ctx)
+	assert.NoError(t, err)
+	_, err = db.Archive.Create().
+		SetName("failed-archive").
+		SetBorgID("borg-id-4").
+		SetDuration(1).
+		SetCreatedAt(now.Add(-3 * time.Hour)).
+		SetRepositoryID(repo.ID).
+		SetStatsFailures(maxArchiveStatsFailures).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	testKeyring := keyring.NewTestService(log)
+	assert.NoError(t, testKeyring.SetRepositoryPassword(repo.ID, "password"))
+
+	ctrl := gomock.NewController(t)
+	mockBorgClient := mocks.NewMockBorg(ctrl)
+	// One call covers the archives up to the oldest archive without stats; the failed archive is not retried
+	mockBorgClient.EXPECT().ArchivesInfo(gomock.Any(), repo.URL, "password", 3).
+		Return([]borgtypes.ArchiveInfo{
+			{
+				Name: "new-archive",
+				Stats: borgtypes.ArchiveStats{
+					NFiles:           42,
+					OriginalSize:     4000,
+					CompressedSize:   3000,
+					DeduplicatedSize: 200,
+				},
+			},
+			{
+				Name:  "old-archive",
+				Stats: borgtypes.ArchiveStats{NFiles: 2, OriginalSize: 2, CompressedSize: 2, DeduplicatedSize: 2},
+			},
+		}, &borgtypes.Status{}).Times(1)
+	mockEmitter := typesmocks.NewMockEventEmitter(ctrl)
+	mockEmitter.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	executor := &borgOperationExecutor{
+		borgClient:   mockBorgClient,
+		db:           db,
+		log:          log,
+		eventEmitter: mockEmitter,
+		keyring:      testKeyring,
+		repoID:       repo.ID,
+	}
+
+	// ACT
+	updated, err := executor.updateArchiveStats(ctx, repo.ID)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, 1, updated)
+
+	arch, err := db.Archive.Get(ctx, withoutStats.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 42, *arch.Nfiles)
+	assert.Equal(t, 4000, *arch.OriginalSize)
+	assert.Equal(t, 3000, *arch.CompressedSize)
+	assert.Equal(t, 200, *arch.DeduplicatedSize)
+
+	arch, err = db.Archive.Get(ctx, withStats.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, *arch.DeduplicatedSize, "existing stats should not be overwritten")
+
+	arch, err = db.Archive.Get(ctx, missing.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, arch.Nfiles)
+	assert.Equal(t, 1, arch.StatsFailures, "archive without stats in the response should count as failed attempt")
+}
@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+)
+
+// maxHookOutputLength is the number of bytes of the hook output that are stored (the end is kept)
+const maxHookOutputLength = 16 * 1024
+
+// Status of the backup passed to post-backup hooks in ARCO_BACKUP_STATUS
+const (
+	hookBackupStatusSuccess  = "success"
+	hookBackupStatusFailure  = "failure"
+	hookBackupStatusCanceled = "canceled"
+)
+
+// hookResult is the outcome of a single hook command
+type hookResult struct {
+	exitCode int // -1 if the command could not be started or was killed
+	output   string
+	timedOut bool
+	err      error // nil if the command exited with code 0
+}
+
+// getBackupHooks returns the hooks of a backup profile in the order in which they are executed
+func (e *borgOperationExecutor) getBackupHooks(ctx context.Context, profileID int) ([]*ent.BackupHook, error) {
+	hooks, err := e.db.BackupHook.Query().
+		Where(backuphook.HasBackupProfileWith(backupprofile.ID(profileID))).
+		Order(ent.Asc(backuphook.FieldPosition), ent.Asc(backuphook.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query backup hooks: %w", err)
+	}
+	return hooks, nil
+}
+
+// runPreBackupHooks runs the pre-backup hooks of a profile.
+// It returns a status if the backup must not be started (a hook with abort_on_failure failed or the backup was canceled).
+func (e *borgOperationExecutor) runPreBackupHooks(ctx context.Context, hooks []*ent.BackupHook, env []string) *borgtypes.Status {
+	for _, hook := range hooks {
+		if hook.Type != backuphook.TypePreBackup {
+			continue
+		}
+		result := e.runBackupHook(ctx, hook, env)
+		if ctx.Err() != nil {
+			return &borgtypes.Status{HasBeenCanceled: true}
+		}
+		if result.err != nil && hook.AbortOnFailure {
+			return &borgtypes.Status{
+				Error: &borgtypes.BorgError{
+					ExitCode: result.exitCode,
+					Message:  fmt.Sprintf("Backup aborted because the pre-backup hook %q failed: %s", hook.Command, result.err),
+					Category: borgtypes.CategoryGeneral,
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// runPostBackupHooks runs the post-backup hooks of a profile that match the result of the backup.
+// They also run if the backup was canceled so that services stopped by a pre-backup hook are restarted.
+func (e *borgOperationExecutor) runPostBackupHooks(ctx context.Context, hooks []*ent.BackupHook, env []string, status *borgtypes.Status) {
+	// Post-backup hooks must run even if the backup was canceled, their own timeout still applies
+	ctx = context.WithoutCancel(ctx)
+	succeeded := status.IsCompletedWithSuccess()
+	for _, hook := range hooks {
+		if hook.Type != backuphook.TypePostBackup || !shouldRunPostBackupHook(hook.RunOn, succeeded) {
+			continue
+		}
+		e.runBackupHook(ctx, hook, env)
+	}
+}
+
+// runBackupHook runs a single hook and stores its result
+func (e *borgOperationExecutor) runBackupHook(ctx context.Context, hook *ent.BackupHook, env []string) hookResult {
+	e.log.Infow("Running backup hook",
+		"hookID", hook.ID,
+		"type", hook.Type,
+		"command", hook.Command)
+
+	startedAt := time.Now()
+	result := runHookCommand(ctx, hook.Command, time.Duration(hook.TimeoutSeconds)*time.Second, env)
+	if result.err != nil {
+		e.log.Warnw("Backup hook failed",
+			"hookID", hook.ID,
+			"type", hook.Type,
+			"command", hook.Command,
+			"exitCode", result.exitCode,
+			"timedOut", result.timedOut,
+			"output", result.output,
+			"error", result.err.Error())
+	} else {
+		e.log.Infow("Backup hook completed",
+			"hookID", hook.ID,
+			"type", hook.Type,
+			"duration", time.Since(startedAt))
+	}
+
+	// Use a context that is not canceled so that the result of a canceled hook is still stored
+	err := e.db.BackupHook.UpdateOneID(hook.ID).
+		SetLastRunAt(startedAt).
+		SetLastExitCode(result.exitCode).
+		SetLastOutput(result.output).
+		Exec(context.WithoutCancel(ctx))
+	if err != nil {
+		e.log.Errorw("Failed to save backup hook result", "hookID", hook.ID, "error", err.Error())
+	}
+	return result
+}
+
+// runHookCommand runs a command with sh -c and returns its exit code and combined output.
+// The whole process group is killed if the timeout is reached or the context is canceled.
+func runHookCommand(ctx context.Context, command string, timeout time.Duration, env []string) hookResult {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Env = append(os.Environ(), env...)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+	}
+	// Don't wait for processes that were started in the background and keep the output open
+	cmd.WaitDelay = time.Second
+
+	out, err := cmd.CombinedOutput()
+	result := hookResult{
+		exitCode: cmd.ProcessState.ExitCode(),
+		output:   truncateHookOutput(string(out)),
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		result.timedOut = true
+		result.err = fmt.Errorf("timed out after %s", timeout)
+	case err != nil:
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && result.exitCode > 0 {
+			result.err = fmt.Errorf("exited with code %d", result.exitCode)
+		} else {
+			result.err = err
+		}
+	}
+	return result
+}
+
+// shouldRunPostBackupHook returns true if a post-backup hook runs for the given backup result
+func shouldRunPostBackupHook(runOn backuphook.RunOn, succeeded bool) bool {
+	switch runOn {
+	case backuphook.RunOnSuccess:
+		return succeeded
+	case backuphook.RunOnFailure:
+		return !succeeded
+	default:
+		return true
+	}
+}
+
+// hookEnv returns the environment variables that describe the backup to the hooks.
+// The repository password is deliberately not part of it.
+func hookEnv(profile *ent.BackupProfile, repo *ent.Repository, archiveName string, status *borgtypes.Status) []string {
+	env := []string{
+		"ARCO_BACKUP_PROFILE=" + profile.Name,
+		"ARCO_BACKUP_PROFILE_ID=" + strconv.Itoa(profile.ID),
+		"ARCO_REPOSITORY=" + repo.Name,
+		"ARCO_REPOSITORY_ID=" + strconv.Itoa(repo.ID),
+		"ARCO_REPOSITORY_URL=" + repo.URL,
+	}
+	if archiveName != "" {
+		env = append(env, "ARCO_ARCHIVE_NAME="+archiveName)
+	}
+	if status != nil {
+		env = append(env, "ARCO_BACKUP_STATUS="+hookBackupStatus(status))
+	}
+	return env
+}
+
+// hookBackupStatus returns the value of ARCO_BACKUP_STATUS for a backup result
+func hookBackupStatus(status *borgtypes.Status) string {
+	switch {
+	case status.HasBeenCanceled:
+		return hookBackupStatusCanceled
+	case status.HasError():
+		return hookBackupStatusFailure
+	default:
+		return hookBackupStatusSuccess
+	}
+}
+
+// truncateHookOutput keeps the end of the output since errors are usually printed last
+func truncateHookOutput(output string) string {
+	if len(output) <= maxHookOutputLength {
+		return output
+	}
+	return "[...]\n" + strings.ToValidUTF8(output[len(output)-maxHookOutputLength:], "")
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRunHookCommand(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("captures output and environment", func(t *testing.T) {
+		result := runHookCommand(ctx, `echo "dumping $ARCO_BACKUP_PROFILE"; echo oops >&2`, time.Minute, []string{"ARCO_BACKUP_PROFILE=home"})
+
+		assert.NoError(t, result.err)
+		assert.Equal(t, 0, result.exitCode)
+		assert.Contains(t, result.output, "dumping home")
+		assert.Contains(t, result.output, "oops")
+	})
+
+	t.Run("reports exit code", func(t *testing.T) {
+		result := runHookCommand(ctx, "exit 3", time.Minute, nil)
+
+		assert.Error(t, result.err)
+		assert.Equal(t, 3, result.exitCode)
+		assert.False(t, result.timedOut)
+	})
+
+	t.Run("kills command after timeout", func(t *testing.T) {
+		startedAt := time.Now()
+		result := runHookCommand(ctx, "sleep 30", 100*time.Millisecond, nil)
+
+		assert.Error(t, result.err)
+		assert.True(t, result.timedOut)
+		assert.Equal(t, -1, result.exitCode)
+		assert.Less(t, time.Since(startedAt), 10*time.Second)
+	})
+}
+
+func TestShouldRunPostBackupHook(t *testing.T) {
+	assert.True(t, shouldRunPostBackupHook(backuphook.RunOnSuccess, true))
+	assert.False(t, shouldRunPostBackupHook(backuphook.RunOnSuccess, false))
+	assert.False(t, shouldRunPostBackupHook(backuphook.RunOnFailure, true))
+	assert.True(t, shouldRunPostBackupHook(backuphook.RunOnFailure, false))
+	assert.True(t, shouldRunPostBackupHook(backuphook.RunOnAlways, true))
+	assert.True(t, shouldRunPostBackupHook(backuphook.RunOnAlways, false))
+}
+
+func TestHookEnv(t *testing.T) {
+	profile := &ent.BackupProfile{ID: 2, Name: "Home"}
+	repo := &ent.Repository{ID: 3, Name: "NAS", URL: "ssh://nas/backups"}
+
+	pre := hookEnv(profile, repo, "", nil)
+	assert.Contains(t, pre, "ARCO_BACKUP_PROFILE=Home")
+	assert.Contains(t, pre, "ARCO_REPOSITORY_URL=ssh://nas/backups")
+	for _, v := range pre {
+		assert.False(t, strings.HasPrefix(v, "ARCO_BACKUP_STATUS="), "pre-backup hooks have no status")
+	}
+
+	post := hookEnv(profile, repo, "home-2024-01-01", &borgtypes.Status{HasBeenCanceled: true})
+	assert.Contains(t, post, "ARCO_ARCHIVE_NAME=home-2024-01-01")
+	assert.Contains(t, post, "ARCO_BACKUP_STATUS=canceled")
+	assert.Equal(t, "failure", hookBackupStatus(&borgtypes.Status{Error: borgtypes.ErrorLockTimeout}))
+	assert.Equal(t, "success", hookBackupStatus(&borgtypes.Status{}))
+}
+
+func TestTruncateHookOutput(t *testing.T) {
+	assert.Equal(t, "short", truncateHookOutput("short"))
+
+	long := strings.Repeat("a", maxHookOutputLength) + "error at the end"
+	truncated := truncateHookOutput(long)
+	assert.True(t, strings.HasSuffix(truncated, "error at the end"))
+	assert.LessOrEqual(t, len(truncated), maxHookOutputLength+len("[...]\n"))
+}
+
+func TestBackupHooks(t *testing.T) {
+	var db *ent.Client
+	var ctx context.Context
+	var executor *borgOperationExecutor
+	var profile *ent.BackupProfile
+
+	setup := func(t *testing.T) {
+		db = enttest.Open(t, "sqlite3", "file:backuphooks?mode=memory&cache=shared&_fk=1")
+		t.Cleanup(func() { db.Close() })
+		ctx = context.Background()
+
+		repo := createTestRepository(t, db, ctx, 1)
+		profile = createTestBackupProfile(t, db, ctx, 1, repo.ID)
+		executor = &borgOperationExecutor{
+			db:     db,
+			log:    zap.NewNop().Sugar(),
+			repoID: repo.ID,
+		}
+	}
+
+	createHook := func(hookType backuphook.Type, command string, position int) *ent.BackupHookCreate {
+		return db.BackupHook.Create().
+			SetType(hookType).
+			SetCommand(command).
+			SetPosition(position).
+			SetBackupProfileID(profile.ID)
+	}
+
+	t.Run("pre-backup hook failure aborts the backup", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		failing := createHook(backuphook.TypePreBackup, "echo dump failed; exit 2", 0).SetAbortOnFailure(true).SaveX(ctx)
+		skipped := createHook(backuphook.TypePreBackup, "echo never", 1).SaveX(ctx)
+		hooks, err := executor.getBackupHooks(ctx, profile.ID)
+		assert.NoError(t, err)
+
+		// ACT
+		status := executor.runPreBackupHooks(ctx, hooks, nil)
+
+		// ASSERT
+		assert.NotNil(t, status)
+		assert.True(t, status.HasError())
+		assert.Equal(t, 2, status.Error.ExitCode)
+
+		failing = db.BackupHook.GetX(ctx, failing.ID)
+		assert.NotNil(t, failing.LastRunAt)
+		assert.Equal(t, 2, *failing.LastExitCode)
+		assert.Equal(t, "dump failed\n", *failing.LastOutput)
+		assert.Nil(t, db.BackupHook.GetX(ctx, skipped.ID).LastRunAt, "hooks after the failed hook must not run")
+	})
+
+	t.Run("pre-backup hook failure without abort continues", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		createHook(backuphook.TypePreBackup, "exit 1", 0).SaveX(ctx)
+		next := createHook(backuphook.TypePreBackup, "true", 1).SaveX(ctx)
+		hooks, err := executor.getBackupHooks(ctx, profile.ID)
+		assert.NoError(t, err)
+
+		// ACT
+		status := executor.runPreBackupHooks(ctx, hooks, nil)
+
+		// ASSERT
+		assert.Nil(t, status)
+		assert.Equal(t, 0, *db.BackupHook.GetX(ctx, next.ID).LastExitCode)
+	})
+
+	t.Run("post-backup hooks run depending on the result", func(t *testing.T) {
+		// ARRANGE
+		setup(t)
+		onSuccess := createHook(backuphook.TypePostBackup, "true", 0).SetRunOn(backuphook.RunOnSuccess).SaveX(ctx)
+		onFailure := createHook(backuphook.TypePostBackup, "true", 1).SetRunOn(backuphook.RunOnFailure).SaveX(ctx)
+		always := createHook(backuphook.TypePostBackup, "true", 2).SaveX(ctx)
+		hooks, err := executor.getBackupHooks(ctx, profile.ID)
+		assert.NoError(t, err)
+
+		// ACT
+		executor.runPostBackupHooks(ctx, hooks, nil, &borgtypes.Status{HasBeenCanceled: true})
+
+		// ASSERT
+		assert.Nil(t, db.BackupHook.GetX(ctx, onSuccess.ID).LastRunAt)
+		assert.NotNil(t, db.BackupHook.GetX(ctx, onFailure.ID).LastRunAt)
+		assert.NotNil(t, db.BackupHook.GetX(ctx, always.ID).LastRunAt)
+	})
+}
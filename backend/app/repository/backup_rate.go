@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"math"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+)
+
+const (
+	// backupRateSmoothing is the weight of the newest sample in the exponential moving average of the throughput
+	backupRateSmoothing = 0.3
+	// backupEstimateArchives is the number of previous archives whose durations are averaged for the ETA
+	backupEstimateArchives = 5
+)
+
+// backupRateTracker calculates the throughput and the remaining time of a running backup
+type backupRateTracker struct {
+	estimate  borgtypes.BackupEstimate
+	startedAt time.Time
+
+	lastUpdate     time.Time
+	lastFiles      int
+	lastBytes      int
+	samples        int
+	filesPerSecond float64
+	bytesPerSecond float64
+}
+
+func newBackupRateTracker(estimate borgtypes.BackupEstimate, startedAt time.Time) *backupRateTracker {
+	return &backupRateTracker{
+		estimate:   estimate,
+		startedAt:  startedAt,
+		lastUpdate: startedAt,
+	}
+}
+
+// update adds a progress sample and sets the throughput and the remaining time of the progress
+func (t *backupRateTracker) update(progress *borgtypes.BackupProgress, now time.Time) {
+	if elapsed := now.Sub(t.lastUpdate).Seconds(); elapsed > 0 {
+		filesPerSecond := float64(progress.ProcessedFiles-t.lastFiles) / elapsed
+		bytesPerSecond := float64(progress.ProcessedBytes-t.lastBytes) / elapsed
+		if t.samples == 0 {
+			t.filesPerSecond = filesPerSecond
+			t.bytesPerSecond = bytesPerSecond
+		} else {
+			t.filesPerSecond = backupRateSmoothing*filesPerSecond + (1-backupRateSmoothing)*t.filesPerSecond
+			t.bytesPerSecond = backupRateSmoothing*bytesPerSecond + (1-backupRateSmoothing)*t.bytesPerSecond
+		}
+		t.samples++
+		t.lastUpdate = now
+		t.lastFiles = progress.ProcessedFiles
+		t.lastBytes = progress.ProcessedBytes
+	}
+
+	progress.FilesPerSecond = max(t.filesPerSecond, 0)
+	progress.BytesPerSecond = max(t.bytesPerSecond, 0)
+	progress.RemainingSeconds = t.remainingSeconds(progress, now)
+}
+
+// remainingSeconds estimates the time until the backup is done.
+// The duration of previous backups is trusted at the start, the current throughput towards the end.
+func (t *backupRateTracker) remainingSeconds(progress *borgtypes.BackupProgress, now time.Time) *int {
+	var fromRate, fraction float64
+	hasRate := false
+	switch {
+	case progress.TotalBytes > 0 && progress.BytesPerSecond > 0:
+		fromRate = float64(progress.TotalBytes-progress.ProcessedBytes) / progress.BytesPerSecond
+		fraction = float64(progress.ProcessedBytes) / float64(progress.TotalBytes)
+		hasRate = true
+	case progress.TotalFiles > 0 && progress.FilesPerSecond > 0:
+		fromRate = float64(progress.TotalFiles-progress.ProcessedFiles) / progress.FilesPerSecond
+		fraction = float64(progress.ProcessedFiles) / float64(progress.TotalFiles)
+		hasRate = true
+	}
+
+	hasHistory := t.estimate.Duration > 0
+	fromHistory := max((t.estimate.Duration - now.Sub(t.startedAt)).Seconds(), 0)
+
+	var remaining float64
+	switch {
+	case hasRate && hasHistory:
+		remaining = (1-fraction)*fromHistory + fraction*fromRate
+	case hasRate:
+		remaining = fromRate
+	case hasHistory:
+		remaining = fromHistory
+	default:
+		return nil
+	}
+
+	seconds := int(math.Round(remaining))
+	return &seconds
+}
+
+// estimateBackup creates the estimate of a backup from previous archives (sorted from newest to oldest)
+func estimateBackup(previous []*ent.Archive) borgtypes.BackupEstimate {
+	var estimate borgtypes.BackupEstimate
+	for _, arch := range previous {
+		if arch.Nfiles != nil {
+			estimate.TotalFiles = *arch.Nfiles
+			if arch.OriginalSize != nil {
+				estimate.TotalBytes = *arch.OriginalSize
+			}
+			break
+		}
+	}
+
+	var total float64
+	var count int
+	for _, arch := range previous {
+		if arch.Duration > 0 {
+			total += arch.Duration
+			count++
+		}
+	}
+	if count > 0 {
+		estimate.Duration = time.Duration(total / float64(count) * float64(time.Second))
+	}
+	return estimate
+}
@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackupRateTracker(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("uses the duration of previous backups before the throughput is known", func(t *testing.T) {
+		// ARRANGE
+		tracker := newBackupRateTracker(borgtypes.BackupEstimate{Duration: 10 * time.Minute}, start)
+		progress := borgtypes.BackupProgress{}
+
+		// ACT
+		tracker.update(&progress, start.Add(2*time.Minute))
+
+		// ASSERT
+		assert.NotNil(t, progress.RemainingSeconds)
+		assert.Equal(t, 8*60, *progress.RemainingSeconds)
+	})
+
+	t.Run("smooths the throughput and estimates the remaining time", func(t *testing.T) {
+		// ARRANGE
+		tracker := newBackupRateTracker(borgtypes.BackupEstimate{}, start)
+		progress := borgtypes.BackupProgress{TotalBytes: 1000, ProcessedBytes: 100, TotalFiles: 100, ProcessedFiles: 10}
+
+		// ACT
+		tracker.update(&progress, start.Add(10*time.Second))
+		first := progress.BytesPerSecond
+		progress.ProcessedBytes = 400
+		tracker.update(&progress, start.Add(20*time.Second))
+
+		// ASSERT
+		assert.Equal(t, 10.0, first)
+		// 0.3 * 30 B/s + 0.7 * 10 B/s
+		assert.InDelta(t, 16.0, progress.BytesPerSecond, 0.001)
+		assert.NotNil(t, progress.RemainingSeconds)
+		assert.Equal(t, 38, *progress.RemainingSeconds) // 600 B / 16 B/s
+	})
+
+	t.Run("unknown without throughput and history", func(t *testing.T) {
+		tracker := newBackupRateTracker(borgtypes.BackupEstimate{}, start)
+		progress := borgtypes.BackupProgress{ProcessedFiles: 10}
+
+		tracker.update(&progress, start.Add(time.Second))
+
+		assert.Nil(t, progress.RemainingSeconds)
+	})
+}
+
+func TestEstimateBackup(t *testing.T) {
+	nfiles, size := 100, 5000
+	previous := []*ent.Archive{
+		{Duration: 60},
+		{Duration: 120, Nfiles: &nfiles, OriginalSize: &size},
+		{Duration: 0},
+	}
+
+	estimate := estimateBackup(previous)
+
+	assert.Equal(t, 100, estimate.TotalFiles)
+	assert.Equal(t, 5000, estimate.TotalBytes)
+	assert.Equal(t, 90*time.Second, estimate.Duration)
+}
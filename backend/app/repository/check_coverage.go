@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+)
+
+// dataCheckResult is the outcome of a full check (--verify-data)
+type dataCheckResult struct {
+	result         *borgtypes.CheckResult
+	verifiedIds    []int      // Archives whose data was verified without errors
+	cycleStartedAt *time.Time // New start of the verification cycle, nil if unchanged
+}
+
+// runDataCheck verifies the data of the archives selected by the check operation.
+// Rolling checks run borg once per archive so that each archive is only marked as verified if its own check succeeded.
+func (e *borgOperationExecutor) runDataCheck(ctx context.Context, repo *ent.Repository, password string, checkData statemachine.Check) (*dataCheckResult, error) {
+	startedAt := time.Now()
+
+	archives, err := e.db.Archive.Query().
+		Where(archive.HasRepositoryWith(repository.ID(repo.ID))).
+		Order(ent.Asc(archive.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archives: %w", err)
+	}
+
+	switch {
+	case checkData.RollingArchives > 0:
+		selected, cycleStartedAt := selectRollingCheckArchives(archives, repo.CheckCycleStartedAt, checkData.RollingArchives, startedAt)
+		e.log.Infow("Verifying next archives of the verification cycle", "repoID", repo.ID, "archives", len(selected), "cycleStartedAt", cycleStartedAt)
+
+		checkResult := &dataCheckResult{
+			result:         &borgtypes.CheckResult{Status: &borgtypes.Status{}},
+			cycleStartedAt: &cycleStartedAt,
+		}
+		for _, arch := range selected {
+			filter := &borgtypes.CheckArchiveFilter{GlobArchives: escapeArchiveGlob(arch.Name)}
+			result := e.borgClient.Check(ctx, repo.URL, password, false, filter)
+			checkResult.result.Status = result.Status
+			checkResult.result.ErrorLogs = append(checkResult.result.ErrorLogs, result.ErrorLogs...)
+			if !result.Status.IsCompletedWithSuccess() {
+				// Canceled or borg failed, the remaining archives are verified by the next run
+				break
+			}
+			if len(result.ErrorLogs) == 0 {
+				checkResult.verifiedIds = append(checkResult.verifiedIds, arch.ID)
+			}
+		}
+		return checkResult, nil
+
+	case checkData.GlobArchives != "" || checkData.LastArchives > 0:
+		filter := &borgtypes.CheckArchiveFilter{GlobArchives: checkData.GlobArchives, Last: checkData.LastArchives}
+		checkResult := &dataCheckResult{result: e.borgClient.Check(ctx, repo.URL, password, false, filter)}
+		if repo.CheckCycleStartedAt == nil {
+			checkResult.cycleStartedAt = &startedAt
+		}
+		if checkResult.result.Status.IsCompletedWithSuccess() && len(checkResult.result.ErrorLogs) == 0 {
+			for _, arch := range filterCheckArchives(archives, filter) {
+				checkResult.verifiedIds = append(checkResult.verifiedIds, arch.ID)
+			}
+		}
+		return checkResult, nil
+
+	default:
+		// Verifying all archives completes a cycle
+		checkResult := &dataCheckResult{
+			result:         e.borgClient.Check(ctx, repo.URL, password, false, nil),
+			cycleStartedAt: &startedAt,
+		}
+		if checkResult.result.Status.IsCompletedWithSuccess() && len(checkResult.result.ErrorLogs) == 0 {
+			for _, arch := range archives {
+				checkResult.verifiedIds = append(checkResult.verifiedIds, arch.ID)
+			}
+		}
+		return checkResult, nil
+	}
+}
+
+// selectRollingCheckArchives returns the next archives to verify in the current verification cycle.
+// Archives that have not been verified since the cycle started are pending, the least recently verified first.
+// A new cycle is started (and returned) when no archive is pending.
+// Archives must be sorted from oldest to newest.
+func selectRollingCheckArchives(archives []*ent.Archive, cycleStartedAt *time.Time, count int, now time.Time) ([]*ent.Archive, time.Time) {
+	var pending []*ent.Archive
+	if cycleStartedAt != nil {
+		for _, arch := range archives {
+			if !isVerifiedInCycle(arch, *cycleStartedAt) {
+				pending = append(pending, arch)
+			}
+		}
+	}
+
+	start := now
+	if len(pending) > 0 {
+		start = *cycleStartedAt
+	} else {
+		pending = append(pending, archives...)
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		a, b := pending[i].DataVerifiedAt, pending[j].DataVerifiedAt
+		if a == nil || b == nil {
+			return a == nil && b != nil
+		}
+		return a.Before(*b)
+	})
+
+	if len(pending) > count {
+		pending = pending[:count]
+	}
+	return pending, start
+}
+
+// filterCheckArchives returns the archives that borg check selects with the given filter.
+// Archives must be sorted from oldest to newest.
+func filterCheckArchives(archives []*ent.Archive, filter *borgtypes.CheckArchiveFilter) []*ent.Archive {
+	var matching []*ent.Archive
+	for _, arch := range archives {
+		if filter.GlobArchives != "" {
+			if ok, err := path.Match(filter.GlobArchives, arch.Name); err != nil || !ok {
+				continue
+			}
+		}
+		matching = append(matching, arch)
+	}
+	if filter.Last > 0 && len(matching) > filter.Last {
+		matching = matching[len(matching)-filter.Last:]
+	}
+	return matching
+}
+
+// escapeArchiveGlob returns a pattern for --glob-archives that only matches the given archive name
+func escapeArchiveGlob(name string) string {
+	var sb strings.Builder
+	for _, r := range name {
+		switch r {
+		case '*', '?', '[':
+			sb.WriteRune('[')
+			sb.WriteRune(r)
+			sb.WriteRune(']')
+		default:
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// isVerifiedInCycle returns true if the data of the archive was verified since the cycle started
+func isVerifiedInCycle(arch *ent.Archive, cycleStartedAt time.Time) bool {
+	return arch.DataVerifiedAt != nil && !arch.DataVerifiedAt.Before(cycleStartedAt)
+}
+
+// getVerificationCoverage returns how many archives have been verified in the current verification cycle
+func getVerificationCoverage(archives []*ent.Archive, cycleStartedAt *time.Time) VerificationCoverage {
+	coverage := VerificationCoverage{
+		TotalArchives:  len(archives),
+		CycleStartedAt: cycleStartedAt,
+	}
+	if cycleStartedAt == nil {
+		return coverage
+	}
+	for _, arch := range archives {
+		if isVerifiedInCycle(arch, *cycleStartedAt) {
+			coverage.VerifiedArchives++
+		}
+	}
+	return coverage
+}
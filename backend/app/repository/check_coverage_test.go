@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectRollingCheckArchives(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	cycleStart := now.Add(-7 * 24 * time.Hour)
+	beforeCycle := cycleStart.Add(-time.Hour)
+	inCycle := cycleStart.Add(time.Hour)
+
+	t.Run("continues the current cycle with the least recently verified archives", func(t *testing.T) {
+		// ARRANGE
+		archives := []*ent.Archive{
+			{ID: 1, DataVerifiedAt: &inCycle},
+			{ID: 2, DataVerifiedAt: &beforeCycle},
+			{ID: 3},
+			{ID: 4, DataVerifiedAt: &beforeCycle},
+		}
+
+		// ACT
+		selected, start := selectRollingCheckArchives(archives, &cycleStart, 2, now)
+
+		// ASSERT
+		assert.Equal(t, cycleStart, start)
+		assert.Len(t, selected, 2)
+		assert.Equal(t, 3, selected[0].ID, "never verified archives come first")
+		assert.Equal(t, 2, selected[1].ID)
+	})
+
+	t.Run("starts a new cycle when every archive is verified", func(t *testing.T) {
+		// ARRANGE
+		archives := []*ent.Archive{
+			{ID: 1, DataVerifiedAt: &inCycle},
+			{ID: 2, DataVerifiedAt: &now},
+		}
+
+		// ACT
+		selected, start := selectRollingCheckArchives(archives, &cycleStart, 1, now)
+
+		// ASSERT
+		assert.Equal(t, now, start)
+		assert.Len(t, selected, 1)
+		assert.Equal(t, 1, selected[0].ID)
+	})
+
+	t.Run("starts a new cycle for repositories without a cycle", func(t *testing.T) {
+		archives := []*ent.Archive{{ID: 1}, {ID: 2}}
+
+		selected, start := selectRollingCheckArchives(archives, nil, 5, now)
+
+		assert.Equal(t, now, start)
+		assert.Len(t, selected, 2)
+	})
+}
+
+func TestFilterCheckArchives(t *testing.T) {
+	archives := []*ent.Archive{
+		{ID: 1, Name: "docs-2024-01-01"},
+		{ID: 2, Name: "photos-2024-01-02"},
+		{ID: 3, Name: "docs-2024-01-03"},
+		{ID: 4, Name: "docs-2024-01-04"},
+	}
+
+	matching := filterCheckArchives(archives, &borgtypes.CheckArchiveFilter{GlobArchives: "docs-*", Last: 2})
+
+	assert.Len(t, matching, 2)
+	assert.Equal(t, 3, matching[0].ID)
+	assert.Equal(t, 4, matching[1].ID)
+}
+
+func TestEscapeArchiveGlob(t *testing.T) {
+	assert.Equal(t, "backup-2024", escapeArchiveGlob("backup-2024"))
+	assert.Equal(t, "a[*]b[?]c[[]d]", escapeArchiveGlob("a*b?c[d]"))
+	assert.Len(t, filterCheckArchives([]*ent.Archive{{Name: "a*b"}, {Name: "axb"}}, &borgtypes.CheckArchiveFilter{GlobArchives: escapeArchiveGlob("a*b")}), 1)
+}
+
+func TestGetVerificationCoverage(t *testing.T) {
+	cycleStart := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := cycleStart.Add(-time.Hour)
+	after := cycleStart.Add(time.Hour)
+	archives := []*ent.Archive{{DataVerifiedAt: &after}, {DataVerifiedAt: &before}, {}}
+
+	coverage := getVerificationCoverage(archives, &cycleStart)
+	assert.Equal(t, 1, coverage.VerifiedArchives)
+	assert.Equal(t, 3, coverage.TotalArchives)
+
+	assert.Equal(t, 0, getVerificationCoverage(archives, nil).VerifiedArchives)
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	"github.com/loomi-labs/arco/backend/app/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+	"github.com/loomi-labs/arco/backend/ent/notification"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+)
+
+// checkOverdueGracePeriod is how long a scheduled check may be late before it is reported as overdue
+// (e.g. because the app was not running or the queue was busy with other heavy operations)
+const checkOverdueGracePeriod = 24 * time.Hour
+
+// StartCheckScheduleChangeListener schedules the integrity checks of all repositories
+// and reschedules them whenever a check schedule changes
+func (si *ServiceInternal) StartCheckScheduleChangeListener(ctx context.Context) {
+	si.log.Debug("Starting check schedule change listener")
+	var timers []*time.Timer
+
+	// Clean up timers when function exits
+	defer func() {
+		for _, t := range timers {
+			t.Stop()
+		}
+	}()
+
+	// Check if channel is nil (used in tests)
+	if si.checkScheduleChangedCh == nil {
+		si.log.Debug("Check schedule change channel is nil, exiting listener")
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			si.log.Debug("Check schedule change listener stopped due to context cancellation")
+			return
+		case <-si.checkScheduleChangedCh:
+			// Stop all scheduled checks
+			for _, t := range timers {
+				t.Stop()
+			}
+
+			// Schedule all checks
+			timers = si.scheduleChecks(ctx)
+		}
+	}
+}
+
+// notifyCheckScheduleChanged triggers a reschedule of all checks
+func (s *Service) notifyCheckScheduleChanged() {
+	if s.checkScheduleChangedCh == nil {
+		return
+	}
+	s.checkScheduleChangedCh <- struct{}{}
+}
+
+func (si *ServiceInternal) scheduleChecks(ctx context.Context) []*time.Timer {
+	si.log.Info("Scheduling checks")
+
+	// Timers of earlier runs that already fired reschedule themselves; they stop when the generation changed
+	generation := si.checkScheduleGeneration.Add(1)
+
+	repos, err := si.db.Repository.Query().
+		Where(repository.Or(
+			repository.QuickCheckIntervalDaysGT(0),
+			repository.FullCheckIntervalWeeksGT(0),
+		)).
+		All(ctx)
+	if err != nil {
+		si.log.Errorf("Failed to get check schedules: %s", err)
+		return nil
+	}
+
+	var timers []*time.Timer
+	for _, repo := range repos {
+		for _, quick := range []bool{true, false} {
+			interval := checkInterval(repo, quick)
+			if interval == 0 {
+				continue
+			}
+			dueAt := nextCheckTime(lastCheckAt(repo, quick), interval, time.Now())
+			timers = append(timers, si.scheduleCheck(ctx, repo.ID, quick, interval, dueAt, generation))
+		}
+	}
+	return timers
+}
+
+func (si *ServiceInternal) scheduleCheck(ctx context.Context, repoID int, quick bool, interval time.Duration, dueAt time.Time, generation int64) *time.Timer {
+	// Calculate the duration until the next check
+	durationUntilNextCheck := time.Until(dueAt)
+	if durationUntilNextCheck < 0 {
+		// If the duration is negative, schedule the check immediately
+		durationUntilNextCheck = 0
+	}
+
+	timer := time.AfterFunc(durationUntilNextCheck, func() {
+		si.runScheduledCheck(ctx, repoID, quick, interval, dueAt, generation)
+	})
+	si.log.Infof("Scheduled %s check for repo %d in %s", checkTypeName(quick), repoID, durationUntilNextCheck)
+	return timer
+}
+
+func (si *ServiceInternal) runScheduledCheck(ctx context.Context, repoID int, quick bool, interval time.Duration, dueAt time.Time, generation int64) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	// Check if the schedule has been modified in the meantime
+	if si.checkScheduleGeneration.Load() != generation {
+		si.log.Infof("Check schedule of repo %d has been modified, skipping", repoID)
+		return
+	}
+
+	repo, err := si.db.Repository.Get(ctx, repoID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			si.log.Infof("Repository %d does not exist anymore, skipping scheduled check", repoID)
+		} else {
+			si.log.Errorf("Failed to get repository %d for scheduled check: %s", repoID, err)
+		}
+		return
+	}
+
+	// A check might have been run manually since the timer was set
+	now := time.Now()
+	if actualDueAt := nextCheckTime(lastCheckAt(repo, quick), interval, now); actualDueAt.After(now) {
+		si.scheduleCheck(ctx, repoID, quick, interval, actualDueAt, generation)
+		return
+	}
+
+	if isCheckOverdue(dueAt, now) {
+		si.createCheckOverdueNotification(ctx, repo, quick, dueAt)
+	}
+
+	// Run the check (the queue manager makes sure it does not run in parallel with other heavy operations)
+	si.log.Infof("Running scheduled %s check for repo %d", checkTypeName(quick), repoID)
+	if quick || repo.RollingCheckArchives == 0 {
+		_, err = si.QueueCheck(ctx, repoID, quick)
+	} else {
+		_, err = si.queueCheckOperation(repoID, statemachine.Check{
+			RepositoryID:    repoID,
+			RollingArchives: repo.RollingCheckArchives,
+		})
+	}
+	if err != nil {
+		si.log.Errorf("Failed to run scheduled %s check for repo %d: %s", checkTypeName(quick), repoID, err)
+	}
+
+	// The check is not done yet, so the next run is based on the time it was started
+	si.scheduleCheck(ctx, repoID, quick, interval, now.Add(interval), generation)
+}
+
+// createCheckOverdueNotification creates a notification for a scheduled check that did not run in time
+func (si *ServiceInternal) createCheckOverdueNotification(ctx context.Context, repo *ent.Repository, quick bool, dueAt time.Time) {
+	// Notifications belong to a backup profile, use the first one of the repository
+	backupProfileID, err := si.db.BackupProfile.Query().
+		Where(backupprofile.HasRepositoriesWith(repository.ID(repo.ID))).
+		FirstID(ctx)
+	if err != nil {
+		si.log.Warnw("Scheduled check is overdue but no notification could be created",
+			"repoID", repo.ID,
+			"checkType", checkTypeName(quick),
+			"dueAt", dueAt,
+			"error", err.Error())
+		return
+	}
+
+	notificationType := notification.TypeOverdueFullCheck
+	if quick {
+		notificationType = notification.TypeOverdueQuickCheck
+	}
+	message := fmt.Sprintf("The scheduled %s check of %s was due on %s", checkTypeName(quick), repo.Name, dueAt.Format("2006-01-02 15:04"))
+
+	_, err = si.db.Notification.Create().
+		SetMessage(message).
+		SetType(notificationType).
+		SetRepositoryID(repo.ID).
+		SetBackupProfileID(backupProfileID).
+		Save(ctx)
+	if err != nil {
+		si.log.Errorw("Failed to create overdue check notification", "repoID", repo.ID, "error", err.Error())
+		return
+	}
+	si.eventEmitter.EmitEvent(ctx, types.EventNotificationCreatedString())
+}
+
+// checkInterval returns the interval between scheduled checks of a repository, 0 if scheduled checks are disabled
+func checkInterval(repo *ent.Repository, quick bool) time.Duration {
+	if quick {
+		return time.Duration(repo.QuickCheckIntervalDays) * 24 * time.Hour
+	}
+	return time.Duration(repo.FullCheckIntervalWeeks) * 7 * 24 * time.Hour
+}
+
+// lastCheckAt returns the time of the last check of a repository
+func lastCheckAt(repo *ent.Repository, quick bool) *time.Time {
+	if quick {
+		return repo.LastQuickCheckAt
+	}
+	return repo.LastFullCheckAt
+}
+
+// nextCheckTime calculates when the next scheduled check is due.
+// Repositories that have never been checked are due immediately.
+func nextCheckTime(lastCheckAt *time.Time, interval time.Duration, now time.Time) time.Time {
+	if lastCheckAt == nil {
+		return now
+	}
+	return lastCheckAt.Add(interval)
+}
+
+// isCheckOverdue returns true if a check that was due at dueAt is late by more than the grace period
+func isCheckOverdue(dueAt time.Time, now time.Time) bool {
+	return now.Sub(dueAt) > checkOverdueGracePeriod
+}
+
+func checkTypeName(quick bool) string {
+	if quick {
+		return "quick"
+	}
+	return "full"
+}
+
+// getCheckSchedule returns the check schedule of a repository
+func getCheckSchedule(repo *ent.Repository) CheckSchedule {
+	schedule := CheckSchedule{
+		QuickCheckIntervalDays: repo.QuickCheckIntervalDays,
+		FullCheckIntervalWeeks: repo.FullCheckIntervalWeeks,
+		RollingCheckArchives:   repo.RollingCheckArchives,
+	}
+	now := time.Now()
+	if interval := checkInterval(repo, true); interval > 0 {
+		next := nextCheckTime(repo.LastQuickCheckAt, interval, now)
+		schedule.NextQuickCheckAt = &next
+	}
+	if interval := checkInterval(repo, false); interval > 0 {
+		next := nextCheckTime(repo.LastFullCheckAt, interval, now)
+		schedule.NextFullCheckAt = &next
+	}
+	return schedule
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextCheckTime(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	lastCheck := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("never checked is due now", func(t *testing.T) {
+		assert.Equal(t, now, nextCheckTime(nil, 7*24*time.Hour, now))
+	})
+
+	t.Run("due one interval after last check", func(t *testing.T) {
+		assert.Equal(t, lastCheck.Add(14*24*time.Hour), nextCheckTime(&lastCheck, 14*24*time.Hour, now))
+	})
+}
+
+func TestIsCheckOverdue(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	assert.False(t, isCheckOverdue(now.Add(time.Hour), now), "future check is not overdue")
+	assert.False(t, isCheckOverdue(now.Add(-time.Hour), now), "check within the grace period is not overdue")
+	assert.True(t, isCheckOverdue(now.Add(-checkOverdueGracePeriod-time.Minute), now))
+}
+
+func TestGetCheckSchedule(t *testing.T) {
+	// ARRANGE
+	lastQuickCheck := time.Now().Add(-24 * time.Hour)
+	repo := &ent.Repository{
+		QuickCheckIntervalDays: 3,
+		FullCheckIntervalWeeks: 0,
+		LastQuickCheckAt:       &lastQuickCheck,
+	}
+
+	// ACT
+	schedule := getCheckSchedule(repo)
+
+	// ASSERT
+	assert.Equal(t, 3, schedule.QuickCheckIntervalDays)
+	assert.NotNil(t, schedule.NextQuickCheckAt)
+	assert.Equal(t, lastQuickCheck.Add(3*24*time.Hour), *schedule.NextQuickCheckAt)
+	assert.Nil(t, schedule.NextFullCheckAt, "disabled full checks have no next run")
+	assert.Equal(t, time.Duration(0), checkInterval(repo, false))
+}
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+	"github.com/loomi-labs/arco/backend/ent/backupschedule"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/platform"
+)
+
+// driveIdlePollInterval is how often to check if the backups that were started by connecting a drive have finished
+const driveIdlePollInterval = 10 * time.Second
+
+// StartDriveListener queues the backups of the repositories on a drive whenever the drive is connected
+func (si *ServiceInternal) StartDriveListener(ctx context.Context) {
+	mounted, err := si.drives.WatchMounts(ctx)
+	if err != nil {
+		si.log.Infof("Not watching for connected drives: %s", err)
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			si.log.Debug("Drive listener stopped due to context cancellation")
+			return
+		case volume, ok := <-mounted:
+			if !ok {
+				return
+			}
+			go si.runDriveBackups(ctx, volume)
+		}
+	}
+}
+
+// runDriveBackups queues the backups for the connected drive and unmounts or ejects it after they have finished
+func (si *ServiceInternal) runDriveBackups(ctx context.Context, volume platform.MountedVolume) {
+	repoIDs, action := si.queueDriveBackups(ctx, volume)
+	if len(repoIDs) == 0 || action == backupschedule.DriveActionAfterBackupKeep {
+		return
+	}
+
+	if !si.waitForIdleRepositories(ctx, repoIDs) {
+		return
+	}
+
+	var err error
+	switch action {
+	case backupschedule.DriveActionAfterBackupUnmount:
+		si.log.Infof("Backups to %s have finished, unmounting the drive", volume.MountPoint)
+		err = si.drives.Unmount(ctx, volume)
+	case backupschedule.DriveActionAfterBackupEject:
+		si.log.Infof("Backups to %s have finished, ejecting the drive", volume.MountPoint)
+		err = si.drives.Eject(ctx, volume)
+	case backupschedule.DriveActionAfterBackupKeep:
+	}
+	if err != nil {
+		si.log.Errorf("Failed to %s drive: %s", action, err)
+	}
+}
+
+// queueDriveBackups queues a backup for every profile that runs when the drive of its repository is connected.
+// It returns the repositories with queued backups and what to do with the drive afterwards.
+func (si *ServiceInternal) queueDriveBackups(ctx context.Context, volume platform.MountedVolume) ([]int, backupschedule.DriveActionAfterBackup) {
+	action := backupschedule.DriveActionAfterBackupKeep
+	if volume.IsEmpty() {
+		return nil, action
+	}
+
+	repos, err := si.db.Repository.Query().
+		Where(repository.Or(
+			repository.VolumeUUIDNEQ(""),
+			repository.VolumeLabelNEQ(""),
+		)).
+		WithBackupProfiles(func(q *ent.BackupProfileQuery) {
+			q.Where(backupprofile.HasBackupScheduleWith(backupschedule.RunOnDriveConnect(true)))
+			q.WithBackupSchedule()
+		}).
+		All(ctx)
+	if err != nil {
+		si.log.Errorf("Failed to get repositories for connected drive: %s", err)
+		return nil, action
+	}
+
+	var repoIDs []int
+	for _, repo := range repos {
+		repoVolume := platform.Volume{UUID: repo.VolumeUUID, Label: repo.VolumeLabel}
+		if !repoVolume.Matches(volume.Volume) || len(repo.Edges.BackupProfiles) == 0 {
+			continue
+		}
+
+		si.log.Infof("Drive of repository %d has been connected at %s", repo.ID, volume.MountPoint)
+		queued := false
+		for _, profile := range repo.Edges.BackupProfiles {
+			backupId := types.BackupId{BackupProfileId: profile.ID, RepositoryId: repo.ID}
+			if _, err := si.QueueScheduledBackup(ctx, backupId); err != nil {
+				si.log.Errorf("Failed to queue backup %s for connected drive: %s", backupId, err)
+				continue
+			}
+			queued = true
+			action = strongerDriveAction(action, profile.Edges.BackupSchedule.DriveActionAfterBackup)
+		}
+		if queued {
+			repoIDs = append(repoIDs, repo.ID)
+		}
+	}
+	return repoIDs, action
+}
+
+// strongerDriveAction returns the action that removes the drive further, so that a drive is ejected
+// if any of the profiles backing up to it asks for it
+func strongerDriveAction(a, b backupschedule.DriveActionAfterBackup) backupschedule.DriveActionAfterBackup {
+	rank := map[backupschedule.DriveActionAfterBackup]int{
+		backupschedule.DriveActionAfterBackupKeep:    0,
+		backupschedule.DriveActionAfterBackupUnmount: 1,
+		backupschedule.DriveActionAfterBackupEject:   2,
+	}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+// waitForIdleRepositories waits until no operation of the repositories is queued or running.
+// Backups that are held by the start window of their schedule can wait for hours, so they are not waited for.
+// It returns false if the context is cancelled before.
+func (si *ServiceInternal) waitForIdleRepositories(ctx context.Context, repoIDs []int) bool {
+	ticker := time.NewTicker(driveIdlePollInterval)
+	defer ticker.Stop()
+
+	for {
+		idle := true
+		for _, repoID := range repoIDs {
+			if si.queueManager.GetQueue(repoID).HasUnheldOperations() || si.queueManager.GetActiveOperation(repoID, nil) != nil {
+				idle = false
+				break
+			}
+		}
+		if idle {
+			for _, repoID := range repoIDs {
+				if si.queueManager.HasQueuedOperations(repoID) {
+					si.log.Infof("Not waiting for the held operations of repository %d", repoID)
+				}
+			}
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	"github.com/loomi-labs/arco/backend/app/types"
+	"github.com/loomi-labs/arco/backend/ent/backupschedule"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrongerDriveAction(t *testing.T) {
+	keep := backupschedule.DriveActionAfterBackupKeep
+	unmount := backupschedule.DriveActionAfterBackupUnmount
+	eject := backupschedule.DriveActionAfterBackupEject
+
+	assert.Equal(t, keep, strongerDriveAction(keep, keep))
+	assert.Equal(t, unmount, strongerDriveAction(keep, unmount))
+	assert.Equal(t, unmount, strongerDriveAction(unmount, keep), "keeping the drive does not override unmounting it")
+	assert.Equal(t, eject, strongerDriveAction(unmount, eject))
+	assert.Equal(t, eject, strongerDriveAction(eject, unmount), "unmounting does not override ejecting")
+}
+
+func TestWaitForIdleRepositories_HeldBackupCountsAsIdle(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+	si := &ServiceInternal{Service: &Service{log: qm.log, queueManager: qm}}
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	op := qm.GetQueue(repoID).CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+	op.Scheduled = true
+	_, err := qm.AddOperation(repoID, op)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		qm.holdTimers[repoID].Stop()
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	// ACT
+	idle := si.waitForIdleRepositories(waitCtx, []int{repoID})
+
+	// ASSERT
+	assert.True(t, idle, "A held backup should not keep the drive connected")
+	assert.True(t, qm.HasQueuedOperations(repoID), "The held backup should stay in the queue")
+}
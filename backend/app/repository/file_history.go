@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+)
+
+// fileSnapshot is the state of a file in a single archive
+type fileSnapshot struct {
+	size    int64
+	modTime time.Time
+}
+
+// buildFileHistory creates the history of a file from its snapshots per archive.
+// Archives must be sorted from oldest to newest; archives without a snapshot do not contain the file.
+func buildFileHistory(filePath string, archives []*ent.Archive, snapshots map[int]fileSnapshot) *FileHistory {
+	history := &FileHistory{
+		Path:     filePath,
+		Versions: make([]FileVersion, 0),
+	}
+
+	var previous *fileSnapshot
+	for _, arch := range archives {
+		snapshot, ok := snapshots[arch.ID]
+		if !ok {
+			continue
+		}
+
+		changed := previous == nil || previous.size != snapshot.size || !previous.modTime.Equal(snapshot.modTime)
+		if changed {
+			history.DistinctVersions++
+		}
+		history.Versions = append(history.Versions, FileVersion{
+			ArchiveId:        arch.ID,
+			ArchiveName:      arch.Name,
+			ArchiveCreatedAt: arch.CreatedAt,
+			Size:             snapshot.size,
+			ModTime:          snapshot.modTime,
+			Changed:          changed,
+		})
+		previous = &snapshot
+	}
+
+	return history
+}
+
+// getFileSnapshots returns the state of a file in every archive that contains it.
+// Indexed archives are looked up in the file index, all others are listed with borg (one call per archive).
+// Listing stops as soon as the context is canceled, so a slow history can be aborted by the caller.
+func (s *Service) getFileSnapshots(ctx context.Context, repoEntity *ent.Repository, archives []*ent.Archive, filePath string) (map[int]fileSnapshot, error) {
+	snapshots := make(map[int]fileSnapshot)
+
+	var indexedIds []int
+	var notIndexed []*ent.Archive
+	for _, arch := range archives {
+		if repoEntity.FileIndexEnabled && arch.FilesIndexedAt != nil {
+			indexedIds = append(indexedIds, arch.ID)
+		} else {
+			notIndexed = append(notIndexed, arch)
+		}
+	}
+
+	if len(indexedIds) > 0 {
+		files, err := s.db.ArchiveFile.Query().
+			Where(
+				archivefile.Path(filePath),
+				archivefile.HasArchiveWith(archive.IDIn(indexedIds...)),
+			).
+			WithArchive().
+			All(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query file index: %w", err)
+		}
+		for _, file := range files {
+			snapshots[file.Edges.Archive.ID] = fileSnapshot{size: file.Size, modTime: file.Mtime}
+		}
+	}
+
+	if len(notIndexed) == 0 {
+		return snapshots, nil
+	}
+
+	password, err := s.keyring.GetRepositoryPassword(repoEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password for repository %d: %w", repoEntity.ID, err)
+	}
+
+	for _, arch := range notIndexed {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		items, status := s.borgClient.ListFiles(ctx, repoEntity.URL, arch.Name, password, []string{filePath})
+		if status != nil && !status.IsCompletedWithSuccess() {
+			if status.HasBeenCanceled {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("failed to list files of archive %s: %s", arch.Name, status.GetError())
+		}
+		for _, item := range items {
+			if item.IsDir() || normalizeArchivePath(item.Path) != filePath {
+				continue
+			}
+			snapshots[arch.ID] = fileSnapshot{size: int64(item.Size), modTime: time.Time(item.MTime)}
+		}
+	}
+
+	return snapshots, nil
+}
+
+// countPathComponents returns the number of elements of an archive path
+func countPathComponents(archivePath string) int {
+	if archivePath == "" {
+		return 0
+	}
+	return strings.Count(archivePath, "/") + 1
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/keyring"
+	"github.com/loomi-labs/arco/backend/borg/mocks"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestBuildFileHistory(t *testing.T) {
+	// ARRANGE
+	t1 := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	t2 := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	archives := []*ent.Archive{
+		{ID: 1, Name: "a1", CreatedAt: t1},
+		{ID: 2, Name: "a2", CreatedAt: t1.Add(time.Hour)},
+		{ID: 3, Name: "a3", CreatedAt: t1.Add(2 * time.Hour)},
+		{ID: 4, Name: "a4", CreatedAt: t1.Add(3 * time.Hour)},
+	}
+	snapshots := map[int]fileSnapshot{
+		1: {size: 10, modTime: t1},
+		2: {size: 10, modTime: t1},
+		// Archive 3 does not contain the file
+		4: {size: 20, modTime: t2},
+	}
+
+	// ACT
+	history := buildFileHistory("home/user/report.docx", archives, snapshots)
+
+	// ASSERT
+	assert.Equal(t, "home/user/report.docx", history.Path)
+	assert.Len(t, history.Versions, 3)
+	assert.Equal(t, 2, history.DistinctVersions)
+	assert.True(t, history.Versions[0].Changed, "first version should be marked as changed")
+	assert.False(t, history.Versions[1].Changed)
+	assert.Equal(t, 4, history.Versions[2].ArchiveId)
+	assert.True(t, history.Versions[2].Changed)
+	assert.Equal(t, int64(20), history.Versions[2].Size)
+}
+
+func TestCountPathComponents(t *testing.T) {
+	assert.Equal(t, 0, countPathComponents(""))
+	assert.Equal(t, 1, countPathComponents("file.txt"))
+	assert.Equal(t, 3, countPathComponents("home/user/file.txt"))
+}
+
+func TestGetFileSnapshots_ListsEveryArchiveWithoutIndex(t *testing.T) {
+	// ARRANGE
+	db := enttest.Open(t, "sqlite3", "file:filesnapshots?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+	log := zap.NewNop().Sugar()
+
+	repo := createTestRepository(t, db, ctx, 1)
+	var archives []*ent.Archive
+	for i := range 15 {
+		archives = append(archives, db.Archive.Create().
+			SetName(fmt.Sprintf("archive-%d", i)).
+			SetBorgID(fmt.Sprintf("borg-id-%d", i)).
+			SetDuration(1).
+			SetRepositoryID(repo.ID).
+			SaveX(ctx))
+	}
+
+	testKeyring := keyring.NewTestService(log)
+	assert.NoError(t, testKeyring.SetRepositoryPassword(repo.ID, "password"))
+
+	ctrl := gomock.NewController(t)
+	mockBorgClient := mocks.NewMockBorg(ctrl)
+	mockBorgClient.EXPECT().ListFiles(gomock.Any(), repo.URL, gomock.Any(), "password", []string{"home/file.txt"}).
+		Return([]borgtypes.ArchiveItem{{Type: "-", Path: "home/file.txt", Size: 10}}, &borgtypes.Status{}).
+		Times(len(archives))
+
+	s := &Service{log: log, db: db, borgClient: mockBorgClient, keyring: testKeyring}
+
+	// ACT
+	snapshots, err := s.getFileSnapshots(ctx, repo, archives, "home/file.txt")
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, len(archives), "every archive should be searched")
+}
@@ -0,0 +1,326 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/loomi-labs/arco/backend/app/database"
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/negrel/assert"
+)
+
+const (
+	// fileIndexBatchSize is the number of files inserted per statement (sqlite limits the number of variables per statement)
+	fileIndexBatchSize = 1000
+	// maxFileSearchMatchesPerArchive is the number of matching files returned per archive
+	maxFileSearchMatchesPerArchive = 100
+)
+
+// ============================================================================
+// INDEX BUILDING
+// ============================================================================
+
+// executeIndexFiles adds the files of the newest archive that is not indexed yet to the file index.
+// Only one archive is indexed per operation, so other operations of the repository wait for one archive at most.
+// The next archive is indexed by the operation that is queued once this one completes (see queueFileIndexAfter).
+// Files of deleted archives are removed by the database (cascade delete).
+func (e *borgOperationExecutor) executeIndexFiles(ctx context.Context, indexOp statemachine.IndexFilesVariant) (*borgtypes.Status, error) {
+	indexData := indexOp()
+
+	repo, err := e.db.Repository.Get(ctx, indexData.RepositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("repository %d not found: %w", indexData.RepositoryID, err)
+	}
+	if !repo.FileIndexEnabled {
+		return &borgtypes.Status{}, nil
+	}
+
+	arch, err := e.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(repo.ID)),
+			archive.FilesIndexedAtIsNil(),
+		).
+		Order(ent.Desc(archive.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &borgtypes.Status{}, nil
+		}
+		return nil, fmt.Errorf("failed to query archive to index: %w", err)
+	}
+
+	// Get password from keyring
+	password, err := e.keyring.GetRepositoryPassword(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository password: %w", err)
+	}
+
+	items, status := e.borgClient.ListFiles(ctx, repo.URL, arch.Name, password, nil)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return status, nil
+	}
+
+	fileCount, err := indexArchiveFiles(ctx, e.db, arch.ID, items)
+	if err != nil {
+		if ctx.Err() != nil {
+			return &borgtypes.Status{HasBeenCanceled: true}, nil
+		}
+		return nil, fmt.Errorf("failed to index archive %s: %w", arch.Name, err)
+	}
+	e.log.Debugw("Indexed archive files", "archive", arch.Name, "files", fileCount, "repoID", repo.ID)
+	return &borgtypes.Status{}, nil
+}
+
+// queueFileIndexAfter queues the next file index step after an operation that added archives or indexed one
+func (qm *QueueManager) queueFileIndexAfter(repoID int, operation statemachine.Operation) {
+	switch statemachine.GetOperationType(operation) {
+	case statemachine.OperationTypeBackup,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeArchiveRefresh,
+		statemachine.OperationTypeIndexFiles:
+		qm.queueFileIndex(repoID)
+	case statemachine.OperationTypePrune,
+		statemachine.OperationTypeDelete,
+		statemachine.OperationTypeCheck,
+		statemachine.OperationTypeArchiveDelete,
+		statemachine.OperationTypeArchiveRename,
+		statemachine.OperationTypeArchiveComment,
+		statemachine.OperationTypeMount,
+		statemachine.OperationTypeMountArchive,
+		statemachine.OperationTypeUnmount,
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive:
+		// These operations don't add archives
+	default:
+		assert.Fail("Unhandled OperationType in queueFileIndexAfter")
+	}
+}
+
+// queueFileIndex queues an operation that indexes the next archive of a repository.
+// Does nothing if the file index is disabled, every archive is indexed or an index operation exists already.
+func (qm *QueueManager) queueFileIndex(repoID int) {
+	ctx := context.Background()
+	needsIndex, err := qm.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(repoID), repository.FileIndexEnabled(true)),
+			archive.FilesIndexedAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		qm.log.Errorw("Failed to check for archives to index", "repoID", repoID, "error", err.Error())
+		return
+	}
+	if !needsIndex {
+		return
+	}
+
+	queue := qm.GetQueue(repoID)
+	indexOp := statemachine.NewOperationIndexFiles(statemachine.IndexFiles{RepositoryID: repoID})
+	if queue.FindOperation(indexOp) != "" {
+		return
+	}
+	queuedOp := queue.CreateQueuedOperation(indexOp, repoID, nil, nil, false)
+	if _, err := qm.AddOperation(repoID, queuedOp); err != nil {
+		qm.log.Errorw("Failed to queue file index operation", "repoID", repoID, "error", err.Error())
+	}
+}
+
+// preemptFileIndex cancels an active file index operation, so the given heavy operation doesn't wait for it.
+// Indexing continues after the heavy operation.
+func (qm *QueueManager) preemptFileIndex(repoID int) {
+	active := qm.GetQueue(repoID).GetActive()
+	if active == nil || statemachine.GetOperationType(active.Operation) != statemachine.OperationTypeIndexFiles {
+		return
+	}
+	qm.log.Debugw("Canceling file index operation for heavy operation", "repoID", repoID, "operationID", active.ID)
+	if err := qm.CancelOperation(repoID, active.ID); err != nil {
+		qm.log.Warnw("Failed to cancel file index operation", "repoID", repoID, "operationID", active.ID, "error", err.Error())
+	}
+}
+
+// indexArchiveFiles replaces the indexed files of an archive and marks the archive as indexed.
+// Directories are not indexed. Returns the number of indexed files.
+func indexArchiveFiles(ctx context.Context, db *ent.Client, archiveID int, items []borgtypes.ArchiveItem) (int, error) {
+	return database.WithTxData(ctx, db, func(tx *ent.Tx) (int, error) {
+		// Remove leftovers (e.g. from an interrupted run)
+		_, err := tx.ArchiveFile.Delete().
+			Where(archivefile.HasArchiveWith(archive.ID(archiveID))).
+			Exec(ctx)
+		if err != nil {
+			return 0, err
+		}
+
+		count := 0
+		batch := make([]*ent.ArchiveFileCreate, 0, fileIndexBatchSize)
+		for _, item := range items {
+			if item.IsDir() {
+				continue
+			}
+			batch = append(batch, tx.ArchiveFile.Create().
+				SetPath(normalizeArchivePath(item.Path)).
+				SetSize(int64(item.Size)).
+				SetMtime(time.Time(item.MTime)).
+				SetArchiveID(archiveID))
+
+			if len(batch) == fileIndexBatchSize {
+				if err := tx.ArchiveFile.CreateBulk(batch...).Exec(ctx); err != nil {
+					return 0, err
+				}
+				count += len(batch)
+				batch = batch[:0]
+			}
+		}
+		if len(batch) > 0 {
+			if err := tx.ArchiveFile.CreateBulk(batch...).Exec(ctx); err != nil {
+				return 0, err
+			}
+			count += len(batch)
+		}
+
+		err = tx.Archive.UpdateOneID(archiveID).
+			SetFilesIndexedAt(time.Now()).
+			Exec(ctx)
+		return count, err
+	})
+}
+
+// clearFileIndex removes all indexed files of a repository
+func clearFileIndex(ctx context.Context, db *ent.Client, repoID int) error {
+	return database.WithTx(ctx, db, func(tx *ent.Tx) error {
+		_, err := tx.ArchiveFile.Delete().
+			Where(archivefile.HasArchiveWith(archive.HasRepositoryWith(repository.ID(repoID)))).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		return tx.Archive.Update().
+			Where(archive.HasRepositoryWith(repository.ID(repoID))).
+			ClearFilesIndexedAt().
+			Exec(ctx)
+	})
+}
+
+// ============================================================================
+// SEARCH
+// ============================================================================
+
+// searchIndexedFiles returns the indexed files of a repository that match the predicates per archive, newest archive first.
+// The matches of all archives are counted with one grouped query and the first matches of every archive are loaded
+// with one query, so the number of queries doesn't grow with the number of archives.
+func searchIndexedFiles(ctx context.Context, db *ent.Client, repoID int, filePredicates ...predicate.ArchiveFile) ([]*FileSearchResult, error) {
+	predicates := append([]predicate.ArchiveFile{archivefile.HasArchiveWith(archive.HasRepositoryWith(repository.ID(repoID)))}, filePredicates...)
+
+	var counts []struct {
+		ArchiveID int `sql:"archive_file_archive"`
+		Count     int `sql:"count"`
+	}
+	err := db.ArchiveFile.Query().
+		Where(predicates...).
+		GroupBy(archivefile.ArchiveColumn).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count matching files: %w", err)
+	}
+	if len(counts) == 0 {
+		return []*FileSearchResult{}, nil
+	}
+	matchCounts := make(map[int]int, len(counts))
+	archiveIDs := make([]int, 0, len(counts))
+	for _, c := range counts {
+		matchCounts[c.ArchiveID] = c.Count
+		archiveIDs = append(archiveIDs, c.ArchiveID)
+	}
+
+	archives, err := db.Archive.Query().
+		Where(archive.IDIn(archiveIDs...)).
+		WithBackupProfile().
+		Order(ent.Desc(archive.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search archives: %w", err)
+	}
+
+	files, err := db.ArchiveFile.Query().
+		Where(firstMatchesPerArchive(maxFileSearchMatchesPerArchive, predicates...)).
+		WithArchive(func(q *ent.ArchiveQuery) {
+			q.Select(archive.FieldID)
+		}).
+		Order(ent.Asc(archivefile.FieldPath)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query matching files: %w", err)
+	}
+	filesByArchive := make(map[int][]*ent.ArchiveFile, len(archives))
+	for _, file := range files {
+		archiveID := file.Edges.Archive.ID
+		// The archive is only loaded to group the files, it is part of the result already
+		file.Edges.Archive = nil
+		filesByArchive[archiveID] = append(filesByArchive[archiveID], file)
+	}
+
+	results := make([]*FileSearchResult, 0, len(archives))
+	for _, arch := range archives {
+		results = append(results, &FileSearchResult{
+			Archive:    arch,
+			Files:      filesByArchive[arch.ID],
+			MatchCount: matchCounts[arch.ID],
+		})
+	}
+	return results, nil
+}
+
+// firstMatchesPerArchive returns a predicate for the first files of every archive (sorted by path) that match the
+// predicates. The files are ranked per archive with a window function in a subquery.
+func firstMatchesPerArchive(limit int, predicates ...predicate.ArchiveFile) predicate.ArchiveFile {
+	return predicate.ArchiveFile(func(s *entsql.Selector) {
+		ranked := entsql.Dialect(s.Dialect()).
+			Select(archivefile.FieldID).
+			AppendSelectExprAs(entsql.Expr(fmt.Sprintf("ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s)",
+				archivefile.ArchiveColumn, archivefile.FieldPath)), "match_rank").
+			From(entsql.Table(archivefile.Table))
+		for _, p := range predicates {
+			p(ranked)
+		}
+		firstMatches := entsql.Dialect(s.Dialect()).
+			Select(archivefile.FieldID).
+			From(ranked.As("ranked")).
+			Where(entsql.LTE("match_rank", limit))
+		s.Where(entsql.In(s.C(archivefile.FieldID), firstMatches))
+	})
+}
+
+// pathMatchesGlob returns a predicate matching indexed paths against a shell-style glob (sqlite GLOB).
+// A glob without a slash is matched against the file name, otherwise against the whole path.
+// Example: "report*.docx" matches "home/user/report-2024.docx"
+func pathMatchesGlob(glob string) predicate.ArchiveFile {
+	glob = strings.TrimPrefix(glob, "/")
+	return predicate.ArchiveFile(func(s *entsql.Selector) {
+		column := s.C(archivefile.FieldPath)
+		if strings.Contains(glob, "/") {
+			s.Where(entsql.P(func(b *entsql.Builder) {
+				b.Ident(column).WriteString(" GLOB ").Arg(glob)
+			}))
+			return
+		}
+		s.Where(entsql.Or(
+			entsql.P(func(b *entsql.Builder) {
+				b.Ident(column).WriteString(" GLOB ").Arg(glob)
+			}),
+			entsql.P(func(b *entsql.Builder) {
+				b.Ident(column).WriteString(" GLOB ").Arg("*/" + glob)
+			}),
+		))
+	})
+}
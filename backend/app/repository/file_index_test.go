@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/keyring"
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	"github.com/loomi-labs/arco/backend/borg/mocks"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// newTestFileIndexDB creates an in-memory database with a repository and one archive
+func newTestFileIndexDB(t *testing.T) (*ent.Client, context.Context, *ent.Archive) {
+	db := enttest.Open(t, "sqlite3", "file:fileindex?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+
+	repo := createTestRepository(t, db, ctx, 1)
+	arch, err := db.Archive.Create().
+		SetName("test-archive").
+		SetBorgID("borg-id").
+		SetDuration(1).
+		SetRepositoryID(repo.ID).
+		Save(ctx)
+	assert.NoError(t, err)
+	return db, ctx, arch
+}
+
+func TestIndexArchiveFiles(t *testing.T) {
+	// ARRANGE
+	db, ctx, arch := newTestFileIndexDB(t)
+	items := []borgtypes.ArchiveItem{
+		{Type: "d", Path: "home/user"},
+		{Type: "-", Path: "home/user/report.docx", Size: 10, MTime: borgtypes.StringTime(time.Now())},
+		{Type: "-", Path: "home/user/notes.txt", Size: 20, MTime: borgtypes.StringTime(time.Now())},
+	}
+
+	// ACT
+	// Indexing twice must not duplicate files
+	_, err := indexArchiveFiles(ctx, db, arch.ID, items)
+	assert.NoError(t, err)
+	count, err := indexArchiveFiles(ctx, db, arch.ID, items)
+	assert.NoError(t, err)
+
+	// ASSERT
+	assert.Equal(t, 2, count, "directories should not be indexed")
+	total, err := db.ArchiveFile.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	updated, err := db.Archive.Get(ctx, arch.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, updated.FilesIndexedAt)
+
+	// Glob without slash matches file names
+	matches, err := db.ArchiveFile.Query().Where(pathMatchesGlob("report*")).All(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+	assert.Equal(t, "home/user/report.docx", matches[0].Path)
+
+	// Glob with slash matches the whole path
+	matches, err = db.ArchiveFile.Query().Where(pathMatchesGlob("/home/*/notes.txt")).All(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+
+	matches, err = db.ArchiveFile.Query().Where(archivefile.PathContainsFold("REPORT")).All(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 1)
+}
+
+func TestFileIndexRemoval(t *testing.T) {
+	// ARRANGE
+	db, ctx, arch := newTestFileIndexDB(t)
+	items := []borgtypes.ArchiveItem{{Type: "-", Path: "home/user/report.docx", Size: 10}}
+	_, err := indexArchiveFiles(ctx, db, arch.ID, items)
+	assert.NoError(t, err)
+
+	// ACT & ASSERT
+	// Clearing the index removes all files and marks archives as not indexed
+	assert.NoError(t, clearFileIndex(ctx, db, 1))
+	total, err := db.ArchiveFile.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, total)
+	updated, err := db.Archive.Get(ctx, arch.ID)
+	assert.NoError(t, err)
+	assert.Nil(t, updated.FilesIndexedAt)
+
+	// Deleting an archive removes its files
+	_, err = indexArchiveFiles(ctx, db, arch.ID, items)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Archive.DeleteOneID(arch.ID).Exec(ctx))
+	total, err = db.ArchiveFile.Query().Count(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestSearchIndexedFiles(t *testing.T) {
+	// ARRANGE
+	db, ctx, oldArchive := newTestFileIndexDB(t)
+	newArchive, err := db.Archive.Create().
+		SetName("new-archive").
+		SetBorgID("borg-id-2").
+		SetDuration(1).
+		SetCreatedAt(oldArchive.CreatedAt.Add(time.Hour)).
+		SetRepositoryID(1).
+		Save(ctx)
+	assert.NoError(t, err)
+
+	var manyReports []borgtypes.ArchiveItem
+	for i := range maxFileSearchMatchesPerArchive + 5 {
+		manyReports = append(manyReports, borgtypes.ArchiveItem{Type: "-", Path: fmt.Sprintf("home/user/report-%03d.docx", i)})
+	}
+	_, err = indexArchiveFiles(ctx, db, oldArchive.ID, []borgtypes.ArchiveItem{
+		{Type: "-", Path: "home/user/report-old.docx"},
+		{Type: "-", Path: "home/user/notes.txt"},
+	})
+	assert.NoError(t, err)
+	_, err = indexArchiveFiles(ctx, db, newArchive.ID, append(manyReports, borgtypes.ArchiveItem{Type: "-", Path: "home/user/notes.txt"}))
+	assert.NoError(t, err)
+
+	// ACT
+	results, err := searchIndexedFiles(ctx, db, 1, pathMatchesGlob("report*"))
+
+	// ASSERT
+	assert.NoError(t, err)
+	if assert.Len(t, results, 2) {
+		assert.Equal(t, newArchive.ID, results[0].Archive.ID, "newest archive should come first")
+		assert.Equal(t, maxFileSearchMatchesPerArchive+5, results[0].MatchCount)
+		assert.Len(t, results[0].Files, maxFileSearchMatchesPerArchive, "only the first matches should be loaded")
+		assert.Equal(t, "home/user/report-000.docx", results[0].Files[0].Path)
+		assert.Nil(t, results[0].Files[0].Edges.Archive)
+
+		assert.Equal(t, oldArchive.ID, results[1].Archive.ID)
+		assert.Equal(t, 1, results[1].MatchCount)
+		if assert.Len(t, results[1].Files, 1) {
+			assert.Equal(t, "home/user/report-old.docx", results[1].Files[0].Path)
+		}
+	}
+
+	// No matches
+	results, err = searchIndexedFiles(ctx, db, 1, pathMatchesGlob("*.iso"))
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestExecuteIndexFiles_IndexesOneArchivePerOperation(t *testing.T) {
+	// ARRANGE
+	db, ctx, older := newTestFileIndexDB(t)
+	log := zap.NewNop().Sugar()
+	repoID := older.QueryRepository().OnlyIDX(ctx)
+	db.Repository.UpdateOneID(repoID).SetFileIndexEnabled(true).ExecX(ctx)
+	newer := db.Archive.Create().
+		SetName("newer-archive").
+		SetBorgID("borg-id-2").
+		SetDuration(1).
+		SetCreatedAt(older.CreatedAt.Add(time.Hour)).
+		SetRepositoryID(repoID).
+		SaveX(ctx)
+
+	testKeyring := keyring.NewTestService(log)
+	assert.NoError(t, testKeyring.SetRepositoryPassword(repoID, "password"))
+
+	ctrl := gomock.NewController(t)
+	mockBorgClient := mocks.NewMockBorg(ctrl)
+	// Only the newest archive is listed
+	mockBorgClient.EXPECT().ListFiles(gomock.Any(), gomock.Any(), "newer-archive", "password", gomock.Any()).
+		Return([]borgtypes.ArchiveItem{{Type: "-", Path: "home/file.txt", Size: 1}}, &borgtypes.Status{}).Times(1)
+
+	executor := &borgOperationExecutor{
+		borgClient: mockBorgClient,
+		db:         db,
+		log:        log,
+		keyring:    testKeyring,
+		repoID:     repoID,
+	}
+
+	// ACT
+	status, err := executor.executeIndexFiles(ctx, statemachine.NewOperationIndexFiles(statemachine.IndexFiles{RepositoryID: repoID}))
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.True(t, status.IsCompletedWithSuccess())
+	assert.NotNil(t, db.Archive.GetX(ctx, newer.ID).FilesIndexedAt)
+	assert.Nil(t, db.Archive.GetX(ctx, older.ID).FilesIndexedAt, "Older archive should be indexed by the next operation")
+}
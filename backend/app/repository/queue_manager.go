@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"os/user"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/loomi-labs/arco/backend/app/analytics"
 	"github.com/loomi-labs/arco/backend/app/keyring"
+	"github.com/loomi-labs/arco/backend/app/schedule"
 	"github.com/loomi-labs/arco/backend/app/statemachine"
 	"github.com/loomi-labs/arco/backend/app/types"
 	"github.com/loomi-labs/arco/backend/borg"
@@ -22,6 +24,7 @@ import (
 	"github.com/loomi-labs/arco/backend/ent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/repository"
 	"github.com/loomi-labs/arco/backend/platform"
@@ -67,6 +70,13 @@ type QueueManager struct {
 	maxHeavyOps int                      // Max heavy operations across all repositories
 	activeHeavy map[int]*QueuedOperation // RepoID -> active heavy operation
 	activeLight map[int]*QueuedOperation // RepoID -> active light operation
+
+	// Throughput of running backups
+	backupRates map[string]*backupRateTracker // OperationID -> rate tracker
+
+	// Timers that process a queue again when its held operation can start
+	holdTimers map[int]*time.Timer // RepoID -> timer
+	holdMu     sync.Mutex          // Separate mutex for hold timers
 }
 
 // NewQueueManager creates a new QueueManager with specified concurrency limits
@@ -79,6 +89,8 @@ func NewQueueManager(log *zap.SugaredLogger, stateMachine *statemachine.Reposito
 		maxHeavyOps:      maxHeavyOps,
 		activeHeavy:      make(map[int]*QueuedOperation),
 		activeLight:      make(map[int]*QueuedOperation),
+		backupRates:      make(map[string]*backupRateTracker),
+		holdTimers:       make(map[int]*time.Timer),
 	}
 }
 
@@ -131,10 +143,15 @@ func (qm *QueueManager) setRepositoryState(repoID int, state statemachine.Reposi
 		qm.eventEmitter.EmitEvent(application.Get().Context(), types.EventPruneStateChangedString(data.BackupID))
 	case statemachine.RepositoryStateTypeRefreshing:
 		qm.eventEmitter.EmitEvent(application.Get().Context(), types.EventArchivesChangedString(repoID))
+	case statemachine.RepositoryStateTypeRestoring:
+		qm.eventEmitter.EmitEvent(application.Get().Context(), types.EventRestoreStateChangedString(repoID))
+	case statemachine.RepositoryStateTypeExporting:
+		qm.eventEmitter.EmitEvent(application.Get().Context(), types.EventExportStateChangedString(repoID))
 	case statemachine.RepositoryStateTypeIdle,
 		statemachine.RepositoryStateTypeQueued,
 		statemachine.RepositoryStateTypeDeleting,
 		statemachine.RepositoryStateTypeChecking,
+		statemachine.RepositoryStateTypeImporting,
 		statemachine.RepositoryStateTypeMounting,
 		statemachine.RepositoryStateTypeMounted,
 		statemachine.RepositoryStateTypeError:
@@ -175,6 +192,10 @@ func (qm *QueueManager) AddOperation(repoID int, op *QueuedOperation) (string, e
 			return "", fmt.Errorf("cannot start immediate heavy operation: repository has queued operations")
 		}
 
+		if reason, _ := qm.getHoldReason(op, time.Now()); reason != "" {
+			return "", fmt.Errorf("cannot start immediate operation: %s", reason)
+		}
+
 		if !qm.CanStartOperation(repoID, op) {
 			return "", fmt.Errorf("cannot start immediate operation: concurrency limits exceeded")
 		}
@@ -183,6 +204,11 @@ func (qm *QueueManager) AddOperation(repoID int, op *QueuedOperation) (string, e
 	// Add operation to queue (handles idempotency internally)
 	operationID := queue.AddOperation(op)
 
+	// Heavy operations don't wait for the file index, it continues after them
+	if statemachine.GetOperationWeight(op.Operation) == statemachine.WeightHeavy {
+		qm.preemptFileIndex(repoID)
+	}
+
 	// Attempt to start operation if possible
 	err := qm.processQueue(repoID)
 	if err != nil {
@@ -271,11 +297,15 @@ func (qm *QueueManager) RemoveOperation(repoID int, operationID string) error {
 	// Non-archive-affecting operations - no action
 	case statemachine.OperationTypeDelete,
 		statemachine.OperationTypeCheck,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypePrune,
+		statemachine.OperationTypeRestore,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 	// No action needed
 	default:
 		assert.Fail("Unknown operation")
@@ -320,6 +350,84 @@ func (qm *QueueManager) CanStartOperation(repoID int, op *QueuedOperation) bool
 	return true
 }
 
+// nextUnheldOperation returns the first queued operation that isn't held by the allowed hours and blackout periods
+// of its backup schedule. Held operations stay in the queue and the queue is processed again when the first of them
+// can start. It queries the database, so it must not be called while holding qm.mu.
+func (qm *QueueManager) nextUnheldOperation(repoID int) *QueuedOperation {
+	queue := qm.GetQueue(repoID)
+
+	var next *QueuedOperation
+	var releaseAt *time.Time
+	for _, op := range queue.GetQueuedOperations(nil) {
+		reason, until := qm.getHoldReason(op, time.Now())
+		queue.SetHold(op.ID, reason, until)
+		if reason == "" {
+			next = op
+			break
+		}
+
+		qm.log.Debugw("Holding operation",
+			"repoID", repoID, "operationID", op.ID, "reason", reason)
+		if until != nil && (releaseAt == nil || until.Before(*releaseAt)) {
+			releaseAt = until
+		}
+	}
+	if releaseAt != nil {
+		qm.processQueueAt(repoID, *releaseAt)
+	}
+	return next
+}
+
+// processQueueAt processes the queue of a repository again at the given time, when its held operations can start
+func (qm *QueueManager) processQueueAt(repoID int, at time.Time) {
+	timer := time.AfterFunc(time.Until(at), func() {
+		if err := qm.processQueue(repoID); err != nil {
+			qm.log.Warnw("Failed to process queue after hold", "repoID", repoID, "error", err)
+		}
+		qm.eventEmitter.EmitEvent(application.Get().Context(), types.EventRepoStateChangedString(repoID))
+	})
+
+	qm.holdMu.Lock()
+	previous := qm.holdTimers[repoID]
+	qm.holdTimers[repoID] = timer
+	qm.holdMu.Unlock()
+	if previous != nil {
+		previous.Stop()
+	}
+}
+
+// getHoldReason returns why a scheduled backup can't start at the given time because of the allowed hours and
+// blackout periods of its backup schedule and when it can start. The reason is empty if the operation can start.
+// Manual backups are never held.
+func (qm *QueueManager) getHoldReason(op *QueuedOperation, now time.Time) (string, *time.Time) {
+	if qm.db == nil || !op.Scheduled || op.BackupProfileID == nil || statemachine.GetOperationType(op.Operation) != statemachine.OperationTypeBackup {
+		return "", nil
+	}
+
+	bs, err := qm.db.BackupSchedule.Query().
+		Where(backupschedule.HasBackupProfileWith(backupprofile.ID(*op.BackupProfileID))).
+		Only(context.Background())
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			qm.log.Errorw("Failed to get backup schedule of operation",
+				"operationID", op.ID,
+				"error", err.Error())
+		}
+		return "", nil
+	}
+	// The start window only applies while the schedule is enabled
+	if bs.Mode == backupschedule.ModeDisabled {
+		return "", nil
+	}
+
+	window := schedule.StartWindow{AllowedHours: bs.AllowedHours, BlackoutPeriods: bs.BlackoutPeriods}
+	reason, until := window.HoldReason(now)
+	if until.IsZero() {
+		return reason, nil
+	}
+	return reason, &until
+}
+
 // StartOperation marks an operation as active and updates state
 func (qm *QueueManager) StartOperation(ctx context.Context, repoID int, operationID string) error {
 	queue := qm.GetQueue(repoID)
@@ -472,6 +580,9 @@ func (qm *QueueManager) StartOperation(ctx context.Context, repoID int, operatio
 					"operationID", operationID,
 					"completionError", completeErr.Error())
 			}
+
+			// Index the files of new archives one archive at a time, so other operations don't wait for the whole index
+			qm.queueFileIndexAfter(repoID, op.Operation)
 		}
 	}()
 
@@ -510,6 +621,7 @@ func (qm *QueueManager) CompleteOperation(ctx context.Context, repoID int, opera
 	} else {
 		delete(qm.activeLight, repoID)
 	}
+	delete(qm.backupRates, operationID)
 	qm.mu.Unlock()
 
 	// Update operation status and complete in queue
@@ -640,11 +752,25 @@ func (qm *QueueManager) GetOperation(operationID string) (*QueuedOperation, erro
 	return nil, fmt.Errorf("operation %s not found in any queue", operationID)
 }
 
-// UpdateBackupProgress updates the progress of a backup operation
+// StartBackupProgress starts tracking the throughput of a backup operation.
+// The estimate (from previous archives) is used for the remaining time until the current throughput is known.
+func (qm *QueueManager) StartBackupProgress(operationID string, estimate borgtypes.BackupEstimate) {
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+
+	qm.backupRates[operationID] = newBackupRateTracker(estimate, time.Now())
+}
+
+// UpdateBackupProgress updates the progress of a backup operation.
+// Throughput and remaining time are calculated if the operation has been started with StartBackupProgress.
 func (qm *QueueManager) UpdateBackupProgress(ctx context.Context, operationID string, progress borgtypes.BackupProgress) error {
 	qm.mu.Lock()
 	defer qm.mu.Unlock()
 
+	if tracker, ok := qm.backupRates[operationID]; ok {
+		tracker.update(&progress, time.Now())
+	}
+
 	// Search across all repository queues
 	for _, queue := range qm.queues {
 		op := queue.GetOperationByID(operationID)
@@ -671,6 +797,68 @@ func (qm *QueueManager) UpdateBackupProgress(ctx context.Context, operationID st
 	return fmt.Errorf("operation %s not found in any queue", operationID)
 }
 
+// UpdateRestoreProgress updates the progress of a restore operation
+func (qm *QueueManager) UpdateRestoreProgress(ctx context.Context, operationID string, progress borgtypes.ExtractProgress) error {
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+
+	// Search across all repository queues
+	for repoID, queue := range qm.queues {
+		op := queue.GetOperationByID(operationID)
+		if op != nil {
+			// Check if this is a restore operation
+			if restoreVariant, isRestore := op.Operation.(statemachine.RestoreVariant); isRestore {
+
+				// Update the operation's restore data with new progress
+				restoreData := restoreVariant()
+				restoreData.Progress = &progress
+
+				// Create a new RestoreVariant with updated data
+				updatedOperation := statemachine.NewOperationRestore(restoreData)
+				op.Operation = updatedOperation
+
+				qm.eventEmitter.EmitEvent(ctx, types.EventRestoreStateChangedString(repoID))
+
+				return nil
+			}
+			return fmt.Errorf("operation %s is not a restore operation", operationID)
+		}
+	}
+
+	return fmt.Errorf("operation %s not found in any queue", operationID)
+}
+
+// UpdateExportProgress updates the progress of an export operation
+func (qm *QueueManager) UpdateExportProgress(ctx context.Context, operationID string, progress borgtypes.ExtractProgress) error {
+	qm.mu.Lock()
+	defer qm.mu.Unlock()
+
+	// Search across all repository queues
+	for repoID, queue := range qm.queues {
+		op := queue.GetOperationByID(operationID)
+		if op != nil {
+			// Check if this is an export operation
+			if exportVariant, isExport := op.Operation.(statemachine.ExportArchiveVariant); isExport {
+
+				// Update the operation's export data with new progress
+				exportData := exportVariant()
+				exportData.Progress = &progress
+
+				// Create a new ExportArchiveVariant with updated data
+				updatedOperation := statemachine.NewOperationExportArchive(exportData)
+				op.Operation = updatedOperation
+
+				qm.eventEmitter.EmitEvent(ctx, types.EventExportStateChangedString(repoID))
+
+				return nil
+			}
+			return fmt.Errorf("operation %s is not an export operation", operationID)
+		}
+	}
+
+	return fmt.Errorf("operation %s not found in any queue", operationID)
+}
+
 // GetQueuedOperations returns only queued operations for a repository (excluding active), optionally filtered by operation type
 func (qm *QueueManager) GetQueuedOperations(repoID int, operationType *statemachine.OperationType) ([]*QueuedOperation, error) {
 	queue := qm.GetQueue(repoID)
@@ -757,8 +945,8 @@ func (qm *QueueManager) processQueue(repoID int) error {
 		return nil
 	}
 
-	// Get next operation from queue
-	nextOp := queue.GetNext()
+	// Get next operation from queue, operations behind a held backup can start
+	nextOp := qm.nextUnheldOperation(repoID)
 	if nextOp == nil {
 		return nil
 	}
@@ -833,12 +1021,27 @@ func (qm *QueueManager) getTargetStateForOperation(ctx context.Context, op *Queu
 	case statemachine.OperationTypeDelete:
 		return statemachine.CreateDeletingState(ctx, 0), nil // Repository delete, no specific archive
 
-	case statemachine.OperationTypeArchiveRefresh:
+	case statemachine.OperationTypeArchiveRefresh, statemachine.OperationTypeIndexFiles:
 		return statemachine.CreateRefreshingState(ctx), nil
 
 	case statemachine.OperationTypeCheck:
 		return statemachine.CreateCheckingState(ctx), nil
 
+	case statemachine.OperationTypeRestore:
+		restoreVariant := op.Operation.(statemachine.RestoreVariant)
+		restoreData := restoreVariant()
+		return statemachine.CreateRestoringState(ctx, restoreData.ArchiveID), nil
+
+	case statemachine.OperationTypeExportArchive:
+		exportVariant := op.Operation.(statemachine.ExportArchiveVariant)
+		exportData := exportVariant()
+		return statemachine.CreateExportingState(ctx, exportData.ArchiveID), nil
+
+	case statemachine.OperationTypeImportTar:
+		importVariant := op.Operation.(statemachine.ImportTarVariant)
+		importData := importVariant()
+		return statemachine.CreateImportingState(ctx, importData.BackupID), nil
+
 	case statemachine.OperationTypeArchiveDelete:
 		deleteVariant := op.Operation.(statemachine.ArchiveDeleteVariant)
 		deleteData := deleteVariant()
@@ -928,7 +1131,11 @@ func (qm *QueueManager) getCompletionStateForRepository(repoID int, completedOp
 		statemachine.OperationTypeArchiveComment,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeIndexFiles:
 		// All other operations return to idle
 		return statemachine.CreateIdleState(), nil
 
@@ -1025,6 +1232,8 @@ func (qm *QueueManager) sendFrontendNotification(ctx context.Context, repoID int
 		message = fmt.Sprintf("Failed to delete archive (ID %d): %s", archiveData.ArchiveID, errorMsg)
 	case statemachine.OperationTypeArchiveRefresh:
 		message = fmt.Sprintf("Failed to refresh archives: %s", errorMsg)
+	case statemachine.OperationTypeIndexFiles:
+		message = fmt.Sprintf("Failed to index files: %s", errorMsg)
 	case statemachine.OperationTypeBackup:
 		message = fmt.Sprintf("Failed to backup: %s", errorMsg)
 	case statemachine.OperationTypePrune:
@@ -1050,6 +1259,12 @@ func (qm *QueueManager) sendFrontendNotification(ctx context.Context, repoID int
 		} else {
 			message = fmt.Sprintf("Repository full check failed: %s", errorMsg)
 		}
+	case statemachine.OperationTypeRestore:
+		message = fmt.Sprintf("Failed to restore archive: %s", errorMsg)
+	case statemachine.OperationTypeExportArchive:
+		message = fmt.Sprintf("Failed to export archive: %s", errorMsg)
+	case statemachine.OperationTypeImportTar:
+		message = fmt.Sprintf("Failed to import tar file: %s", errorMsg)
 	default:
 		assert.Fail("Unhandled OperationType in sendFrontendNotification")
 	}
@@ -1079,6 +1294,12 @@ func (qm *QueueManager) getErrorNotificationType(operation statemachine.Operatio
 		} else {
 			return notification.TypeFailedFullCheck
 		}
+	case statemachine.OperationTypeRestore:
+		return notification.TypeFailedRestoreRun
+	case statemachine.OperationTypeExportArchive:
+		return notification.TypeFailedExportRun
+	case statemachine.OperationTypeImportTar:
+		return notification.TypeFailedImportRun
 	case statemachine.OperationTypeDelete,
 		statemachine.OperationTypeArchiveRefresh,
 		statemachine.OperationTypeArchiveDelete,
@@ -1088,7 +1309,8 @@ func (qm *QueueManager) getErrorNotificationType(operation statemachine.Operatio
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 		// This should never happen if shouldCreateNotification is used correctly
 		qm.log.Errorw("Unexpected operation type for error notification",
 			"operationType", fmt.Sprintf("%T", operation))
@@ -1112,7 +1334,12 @@ func (qm *QueueManager) getWarningNotificationType(operation statemachine.Operat
 		} else {
 			return notification.TypeWarningFullCheck
 		}
+	case statemachine.OperationTypeRestore:
+		return notification.TypeWarningRestoreRun
+	case statemachine.OperationTypeExportArchive:
+		return notification.TypeWarningExportRun
 	case statemachine.OperationTypeBackup,
+		statemachine.OperationTypeImportTar,
 		statemachine.OperationTypeDelete,
 		statemachine.OperationTypeArchiveRefresh,
 		statemachine.OperationTypeArchiveDelete,
@@ -1122,7 +1349,8 @@ func (qm *QueueManager) getWarningNotificationType(operation statemachine.Operat
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 		// This should never happen if shouldCreateWarningNotification is used correctly
 		// Note: Backup warnings are stored on Archive entity, not as notifications
 		qm.log.Errorw("Unexpected operation type for warning notification",
@@ -1164,6 +1392,17 @@ func (qm *QueueManager) getBackupProfileIDFromOperation(operation statemachine.O
 			return 0
 		}
 		return backupProfile.ID
+	case statemachine.OperationTypeRestore:
+		restoreVariant := operation.(statemachine.RestoreVariant)
+		restoreData := restoreVariant()
+		return qm.getBackupProfileIDFromArchive(restoreData.ArchiveID)
+	case statemachine.OperationTypeExportArchive:
+		exportVariant := operation.(statemachine.ExportArchiveVariant)
+		exportData := exportVariant()
+		return qm.getBackupProfileIDFromArchive(exportData.ArchiveID)
+	case statemachine.OperationTypeImportTar:
+		importVariant := operation.(statemachine.ImportTarVariant)
+		return importVariant().BackupID.BackupProfileId
 	case statemachine.OperationTypeDelete,
 		statemachine.OperationTypeArchiveRefresh,
 		statemachine.OperationTypeArchiveDelete,
@@ -1172,7 +1411,8 @@ func (qm *QueueManager) getBackupProfileIDFromOperation(operation statemachine.O
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 		// This should never happen if shouldCreateNotification is used correctly
 		qm.log.Errorw("Unexpected operation type for backup profile",
 			"operationType", fmt.Sprintf("%T", operation))
@@ -1183,10 +1423,39 @@ func (qm *QueueManager) getBackupProfileIDFromOperation(operation statemachine.O
 	}
 }
 
+// getBackupProfileIDFromArchive returns the backup profile of an archive
+// or falls back to the first backup profile of its repository
+func (qm *QueueManager) getBackupProfileIDFromArchive(archiveID int) int {
+	archiveEntity, err := qm.db.Archive.Query().
+		Where(archive.ID(archiveID)).
+		WithRepository().
+		WithBackupProfile().
+		Only(context.Background())
+	if err != nil {
+		qm.log.Errorw("Failed to get archive for notification",
+			"archiveID", archiveID,
+			"error", err.Error())
+		return 0
+	}
+	if archiveEntity.Edges.BackupProfile != nil {
+		return archiveEntity.Edges.BackupProfile.ID
+	}
+	backupProfile, err := qm.db.BackupProfile.Query().
+		Where(backupprofile.HasRepositoriesWith(repository.ID(archiveEntity.Edges.Repository.ID))).
+		First(context.Background())
+	if err != nil {
+		qm.log.Errorw("Failed to get backup profile for notification",
+			"repositoryID", archiveEntity.Edges.Repository.ID,
+			"error", err.Error())
+		return 0
+	}
+	return backupProfile.ID
+}
+
 // shouldCreateNotification determines if error notifications should be created for this operation type
 func (qm *QueueManager) shouldCreateNotification(operation statemachine.Operation) bool {
 	switch statemachine.GetOperationType(operation) {
-	case statemachine.OperationTypeBackup, statemachine.OperationTypePrune, statemachine.OperationTypeCheck:
+	case statemachine.OperationTypeBackup, statemachine.OperationTypePrune, statemachine.OperationTypeCheck, statemachine.OperationTypeRestore, statemachine.OperationTypeExportArchive, statemachine.OperationTypeImportTar:
 		return true
 	case statemachine.OperationTypeDelete,
 		statemachine.OperationTypeArchiveRefresh,
@@ -1197,7 +1466,8 @@ func (qm *QueueManager) shouldCreateNotification(operation statemachine.Operatio
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 		return false
 	default:
 		assert.Fail("Unhandled OperationType in shouldCreateNotification")
@@ -1209,9 +1479,10 @@ func (qm *QueueManager) shouldCreateNotification(operation statemachine.Operatio
 // Note: Backup warnings are stored on Archive entity, not as notifications
 func (qm *QueueManager) shouldCreateWarningNotification(operation statemachine.Operation) bool {
 	switch statemachine.GetOperationType(operation) {
-	case statemachine.OperationTypePrune, statemachine.OperationTypeCheck:
+	case statemachine.OperationTypePrune, statemachine.OperationTypeCheck, statemachine.OperationTypeRestore, statemachine.OperationTypeExportArchive:
 		return true
 	case statemachine.OperationTypeBackup,
+		statemachine.OperationTypeImportTar,
 		statemachine.OperationTypeDelete,
 		statemachine.OperationTypeArchiveRefresh,
 		statemachine.OperationTypeArchiveDelete,
@@ -1221,7 +1492,8 @@ func (qm *QueueManager) shouldCreateWarningNotification(operation statemachine.O
 		statemachine.OperationTypeMount,
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
-		statemachine.OperationTypeUnmountArchive:
+		statemachine.OperationTypeUnmountArchive,
+		statemachine.OperationTypeIndexFiles:
 		return false
 	default:
 		assert.Fail("Unhandled OperationType in shouldCreateWarningNotification")
@@ -1239,7 +1511,10 @@ type OperationExecutor interface {
 }
 
 type progressUpdater interface {
+	StartBackupProgress(operationID string, estimate borgtypes.BackupEstimate)
 	UpdateBackupProgress(ctx context.Context, operationID string, progress borgtypes.BackupProgress) error
+	UpdateRestoreProgress(ctx context.Context, operationID string, progress borgtypes.ExtractProgress) error
+	UpdateExportProgress(ctx context.Context, operationID string, progress borgtypes.ExtractProgress) error
 }
 
 // borgOperationExecutor implements OperationExecutor using borg commands
@@ -1306,6 +1581,14 @@ func (e *borgOperationExecutor) Execute(ctx context.Context, operation statemach
 		examineVariant := operation.(statemachine.ExaminePruneVariant)
 		examineData := examineVariant()
 		return e.executePrune(ctx, examineData.BackupID, true, examineData.PruningRule, examineData.ResultCh, examineData.SaveResults)
+	case statemachine.OperationTypeRestore:
+		return e.executeRestore(ctx, operation.(statemachine.RestoreVariant))
+	case statemachine.OperationTypeExportArchive:
+		return e.executeExportArchive(ctx, operation.(statemachine.ExportArchiveVariant))
+	case statemachine.OperationTypeIndexFiles:
+		return e.executeIndexFiles(ctx, operation.(statemachine.IndexFilesVariant))
+	case statemachine.OperationTypeImportTar:
+		return e.executeImportTar(ctx, operation.(statemachine.ImportTarVariant))
 	default:
 		assert.Fail("Unhandled OperationType in borgOperationExecutor.Execute")
 		return nil, fmt.Errorf("unsupported operation type: %T", operation)
@@ -1338,11 +1621,33 @@ func (e *borgOperationExecutor) executeBackup(ctx context.Context, backupOp stat
 	repo := profile.Edges.Repositories[0] // Should be exactly the repository we requested
 
 	backupPaths := profile.BackupPaths
-	excludePaths := profile.ExcludePaths
-	excludeCaches := profile.ExcludeCaches
 	prefix := profile.Prefix
-	compressionMode := profile.CompressionMode
-	compressionLevel := profile.CompressionLevel
+
+	var contentCommand *borgtypes.ContentCommand
+	if profile.ContentCommand != "" {
+		contentCommand = &borgtypes.ContentCommand{
+			Command:   profile.ContentCommand,
+			StdinName: profile.ContentStdinName,
+		}
+	}
+
+	createOptions := borgtypes.CreateOptions{
+		ExcludePaths:       profile.ExcludePaths,
+		Patterns:           profile.Patterns,
+		ExcludeCaches:      profile.ExcludeCaches,
+		ExcludeIfPresent:   profile.ExcludeIfPresent,
+		UseIgnoreFiles:     profile.UseIgnoreFiles,
+		CompressionMode:    profile.CompressionMode,
+		CompressionLevel:   profile.CompressionLevel,
+		OneFileSystem:      profile.OneFileSystem,
+		NumericIds:         profile.NumericIds,
+		NoFlags:            profile.NoFlags,
+		NoAcls:             profile.NoAcls,
+		NoXattrs:           profile.NoXattrs,
+		Sparse:             profile.Sparse,
+		ReadSpecial:        profile.ReadSpecial,
+		CheckpointInterval: profile.CheckpointInterval,
+	}
 
 	// Get password from keyring
 	password, err := e.getRepoPassword()
@@ -1350,6 +1655,20 @@ func (e *borgOperationExecutor) executeBackup(ctx context.Context, backupOp stat
 		return nil, fmt.Errorf("failed to get repository password: %w", err)
 	}
 
+	// Run pre-backup hooks (e.g. dump databases or stop containers)
+	hooks, err := e.getBackupHooks(ctx, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+	if status := e.runPreBackupHooks(ctx, hooks, hookEnv(profile, repo, "", nil)); status != nil {
+		e.runPostBackupHooks(ctx, hooks, hookEnv(profile, repo, "", status), status)
+		return status, nil
+	}
+
+	// Estimate the size of the backup from the previous archive (instead of counting files with a dry-run)
+	estimate := e.getBackupEstimate(ctx, profile.ID, repo.ID)
+	e.progressUpdater.StartBackupProgress(e.operationID, estimate)
+
 	// Create progress channel
 	progressCh := make(chan borgtypes.BackupProgress, 100)
 
@@ -1357,7 +1676,12 @@ func (e *borgOperationExecutor) executeBackup(ctx context.Context, backupOp stat
 	go e.monitorBackupProgress(ctx, progressCh)
 
 	// Execute borg create command
-	archivePath, status := e.borgClient.Create(ctx, repo.URL, password, prefix, backupPaths, excludePaths, excludeCaches, compressionMode, compressionLevel, progressCh)
+	archivePath, status := e.borgClient.Create(ctx, repo.URL, password, prefix, backupPaths, createOptions, contentCommand, estimate, progressCh)
+
+	// Run post-backup hooks (e.g. restart containers) before the archive is processed
+	_, archiveName, _ := strings.Cut(archivePath, "::")
+	e.runPostBackupHooks(ctx, hooks, hookEnv(profile, repo, archiveName, status), status)
+
 	if !status.IsCompletedWithSuccess() {
 		return status, nil
 	}
@@ -1379,7 +1703,7 @@ func (e *borgOperationExecutor) executeBackup(ctx context.Context, backupOp stat
 	}
 
 	// Refresh repository stats from borg info
-	err = e.refreshRepositoryStats(ctx, e.repoID)
+	err = e.refreshRepositoryStats(ctx, e.repoID, true)
 	if err != nil {
 		e.log.Errorw("Failed to refresh repository stats after backup",
 			"repoID", e.repoID,
@@ -1392,6 +1716,90 @@ func (e *borgOperationExecutor) executeBackup(ctx context.Context, backupOp stat
 	return status, nil
 }
 
+// executeImportTar performs a borg import-tar operation to create an archive from a tar file
+func (e *borgOperationExecutor) executeImportTar(ctx context.Context, importOp statemachine.ImportTarVariant) (*borgtypes.Status, error) {
+	importData := importOp()
+
+	// Get backup profile with repository data in a single query
+	profile, err := e.db.BackupProfile.Query().
+		Where(
+			backupprofile.ID(importData.BackupID.BackupProfileId),
+			backupprofile.HasRepositoriesWith(repository.ID(importData.BackupID.RepositoryId)),
+		).
+		WithRepositories(func(q *ent.RepositoryQuery) {
+			q.Where(repository.ID(importData.BackupID.RepositoryId))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("backup profile %d not found: %w", importData.BackupID.BackupProfileId, err)
+	}
+	repo := profile.Edges.Repositories[0]
+
+	// Get password from keyring
+	password, err := e.getRepoPassword()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository password: %w", err)
+	}
+
+	e.log.Infow("Starting tar import",
+		"repoID", repo.ID,
+		"backupProfileID", profile.ID,
+		"tarPath", importData.TarPath)
+
+	// Execute borg import-tar command
+	archivePath, status := e.borgClient.ImportTar(ctx, repo.URL, password, profile.Prefix, importData.TarPath, importData.Comment, importData.Timestamp, profile.CompressionMode, profile.CompressionLevel)
+	if !status.IsCompletedWithSuccess() {
+		return status, nil
+	}
+
+	// Capture warning message from status if present
+	var warningMessage string
+	if status.HasWarning() {
+		warningMessage = fmt.Sprintf("%s (Exit Code: %d)", status.Warning.Message, status.Warning.ExitCode)
+	}
+
+	// Add the imported archive to the database (it belongs to the backup profile through its prefix)
+	err = e.refreshNewArchive(ctx, repo, archivePath, warningMessage)
+	if err != nil {
+		e.log.Errorw("Failed to refresh archive after import",
+			"archivePath", archivePath,
+			"repoID", e.repoID,
+			"error", err.Error())
+		// Don't fail import operation for refresh errors
+	}
+
+	// Refresh repository stats from borg info
+	err = e.refreshRepositoryStats(ctx, e.repoID, false)
+	if err != nil {
+		e.log.Errorw("Failed to refresh repository stats after import",
+			"repoID", e.repoID,
+			"error", err.Error())
+		// Don't fail import operation for stats refresh errors
+	}
+
+	// Return status directly (preserves rich error information)
+	return status, nil
+}
+
+// getBackupEstimate returns the expected size and duration of a backup from the previous archives of the backup profile.
+// File count and size are taken from the newest archive with known stats, the duration is averaged.
+// Returns an empty estimate (progress without totals) if there are no previous archives.
+func (e *borgOperationExecutor) getBackupEstimate(ctx context.Context, backupProfileID, repoID int) borgtypes.BackupEstimate {
+	previous, err := e.db.Archive.Query().
+		Where(
+			archive.HasBackupProfileWith(backupprofile.ID(backupProfileID)),
+			archive.HasRepositoryWith(repository.ID(repoID)),
+		).
+		Order(ent.Desc(archive.FieldCreatedAt)).
+		Limit(backupEstimateArchives).
+		All(ctx)
+	if err != nil {
+		e.log.Warnw("Failed to get previous archives for backup estimate", "repoID", repoID, "error", err.Error())
+		return borgtypes.BackupEstimate{}
+	}
+	return estimateBackup(previous)
+}
+
 // monitorBackupProgress monitors backup progress and updates operation status
 func (e *borgOperationExecutor) monitorBackupProgress(ctx context.Context, progressCh <-chan borgtypes.BackupProgress) {
 	for {
@@ -1579,7 +1987,7 @@ func (e *borgOperationExecutor) executePrune(ctx context.Context, backupID types
 		}
 
 		// Refresh repository stats from borg info
-		err := e.refreshRepositoryStats(ctx, repo.ID)
+		err := e.refreshRepositoryStats(ctx, repo.ID, true)
 		if err != nil {
 			e.log.Errorw("Failed to refresh repository stats after prune",
 				"repoID", repo.ID,
@@ -1737,7 +2145,7 @@ func (e *borgOperationExecutor) executeArchiveRefresh(ctx context.Context, refre
 	}
 
 	// Refresh repository stats from borg info
-	err = e.refreshRepositoryStats(ctx, refreshData.RepositoryID)
+	err = e.refreshRepositoryStats(ctx, refreshData.RepositoryID, false)
 	if err != nil {
 		e.log.Errorw("Failed to refresh repository stats after archive refresh",
 			"repoID", refreshData.RepositoryID,
@@ -1768,7 +2176,17 @@ func (e *borgOperationExecutor) executeCheck(ctx context.Context, checkOp statem
 	}
 
 	// Execute borg check command
-	result := e.borgClient.Check(ctx, repo.URL, password, checkData.QuickVerification)
+	var result *borgtypes.CheckResult
+	var dataCheck *dataCheckResult
+	if checkData.QuickVerification {
+		result = e.borgClient.Check(ctx, repo.URL, password, true, nil)
+	} else {
+		dataCheck, err = e.runDataCheck(ctx, repo, password, checkData)
+		if err != nil {
+			return nil, err
+		}
+		result = dataCheck.result
+	}
 
 	// Extract error messages for logging and storage
 	errorMessages := make([]string, 0, len(result.ErrorLogs))
@@ -1795,14 +2213,201 @@ func (e *borgOperationExecutor) executeCheck(ctx context.Context, checkOp statem
 			SetLastQuickCheckAt(now).
 			SetQuickCheckError(errorMessages) // Empty array if no errors
 	} else {
-		// Update full check fields
+		// Update full check fields (partial checks count as full checks for scheduling)
 		updateQuery = updateQuery.
 			SetLastFullCheckAt(now).
-			SetFullCheckError(errorMessages) // Empty array if no errors
+			SetFullCheckError(errorMessages). // Empty array if no errors
+			SetNillableCheckCycleStartedAt(dataCheck.cycleStartedAt)
 	}
 
 	// Save updates
-	return result.Status, updateQuery.Exec(ctx)
+	if err := updateQuery.Exec(ctx); err != nil {
+		return result.Status, err
+	}
+	if dataCheck != nil && len(dataCheck.verifiedIds) > 0 {
+		err = e.db.Archive.Update().
+			Where(archive.IDIn(dataCheck.verifiedIds...)).
+			SetDataVerifiedAt(now).
+			Exec(ctx)
+		if err != nil {
+			return result.Status, fmt.Errorf("failed to save verified archives: %w", err)
+		}
+		e.eventEmitter.EmitEvent(ctx, types.EventArchivesChangedString(repo.ID))
+	}
+	return result.Status, nil
+}
+
+// executeRestore performs a borg extract operation to restore archive contents into a directory
+func (e *borgOperationExecutor) executeRestore(ctx context.Context, restoreOp statemachine.RestoreVariant) (*borgtypes.Status, error) {
+	restoreData := restoreOp()
+
+	// Get archive from database to get repository and archive name
+	archiveEntity, err := e.db.Archive.Query().
+		Where(archive.ID(restoreData.ArchiveID)).
+		WithRepository().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("archive %d not found: %w", restoreData.ArchiveID, err)
+	}
+
+	// Get repository from archive relationship
+	repo := archiveEntity.Edges.Repository
+
+	// Make sure the destination exists before borg changes into it
+	if err := ensurePathExists(restoreData.Destination); err != nil {
+		return nil, fmt.Errorf("failed to create restore destination %s: %w", restoreData.Destination, err)
+	}
+
+	// Get password from keyring
+	password, err := e.keyring.GetRepositoryPassword(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository password: %w", err)
+	}
+
+	e.log.Infow("Starting restore",
+		"repoID", repo.ID,
+		"archive", archiveEntity.Name,
+		"destination", restoreData.Destination,
+		"paths", restoreData.Paths)
+
+	// Without conflict handling borg simply overwrites existing files
+	if restoreData.ConflictPolicy == "" || restoreData.ConflictPolicy == statemachine.RestoreConflictPolicyOverwrite {
+		return e.extract(ctx, repo.URL, archiveEntity.Name, password, restoreData.Destination, restoreData.Paths, restoreData.ExcludePaths, restoreData.StripComponents), nil
+	}
+
+	// Decide per file what to do with existing files at the destination (only conflicting files are kept)
+	plan, status := streamRestorePlan(ctx, e.borgClient, repo.URL, archiveEntity.Name, password, restoreData, restoreKeepBothSuffix(archiveEntity.CreatedAt), true)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return status, nil
+	}
+
+	// Extract everything that is created or overwritten in place
+	excludePaths := append(slices.Clone(restoreData.ExcludePaths), plan.excludePatterns()...)
+	status = e.extract(ctx, repo.URL, archiveEntity.Name, password, restoreData.Destination, restoreData.Paths, excludePaths, restoreData.StripComponents)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return status, nil
+	}
+
+	keepBothEntries := plan.keepBothEntries()
+	if len(keepBothEntries) == 0 {
+		return status, nil
+	}
+
+	// Files kept next to existing ones are extracted separately into a temporary directory and moved to their final name
+	tmpDir, err := os.MkdirTemp("", "arco-restore-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary restore directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	keepBothPaths := make([]string, 0, len(keepBothEntries))
+	for _, entry := range keepBothEntries {
+		keepBothPaths = append(keepBothPaths, "pf:"+entry.ArchivePath)
+	}
+	status = e.extract(ctx, repo.URL, archiveEntity.Name, password, tmpDir, keepBothPaths, restoreData.ExcludePaths, restoreData.StripComponents)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return status, nil
+	}
+
+	for _, entry := range keepBothEntries {
+		relPath, _ := stripArchivePath(entry.ArchivePath, restoreData.StripComponents)
+		if err := moveFile(filepath.Join(tmpDir, filepath.FromSlash(relPath)), entry.TargetPath); err != nil {
+			return nil, fmt.Errorf("failed to restore %s to %s: %w", entry.ArchivePath, entry.TargetPath, err)
+		}
+	}
+
+	return status, nil
+}
+
+// extract runs a borg extract command and reports its progress
+func (e *borgOperationExecutor) extract(ctx context.Context, repoURL, archiveName, password, destination string, paths, excludePaths []string, stripComponents int) *borgtypes.Status {
+	// Create progress channel
+	progressCh := make(chan borgtypes.ExtractProgress, 100)
+
+	// Start progress monitoring in background
+	go e.monitorRestoreProgress(ctx, progressCh)
+
+	// Execute borg extract command
+	// Return status directly (preserves rich error information)
+	return e.borgClient.Extract(ctx, repoURL, archiveName, password, destination, paths, excludePaths, stripComponents, progressCh)
+}
+
+// monitorRestoreProgress monitors restore progress and updates operation status
+func (e *borgOperationExecutor) monitorRestoreProgress(ctx context.Context, progressCh <-chan borgtypes.ExtractProgress) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case progress, ok := <-progressCh:
+			if !ok {
+				return // Channel closed
+			}
+			err := e.progressUpdater.UpdateRestoreProgress(ctx, e.operationID, progress)
+			if err != nil {
+				e.log.Errorw("Failed to update operation progress", "operationID", e.operationID, "error", err.Error())
+			}
+		}
+	}
+}
+
+// executeExportArchive performs a borg export-tar operation to write archive contents into a tar file
+func (e *borgOperationExecutor) executeExportArchive(ctx context.Context, exportOp statemachine.ExportArchiveVariant) (*borgtypes.Status, error) {
+	exportData := exportOp()
+
+	// Get archive from database to get repository and archive name
+	archiveEntity, err := e.db.Archive.Query().
+		Where(archive.ID(exportData.ArchiveID)).
+		WithRepository().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("archive %d not found: %w", exportData.ArchiveID, err)
+	}
+
+	// Get repository from archive relationship
+	repo := archiveEntity.Edges.Repository
+
+	// Get password from keyring
+	password, err := e.keyring.GetRepositoryPassword(repo.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository password: %w", err)
+	}
+
+	e.log.Infow("Starting export",
+		"repoID", repo.ID,
+		"archive", archiveEntity.Name,
+		"outputPath", exportData.OutputPath,
+		"format", exportData.Format,
+		"paths", exportData.Paths)
+
+	// Create progress channel
+	progressCh := make(chan borgtypes.ExtractProgress, 100)
+
+	// Start progress monitoring in background
+	go e.monitorExportProgress(ctx, progressCh)
+
+	// Execute borg export-tar command
+	status := e.borgClient.ExportTar(ctx, repo.URL, archiveEntity.Name, password, exportData.OutputPath, exportData.Format, exportData.Paths, exportData.ExcludePaths, progressCh)
+
+	// Return status directly (preserves rich error information)
+	return status, nil
+}
+
+// monitorExportProgress monitors export progress and updates operation status
+func (e *borgOperationExecutor) monitorExportProgress(ctx context.Context, progressCh <-chan borgtypes.ExtractProgress) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case progress, ok := <-progressCh:
+			if !ok {
+				return // Channel closed
+			}
+			err := e.progressUpdater.UpdateExportProgress(ctx, e.operationID, progress)
+			if err != nil {
+				e.log.Errorw("Failed to update operation progress", "operationID", e.operationID, "error", err.Error())
+			}
+		}
+	}
 }
 
 // executeArchiveRename performs a borg rename operation
@@ -2102,6 +2707,14 @@ func (e *borgOperationExecutor) syncArchivesToDatabase(ctx context.Context, repo
 		e.eventEmitter.EmitEvent(ctx, types.EventArchivesChangedString(repositoryID))
 	}
 
+	// Fetch the stats of the newest archives without stats (archives created before stats were stored are backfilled batch by batch)
+	if _, err := e.updateArchiveStats(ctx, repositoryID); err != nil {
+		e.log.Errorw("Failed to update archive stats",
+			"repositoryID", repositoryID,
+			"error", err.Error())
+		// Don't fail the sync for stats errors
+	}
+
 	return nil
 }
 
@@ -2222,11 +2835,18 @@ func (e *borgOperationExecutor) refreshNewArchive(ctx context.Context, repo *ent
 	}
 
 	// Sync only the single archive (no deletions)
-	return e.syncSingleArchiveToDatabase(ctx, e.repoID, listResponse.Archives[0], warningMessage)
+	err = e.syncSingleArchiveToDatabase(ctx, e.repoID, listResponse.Archives[0], warningMessage)
+	if err != nil {
+		return err
+	}
+
+	// Fetch the stats of the new archive
+	return e.updateNewArchiveStats(ctx, repo, password, archiveName)
 }
 
-// refreshRepositoryStats calls borg info to update repository statistics for local/remote repositories
-func (e *borgOperationExecutor) refreshRepositoryStats(ctx context.Context, repoID int) error {
+// refreshRepositoryStats calls borg info to update repository statistics for local/remote repositories.
+// saveSnapshot records the stats in the growth history; only backups and prunes save one.
+func (e *borgOperationExecutor) refreshRepositoryStats(ctx context.Context, repoID int, saveSnapshot bool) error {
 	// Get repository from database
 	repo, err := e.db.Repository.Get(ctx, repoID)
 	if err != nil {
@@ -2259,6 +2879,13 @@ func (e *borgOperationExecutor) refreshRepositoryStats(ctx context.Context, repo
 		return fmt.Errorf("failed to update repository stats: %w", err)
 	}
 
+	// Keep a snapshot of the stats to track the growth of the repository
+	if saveSnapshot {
+		if err := e.saveRepositoryStatsSnapshot(ctx, repoID, infoResponse.Cache.Stats); err != nil {
+			return err
+		}
+	}
+
 	e.log.Debugw("Refreshed repository stats",
 		"repoID", repoID,
 		"uniqueSize", infoResponse.Cache.Stats.UniqueSize,
@@ -2310,7 +2937,7 @@ func (qm *QueueManager) mapOperationErrorResponse(ctx context.Context, borgError
 
 	// Now determine operation-specific behavior
 	switch statemachine.GetOperationType(operation) {
-	case statemachine.OperationTypeBackup, statemachine.OperationTypePrune, statemachine.OperationTypeCheck:
+	case statemachine.OperationTypeBackup, statemachine.OperationTypePrune, statemachine.OperationTypeCheck, statemachine.OperationTypeRestore, statemachine.OperationTypeExportArchive, statemachine.OperationTypeImportTar:
 		// Critical operations - full error handling with persistent notifications
 		return OperationErrorResponse{
 			ErrorType:                 errorType,
@@ -2329,7 +2956,8 @@ func (qm *QueueManager) mapOperationErrorResponse(ctx context.Context, borgError
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeIndexFiles:
 
 		// Default is to notify but not enter error state and not persist the errors
 		return OperationErrorResponse{
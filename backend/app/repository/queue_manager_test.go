@@ -3,7 +3,10 @@ package repository
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/loomi-labs/arco/backend/app/analytics"
 	"github.com/loomi-labs/arco/backend/app/keyring"
@@ -13,7 +16,9 @@ import (
 	"github.com/loomi-labs/arco/backend/borg/mocks"
 	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/enttest"
+	backendtypes "github.com/loomi-labs/arco/backend/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/wailsapp/wails/v3/pkg/application"
 	"go.uber.org/mock/gomock"
@@ -48,7 +53,7 @@ func newTestQueueManager(t *testing.T) (*QueueManager, *ent.Client, context.Cont
 	// Use AnyTimes() to allow any number of calls without failing
 	mockBorgClient.EXPECT().Info(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&borgtypes.InfoResponse{}, &borgtypes.Status{}).AnyTimes()
-	mockBorgClient.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+	mockBorgClient.EXPECT().Create(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return("test-archive", &borgtypes.Status{}).AnyTimes()
 	mockBorgClient.EXPECT().Prune(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&borgtypes.Status{}).AnyTimes()
@@ -56,6 +61,12 @@ func newTestQueueManager(t *testing.T) (*QueueManager, *ent.Client, context.Cont
 		Return(&borgtypes.Status{}).AnyTimes()
 	mockBorgClient.EXPECT().DeleteArchive(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(&borgtypes.Status{}).AnyTimes()
+	mockBorgClient.EXPECT().Extract(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&borgtypes.Status{}).AnyTimes()
+	mockBorgClient.EXPECT().ExportTar(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&borgtypes.Status{}).AnyTimes()
+	mockBorgClient.EXPECT().ImportTar(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return("", &borgtypes.Status{}).AnyTimes()
 
 	// Create mock event emitter
 	mockEmitter := typesmocks.NewMockEventEmitter(ctrl)
@@ -461,6 +472,127 @@ func TestAddOperation_StateTransitionsWhenOperationStarts(t *testing.T) {
 		"BackingUp state should reference the started backup")
 }
 
+// TestAddOperation_RestoreTransitionsToRestoring verifies that a restore operation
+// is treated as a heavy operation and moves the repository into the Restoring state.
+func TestAddOperation_RestoreTransitionsToRestoring(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const archiveID = 10
+
+	// Create test data
+	createTestRepository(t, db, ctx, repoID)
+
+	// ACT - Add a restore operation (should start immediately)
+	op := &QueuedOperation{
+		Operation: statemachine.NewOperationRestore(statemachine.Restore{
+			ArchiveID:   archiveID,
+			Destination: t.TempDir(),
+		}),
+		Status:    NewOperationStatusQueued(Queued{}),
+		Immediate: false,
+	}
+
+	_, err := qm.AddOperation(repoID, op)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, statemachine.WeightHeavy, statemachine.GetOperationWeight(op.Operation),
+		"Restore should be a heavy operation")
+
+	// Verify repository state transitioned to Restoring
+	currentState := qm.GetRepositoryState(repoID)
+	assert.Equal(t, statemachine.RepositoryStateTypeRestoring, statemachine.GetRepositoryStateType(currentState),
+		"Repository state should transition to Restoring when restore starts")
+
+	// Verify the Restoring state references the archive
+	restoringVariant := currentState.(statemachine.RestoringVariant)
+	assert.Equal(t, archiveID, restoringVariant().ArchiveID,
+		"Restoring state should reference the restored archive")
+}
+
+// TestAddOperation_ExportTransitionsToExporting verifies that an export operation
+// is treated as a heavy operation and moves the repository into the Exporting state.
+func TestAddOperation_ExportTransitionsToExporting(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const archiveID = 10
+
+	// Create test data
+	createTestRepository(t, db, ctx, repoID)
+
+	// ACT - Add an export operation (should start immediately)
+	op := &QueuedOperation{
+		Operation: statemachine.NewOperationExportArchive(statemachine.ExportArchive{
+			ArchiveID:  archiveID,
+			OutputPath: filepath.Join(t.TempDir(), "archive.tar.gz"),
+			Format:     borgtypes.TarFormatTarGz,
+		}),
+		Status:    NewOperationStatusQueued(Queued{}),
+		Immediate: false,
+	}
+
+	_, err := qm.AddOperation(repoID, op)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, statemachine.WeightHeavy, statemachine.GetOperationWeight(op.Operation),
+		"Export should be a heavy operation")
+
+	// Verify repository state transitioned to Exporting
+	currentState := qm.GetRepositoryState(repoID)
+	assert.Equal(t, statemachine.RepositoryStateTypeExporting, statemachine.GetRepositoryStateType(currentState),
+		"Repository state should transition to Exporting when export starts")
+
+	// Verify the Exporting state references the archive
+	exportingVariant := currentState.(statemachine.ExportingVariant)
+	assert.Equal(t, archiveID, exportingVariant().ArchiveID,
+		"Exporting state should reference the exported archive")
+}
+
+// TestAddOperation_ImportTransitionsToImporting verifies that a tar import
+// is treated as a heavy operation and moves the repository into the Importing state.
+func TestAddOperation_ImportTransitionsToImporting(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	backupID := types.BackupId{BackupProfileId: 5, RepositoryId: repoID}
+
+	// Create test data
+	createTestRepository(t, db, ctx, repoID)
+
+	// ACT - Add an import operation (should start immediately)
+	op := &QueuedOperation{
+		Operation: statemachine.NewOperationImportTar(statemachine.ImportTar{
+			BackupID: backupID,
+			TarPath:  filepath.Join(t.TempDir(), "legacy.tar"),
+		}),
+		Status:    NewOperationStatusQueued(Queued{}),
+		Immediate: false,
+	}
+
+	_, err := qm.AddOperation(repoID, op)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Equal(t, statemachine.WeightHeavy, statemachine.GetOperationWeight(op.Operation),
+		"Import should be a heavy operation")
+
+	// Verify repository state transitioned to Importing
+	currentState := qm.GetRepositoryState(repoID)
+	assert.Equal(t, statemachine.RepositoryStateTypeImporting, statemachine.GetRepositoryStateType(currentState),
+		"Repository state should transition to Importing when import starts")
+
+	// Verify the Importing state references the backup
+	importingVariant := currentState.(statemachine.ImportingVariant)
+	assert.Equal(t, backupID, importingVariant().BackupID,
+		"Importing state should reference the backup profile of the import")
+}
+
 // TestAddOperation_StateSetToQueuedWithoutActiveOperation verifies that repository state
 // transitions to Queued when an operation can't start due to concurrency limits.
 func TestAddOperation_StateSetToQueuedWithoutActiveOperation(t *testing.T) {
@@ -767,3 +899,274 @@ func TestAddOperation_Integration_MultipleRepositories(t *testing.T) {
 	// Verify global state
 	assert.Len(t, qm.activeHeavy, 2, "Should maintain 2 active heavy operations")
 }
+
+// createTestBackupScheduleWithBlackout creates an enabled backup schedule with a blackout period of two hours around now
+func createTestBackupScheduleWithBlackout(t *testing.T, db *ent.Client, ctx context.Context, backupProfileID int) {
+	now := time.Now()
+	_, err := db.BackupSchedule.Create().
+		SetMode(backupschedule.ModeDaily).
+		SetDailyAt(now).
+		SetWeekday(backupschedule.WeekdayMonday).
+		SetWeeklyAt(now).
+		SetMonthday(1).
+		SetMonthlyAt(now).
+		SetBlackoutPeriods([]backendtypes.TimeWindow{{
+			Start: now.Add(-time.Hour).Format("15:04"),
+			End:   now.Add(time.Hour).Format("15:04"),
+		}}).
+		SetBackupProfileID(backupProfileID).
+		Save(ctx)
+	assert.NoError(t, err)
+}
+
+// TestAddOperation_BackupHeldDuringBlackoutPeriod verifies that a backup is held with a
+// reason while the blackout period of its backup schedule is active.
+func TestAddOperation_BackupHeldDuringBlackoutPeriod(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	queue := qm.GetQueue(repoID)
+	op := queue.CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+	op.Scheduled = true
+
+	// ACT
+	operationID, err := qm.AddOperation(repoID, op)
+	t.Cleanup(func() {
+		qm.holdTimers[repoID].Stop()
+	})
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.False(t, queue.HasActiveOperation(), "Backup should be held during the blackout period")
+
+	held := queue.GetOperationByID(operationID)
+	assert.NotNil(t, held)
+	serialized := toSerializableQueuedOperation(held)
+	assert.Contains(t, serialized.HeldReason, "Blackout period until")
+	assert.NotNil(t, serialized.HeldUntil)
+	assert.True(t, serialized.HeldUntil.After(time.Now()), "Backup should be held until the blackout period is over")
+}
+
+// TestAddOperation_ImmediateBackupFailsDuringBlackoutPeriod verifies that an immediate
+// backup fails with the hold reason while the blackout period of its backup schedule is active.
+func TestAddOperation_ImmediateBackupFailsDuringBlackoutPeriod(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	op := qm.GetQueue(repoID).CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		true,
+	)
+	op.Scheduled = true
+
+	// ACT
+	_, err := qm.AddOperation(repoID, op)
+
+	// ASSERT
+	assert.ErrorContains(t, err, "Blackout period until")
+}
+
+// TestCanStartOperation_OnlyChecksConcurrencyLimits verifies that checking an operation neither
+// holds it nor arms a hold timer, the start window is only applied when the queue is processed.
+func TestCanStartOperation_OnlyChecksConcurrencyLimits(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	queue := qm.GetQueue(repoID)
+	op := queue.CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+	op.Scheduled = true
+
+	// ACT
+	canStart := qm.CanStartOperation(repoID, op)
+
+	// ASSERT
+	assert.True(t, canStart)
+	assert.Empty(t, toSerializableQueuedOperation(op).HeldReason)
+	assert.Empty(t, qm.holdTimers)
+}
+
+// TestAddOperation_RestoreStartsBehindHeldBackup verifies that a held backup stays in the queue
+// while the operations queued behind it start.
+func TestAddOperation_RestoreStartsBehindHeldBackup(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	queue := qm.GetQueue(repoID)
+	backupOp := queue.CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+	backupOp.Scheduled = true
+	backupOpID, err := qm.AddOperation(repoID, backupOp)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		qm.holdTimers[repoID].Stop()
+	})
+
+	restoreOp := queue.CreateQueuedOperation(
+		statemachine.NewOperationRestore(statemachine.Restore{ArchiveID: 500, Destination: t.TempDir()}),
+		repoID,
+		nil,
+		nil,
+		false,
+	)
+
+	// ACT
+	restoreOpID, err := qm.AddOperation(repoID, restoreOp)
+
+	// ASSERT
+	assert.NoError(t, err)
+	queued := queue.GetQueuedOperations(nil)
+	assert.Len(t, queued, 1, "Only the held backup should be left in the queue")
+	assert.Equal(t, backupOpID, queued[0].ID)
+	assert.NotEmpty(t, queued[0].HeldReason)
+	restore := queue.GetOperationByID(restoreOpID)
+	if restore != nil {
+		_, isQueued := restore.Status.(QueuedVariant)
+		assert.False(t, isQueued, "Restore should have started")
+	}
+}
+
+// TestAddOperation_ManualBackupIgnoresBlackoutPeriod verifies that a backup started by the user
+// is not held by the blackout period of its backup schedule.
+func TestAddOperation_ManualBackupIgnoresBlackoutPeriod(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	const backupProfileID = 100
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: backupProfileID}
+
+	createTestRepository(t, db, ctx, repoID)
+	createTestBackupProfile(t, db, ctx, backupProfileID, repoID)
+	createTestBackupScheduleWithBlackout(t, db, ctx, backupProfileID)
+
+	queue := qm.GetQueue(repoID)
+	op := queue.CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+
+	// ACT
+	_, err := qm.AddOperation(repoID, op)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Empty(t, queue.GetQueuedOperations(nil), "Manual backup should have started")
+	assert.Empty(t, qm.holdTimers)
+}
+
+// TestAddOperation_BackupPreemptsFileIndex tests that a backup doesn't wait for an active file index operation.
+// The index operation is canceled and indexing continues after the backup.
+func TestAddOperation_BackupPreemptsFileIndex(t *testing.T) {
+	// ARRANGE
+	qm, db, ctx, _ := newTestQueueManager(t)
+
+	const repoID = 1
+	backupID := types.BackupId{RepositoryId: repoID, BackupProfileId: 100}
+
+	createTestRepository(t, db, ctx, repoID)
+	db.Repository.UpdateOneID(repoID).SetFileIndexEnabled(true).ExecX(ctx)
+	db.Archive.Create().
+		SetName("archive").
+		SetBorgID("borg-id").
+		SetDuration(1).
+		SetRepositoryID(repoID).
+		ExecX(ctx)
+	assert.NoError(t, qm.keyring.SetRepositoryPassword(repoID, "password"))
+
+	// The first index operation runs until it is canceled, later ones complete right away
+	indexStarted := make(chan struct{})
+	var calls atomic.Int32
+	qm.borg.(*mocks.MockBorg).EXPECT().ListFiles(gomock.Any(), gomock.Any(), "archive", "password", gomock.Any()).
+		DoAndReturn(func(ctx context.Context, _, _, _ string, _ []string) ([]borgtypes.ArchiveItem, *borgtypes.Status) {
+			if calls.Add(1) == 1 {
+				close(indexStarted)
+				<-ctx.Done()
+				return nil, &borgtypes.Status{HasBeenCanceled: true}
+			}
+			return nil, &borgtypes.Status{}
+		}).AnyTimes()
+
+	qm.queueFileIndex(repoID)
+	<-indexStarted
+	indexOp := qm.GetActiveOperation(repoID, nil)
+	assert.NotNil(t, indexOp)
+
+	queue := qm.GetQueue(repoID)
+	backupOp := queue.CreateQueuedOperation(
+		statemachine.NewOperationBackup(statemachine.Backup{BackupID: backupID}),
+		repoID,
+		&backupID.BackupProfileId,
+		nil,
+		false,
+	)
+
+	// ACT
+	backupOpID, err := qm.AddOperation(repoID, backupOp)
+
+	// ASSERT
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		for _, op := range queue.GetQueuedOperations(nil) {
+			if op.ID == backupOpID {
+				return false
+			}
+		}
+		return true
+	}, time.Second, 10*time.Millisecond, "Backup should start after the index operation is canceled")
+	assert.Nil(t, queue.GetOperationByID(indexOp.ID), "Canceled index operation should be removed")
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+	"github.com/loomi-labs/arco/backend/util"
+)
+
+const (
+	// maxFullByProjection limits how far into the future a "full by" date is projected
+	maxFullByProjection = 100 * 365 * 24 * time.Hour
+	// statsSnapshotFullRetention is how long every stats snapshot is kept; older snapshots are thinned out to one per day
+	statsSnapshotFullRetention = 30 * 24 * time.Hour
+)
+
+// GetRepositoryGrowth returns the storage usage history of a repository within the last days
+// and projects when the target runs out of space (only for local repositories)
+func (s *Service) GetRepositoryGrowth(ctx context.Context, repoId int, days int) (*RepositoryGrowth, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("days must be positive")
+	}
+
+	repo, err := s.db.Repository.Get(ctx, repoId)
+	if err != nil {
+		return nil, fmt.Errorf("repository %d not found: %w", repoId, err)
+	}
+
+	now := time.Now()
+	from := now.AddDate(0, 0, -days)
+	snapshots, err := s.db.RepositoryStatsSnapshot.Query().
+		Where(
+			repositorystatssnapshot.RepositoryID(repoId),
+			repositorystatssnapshot.CreatedAtGTE(from),
+		).
+		Order(ent.Asc(repositorystatssnapshot.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query repository stats snapshots: %w", err)
+	}
+
+	// Free space is only known for repositories on a local file system
+	var freeSpace *int64
+	if strings.HasPrefix(repo.URL, "/") {
+		free, err := util.FreeSpace(repo.URL)
+		if err != nil {
+			s.log.Warnw("Failed to get free space of repository", "repoID", repoId, "error", err.Error())
+		} else {
+			freeSpace = &free
+		}
+	}
+
+	growth := buildRepositoryGrowth(snapshots, freeSpace, now)
+	growth.RepositoryId = repoId
+	growth.From = from
+	return growth, nil
+}
+
+// saveRepositoryStatsSnapshot records the stats of a repository in its growth history.
+// Stats that didn't change since the last snapshot are skipped and snapshots older than statsSnapshotFullRetention are thinned out.
+func (e *borgOperationExecutor) saveRepositoryStatsSnapshot(ctx context.Context, repoID int, stats borgtypes.Stats) error {
+	last, err := e.db.RepositoryStatsSnapshot.Query().
+		Where(repositorystatssnapshot.RepositoryID(repoID)).
+		Order(ent.Desc(repositorystatssnapshot.FieldCreatedAt)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to query last repository stats snapshot: %w", err)
+	}
+	if last != nil &&
+		last.TotalSize == stats.TotalSize &&
+		last.TotalCsize == stats.TotalCSize &&
+		last.UniqueSize == stats.UniqueSize &&
+		last.UniqueCsize == stats.UniqueCSize {
+		return nil
+	}
+
+	err = e.db.RepositoryStatsSnapshot.Create().
+		SetTotalSize(stats.TotalSize).
+		SetTotalCsize(stats.TotalCSize).
+		SetUniqueSize(stats.UniqueSize).
+		SetUniqueCsize(stats.UniqueCSize).
+		SetRepositoryID(repoID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save repository stats snapshot: %w", err)
+	}
+	return e.thinOutRepositoryStatsSnapshots(ctx, repoID, time.Now().Add(-statsSnapshotFullRetention))
+}
+
+// thinOutRepositoryStatsSnapshots keeps only the last snapshot of each day for snapshots created before the given time
+func (e *borgOperationExecutor) thinOutRepositoryStatsSnapshots(ctx context.Context, repoID int, before time.Time) error {
+	old, err := e.db.RepositoryStatsSnapshot.Query().
+		Where(
+			repositorystatssnapshot.RepositoryID(repoID),
+			repositorystatssnapshot.CreatedAtLT(before),
+		).
+		Order(ent.Desc(repositorystatssnapshot.FieldCreatedAt)).
+		Select(repositorystatssnapshot.FieldID, repositorystatssnapshot.FieldCreatedAt).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query old repository stats snapshots: %w", err)
+	}
+
+	var toDelete []int
+	var lastDay time.Time
+	for _, snapshot := range old {
+		y, m, d := snapshot.CreatedAt.Date()
+		day := time.Date(y, m, d, 0, 0, 0, 0, snapshot.CreatedAt.Location())
+		if day.Equal(lastDay) {
+			toDelete = append(toDelete, snapshot.ID)
+			continue
+		}
+		lastDay = day
+	}
+	if len(toDelete) == 0 {
+		return nil
+	}
+
+	_, err = e.db.RepositoryStatsSnapshot.Delete().
+		Where(repositorystatssnapshot.IDIn(toDelete...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete old repository stats snapshots: %w", err)
+	}
+	return nil
+}
+
+// buildRepositoryGrowth calculates the growth trend from snapshots sorted from oldest to newest.
+// The trend is a least squares fit of the size on disk, so single outliers (e.g. a prune) don't dominate it.
+func buildRepositoryGrowth(snapshots []*ent.RepositoryStatsSnapshot, freeSpace *int64, now time.Time) *RepositoryGrowth {
+	growth := &RepositoryGrowth{
+		To:             now,
+		Points:         make([]RepositoryStatsPoint, 0, len(snapshots)),
+		FreeSpaceBytes: freeSpace,
+	}
+	for _, snapshot := range snapshots {
+		growth.Points = append(growth.Points, RepositoryStatsPoint{
+			Timestamp:  snapshot.CreatedAt,
+			SizeOnDisk: int64(snapshot.UniqueCsize),
+			UniqueSize: int64(snapshot.UniqueSize),
+			TotalSize:  int64(snapshot.TotalSize),
+		})
+	}
+	if len(growth.Points) < 2 {
+		return growth
+	}
+
+	first := growth.Points[0]
+	last := growth.Points[len(growth.Points)-1]
+	growth.GrowthBytes = last.SizeOnDisk - first.SizeOnDisk
+
+	// Least squares slope of size on disk over time (in days since the first point)
+	n := float64(len(growth.Points))
+	var sumX, sumY, sumXY, sumXX float64
+	for _, point := range growth.Points {
+		x := point.Timestamp.Sub(first.Timestamp).Hours() / 24
+		y := float64(point.SizeOnDisk)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		// All snapshots were taken at the same time
+		return growth
+	}
+	growth.GrowthBytesPerDay = (n*sumXY - sumX*sumY) / denominator
+
+	if freeSpace != nil && growth.GrowthBytesPerDay > 0 {
+		untilFull := float64(*freeSpace) / growth.GrowthBytesPerDay * float64(24*time.Hour)
+		if untilFull < float64(maxFullByProjection) {
+			fullBy := now.Add(time.Duration(untilFull))
+			growth.FullBy = &fullBy
+		}
+	}
+	return growth
+}
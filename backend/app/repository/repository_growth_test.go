@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/enttest"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestBuildRepositoryGrowth(t *testing.T) {
+	now := time.Date(2024, 6, 11, 12, 0, 0, 0, time.UTC)
+	start := now.AddDate(0, 0, -10)
+	gib := 1024 * 1024 * 1024
+
+	// Repository grows by 1 GiB per day
+	var snapshots []*ent.RepositoryStatsSnapshot
+	for day := 0; day <= 10; day++ {
+		snapshots = append(snapshots, &ent.RepositoryStatsSnapshot{
+			CreatedAt:   start.AddDate(0, 0, day),
+			UniqueCsize: (10 + day) * gib,
+			UniqueSize:  (20 + day) * gib,
+			TotalSize:   (100 + day) * gib,
+		})
+	}
+
+	t.Run("projects full date from free space", func(t *testing.T) {
+		// ARRANGE
+		freeSpace := int64(30 * gib)
+
+		// ACT
+		growth := buildRepositoryGrowth(snapshots, &freeSpace, now)
+
+		// ASSERT
+		assert.Len(t, growth.Points, 11)
+		assert.Equal(t, int64(10*gib), growth.GrowthBytes)
+		assert.InDelta(t, float64(gib), growth.GrowthBytesPerDay, 1)
+		if assert.NotNil(t, growth.FullBy) {
+			assert.WithinDuration(t, now.AddDate(0, 0, 30), *growth.FullBy, time.Minute)
+		}
+	})
+
+	t.Run("no projection without free space", func(t *testing.T) {
+		// ACT
+		growth := buildRepositoryGrowth(snapshots, nil, now)
+
+		// ASSERT
+		assert.Nil(t, growth.FullBy)
+		assert.InDelta(t, float64(gib), growth.GrowthBytesPerDay, 1)
+	})
+
+	t.Run("no projection for shrinking repository", func(t *testing.T) {
+		// ARRANGE
+		freeSpace := int64(30 * gib)
+		shrinking := []*ent.RepositoryStatsSnapshot{
+			{CreatedAt: start, UniqueCsize: 20 * gib},
+			{CreatedAt: now, UniqueCsize: 10 * gib},
+		}
+
+		// ACT
+		growth := buildRepositoryGrowth(shrinking, &freeSpace, now)
+
+		// ASSERT
+		assert.Equal(t, int64(-10*gib), growth.GrowthBytes)
+		assert.Less(t, growth.GrowthBytesPerDay, 0.0)
+		assert.Nil(t, growth.FullBy)
+	})
+
+	t.Run("single snapshot has no trend", func(t *testing.T) {
+		// ACT
+		growth := buildRepositoryGrowth(snapshots[:1], nil, now)
+
+		// ASSERT
+		assert.Len(t, growth.Points, 1)
+		assert.Zero(t, growth.GrowthBytesPerDay)
+	})
+}
+
+func TestSaveRepositoryStatsSnapshot(t *testing.T) {
+	// ARRANGE
+	db := enttest.Open(t, "sqlite3", "file:statssnapshot?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { db.Close() })
+	ctx := context.Background()
+	repo := createTestRepository(t, db, ctx, 1)
+	executor := &borgOperationExecutor{db: db, log: zap.NewNop().Sugar(), repoID: repo.ID}
+
+	// Three snapshots on the same day before the full retention period and one on another day
+	old := time.Now().Add(-statsSnapshotFullRetention).AddDate(0, 0, -10)
+	oldDay := time.Date(old.Year(), old.Month(), old.Day(), 10, 0, 0, 0, old.Location())
+	for i, createdAt := range []time.Time{oldDay, oldDay.Add(time.Hour), oldDay.Add(2 * time.Hour), oldDay.AddDate(0, 0, 1)} {
+		db.RepositoryStatsSnapshot.Create().
+			SetCreatedAt(createdAt).
+			SetUniqueCsize(i).
+			SetUniqueSize(i).
+			SetTotalSize(i).
+			SetTotalCsize(i).
+			SetRepositoryID(repo.ID).
+			ExecX(ctx)
+	}
+	stats := borgtypes.Stats{TotalSize: 100, TotalCSize: 80, UniqueSize: 50, UniqueCSize: 40}
+
+	// ACT
+	err := executor.saveRepositoryStatsSnapshot(ctx, repo.ID, stats)
+	assert.NoError(t, err)
+	err = executor.saveRepositoryStatsSnapshot(ctx, repo.ID, stats)
+	assert.NoError(t, err)
+
+	// ASSERT
+	snapshots := db.RepositoryStatsSnapshot.Query().
+		Where(repositorystatssnapshot.RepositoryID(repo.ID)).
+		Order(ent.Asc(repositorystatssnapshot.FieldCreatedAt)).
+		AllX(ctx)
+	if assert.Len(t, snapshots, 3, "unchanged stats should be skipped and old snapshots thinned out to one per day") {
+		assert.Equal(t, 2, snapshots[0].UniqueCsize, "the last snapshot of a day should be kept")
+		assert.Equal(t, 3, snapshots[1].UniqueCsize)
+		assert.Equal(t, 40, snapshots[2].UniqueCsize)
+	}
+}
@@ -64,6 +64,10 @@ func (q *RepositoryQueue) AddOperation(op *QueuedOperation) string {
 	// Check for existing operation (idempotency)
 	canAdd, existingOpID := q.canAddOperationLocked(op.Operation)
 	if !canAdd {
+		// A manual backup doesn't wait for the start window, even if the same backup was scheduled before
+		if existing, exists := q.operations[existingOpID]; exists && !op.Scheduled {
+			existing.Scheduled = false
+		}
 		return existingOpID // Return existing operation ID
 	}
 
@@ -208,6 +212,8 @@ func (q *RepositoryQueue) MoveToActive(operationID string) error {
 
 	// Set as active
 	q.active = op
+	q.active.HeldReason = ""
+	q.active.HeldUntil = nil
 
 	// Update status to running
 	q.active.Status = NewOperationStatusRunning(Running{
@@ -346,7 +352,11 @@ func (q *RepositoryQueue) CanAddOperation(op statemachine.Operation) (bool, stri
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeIndexFiles:
 		// No deduplication needed for these operations
 	default:
 		assert.Fail("Unhandled OperationType in CanAddOperation")
@@ -413,6 +423,17 @@ func (q *RepositoryQueue) UpdateOperationStatus(operationID string, status Opera
 	return fmt.Errorf("operation %s not found in repository %d queue", operationID, q.repoID)
 }
 
+// SetHold records why a queued operation can't start yet and until when, an empty reason releases the hold
+func (q *RepositoryQueue) SetHold(operationID string, reason string, until *time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if op, exists := q.operations[operationID]; exists {
+		op.HeldReason = reason
+		op.HeldUntil = until
+	}
+}
+
 // GetQueueLength returns the number of queued operations (excluding active)
 func (q *RepositoryQueue) GetQueueLength() int {
 	q.mu.Lock()
@@ -420,6 +441,18 @@ func (q *RepositoryQueue) GetQueueLength() int {
 	return len(q.operationList)
 }
 
+// HasUnheldOperations returns true if the queue has operations that aren't held by the start window of their schedule
+func (q *RepositoryQueue) HasUnheldOperations() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for _, operationID := range q.operationList {
+		if op, exists := q.operations[operationID]; exists && op.HeldReason == "" {
+			return true
+		}
+	}
+	return false
+}
+
 // IsEmpty returns true if the queue has no operations
 func (q *RepositoryQueue) IsEmpty() bool {
 	q.mu.Lock()
@@ -525,7 +558,11 @@ func (q *RepositoryQueue) addToTrackingMaps(op *QueuedOperation) {
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeIndexFiles:
 		// No tracking needed for these operations
 	default:
 		assert.Fail("Unhandled OperationType in addToTrackingMaps")
@@ -554,7 +591,11 @@ func (q *RepositoryQueue) removeFromTrackingMaps(op *QueuedOperation) {
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeIndexFiles:
 		// No tracking to remove for these operations
 	default:
 		assert.Fail("Unhandled OperationType in removeFromTrackingMaps")
@@ -611,7 +652,11 @@ func (q *RepositoryQueue) canAddOperationLocked(op statemachine.Operation) (bool
 		statemachine.OperationTypeMountArchive,
 		statemachine.OperationTypeUnmount,
 		statemachine.OperationTypeUnmountArchive,
-		statemachine.OperationTypeExaminePrune:
+		statemachine.OperationTypeExaminePrune,
+		statemachine.OperationTypeRestore,
+		statemachine.OperationTypeExportArchive,
+		statemachine.OperationTypeImportTar,
+		statemachine.OperationTypeIndexFiles:
 		// No deduplication needed for these operations
 	default:
 		assert.Fail("Unhandled OperationType in canAddOperationLocked")
@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	"github.com/loomi-labs/arco/backend/borg"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// ============================================================================
+// RESTORE PLANNING
+// ============================================================================
+
+// restorePlanBuilder determines for every file of an archive what a restore into destination does with it.
+// Items are added one at a time while borg lists them, so the listing itself is never kept in memory.
+// Directories are not part of the plan since borg creates them as needed.
+type restorePlanBuilder struct {
+	destination     string
+	stripComponents int
+	policy          statemachine.RestoreConflictPolicy
+	keepBothSuffix  string
+	conflictsOnly   bool // Only keep the entries of files that are not extracted in place (skip and keepBoth)
+	plan            RestorePlan
+}
+
+func newRestorePlanBuilder(destination string, stripComponents int, policy statemachine.RestoreConflictPolicy, keepBothSuffix string, conflictsOnly bool) *restorePlanBuilder {
+	return &restorePlanBuilder{
+		destination:     destination,
+		stripComponents: stripComponents,
+		policy:          policy,
+		keepBothSuffix:  keepBothSuffix,
+		conflictsOnly:   conflictsOnly,
+		plan: RestorePlan{
+			Entries: make([]RestorePlanEntry, 0),
+		},
+	}
+}
+
+// add determines the action for an item and adds it to the plan
+func (b *restorePlanBuilder) add(item borgtypes.ArchiveItem) {
+	if item.IsDir() {
+		return
+	}
+
+	relPath, ok := stripArchivePath(item.Path, b.stripComponents)
+	if !ok {
+		// borg does not extract items with fewer path elements than stripped components
+		return
+	}
+
+	entry := RestorePlanEntry{
+		ArchivePath:    item.Path,
+		TargetPath:     filepath.Join(b.destination, filepath.FromSlash(relPath)),
+		Size:           item.Size,
+		ArchiveModTime: time.Time(item.MTime),
+	}
+
+	existing, err := os.Lstat(entry.TargetPath)
+	if err != nil {
+		// Nothing at the target path (or not accessible, in which case borg reports the error)
+		entry.Action = RestorePlanActionCreate
+	} else {
+		existingModTime := existing.ModTime()
+		entry.ExistingModTime = &existingModTime
+		entry.Action = resolveConflict(b.policy, entry.ArchiveModTime, existingModTime)
+		if entry.Action == RestorePlanActionKeepBoth {
+			entry.TargetPath = keepBothPath(entry.TargetPath, b.keepBothSuffix)
+		}
+	}
+
+	switch entry.Action {
+	case RestorePlanActionCreate:
+		b.plan.CreateCount++
+		b.plan.TotalSize += entry.Size
+	case RestorePlanActionOverwrite:
+		b.plan.OverwriteCount++
+		b.plan.TotalSize += entry.Size
+	case RestorePlanActionKeepBoth:
+		b.plan.KeepBothCount++
+		b.plan.TotalSize += entry.Size
+	case RestorePlanActionSkip:
+		b.plan.SkipCount++
+	}
+	if b.conflictsOnly && (entry.Action == RestorePlanActionCreate || entry.Action == RestorePlanActionOverwrite) {
+		return
+	}
+	b.plan.Entries = append(b.plan.Entries, entry)
+}
+
+// buildRestorePlan determines for every file of the items what a restore into destination does with it
+func buildRestorePlan(items []borgtypes.ArchiveItem, destination string, stripComponents int, policy statemachine.RestoreConflictPolicy, keepBothSuffix string) RestorePlan {
+	builder := newRestorePlanBuilder(destination, stripComponents, policy, keepBothSuffix, false)
+	for _, item := range items {
+		builder.add(item)
+	}
+	return builder.plan
+}
+
+// streamRestorePlan lists the files of a restore with borg and streams them into a restore plan
+func streamRestorePlan(ctx context.Context, borgClient borg.Borg, repoURL, archiveName, password string, restoreData statemachine.Restore, keepBothSuffix string, conflictsOnly bool) (RestorePlan, *borgtypes.Status) {
+	builder := newRestorePlanBuilder(restoreData.Destination, restoreData.StripComponents, restoreData.ConflictPolicy, keepBothSuffix, conflictsOnly)
+	itemCh := make(chan borgtypes.ArchiveItem, 100)
+	planDone := make(chan struct{})
+	go func() {
+		defer close(planDone)
+		for item := range itemCh {
+			builder.add(item)
+		}
+	}()
+
+	status := borgClient.StreamFiles(ctx, repoURL, archiveName, password, restoreData.Paths, itemCh)
+	<-planDone
+	return builder.plan, status
+}
+
+// resolveConflict returns the action for a file that already exists at the target path
+func resolveConflict(policy statemachine.RestoreConflictPolicy, archiveModTime, existingModTime time.Time) RestorePlanAction {
+	switch policy {
+	case statemachine.RestoreConflictPolicySkip:
+		return RestorePlanActionSkip
+	case statemachine.RestoreConflictPolicyKeepBoth:
+		return RestorePlanActionKeepBoth
+	case statemachine.RestoreConflictPolicyOnlyIfNewer:
+		if archiveModTime.After(existingModTime) {
+			return RestorePlanActionOverwrite
+		}
+		return RestorePlanActionSkip
+	case statemachine.RestoreConflictPolicyOverwrite:
+		return RestorePlanActionOverwrite
+	default:
+		return RestorePlanActionOverwrite
+	}
+}
+
+// stripArchivePath removes the first stripComponents elements of an archive path.
+// Returns false if nothing is left of the path.
+func stripArchivePath(archivePath string, stripComponents int) (string, bool) {
+	parts := strings.Split(strings.Trim(archivePath, "/"), "/")
+	if stripComponents >= len(parts) {
+		return "", false
+	}
+	return strings.Join(parts[stripComponents:], "/"), true
+}
+
+// keepBothPath returns a free path next to targetPath with the suffix added before the file extension
+// Example: /home/user/report.docx -> /home/user/report.restored-2024-07-22-19-43-49.docx
+func keepBothPath(targetPath, suffix string) string {
+	dir := filepath.Dir(targetPath)
+	base := filepath.Base(targetPath)
+	ext := filepath.Ext(base)
+	if ext == base {
+		// Dotfiles like .bashrc have no extension
+		ext = ""
+	}
+	name := strings.TrimSuffix(base, ext)
+
+	candidate := filepath.Join(dir, fmt.Sprintf("%s.%s%s", name, suffix, ext))
+	for i := 1; ; i++ {
+		if _, err := os.Lstat(candidate); errors.Is(err, os.ErrNotExist) {
+			return candidate
+		}
+		candidate = filepath.Join(dir, fmt.Sprintf("%s.%s-%d%s", name, suffix, i, ext))
+	}
+}
+
+// restoreKeepBothSuffix returns the suffix used for files restored next to existing ones
+func restoreKeepBothSuffix(archiveCreatedAt time.Time) string {
+	return "restored-" + archiveCreatedAt.Format("2006-01-02-15-04-05")
+}
+
+// excludePatterns returns borg exclude patterns for all files that must not be extracted in place.
+// Full path patterns (pf:) are used because borg looks them up in a set instead of matching
+// every archived path against every pattern, which keeps restores with many conflicts fast.
+func (p RestorePlan) excludePatterns() []string {
+	var patterns []string
+	for _, entry := range p.Entries {
+		if entry.Action == RestorePlanActionSkip || entry.Action == RestorePlanActionKeepBoth {
+			patterns = append(patterns, "pf:"+entry.ArchivePath)
+		}
+	}
+	return patterns
+}
+
+// keepBothEntries returns all entries that are restored next to an existing file
+func (p RestorePlan) keepBothEntries() []RestorePlanEntry {
+	var entries []RestorePlanEntry
+	for _, entry := range p.Entries {
+		if entry.Action == RestorePlanActionKeepBoth {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// ============================================================================
+// ORIGINAL LOCATION
+// ============================================================================
+
+// mapToBackupPaths maps the requested archive paths back to the source paths of a backup profile.
+// Borg stores absolute source paths without the leading slash, so restoring to "/" puts files back
+// to where they were backed up from. Every requested path must be located inside one of the backup paths.
+// If no paths are requested all backup paths are restored.
+func mapToBackupPaths(paths []string, backupPaths []string) ([]string, error) {
+	if len(backupPaths) == 0 {
+		return nil, fmt.Errorf("backup profile has no backup paths")
+	}
+	if len(paths) == 0 {
+		paths = backupPaths
+	}
+
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		absPath := path.Clean("/" + strings.TrimPrefix(p, "/"))
+		if !isInsideAnyPath(absPath, backupPaths) {
+			return nil, fmt.Errorf("path %s is not part of the backup paths of the backup profile", absPath)
+		}
+		result = append(result, strings.TrimPrefix(absPath, "/"))
+	}
+	return result, nil
+}
+
+// isInsideAnyPath returns true if p is equal to or located inside one of the parent paths
+func isInsideAnyPath(p string, parents []string) bool {
+	for _, parent := range parents {
+		parent = path.Clean(parent)
+		if p == parent || parent == "/" || strings.HasPrefix(p, parent+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ============================================================================
+// FILE HELPERS
+// ============================================================================
+
+// moveFile moves a file and falls back to copying if source and destination are on different filesystems
+func moveFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+
+	info, err := os.Lstat(src)
+	if err != nil {
+		return err
+	}
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(src)
+		if err != nil {
+			return err
+		}
+		return os.Symlink(target, dst)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Chtimes(dst, info.ModTime(), info.ModTime())
+}
@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/app/statemachine"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// ============================================================================
+// TEST HELPERS
+// ============================================================================
+
+// writeTestFile creates a file with the given modification time
+func writeTestFile(t *testing.T, path string, modTime time.Time) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	assert.NoError(t, os.WriteFile(path, []byte("existing"), 0644))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func newTestArchiveItem(path string, size int, modTime time.Time) borgtypes.ArchiveItem {
+	return borgtypes.ArchiveItem{
+		Type:  "-",
+		Path:  path,
+		Size:  size,
+		MTime: borgtypes.StringTime(modTime),
+	}
+}
+
+// ============================================================================
+// RESTORE PLAN TESTS
+// ============================================================================
+
+func TestBuildRestorePlan_ConflictPolicies(t *testing.T) {
+	oldTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	newTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	suffix := "restored-2024-06-01-12-00-00"
+
+	tests := []struct {
+		name           string
+		policy         statemachine.RestoreConflictPolicy
+		archiveModTime time.Time
+		expectedAction RestorePlanAction
+	}{
+		{"overwrite replaces existing file", statemachine.RestoreConflictPolicyOverwrite, oldTime, RestorePlanActionOverwrite},
+		{"skip keeps existing file", statemachine.RestoreConflictPolicySkip, newTime, RestorePlanActionSkip},
+		{"keepBoth restores next to existing file", statemachine.RestoreConflictPolicyKeepBoth, newTime, RestorePlanActionKeepBoth},
+		{"onlyIfNewer overwrites older file", statemachine.RestoreConflictPolicyOnlyIfNewer, newTime.Add(time.Hour), RestorePlanActionOverwrite},
+		{"onlyIfNewer skips newer file", statemachine.RestoreConflictPolicyOnlyIfNewer, oldTime, RestorePlanActionSkip},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// ARRANGE
+			dest := t.TempDir()
+			writeTestFile(t, filepath.Join(dest, "home", "user", "report.txt"), newTime)
+			items := []borgtypes.ArchiveItem{
+				{Type: "d", Path: "home/user"},
+				newTestArchiveItem("home/user/report.txt", 10, tt.archiveModTime),
+				newTestArchiveItem("home/user/new.txt", 20, tt.archiveModTime),
+			}
+
+			// ACT
+			plan := buildRestorePlan(items, dest, 0, tt.policy, suffix)
+
+			// ASSERT
+			assert.Len(t, plan.Entries, 2, "directories should not be part of the plan")
+			assert.Equal(t, tt.expectedAction, plan.Entries[0].Action)
+			assert.NotNil(t, plan.Entries[0].ExistingModTime)
+			assert.Equal(t, RestorePlanActionCreate, plan.Entries[1].Action)
+			assert.Equal(t, filepath.Join(dest, "home", "user", "new.txt"), plan.Entries[1].TargetPath)
+			assert.Equal(t, 1, plan.CreateCount)
+
+			if tt.expectedAction == RestorePlanActionKeepBoth {
+				assert.Equal(t, filepath.Join(dest, "home", "user", "report."+suffix+".txt"), plan.Entries[0].TargetPath)
+				assert.Equal(t, []string{"pf:home/user/report.txt"}, plan.excludePatterns())
+			}
+			if tt.expectedAction == RestorePlanActionSkip {
+				assert.Equal(t, 20, plan.TotalSize, "skipped files should not count towards the total size")
+				assert.Equal(t, []string{"pf:home/user/report.txt"}, plan.excludePatterns())
+			} else {
+				assert.Equal(t, 30, plan.TotalSize)
+			}
+		})
+	}
+}
+
+func TestBuildRestorePlan_StripComponents(t *testing.T) {
+	// ARRANGE
+	dest := t.TempDir()
+	items := []borgtypes.ArchiveItem{
+		newTestArchiveItem("home", 1, time.Now()),
+		newTestArchiveItem("home/user/docs/a.txt", 1, time.Now()),
+	}
+
+	// ACT
+	plan := buildRestorePlan(items, dest, 2, statemachine.RestoreConflictPolicySkip, "restored")
+
+	// ASSERT
+	assert.Len(t, plan.Entries, 1, "items with fewer path elements than stripped components should be ignored")
+	assert.Equal(t, filepath.Join(dest, "docs", "a.txt"), plan.Entries[0].TargetPath)
+	assert.Equal(t, "home/user/docs/a.txt", plan.Entries[0].ArchivePath)
+}
+
+func TestRestorePlanBuilder_ConflictsOnly(t *testing.T) {
+	// ARRANGE
+	dest := t.TempDir()
+	writeTestFile(t, filepath.Join(dest, "home", "user", "report.txt"), time.Now())
+	builder := newRestorePlanBuilder(dest, 0, statemachine.RestoreConflictPolicySkip, "restored", true)
+
+	// ACT
+	builder.add(newTestArchiveItem("home/user/report.txt", 10, time.Now()))
+	builder.add(newTestArchiveItem("home/user/new.txt", 20, time.Now()))
+
+	// ASSERT
+	assert.Len(t, builder.plan.Entries, 1, "files extracted in place should not be kept")
+	assert.Equal(t, RestorePlanActionSkip, builder.plan.Entries[0].Action)
+	assert.Equal(t, 1, builder.plan.CreateCount)
+	assert.Equal(t, 1, builder.plan.SkipCount)
+	assert.Equal(t, 20, builder.plan.TotalSize)
+}
+
+func TestKeepBothPath(t *testing.T) {
+	// ARRANGE
+	dir := t.TempDir()
+	suffix := "restored-2024-06-01-12-00-00"
+
+	// ACT & ASSERT
+	assert.Equal(t, filepath.Join(dir, "report."+suffix+".txt"), keepBothPath(filepath.Join(dir, "report.txt"), suffix))
+	assert.Equal(t, filepath.Join(dir, ".bashrc."+suffix), keepBothPath(filepath.Join(dir, ".bashrc"), suffix))
+
+	// A taken name gets a counter
+	writeTestFile(t, filepath.Join(dir, "report."+suffix+".txt"), time.Now())
+	assert.Equal(t, filepath.Join(dir, "report."+suffix+"-1.txt"), keepBothPath(filepath.Join(dir, "report.txt"), suffix))
+}
+
+// ============================================================================
+// ORIGINAL LOCATION TESTS
+// ============================================================================
+
+func TestMapToBackupPaths(t *testing.T) {
+	backupPaths := []string{"/home/user/docs", "/etc"}
+
+	paths, err := mapToBackupPaths(nil, backupPaths)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"home/user/docs", "etc"}, paths, "all backup paths should be restored if no paths are given")
+
+	paths, err = mapToBackupPaths([]string{"home/user/docs/a.txt", "/etc/hosts"}, backupPaths)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"home/user/docs/a.txt", "etc/hosts"}, paths)
+
+	_, err = mapToBackupPaths([]string{"home/user/docsx/a.txt"}, backupPaths)
+	assert.Error(t, err, "paths outside of the backup paths should be rejected")
+
+	_, err = mapToBackupPaths(nil, nil)
+	assert.Error(t, err)
+}
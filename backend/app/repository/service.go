@@ -6,10 +6,12 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -25,6 +27,7 @@ import (
 	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/ent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
 	"github.com/loomi-labs/arco/backend/ent/notification"
@@ -47,6 +50,8 @@ type Service struct {
 	config       *types.Config
 	queueManager *QueueManager
 	stateMachine *statemachine.RepositoryStateMachine
+	archiveDirs  *archiveDirectoryCache
+	drives       platform.Drives
 
 	// Dependencies to be set via Init()
 	db              *ent.Client
@@ -55,6 +60,10 @@ type Service struct {
 	cloudRepoClient *CloudRepositoryClient
 	keyring         *keyring.Service
 	analytics       analytics.Tracker
+
+	// Check scheduling
+	checkScheduleChangedCh  chan struct{}
+	checkScheduleGeneration atomic.Int64
 }
 
 // ServiceInternal provides backend-only methods that should not be exposed to frontend
@@ -75,13 +84,16 @@ func NewService(log *zap.SugaredLogger, config *types.Config) *ServiceInternal {
 			config:       config,
 			queueManager: queueManager,
 			stateMachine: stateMachine,
+			archiveDirs:  &archiveDirectoryCache{},
+			drives:       platform.NewDrives(),
 		},
 	}
 }
 
 // Init initializes the service with remaining dependencies
-func (si *ServiceInternal) Init(ctx context.Context, db *ent.Client, eventEmitter types.EventEmitter, borgClient borg.Borg, cloudRepoClient *CloudRepositoryClient, keyringService *keyring.Service, analyticsService analytics.Tracker) {
+func (si *ServiceInternal) Init(ctx context.Context, db *ent.Client, eventEmitter types.EventEmitter, checkScheduleChangedCh chan struct{}, borgClient borg.Borg, cloudRepoClient *CloudRepositoryClient, keyringService *keyring.Service, analyticsService analytics.Tracker) {
 	si.db = db
+	si.checkScheduleChangedCh = checkScheduleChangedCh
 	si.eventEmitter = eventEmitter
 	si.borgClient = borgClient
 	si.cloudRepoClient = cloudRepoClient
@@ -309,10 +321,13 @@ func (s *Service) entityToRepository(ctx context.Context, repoEntity *ent.Reposi
 		QuickCheckError:  repoEntity.QuickCheckError,
 		LastFullCheckAt:  repoEntity.LastFullCheckAt,
 		FullCheckError:   repoEntity.FullCheckError,
+		CheckSchedule:    getCheckSchedule(repoEntity),
+		CheckCoverage:    getVerificationCoverage(archives, repoEntity.CheckCycleStartedAt),
 		SizeOnDisk:       sizeOnDisk,
 		CompressionRatio: compressionRatio,
 		OldestBackup:     oldestBackup,
 		HasPassword:      s.keyring.HasRepositoryPassword(repoEntity.ID),
+		FileIndexEnabled: repoEntity.FileIndexEnabled,
 	}
 }
 
@@ -337,12 +352,22 @@ func (s *Service) Create(ctx context.Context, name, location, password string, n
 	}
 
 	// Create a new repository entity in the database
-	repoEntity, err := s.db.Repository.
+	isLocal := strings.HasPrefix(location, "/") || strings.HasPrefix(location, "~")
+	create := s.db.Repository.
 		Create().
 		SetName(name).
 		SetURL(location).
-		SetHasPassword(password != "").
-		Save(ctx)
+		SetHasPassword(password != "")
+	if isLocal {
+		// Remember the volume so that backups can start when its drive is connected
+		volume, err := platform.VolumeOf(util.ExpandPath(location))
+		if err != nil {
+			s.log.Debugf("Could not identify the volume of %s: %s", location, err)
+		} else {
+			create.SetVolumeUUID(volume.UUID).SetVolumeLabel(volume.Label)
+		}
+	}
+	repoEntity, err := create.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create repository in database: %w", err)
 	}
@@ -361,7 +386,7 @@ func (s *Service) Create(ctx context.Context, name, location, password string, n
 	s.eventEmitter.EmitEvent(ctx, types.EventRepositoryCreatedString())
 
 	locationType := "remote"
-	if strings.HasPrefix(location, "/") || strings.HasPrefix(location, "~") {
+	if isLocal {
 		locationType = "local"
 	}
 	s.analytics.TrackEvent(ctx, analytics.EventRepositoryCreated, map[string]string{
@@ -757,13 +782,102 @@ func (s *Service) RefreshArchives(ctx context.Context, repoId int) (string, erro
 	return operationID, nil
 }
 
+// SetFileIndexEnabled enables or disables the local file index of a repository.
+// Enabling queues the indexing of all existing archives (one archive per operation), disabling removes the index.
+func (s *Service) SetFileIndexEnabled(ctx context.Context, repoId int, enabled bool) error {
+	err := s.db.Repository.UpdateOneID(repoId).
+		SetFileIndexEnabled(enabled).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("repository with ID %d not found", repoId)
+		}
+		return fmt.Errorf("failed to update repository %d: %w", repoId, err)
+	}
+
+	if enabled {
+		s.queueManager.queueFileIndex(repoId)
+	} else {
+		if err := clearFileIndex(ctx, s.db, repoId); err != nil {
+			return fmt.Errorf("failed to remove file index of repository %d: %w", repoId, err)
+		}
+	}
+
+	s.eventEmitter.EmitEvent(ctx, types.EventRepositoryUpdatedString())
+	return nil
+}
+
+// SetCheckSchedule sets how often a repository is checked automatically.
+// An interval of 0 disables the scheduled check of that type.
+// If rollingCheckArchives is set, each scheduled full check only verifies that many archives (the least recently verified first).
+func (s *Service) SetCheckSchedule(ctx context.Context, repoId int, quickCheckIntervalDays, fullCheckIntervalWeeks, rollingCheckArchives int) error {
+	if quickCheckIntervalDays < 0 || fullCheckIntervalWeeks < 0 {
+		return errors.New("check intervals must not be negative")
+	}
+	if rollingCheckArchives < 0 {
+		return errors.New("number of archives per rolling check must not be negative")
+	}
+
+	err := s.db.Repository.UpdateOneID(repoId).
+		SetQuickCheckIntervalDays(quickCheckIntervalDays).
+		SetFullCheckIntervalWeeks(fullCheckIntervalWeeks).
+		SetRollingCheckArchives(rollingCheckArchives).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("repository with ID %d not found", repoId)
+		}
+		return fmt.Errorf("failed to update repository %d: %w", repoId, err)
+	}
+
+	s.notifyCheckScheduleChanged()
+	s.eventEmitter.EmitEvent(ctx, types.EventRepositoryUpdatedString())
+	return nil
+}
+
 // QueueCheck queues a repository integrity check operation
 func (s *Service) QueueCheck(ctx context.Context, repoId int, quickVerification bool) (string, error) {
-	// Create check operation
-	checkOp := statemachine.NewOperationCheck(statemachine.Check{
+	operationID, err := s.queueCheckOperation(repoId, statemachine.Check{
 		RepositoryID:      repoId,
 		QuickVerification: quickVerification,
 	})
+	if err != nil {
+		return "", err
+	}
+
+	s.log.Infof("Queued %s check for repo %d", map[bool]string{true: "quick", false: "full"}[quickVerification], repoId)
+	return operationID, nil
+}
+
+// QueuePartialCheck queues a full check (--verify-data) that only verifies the data of the selected archives
+func (s *Service) QueuePartialCheck(ctx context.Context, repoId int, req *PartialCheckRequest) (string, error) {
+	if req == nil || (req.GlobArchives == "" && req.LastArchives <= 0) {
+		return "", errors.New("select archives by pattern or number of newest archives")
+	}
+	if req.LastArchives < 0 {
+		return "", errors.New("number of archives must not be negative")
+	}
+	if _, err := path.Match(req.GlobArchives, ""); err != nil {
+		return "", fmt.Errorf("invalid archive pattern: %w", err)
+	}
+
+	operationID, err := s.queueCheckOperation(repoId, statemachine.Check{
+		RepositoryID: repoId,
+		GlobArchives: req.GlobArchives,
+		LastArchives: req.LastArchives,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.log.Infow("Queued partial check", "repoID", repoId, "globArchives", req.GlobArchives, "lastArchives", req.LastArchives)
+	return operationID, nil
+}
+
+// queueCheckOperation adds a check operation to the queue of a repository
+func (s *Service) queueCheckOperation(repoId int, check statemachine.Check) (string, error) {
+	// Create check operation
+	checkOp := statemachine.NewOperationCheck(check)
 
 	// Create queued operation with immediate flag
 	queue := s.queueManager.GetQueue(repoId)
@@ -780,8 +894,6 @@ func (s *Service) QueueCheck(ctx context.Context, repoId int, quickVerification
 	if err != nil {
 		return "", fmt.Errorf("failed to queue check operation: %w", err)
 	}
-
-	s.log.Infof("Queued %s check for repo %d", map[bool]string{true: "quick", false: "full"}[quickVerification], repoId)
 	return operationID, nil
 }
 
@@ -845,8 +957,18 @@ func (s *Service) GetBackupProfilesThatHaveOnlyRepo(ctx context.Context, repoId
 // QUEUED OPERATIONS
 // ============================================================================
 
-// QueueBackup queues a backup operation
+// QueueBackup queues a backup operation that doesn't wait for the allowed hours and blackout periods of its schedule
 func (s *Service) QueueBackup(ctx context.Context, backupId types.BackupId) (string, error) {
+	return s.queueBackup(ctx, backupId, false)
+}
+
+// QueueScheduledBackup queues a backup that was started by its schedule or by connecting the drive of its repository.
+// It waits until the allowed hours and blackout periods of the schedule allow it to start.
+func (si *ServiceInternal) QueueScheduledBackup(ctx context.Context, backupId types.BackupId) (string, error) {
+	return si.queueBackup(ctx, backupId, true)
+}
+
+func (s *Service) queueBackup(ctx context.Context, backupId types.BackupId, scheduled bool) (string, error) {
 	// Check if repository is mounted or mounting - cannot start backup in this state
 	if s.isRepositoryMountedOrMounting(backupId.RepositoryId) {
 		return "", fmt.Errorf("cannot start backup while repository is mounted or mounting - please unmount the repository first")
@@ -870,6 +992,7 @@ func (s *Service) QueueBackup(ctx context.Context, backupId types.BackupId) (str
 		nil,   // no expiration
 		false, // will be queued
 	)
+	queuedOp.Scheduled = scheduled
 
 	// Add to queue
 	operationID, err := s.queueManager.AddOperation(backupId.RepositoryId, queuedOp)
@@ -1071,6 +1194,298 @@ func (s *Service) QueueArchiveComment(ctx context.Context, archiveId int, commen
 	return operationID, nil
 }
 
+// QueueRestore queues a restore operation that extracts archive contents into a directory or back to their original location
+func (s *Service) QueueRestore(ctx context.Context, req *RestoreRequest) (string, error) {
+	restoreData, archiveEntity, err := s.resolveRestore(ctx, req)
+	if err != nil {
+		return "", err
+	}
+
+	// Check if repository is mounted or mounting - cannot restore in this state
+	if s.isRepositoryMountedOrMounting(archiveEntity.Edges.Repository.ID) {
+		return "", fmt.Errorf("cannot restore archive while repository is mounted or mounting - please unmount the repository first")
+	}
+
+	// Create restore operation
+	restoreData.Progress = &borgtypes.ExtractProgress{}
+	restoreOp := statemachine.NewOperationRestore(restoreData)
+
+	// Get backup profile ID if available
+	var backupProfileID *int
+	if archiveEntity.Edges.BackupProfile != nil {
+		backupProfileID = &archiveEntity.Edges.BackupProfile.ID
+	}
+
+	// Create queued operation using factory method
+	queue := s.queueManager.GetQueue(archiveEntity.Edges.Repository.ID)
+	queuedOp := queue.CreateQueuedOperation(
+		restoreOp,
+		archiveEntity.Edges.Repository.ID,
+		backupProfileID,
+		nil,   // no expiration
+		false, // will be queued
+	)
+
+	// Add to queue
+	operationID, err := s.queueManager.AddOperation(archiveEntity.Edges.Repository.ID, queuedOp)
+	if err != nil {
+		return "", fmt.Errorf("failed to queue restore operation: %w", err)
+	}
+
+	return operationID, nil
+}
+
+// QueueFileVersionRestore queues the restore of a single file as it was stored in an archive.
+// Without a destination the file is restored to its original location.
+func (s *Service) QueueFileVersionRestore(ctx context.Context, req *FileVersionRestoreRequest) (string, error) {
+	filePath := normalizeArchivePath(req.Path)
+	if filePath == "" {
+		return "", fmt.Errorf("path is required")
+	}
+
+	restoreReq := &RestoreRequest{
+		ArchiveId:      req.ArchiveId,
+		Paths:          []string{filePath},
+		ConflictPolicy: req.ConflictPolicy,
+	}
+	if req.Destination == "" {
+		restoreReq.OriginalLocation = true
+	} else {
+		// Put the file directly into the destination instead of recreating its parent directories
+		restoreReq.Destination = req.Destination
+		restoreReq.StripComponents = countPathComponents(filePath) - 1
+	}
+
+	return s.QueueRestore(ctx, restoreReq)
+}
+
+// GetRestorePlan returns what a restore would do with every file without restoring anything
+func (s *Service) GetRestorePlan(ctx context.Context, req *RestoreRequest) (*RestorePlan, error) {
+	restoreData, archiveEntity, err := s.resolveRestore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	repoEntity := archiveEntity.Edges.Repository
+
+	// Get password from keyring
+	password, err := s.keyring.GetRepositoryPassword(repoEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password for repository %d: %w", repoEntity.ID, err)
+	}
+
+	plan, status := streamRestorePlan(ctx, s.borgClient, repoEntity.URL, archiveEntity.Name, password, restoreData, restoreKeepBothSuffix(archiveEntity.CreatedAt), false)
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return nil, fmt.Errorf("failed to list files of archive %d: %s", archiveEntity.ID, status.GetError())
+	}
+	return &plan, nil
+}
+
+// resolveRestore validates a restore request and turns it into the data of a restore operation.
+// For restores to the original location the destination is "/" and the requested paths are
+// validated against the backup paths of the backup profile that created the archive.
+func (s *Service) resolveRestore(ctx context.Context, req *RestoreRequest) (statemachine.Restore, *ent.Archive, error) {
+	if req.ArchiveId <= 0 {
+		return statemachine.Restore{}, nil, fmt.Errorf("archiveId is required")
+	}
+	if req.StripComponents < 0 {
+		return statemachine.Restore{}, nil, fmt.Errorf("stripComponents must not be negative")
+	}
+
+	policy := req.ConflictPolicy
+	if policy == "" {
+		policy = statemachine.RestoreConflictPolicyOverwrite
+	}
+	if !policy.IsValid() {
+		return statemachine.Restore{}, nil, fmt.Errorf("invalid conflict policy: %s", policy)
+	}
+
+	// Get archive to determine repository ID and backup profile ID
+	archiveEntity, err := s.db.Archive.Query().
+		Where(archive.ID(req.ArchiveId)).
+		WithRepository().
+		WithBackupProfile().
+		Only(ctx)
+	if err != nil {
+		return statemachine.Restore{}, nil, fmt.Errorf("archive %d not found: %w", req.ArchiveId, err)
+	}
+
+	restoreData := statemachine.Restore{
+		ArchiveID:       req.ArchiveId,
+		Paths:           req.Paths,
+		ExcludePaths:    req.ExcludePaths,
+		StripComponents: req.StripComponents,
+		ConflictPolicy:  policy,
+	}
+
+	if req.OriginalLocation {
+		backupProfile := archiveEntity.Edges.BackupProfile
+		if backupProfile == nil {
+			return statemachine.Restore{}, nil, fmt.Errorf("archive %d does not belong to a backup profile - please choose a destination", req.ArchiveId)
+		}
+		if req.StripComponents > 0 {
+			return statemachine.Restore{}, nil, fmt.Errorf("stripComponents cannot be used when restoring to the original location")
+		}
+
+		paths, err := mapToBackupPaths(req.Paths, backupProfile.BackupPaths)
+		if err != nil {
+			return statemachine.Restore{}, nil, err
+		}
+		restoreData.Destination = "/"
+		restoreData.Paths = paths
+		return restoreData, archiveEntity, nil
+	}
+
+	if req.Destination == "" {
+		return statemachine.Restore{}, nil, fmt.Errorf("destination is required")
+	}
+	if !filepath.IsAbs(req.Destination) {
+		return statemachine.Restore{}, nil, fmt.Errorf("destination must be an absolute path")
+	}
+	restoreData.Destination = filepath.Clean(req.Destination)
+
+	return restoreData, archiveEntity, nil
+}
+
+// QueueExportArchive queues an export operation that writes archive contents into a tar file
+func (s *Service) QueueExportArchive(ctx context.Context, req *ExportArchiveRequest) (string, error) {
+	if req.ArchiveId <= 0 {
+		return "", fmt.Errorf("archiveId is required")
+	}
+	if req.OutputPath == "" {
+		return "", fmt.Errorf("outputPath is required")
+	}
+	outputPath := filepath.Clean(util.ExpandPath(req.OutputPath))
+	if !filepath.IsAbs(outputPath) {
+		return "", fmt.Errorf("outputPath must be an absolute path")
+	}
+	if !util.IsDirectory(filepath.Dir(outputPath)) {
+		return "", fmt.Errorf("directory %s does not exist", filepath.Dir(outputPath))
+	}
+	if util.DoesPathExist(outputPath) {
+		return "", fmt.Errorf("%s already exists", outputPath)
+	}
+
+	format := req.Format
+	if format == "" {
+		format = borgtypes.TarFormatFromPath(outputPath)
+	}
+	if !format.IsValid() {
+		return "", fmt.Errorf("invalid tar format: %s", format)
+	}
+
+	// Get archive to determine repository ID and backup profile ID
+	archiveEntity, err := s.db.Archive.Query().
+		Where(archive.ID(req.ArchiveId)).
+		WithRepository().
+		WithBackupProfile().
+		Only(ctx)
+	if err != nil {
+		return "", fmt.Errorf("archive %d not found: %w", req.ArchiveId, err)
+	}
+
+	// Check if repository is mounted or mounting - cannot export in this state
+	if s.isRepositoryMountedOrMounting(archiveEntity.Edges.Repository.ID) {
+		return "", fmt.Errorf("cannot export archive while repository is mounted or mounting - please unmount the repository first")
+	}
+
+	// Create export operation
+	exportOp := statemachine.NewOperationExportArchive(statemachine.ExportArchive{
+		ArchiveID:    req.ArchiveId,
+		OutputPath:   outputPath,
+		Format:       format,
+		Paths:        req.Paths,
+		ExcludePaths: req.ExcludePaths,
+		Progress:     &borgtypes.ExtractProgress{},
+	})
+
+	// Get backup profile ID if available
+	var backupProfileID *int
+	if archiveEntity.Edges.BackupProfile != nil {
+		backupProfileID = &archiveEntity.Edges.BackupProfile.ID
+	}
+
+	// Create queued operation using factory method
+	queue := s.queueManager.GetQueue(archiveEntity.Edges.Repository.ID)
+	queuedOp := queue.CreateQueuedOperation(
+		exportOp,
+		archiveEntity.Edges.Repository.ID,
+		backupProfileID,
+		nil,   // no expiration
+		false, // will be queued
+	)
+
+	// Add to queue
+	operationID, err := s.queueManager.AddOperation(archiveEntity.Edges.Repository.ID, queuedOp)
+	if err != nil {
+		return "", fmt.Errorf("failed to queue export operation: %w", err)
+	}
+
+	return operationID, nil
+}
+
+// QueueImportTar queues an import operation that creates an archive from a tar file.
+// The archive belongs to the backup profile (through its prefix) and is therefore also pruned with its rules.
+func (s *Service) QueueImportTar(ctx context.Context, req *ImportTarRequest) (string, error) {
+	if req.TarPath == "" {
+		return "", fmt.Errorf("tarPath is required")
+	}
+	tarPath := filepath.Clean(util.ExpandPath(req.TarPath))
+	if !filepath.IsAbs(tarPath) {
+		return "", fmt.Errorf("tarPath must be an absolute path")
+	}
+	if !util.DoesPathExist(tarPath) || util.IsDirectory(tarPath) {
+		return "", fmt.Errorf("tar file %s does not exist", tarPath)
+	}
+	if req.Timestamp != nil && req.Timestamp.After(time.Now()) {
+		return "", fmt.Errorf("timestamp must not be in the future")
+	}
+
+	// Make sure the backup profile uses the repository
+	exists, err := s.db.BackupProfile.Query().
+		Where(
+			backupprofile.ID(req.BackupId.BackupProfileId),
+			backupprofile.HasRepositoriesWith(repository.ID(req.BackupId.RepositoryId)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to query backup profile %d: %w", req.BackupId.BackupProfileId, err)
+	}
+	if !exists {
+		return "", fmt.Errorf("backup profile %d does not use repository %d", req.BackupId.BackupProfileId, req.BackupId.RepositoryId)
+	}
+
+	// Check if repository is mounted or mounting - cannot import in this state
+	if s.isRepositoryMountedOrMounting(req.BackupId.RepositoryId) {
+		return "", fmt.Errorf("cannot import tar file while repository is mounted or mounting - please unmount the repository first")
+	}
+
+	// Create import operation
+	importOp := statemachine.NewOperationImportTar(statemachine.ImportTar{
+		BackupID:  req.BackupId,
+		TarPath:   tarPath,
+		Comment:   req.Comment,
+		Timestamp: req.Timestamp,
+	})
+
+	// Create queued operation
+	queue := s.queueManager.GetQueue(req.BackupId.RepositoryId)
+	queuedOp := queue.CreateQueuedOperation(
+		importOp,
+		req.BackupId.RepositoryId,
+		&req.BackupId.BackupProfileId,
+		nil,   // no expiration
+		false, // will be queued
+	)
+
+	// Add to queue
+	operationID, err := s.queueManager.AddOperation(req.BackupId.RepositoryId, queuedOp)
+	if err != nil {
+		return "", fmt.Errorf("failed to queue import operation: %w", err)
+	}
+
+	return operationID, nil
+}
+
 // ============================================================================
 // OPERATION MANAGEMENT
 // ============================================================================
@@ -1839,11 +2254,15 @@ func (s *Service) getArchiveOperationStates(repoID int) (map[int]ArchiveEditStat
 			statemachine.OperationTypeCheck,
 			statemachine.OperationTypeDelete,
 			statemachine.OperationTypeExaminePrune,
+			statemachine.OperationTypeExportArchive,
+			statemachine.OperationTypeImportTar,
 			statemachine.OperationTypeMount,
 			statemachine.OperationTypeMountArchive,
 			statemachine.OperationTypePrune,
+			statemachine.OperationTypeRestore,
 			statemachine.OperationTypeUnmount,
-			statemachine.OperationTypeUnmountArchive:
+			statemachine.OperationTypeUnmountArchive,
+			statemachine.OperationTypeIndexFiles:
 			// These operations don't affect individual archive edit/delete states
 		}
 	}
@@ -2101,6 +2520,210 @@ func (s *Service) GetLastArchiveByBackupId(ctx context.Context, backupId types.B
 	return first, err
 }
 
+// GetArchiveDirectory returns a page of the files and directories inside a directory of an archive.
+// This works without mounting the archive, so it can be used on systems without FUSE.
+func (s *Service) GetArchiveDirectory(ctx context.Context, req *ArchiveDirectoryRequest) (*ArchiveDirectoryResponse, error) {
+	if req.ArchiveId <= 0 {
+		return nil, fmt.Errorf("archiveId is required")
+	}
+	if req.Page <= 0 {
+		return nil, fmt.Errorf("page is required")
+	}
+	if req.PageSize <= 0 {
+		return nil, fmt.Errorf("pageSize is required")
+	}
+
+	archiveEntity, err := s.db.Archive.Query().
+		Where(archive.ID(req.ArchiveId)).
+		WithRepository().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("archive %d not found: %w", req.ArchiveId, err)
+	}
+
+	dirPath := normalizeArchivePath(req.Path)
+	index, err := s.getArchiveDirectoryIndex(ctx, archiveEntity)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := index.entries(dirPath)
+	total := len(entries)
+
+	// Paginate
+	start := min((req.Page-1)*req.PageSize, total)
+	end := min(start+req.PageSize, total)
+
+	return &ArchiveDirectoryResponse{
+		Path:    dirPath,
+		Entries: entries[start:end],
+		Total:   total,
+	}, nil
+}
+
+// getArchiveDirectoryIndex returns the directory index of an archive.
+// The whole archive is listed once and the items are streamed into the index, so the listing itself is never kept in memory.
+func (s *Service) getArchiveDirectoryIndex(ctx context.Context, archiveEntity *ent.Archive) (*archiveDirectoryIndex, error) {
+	if index, ok := s.archiveDirs.get(archiveEntity.ID, archiveEntity.Name); ok {
+		return index, nil
+	}
+
+	repoEntity := archiveEntity.Edges.Repository
+	password, err := s.keyring.GetRepositoryPassword(repoEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password for repository %d: %w", repoEntity.ID, err)
+	}
+
+	index := newArchiveDirectoryIndex()
+	itemCh := make(chan borgtypes.ArchiveItem, 100)
+	indexDone := make(chan struct{})
+	go func() {
+		defer close(indexDone)
+		for item := range itemCh {
+			index.add(item)
+		}
+	}()
+
+	status := s.borgClient.StreamFiles(ctx, repoEntity.URL, archiveEntity.Name, password, nil, itemCh)
+	<-indexDone
+	if status != nil && !status.IsCompletedWithSuccess() {
+		return nil, fmt.Errorf("failed to list files of archive %d: %s", archiveEntity.ID, status.GetError())
+	}
+	index.finish()
+
+	s.archiveDirs.set(archiveEntity.ID, archiveEntity.Name, index)
+	return index, nil
+}
+
+// SearchFiles searches the file index of a repository and returns every archive that contains matching files.
+// Query matches any part of the path (case-insensitive) and glob is a shell-style pattern (e.g. "*.docx").
+// At least one of them is required. Results are sorted from newest to oldest archive.
+func (s *Service) SearchFiles(ctx context.Context, repoId int, query, glob string) ([]*FileSearchResult, error) {
+	query = strings.TrimSpace(query)
+	glob = strings.TrimSpace(glob)
+	if query == "" && glob == "" {
+		return nil, fmt.Errorf("query or glob is required")
+	}
+
+	repoEntity, err := s.db.Repository.Get(ctx, repoId)
+	if err != nil {
+		return nil, fmt.Errorf("repository %d not found: %w", repoId, err)
+	}
+	if !repoEntity.FileIndexEnabled {
+		return nil, fmt.Errorf("file index is not enabled for repository %s", repoEntity.Name)
+	}
+
+	var filePredicates []predicate.ArchiveFile
+	if query != "" {
+		filePredicates = append(filePredicates, archivefile.PathContainsFold(query))
+	}
+	if glob != "" {
+		filePredicates = append(filePredicates, pathMatchesGlob(glob))
+	}
+
+	return searchIndexedFiles(ctx, s.db, repoId, filePredicates...)
+}
+
+// GetFileHistory returns every version of a file across the archives of a backup profile in a repository.
+// The file index is used for indexed archives, all other archives are listed with borg (one call per archive).
+// The call can be canceled by the frontend while the archives are listed.
+func (s *Service) GetFileHistory(ctx context.Context, backupId types.BackupId, path string) (*FileHistory, error) {
+	filePath := normalizeArchivePath(path)
+	if filePath == "" {
+		return nil, fmt.Errorf("path is required")
+	}
+
+	repoEntity, err := s.db.Repository.Get(ctx, backupId.RepositoryId)
+	if err != nil {
+		return nil, fmt.Errorf("repository %d not found: %w", backupId.RepositoryId, err)
+	}
+	profile, err := s.db.BackupProfile.Get(ctx, backupId.BackupProfileId)
+	if err != nil {
+		return nil, fmt.Errorf("backup profile %d not found: %w", backupId.BackupProfileId, err)
+	}
+
+	archives, err := s.db.Archive.Query().
+		Where(
+			archive.HasRepositoryWith(repository.ID(backupId.RepositoryId)),
+			archive.NameHasPrefix(profile.Prefix),
+		).
+		Order(ent.Asc(archive.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query archives of %s: %w", backupId, err)
+	}
+
+	snapshots, err := s.getFileSnapshots(ctx, repoEntity, archives, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildFileHistory(filePath, archives, snapshots), nil
+}
+
+// DiffArchives compares two archives of the same repository and returns a page of the changed paths.
+// The changes are streamed from borg, so only the entries of the requested page are kept in memory.
+func (s *Service) DiffArchives(ctx context.Context, req *ArchiveDiffRequest) (*ArchiveDiff, error) {
+	if req.Page <= 0 {
+		return nil, fmt.Errorf("page is required")
+	}
+	if req.PageSize <= 0 {
+		return nil, fmt.Errorf("pageSize is required")
+	}
+	archiveIdA, archiveIdB := req.ArchiveIdA, req.ArchiveIdB
+	if archiveIdA == archiveIdB {
+		return nil, fmt.Errorf("cannot compare an archive with itself")
+	}
+
+	archiveA, err := s.db.Archive.Query().
+		Where(archive.ID(archiveIdA)).
+		WithRepository().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("archive %d not found: %w", archiveIdA, err)
+	}
+	archiveB, err := s.db.Archive.Query().
+		Where(archive.ID(archiveIdB)).
+		WithRepository().
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("archive %d not found: %w", archiveIdB, err)
+	}
+
+	repoEntity := archiveA.Edges.Repository
+	if repoEntity.ID != archiveB.Edges.Repository.ID {
+		return nil, fmt.Errorf("archives %d and %d belong to different repositories", archiveIdA, archiveIdB)
+	}
+
+	// Get password from keyring
+	password, err := s.keyring.GetRepositoryPassword(repoEntity.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get password for repository %d: %w", repoEntity.ID, err)
+	}
+
+	// Count the entries while borg streams them and keep the ones of the requested page
+	diff := newArchiveDiff(req)
+	diffCh := make(chan borgtypes.DiffEntry, 100)
+	collectDone := make(chan struct{})
+	go func() {
+		defer close(collectDone)
+		for entry := range diffCh {
+			diff.add(entry)
+		}
+	}()
+
+	status := s.borgClient.Diff(ctx, repoEntity.URL, archiveA.Name, archiveB.Name, password, diffCh)
+	<-collectDone
+	if status != nil && !status.IsCompletedWithSuccess() {
+		if status.HasBeenCanceled {
+			return nil, fmt.Errorf("diff of archives %d and %d was canceled", archiveIdA, archiveIdB)
+		}
+		return nil, fmt.Errorf("failed to diff archives %d and %d: %s", archiveIdA, archiveIdB, status.GetError())
+	}
+
+	return diff, nil
+}
+
 // ============================================================================
 // BACKUP MANAGEMENT
 // ============================================================================
@@ -2149,6 +2772,9 @@ func (s *Service) getSingleBackupButtonStatus(ctx context.Context, backupId type
 		statemachine.RepositoryStateTypeDeleting,
 		statemachine.RepositoryStateTypeRefreshing,
 		statemachine.RepositoryStateTypeChecking,
+		statemachine.RepositoryStateTypeRestoring,
+		statemachine.RepositoryStateTypeExporting,
+		statemachine.RepositoryStateTypeImporting,
 		statemachine.RepositoryStateTypeMounting:
 		// Repository is busy with other operations
 		return BackupButtonStatusBusy, nil
@@ -2232,6 +2858,27 @@ func (s *Service) isBackupInQueue(backupId types.BackupId) bool {
 	return false
 }
 
+// GetBackupHoldReason returns why a queued backup of the given backup IDs can't start yet (e.g. a blackout period).
+// It returns an empty string if none of the backups is held.
+func (s *Service) GetBackupHoldReason(ctx context.Context, backupIds []types.BackupId) (string, error) {
+	for _, backupId := range backupIds {
+		queuedOps, err := s.queueManager.GetQueuedOperations(backupId.RepositoryId, nil)
+		if err != nil {
+			return "", fmt.Errorf("failed to get queued operations: %w", err)
+		}
+
+		for _, op := range queuedOps {
+			if backupVariant, isBackup := op.Operation.(statemachine.BackupVariant); isBackup {
+				backupData := backupVariant()
+				if backupData.BackupID.String() == backupId.String() && op.HeldReason != "" {
+					return op.HeldReason, nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
 // findOperationIDByBackupID finds the operation ID for a given backup ID
 func (s *Service) findOperationIDByBackupID(backupId types.BackupId) (string, error) {
 	// Check active operations first
@@ -2268,7 +2915,9 @@ func (s *Service) GetCombinedBackupProgress(ctx context.Context, backupIds []typ
 	// Get all active operations from queue manager
 	activeOperations := s.queueManager.GetActiveOperations()
 
-	var totalFiles, processedFiles int
+	var totalFiles, processedFiles, totalBytes, processedBytes int
+	var filesPerSecond, bytesPerSecond float64
+	var remainingSeconds *int
 	found := false
 
 	// Iterate through provided backup IDs
@@ -2285,6 +2934,14 @@ func (s *Service) GetCombinedBackupProgress(ctx context.Context, backupIds []typ
 						found = true
 						totalFiles += backupData.Progress.TotalFiles
 						processedFiles += backupData.Progress.ProcessedFiles
+						totalBytes += backupData.Progress.TotalBytes
+						processedBytes += backupData.Progress.ProcessedBytes
+						filesPerSecond += backupData.Progress.FilesPerSecond
+						bytesPerSecond += backupData.Progress.BytesPerSecond
+						// The backups are done when the slowest one is done
+						if r := backupData.Progress.RemainingSeconds; r != nil && (remainingSeconds == nil || *r > *remainingSeconds) {
+							remainingSeconds = r
+						}
 					}
 				}
 			}
@@ -2297,8 +2954,13 @@ func (s *Service) GetCombinedBackupProgress(ctx context.Context, backupIds []typ
 	}
 
 	return &borgtypes.BackupProgress{
-		TotalFiles:     totalFiles,
-		ProcessedFiles: processedFiles,
+		TotalFiles:       totalFiles,
+		ProcessedFiles:   processedFiles,
+		TotalBytes:       totalBytes,
+		ProcessedBytes:   processedBytes,
+		FilesPerSecond:   filesPerSecond,
+		BytesPerSecond:   bytesPerSecond,
+		RemainingSeconds: remainingSeconds,
 	}, nil
 }
 
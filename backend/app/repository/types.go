@@ -8,6 +8,7 @@ import (
 	"github.com/chris-tomich/adtenum"
 	"github.com/loomi-labs/arco/backend/app/statemachine"
 	"github.com/loomi-labs/arco/backend/app/types"
+	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/ent"
 )
 
@@ -34,10 +35,12 @@ type Repository struct {
 	LastAttempt  *types.LastAttempt `json:"lastAttempt,omitempty"`
 
 	// Check tracking
-	LastQuickCheckAt *time.Time `json:"lastQuickCheckAt,omitempty"`
-	QuickCheckError  []string   `json:"quickCheckError,omitempty"`
-	LastFullCheckAt  *time.Time `json:"lastFullCheckAt,omitempty"`
-	FullCheckError   []string   `json:"fullCheckError,omitempty"`
+	LastQuickCheckAt *time.Time           `json:"lastQuickCheckAt,omitempty"`
+	QuickCheckError  []string             `json:"quickCheckError,omitempty"`
+	LastFullCheckAt  *time.Time           `json:"lastFullCheckAt,omitempty"`
+	FullCheckError   []string             `json:"fullCheckError,omitempty"`
+	CheckSchedule    CheckSchedule        `json:"checkSchedule"`
+	CheckCoverage    VerificationCoverage `json:"checkCoverage"` // Archives verified in the current verification cycle
 
 	// Storage Statistics
 	SizeOnDisk       int64      `json:"sizeOnDisk"`             // Actual storage used (compressed + deduplicated)
@@ -46,6 +49,32 @@ type Repository struct {
 
 	// Security
 	HasPassword bool `json:"hasPassword"` // Whether repository has encryption passphrase
+
+	// File index
+	FileIndexEnabled bool `json:"fileIndexEnabled"` // Whether files of all archives are indexed for searching
+}
+
+// CheckSchedule is the schedule of the automatic integrity checks of a repository
+type CheckSchedule struct {
+	QuickCheckIntervalDays int        `json:"quickCheckIntervalDays"`     // 0 disables scheduled quick checks
+	FullCheckIntervalWeeks int        `json:"fullCheckIntervalWeeks"`     // 0 disables scheduled full checks
+	NextQuickCheckAt       *time.Time `json:"nextQuickCheckAt,omitempty"` // When the next quick check is due
+	NextFullCheckAt        *time.Time `json:"nextFullCheckAt,omitempty"`  // When the next full check is due
+	RollingCheckArchives   int        `json:"rollingCheckArchives"`       // Archives verified per scheduled full check, 0 for all
+}
+
+// VerificationCoverage describes how many archives had their data verified in the current verification cycle.
+// A cycle starts with a full check of all archives or when a rolling check has verified every archive.
+type VerificationCoverage struct {
+	VerifiedArchives int        `json:"verifiedArchives"`
+	TotalArchives    int        `json:"totalArchives"`
+	CycleStartedAt   *time.Time `json:"cycleStartedAt,omitempty"`
+}
+
+// PartialCheckRequest selects the archives whose data is verified by a partial full check
+type PartialCheckRequest struct {
+	GlobArchives string `json:"globArchives"` // Only verify archives matching this shell-style pattern
+	LastArchives int    `json:"lastArchives"` // Only verify the newest N archives, 0 for no limit
 }
 
 // GetID implements the statemachine.Repository interface
@@ -53,6 +82,26 @@ func (r *Repository) GetID() int {
 	return r.ID
 }
 
+// RepositoryStatsPoint is the storage usage of a repository at a point in time
+type RepositoryStatsPoint struct {
+	Timestamp  time.Time `json:"timestamp"`
+	SizeOnDisk int64     `json:"sizeOnDisk"` // Actual storage used (compressed + deduplicated)
+	UniqueSize int64     `json:"uniqueSize"` // Deduplicated uncompressed size
+	TotalSize  int64     `json:"totalSize"`  // Uncompressed size of all archives
+}
+
+// RepositoryGrowth describes how the storage usage of a repository changed within a time window
+type RepositoryGrowth struct {
+	RepositoryId      int                    `json:"repositoryId"`
+	From              time.Time              `json:"from"`
+	To                time.Time              `json:"to"`
+	Points            []RepositoryStatsPoint `json:"points"`                   // Oldest first
+	GrowthBytes       int64                  `json:"growthBytes"`              // Change of the size on disk within the window
+	GrowthBytesPerDay float64                `json:"growthBytesPerDay"`        // Linear trend of the size on disk
+	FreeSpaceBytes    *int64                 `json:"freeSpaceBytes,omitempty"` // Free space on the target (only known for local repositories)
+	FullBy            *time.Time             `json:"fullBy,omitempty"`         // Projected date the target runs out of space (nil if unknown or not growing)
+}
+
 // RepositoryWithQueue extends Repository with queue information for frontend
 type RepositoryWithQueue struct {
 	Repository       `json:",inline"`
@@ -174,6 +223,9 @@ type QueuedOperation struct {
 	CreatedAt       time.Time              `json:"createdAt"`
 	ValidUntil      *time.Time             `json:"validUntil"` // Auto-expire if not started
 	Immediate       bool                   `json:"immediate"`  // Must start immediately or fail
+	HeldReason      string                 `json:"heldReason"` // Why the operation can't start yet (e.g. blackout period), empty if it isn't held
+	HeldUntil       *time.Time             `json:"heldUntil"`  // When a held operation can start
+	Scheduled       bool                   `json:"scheduled"`  // Started by a schedule or drive trigger, only these backups wait for the start window
 }
 
 // SerializableQueuedOperation represents a queued repository operation with JSON-serializable Union types
@@ -186,6 +238,8 @@ type SerializableQueuedOperation struct {
 	CreatedAt       time.Time                   `json:"createdAt"`
 	ValidUntil      *time.Time                  `json:"validUntil"` // Auto-expire if not started
 	Immediate       bool                        `json:"immediate"`  // Must start immediately or fail
+	HeldReason      string                      `json:"heldReason"` // Why the operation can't start yet (e.g. blackout period), empty if it isn't held
+	HeldUntil       *time.Time                  `json:"heldUntil"`  // When a held operation can start
 }
 
 // toSerializableQueuedOperation converts a QueuedOperation to SerializableQueuedOperation
@@ -199,6 +253,8 @@ func toSerializableQueuedOperation(op *QueuedOperation) SerializableQueuedOperat
 		CreatedAt:       op.CreatedAt,
 		ValidUntil:      op.ValidUntil,
 		Immediate:       op.Immediate,
+		HeldReason:      op.HeldReason,
+		HeldUntil:       op.HeldUntil,
 	}
 }
 
@@ -257,6 +313,200 @@ type ArchiveWithPendingChanges struct {
 	DeleteStateUnion ArchiveDeleteStateUnion `json:"deleteStateUnion"` // Serializable delete operation state
 }
 
+// RestoreRequest represents a request to restore (extract) archive contents into a directory
+type RestoreRequest struct {
+	// Required
+	ArchiveId int `json:"archiveId"`
+	// Either a destination or the original location is required
+	Destination      string `json:"destination,omitempty"` // Absolute directory the archive contents are extracted into
+	OriginalLocation bool   `json:"originalLocation"`      // Restore to the original paths of the backup profile
+	// Optional
+	Paths           []string                           `json:"paths,omitempty"`           // Archive paths to restore (everything if empty)
+	ExcludePaths    []string                           `json:"excludePaths,omitempty"`    // Patterns that are not restored
+	StripComponents int                                `json:"stripComponents,omitempty"` // Number of leading path elements to remove
+	ConflictPolicy  statemachine.RestoreConflictPolicy `json:"conflictPolicy,omitempty"`  // Defaults to overwrite
+}
+
+// FileVersionRestoreRequest represents a request to restore a single file as it was in one archive
+type FileVersionRestoreRequest struct {
+	// Required
+	ArchiveId int    `json:"archiveId"`
+	Path      string `json:"path"` // Path of the file (with or without leading slash)
+	// Optional
+	Destination    string                             `json:"destination,omitempty"`    // Absolute directory the file is restored into (original location if empty)
+	ConflictPolicy statemachine.RestoreConflictPolicy `json:"conflictPolicy,omitempty"` // Defaults to overwrite
+}
+
+// ExportArchiveRequest represents a request to export archive contents as tar file
+type ExportArchiveRequest struct {
+	// Required
+	ArchiveId  int    `json:"archiveId"`
+	OutputPath string `json:"outputPath"` // Path of the tar file that is created (must not exist yet)
+	// Optional
+	Format       borgtypes.TarFormat `json:"format,omitempty"`       // Detected from the output path if empty (defaults to tar)
+	Paths        []string            `json:"paths,omitempty"`        // Archive paths to export (everything if empty)
+	ExcludePaths []string            `json:"excludePaths,omitempty"` // Patterns that are not exported
+}
+
+// ImportTarRequest represents a request to create an archive from a tar file
+type ImportTarRequest struct {
+	// Required
+	BackupId types.BackupId `json:"backupId"` // The archive is named with the prefix and compressed with the settings of the backup profile
+	TarPath  string         `json:"tarPath"`  // Path of the tar file (.tar, .tar.gz or .tar.zst)
+	// Optional
+	Comment   string     `json:"comment,omitempty"`
+	Timestamp *time.Time `json:"timestamp,omitempty"` // Creation time of the archive (now if empty)
+}
+
+// RestorePlanAction describes what a restore does with a single file
+type RestorePlanAction string
+
+const (
+	RestorePlanActionCreate    RestorePlanAction = "create"
+	RestorePlanActionOverwrite RestorePlanAction = "overwrite"
+	RestorePlanActionSkip      RestorePlanAction = "skip"
+	RestorePlanActionKeepBoth  RestorePlanAction = "keepBoth"
+)
+
+var AvailableRestorePlanActions = []RestorePlanAction{
+	RestorePlanActionCreate,
+	RestorePlanActionOverwrite,
+	RestorePlanActionSkip,
+	RestorePlanActionKeepBoth,
+}
+
+// RestorePlanEntry represents the planned action for a single file of a restore
+type RestorePlanEntry struct {
+	ArchivePath     string            `json:"archivePath"`               // Path inside the archive
+	TargetPath      string            `json:"targetPath"`                // Path the file is written to
+	Action          RestorePlanAction `json:"action"`                    // What happens with the file
+	Size            int               `json:"size"`                      // Size of the archived file in bytes
+	ArchiveModTime  time.Time         `json:"archiveModTime"`            // Modification time of the archived file
+	ExistingModTime *time.Time        `json:"existingModTime,omitempty"` // Modification time of the file at the target path (if it exists)
+}
+
+// RestorePlan represents the files a restore would create, overwrite or skip
+type RestorePlan struct {
+	Entries        []RestorePlanEntry `json:"entries"`
+	CreateCount    int                `json:"createCount"`
+	OverwriteCount int                `json:"overwriteCount"`
+	SkipCount      int                `json:"skipCount"`
+	KeepBothCount  int                `json:"keepBothCount"`
+	TotalSize      int                `json:"totalSize"` // Bytes that will be written
+}
+
+// ArchiveDiffRequest represents a request for a page of the changes between two archives
+type ArchiveDiffRequest struct {
+	ArchiveIdA int `json:"archiveIdA"` // Base archive (usually the older one)
+	ArchiveIdB int `json:"archiveIdB"` // Archive that is compared with the base archive
+	Page       int `json:"page"`
+	PageSize   int `json:"pageSize"`
+}
+
+// ArchiveDiff represents a page of the changes between two archives.
+// The counts and sizes cover all changes, the entries only the requested page.
+type ArchiveDiff struct {
+	ArchiveIdA        int                   `json:"archiveIdA"`
+	ArchiveIdB        int                   `json:"archiveIdB"`
+	Entries           []borgtypes.DiffEntry `json:"entries"`
+	Total             int                   `json:"total"`
+	AddedCount        int                   `json:"addedCount"`
+	RemovedCount      int                   `json:"removedCount"`
+	ModifiedCount     int                   `json:"modifiedCount"`
+	TotalAddedBytes   int64                 `json:"totalAddedBytes"`
+	TotalRemovedBytes int64                 `json:"totalRemovedBytes"`
+	SizeDelta         int64                 `json:"sizeDelta"`
+
+	// Window of entries that are kept
+	start int
+	end   int
+}
+
+// newArchiveDiff creates a diff that keeps only the entries of the requested page
+func newArchiveDiff(req *ArchiveDiffRequest) *ArchiveDiff {
+	start := (req.Page - 1) * req.PageSize
+	return &ArchiveDiff{
+		ArchiveIdA: req.ArchiveIdA,
+		ArchiveIdB: req.ArchiveIdB,
+		Entries:    make([]borgtypes.DiffEntry, 0, req.PageSize),
+		start:      start,
+		end:        start + req.PageSize,
+	}
+}
+
+// add updates the totals with an entry and keeps it if it is part of the requested page
+func (d *ArchiveDiff) add(entry borgtypes.DiffEntry) {
+	if d.Total >= d.start && d.Total < d.end {
+		d.Entries = append(d.Entries, entry)
+	}
+	d.Total++
+	switch entry.ChangeType {
+	case borgtypes.DiffChangeTypeAdded:
+		d.AddedCount++
+	case borgtypes.DiffChangeTypeRemoved:
+		d.RemovedCount++
+	case borgtypes.DiffChangeTypeModified:
+		d.ModifiedCount++
+	}
+	d.TotalAddedBytes += entry.AddedBytes
+	d.TotalRemovedBytes += entry.RemovedBytes
+	d.SizeDelta += entry.SizeDelta
+}
+
+// ArchiveDirectoryRequest represents a request for a page of a directory inside an archive
+type ArchiveDirectoryRequest struct {
+	// Required
+	ArchiveId int `json:"archiveId"`
+	Page      int `json:"page"`
+	PageSize  int `json:"pageSize"`
+	// Optional
+	Path string `json:"path,omitempty"` // Directory inside the archive (root if empty)
+}
+
+// ArchiveDirectoryEntry represents a file or directory inside an archive
+type ArchiveDirectoryEntry struct {
+	Name       string    `json:"name"`
+	Path       string    `json:"path"` // Path inside the archive (without leading slash)
+	IsDir      bool      `json:"isDir"`
+	Type       string    `json:"type"` // "d" = directory, "-" = regular file, "l" = symlink, ...
+	Mode       string    `json:"mode"`
+	Size       int64     `json:"size"`      // For directories the size of all contents
+	ItemCount  int       `json:"itemCount"` // For directories the number of contained items (recursive)
+	ModTime    time.Time `json:"modTime"`
+	LinkTarget string    `json:"linkTarget,omitempty"`
+}
+
+// ArchiveDirectoryResponse represents a page of a directory inside an archive
+type ArchiveDirectoryResponse struct {
+	Path    string                  `json:"path"`
+	Entries []ArchiveDirectoryEntry `json:"entries"`
+	Total   int                     `json:"total"`
+}
+
+// FileSearchResult represents an archive that contains files matching a search
+type FileSearchResult struct {
+	Archive    *ent.Archive       `json:"archive"`
+	Files      []*ent.ArchiveFile `json:"files"`      // Limited to the first matches (sorted by path)
+	MatchCount int                `json:"matchCount"` // Total number of matching files in the archive
+}
+
+// FileVersion represents a file as it is stored in one archive
+type FileVersion struct {
+	ArchiveId        int       `json:"archiveId"`
+	ArchiveName      string    `json:"archiveName"`
+	ArchiveCreatedAt time.Time `json:"archiveCreatedAt"`
+	Size             int64     `json:"size"`
+	ModTime          time.Time `json:"modTime"`
+	Changed          bool      `json:"changed"` // True if the file differs from the previous archive containing it (or is the first one)
+}
+
+// FileHistory represents all versions of a file across the archives of a repository
+type FileHistory struct {
+	Path             string        `json:"path"`
+	Versions         []FileVersion `json:"versions"` // Sorted from oldest to newest archive
+	DistinctVersions int           `json:"distinctVersions"`
+}
+
 // PaginatedArchivesResponse represents the response for paginated archives
 type PaginatedArchivesResponse struct {
 	Archives []*ArchiveWithPendingChanges `json:"archives"`
@@ -0,0 +1,232 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears limits the search for the next run of an expression that (almost) never matches (e.g. "0 0 31 2 *")
+const maxSearchYears = 5
+
+// cronField is the range and the names of a field of a cron expression
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	minuteField  = cronField{name: "minute", min: 0, max: 59}
+	hourField    = cronField{name: "hour", min: 0, max: 23}
+	dayField     = cronField{name: "day of month", min: 1, max: 31}
+	monthField   = cronField{name: "month", min: 1, max: 12, names: map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12}}
+	weekdayField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}}
+)
+
+// macros are the supported shortcuts for common expressions
+var macros = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+}
+
+// Expression is a parsed cron expression with the fields "minute hour day-of-month month day-of-week".
+// Every field supports "*", values, names (jan-dec, sun-sat), ranges ("1-5"), steps ("*/15", "8-18/2") and lists ("12,18").
+// The day of month also supports "L" for the last day of the month. Like in Vixie cron, a day matches if either the day
+// of month or the day of week matches when both are restricted.
+type Expression struct {
+	text     string
+	minutes  uint64
+	hours    uint64
+	days     uint64
+	lastDay  bool // "L" in the day of month
+	months   uint64
+	weekdays uint64
+
+	dayRestricted     bool
+	weekdayRestricted bool
+}
+
+// ParseExpression parses a cron expression like "0 12,18 * * mon-fri"
+func ParseExpression(expr string) (*Expression, error) {
+	text := strings.TrimSpace(expr)
+	if macro, ok := macros[strings.ToLower(text)]; ok {
+		text = macro
+	}
+	fields := strings.Fields(text)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression %q must have 5 fields (minute hour day-of-month month day-of-week), got %d", expr, len(fields))
+	}
+
+	e := &Expression{text: strings.TrimSpace(expr)}
+	var err error
+	if e.minutes, err = parseField(fields[0], minuteField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if e.hours, err = parseField(fields[1], hourField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if e.days, e.lastDay, err = parseDayField(fields[2]); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if e.months, err = parseField(fields[3], monthField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	if e.weekdays, err = parseField(fields[4], weekdayField); err != nil {
+		return nil, fmt.Errorf("cron expression %q: %w", expr, err)
+	}
+	// Sunday is both 0 and 7
+	if e.weekdays&(1<<7) != 0 {
+		e.weekdays = e.weekdays&^(1<<7) | 1
+	}
+	e.dayRestricted = !strings.HasPrefix(fields[2], "*")
+	e.weekdayRestricted = !strings.HasPrefix(fields[4], "*")
+	return e, nil
+}
+
+// String returns the expression as it was written
+func (e *Expression) String() string {
+	return e.text
+}
+
+// Next returns the first time after the given time that matches the expression in the location of the given time.
+// It returns the zero time if the expression doesn't match within the next years.
+func (e *Expression) Next(from time.Time) time.Time {
+	loc := from.Location()
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	limit := from.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !hasBit(e.months, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !e.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !hasBit(e.hours, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !hasBit(e.minutes, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (e *Expression) matchesDay(t time.Time) bool {
+	day := hasBit(e.days, t.Day()) || e.lastDay && t.Day() == daysInMonth(t)
+	weekday := hasBit(e.weekdays, int(t.Weekday()))
+	if e.dayRestricted && e.weekdayRestricted {
+		return day || weekday
+	}
+	return day && weekday
+}
+
+// parseDayField parses the day of month, which can contain "L" for the last day of the month
+func parseDayField(field string) (uint64, bool, error) {
+	var items []string
+	lastDay := false
+	for _, item := range strings.Split(field, ",") {
+		if strings.EqualFold(item, "L") {
+			lastDay = true
+			continue
+		}
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		return 0, lastDay, nil
+	}
+	days, err := parseField(strings.Join(items, ","), dayField)
+	return days, lastDay, err
+}
+
+// parseField parses a comma separated list of values, ranges and steps into a bit set
+func parseField(field string, f cronField) (uint64, error) {
+	var set uint64
+	for _, item := range strings.Split(field, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("%s %q contains an empty value", f.name, field)
+		}
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			s, err := strconv.Atoi(stepPart)
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("%s %q has an invalid step %q", f.name, field, stepPart)
+			}
+			step = s
+		}
+
+		var start, end int
+		switch {
+		case rangePart == "*":
+			start, end = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			startPart, endPart, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = f.parseValue(startPart); err != nil {
+				return 0, err
+			}
+			if end, err = f.parseValue(endPart); err != nil {
+				return 0, err
+			}
+			// Allow ranges that end on Sunday like "fri-sun"
+			if f.max == weekdayField.max && end == 0 {
+				end = 7
+			}
+			if start > end {
+				return 0, fmt.Errorf("%s range %q must not end before it starts", f.name, rangePart)
+			}
+		default:
+			var err error
+			if start, err = f.parseValue(rangePart); err != nil {
+				return 0, err
+			}
+			// "5/15" means from 5 to the end in steps of 15
+			end = start
+			if hasStep {
+				end = f.max
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set |= 1 << v
+		}
+	}
+	return set, nil
+}
+
+func (f cronField) parseValue(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s %q is not a number", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s %d must be between %d and %d", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+func hasBit(set uint64, v int) bool {
+	return set&(1<<v) != 0
+}
+
+// daysInMonth returns the number of days of the month of the given time
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
@@ -0,0 +1,132 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+TEST CASES - cron.go
+
+TestParseExpression
+* Parse valid expressions
+* Reject wrong number of fields
+* Reject value out of range
+* Reject invalid step
+* Reject reversed range
+* Reject unknown name
+
+TestExpressionNext
+* Every 15 minutes
+* Weekdays at 12:00 and 18:00 - from Friday evening
+* Last day of month - in February of a leap year
+* Last day of month - in a 30 day month
+* Day of month or day of week if both are restricted
+* Range ending on Sunday
+* Sunday as 7
+* Macro @weekly
+* Never matching expression
+
+*/
+
+func TestParseExpression(t *testing.T) {
+	t.Run("Parse valid expressions", func(t *testing.T) {
+		for _, expr := range []string{"* * * * *", "0 12,18 * * mon-fri", "*/15 8-18/2 1,L * *", "30 4 * jan-mar 0,7", "@daily"} {
+			_, err := ParseExpression(expr)
+			assert.NoError(t, err, expr)
+		}
+	})
+
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "Reject wrong number of fields", expr: "0 12 * *"},
+		{name: "Reject value out of range", expr: "0 24 * * *"},
+		{name: "Reject invalid step", expr: "*/0 * * * *"},
+		{name: "Reject reversed range", expr: "0 18-12 * * *"},
+		{name: "Reject unknown name", expr: "0 12 * * mo"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseExpression(tt.expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestExpressionNext(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "Every 15 minutes",
+			expr: "*/15 * * * *",
+			from: date(t, "2024-01-01 10:07:30"),
+			want: date(t, "2024-01-01 10:15:00"),
+		},
+		{
+			name: "Weekdays at 12:00 and 18:00 - from Friday evening",
+			expr: "0 12,18 * * mon-fri",
+			from: date(t, "2024-01-05 18:00:00"), // Friday
+			want: date(t, "2024-01-08 12:00:00"), // Monday
+		},
+		{
+			name: "Last day of month - in February of a leap year",
+			expr: "0 9 L * *",
+			from: date(t, "2024-02-01 00:00:00"),
+			want: date(t, "2024-02-29 09:00:00"),
+		},
+		{
+			name: "Last day of month - in a 30 day month",
+			expr: "0 9 L * *",
+			from: date(t, "2024-04-30 10:00:00"),
+			want: date(t, "2024-05-31 09:00:00"),
+		},
+		{
+			name: "Day of month or day of week if both are restricted",
+			expr: "0 0 15 * sun",
+			from: date(t, "2024-01-01 00:00:00"), // Monday
+			want: date(t, "2024-01-07 00:00:00"), // Sunday before the 15th
+		},
+		{
+			name: "Range ending on Sunday",
+			expr: "0 10 * * fri-sun",
+			from: date(t, "2024-01-06 11:00:00"), // Saturday
+			want: date(t, "2024-01-07 10:00:00"), // Sunday
+		},
+		{
+			name: "Sunday as 7",
+			expr: "0 10 * * 7",
+			from: date(t, "2024-01-01 00:00:00"),
+			want: date(t, "2024-01-07 10:00:00"),
+		},
+		{
+			name: "Macro @weekly",
+			expr: "@weekly",
+			from: date(t, "2024-01-01 00:00:00"),
+			want: date(t, "2024-01-07 00:00:00"),
+		},
+		{
+			name: "Never matching expression",
+			expr: "0 0 31 2 *",
+			from: date(t, "2024-01-01 00:00:00"),
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := ParseExpression(tt.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, e.Next(tt.from))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Schedule calculates the run times of a recurring job
+type Schedule interface {
+	// Next returns the first run time after the given time or the zero time if there is none
+	Next(from time.Time) time.Time
+}
+
+// NextRuns returns the next n run times after the given time.
+// It returns fewer run times if the schedule stops running.
+func NextRuns(s Schedule, from time.Time, n int) []time.Time {
+	runs := make([]time.Time, 0, n)
+	for len(runs) < n {
+		next := s.Next(from)
+		if next.IsZero() {
+			break
+		}
+		runs = append(runs, next)
+		from = next
+	}
+	return runs
+}
+
+/***********************************/
+/********** Interval ***************/
+/***********************************/
+
+type interval time.Duration
+
+// Interval returns a schedule that runs every d after the given time
+func Interval(d time.Duration) (Schedule, error) {
+	if d <= 0 {
+		return nil, fmt.Errorf("interval must be greater than 0")
+	}
+	return interval(d), nil
+}
+
+func (i interval) Next(from time.Time) time.Time {
+	return from.Add(time.Duration(i))
+}
+
+/***********************************/
+/********** Monthly ****************/
+/***********************************/
+
+type monthly struct {
+	day    int
+	hour   int
+	minute int
+	loc    *time.Location
+}
+
+// Monthly returns a schedule that runs once a month on the given day at the given time.
+// In months that are shorter than the day, it runs on the last day of the month.
+func Monthly(day, hour, minute int, loc *time.Location) (Schedule, error) {
+	if day < 1 || day > 31 {
+		return nil, fmt.Errorf("day of month must be between 1 and 31, got %d", day)
+	}
+	if hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+		return nil, fmt.Errorf("invalid time %02d:%02d", hour, minute)
+	}
+	return monthly{day: day, hour: hour, minute: minute, loc: loc}, nil
+}
+
+func (m monthly) Next(from time.Time) time.Time {
+	from = from.In(m.loc)
+	for i := 0; i < 2; i++ {
+		first := time.Date(from.Year(), from.Month()+time.Month(i), 1, 0, 0, 0, 0, m.loc)
+		day := min(m.day, daysInMonth(first))
+		next := time.Date(first.Year(), first.Month(), day, m.hour, m.minute, 0, 0, m.loc)
+		if next.After(from) {
+			return next
+		}
+	}
+	return time.Time{}
+}
+
+/***********************************/
+/********** Cron *******************/
+/***********************************/
+
+// Cron is a schedule made of one or more cron expressions, it runs whenever any of the expressions matches
+type Cron struct {
+	expressions []*Expression
+	loc         *time.Location
+}
+
+// NewCron parses the cron expressions, which are evaluated in the given location.
+// Empty expressions are ignored, but at least one expression is required.
+func NewCron(expressions []string, loc *time.Location) (*Cron, error) {
+	c := &Cron{loc: loc}
+	for _, expr := range expressions {
+		if strings.TrimSpace(expr) == "" {
+			continue
+		}
+		e, err := ParseExpression(expr)
+		if err != nil {
+			return nil, err
+		}
+		c.expressions = append(c.expressions, e)
+	}
+	if len(c.expressions) == 0 {
+		return nil, fmt.Errorf("at least one cron expression is required")
+	}
+	return c, nil
+}
+
+func (c *Cron) Next(from time.Time) time.Time {
+	from = from.In(c.loc)
+	var next time.Time
+	for _, e := range c.expressions {
+		if t := e.Next(from); !t.IsZero() && (next.IsZero() || t.Before(next)) {
+			next = t
+		}
+	}
+	return next
+}
@@ -0,0 +1,143 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+TEST CASES - schedule.go
+
+TestNextRuns
+* Multiple expressions are merged in order
+* Stop if the schedule stops running
+
+TestInterval
+* Run after the interval
+* Reject interval of 0
+
+TestMonthly
+* Run later this month
+* Run next month if the time has passed
+* Run on the last day of shorter months
+* Reject invalid day
+
+TestNewCron
+* Ignore empty expressions
+* Require at least one expression
+* Evaluate in the location of the schedule
+
+*/
+
+func date(t *testing.T, s string) time.Time {
+	d, err := time.ParseInLocation(time.DateTime, s, time.UTC)
+	require.NoError(t, err)
+	return d
+}
+
+func TestNextRuns(t *testing.T) {
+	t.Run("Multiple expressions are merged in order", func(t *testing.T) {
+		cron, err := NewCron([]string{"0 18 * * mon-fri", "0 12 * * mon-fri"}, time.UTC)
+		require.NoError(t, err)
+
+		runs := NextRuns(cron, date(t, "2024-01-05 13:00:00"), 3) // Friday
+
+		assert.Equal(t, []time.Time{
+			date(t, "2024-01-05 18:00:00"),
+			date(t, "2024-01-08 12:00:00"),
+			date(t, "2024-01-08 18:00:00"),
+		}, runs)
+	})
+
+	t.Run("Stop if the schedule stops running", func(t *testing.T) {
+		cron, err := NewCron([]string{"0 0 30 2 *"}, time.UTC)
+		require.NoError(t, err)
+
+		runs := NextRuns(cron, date(t, "2024-01-01 00:00:00"), 5)
+
+		assert.Empty(t, runs)
+	})
+}
+
+func TestInterval(t *testing.T) {
+	t.Run("Run after the interval", func(t *testing.T) {
+		s, err := Interval(30 * time.Minute)
+		require.NoError(t, err)
+
+		assert.Equal(t, date(t, "2024-01-01 00:30:15"), s.Next(date(t, "2024-01-01 00:00:15")))
+	})
+
+	t.Run("Reject interval of 0", func(t *testing.T) {
+		_, err := Interval(0)
+		assert.Error(t, err)
+	})
+}
+
+func TestMonthly(t *testing.T) {
+	tests := []struct {
+		name string
+		day  int
+		from time.Time
+		want time.Time
+	}{
+		{
+			name: "Run later this month",
+			day:  5,
+			from: date(t, "2024-01-05 09:00:00"),
+			want: date(t, "2024-01-05 10:15:00"),
+		},
+		{
+			name: "Run next month if the time has passed",
+			day:  5,
+			from: date(t, "2024-01-05 11:00:00"),
+			want: date(t, "2024-02-05 10:15:00"),
+		},
+		{
+			name: "Run on the last day of shorter months",
+			day:  31,
+			from: date(t, "2024-04-01 00:00:00"),
+			want: date(t, "2024-04-30 10:15:00"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Monthly(tt.day, 10, 15, time.UTC)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, s.Next(tt.from))
+		})
+	}
+
+	t.Run("Reject invalid day", func(t *testing.T) {
+		_, err := Monthly(32, 10, 15, time.UTC)
+		assert.Error(t, err)
+	})
+}
+
+func TestNewCron(t *testing.T) {
+	t.Run("Ignore empty expressions", func(t *testing.T) {
+		cron, err := NewCron([]string{"", "0 12 * * *", "  "}, time.UTC)
+
+		require.NoError(t, err)
+		assert.Len(t, cron.expressions, 1)
+	})
+
+	t.Run("Require at least one expression", func(t *testing.T) {
+		_, err := NewCron([]string{" "}, time.UTC)
+		assert.Error(t, err)
+	})
+
+	t.Run("Evaluate in the location of the schedule", func(t *testing.T) {
+		loc := time.FixedZone("UTC+2", 2*60*60)
+		cron, err := NewCron([]string{"0 12 * * *"}, loc)
+		require.NoError(t, err)
+
+		next := cron.Next(date(t, "2024-01-01 09:00:00"))
+
+		assert.Equal(t, date(t, "2024-01-01 10:00:00"), next.In(time.UTC))
+	})
+}
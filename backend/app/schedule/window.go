@@ -0,0 +1,168 @@
+package schedule
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/types"
+)
+
+// maxWindowSearchDays limits the search for the next start, windows repeat at least once a week
+const maxWindowSearchDays = 8
+
+// occurrence returns the start and end of the window that starts on the day of the given time
+func occurrence(w types.TimeWindow, day time.Time) (time.Time, time.Time, bool) {
+	if len(w.Weekdays) > 0 && !slices.Contains(w.Weekdays, day.Weekday()) {
+		return time.Time{}, time.Time{}, false
+	}
+	startHour, startMinute, err := types.ParseClock(w.Start)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	endHour, endMinute, err := types.ParseClock(w.End)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	start := time.Date(day.Year(), day.Month(), day.Day(), startHour, startMinute, 0, 0, day.Location())
+	end := time.Date(day.Year(), day.Month(), day.Day(), endHour, endMinute, 0, 0, day.Location())
+	if !end.After(start) {
+		end = time.Date(day.Year(), day.Month(), day.Day()+1, endHour, endMinute, 0, 0, day.Location())
+	}
+	return start, end, true
+}
+
+// StartWindow restricts when a job may start. A job may only start within one of the allowed hours
+// (at any time if there are none) and never during a blackout period.
+// The windows are evaluated in the location of the times passed to its methods.
+type StartWindow struct {
+	AllowedHours    []types.TimeWindow
+	BlackoutPeriods []types.TimeWindow
+}
+
+// Validate checks all windows
+func (sw StartWindow) Validate() error {
+	for _, w := range sw.AllowedHours {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("allowed hours: %w", err)
+		}
+	}
+	for _, w := range sw.BlackoutPeriods {
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("blackout period: %w", err)
+		}
+	}
+	return nil
+}
+
+// NextStart returns the first time at or after the given time at which a job may start.
+// It returns the zero time if the windows never allow a start.
+func (sw StartWindow) NextStart(t time.Time) time.Time {
+	limit := t.AddDate(0, 0, maxWindowSearchDays)
+	for !t.After(limit) {
+		if end, ok := containingEnd(sw.BlackoutPeriods, t); ok {
+			t = end
+			continue
+		}
+		if len(sw.AllowedHours) == 0 {
+			return t
+		}
+		if _, ok := containingEnd(sw.AllowedHours, t); ok {
+			return t
+		}
+		next, ok := nextOpening(sw.AllowedHours, t)
+		if !ok {
+			return time.Time{}
+		}
+		t = next
+	}
+	return time.Time{}
+}
+
+// HoldReason returns why a job can't start at the given time and when it can start.
+// The reason is empty if the job can start.
+func (sw StartWindow) HoldReason(t time.Time) (string, time.Time) {
+	next := sw.NextStart(t)
+	if next.Equal(t) {
+		return "", time.Time{}
+	}
+	if next.IsZero() {
+		return "The allowed hours and blackout periods never allow a start", time.Time{}
+	}
+	if _, ok := containingEnd(sw.BlackoutPeriods, t); ok {
+		return fmt.Sprintf("Blackout period until %s", next.Format("Mon 15:04")), next
+	}
+	return fmt.Sprintf("Outside of the allowed hours until %s", next.Format("Mon 15:04")), next
+}
+
+// containingEnd returns the latest end of the windows that contain the given time
+func containingEnd(windows []types.TimeWindow, t time.Time) (time.Time, bool) {
+	var latestEnd time.Time
+	found := false
+	for _, w := range windows {
+		// A window lasts at most 24 hours, so only the windows starting on this or the previous day can contain t
+		for _, day := range []time.Time{t.AddDate(0, 0, -1), t} {
+			start, end, ok := occurrence(w, day)
+			if ok && !t.Before(start) && t.Before(end) && end.After(latestEnd) {
+				latestEnd = end
+				found = true
+			}
+		}
+	}
+	return latestEnd, found
+}
+
+// nextOpening returns the earliest start of the windows after the given time
+func nextOpening(windows []types.TimeWindow, t time.Time) (time.Time, bool) {
+	var earliest time.Time
+	for _, w := range windows {
+		for i := 0; i < maxWindowSearchDays; i++ {
+			start, _, ok := occurrence(w, t.AddDate(0, 0, i))
+			if ok && start.After(t) {
+				if earliest.IsZero() || start.Before(earliest) {
+					earliest = start
+				}
+				break
+			}
+		}
+	}
+	return earliest, !earliest.IsZero()
+}
+
+/***********************************/
+/********** Restrict ***************/
+/***********************************/
+
+type restricted struct {
+	schedule Schedule
+	window   StartWindow
+	loc      *time.Location
+}
+
+// Restrict returns a schedule that delays every run of the given schedule until the start window allows it.
+// The start window is evaluated in the given location.
+func Restrict(s Schedule, window StartWindow, loc *time.Location) Schedule {
+	if len(window.AllowedHours) == 0 && len(window.BlackoutPeriods) == 0 {
+		return s
+	}
+	return restricted{schedule: s, window: window, loc: loc}
+}
+
+func (r restricted) Next(from time.Time) time.Time {
+	next := r.schedule.Next(from)
+	if next.IsZero() {
+		return next
+	}
+	return r.window.NextStart(next.In(r.loc))
+}
+
+// Jitter delays the given time by a random duration of up to max,
+// so that many clients with the same schedule don't start at the same time
+func Jitter(t time.Time, max time.Duration) time.Time {
+	if max <= 0 {
+		return t
+	}
+	return t.Add(rand.N(max))
+}
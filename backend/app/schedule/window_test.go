@@ -0,0 +1,193 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+TEST CASES - window.go
+
+TestStartWindowNextStart
+* No windows
+* Within allowed hours
+* Before allowed hours
+* Allowed hours over midnight
+* Allowed hours only on weekdays - from Saturday
+* During blackout period
+* Blackout period over midnight
+* Blackout period at the start of allowed hours
+* Blackout period covers all allowed hours
+
+TestStartWindowHoldReason
+* Not held
+* Held by blackout period
+* Held outside of allowed hours
+* Never allowed
+
+TestRestrict
+* Run is delayed until the blackout period is over
+* Schedule without windows is not wrapped
+
+TestJitter
+* Jitter stays within the maximum
+* No jitter
+
+*/
+
+func TestStartWindowNextStart(t *testing.T) {
+	tests := []struct {
+		name   string
+		window StartWindow
+		from   time.Time
+		want   time.Time
+	}{
+		{
+			name:   "No windows",
+			window: StartWindow{},
+			from:   date(t, "2024-01-01 12:00:00"),
+			want:   date(t, "2024-01-01 12:00:00"),
+		},
+		{
+			name:   "Within allowed hours",
+			window: StartWindow{AllowedHours: []types.TimeWindow{{Start: "08:00", End: "18:00"}}},
+			from:   date(t, "2024-01-01 12:00:00"),
+			want:   date(t, "2024-01-01 12:00:00"),
+		},
+		{
+			name:   "Before allowed hours",
+			window: StartWindow{AllowedHours: []types.TimeWindow{{Start: "22:00", End: "23:00"}, {Start: "20:00", End: "21:00"}}},
+			from:   date(t, "2024-01-01 12:00:00"),
+			want:   date(t, "2024-01-01 20:00:00"),
+		},
+		{
+			name:   "Allowed hours over midnight",
+			window: StartWindow{AllowedHours: []types.TimeWindow{{Start: "22:00", End: "06:00"}}},
+			from:   date(t, "2024-01-02 05:00:00"),
+			want:   date(t, "2024-01-02 05:00:00"),
+		},
+		{
+			name:   "Allowed hours only on weekdays - from Saturday",
+			window: StartWindow{AllowedHours: []types.TimeWindow{{Start: "22:00", End: "06:00", Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}}}},
+			from:   date(t, "2024-01-06 12:00:00"), // Saturday
+			want:   date(t, "2024-01-08 22:00:00"), // Monday
+		},
+		{
+			name:   "During blackout period",
+			window: StartWindow{BlackoutPeriods: []types.TimeWindow{{Start: "09:00", End: "17:00"}}},
+			from:   date(t, "2024-01-01 12:00:00"),
+			want:   date(t, "2024-01-01 17:00:00"),
+		},
+		{
+			name:   "Blackout period over midnight",
+			window: StartWindow{BlackoutPeriods: []types.TimeWindow{{Start: "23:00", End: "01:30"}}},
+			from:   date(t, "2024-01-02 00:15:00"),
+			want:   date(t, "2024-01-02 01:30:00"),
+		},
+		{
+			name: "Blackout period at the start of allowed hours",
+			window: StartWindow{
+				AllowedHours:    []types.TimeWindow{{Start: "12:00", End: "14:00"}},
+				BlackoutPeriods: []types.TimeWindow{{Start: "11:00", End: "12:30"}},
+			},
+			from: date(t, "2024-01-01 08:00:00"),
+			want: date(t, "2024-01-01 12:30:00"),
+		},
+		{
+			name: "Blackout period covers all allowed hours",
+			window: StartWindow{
+				AllowedHours:    []types.TimeWindow{{Start: "12:00", End: "14:00"}},
+				BlackoutPeriods: []types.TimeWindow{{Start: "11:00", End: "15:00"}},
+			},
+			from: date(t, "2024-01-01 08:00:00"),
+			want: time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.window.NextStart(tt.from))
+		})
+	}
+}
+
+func TestStartWindowHoldReason(t *testing.T) {
+	window := StartWindow{
+		AllowedHours:    []types.TimeWindow{{Start: "08:00", End: "20:00"}},
+		BlackoutPeriods: []types.TimeWindow{{Start: "12:00", End: "13:00"}},
+	}
+
+	t.Run("Not held", func(t *testing.T) {
+		reason, until := window.HoldReason(date(t, "2024-01-01 10:00:00"))
+
+		assert.Empty(t, reason)
+		assert.True(t, until.IsZero())
+	})
+
+	t.Run("Held by blackout period", func(t *testing.T) {
+		reason, until := window.HoldReason(date(t, "2024-01-01 12:15:00"))
+
+		assert.Equal(t, "Blackout period until Mon 13:00", reason)
+		assert.Equal(t, date(t, "2024-01-01 13:00:00"), until)
+	})
+
+	t.Run("Held outside of allowed hours", func(t *testing.T) {
+		reason, until := window.HoldReason(date(t, "2024-01-01 21:00:00"))
+
+		assert.Equal(t, "Outside of the allowed hours until Tue 08:00", reason)
+		assert.Equal(t, date(t, "2024-01-02 08:00:00"), until)
+	})
+
+	t.Run("Never allowed", func(t *testing.T) {
+		never := StartWindow{BlackoutPeriods: []types.TimeWindow{{Start: "00:00", End: "00:00"}}}
+
+		reason, until := never.HoldReason(date(t, "2024-01-01 21:00:00"))
+
+		assert.NotEmpty(t, reason)
+		assert.True(t, until.IsZero())
+	})
+}
+
+func TestRestrict(t *testing.T) {
+	t.Run("Run is delayed until the blackout period is over", func(t *testing.T) {
+		cron, err := NewCron([]string{"0 * * * *"}, time.UTC)
+		require.NoError(t, err)
+		s := Restrict(cron, StartWindow{BlackoutPeriods: []types.TimeWindow{{Start: "09:00", End: "17:30"}}}, time.UTC)
+
+		runs := NextRuns(s, date(t, "2024-01-01 07:30:00"), 3)
+
+		assert.Equal(t, []time.Time{
+			date(t, "2024-01-01 08:00:00"),
+			date(t, "2024-01-01 17:30:00"),
+			date(t, "2024-01-01 18:00:00"),
+		}, runs)
+	})
+
+	t.Run("Schedule without windows is not wrapped", func(t *testing.T) {
+		cron, err := NewCron([]string{"0 * * * *"}, time.UTC)
+		require.NoError(t, err)
+
+		assert.Same(t, cron, Restrict(cron, StartWindow{}, time.UTC))
+	})
+}
+
+func TestJitter(t *testing.T) {
+	from := date(t, "2024-01-01 12:00:00")
+
+	t.Run("Jitter stays within the maximum", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			jittered := Jitter(from, 10*time.Minute)
+
+			assert.False(t, jittered.Before(from))
+			assert.True(t, jittered.Before(from.Add(10*time.Minute)))
+		}
+	})
+
+	t.Run("No jitter", func(t *testing.T) {
+		assert.Equal(t, from, Jitter(from, 0))
+	})
+}
@@ -20,9 +20,13 @@ const (
 	OperationTypeCheck          OperationType = "Check"
 	OperationTypeDelete         OperationType = "Delete"
 	OperationTypeExaminePrune   OperationType = "ExaminePrune"
+	OperationTypeExportArchive  OperationType = "ExportArchive"
+	OperationTypeImportTar      OperationType = "ImportTar"
+	OperationTypeIndexFiles     OperationType = "IndexFiles"
 	OperationTypeMount          OperationType = "Mount"
 	OperationTypeMountArchive   OperationType = "MountArchive"
 	OperationTypePrune          OperationType = "Prune"
+	OperationTypeRestore        OperationType = "Restore"
 	OperationTypeUnmount        OperationType = "Unmount"
 	OperationTypeUnmountArchive OperationType = "UnmountArchive"
 )
@@ -36,9 +40,13 @@ type BackupVariant adtenum.OneVariantValue[Backup]
 type CheckVariant adtenum.OneVariantValue[Check]
 type DeleteVariant adtenum.OneVariantValue[Delete]
 type ExaminePruneVariant adtenum.OneVariantValue[ExaminePrune]
+type ExportArchiveVariant adtenum.OneVariantValue[ExportArchive]
+type ImportTarVariant adtenum.OneVariantValue[ImportTar]
+type IndexFilesVariant adtenum.OneVariantValue[IndexFiles]
 type MountVariant adtenum.OneVariantValue[Mount]
 type MountArchiveVariant adtenum.OneVariantValue[MountArchive]
 type PruneVariant adtenum.OneVariantValue[Prune]
+type RestoreVariant adtenum.OneVariantValue[Restore]
 type UnmountVariant adtenum.OneVariantValue[Unmount]
 type UnmountArchiveVariant adtenum.OneVariantValue[UnmountArchive]
 
@@ -51,9 +59,13 @@ var NewOperationBackup = adtenum.CreateOneVariantValueConstructor[BackupVariant]
 var NewOperationCheck = adtenum.CreateOneVariantValueConstructor[CheckVariant]()
 var NewOperationDelete = adtenum.CreateOneVariantValueConstructor[DeleteVariant]()
 var NewOperationExaminePrune = adtenum.CreateOneVariantValueConstructor[ExaminePruneVariant]()
+var NewOperationExportArchive = adtenum.CreateOneVariantValueConstructor[ExportArchiveVariant]()
+var NewOperationImportTar = adtenum.CreateOneVariantValueConstructor[ImportTarVariant]()
+var NewOperationIndexFiles = adtenum.CreateOneVariantValueConstructor[IndexFilesVariant]()
 var NewOperationMount = adtenum.CreateOneVariantValueConstructor[MountVariant]()
 var NewOperationMountArchive = adtenum.CreateOneVariantValueConstructor[MountArchiveVariant]()
 var NewOperationPrune = adtenum.CreateOneVariantValueConstructor[PruneVariant]()
+var NewOperationRestore = adtenum.CreateOneVariantValueConstructor[RestoreVariant]()
 var NewOperationUnmount = adtenum.CreateOneVariantValueConstructor[UnmountVariant]()
 var NewOperationUnmountArchive = adtenum.CreateOneVariantValueConstructor[UnmountArchiveVariant]()
 
@@ -66,9 +78,13 @@ func (v BackupVariant) EnumType() Operation         { return v }
 func (v CheckVariant) EnumType() Operation          { return v }
 func (v DeleteVariant) EnumType() Operation         { return v }
 func (v ExaminePruneVariant) EnumType() Operation   { return v }
+func (v ExportArchiveVariant) EnumType() Operation  { return v }
+func (v ImportTarVariant) EnumType() Operation      { return v }
+func (v IndexFilesVariant) EnumType() Operation     { return v }
 func (v MountVariant) EnumType() Operation          { return v }
 func (v MountArchiveVariant) EnumType() Operation   { return v }
 func (v PruneVariant) EnumType() Operation          { return v }
+func (v RestoreVariant) EnumType() Operation        { return v }
 func (v UnmountVariant) EnumType() Operation        { return v }
 func (v UnmountArchiveVariant) EnumType() Operation { return v }
 
@@ -101,6 +117,14 @@ func GetOperationType(enum Operation) OperationType {
 		return OperationTypeExaminePrune
 	case CheckVariant:
 		return OperationTypeCheck
+	case RestoreVariant:
+		return OperationTypeRestore
+	case ExportArchiveVariant:
+		return OperationTypeExportArchive
+	case ImportTarVariant:
+		return OperationTypeImportTar
+	case IndexFilesVariant:
+		return OperationTypeIndexFiles
 	default:
 		assert.Fail("Unhandled Operation variant in GetOperationType")
 		return OperationTypeArchiveComment
@@ -125,6 +149,10 @@ type OperationUnion struct {
 	UnmountArchive *UnmountArchive `json:"unmountArchive,omitempty"`
 	ExaminePrune   *ExaminePrune   `json:"examinePrune,omitempty"`
 	Check          *Check          `json:"check,omitempty"`
+	Restore        *Restore        `json:"restore,omitempty"`
+	ExportArchive  *ExportArchive  `json:"exportArchive,omitempty"`
+	ImportTar      *ImportTar      `json:"importTar,omitempty"`
+	IndexFiles     *IndexFiles     `json:"indexFiles,omitempty"`
 }
 
 // ToOperationUnion converts an ADT Operation to an OperationUnion
@@ -208,6 +236,30 @@ func ToOperationUnion(r Operation) OperationUnion {
 			Type:  OperationTypeCheck,
 			Check: &data,
 		}
+	case RestoreVariant:
+		data := i()
+		return OperationUnion{
+			Type:    OperationTypeRestore,
+			Restore: &data,
+		}
+	case ExportArchiveVariant:
+		data := i()
+		return OperationUnion{
+			Type:          OperationTypeExportArchive,
+			ExportArchive: &data,
+		}
+	case ImportTarVariant:
+		data := i()
+		return OperationUnion{
+			Type:      OperationTypeImportTar,
+			ImportTar: &data,
+		}
+	case IndexFilesVariant:
+		data := i()
+		return OperationUnion{
+			Type:       OperationTypeIndexFiles,
+			IndexFiles: &data,
+		}
 	default:
 		return OperationUnion{
 			Type:           OperationTypeArchiveComment,
@@ -224,12 +276,15 @@ const (
 	RepositoryStateTypeChecking   RepositoryStateType = "Checking"
 	RepositoryStateTypeDeleting   RepositoryStateType = "Deleting"
 	RepositoryStateTypeError      RepositoryStateType = "Error"
+	RepositoryStateTypeExporting  RepositoryStateType = "Exporting"
 	RepositoryStateTypeIdle       RepositoryStateType = "Idle"
+	RepositoryStateTypeImporting  RepositoryStateType = "Importing"
 	RepositoryStateTypeMounted    RepositoryStateType = "Mounted"
 	RepositoryStateTypeMounting   RepositoryStateType = "Mounting"
 	RepositoryStateTypePruning    RepositoryStateType = "Pruning"
 	RepositoryStateTypeQueued     RepositoryStateType = "Queued"
 	RepositoryStateTypeRefreshing RepositoryStateType = "Refreshing"
+	RepositoryStateTypeRestoring  RepositoryStateType = "Restoring"
 )
 
 // RepositoryState variant wrappers
@@ -237,36 +292,45 @@ type BackingUpVariant adtenum.OneVariantValue[BackingUp]
 type CheckingVariant adtenum.OneVariantValue[Checking]
 type DeletingVariant adtenum.OneVariantValue[Deleting]
 type ErrorVariant adtenum.OneVariantValue[Error]
+type ExportingVariant adtenum.OneVariantValue[Exporting]
 type IdleVariant adtenum.OneVariantValue[Idle]
+type ImportingVariant adtenum.OneVariantValue[Importing]
 type MountedVariant adtenum.OneVariantValue[Mounted]
 type MountingVariant adtenum.OneVariantValue[Mounting]
 type PruningVariant adtenum.OneVariantValue[Pruning]
 type QueuedVariant adtenum.OneVariantValue[Queued]
 type RefreshingVariant adtenum.OneVariantValue[Refreshing]
+type RestoringVariant adtenum.OneVariantValue[Restoring]
 
 // RepositoryState constructors
 var NewRepositoryStateBackingUp = adtenum.CreateOneVariantValueConstructor[BackingUpVariant]()
 var NewRepositoryStateChecking = adtenum.CreateOneVariantValueConstructor[CheckingVariant]()
 var NewRepositoryStateDeleting = adtenum.CreateOneVariantValueConstructor[DeletingVariant]()
 var NewRepositoryStateError = adtenum.CreateOneVariantValueConstructor[ErrorVariant]()
+var NewRepositoryStateExporting = adtenum.CreateOneVariantValueConstructor[ExportingVariant]()
 var NewRepositoryStateIdle = adtenum.CreateOneVariantValueConstructor[IdleVariant]()
+var NewRepositoryStateImporting = adtenum.CreateOneVariantValueConstructor[ImportingVariant]()
 var NewRepositoryStateMounted = adtenum.CreateOneVariantValueConstructor[MountedVariant]()
 var NewRepositoryStateMounting = adtenum.CreateOneVariantValueConstructor[MountingVariant]()
 var NewRepositoryStatePruning = adtenum.CreateOneVariantValueConstructor[PruningVariant]()
 var NewRepositoryStateQueued = adtenum.CreateOneVariantValueConstructor[QueuedVariant]()
 var NewRepositoryStateRefreshing = adtenum.CreateOneVariantValueConstructor[RefreshingVariant]()
+var NewRepositoryStateRestoring = adtenum.CreateOneVariantValueConstructor[RestoringVariant]()
 
 // EnumType methods for RepositoryState variants
 func (v BackingUpVariant) EnumType() RepositoryState  { return v }
 func (v CheckingVariant) EnumType() RepositoryState   { return v }
 func (v DeletingVariant) EnumType() RepositoryState   { return v }
 func (v ErrorVariant) EnumType() RepositoryState      { return v }
+func (v ExportingVariant) EnumType() RepositoryState  { return v }
 func (v IdleVariant) EnumType() RepositoryState       { return v }
+func (v ImportingVariant) EnumType() RepositoryState  { return v }
 func (v MountedVariant) EnumType() RepositoryState    { return v }
 func (v MountingVariant) EnumType() RepositoryState   { return v }
 func (v PruningVariant) EnumType() RepositoryState    { return v }
 func (v QueuedVariant) EnumType() RepositoryState     { return v }
 func (v RefreshingVariant) EnumType() RepositoryState { return v }
+func (v RestoringVariant) EnumType() RepositoryState  { return v }
 
 // GetRepositoryStateType returns the discriminator type for exhaustive switch checking
 func GetRepositoryStateType(enum RepositoryState) RepositoryStateType {
@@ -285,6 +349,12 @@ func GetRepositoryStateType(enum RepositoryState) RepositoryStateType {
 		return RepositoryStateTypeRefreshing
 	case CheckingVariant:
 		return RepositoryStateTypeChecking
+	case RestoringVariant:
+		return RepositoryStateTypeRestoring
+	case ExportingVariant:
+		return RepositoryStateTypeExporting
+	case ImportingVariant:
+		return RepositoryStateTypeImporting
 	case MountingVariant:
 		return RepositoryStateTypeMounting
 	case MountedVariant:
@@ -309,6 +379,9 @@ type RepositoryStateUnion struct {
 	Deleting   *Deleting   `json:"deleting,omitempty"`
 	Refreshing *Refreshing `json:"refreshing,omitempty"`
 	Checking   *Checking   `json:"checking,omitempty"`
+	Restoring  *Restoring  `json:"restoring,omitempty"`
+	Exporting  *Exporting  `json:"exporting,omitempty"`
+	Importing  *Importing  `json:"importing,omitempty"`
 	Mounting   *Mounting   `json:"mounting,omitempty"`
 	Mounted    *Mounted    `json:"mounted,omitempty"`
 	Error      *Error      `json:"error,omitempty"`
@@ -359,6 +432,24 @@ func ToRepositoryStateUnion(r RepositoryState) RepositoryStateUnion {
 			Type:     RepositoryStateTypeChecking,
 			Checking: &data,
 		}
+	case RestoringVariant:
+		data := i()
+		return RepositoryStateUnion{
+			Type:      RepositoryStateTypeRestoring,
+			Restoring: &data,
+		}
+	case ExportingVariant:
+		data := i()
+		return RepositoryStateUnion{
+			Type:      RepositoryStateTypeExporting,
+			Exporting: &data,
+		}
+	case ImportingVariant:
+		data := i()
+		return RepositoryStateUnion{
+			Type:      RepositoryStateTypeImporting,
+			Importing: &data,
+		}
 	case MountingVariant:
 		data := i()
 		return RepositoryStateUnion{
@@ -3,6 +3,9 @@
 package statemachine
 
 import (
+	"slices"
+	"time"
+
 	"github.com/chris-tomich/adtenum"
 	"github.com/loomi-labs/arco/backend/app/types"
 	borgtypes "github.com/loomi-labs/arco/backend/borg/types"
@@ -74,8 +77,41 @@ type ExaminePrune struct {
 }
 
 type Check struct {
-	RepositoryID      int  `json:"repositoryId"`
-	QuickVerification bool `json:"quickVerification"`
+	RepositoryID      int    `json:"repositoryId"`
+	QuickVerification bool   `json:"quickVerification"`
+	GlobArchives      string `json:"globArchives"`    // Only verify the data of archives matching this pattern (full checks only)
+	LastArchives      int    `json:"lastArchives"`    // Only verify the data of the newest N archives (full checks only)
+	RollingArchives   int    `json:"rollingArchives"` // Verify the data of the next N archives of the verification cycle (full checks only)
+}
+
+type Restore struct {
+	ArchiveID       int                        `json:"archiveId"`
+	Destination     string                     `json:"destination"`     // Directory the archive contents are extracted into ("/" for the original location)
+	Paths           []string                   `json:"paths"`           // Archive paths to restore (everything if empty)
+	ExcludePaths    []string                   `json:"excludePaths"`    // Patterns that are not restored
+	StripComponents int                        `json:"stripComponents"` // Number of leading path elements to remove
+	ConflictPolicy  RestoreConflictPolicy      `json:"conflictPolicy"`  // How to handle files that already exist at the destination
+	Progress        *borgtypes.ExtractProgress `json:"progress,omitempty"`
+}
+
+type ExportArchive struct {
+	ArchiveID    int                        `json:"archiveId"`
+	OutputPath   string                     `json:"outputPath"`   // Absolute path of the tar file that is created
+	Format       borgtypes.TarFormat        `json:"format"`       // Tar format (and compression) of the output file
+	Paths        []string                   `json:"paths"`        // Archive paths to export (everything if empty)
+	ExcludePaths []string                   `json:"excludePaths"` // Patterns that are not exported
+	Progress     *borgtypes.ExtractProgress `json:"progress,omitempty"`
+}
+
+type ImportTar struct {
+	BackupID  types.BackupId `json:"backupId"`            // Backup profile (prefix, compression) and repository of the new archive
+	TarPath   string         `json:"tarPath"`             // Absolute path of the tar file that is imported
+	Comment   string         `json:"comment"`             // Comment of the new archive
+	Timestamp *time.Time     `json:"timestamp,omitempty"` // Creation time of the new archive (now if nil)
+}
+
+type IndexFiles struct {
+	RepositoryID int `json:"repositoryId"` // Adds the files of the newest archive that is not indexed yet to the file index
 }
 
 // Operation ADT definition
@@ -95,6 +131,36 @@ func (Unmount) isADTVariant() Operation        { var zero Operation; return zero
 func (UnmountArchive) isADTVariant() Operation { var zero Operation; return zero }
 func (ExaminePrune) isADTVariant() Operation   { var zero Operation; return zero }
 func (Check) isADTVariant() Operation          { var zero Operation; return zero }
+func (Restore) isADTVariant() Operation        { var zero Operation; return zero }
+func (ExportArchive) isADTVariant() Operation  { var zero Operation; return zero }
+func (ImportTar) isADTVariant() Operation      { var zero Operation; return zero }
+func (IndexFiles) isADTVariant() Operation     { var zero Operation; return zero }
+
+// ============================================================================
+// SUPPORTING TYPES
+// ============================================================================
+
+// RestoreConflictPolicy defines how a restore handles files that already exist at the destination
+type RestoreConflictPolicy string
+
+const (
+	RestoreConflictPolicyOverwrite   RestoreConflictPolicy = "overwrite"   // Replace existing files
+	RestoreConflictPolicySkip        RestoreConflictPolicy = "skip"        // Keep existing files untouched
+	RestoreConflictPolicyKeepBoth    RestoreConflictPolicy = "keepBoth"    // Restore next to existing files with a suffix
+	RestoreConflictPolicyOnlyIfNewer RestoreConflictPolicy = "onlyIfNewer" // Replace existing files only if the archived version is newer
+)
+
+var AvailableRestoreConflictPolicies = []RestoreConflictPolicy{
+	RestoreConflictPolicyOverwrite,
+	RestoreConflictPolicySkip,
+	RestoreConflictPolicyKeepBoth,
+	RestoreConflictPolicyOnlyIfNewer,
+}
+
+// IsValid returns true if the policy is one of the known policies
+func (p RestoreConflictPolicy) IsValid() bool {
+	return slices.Contains(AvailableRestoreConflictPolicies, p)
+}
 
 // ============================================================================
 // QUEUE MANAGEMENT
@@ -104,16 +170,16 @@ func (Check) isADTVariant() Operation          { var zero Operation; return zero
 type OperationWeight int
 
 const (
-	WeightLight OperationWeight = iota // Quick operations (refresh, rename, single archive delete)
-	WeightHeavy                        // Resource-intensive operations (backup, prune, repo delete)
+	WeightLight OperationWeight = iota // Quick operations (refresh, rename, single archive delete, indexing one archive)
+	WeightHeavy                        // Resource-intensive operations (backup, prune, repo delete, restore, export, import)
 )
 
 // GetOperationWeight determines operation weight for concurrency control
 func GetOperationWeight(op Operation) OperationWeight {
 	switch GetOperationType(op) {
-	case OperationTypeBackup, OperationTypePrune, OperationTypeDelete, OperationTypeCheck:
+	case OperationTypeBackup, OperationTypePrune, OperationTypeDelete, OperationTypeCheck, OperationTypeRestore, OperationTypeExportArchive, OperationTypeImportTar:
 		return WeightHeavy
-	case OperationTypeArchiveRefresh, OperationTypeArchiveDelete, OperationTypeArchiveRename, OperationTypeArchiveComment, OperationTypeMount, OperationTypeMountArchive, OperationTypeUnmount, OperationTypeUnmountArchive, OperationTypeExaminePrune:
+	case OperationTypeArchiveRefresh, OperationTypeArchiveDelete, OperationTypeArchiveRename, OperationTypeArchiveComment, OperationTypeMount, OperationTypeMountArchive, OperationTypeUnmount, OperationTypeUnmountArchive, OperationTypeExaminePrune, OperationTypeIndexFiles:
 		return WeightLight
 	default:
 		assert.Fail("Unhandled OperationType in GetOperationWeight")
@@ -117,6 +117,9 @@ func (sm *RepositoryStateMachine) initializeTransitions() {
 	deleting := NewRepositoryStateDeleting(Deleting{})
 	refreshing := NewRepositoryStateRefreshing(Refreshing{})
 	checking := NewRepositoryStateChecking(Checking{})
+	restoring := NewRepositoryStateRestoring(Restoring{})
+	exporting := NewRepositoryStateExporting(Exporting{})
+	importing := NewRepositoryStateImporting(Importing{})
 	mounting := NewRepositoryStateMounting(Mounting{})
 	mounted := NewRepositoryStateMounted(Mounted{})
 	errorState := NewRepositoryStateError(Error{})
@@ -141,6 +144,9 @@ func (sm *RepositoryStateMachine) initializeTransitions() {
 		{From: idle, To: deleting, Guard: nop},   // Start repository delete operation
 		{From: idle, To: refreshing, Guard: nop}, // Start refreshing archive list
 		{From: idle, To: checking, Guard: nop},   // Start checking repository integrity
+		{From: idle, To: restoring, Guard: nop},  // Start restoring files from an archive
+		{From: idle, To: exporting, Guard: nop},  // Start exporting an archive as tar file
+		{From: idle, To: importing, Guard: nop},  // Start importing a tar file as archive
 		{From: idle, To: mounting, Guard: nop},   // Start mounting repository or archive
 		{From: idle, To: errorState, Guard: nop}, // Unexpected error (e.g., repository locked)
 
@@ -150,6 +156,9 @@ func (sm *RepositoryStateMachine) initializeTransitions() {
 		{From: queued, To: deleting, Guard: nop},   // Delete operation starts from queue
 		{From: queued, To: refreshing, Guard: nop}, // Refresh operation starts from queue
 		{From: queued, To: checking, Guard: nop},   // Check operation starts from queue
+		{From: queued, To: restoring, Guard: nop},  // Restore operation starts from queue
+		{From: queued, To: exporting, Guard: nop},  // Export operation starts from queue
+		{From: queued, To: importing, Guard: nop},  // Import operation starts from queue
 		{From: queued, To: mounting, Guard: nop},   // Mount operation starts from queue
 		{From: queued, To: idle, Guard: nop},       // Queue cleared or all operations expired
 		{From: queued, To: errorState, Guard: nop}, // Queue processing error
@@ -179,6 +188,21 @@ func (sm *RepositoryStateMachine) initializeTransitions() {
 		{From: checking, To: errorState, Guard: nop},      // Check failed with error
 		{From: checking, To: queued, Guard: hasQueuedOps}, // Check cancelled, more operations waiting
 
+		// From Restoring
+		{From: restoring, To: idle, Guard: nop},            // Restore completed successfully
+		{From: restoring, To: errorState, Guard: nop},      // Restore failed with error
+		{From: restoring, To: queued, Guard: hasQueuedOps}, // Restore cancelled, more operations waiting
+
+		// From Exporting
+		{From: exporting, To: idle, Guard: nop},            // Export completed successfully
+		{From: exporting, To: errorState, Guard: nop},      // Export failed with error
+		{From: exporting, To: queued, Guard: hasQueuedOps}, // Export cancelled, more operations waiting
+
+		// From Importing
+		{From: importing, To: idle, Guard: nop},            // Import completed successfully
+		{From: importing, To: errorState, Guard: nop},      // Import failed with error
+		{From: importing, To: queued, Guard: hasQueuedOps}, // Import cancelled, more operations waiting
+
 		// From Mounting
 		{From: mounting, To: mounted, Guard: nop},         // Mount completed successfully
 		{From: mounting, To: errorState, Guard: nop},      // Mount failed with error
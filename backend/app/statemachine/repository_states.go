@@ -56,6 +56,24 @@ type Checking struct {
 	cancelCtx cancelCtx
 }
 
+type Restoring struct {
+	ArchiveID int       `json:"archiveId"`
+	StartedAt time.Time `json:"startedAt"`
+	cancelCtx cancelCtx
+}
+
+type Exporting struct {
+	ArchiveID int       `json:"archiveId"`
+	StartedAt time.Time `json:"startedAt"`
+	cancelCtx cancelCtx
+}
+
+type Importing struct {
+	BackupID  types.BackupId `json:"backupId"`
+	StartedAt time.Time      `json:"startedAt"`
+	cancelCtx cancelCtx
+}
+
 type Mounting struct {
 	MountType MountType `json:"mountType"`
 	ArchiveID *int      `json:"archiveId,omitempty"`
@@ -83,6 +101,9 @@ func (Pruning) isADTVariant() RepositoryState    { var zero RepositoryState; ret
 func (Deleting) isADTVariant() RepositoryState   { var zero RepositoryState; return zero }
 func (Refreshing) isADTVariant() RepositoryState { var zero RepositoryState; return zero }
 func (Checking) isADTVariant() RepositoryState   { var zero RepositoryState; return zero }
+func (Restoring) isADTVariant() RepositoryState  { var zero RepositoryState; return zero }
+func (Exporting) isADTVariant() RepositoryState  { var zero RepositoryState; return zero }
+func (Importing) isADTVariant() RepositoryState  { var zero RepositoryState; return zero }
 func (Mounting) isADTVariant() RepositoryState   { var zero RepositoryState; return zero }
 func (Mounted) isADTVariant() RepositoryState    { var zero RepositoryState; return zero }
 func (Error) isADTVariant() RepositoryState      { var zero RepositoryState; return zero }
@@ -149,6 +170,12 @@ func GetStateTypeName(state RepositoryState) string {
 		return "Refreshing"
 	case RepositoryStateTypeChecking:
 		return "Checking"
+	case RepositoryStateTypeRestoring:
+		return "Restoring"
+	case RepositoryStateTypeExporting:
+		return "Exporting"
+	case RepositoryStateTypeImporting:
+		return "Importing"
 	case RepositoryStateTypeMounting:
 		return "Mounting"
 	case RepositoryStateTypeMounted:
@@ -166,7 +193,7 @@ func GetStateTypeName(state RepositoryState) string {
 // IsActiveState returns true if the state represents an active operation
 func IsActiveState(state RepositoryState) bool {
 	switch GetRepositoryStateType(state) {
-	case RepositoryStateTypeBackingUp, RepositoryStateTypePruning, RepositoryStateTypeDeleting, RepositoryStateTypeRefreshing, RepositoryStateTypeChecking, RepositoryStateTypeMounting:
+	case RepositoryStateTypeBackingUp, RepositoryStateTypePruning, RepositoryStateTypeDeleting, RepositoryStateTypeRefreshing, RepositoryStateTypeChecking, RepositoryStateTypeRestoring, RepositoryStateTypeExporting, RepositoryStateTypeImporting, RepositoryStateTypeMounting:
 		return true
 	case RepositoryStateTypeIdle, RepositoryStateTypeQueued, RepositoryStateTypeMounted, RepositoryStateTypeError:
 		return false
@@ -229,6 +256,18 @@ func GetCancelCtxOrDefault(defaultContext context.Context, state RepositoryState
 		checkingVariant := state.(CheckingVariant)
 		data := checkingVariant()
 		return data.cancelCtx.ctx
+	case RepositoryStateTypeRestoring:
+		restoringVariant := state.(RestoringVariant)
+		data := restoringVariant()
+		return data.cancelCtx.ctx
+	case RepositoryStateTypeExporting:
+		exportingVariant := state.(ExportingVariant)
+		data := exportingVariant()
+		return data.cancelCtx.ctx
+	case RepositoryStateTypeImporting:
+		importingVariant := state.(ImportingVariant)
+		data := importingVariant()
+		return data.cancelCtx.ctx
 	case RepositoryStateTypeIdle, RepositoryStateTypeQueued, RepositoryStateTypeMounted, RepositoryStateTypeMounting, RepositoryStateTypeError:
 		return defaultContext
 	default:
@@ -260,6 +299,18 @@ func GetCancel(state RepositoryState) (context.CancelFunc, bool) {
 		checkingVariant := state.(CheckingVariant)
 		data := checkingVariant()
 		return data.cancelCtx.cancel, true
+	case RepositoryStateTypeRestoring:
+		restoringVariant := state.(RestoringVariant)
+		data := restoringVariant()
+		return data.cancelCtx.cancel, true
+	case RepositoryStateTypeExporting:
+		exportingVariant := state.(ExportingVariant)
+		data := exportingVariant()
+		return data.cancelCtx.cancel, true
+	case RepositoryStateTypeImporting:
+		importingVariant := state.(ImportingVariant)
+		data := importingVariant()
+		return data.cancelCtx.cancel, true
 	case RepositoryStateTypeIdle, RepositoryStateTypeQueued, RepositoryStateTypeMounted, RepositoryStateTypeMounting, RepositoryStateTypeError:
 		return nil, false
 	default:
@@ -336,6 +387,33 @@ func CreateCheckingState(ctx context.Context) RepositoryState {
 	})
 }
 
+// CreateRestoringState creates a new restoring state with context and archive ID
+func CreateRestoringState(ctx context.Context, archiveId int) RepositoryState {
+	return NewRepositoryStateRestoring(Restoring{
+		ArchiveID: archiveId,
+		StartedAt: time.Now(),
+		cancelCtx: createCancelContext(ctx),
+	})
+}
+
+// CreateExportingState creates a new exporting state with context and archive ID
+func CreateExportingState(ctx context.Context, archiveId int) RepositoryState {
+	return NewRepositoryStateExporting(Exporting{
+		ArchiveID: archiveId,
+		StartedAt: time.Now(),
+		cancelCtx: createCancelContext(ctx),
+	})
+}
+
+// CreateImportingState creates a new importing state with context and backup ID
+func CreateImportingState(ctx context.Context, backupID types.BackupId) RepositoryState {
+	return NewRepositoryStateImporting(Importing{
+		BackupID:  backupID,
+		StartedAt: time.Now(),
+		cancelCtx: createCancelContext(ctx),
+	})
+}
+
 // CreateMountingState creates a new mounting state
 func CreateMountingState(archiveID *int) RepositoryState {
 	mountType := MountTypeRepository
@@ -16,6 +16,8 @@ const (
 	EventNotificationAvailable  Event = "notificationAvailable"
 	EventBackupStateChanged     Event = "backupStateChanged"
 	EventPruneStateChanged      Event = "pruneStateChanged"
+	EventRestoreStateChanged    Event = "restoreStateChanged"
+	EventExportStateChanged     Event = "exportStateChanged"
 	EventRepoStateChanged       Event = "repoStateChanged"
 	EventArchivesChanged        Event = "archivesChanged"
 	EventBackupProfileCreated   Event = "backupProfileCreated"
@@ -40,6 +42,8 @@ var AllEvents = []Event{
 	EventNotificationAvailable,
 	EventBackupStateChanged,
 	EventPruneStateChanged,
+	EventRestoreStateChanged,
+	EventExportStateChanged,
 	EventRepoStateChanged,
 	EventArchivesChanged,
 	EventBackupProfileCreated,
@@ -71,6 +75,14 @@ func EventPruneStateChangedString(bId BackupId) string {
 	return fmt.Sprintf("%s:%d-%d", EventPruneStateChanged.String(), bId.BackupProfileId, bId.RepositoryId)
 }
 
+func EventRestoreStateChangedString(repoId int) string {
+	return fmt.Sprintf("%s:%d", EventRestoreStateChanged.String(), repoId)
+}
+
+func EventExportStateChangedString(repoId int) string {
+	return fmt.Sprintf("%s:%d", EventExportStateChanged.String(), repoId)
+}
+
 func EventRepoStateChangedString(repoId int) string {
 	return fmt.Sprintf("%s:%d", EventRepoStateChanged.String(), repoId)
 }
@@ -22,11 +22,20 @@ type Borg interface {
 	Version(ctx context.Context) (*version.Version, *types.Status)
 	MountVersion(ctx context.Context) (*version.Version, *types.Status)
 	Info(ctx context.Context, repository, password string, allowRelocated bool) (*types.InfoResponse, *types.Status)
+	ArchiveInfo(ctx context.Context, repository, archive, password string) (*types.ArchiveInfo, *types.Status)
+	ArchivesInfo(ctx context.Context, repository, password string, last int) ([]types.ArchiveInfo, *types.Status)
 	Init(ctx context.Context, repository, password string, noPassword bool) *types.Status
 	List(ctx context.Context, repository string, password string, glob string) (*types.ListResponse, *types.Status)
+	ListFiles(ctx context.Context, repository, archive, password string, paths []string) ([]types.ArchiveItem, *types.Status)
+	StreamFiles(ctx context.Context, repository, archive, password string, paths []string, ch chan types.ArchiveItem) *types.Status
 	Compact(ctx context.Context, repository string, password string) *types.Status
-	Check(ctx context.Context, repository, password string, quick bool) *types.CheckResult
-	Create(ctx context.Context, repository, password, prefix string, backupPaths, excludePaths []string, excludeCaches bool, compressionMode backupprofile.CompressionMode, compressionLevel *int, ch chan types.BackupProgress) (string, *types.Status)
+	Check(ctx context.Context, repository, password string, quick bool, filter *types.CheckArchiveFilter) *types.CheckResult
+	Create(ctx context.Context, repository, password, prefix string, backupPaths []string, options types.CreateOptions, contentCommand *types.ContentCommand, estimate types.BackupEstimate, ch chan types.BackupProgress) (string, *types.Status)
+	DryRunCreate(ctx context.Context, backupPaths []string, options types.CreateOptions, ch chan types.FileStatus) *types.Status
+	Diff(ctx context.Context, repository, archiveA, archiveB, password string, ch chan types.DiffEntry) *types.Status
+	Extract(ctx context.Context, repository, archive, password, targetDir string, paths, excludePaths []string, stripComponents int, ch chan types.ExtractProgress) *types.Status
+	ExportTar(ctx context.Context, repository, archive, password, outputFile string, format types.TarFormat, paths, excludePaths []string, ch chan types.ExtractProgress) *types.Status
+	ImportTar(ctx context.Context, repository, password, prefix, tarFile, comment string, timestamp *time.Time, compressionMode backupprofile.CompressionMode, compressionLevel *int) (string, *types.Status)
 	Rename(ctx context.Context, repository, archive, password, newName string) *types.Status
 	DeleteArchive(ctx context.Context, repository string, archive string, password string) *types.Status
 	DeleteArchives(ctx context.Context, repository, password, prefix string) *types.Status
@@ -46,6 +55,7 @@ type borg struct {
 	log            *CmdLogger
 	sshPrivateKeys []string
 	commandRunner  CommandRunner
+	ignoreFiles    *ignoreFileCache
 }
 
 type CommandRunner interface {
@@ -68,6 +78,7 @@ func NewBorg(path string, mountPath string, log *zap.SugaredLogger, sshPrivateKe
 		log:            NewCmdLogger(log),
 		sshPrivateKeys: sshPrivateKeys,
 		commandRunner:  cr,
+		ignoreFiles:    newIgnoreFileCache(),
 	}
 }
 
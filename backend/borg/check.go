@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,14 +12,24 @@ import (
 	"github.com/loomi-labs/arco/backend/borg/types"
 )
 
-// Check runs the borg check command to verify repository integrity
-func (b *borg) Check(ctx context.Context, repository, password string, quick bool) *types.CheckResult {
+// Check runs the borg check command to verify repository integrity.
+// A filter restricts a full check to the data of the selected archives (ignored for quick checks).
+func (b *borg) Check(ctx context.Context, repository, password string, quick bool, filter *types.CheckArchiveFilter) *types.CheckResult {
 	cmdStr := []string{"check"}
 
 	if quick {
 		cmdStr = append(cmdStr, "--repository-only")
 	} else {
 		cmdStr = append(cmdStr, "--verify-data")
+		if filter != nil {
+			cmdStr = append(cmdStr, "--archives-only")
+			if filter.GlobArchives != "" {
+				cmdStr = append(cmdStr, "--glob-archives", filter.GlobArchives)
+			}
+			if filter.Last > 0 {
+				cmdStr = append(cmdStr, "--last", strconv.Itoa(filter.Last))
+			}
+		}
 	}
 
 	cmdStr = append(cmdStr, "--log-json", repository)
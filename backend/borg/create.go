@@ -1,11 +1,19 @@
 package borg
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	gocmd "github.com/go-cmd/cmd"
@@ -13,20 +21,18 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 )
 
+// contentCommandWaitDelay is how long borg waits for the stdin copy to finish after it exited
+const contentCommandWaitDelay = 5 * time.Second
+
 // Create creates a new backup in the repository.
 // It is long running and should be run in a goroutine.
-func (b *borg) Create(ctx context.Context, repository, password, prefix string, backupPaths, excludePaths []string, excludeCaches bool, compressionMode backupprofile.CompressionMode, compressionLevel *int, ch chan types.BackupProgress) (string, *types.Status) {
+// The estimate (usually the size of the previous archive) is used as total for the progress.
+// If a content command is given, its output is stored as a single file in the archive.
+// Pattern rules are passed with --patterns-from and are evaluated before the exclude paths.
+// If ignore files are used, the rules of the ignore files in the backup paths are evaluated last.
+func (b *borg) Create(ctx context.Context, repository, password, prefix string, backupPaths []string, options types.CreateOptions, contentCommand *types.ContentCommand, estimate types.BackupEstimate, ch chan types.BackupProgress) (string, *types.Status) {
 	archivePath := fmt.Sprintf("%s::%s%s", repository, prefix, time.Now().In(time.Local).Format("2006-01-02-15-04-05"))
 
-	// Count the total files to backup
-	totalFiles, borgStatus, err := b.countBackupFiles(ctx, archivePath, password, backupPaths, excludePaths, excludeCaches, compressionMode, compressionLevel)
-	if err != nil {
-		return "", newStatusWithError(err)
-	}
-	if !borgStatus.IsCompletedWithSuccess() {
-		return "", borgStatus
-	}
-
 	// Prepare backup command
 	cmdStr := []string{
 		"create",     // https://borgbackup.readthedocs.io/en/stable/usage/create.html#borg-create
@@ -35,48 +41,81 @@ func (b *borg) Create(ctx context.Context, repository, password, prefix string,
 	}
 
 	// Add compression flag if enabled
-	if compressionFlag := buildCompressionFlag(compressionMode, compressionLevel); compressionFlag != "" {
+	if compressionFlag := buildCompressionFlag(options.CompressionMode, options.CompressionLevel); compressionFlag != "" {
 		cmdStr = append(cmdStr, compressionFlag)
 	}
 
-	// Add archive path and backup paths
-	cmdStr = append(cmdStr, archivePath)
-	cmdStr = append(cmdStr, backupPaths...)
+	// Add the advanced options
+	cmdStr = append(cmdStr, buildCreateOptionArgs(options)...)
 
-	// Add exclude paths
-	for _, excludeDir := range excludePaths {
-		cmdStr = append(cmdStr, "--exclude", excludeDir) // Paths and files that will be ignored
+	// Add the selection of paths
+	selectionArgs, cleanup, err := b.buildSelectionArgs(backupPaths, options)
+	if err != nil {
+		return archivePath, b.log.LogCmdStatus(ctx, newStatusWithError(err), fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " ")), 0)
 	}
+	defer cleanup()
+	cmdStr = append(cmdStr, selectionArgs...)
 
-	// Add exclude caches flag
-	if excludeCaches {
-		cmdStr = append(cmdStr, "--exclude-caches")
+	// Add archive path and backup paths
+	cmdStr = append(cmdStr, buildCreateSourceArgs(archivePath, backupPaths, contentCommand)...)
+
+	// Borg can only run the content command itself if there is nothing else to back up.
+	// Otherwise, the output of the command is passed to borg through stdin.
+	readStdin := contentCommand != nil && len(backupPaths) > 0
+
+	cmdOptions := gocmd.Options{Buffered: false, Streaming: true}
+	if readStdin {
+		cmdOptions.BeforeExec = []func(cmd *exec.Cmd){func(cmd *exec.Cmd) {
+			// Don't wait for a content command that stopped producing output after borg exited
+			cmd.WaitDelay = contentCommandWaitDelay
+		}}
 	}
-
-	options := gocmd.Options{Buffered: false, Streaming: true}
-	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmd := gocmd.NewCmdOptions(cmdOptions, b.path, cmdStr...)
 	cmdLog := fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " "))
 	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
 
+	var source *contentCommandSource
+	if readStdin {
+		source, err = startContentCommand(ctx, contentCommand.Command, func() {
+			if err := cmd.Stop(); err != nil {
+				b.log.Errorf("error stopping command: %v", err)
+			}
+		})
+		if err != nil {
+			return archivePath, b.log.LogCmdStatus(ctx, newStatusWithError(err), cmdLog, 0)
+		}
+	}
+
 	// Run backup command
 	b.log.LogCmdStart(cmdLog)
-	statusChan := cmd.Start()
+	var statusChan <-chan gocmd.Status
+	if source != nil {
+		statusChan = cmd.StartWithStdin(source)
+	} else {
+		statusChan = cmd.Start()
+	}
 
-	go decodeBackupProgress(cmd, totalFiles, ch)
+	errorMessageCh := make(chan string, 1)
+	go func() {
+		errorMessageCh <- decodeBackupProgress(cmd, estimate, ch)
+	}()
 
 	select {
 	case <-ctx.Done():
 		// If the context gets cancelled we stop the command
-		err = cmd.Stop()
+		err := cmd.Stop()
 		if err != nil {
 			b.log.Errorf("error stopping command: %v", err)
 		}
 
 		// We still have to wait for the command to finish
 		_ = <-statusChan
+		if source != nil {
+			source.stop()
+		}
 
 		// We don't care about the real status of the borg operation because we canceled it
-		borgStatus = newStatusWithCanceled()
+		borgStatus := newStatusWithCanceled()
 		return archivePath, b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
 	case _ = <-statusChan:
 		// Break in case the command completes
@@ -85,47 +124,26 @@ func (b *borg) Create(ctx context.Context, repository, password, prefix string,
 
 	// If we are here the command has completed
 	status := cmd.Status()
-	borgStatus = gocmdToStatus(status, "")
-	return archivePath, b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
-}
-
-// decodeBackupProgress decodes the progress messages from borg and sends them to the channel.
-func decodeBackupProgress(cmd *gocmd.Cmd, totalFiles int, ch chan<- types.BackupProgress) {
-	defer close(ch)
-	for {
-		select {
-		case _ = <-cmd.Stdout:
-			// ignore stdout (info comes through stderr)
-		case data := <-cmd.Stderr:
-			var typeMsg types.Type
-			if err := json.Unmarshal([]byte(data), &typeMsg); err != nil {
-				// Skip errors
-				continue
-			}
-			if types.JSONType(typeMsg.Type) != types.ArchiveProgressType {
-				// We only care about archive progress
-				continue
-			}
-
-			var archiveProgress types.ArchiveProgress
-			if err := json.Unmarshal([]byte(data), &archiveProgress); err != nil {
-				// Skip errors
-				continue
-			}
-			if archiveProgress.Finished {
-				ch <- types.BackupProgress{TotalFiles: totalFiles, ProcessedFiles: totalFiles}
-			} else if totalFiles > 0 && archiveProgress.NFiles > 0 {
-				ch <- types.BackupProgress{TotalFiles: totalFiles, ProcessedFiles: archiveProgress.NFiles}
-			}
-		case <-cmd.Done():
-			return
+	errorMessage := <-errorMessageCh
+	borgStatus := gocmdToStatus(status, "")
+
+	// A failed content command must not look like a canceled or generic borg error
+	if source != nil {
+		source.stop()
+		if err := source.failure(); err != nil {
+			borgStatus = newStatusWithContentCommandError(err.Error())
 		}
+	} else if contentCommand != nil && borgStatus.HasError() && borgStatus.Error.ExitCode == types.ErrorCommandError.ExitCode {
+		borgStatus = newStatusWithContentCommandError(errorMessage)
 	}
+	return archivePath, b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
 }
 
-// countBackupFiles counts the number of files that will be backed up.
-// We use the --dry-run flag to simulate the backup and count the files.
-func (b *borg) countBackupFiles(ctx context.Context, archiveName, password string, backupPaths, excludePaths []string, excludeCaches bool, compressionMode backupprofile.CompressionMode, compressionLevel *int) (int, *types.Status, error) {
+// DryRunCreate runs borg create --dry-run --list for the selection of paths and sends the status of every path
+// to the channel ("-" for included and "x" for excluded paths). The channel is closed once all paths have been sent.
+// Borg doesn't open the repository during a dry-run, so no repository is needed.
+// It is long running and should be run in a goroutine.
+func (b *borg) DryRunCreate(ctx context.Context, backupPaths []string, options types.CreateOptions, ch chan types.FileStatus) *types.Status {
 	cmdStr := []string{
 		"create",     // https://borgbackup.readthedocs.io/en/stable/usage/create.html#borg-create
 		"--dry-run",  // Simulate the backup
@@ -133,36 +151,36 @@ func (b *borg) countBackupFiles(ctx context.Context, archiveName, password strin
 		"--log-json", // Outputs JSON log messages
 	}
 
-	// Add compression flag if enabled
-	if compressionFlag := buildCompressionFlag(compressionMode, compressionLevel); compressionFlag != "" {
-		cmdStr = append(cmdStr, compressionFlag)
-	}
-
-	// Add archive name and backup paths
-	cmdStr = append(cmdStr, archiveName)
-	cmdStr = append(cmdStr, backupPaths...)
+	// Add the advanced options (e.g. --one-file-system changes which paths are backed up)
+	cmdStr = append(cmdStr, buildCreateOptionArgs(options)...)
 
-	// Add exclude paths
-	for _, excludeDir := range excludePaths {
-		cmdStr = append(cmdStr, "--exclude", excludeDir) // Paths and files that will be ignored
+	// Add the selection of paths
+	selectionArgs, cleanup, err := b.buildSelectionArgs(backupPaths, options)
+	if err != nil {
+		close(ch)
+		return b.log.LogCmdStatus(ctx, newStatusWithError(err), fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " ")), 0)
 	}
+	defer cleanup()
+	cmdStr = append(cmdStr, selectionArgs...)
 
-	// Add exclude caches flag
-	if excludeCaches {
-		cmdStr = append(cmdStr, "--exclude-caches")
-	}
+	// The archive path only has to be valid
+	cmdStr = append(cmdStr, filepath.Join(os.TempDir(), "arco-dry-run")+"::dry-run")
+	cmdStr = append(cmdStr, backupPaths...)
 
-	options := gocmd.Options{Buffered: false, Streaming: true}
-	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmdOptions := gocmd.Options{Buffered: false, Streaming: true}
+	cmd := gocmd.NewCmdOptions(cmdOptions, b.path, cmdStr...)
 	cmdLog := fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " "))
-	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+	cmd.Env = NewEnv(b.sshPrivateKeys).AsList()
 
 	// Run dry-run command
 	b.log.LogCmdStart(cmdLog)
 	statusChan := cmd.Start()
-	fileCountChan := make(chan int)
 
-	go countFiles(cmd, fileCountChan)
+	decodeDone := make(chan struct{})
+	go func() {
+		defer close(decodeDone)
+		decodeFileStatus(cmd, ch)
+	}()
 
 	select {
 	case <-ctx.Done():
@@ -174,56 +192,225 @@ func (b *borg) countBackupFiles(ctx context.Context, archiveName, password strin
 
 		// We still have to wait for the command to finish
 		_ = <-statusChan
+
+		// We don't care about the real status of the borg operation because we canceled it
+		borgStatus := newStatusWithCanceled()
+		return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
 	case _ = <-statusChan:
 		// Break in case the command completes
 		break
 	}
 
-	// If we are here the command has completed or the context has been cancelled
+	// Make sure all paths have been sent before returning
+	<-decodeDone
+
+	// If we are here the command has completed
 	status := cmd.Status()
-	result := gocmdToStatus(status, "")
-	if result.HasError() || result.HasBeenCanceled {
-		return 0, b.log.LogCmdStatus(ctx, result, cmdLog, time.Duration(status.Runtime)), nil
+	borgStatus := gocmdToStatus(status, "")
+	return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
+}
+
+// decodeFileStatus decodes the file status messages of borg --list and sends them to the channel.
+// It returns once both output streams are closed.
+func decodeFileStatus(cmd *gocmd.Cmd, ch chan<- types.FileStatus) {
+	defer close(ch)
+	stdout, stderr := cmd.Stdout, cmd.Stderr
+	for stdout != nil || stderr != nil {
+		select {
+		case _, ok := <-stdout:
+			// ignore stdout (info comes through stderr)
+			if !ok {
+				stdout = nil
+			}
+		case data, ok := <-stderr:
+			if !ok {
+				stderr = nil
+				continue
+			}
+			var typeMsg types.Type
+			if err := json.Unmarshal([]byte(data), &typeMsg); err != nil || types.JSONType(typeMsg.Type) != types.FileStatusType {
+				// Skip log messages
+				continue
+			}
+			var fileStatus types.FileStatus
+			if err := json.Unmarshal([]byte(data), &fileStatus); err != nil {
+				// Skip errors
+				continue
+			}
+			ch <- fileStatus
+		}
 	}
+}
 
-	b.log.LogCmdStatus(ctx, result, cmdLog, time.Duration(status.Runtime))
+// buildSelectionArgs builds the arguments that select which paths are backed up.
+// Pattern rules are evaluated before the exclude paths, the rules of ignore files come last so that the
+// settings of the profile take precedence.
+// The returned cleanup function removes the pattern files and must be called once borg exited.
+func (b *borg) buildSelectionArgs(backupPaths []string, options types.CreateOptions) ([]string, func(), error) {
+	var args []string
+	var patternsFiles []string
+	cleanup := func() {
+		for _, patternsFile := range patternsFiles {
+			if err := os.Remove(patternsFile); err != nil {
+				b.log.Errorf("error removing patterns file: %v", err)
+			}
+		}
+	}
+	addPatternsFile := func(patterns []string) error {
+		patternsFile, err := writePatternsFile(patterns)
+		if err != nil {
+			return err
+		}
+		patternsFiles = append(patternsFiles, patternsFile)
+		args = append(args, "--patterns-from", patternsFile)
+		return nil
+	}
 
-	select {
-	case totalFiles := <-fileCountChan:
-		return totalFiles, result, nil
-	case <-time.After(10 * time.Second):
-		return 0, result, fmt.Errorf("timeout reached while counting files")
+	// Add pattern rules
+	if len(options.Patterns) > 0 {
+		if err := addPatternsFile(options.Patterns); err != nil {
+			return nil, nil, err
+		}
 	}
+
+	// Add exclude paths
+	for _, excludeDir := range options.ExcludePaths {
+		args = append(args, "--exclude", excludeDir) // Paths and files that will be ignored
+	}
+
+	// Add rules of ignore files
+	if options.UseIgnoreFiles {
+		if ignoreRules := b.findIgnoreFileRules(backupPaths, options); len(ignoreRules) > 0 {
+			if err := addPatternsFile(ignoreRules); err != nil {
+				cleanup()
+				return nil, nil, err
+			}
+		}
+	}
+
+	// Add exclude caches flag
+	if options.ExcludeCaches {
+		args = append(args, "--exclude-caches")
+	}
+
+	// Add marker files
+	for _, marker := range options.ExcludeIfPresent {
+		args = append(args, "--exclude-if-present", marker) // Directories containing the marker are ignored
+	}
+	return args, cleanup, nil
 }
 
-// countFiles counts the number of files in the output of the borg --list command.
-func countFiles(cmd *gocmd.Cmd, fileCountChan chan int) {
-	totalFiles := 0
+// buildCreateSourceArgs builds the archive path and the sources of a borg create command.
+// A content command is run by borg (--content-from-command) if there are no backup paths,
+// otherwise its output is read from stdin ("-").
+func buildCreateSourceArgs(archivePath string, backupPaths []string, contentCommand *types.ContentCommand) []string {
+	if contentCommand == nil {
+		return append([]string{archivePath}, backupPaths...)
+	}
+
+	args := []string{"--stdin-name", contentCommand.StdinName}
+	if len(backupPaths) == 0 {
+		args = append(args, "--content-from-command", archivePath, "--")
+		// The command inherits the environment of borg which contains the passphrase
+		return append(args, "env", "-u", "BORG_PASSPHRASE", "sh", "-c", contentCommand.Command)
+	}
+
+	// Stdin comes first so that the command is not kept waiting while borg reads the other paths
+	args = append(args, archivePath, "-")
+	return append(args, backupPaths...)
+}
+
+// newStatusWithContentCommandError creates the status of a backup whose content command failed
+func newStatusWithContentCommandError(detail string) *types.Status {
+	message := "content command failed"
+	if detail != "" {
+		message = fmt.Sprintf("%s: %s", message, detail)
+	}
+	return &types.Status{
+		Error: &types.BorgError{
+			ExitCode: types.ErrorCommandError.ExitCode,
+			Message:  message,
+			Category: types.CategoryBackup,
+		},
+	}
+}
+
+// decodeBackupProgress decodes the progress messages from borg and sends them to the channel.
+// It returns the last error message logged by borg.
+func decodeBackupProgress(cmd *gocmd.Cmd, estimate types.BackupEstimate, ch chan<- types.BackupProgress) string {
+	defer close(ch)
+	var last types.BackupProgress
+	var errorMessage string
+	handleStderr := func(data string) {
+		var typeMsg types.Type
+		if err := json.Unmarshal([]byte(data), &typeMsg); err != nil {
+			// Skip errors
+			return
+		}
+		switch types.JSONType(typeMsg.Type) {
+		case types.LogMessageType:
+			var logMsg types.LogMessage
+			if err := json.Unmarshal([]byte(data), &logMsg); err == nil && (logMsg.LevelName == "ERROR" || logMsg.LevelName == "CRITICAL") {
+				errorMessage = logMsg.Message
+			}
+		case types.ArchiveProgressType:
+			var archiveProgress types.ArchiveProgress
+			if err := json.Unmarshal([]byte(data), &archiveProgress); err != nil {
+				// Skip errors
+				return
+			}
+			if archiveProgress.Finished {
+				// The finished message has no counters, so we report the last values as complete
+				ch <- types.BackupProgress{
+					TotalFiles:     last.ProcessedFiles,
+					ProcessedFiles: last.ProcessedFiles,
+					TotalBytes:     last.ProcessedBytes,
+					ProcessedBytes: last.ProcessedBytes,
+				}
+			} else if archiveProgress.NFiles > 0 || archiveProgress.OriginalSize > 0 {
+				last = estimateBackupProgress(estimate, archiveProgress.NFiles, int(archiveProgress.OriginalSize))
+				ch <- last
+			}
+		default:
+			// We only care about archive progress and errors
+		}
+	}
 
 	for {
 		select {
 		case _ = <-cmd.Stdout:
 			// ignore stdout (info comes through stderr)
-		case data := <-cmd.Stderr:
-			var fileStatus types.FileStatus
-			if err := json.Unmarshal([]byte(data), &fileStatus); err != nil {
-				// Skip errors
-				continue
-			}
-
-			stat, err := os.Stat(fileStatus.Path)
-			if err != nil || stat.IsDir() {
-				// Skip errors and directories
-				continue
+		case data, ok := <-cmd.Stderr:
+			if ok {
+				handleStderr(data)
 			}
-			totalFiles++
 		case <-cmd.Done():
-			fileCountChan <- totalFiles
-			return
+			// Error messages are the last lines, read what is left in the stream
+			for data := range cmd.Stderr {
+				handleStderr(data)
+			}
+			return errorMessage
 		}
 	}
 }
 
+// estimateBackupProgress creates the progress of a running backup.
+// Totals are taken from the estimate and grow with the processed values if the backup is larger than estimated.
+// Totals stay 0 if nothing is known about the size of the backup.
+func estimateBackupProgress(estimate types.BackupEstimate, processedFiles, processedBytes int) types.BackupProgress {
+	progress := types.BackupProgress{
+		ProcessedFiles: processedFiles,
+		ProcessedBytes: processedBytes,
+	}
+	if estimate.TotalFiles > 0 {
+		progress.TotalFiles = max(estimate.TotalFiles, processedFiles)
+	}
+	if estimate.TotalBytes > 0 {
+		progress.TotalBytes = max(estimate.TotalBytes, processedBytes)
+	}
+	return progress
+}
+
 // buildCompressionFlag builds the --compression flag for borg based on mode and level.
 // Always uses borg's auto prefix (auto,ALGO[,LEVEL]) which tests if compression
 // actually helps before applying it, avoiding wasted CPU on incompressible data.
@@ -254,3 +441,145 @@ func buildCompressionFlag(mode backupprofile.CompressionMode, level *int) string
 
 	return fmt.Sprintf("--compression=auto,%s", algo)
 }
+
+// buildCreateOptionArgs builds the flags of the advanced create options
+func buildCreateOptionArgs(options types.CreateOptions) []string {
+	var args []string
+	if options.OneFileSystem {
+		args = append(args, "--one-file-system")
+	}
+	if options.NumericIds {
+		args = append(args, "--numeric-ids")
+	}
+	if options.NoFlags {
+		args = append(args, "--noflags")
+	}
+	if options.NoAcls {
+		args = append(args, "--noacls")
+	}
+	if options.NoXattrs {
+		args = append(args, "--noxattrs")
+	}
+	if options.Sparse {
+		args = append(args, "--sparse")
+	}
+	if options.ReadSpecial {
+		args = append(args, "--read-special")
+	}
+	if options.CheckpointInterval != nil {
+		args = append(args, fmt.Sprintf("--checkpoint-interval=%d", *options.CheckpointInterval))
+	}
+	return args
+}
+
+// writePatternsFile writes the pattern rules to a temporary file that can be passed to --patterns-from.
+// The caller has to remove the file.
+func writePatternsFile(patterns []string) (string, error) {
+	file, err := os.CreateTemp("", "arco-patterns-*.lst")
+	if err != nil {
+		return "", fmt.Errorf("failed to create patterns file: %w", err)
+	}
+	_, err = file.WriteString(strings.Join(patterns, "\n") + "\n")
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return "", fmt.Errorf("failed to write patterns file: %w", err)
+	}
+	return file.Name(), nil
+}
+
+// contentCommandSource runs a content command and passes its output to borg through stdin.
+// The end of the output is only passed on once the command succeeded.
+// If the command fails, borg is stopped first so that it does not create an archive with truncated content.
+type contentCommandSource struct {
+	cmd      *exec.Cmd
+	stdout   io.ReadCloser
+	stderr   bytes.Buffer
+	stopBorg func()
+
+	waitOnce sync.Once
+	waited   atomic.Bool
+	failed   atomic.Bool // The command failed before borg read its whole output
+	err      error
+}
+
+// startContentCommand starts the command with sh -c in its own process group
+func startContentCommand(ctx context.Context, command string, stopBorg func()) (*contentCommandSource, error) {
+	s := &contentCommandSource{stopBorg: stopBorg}
+	s.cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	s.cmd.Stderr = &s.stderr
+	s.cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	s.cmd.Cancel = func() error {
+		return syscall.Kill(-s.cmd.Process.Pid, syscall.SIGTERM)
+	}
+
+	stdout, err := s.cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start content command: %w", err)
+	}
+	s.stdout = stdout
+	if err := s.cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start content command: %w", err)
+	}
+	return s, nil
+}
+
+// Read implements io.Reader for the stdin of borg
+func (s *contentCommandSource) Read(p []byte) (int, error) {
+	n, err := s.stdout.Read(p)
+	if !errors.Is(err, io.EOF) {
+		return n, err
+	}
+	if waitErr := s.wait(); waitErr != nil {
+		// Borg must be stopped before it sees the end of its input
+		s.failed.Store(true)
+		s.stopBorg()
+		return n, waitErr
+	}
+	return n, io.EOF
+}
+
+// wait waits for the command to exit and returns its error
+func (s *contentCommandSource) wait() error {
+	s.waitOnce.Do(func() {
+		err := s.cmd.Wait()
+		s.waited.Store(true)
+		if err == nil {
+			return
+		}
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			err = fmt.Errorf("exited with code %d", exitErr.ExitCode())
+		}
+		if detail := lastLine(s.stderr.String()); detail != "" {
+			err = fmt.Errorf("%w: %s", err, detail)
+		}
+		s.err = err
+	})
+	return s.err
+}
+
+// stop kills the command if it is still running (e.g. because borg failed) and waits for it
+func (s *contentCommandSource) stop() {
+	if !s.waited.Load() {
+		_ = syscall.Kill(-s.cmd.Process.Pid, syscall.SIGKILL)
+	}
+	_ = s.wait()
+}
+
+// failure returns the error of the command if it failed on its own.
+// If borg stopped reading early, the command was killed and the error of borg is what counts.
+func (s *contentCommandSource) failure() error {
+	if !s.failed.Load() {
+		return nil
+	}
+	return s.err
+}
+
+// lastLine returns the last non-empty line of the output
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,176 @@
+package borg
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - create.go
+
+TestEstimateBackupProgress
+* Use estimate of previous archive
+* Grow totals if backup is larger than estimated
+* Keep totals unknown without estimate
+* Fall back to bytes if file count is unknown
+
+TestBuildCreateSourceArgs
+* Back up paths only
+* Back up command output only
+* Back up command output and paths
+
+TestBuildCreateOptionArgs
+* No options
+* All options
+
+TestContentCommandSource
+* Stream output of successful command
+* Stop borg if command fails
+
+*/
+
+func TestEstimateBackupProgress(t *testing.T) {
+	tests := []struct {
+		name           string
+		estimate       types.BackupEstimate
+		processedFiles int
+		processedBytes int
+		output         types.BackupProgress
+	}{
+		{
+			name:           "Use estimate of previous archive",
+			estimate:       types.BackupEstimate{TotalFiles: 100, TotalBytes: 1000},
+			processedFiles: 10,
+			processedBytes: 200,
+			output:         types.BackupProgress{TotalFiles: 100, ProcessedFiles: 10, TotalBytes: 1000, ProcessedBytes: 200},
+		},
+		{
+			name:           "Grow totals if backup is larger than estimated",
+			estimate:       types.BackupEstimate{TotalFiles: 100, TotalBytes: 1000},
+			processedFiles: 150,
+			processedBytes: 2000,
+			output:         types.BackupProgress{TotalFiles: 150, ProcessedFiles: 150, TotalBytes: 2000, ProcessedBytes: 2000},
+		},
+		{
+			name:           "Keep totals unknown without estimate",
+			processedFiles: 10,
+			processedBytes: 200,
+			output:         types.BackupProgress{ProcessedFiles: 10, ProcessedBytes: 200},
+		},
+		{
+			name:           "Fall back to bytes if file count is unknown",
+			estimate:       types.BackupEstimate{TotalBytes: 1000},
+			processedFiles: 10,
+			processedBytes: 200,
+			output:         types.BackupProgress{ProcessedFiles: 10, TotalBytes: 1000, ProcessedBytes: 200},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.output, estimateBackupProgress(tt.estimate, tt.processedFiles, tt.processedBytes))
+		})
+	}
+}
+
+func TestBuildCreateSourceArgs(t *testing.T) {
+	tests := []struct {
+		name           string
+		backupPaths    []string
+		contentCommand *types.ContentCommand
+		output         []string
+	}{
+		{
+			name:        "Back up paths only",
+			backupPaths: []string{"/home", "/etc"},
+			output:      []string{"repo::archive", "/home", "/etc"},
+		},
+		{
+			name:           "Back up command output only",
+			contentCommand: &types.ContentCommand{Command: "pg_dump mydb", StdinName: "mydb.sql"},
+			output: []string{"--stdin-name", "mydb.sql", "--content-from-command", "repo::archive", "--",
+				"env", "-u", "BORG_PASSPHRASE", "sh", "-c", "pg_dump mydb"},
+		},
+		{
+			name:           "Back up command output and paths",
+			backupPaths:    []string{"/home"},
+			contentCommand: &types.ContentCommand{Command: "pg_dump mydb", StdinName: "mydb.sql"},
+			output:         []string{"--stdin-name", "mydb.sql", "repo::archive", "-", "/home"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.output, buildCreateSourceArgs("repo::archive", tt.backupPaths, tt.contentCommand))
+		})
+	}
+}
+
+func TestBuildCreateOptionArgs(t *testing.T) {
+	interval := 600
+	tests := []struct {
+		name    string
+		options types.CreateOptions
+		output  []string
+	}{
+		{
+			name:    "No options",
+			options: types.CreateOptions{},
+			output:  nil,
+		},
+		{
+			name: "All options",
+			options: types.CreateOptions{
+				OneFileSystem:      true,
+				NumericIds:         true,
+				NoFlags:            true,
+				NoAcls:             true,
+				NoXattrs:           true,
+				Sparse:             true,
+				ReadSpecial:        true,
+				CheckpointInterval: &interval,
+			},
+			output: []string{"--one-file-system", "--numeric-ids", "--noflags", "--noacls", "--noxattrs", "--sparse",
+				"--read-special", "--checkpoint-interval=600"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.output, buildCreateOptionArgs(tt.options))
+		})
+	}
+}
+
+func TestContentCommandSource(t *testing.T) {
+	t.Run("Stream output of successful command", func(t *testing.T) {
+		stopped := false
+		source, err := startContentCommand(context.Background(), "echo data", func() { stopped = true })
+		assert.NoError(t, err)
+
+		out, err := io.ReadAll(source)
+		source.stop()
+
+		assert.NoError(t, err)
+		assert.Equal(t, "data\n", string(out))
+		assert.False(t, stopped)
+		assert.NoError(t, source.failure())
+	})
+
+	t.Run("Stop borg if command fails", func(t *testing.T) {
+		stopped := false
+		source, err := startContentCommand(context.Background(), "echo data; echo broken pipe >&2; exit 3", func() { stopped = true })
+		assert.NoError(t, err)
+
+		_, err = io.ReadAll(source)
+		source.stop()
+
+		assert.Error(t, err)
+		assert.True(t, stopped)
+		assert.EqualError(t, source.failure(), "exited with code 3: broken pipe")
+	})
+}
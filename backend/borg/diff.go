@@ -0,0 +1,141 @@
+package borg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	gocmd "github.com/go-cmd/cmd"
+	"github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// Diff compares two archives of the same repository and sends every changed path to the channel.
+// The channel is closed once all entries have been sent.
+// It is long running and should be run in a goroutine.
+func (b *borg) Diff(ctx context.Context, repository, archiveA, archiveB, password string, ch chan types.DiffEntry) *types.Status {
+	if archiveA == "" || archiveB == "" {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("archive names must not be empty"))
+	}
+
+	// Prepare diff command
+	cmdStr := []string{
+		"diff",         // https://borgbackup.readthedocs.io/en/stable/usage/diff.html#borg-diff
+		"--json-lines", // Outputs one JSON object per changed path
+		"--log-json",   // Outputs JSON log messages
+		fmt.Sprintf("%s::%s", repository, archiveA),
+		archiveB,
+	}
+
+	options := gocmd.Options{Buffered: false, Streaming: true}
+	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmdLog := fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " "))
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// Run diff command
+	b.log.LogCmdStart(cmdLog)
+	statusChan := cmd.Start()
+
+	decodeDone := make(chan struct{})
+	go func() {
+		defer close(decodeDone)
+		decodeDiffOutput(cmd, ch)
+	}()
+
+	select {
+	case <-ctx.Done():
+		// If the context gets cancelled we stop the command
+		err := cmd.Stop()
+		if err != nil {
+			b.log.Errorf("error stopping command: %v", err)
+		}
+
+		// We still have to wait for the command to finish
+		_ = <-statusChan
+
+		// We don't care about the real status of the borg operation because we canceled it
+		borgStatus := newStatusWithCanceled()
+		return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
+	case _ = <-statusChan:
+		// Break in case the command completes
+		break
+	}
+
+	// Make sure all entries have been sent before returning
+	<-decodeDone
+
+	// If we are here the command has completed
+	status := cmd.Status()
+	borgStatus := gocmdToStatus(status, "")
+	return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
+}
+
+// decodeDiffOutput decodes the diff lines from borg and sends them to the channel.
+// It returns once both output streams are closed.
+func decodeDiffOutput(cmd *gocmd.Cmd, ch chan<- types.DiffEntry) {
+	defer close(ch)
+	stdout, stderr := cmd.Stdout, cmd.Stderr
+	for stdout != nil || stderr != nil {
+		select {
+		case data, ok := <-stdout:
+			if !ok {
+				stdout = nil
+				continue
+			}
+			entry, err := parseDiffLine(data)
+			if err != nil {
+				// Skip lines that are not diff entries
+				continue
+			}
+			ch <- entry
+		case _, ok := <-stderr:
+			// ignore stderr (only log messages)
+			if !ok {
+				stderr = nil
+			}
+		}
+	}
+}
+
+// parseDiffLine parses a single line of `borg diff --json-lines` into a DiffEntry.
+// Example: {"path": "home/user/file.txt", "changes": [{"type": "modified", "added": 1024, "removed": 512}]}
+func parseDiffLine(line string) (types.DiffEntry, error) {
+	var diffLine types.DiffLine
+	if err := json.Unmarshal([]byte(line), &diffLine); err != nil {
+		return types.DiffEntry{}, err
+	}
+	if diffLine.Path == "" {
+		return types.DiffEntry{}, fmt.Errorf("diff line has no path")
+	}
+
+	entry := types.DiffEntry{
+		Path:       diffLine.Path,
+		ChangeType: types.DiffChangeTypeModified,
+	}
+	for _, change := range diffLine.Changes {
+		switch {
+		case strings.HasPrefix(change.Type, "added"):
+			entry.ChangeType = types.DiffChangeTypeAdded
+			entry.AddedBytes += change.Size
+			entry.IsDir = entry.IsDir || change.Type == "added directory"
+			entry.IsLink = entry.IsLink || change.Type == "added link"
+		case strings.HasPrefix(change.Type, "removed"):
+			entry.ChangeType = types.DiffChangeTypeRemoved
+			entry.RemovedBytes += change.Size
+			entry.IsDir = entry.IsDir || change.Type == "removed directory"
+			entry.IsLink = entry.IsLink || change.Type == "removed link"
+		case change.Type == "modified":
+			entry.AddedBytes += change.Added
+			entry.RemovedBytes += change.Removed
+		case change.Type == "changed link":
+			entry.IsLink = true
+		default:
+			// mode, owner, ctime, mtime, ...
+			entry.MetadataChanges = append(entry.MetadataChanges, change.Type)
+		}
+	}
+	entry.SizeDelta = entry.AddedBytes - entry.RemovedBytes
+	return entry, nil
+}
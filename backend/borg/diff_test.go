@@ -0,0 +1,86 @@
+package borg
+
+import (
+	"testing"
+
+	"github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - diff.go
+
+TestParseDiffLine
+* Parse modified file with size delta
+* Parse added file
+* Parse removed directory
+* Parse metadata only change
+* Parse invalid line
+
+*/
+
+func TestParseDiffLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  types.DiffEntry
+		wantErr bool
+	}{
+		{
+			name:  "Parse modified file with size delta",
+			input: `{"path": "home/user/file.txt", "changes": [{"type": "modified", "added": 1024, "removed": 512}]}`,
+			output: types.DiffEntry{
+				Path:         "home/user/file.txt",
+				ChangeType:   types.DiffChangeTypeModified,
+				AddedBytes:   1024,
+				RemovedBytes: 512,
+				SizeDelta:    512,
+			},
+		},
+		{
+			name:  "Parse added file",
+			input: `{"path": "home/user/new.txt", "changes": [{"type": "added", "size": 2048}]}`,
+			output: types.DiffEntry{
+				Path:       "home/user/new.txt",
+				ChangeType: types.DiffChangeTypeAdded,
+				AddedBytes: 2048,
+				SizeDelta:  2048,
+			},
+		},
+		{
+			name:  "Parse removed directory",
+			input: `{"path": "home/user/old", "changes": [{"type": "removed directory"}]}`,
+			output: types.DiffEntry{
+				Path:       "home/user/old",
+				ChangeType: types.DiffChangeTypeRemoved,
+				IsDir:      true,
+			},
+		},
+		{
+			name:  "Parse metadata only change",
+			input: `{"path": "home/user/script.sh", "changes": [{"type": "mode", "old_mode": "-rw-r--r--", "new_mode": "-rwxr-xr-x"}]}`,
+			output: types.DiffEntry{
+				Path:            "home/user/script.sh",
+				ChangeType:      types.DiffChangeTypeModified,
+				MetadataChanges: []string{"mode"},
+			},
+		},
+		{
+			name:    "Parse invalid line",
+			input:   `Remote: Warning: Permanently added 'host' to the list of known hosts.`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := parseDiffLine(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output, entry)
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package borg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	gocmd "github.com/go-cmd/cmd"
+	"github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// tarFilter returns the filter program borg pipes the tar stream through.
+// When decompress is set the filter decompresses the stream (import) instead of compressing it (export).
+func tarFilter(format types.TarFormat, decompress bool) string {
+	var filter string
+	switch format {
+	case types.TarFormatTarGz:
+		filter = "gzip"
+	case types.TarFormatTarZst:
+		filter = "zstd"
+	case types.TarFormatTar:
+		return "cat"
+	default:
+		return "cat"
+	}
+	if decompress {
+		return filter + " -d"
+	}
+	return filter
+}
+
+// ExportTar writes the contents of an archive as tar stream into outputFile.
+// The tar stream is compressed according to format (independent of the file extension).
+// Paths limits the export to the given archive paths (everything is exported if empty)
+// and excludePaths are borg exclude patterns (e.g. "pp:home/user/file").
+// It is long running and should be run in a goroutine.
+func (b *borg) ExportTar(ctx context.Context, repository, archive, password, outputFile string, format types.TarFormat, paths, excludePaths []string, ch chan types.ExtractProgress) *types.Status {
+	if !format.IsValid() {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("unsupported tar format: %s", format))
+	}
+	if outputFile == "" || !filepath.IsAbs(outputFile) {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("output file must be an absolute path"))
+	}
+	if stat, err := os.Stat(filepath.Dir(outputFile)); err != nil || !stat.IsDir() {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("output directory %s does not exist or is not a directory", filepath.Dir(outputFile)))
+	}
+
+	// Prepare export-tar command
+	cmdStr := []string{
+		"export-tar", // https://borgbackup.readthedocs.io/en/stable/usage/tar.html#borg-export-tar
+		"--progress", // Outputs continuous progress messages
+		"--log-json", // Outputs JSON log messages
+		"--tar-filter", tarFilter(format, false),
+	}
+
+	// Add the paths to export and the exclude paths (selected like for extract)
+	if patterns := buildExtractPatterns(paths, excludePaths); len(patterns) > 0 {
+		patternsFile, err := writePatternsFile(patterns)
+		if err != nil {
+			close(ch)
+			return newStatusWithError(err)
+		}
+		defer func() {
+			if err := os.Remove(patternsFile); err != nil {
+				b.log.Errorf("error removing patterns file: %v", err)
+			}
+		}()
+		cmdStr = append(cmdStr, "--patterns-from", patternsFile)
+	}
+
+	// Add archive path and output file
+	cmdStr = append(cmdStr, fmt.Sprintf("%s::%s", repository, archive), outputFile)
+
+	options := gocmd.Options{Buffered: false, Streaming: true}
+	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmdLog := fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " "))
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// Run export-tar command
+	b.log.LogCmdStart(cmdLog)
+	statusChan := cmd.Start()
+
+	// export-tar reports its progress like extract
+	go decodeExtractProgress(cmd, ch)
+
+	select {
+	case <-ctx.Done():
+		// If the context gets cancelled we stop the command
+		err := cmd.Stop()
+		if err != nil {
+			b.log.Errorf("error stopping command: %v", err)
+		}
+
+		// We still have to wait for the command to finish
+		_ = <-statusChan
+
+		// Remove the incomplete tar file
+		_ = os.Remove(outputFile)
+
+		// We don't care about the real status of the borg operation because we canceled it
+		borgStatus := newStatusWithCanceled()
+		return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
+	case _ = <-statusChan:
+		// Break in case the command completes
+		break
+	}
+
+	// If we are here the command has completed
+	status := cmd.Status()
+	borgStatus := gocmdToStatus(status, "")
+	return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
+}
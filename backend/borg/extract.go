@@ -0,0 +1,181 @@
+package borg
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	gocmd "github.com/go-cmd/cmd"
+	"github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// Extract restores the contents of an archive into targetDir.
+// Paths limits the extraction to the given archive paths (everything is extracted if empty, a style prefix like
+// "pf:home/user/file" selects single files), excludePaths are borg exclude patterns (e.g. "pf:home/user/file")
+// and stripComponents removes leading path elements.
+// Paths and exclude paths are passed with --patterns-from, so there can be more of them than fit on a command line.
+// It is long running and should be run in a goroutine.
+func (b *borg) Extract(ctx context.Context, repository, archive, password, targetDir string, paths, excludePaths []string, stripComponents int, ch chan types.ExtractProgress) *types.Status {
+	if targetDir == "" {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("target directory must not be empty"))
+	}
+	if stat, err := os.Stat(targetDir); err != nil || !stat.IsDir() {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("target directory %s does not exist or is not a directory", targetDir))
+	}
+	if stripComponents < 0 {
+		close(ch)
+		return newStatusWithError(fmt.Errorf("stripComponents must not be negative"))
+	}
+
+	// Prepare extract command
+	cmdStr := []string{
+		"extract",    // https://borgbackup.readthedocs.io/en/stable/usage/extract.html#borg-extract
+		"--progress", // Outputs continuous progress messages
+		"--log-json", // Outputs JSON log messages
+	}
+
+	// Remove leading path elements
+	if stripComponents > 0 {
+		cmdStr = append(cmdStr, "--strip-components", fmt.Sprintf("%d", stripComponents))
+	}
+
+	// Add the paths to extract and the exclude paths
+	// They are passed through a patterns file since restores with conflict handling can select many files
+	if patterns := buildExtractPatterns(paths, excludePaths); len(patterns) > 0 {
+		patternsFile, err := writePatternsFile(patterns)
+		if err != nil {
+			close(ch)
+			return newStatusWithError(err)
+		}
+		defer func() {
+			if err := os.Remove(patternsFile); err != nil {
+				b.log.Errorf("error removing patterns file: %v", err)
+			}
+		}()
+		cmdStr = append(cmdStr, "--patterns-from", patternsFile)
+	}
+
+	// Add archive path
+	cmdStr = append(cmdStr, fmt.Sprintf("%s::%s", repository, archive))
+
+	options := gocmd.Options{Buffered: false, Streaming: true}
+	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmdLog := fmt.Sprintf("cd %s && %s %s", targetDir, b.path, strings.Join(cmdStr, " "))
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// borg extracts into the current working directory
+	cmd.Dir = targetDir
+
+	// Run extract command
+	b.log.LogCmdStart(cmdLog)
+	statusChan := cmd.Start()
+
+	go decodeExtractProgress(cmd, ch)
+
+	select {
+	case <-ctx.Done():
+		// If the context gets cancelled we stop the command
+		err := cmd.Stop()
+		if err != nil {
+			b.log.Errorf("error stopping command: %v", err)
+		}
+
+		// We still have to wait for the command to finish
+		_ = <-statusChan
+
+		// We don't care about the real status of the borg operation because we canceled it
+		borgStatus := newStatusWithCanceled()
+		return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
+	case _ = <-statusChan:
+		// Break in case the command completes
+		break
+	}
+
+	// If we are here the command has completed
+	status := cmd.Status()
+	borgStatus := gocmdToStatus(status, "")
+	return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
+}
+
+// decodeExtractProgress decodes the progress messages from borg and sends them to the channel.
+func decodeExtractProgress(cmd *gocmd.Cmd, ch chan<- types.ExtractProgress) {
+	defer close(ch)
+	var lastProgress types.ExtractProgress
+	for {
+		select {
+		case _ = <-cmd.Stdout:
+			// ignore stdout (info comes through stderr)
+		case data := <-cmd.Stderr:
+			var typeMsg types.Type
+			if err := json.Unmarshal([]byte(data), &typeMsg); err != nil {
+				// Skip errors
+				continue
+			}
+			if types.JSONType(typeMsg.Type) != types.ProgressPercentType {
+				// We only care about progress percent messages
+				continue
+			}
+
+			var progressPercent types.ProgressPercent
+			if err := json.Unmarshal([]byte(data), &progressPercent); err != nil {
+				// Skip errors
+				continue
+			}
+			if progressPercent.MsgID != "extract" {
+				// Skip progress of other operations (e.g. cache sync)
+				continue
+			}
+
+			if progressPercent.Finished {
+				lastProgress.ProcessedBytes = lastProgress.TotalBytes
+				lastProgress.CurrentPath = ""
+			} else {
+				lastProgress.TotalBytes = progressPercent.Total
+				lastProgress.ProcessedBytes = progressPercent.Current
+				if len(progressPercent.Info) > 0 {
+					lastProgress.CurrentPath = progressPercent.Info[0]
+				}
+			}
+			ch <- lastProgress
+		case <-cmd.Done():
+			return
+		}
+	}
+}
+
+// buildExtractPatterns builds the pattern rules that select the paths to extract.
+// The exclude paths come first since borg uses the first matching rule. If paths are given, they are included
+// (archive paths are stored without leading slash, path prefix style unless they have their own prefix)
+// and everything else is excluded.
+func buildExtractPatterns(paths, excludePaths []string) []string {
+	patterns := make([]string, 0, len(excludePaths)+len(paths)+1)
+	for _, excludePath := range excludePaths {
+		// Exclude paths use the fnmatch style by default like --exclude
+		if hasPatternStyle(excludePath) {
+			patterns = append(patterns, "- "+excludePath)
+		} else {
+			patterns = append(patterns, "- fm:"+excludePath)
+		}
+	}
+	if len(paths) == 0 {
+		return patterns
+	}
+	for _, path := range paths {
+		if hasPatternStyle(path) {
+			patterns = append(patterns, "+ "+path)
+		} else {
+			patterns = append(patterns, "+ pp:"+strings.TrimPrefix(path, "/"))
+		}
+	}
+	return append(patterns, "- fm:*")
+}
+
+// hasPatternStyle returns true if the pattern starts with a style prefix like "pp:" or "fm:"
+func hasPatternStyle(pattern string) bool {
+	return len(pattern) > 2 && pattern[2] == ':'
+}
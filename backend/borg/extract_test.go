@@ -0,0 +1,54 @@
+package borg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - extract.go
+
+TestBuildExtractPatterns
+* Extract everything
+* Exclude paths keep their style prefix
+* Extract only the given paths
+* Extract single files with full path patterns
+
+*/
+
+func TestBuildExtractPatterns(t *testing.T) {
+	tests := []struct {
+		name         string
+		paths        []string
+		excludePaths []string
+		output       []string
+	}{
+		{
+			name:   "Extract everything",
+			output: []string{},
+		},
+		{
+			name:         "Exclude paths keep their style prefix",
+			excludePaths: []string{"pp:home/user/report.txt", "*.tmp"},
+			output:       []string{"- pp:home/user/report.txt", "- fm:*.tmp"},
+		},
+		{
+			name:         "Extract only the given paths",
+			paths:        []string{"/home/user/docs", "home/user/notes.txt"},
+			excludePaths: []string{"pp:home/user/docs/old.txt"},
+			output:       []string{"- pp:home/user/docs/old.txt", "+ pp:home/user/docs", "+ pp:home/user/notes.txt", "- fm:*"},
+		},
+		{
+			name:   "Extract single files with full path patterns",
+			paths:  []string{"pf:home/user/report.txt"},
+			output: []string{"+ pf:home/user/report.txt", "- fm:*"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.output, buildExtractPatterns(tt.paths, tt.excludePaths))
+		})
+	}
+}
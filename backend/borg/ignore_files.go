@@ -0,0 +1,161 @@
+package borg
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/borg/types"
+)
+
+// ignoreFileScan is the result of searching the ignore files of a selection of paths
+type ignoreFileScan struct {
+	selection string               // Settings of the selection the scan was made for
+	modTimes  map[string]time.Time // Modification times of the walked directories and the found ignore files
+	rules     []string
+}
+
+// ignoreFileCache caches the ignore file rules per set of backup paths, which usually means per backup profile
+type ignoreFileCache struct {
+	mu    sync.Mutex
+	scans map[string]*ignoreFileScan
+}
+
+func newIgnoreFileCache() *ignoreFileCache {
+	return &ignoreFileCache{scans: make(map[string]*ignoreFileScan)}
+}
+
+// findIgnoreFileRules returns the borg pattern rules of all ignore files in the backup paths.
+// Rules of deeper ignore files come first so that they take precedence like nested .gitignore files.
+// The rules are cached and only searched again if a walked directory or an ignore file changed.
+// Checking the cache still stats every walked directory, so the cost grows with the number of directories
+// of the backup paths (the full walk only happens if something changed).
+func (b *borg) findIgnoreFileRules(backupPaths []string, options types.CreateOptions) []string {
+	key := strings.Join(backupPaths, "\x00")
+	selection := fmt.Sprintf("%q %q %t %q", options.Patterns, options.ExcludePaths, options.ExcludeCaches, options.ExcludeIfPresent)
+
+	b.ignoreFiles.mu.Lock()
+	scan := b.ignoreFiles.scans[key]
+	b.ignoreFiles.mu.Unlock()
+	if scan != nil && scan.selection == selection && scan.isUpToDate() {
+		return scan.rules
+	}
+
+	scan = b.scanIgnoreFiles(backupPaths, options)
+	scan.selection = selection
+
+	b.ignoreFiles.mu.Lock()
+	b.ignoreFiles.scans[key] = scan
+	b.ignoreFiles.mu.Unlock()
+	return scan.rules
+}
+
+// isUpToDate returns true if none of the walked directories and ignore files changed since the scan.
+// Adding or removing an ignore file or marker file changes the modification time of its directory.
+func (s *ignoreFileScan) isUpToDate() bool {
+	for p, modTime := range s.modTimes {
+		info, err := os.Lstat(p)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// scanIgnoreFiles walks the backup paths like borg does and reads the ignore files it finds.
+// Directories that borg doesn't enter (excluded or tagged directories) are skipped and the walk stays on the
+// filesystem of the backup path, so pseudo filesystems like /proc and network mounts are not searched.
+// Directories that can't be read are skipped.
+func (b *borg) scanIgnoreFiles(backupPaths []string, options types.CreateOptions) *ignoreFileScan {
+	scan := &ignoreFileScan{modTimes: make(map[string]time.Time)}
+
+	// Invalid rules make borg fail anyway, so nothing is skipped because of them
+	rules, _ := types.ParseSelectionRules(options.Patterns, options.ExcludePaths)
+
+	var files []string
+	for _, backupPath := range backupPaths {
+		root, err := os.Stat(backupPath)
+		if err != nil {
+			b.log.Warnf("error searching ignore files in %s: %v", backupPath, err)
+			continue
+		}
+		rootDevice := deviceOf(root)
+
+		err = filepath.WalkDir(backupPath, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if d != nil && d.IsDir() && p != backupPath {
+					return fs.SkipDir
+				}
+				return nil
+			}
+			if !d.IsDir() {
+				if d.Type().IsRegular() && d.Name() == types.IgnoreFileName {
+					if info, err := d.Info(); err == nil {
+						scan.modTimes[p] = info.ModTime()
+						files = append(files, p)
+					}
+				}
+				return nil
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return fs.SkipDir
+			}
+			if p != backupPath && (deviceOf(info) != rootDevice || types.SkipsDirectory(rules, p)) {
+				return fs.SkipDir
+			}
+			// The directory is watched even if it is tagged, so that removing the marker file is noticed
+			scan.modTimes[p] = info.ModTime()
+			if isTaggedDir(p, options) {
+				return fs.SkipDir
+			}
+			return nil
+		})
+		if err != nil {
+			b.log.Warnf("error searching ignore files in %s: %v", backupPath, err)
+		}
+	}
+	slices.SortStableFunc(files, func(x, y string) int {
+		return strings.Count(y, "/") - strings.Count(x, "/")
+	})
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			b.log.Warnf("error reading ignore file %s: %v", file, err)
+			continue
+		}
+		for _, rule := range types.ParseIgnoreFile(file, string(content)) {
+			scan.rules = append(scan.rules, rule.Line)
+		}
+	}
+	return scan
+}
+
+// isTaggedDir returns true if borg doesn't back up the contents of the directory because of a marker file
+func isTaggedDir(dir string, options types.CreateOptions) bool {
+	if options.ExcludeCaches && types.IsCacheDir(dir) {
+		return true
+	}
+	for _, marker := range options.ExcludeIfPresent {
+		if _, err := os.Lstat(filepath.Join(dir, marker)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// deviceOf returns the device of the filesystem the file is on
+func deviceOf(info fs.FileInfo) uint64 {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		return uint64(stat.Dev)
+	}
+	return 0
+}
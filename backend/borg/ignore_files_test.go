@@ -0,0 +1,121 @@
+package borg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+/*
+TEST CASES - ignore_files.go
+
+TestFindIgnoreFileRules
+* Deeper ignore files come first
+* Skip ignore files in excluded directories
+* Skip ignore files in tagged directories
+* Use cached rules if nothing changed
+* Search again if an ignore file changed
+
+*/
+
+func TestFindIgnoreFileRules(t *testing.T) {
+	writeFile := func(t *testing.T, p, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o644))
+	}
+	newTree := func(t *testing.T) string {
+		root := t.TempDir()
+		writeFile(t, filepath.Join(root, types.IgnoreFileName), "*.log\n")
+		writeFile(t, filepath.Join(root, "project", types.IgnoreFileName), "build\n")
+		writeFile(t, filepath.Join(root, "node_modules", "pkg", types.IgnoreFileName), "*.md\n")
+		writeFile(t, filepath.Join(root, "tagged", ".nobackup"), "")
+		writeFile(t, filepath.Join(root, "tagged", "sub", types.IgnoreFileName), "*.txt\n")
+		return root
+	}
+	newBorg := func() *borg {
+		return NewBorg("borg", "borg", zap.NewNop().Sugar(), nil, nil).(*borg)
+	}
+
+	t.Run("Deeper ignore files come first", func(t *testing.T) {
+		// ARRANGE
+		root := newTree(t)
+
+		// ACT
+		rules := newBorg().findIgnoreFileRules([]string{root}, types.CreateOptions{})
+
+		// ASSERT
+		assert.Equal(t, []string{
+			"- sh:" + root + "/node_modules/pkg/**/*.md",
+			"- sh:" + root + "/tagged/sub/**/*.txt",
+			"- sh:" + root + "/project/**/build",
+			"- sh:" + root + "/**/*.log",
+		}, rules)
+	})
+
+	t.Run("Skip ignore files in excluded directories", func(t *testing.T) {
+		// ARRANGE
+		root := newTree(t)
+
+		// ACT
+		rules := newBorg().findIgnoreFileRules([]string{root}, types.CreateOptions{
+			ExcludePaths: []string{"*/node_modules"},
+			Patterns:     []string{"! sh:**/tagged"},
+		})
+
+		// ASSERT
+		assert.Equal(t, []string{"- sh:" + root + "/project/**/build", "- sh:" + root + "/**/*.log"}, rules)
+	})
+
+	t.Run("Skip ignore files in tagged directories", func(t *testing.T) {
+		// ARRANGE
+		root := newTree(t)
+
+		// ACT
+		rules := newBorg().findIgnoreFileRules([]string{root}, types.CreateOptions{
+			ExcludePaths:     []string{"*/node_modules"},
+			ExcludeIfPresent: []string{".nobackup"},
+		})
+
+		// ASSERT
+		assert.Equal(t, []string{"- sh:" + root + "/project/**/build", "- sh:" + root + "/**/*.log"}, rules)
+	})
+
+	t.Run("Use cached rules if nothing changed", func(t *testing.T) {
+		// ARRANGE
+		root := newTree(t)
+		b := newBorg()
+		first := b.findIgnoreFileRules([]string{root}, types.CreateOptions{})
+		scan := b.ignoreFiles.scans[root]
+
+		// ACT
+		second := b.findIgnoreFileRules([]string{root}, types.CreateOptions{})
+
+		// ASSERT
+		assert.Equal(t, first, second)
+		assert.Same(t, scan, b.ignoreFiles.scans[root], "Expected the cached scan to be reused")
+	})
+
+	t.Run("Search again if an ignore file changed", func(t *testing.T) {
+		// ARRANGE
+		root := newTree(t)
+		b := newBorg()
+		b.findIgnoreFileRules([]string{root}, types.CreateOptions{})
+		file := filepath.Join(root, "project", types.IgnoreFileName)
+		writeFile(t, file, "dist\n")
+		later := time.Now().Add(time.Second)
+		require.NoError(t, os.Chtimes(file, later, later))
+
+		// ACT
+		rules := b.findIgnoreFileRules([]string{root}, types.CreateOptions{})
+
+		// ASSERT
+		assert.Contains(t, rules, "- sh:"+root+"/project/**/dist")
+		assert.NotContains(t, rules, "- sh:"+root+"/project/**/build")
+	})
+}
@@ -0,0 +1,76 @@
+package borg
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/loomi-labs/arco/backend/borg/types"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+)
+
+// ImportTar creates a new archive in the repository from the contents of a tar file.
+// The archive is named like a backup (prefix + timestamp). If timestamp is set it is used as creation time of the archive.
+// The tar file is decompressed according to its extension (.tar, .tar.gz or .tar.zst).
+// It is long running and should be run in a goroutine.
+func (b *borg) ImportTar(ctx context.Context, repository, password, prefix, tarFile, comment string, timestamp *time.Time, compressionMode backupprofile.CompressionMode, compressionLevel *int) (string, *types.Status) {
+	if stat, err := os.Stat(tarFile); err != nil || stat.IsDir() {
+		return "", newStatusWithError(fmt.Errorf("tar file %s does not exist or is a directory", tarFile))
+	}
+
+	createdAt := time.Now()
+	if timestamp != nil {
+		createdAt = *timestamp
+	}
+	archivePath := fmt.Sprintf("%s::%s%s", repository, prefix, createdAt.In(time.Local).Format("2006-01-02-15-04-05"))
+
+	// Prepare import-tar command
+	cmdStr := []string{
+		"import-tar", // https://borgbackup.readthedocs.io/en/stable/usage/tar.html#borg-import-tar
+		"--log-json", // Outputs JSON log messages
+		"--tar-filter", tarFilter(types.TarFormatFromPath(tarFile), true),
+	}
+
+	// Add compression flag if enabled
+	if compressionFlag := buildCompressionFlag(compressionMode, compressionLevel); compressionFlag != "" {
+		cmdStr = append(cmdStr, compressionFlag)
+	}
+
+	// Add archive metadata
+	if comment != "" {
+		cmdStr = append(cmdStr, "--comment", comment)
+	}
+	if timestamp != nil {
+		cmdStr = append(cmdStr, "--timestamp", timestamp.UTC().Format("2006-01-02T15:04:05")) // borg expects UTC
+	}
+
+	// Add archive path and tar file
+	cmdStr = append(cmdStr, archivePath, tarFile)
+
+	cmd := exec.CommandContext(ctx, b.path, cmdStr...)
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// Add cancel functionality
+	hasBeenCanceled := false
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	cmd.Cancel = func() error {
+		hasBeenCanceled = true
+		return syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+	}
+
+	// Run import-tar command
+	startTime := b.log.LogCmdStart(cmd.String())
+	out, err := cmd.CombinedOutput()
+
+	if hasBeenCanceled {
+		// We don't care about the real status of the borg operation because we canceled it
+		status := newStatusWithCanceled()
+		return archivePath, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
+	}
+
+	status := combinedOutputToStatus(out, err)
+	return archivePath, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
+}
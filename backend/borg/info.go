@@ -7,6 +7,7 @@ import (
 	"github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/borg/utils"
 	"os/exec"
+	"strconv"
 	"time"
 )
 
@@ -15,7 +16,11 @@ func (cr *commandRunner) Info(cmd *exec.Cmd) ([]byte, error) {
 }
 
 func (b *borg) Info(ctx context.Context, repository, password string, allowRelocated bool) (*types.InfoResponse, *types.Status) {
-	cmd := exec.CommandContext(ctx, b.path, "info", "--json", repository)
+	return b.info(ctx, password, allowRelocated, "info", "--json", repository)
+}
+
+func (b *borg) info(ctx context.Context, password string, allowRelocated bool, args ...string) (*types.InfoResponse, *types.Status) {
+	cmd := exec.CommandContext(ctx, b.path, args...)
 	env := NewEnv(b.sshPrivateKeys).WithPassword(password)
 	if allowRelocated {
 		env = env.WithRelocatedRepoAccess()
@@ -41,3 +46,24 @@ func (b *borg) Info(ctx context.Context, repository, password string, allowReloc
 
 	return &info, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
 }
+
+// ArchiveInfo returns the info (including stats) of a single archive
+func (b *borg) ArchiveInfo(ctx context.Context, repository, archive, password string) (*types.ArchiveInfo, *types.Status) {
+	info, status := b.Info(ctx, fmt.Sprintf("%s::%s", repository, archive), password, false)
+	if info == nil {
+		return nil, status
+	}
+	if len(info.Archives) != 1 {
+		return nil, newStatusWithError(fmt.Errorf("expected info of 1 archive, got %d", len(info.Archives)))
+	}
+	return &info.Archives[0], status
+}
+
+// ArchivesInfo returns the info (including stats) of the last n archives of a repository with a single borg call
+func (b *borg) ArchivesInfo(ctx context.Context, repository, password string, last int) ([]types.ArchiveInfo, *types.Status) {
+	info, status := b.info(ctx, password, false, "info", "--json", "--last", strconv.Itoa(last), repository)
+	if info == nil {
+		return nil, status
+	}
+	return info.Archives, status
+}
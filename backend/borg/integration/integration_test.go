@@ -470,10 +470,9 @@ func TestBorgArchiveOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		archiveName := strings.Split(archivePath, "::")[1]
@@ -499,10 +498,9 @@ func TestBorgArchiveOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -539,10 +537,9 @@ func TestBorgDeleteOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -597,10 +594,9 @@ func TestBorgMaintenanceOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -627,10 +623,9 @@ func TestBorgMaintenanceOperations(t *testing.T) {
 				testPassword,
 				fmt.Sprintf("test-archive-%d", i),
 				[]string{dataDir},
-				[]string{},
-				false,
-				backupprofile.CompressionModeLz4,
+				types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 				nil,
+				types.BackupEstimate{},
 				progressChan,
 			)
 			require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -673,10 +668,9 @@ func TestBorgRenameOperation(t *testing.T) {
 		testPassword,
 		"prefix-",
 		[]string{dataDir},
-		[]string{},
-		false,
-		backupprofile.CompressionModeLz4,
+		types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 		nil,
+		types.BackupEstimate{},
 		progressChan,
 	)
 	require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -777,10 +771,9 @@ func TestBorgMountOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -827,10 +820,9 @@ func TestBorgMountOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -917,10 +909,9 @@ func TestBorgDeleteArchives(t *testing.T) {
 			testPassword,
 			fmt.Sprintf("%s-%d", archivePrefix, i),
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -934,10 +925,9 @@ func TestBorgDeleteArchives(t *testing.T) {
 		testPassword,
 		"other-archive-",
 		[]string{dataDir},
-		[]string{},
-		false,
-		backupprofile.CompressionModeLz4,
+		types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 		nil,
+		types.BackupEstimate{},
 		progressChan,
 	)
 	require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
@@ -986,16 +976,15 @@ func TestBorgCheckOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
 
 		// Run quick check
-		result := suite.borg.Check(suite.ctx, repoPath, testPassword, true)
+		result := suite.borg.Check(suite.ctx, repoPath, testPassword, true, nil)
 		assert.True(t, result.Status.IsCompletedWithSuccess(), "Quick check should succeed: %v", result.Status.GetError())
 		assert.Empty(t, result.ErrorLogs, "Quick check should have no error logs")
 	})
@@ -1016,16 +1005,15 @@ func TestBorgCheckOperations(t *testing.T) {
 			testPassword,
 			"test-archive",
 			[]string{dataDir},
-			[]string{},
-			false,
-			backupprofile.CompressionModeLz4,
+			types.CreateOptions{CompressionMode: backupprofile.CompressionModeLz4},
 			nil,
+			types.BackupEstimate{},
 			progressChan,
 		)
 		require.True(t, status.IsCompletedWithSuccess(), "Archive creation should succeed")
 
 		// Run full check (verify data)
-		result := suite.borg.Check(suite.ctx, repoPath, testPassword, false)
+		result := suite.borg.Check(suite.ctx, repoPath, testPassword, false, nil)
 		assert.True(t, result.Status.IsCompletedWithSuccess(), "Full check should succeed: %v", result.Status.GetError())
 		assert.Empty(t, result.ErrorLogs, "Full check should have no error logs")
 	})
@@ -1034,7 +1022,7 @@ func TestBorgCheckOperations(t *testing.T) {
 		invalidRepoPath := "/nonexistent/repo"
 
 		// Run check on non-existent repository
-		result := suite.borg.Check(suite.ctx, invalidRepoPath, testPassword, true)
+		result := suite.borg.Check(suite.ctx, invalidRepoPath, testPassword, true, nil)
 		assert.True(t, result.Status.HasError(), "Check should fail for non-existent repository")
 		assert.True(t, errors.Is(result.Status.Error, types.ErrorRepositoryDoesNotExist), "Should be repository does not exist error")
 	})
@@ -1047,7 +1035,7 @@ func TestBorgCheckOperations(t *testing.T) {
 		require.True(t, status.IsCompletedWithSuccess(), "Repository initialization should succeed")
 
 		// Try to check with wrong password
-		result := suite.borg.Check(suite.ctx, repoPath, "wrongpassword", false)
+		result := suite.borg.Check(suite.ctx, repoPath, "wrongpassword", false, nil)
 		assert.True(t, result.Status.HasError(), "Check should fail with wrong password")
 		assert.True(t, errors.Is(result.Status.Error, types.ErrorPassphraseWrong), "Should be incorrect passphrase error")
 	})
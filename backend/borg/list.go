@@ -1,12 +1,15 @@
 package borg
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os/exec"
+	"strings"
 	"time"
 
+	gocmd "github.com/go-cmd/cmd"
 	"github.com/loomi-labs/arco/backend/borg/types"
 	"github.com/loomi-labs/arco/backend/borg/utils"
 )
@@ -43,3 +46,132 @@ func (b *borg) List(ctx context.Context, repository string, password string, glo
 
 	return &listResponse, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
 }
+
+// ListFiles lists the items (files, directories, links, ...) stored in an archive.
+// Paths limits the listing to the given archive paths (everything is listed if empty).
+func (b *borg) ListFiles(ctx context.Context, repository, archive, password string, paths []string) ([]types.ArchiveItem, *types.Status) {
+	// Build command arguments
+	args := []string{"list", "--json-lines", fmt.Sprintf("%s::%s", repository, archive)}
+
+	// Archive paths are stored without leading slash
+	for _, path := range paths {
+		args = append(args, strings.TrimPrefix(path, "/"))
+	}
+
+	cmd := exec.CommandContext(ctx, b.path, args...)
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// Get the file list from the borg archive
+	startTime := b.log.LogCmdStart(cmd.String())
+	out, err := cmd.CombinedOutput()
+
+	// Convert command output and error to Status
+	status := combinedOutputToStatus(out, err)
+	if status.HasError() {
+		return nil, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
+	}
+
+	items := make([]types.ArchiveItem, 0)
+	for _, line := range bytes.Split(out, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if !bytes.HasPrefix(line, []byte("{")) {
+			// Skip empty lines and non JSON output (e.g. SSH warnings)
+			continue
+		}
+		var item types.ArchiveItem
+		if err := json.Unmarshal(line, &item); err != nil {
+			parseStatus := newStatusWithError(fmt.Errorf("failed to parse borg list output: %v", err))
+			return nil, b.log.LogCmdStatus(ctx, parseStatus, cmd.String(), time.Since(startTime))
+		}
+		items = append(items, item)
+	}
+
+	return items, b.log.LogCmdStatus(ctx, status, cmd.String(), time.Since(startTime))
+}
+
+// StreamFiles lists the items stored in an archive like ListFiles but sends every item to the channel
+// instead of collecting them, so large archives can be processed without keeping the whole listing in memory.
+// The channel is closed once all items have been sent.
+// It is long running and should be run in a goroutine.
+func (b *borg) StreamFiles(ctx context.Context, repository, archive, password string, paths []string, ch chan types.ArchiveItem) *types.Status {
+	// Prepare list command
+	cmdStr := []string{"list", "--json-lines", fmt.Sprintf("%s::%s", repository, archive)}
+
+	// Archive paths are stored without leading slash
+	for _, path := range paths {
+		cmdStr = append(cmdStr, strings.TrimPrefix(path, "/"))
+	}
+
+	options := gocmd.Options{Buffered: false, Streaming: true}
+	cmd := gocmd.NewCmdOptions(options, b.path, cmdStr...)
+	cmdLog := fmt.Sprintf("%s %s", b.path, strings.Join(cmdStr, " "))
+	cmd.Env = NewEnv(b.sshPrivateKeys).WithPassword(password).AsList()
+
+	// Run list command
+	b.log.LogCmdStart(cmdLog)
+	statusChan := cmd.Start()
+
+	decodeDone := make(chan struct{})
+	go func() {
+		defer close(decodeDone)
+		decodeListOutput(cmd, ch)
+	}()
+
+	select {
+	case <-ctx.Done():
+		// If the context gets cancelled we stop the command
+		err := cmd.Stop()
+		if err != nil {
+			b.log.Errorf("error stopping command: %v", err)
+		}
+
+		// We still have to wait for the command to finish
+		_ = <-statusChan
+
+		// We don't care about the real status of the borg operation because we canceled it
+		borgStatus := newStatusWithCanceled()
+		return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(cmd.Status().Runtime))
+	case _ = <-statusChan:
+		// Break in case the command completes
+		break
+	}
+
+	// Make sure all items have been sent before returning
+	<-decodeDone
+
+	// If we are here the command has completed
+	status := cmd.Status()
+	borgStatus := gocmdToStatus(status, "")
+	return b.log.LogCmdStatus(ctx, borgStatus, cmdLog, time.Duration(status.Runtime))
+}
+
+// decodeListOutput decodes the item lines from borg and sends them to the channel.
+// It returns once both output streams are closed.
+func decodeListOutput(cmd *gocmd.Cmd, ch chan<- types.ArchiveItem) {
+	defer close(ch)
+	stdout, stderr := cmd.Stdout, cmd.Stderr
+	for stdout != nil || stderr != nil {
+		select {
+		case data, ok := <-stdout:
+			if !ok {
+				stdout = nil
+				continue
+			}
+			line := strings.TrimSpace(data)
+			if !strings.HasPrefix(line, "{") {
+				// Skip empty lines and non JSON output (e.g. SSH warnings)
+				continue
+			}
+			var item types.ArchiveItem
+			if err := json.Unmarshal([]byte(line), &item); err != nil {
+				continue
+			}
+			ch <- item
+		case _, ok := <-stderr:
+			// ignore stderr (only log messages)
+			if !ok {
+				stderr = nil
+			}
+		}
+	}
+}
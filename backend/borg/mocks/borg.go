@@ -13,6 +13,7 @@ import (
 	context "context"
 	exec "os/exec"
 	reflect "reflect"
+	time "time"
 
 	version "github.com/hashicorp/go-version"
 	types "github.com/loomi-labs/arco/backend/borg/types"
@@ -44,6 +45,36 @@ func (m *MockBorg) EXPECT() *MockBorgMockRecorder {
 	return m.recorder
 }
 
+// ArchiveInfo mocks base method.
+func (m *MockBorg) ArchiveInfo(ctx context.Context, repository, archive, password string) (*types.ArchiveInfo, *types.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveInfo", ctx, repository, archive, password)
+	ret0, _ := ret[0].(*types.ArchiveInfo)
+	ret1, _ := ret[1].(*types.Status)
+	return ret0, ret1
+}
+
+// ArchiveInfo indicates an expected call of ArchiveInfo.
+func (mr *MockBorgMockRecorder) ArchiveInfo(ctx, repository, archive, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveInfo", reflect.TypeOf((*MockBorg)(nil).ArchiveInfo), ctx, repository, archive, password)
+}
+
+// ArchivesInfo mocks base method.
+func (m *MockBorg) ArchivesInfo(ctx context.Context, repository, password string, last int) ([]types.ArchiveInfo, *types.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchivesInfo", ctx, repository, password, last)
+	ret0, _ := ret[0].([]types.ArchiveInfo)
+	ret1, _ := ret[1].(*types.Status)
+	return ret0, ret1
+}
+
+// ArchivesInfo indicates an expected call of ArchivesInfo.
+func (mr *MockBorgMockRecorder) ArchivesInfo(ctx, repository, password, last any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchivesInfo", reflect.TypeOf((*MockBorg)(nil).ArchivesInfo), ctx, repository, password, last)
+}
+
 // BreakLock mocks base method.
 func (m *MockBorg) BreakLock(ctx context.Context, repository, password string) *types.Status {
 	m.ctrl.T.Helper()
@@ -73,17 +104,17 @@ func (mr *MockBorgMockRecorder) ChangePassphrase(ctx, repository, currentPasswor
 }
 
 // Check mocks base method.
-func (m *MockBorg) Check(ctx context.Context, repository, password string, quick bool) *types.CheckResult {
+func (m *MockBorg) Check(ctx context.Context, repository, password string, quick bool, filter *types.CheckArchiveFilter) *types.CheckResult {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Check", ctx, repository, password, quick)
+	ret := m.ctrl.Call(m, "Check", ctx, repository, password, quick, filter)
 	ret0, _ := ret[0].(*types.CheckResult)
 	return ret0
 }
 
 // Check indicates an expected call of Check.
-func (mr *MockBorgMockRecorder) Check(ctx, repository, password, quick any) *gomock.Call {
+func (mr *MockBorgMockRecorder) Check(ctx, repository, password, quick, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockBorg)(nil).Check), ctx, repository, password, quick)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockBorg)(nil).Check), ctx, repository, password, quick, filter)
 }
 
 // Compact mocks base method.
@@ -101,18 +132,18 @@ func (mr *MockBorgMockRecorder) Compact(ctx, repository, password any) *gomock.C
 }
 
 // Create mocks base method.
-func (m *MockBorg) Create(ctx context.Context, repository, password, prefix string, backupPaths, excludePaths []string, excludeCaches bool, compressionMode backupprofile.CompressionMode, compressionLevel *int, ch chan types.BackupProgress) (string, *types.Status) {
+func (m *MockBorg) Create(ctx context.Context, repository, password, prefix string, backupPaths []string, options types.CreateOptions, contentCommand *types.ContentCommand, estimate types.BackupEstimate, ch chan types.BackupProgress) (string, *types.Status) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, repository, password, prefix, backupPaths, excludePaths, excludeCaches, compressionMode, compressionLevel, ch)
+	ret := m.ctrl.Call(m, "Create", ctx, repository, password, prefix, backupPaths, options, contentCommand, estimate, ch)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(*types.Status)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockBorgMockRecorder) Create(ctx, repository, password, prefix, backupPaths, excludePaths, excludeCaches, compressionMode, compressionLevel, ch any) *gomock.Call {
+func (mr *MockBorgMockRecorder) Create(ctx, repository, password, prefix, backupPaths, options, contentCommand, estimate, ch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBorg)(nil).Create), ctx, repository, password, prefix, backupPaths, excludePaths, excludeCaches, compressionMode, compressionLevel, ch)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockBorg)(nil).Create), ctx, repository, password, prefix, backupPaths, options, contentCommand, estimate, ch)
 }
 
 // DeleteArchive mocks base method.
@@ -157,6 +188,77 @@ func (mr *MockBorgMockRecorder) DeleteRepository(ctx, repository, password any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRepository", reflect.TypeOf((*MockBorg)(nil).DeleteRepository), ctx, repository, password)
 }
 
+// Diff mocks base method.
+func (m *MockBorg) Diff(ctx context.Context, repository, archiveA, archiveB, password string, ch chan types.DiffEntry) *types.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", ctx, repository, archiveA, archiveB, password, ch)
+	ret0, _ := ret[0].(*types.Status)
+	return ret0
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *MockBorgMockRecorder) Diff(ctx, repository, archiveA, archiveB, password, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockBorg)(nil).Diff), ctx, repository, archiveA, archiveB, password, ch)
+}
+
+// DryRunCreate mocks base method.
+func (m *MockBorg) DryRunCreate(ctx context.Context, backupPaths []string, options types.CreateOptions, ch chan types.FileStatus) *types.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DryRunCreate", ctx, backupPaths, options, ch)
+	ret0, _ := ret[0].(*types.Status)
+	return ret0
+}
+
+// DryRunCreate indicates an expected call of DryRunCreate.
+func (mr *MockBorgMockRecorder) DryRunCreate(ctx, backupPaths, options, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DryRunCreate", reflect.TypeOf((*MockBorg)(nil).DryRunCreate), ctx, backupPaths, options, ch)
+}
+
+// ExportTar mocks base method.
+func (m *MockBorg) ExportTar(ctx context.Context, repository, archive, password, outputFile string, format types.TarFormat, paths, excludePaths []string, ch chan types.ExtractProgress) *types.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportTar", ctx, repository, archive, password, outputFile, format, paths, excludePaths, ch)
+	ret0, _ := ret[0].(*types.Status)
+	return ret0
+}
+
+// ExportTar indicates an expected call of ExportTar.
+func (mr *MockBorgMockRecorder) ExportTar(ctx, repository, archive, password, outputFile, format, paths, excludePaths, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportTar", reflect.TypeOf((*MockBorg)(nil).ExportTar), ctx, repository, archive, password, outputFile, format, paths, excludePaths, ch)
+}
+
+// Extract mocks base method.
+func (m *MockBorg) Extract(ctx context.Context, repository, archive, password, targetDir string, paths, excludePaths []string, stripComponents int, ch chan types.ExtractProgress) *types.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Extract", ctx, repository, archive, password, targetDir, paths, excludePaths, stripComponents, ch)
+	ret0, _ := ret[0].(*types.Status)
+	return ret0
+}
+
+// Extract indicates an expected call of Extract.
+func (mr *MockBorgMockRecorder) Extract(ctx, repository, archive, password, targetDir, paths, excludePaths, stripComponents, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Extract", reflect.TypeOf((*MockBorg)(nil).Extract), ctx, repository, archive, password, targetDir, paths, excludePaths, stripComponents, ch)
+}
+
+// ImportTar mocks base method.
+func (m *MockBorg) ImportTar(ctx context.Context, repository, password, prefix, tarFile, comment string, timestamp *time.Time, compressionMode backupprofile.CompressionMode, compressionLevel *int) (string, *types.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportTar", ctx, repository, password, prefix, tarFile, comment, timestamp, compressionMode, compressionLevel)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(*types.Status)
+	return ret0, ret1
+}
+
+// ImportTar indicates an expected call of ImportTar.
+func (mr *MockBorgMockRecorder) ImportTar(ctx, repository, password, prefix, tarFile, comment, timestamp, compressionMode, compressionLevel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportTar", reflect.TypeOf((*MockBorg)(nil).ImportTar), ctx, repository, password, prefix, tarFile, comment, timestamp, compressionMode, compressionLevel)
+}
+
 // Info mocks base method.
 func (m *MockBorg) Info(ctx context.Context, repository, password string, allowRelocated bool) (*types.InfoResponse, *types.Status) {
 	m.ctrl.T.Helper()
@@ -201,6 +303,21 @@ func (mr *MockBorgMockRecorder) List(ctx, repository, password, glob any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockBorg)(nil).List), ctx, repository, password, glob)
 }
 
+// ListFiles mocks base method.
+func (m *MockBorg) ListFiles(ctx context.Context, repository, archive, password string, paths []string) ([]types.ArchiveItem, *types.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiles", ctx, repository, archive, password, paths)
+	ret0, _ := ret[0].([]types.ArchiveItem)
+	ret1, _ := ret[1].(*types.Status)
+	return ret0, ret1
+}
+
+// ListFiles indicates an expected call of ListFiles.
+func (mr *MockBorgMockRecorder) ListFiles(ctx, repository, archive, password, paths any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiles", reflect.TypeOf((*MockBorg)(nil).ListFiles), ctx, repository, archive, password, paths)
+}
+
 // MountArchive mocks base method.
 func (m *MockBorg) MountArchive(ctx context.Context, repository, archive, password, mountPath string) *types.Status {
 	m.ctrl.T.Helper()
@@ -286,6 +403,20 @@ func (mr *MockBorgMockRecorder) Rename(ctx, repository, archive, password, newNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockBorg)(nil).Rename), ctx, repository, archive, password, newName)
 }
 
+// StreamFiles mocks base method.
+func (m *MockBorg) StreamFiles(ctx context.Context, repository, archive, password string, paths []string, ch chan types.ArchiveItem) *types.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamFiles", ctx, repository, archive, password, paths, ch)
+	ret0, _ := ret[0].(*types.Status)
+	return ret0
+}
+
+// StreamFiles indicates an expected call of StreamFiles.
+func (mr *MockBorgMockRecorder) StreamFiles(ctx, repository, archive, password, paths, ch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamFiles", reflect.TypeOf((*MockBorg)(nil).StreamFiles), ctx, repository, archive, password, paths, ch)
+}
+
 // Umount mocks base method.
 func (m *MockBorg) Umount(ctx context.Context, path string) *types.Status {
 	m.ctrl.T.Helper()
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// IgnoreFileName is the name of the gitignore-style files that exclude paths of the directory they are in
+const IgnoreFileName = ".arcoignore"
+
+// cacheDirTagSignature is the start of a CACHEDIR.TAG file that borg honors with --exclude-caches
+const cacheDirTagSignature = "Signature: 8a477f597d28d172789f06886806bc55"
+
+// ParseIgnoreFile translates a gitignore-style ignore file into borg pattern rules.
+// Patterns without a slash match at any depth below the directory of the file, other patterns are relative to it.
+// A trailing slash is ignored, so "build/" also excludes a file named build.
+// The rules are reversed since the last matching line of an ignore file wins while borg uses the first matching rule.
+// Lines that can't be translated are skipped.
+func ParseIgnoreFile(file, content string) []PatternRule {
+	dir := escapeShellPattern(path.Dir(path.Clean(file)))
+
+	var rules []PatternRule
+	for i, line := range strings.Split(content, "\n") {
+		original := strings.TrimRight(line, "\r")
+		pattern := strings.TrimRight(original, " \t")
+		if pattern == "" || strings.HasPrefix(pattern, "#") {
+			continue
+		}
+
+		ruleType := PatternRuleExclude
+		if strings.HasPrefix(pattern, "!") {
+			ruleType = PatternRuleInclude
+			pattern = pattern[1:]
+		}
+		pattern = strings.TrimSuffix(pattern, "/")
+		if strings.Trim(pattern, "/") == "" {
+			continue
+		}
+
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+		} else {
+			pattern = "**/" + pattern
+		}
+
+		rule, err := ParsePatternRule(fmt.Sprintf("%s sh:%s/%s", ruleType, strings.TrimSuffix(dir, "/"), unescapeIgnorePattern(pattern)))
+		if err != nil {
+			continue
+		}
+		rule.Source = fmt.Sprintf("%s:%d: %s", file, i+1, original)
+		rules = append([]PatternRule{rule}, rules...)
+	}
+	return rules
+}
+
+// escapeShellPattern escapes the wildcards of a path so that it can be used in a shell style pattern
+func escapeShellPattern(p string) string {
+	var b strings.Builder
+	for _, r := range p {
+		switch r {
+		case '*', '?', '[':
+			b.WriteString("[" + string(r) + "]")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// unescapeIgnorePattern replaces backslash escapes of gitignore patterns (e.g. "\#" or "\*")
+// since backslashes are no escape character in borg patterns
+func unescapeIgnorePattern(p string) string {
+	var b strings.Builder
+	for i := 0; i < len(p); i++ {
+		if p[i] != '\\' || i+1 == len(p) {
+			b.WriteByte(p[i])
+			continue
+		}
+		i++
+		switch p[i] {
+		case '*', '?', '[':
+			b.WriteString("[" + string(p[i]) + "]")
+		default:
+			b.WriteByte(p[i])
+		}
+	}
+	return b.String()
+}
+
+// IsCacheDir returns true if the directory contains a valid CACHEDIR.TAG file (https://bford.info/cachedir/)
+func IsCacheDir(dir string) bool {
+	file, err := os.Open(path.Join(dir, "CACHEDIR.TAG"))
+	if err != nil {
+		return false
+	}
+	defer file.Close()
+
+	signature := make([]byte, len(cacheDirTagSignature))
+	if _, err := io.ReadFull(file, signature); err != nil {
+		return false
+	}
+	return string(signature) == cacheDirTagSignature
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - ignore.go
+
+TestParseIgnoreFile
+* Name without slash matches at any depth
+* Pattern with slash is relative to the directory of the file
+* Trailing slash is ignored
+* Negation includes path again
+* Later lines take precedence
+* Comments and escapes
+* Wildcards in directory name are escaped
+
+*/
+
+func TestParseIgnoreFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		included []string
+		excluded []string
+	}{
+		{
+			name:     "Name without slash matches at any depth",
+			file:     "/home/user/project/.arcoignore",
+			content:  "node_modules\n*.log\n",
+			included: []string{"/home/user/project/src/main.go", "/home/user/other/node_modules"},
+			excluded: []string{"/home/user/project/node_modules", "/home/user/project/web/node_modules/x/y.js", "/home/user/project/a/b.log"},
+		},
+		{
+			name:     "Pattern with slash is relative to the directory of the file",
+			file:     "/home/user/project/.arcoignore",
+			content:  "/build\ndocs/*.pdf",
+			included: []string{"/home/user/project/src/build", "/home/user/project/docs/sub/a.pdf"},
+			excluded: []string{"/home/user/project/build/out.bin", "/home/user/project/docs/a.pdf"},
+		},
+		{
+			name:     "Trailing slash is ignored",
+			file:     "/data/.arcoignore",
+			content:  "tmp/",
+			excluded: []string{"/data/tmp", "/data/a/tmp/file"},
+		},
+		{
+			name:     "Negation includes path again",
+			file:     "/data/.arcoignore",
+			content:  "*.log\n!important.log",
+			included: []string{"/data/important.log"},
+			excluded: []string{"/data/other.log"},
+		},
+		{
+			name:     "Later lines take precedence",
+			file:     "/data/.arcoignore",
+			content:  "!keep.tmp\n*.tmp",
+			excluded: []string{"/data/keep.tmp"},
+		},
+		{
+			name:     "Comments and escapes",
+			file:     "/data/.arcoignore",
+			content:  "# comment\n\\#notes\n\\*.txt\n",
+			included: []string{"/data/comment", "/data/a.txt"},
+			excluded: []string{"/data/#notes", "/data/*.txt"},
+		},
+		{
+			name:     "Wildcards in directory name are escaped",
+			file:     "/data/[x]*/.arcoignore",
+			content:  "cache",
+			included: []string{"/data/x1/cache"},
+			excluded: []string{"/data/[x]*/cache"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := ParseIgnoreFile(tt.file, tt.content)
+
+			for _, p := range tt.included {
+				assert.True(t, EvaluatePatternRules(rules, "/", p).Included, "%q should be included", p)
+			}
+			for _, p := range tt.excluded {
+				evaluation := EvaluatePatternRules(rules, "/", p)
+				if assert.False(t, evaluation.Included, "%q should be excluded", p) {
+					assert.Contains(t, evaluation.Rule.Source, tt.file+":")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package types
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PatternRuleType is the command of a borg pattern rule
+// (https://borgbackup.readthedocs.io/en/stable/usage/help.html#borg-help-patterns)
+type PatternRuleType string
+
+const (
+	PatternRuleInclude          PatternRuleType = "+" // Include matching paths
+	PatternRuleExclude          PatternRuleType = "-" // Exclude matching paths but still recurse into matching directories
+	PatternRuleExcludeNoRecurse PatternRuleType = "!" // Exclude matching paths and don't recurse into matching directories
+)
+
+// PatternStyle is the style of a borg pattern, selected with a prefix like "sh:"
+type PatternStyle string
+
+const (
+	PatternStyleFnmatch    PatternStyle = "fm"
+	PatternStyleShell      PatternStyle = "sh"
+	PatternStyleRegex      PatternStyle = "re"
+	PatternStylePathPrefix PatternStyle = "pp"
+	PatternStylePathFull   PatternStyle = "pf"
+)
+
+// PatternRule is a parsed borg pattern rule
+type PatternRule struct {
+	Type    PatternRuleType
+	Style   PatternStyle
+	Pattern string
+	Line    string // Rule as it was written
+	Source  string // Origin of the rule if it was not written by the user (e.g. a line of an ignore file)
+
+	regex  *regexp.Regexp // Used by all styles except pp and pf
+	prefix string         // Normalized pattern of the pp and pf styles
+}
+
+// PatternEvaluation is the result of evaluating a path against pattern rules
+type PatternEvaluation struct {
+	Included bool
+	Rule     *PatternRule // Rule that decided the result, nil if no rule matched
+	Path     string       // Path matched by the rule, a parent directory if it was excluded with "!"
+}
+
+// ParsePatternRules parses the lines of a borg patterns file.
+// Empty lines and comments (#) are skipped. Patterns without a style prefix use the shell style.
+// Root paths (R) and default styles (P) are not supported since the roots are the backup paths.
+func ParsePatternRules(lines []string) ([]PatternRule, error) {
+	var rules []PatternRule
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		rule, err := ParsePatternRule(trimmed)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ParseSelectionRules returns the pattern rules and exclude paths of borg create as rules in the order in which
+// borg evaluates them (pattern rules before exclude paths)
+func ParseSelectionRules(patterns, excludePaths []string) ([]PatternRule, error) {
+	rules, err := ParsePatternRules(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern rules: %w", err)
+	}
+	for _, excludePath := range excludePaths {
+		rule, err := NewExcludePatternRule(excludePath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude path: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// ParsePatternRule parses a single rule like "- sh:**/node_modules"
+func ParsePatternRule(line string) (PatternRule, error) {
+	if line == "" {
+		return PatternRule{}, fmt.Errorf("rule must not be empty")
+	}
+	ruleType := PatternRuleType(line[:1])
+	switch ruleType {
+	case PatternRuleInclude, PatternRuleExclude, PatternRuleExcludeNoRecurse:
+	default:
+		return PatternRule{}, fmt.Errorf("rule %q must start with '+', '-' or '!'", line)
+	}
+	pattern := strings.TrimLeft(line[1:], " \t")
+	if pattern == "" {
+		return PatternRule{}, fmt.Errorf("rule %q has no pattern", line)
+	}
+	rule, err := newPatternRule(ruleType, pattern, PatternStyleShell)
+	if err != nil {
+		return PatternRule{}, fmt.Errorf("rule %q: %w", line, err)
+	}
+	rule.Line = line
+	return rule, nil
+}
+
+// NewExcludePatternRule returns the rule of an --exclude flag, which uses the fnmatch style by default
+func NewExcludePatternRule(pattern string) (PatternRule, error) {
+	rule, err := newPatternRule(PatternRuleExclude, pattern, PatternStyleFnmatch)
+	if err != nil {
+		return PatternRule{}, fmt.Errorf("exclude %q: %w", pattern, err)
+	}
+	rule.Line = fmt.Sprintf("--exclude %s", pattern)
+	return rule, nil
+}
+
+func newPatternRule(ruleType PatternRuleType, pattern string, defaultStyle PatternStyle) (PatternRule, error) {
+	rule := PatternRule{Type: ruleType, Style: defaultStyle, Pattern: pattern}
+	if len(pattern) > 2 && pattern[2] == ':' && isAlphanumeric(pattern[:2]) {
+		rule.Style = PatternStyle(pattern[:2])
+		rule.Pattern = pattern[3:]
+	}
+
+	var err error
+	switch rule.Style {
+	case PatternStyleFnmatch:
+		// A pattern matches a path and everything below it
+		p := path.Clean(rule.Pattern) + "/*"
+		if strings.HasSuffix(rule.Pattern, "/") {
+			p += "/"
+		}
+		rule.regex, err = regexp.Compile(`^(?s:` + translateGlob(strings.TrimLeft(p, "/"), false) + `)\z`)
+	case PatternStyleShell:
+		p := path.Clean(rule.Pattern) + "/**/*"
+		if strings.HasSuffix(rule.Pattern, "/") {
+			p += "/"
+		}
+		rule.regex, err = regexp.Compile(`^(?s:` + translateGlob(strings.TrimLeft(p, "/"), true) + `)\z`)
+	case PatternStyleRegex:
+		rule.regex, err = regexp.Compile(rule.Pattern)
+	case PatternStylePathPrefix:
+		rule.prefix = strings.TrimLeft(strings.TrimRight(path.Clean(rule.Pattern), "/")+"/", "/")
+	case PatternStylePathFull:
+		rule.prefix = strings.TrimLeft(path.Clean(rule.Pattern), "/")
+	default:
+		return PatternRule{}, fmt.Errorf("unknown pattern style %q", rule.Style)
+	}
+	if err != nil {
+		return PatternRule{}, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return rule, nil
+}
+
+// Match returns true if the rule matches the absolute path
+func (r *PatternRule) Match(p string) bool {
+	// Borg stores and matches paths without the leading slash
+	p = strings.TrimLeft(path.Clean(p), "/")
+	switch r.Style {
+	case PatternStyleFnmatch, PatternStyleShell:
+		return r.regex.MatchString(p + "/")
+	case PatternStyleRegex:
+		return r.regex.MatchString(p)
+	case PatternStylePathPrefix:
+		return strings.HasPrefix(p+"/", r.prefix)
+	case PatternStylePathFull:
+		return p == r.prefix
+	default:
+		return false
+	}
+}
+
+// EvaluatePatternRules evaluates a path like borg does when it walks a backup root.
+// The first matching rule decides; paths that match no rule are included.
+// Every directory between the root and the path is evaluated as well since borg does not recurse into
+// directories excluded with "!".
+func EvaluatePatternRules(rules []PatternRule, root, p string) PatternEvaluation {
+	root = path.Clean(root)
+	p = path.Clean(p)
+
+	var dirs []string
+	if p != root {
+		for dir := path.Dir(p); isWithinRoot(root, dir); dir = path.Dir(dir) {
+			dirs = append(dirs, dir)
+			if dir == root {
+				break
+			}
+		}
+	}
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if rule := firstMatchingRule(rules, dirs[i]); rule != nil && rule.Type == PatternRuleExcludeNoRecurse {
+			return PatternEvaluation{Included: false, Rule: rule, Path: dirs[i]}
+		}
+	}
+
+	rule := firstMatchingRule(rules, p)
+	if rule == nil {
+		return PatternEvaluation{Included: true, Path: p}
+	}
+	return PatternEvaluation{Included: rule.Type == PatternRuleInclude, Rule: rule, Path: p}
+}
+
+// SkipsDirectory returns true if borg doesn't walk the contents of the directory.
+// That is the case if the first matching rule excludes it with "!", or with "-" while there is no include rule
+// that could match a path below it.
+func SkipsDirectory(rules []PatternRule, dir string) bool {
+	rule := firstMatchingRule(rules, dir)
+	if rule == nil {
+		return false
+	}
+	switch rule.Type {
+	case PatternRuleExcludeNoRecurse:
+		return true
+	case PatternRuleExclude:
+		return !slices.ContainsFunc(rules, func(r PatternRule) bool { return r.Type == PatternRuleInclude })
+	case PatternRuleInclude:
+	}
+	return false
+}
+
+// isWithinRoot returns true if the path is the root or below it
+func isWithinRoot(root, p string) bool {
+	return p == root || strings.HasPrefix(p, strings.TrimSuffix(root, "/")+"/")
+}
+
+func firstMatchingRule(rules []PatternRule, p string) *PatternRule {
+	for i := range rules {
+		if rules[i].Match(p) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// translateGlob translates a fnmatch (shell=false) or shell style pattern into a regular expression.
+// In the shell style "*" and "?" don't match "/" and "**/" matches any number of directories.
+func translateGlob(pattern string, shell bool) string {
+	var b strings.Builder
+	runes := []rune(pattern)
+	n := len(runes)
+	for i := 0; i < n; i++ {
+		c := runes[i]
+		switch {
+		case c == '*' && shell && i+2 < n && runes[i+1] == '*' && runes[i+2] == '/':
+			b.WriteString(`(?:[^/]*/)*`)
+			i += 2
+		case c == '*' && shell:
+			b.WriteString(`[^/]*`)
+		case c == '*':
+			b.WriteString(`.*`)
+		case c == '?' && shell:
+			b.WriteString(`[^/]`)
+		case c == '?':
+			b.WriteString(`.`)
+		case c == '[':
+			j := i + 1
+			if j < n && runes[j] == '!' {
+				j++
+			}
+			if j < n && runes[j] == ']' {
+				j++
+			}
+			for j < n && runes[j] != ']' {
+				j++
+			}
+			if j >= n {
+				b.WriteString(`\[`)
+				continue
+			}
+			b.WriteString(translateBracket(string(runes[i+1 : j])))
+			i = j
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return b.String()
+}
+
+// translateBracket translates the content of a character class like "!a-z"
+func translateBracket(class string) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	if strings.HasPrefix(class, "!") {
+		b.WriteByte('^')
+		class = class[1:]
+	}
+	for _, r := range class {
+		if r == '-' {
+			b.WriteRune(r)
+		} else {
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func isAlphanumeric(s string) bool {
+	for _, r := range s {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
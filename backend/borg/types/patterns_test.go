@@ -0,0 +1,276 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+TEST CASES - patterns.go
+
+TestParsePatternRule
+* Parse rule with default shell style
+* Parse rule with style prefix
+* Reject rule without command
+* Reject rule without pattern
+* Reject unknown style
+* Reject invalid regex
+
+TestPatternRuleMatch
+* fm: matches path and everything below it
+* fm: star crosses directories
+* fm: trailing slash only matches content
+* sh: star does not cross directories
+* sh: double star matches any number of directories
+* sh: character class
+* re: searches path without leading slash
+* pp: matches path prefix at directory boundary
+* pf: matches full path only
+
+TestEvaluatePatternRules
+* Include path that matches no rule
+* First matching rule decides
+* Exclude with "-" still recurses into directory
+* Exclude with "!" does not recurse into directory
+* Exclude path rule uses fnmatch style
+
+TestSkipsDirectory
+* Skip directory excluded with "!"
+* Skip directory excluded with "-" without include rules
+* Enter directory excluded with "-" if an include rule exists
+* Enter directory that matches no rule
+
+*/
+
+func TestParsePatternRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    PatternRule
+		wantErr bool
+	}{
+		{
+			name: "Parse rule with default shell style",
+			line: "- **/node_modules",
+			want: PatternRule{Type: PatternRuleExclude, Style: PatternStyleShell, Pattern: "**/node_modules", Line: "- **/node_modules"},
+		},
+		{
+			name: "Parse rule with style prefix",
+			line: "+pp:/home/user/docs",
+			want: PatternRule{Type: PatternRuleInclude, Style: PatternStylePathPrefix, Pattern: "/home/user/docs", Line: "+pp:/home/user/docs"},
+		},
+		{
+			name:    "Reject rule without command",
+			line:    "/home/user",
+			wantErr: true,
+		},
+		{
+			name:    "Reject rule without pattern",
+			line:    "! ",
+			wantErr: true,
+		},
+		{
+			name:    "Reject unknown style",
+			line:    "- xx:/home",
+			wantErr: true,
+		},
+		{
+			name:    "Reject invalid regex",
+			line:    "- re:(unclosed",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParsePatternRule(tt.line)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Type, rule.Type)
+			assert.Equal(t, tt.want.Style, rule.Style)
+			assert.Equal(t, tt.want.Pattern, rule.Pattern)
+			assert.Equal(t, tt.want.Line, rule.Line)
+		})
+	}
+}
+
+func TestPatternRuleMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		matches []string
+		misses  []string
+	}{
+		{
+			name:    "fm: matches path and everything below it",
+			line:    "- fm:/home/user/.cache",
+			matches: []string{"/home/user/.cache", "/home/user/.cache/thumbnails/a.png"},
+			misses:  []string{"/home/user/.cache2", "/home/user"},
+		},
+		{
+			name:    "fm: star crosses directories",
+			line:    "- fm:/home/*.tmp",
+			matches: []string{"/home/a.tmp", "/home/user/docs/b.tmp"},
+			misses:  []string{"/home/a.txt"},
+		},
+		{
+			name:    "fm: trailing slash only matches content",
+			line:    "- fm:/home/user/.cache/",
+			matches: []string{"/home/user/.cache/thumbnails"},
+			misses:  []string{"/home/user/.cache"},
+		},
+		{
+			name:    "sh: star does not cross directories",
+			line:    "- sh:/home/*.tmp",
+			matches: []string{"/home/a.tmp"},
+			misses:  []string{"/home/user/b.tmp"},
+		},
+		{
+			name:    "sh: double star matches any number of directories",
+			line:    "- sh:/home/**/node_modules",
+			matches: []string{"/home/node_modules", "/home/user/src/app/node_modules", "/home/user/app/node_modules/lib/index.js"},
+			misses:  []string{"/home/user/node_modules2"},
+		},
+		{
+			name:    "sh: character class",
+			line:    "- sh:/data/log[0-9].txt",
+			matches: []string{"/data/log1.txt"},
+			misses:  []string{"/data/loga.txt"},
+		},
+		{
+			name:    "re: searches path without leading slash",
+			line:    `- re:\.(bak|swp)$`,
+			matches: []string{"/home/user/notes.swp", "/etc/hosts.bak"},
+			misses:  []string{"/home/user/notes.txt"},
+		},
+		{
+			name:    "pp: matches path prefix at directory boundary",
+			line:    "- pp:/home/user/Downloads",
+			matches: []string{"/home/user/Downloads", "/home/user/Downloads/iso/a.iso"},
+			misses:  []string{"/home/user/Downloads2"},
+		},
+		{
+			name:    "pf: matches full path only",
+			line:    "- pf:/home/user/big.iso",
+			matches: []string{"/home/user/big.iso"},
+			misses:  []string{"/home/user/big.iso/x", "/home/user"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParsePatternRule(tt.line)
+			require.NoError(t, err)
+			for _, p := range tt.matches {
+				assert.True(t, rule.Match(p), "%q should match %q", tt.line, p)
+			}
+			for _, p := range tt.misses {
+				assert.False(t, rule.Match(p), "%q should not match %q", tt.line, p)
+			}
+		})
+	}
+}
+
+func TestEvaluatePatternRules(t *testing.T) {
+	mustParse := func(lines ...string) []PatternRule {
+		rules, err := ParsePatternRules(lines)
+		require.NoError(t, err)
+		return rules
+	}
+
+	tests := []struct {
+		name         string
+		rules        []PatternRule
+		path         string
+		wantIncluded bool
+		wantRule     string
+		wantPath     string
+	}{
+		{
+			name:         "Include path that matches no rule",
+			rules:        mustParse("- sh:**/*.tmp"),
+			path:         "/home/user/notes.txt",
+			wantIncluded: true,
+			wantPath:     "/home/user/notes.txt",
+		},
+		{
+			name:         "First matching rule decides",
+			rules:        mustParse("+ sh:/home/user/.cache/keep", "- sh:/home/user/.cache"),
+			path:         "/home/user/.cache/keep/a",
+			wantIncluded: true,
+			wantRule:     "+ sh:/home/user/.cache/keep",
+			wantPath:     "/home/user/.cache/keep/a",
+		},
+		{
+			name:         "Exclude with \"-\" still recurses into directory",
+			rules:        mustParse("+ sh:/home/user/*.txt", "- pf:/home/user", "- sh:/home/user/*"),
+			path:         "/home/user/notes.txt",
+			wantIncluded: true,
+			wantRule:     "+ sh:/home/user/*.txt",
+			wantPath:     "/home/user/notes.txt",
+		},
+		{
+			name:         "Exclude with \"!\" does not recurse into directory",
+			rules:        mustParse("+ sh:/home/user/*.txt", "! pf:/home/user"),
+			path:         "/home/user/notes.txt",
+			wantIncluded: false,
+			wantRule:     "! pf:/home/user",
+			wantPath:     "/home/user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluation := EvaluatePatternRules(tt.rules, "/home", tt.path)
+
+			assert.Equal(t, tt.wantIncluded, evaluation.Included)
+			assert.Equal(t, tt.wantPath, evaluation.Path)
+			if tt.wantRule == "" {
+				assert.Nil(t, evaluation.Rule)
+			} else if assert.NotNil(t, evaluation.Rule) {
+				assert.Equal(t, tt.wantRule, evaluation.Rule.Line)
+			}
+		})
+	}
+
+	t.Run("Exclude path rule uses fnmatch style", func(t *testing.T) {
+		rule, err := NewExcludePatternRule("/home/*.iso")
+		require.NoError(t, err)
+
+		evaluation := EvaluatePatternRules([]PatternRule{rule}, "/home", "/home/user/a.iso")
+
+		assert.False(t, evaluation.Included)
+		assert.Equal(t, "--exclude /home/*.iso", evaluation.Rule.Line)
+	})
+}
+
+func TestSkipsDirectory(t *testing.T) {
+	mustParse := func(lines ...string) []PatternRule {
+		rules, err := ParsePatternRules(lines)
+		require.NoError(t, err)
+		return rules
+	}
+
+	tests := []struct {
+		name  string
+		rules []PatternRule
+		dir   string
+		skip  bool
+	}{
+		{name: "Skip directory excluded with \"!\"", rules: mustParse("+ sh:/home/user/*.txt", "! pf:/home/user/.cache"), dir: "/home/user/.cache", skip: true},
+		{name: "Skip directory excluded with \"-\" without include rules", rules: mustParse("- sh:**/node_modules"), dir: "/home/user/app/node_modules", skip: true},
+		{name: "Enter directory excluded with \"-\" if an include rule exists", rules: mustParse("+ sh:**/keep", "- sh:**/node_modules"), dir: "/home/user/app/node_modules", skip: false},
+		{name: "Enter directory that matches no rule", rules: mustParse("! sh:**/node_modules"), dir: "/home/user/app", skip: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.skip, SkipsDirectory(tt.rules, tt.dir))
+		})
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 	"time"
+
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 )
 
 // UnixTime wraps time.Time to provide custom unmarshalling of Unix timestamps.
@@ -201,6 +204,25 @@ type ArchiveList struct {
 	Comment  string     `json:"comment"`
 }
 
+// ArchiveItem is a single entry of the `borg list --json-lines` output of an archive
+type ArchiveItem struct {
+	Type       string     `json:"type"` // "d" = directory, "-" = regular file, "l" = symlink, ...
+	Mode       string     `json:"mode"`
+	User       string     `json:"user"`
+	Group      string     `json:"group"`
+	UID        int        `json:"uid"`
+	GID        int        `json:"gid"`
+	Path       string     `json:"path"`
+	LinkTarget string     `json:"linktarget"`
+	MTime      StringTime `json:"mtime"`
+	Size       int        `json:"size"`
+}
+
+// IsDir returns true if the item is a directory
+func (ai ArchiveItem) IsDir() bool {
+	return ai.Type == "d"
+}
+
 type Limits struct {
 	MaxArchiveSize float64 `json:"max_archive_size"`
 }
@@ -246,6 +268,12 @@ type Stats struct {
 	UniqueCSize       int `json:"unique_csize"`        // Compressed and encrypted size of all chunks
 }
 
+// CheckArchiveFilter restricts a data verification to a subset of archives (--archives-only)
+type CheckArchiveFilter struct {
+	GlobArchives string // Only check archives matching this shell-style pattern (--glob-archives)
+	Last         int    // Only check the newest N archives (--last), 0 for no limit
+}
+
 type CheckResult struct {
 	Status    *Status      // Command execution status
 	ErrorLogs []LogMessage // Captured ERROR messages only
@@ -265,8 +293,119 @@ type InfoResponse struct {
 }
 
 type BackupProgress struct {
-	TotalFiles     int `json:"totalFiles"`
-	ProcessedFiles int `json:"processedFiles"`
+	TotalFiles       int     `json:"totalFiles"` // Estimated number of files, 0 if unknown
+	ProcessedFiles   int     `json:"processedFiles"`
+	TotalBytes       int     `json:"totalBytes"` // Estimated original size, 0 if unknown
+	ProcessedBytes   int     `json:"processedBytes"`
+	FilesPerSecond   float64 `json:"filesPerSecond"`             // Smoothed throughput, 0 if unknown
+	BytesPerSecond   float64 `json:"bytesPerSecond"`             // Smoothed throughput, 0 if unknown
+	RemainingSeconds *int    `json:"remainingSeconds,omitempty"` // Estimated time until the backup is done, nil if unknown
+}
+
+// BackupEstimate is the expected size of a backup (usually taken from the previous archives)
+type BackupEstimate struct {
+	TotalFiles int           // Number of files, 0 if unknown
+	TotalBytes int           // Original size, 0 if unknown
+	Duration   time.Duration // Typical duration of the backup, 0 if unknown
+}
+
+// ContentCommand is a command whose output is backed up as a single file in the archive
+type ContentCommand struct {
+	Command   string // Shell command (run with sh -c)
+	StdinName string // Path of the output in the archive (--stdin-name)
+}
+
+// CreateOptions are the settings of borg create that select the backed up paths and change how they are stored
+type CreateOptions struct {
+	ExcludePaths       []string                      // --exclude
+	Patterns           []string                      // Pattern rules passed with --patterns-from
+	ExcludeCaches      bool                          // --exclude-caches
+	ExcludeIfPresent   []string                      // --exclude-if-present
+	UseIgnoreFiles     bool                          // Honor the ignore files in the backup paths
+	CompressionMode    backupprofile.CompressionMode // --compression, no compression if empty
+	CompressionLevel   *int                          // Level of the compression, borg's default if nil
+	OneFileSystem      bool                          // --one-file-system
+	NumericIds         bool                          // --numeric-ids
+	NoFlags            bool                          // --noflags
+	NoAcls             bool                          // --noacls
+	NoXattrs           bool                          // --noxattrs
+	Sparse             bool                          // --sparse
+	ReadSpecial        bool                          // --read-special
+	CheckpointInterval *int                          // --checkpoint-interval in seconds, borg's default if nil
+}
+
+type ExtractProgress struct {
+	TotalBytes     int    `json:"totalBytes"`
+	ProcessedBytes int    `json:"processedBytes"`
+	CurrentPath    string `json:"currentPath,omitempty"`
+}
+
+// TarFormat is the output format of `borg export-tar`
+type TarFormat string
+
+const (
+	TarFormatTar    TarFormat = "tar"     // Uncompressed tar
+	TarFormatTarGz  TarFormat = "tar.gz"  // Tar compressed with gzip
+	TarFormatTarZst TarFormat = "tar.zst" // Tar compressed with zstd
+)
+
+var AvailableTarFormats = []TarFormat{
+	TarFormatTar,
+	TarFormatTarGz,
+	TarFormatTarZst,
+}
+
+// IsValid returns true if the format is one of the known formats
+func (f TarFormat) IsValid() bool {
+	return slices.Contains(AvailableTarFormats, f)
+}
+
+// Extension returns the file extension (including the leading dot) of the format
+func (f TarFormat) Extension() string {
+	return "." + string(f)
+}
+
+// TarFormatFromPath returns the tar format matching the extension of a file (tar if unknown)
+func TarFormatFromPath(filePath string) TarFormat {
+	for _, format := range AvailableTarFormats {
+		if format != TarFormatTar && strings.HasSuffix(filePath, format.Extension()) {
+			return format
+		}
+	}
+	return TarFormatTar
+}
+
+// DiffLine is a single line of `borg diff --json-lines`
+type DiffLine struct {
+	Path    string       `json:"path"`
+	Changes []DiffChange `json:"changes"`
+}
+
+type DiffChange struct {
+	Type    string `json:"type"`
+	Added   int64  `json:"added,omitempty"`   // Bytes added to a modified file
+	Removed int64  `json:"removed,omitempty"` // Bytes removed from a modified file
+	Size    int64  `json:"size,omitempty"`    // Size of an added or removed file
+}
+
+type DiffChangeType string
+
+const (
+	DiffChangeTypeAdded    DiffChangeType = "added"
+	DiffChangeTypeRemoved  DiffChangeType = "removed"
+	DiffChangeTypeModified DiffChangeType = "modified"
+)
+
+// DiffEntry describes how a single path changed between two archives
+type DiffEntry struct {
+	Path            string         `json:"path"`
+	ChangeType      DiffChangeType `json:"changeType"`
+	IsDir           bool           `json:"isDir"`
+	IsLink          bool           `json:"isLink"`
+	AddedBytes      int64          `json:"addedBytes"`
+	RemovedBytes    int64          `json:"removedBytes"`
+	SizeDelta       int64          `json:"sizeDelta"`                 // AddedBytes - RemovedBytes
+	MetadataChanges []string       `json:"metadataChanges,omitempty"` // e.g. mode, owner, mtime
 }
 
 type ListResponse struct {
@@ -35,6 +35,20 @@ type Archive struct {
 	Comment string `json:"comment"`
 	// Warning message from the backup operation that created this archive
 	WarningMessage *string `json:"warningMessage,omitempty"`
+	// Timestamp when the files of this archive were added to the file index
+	FilesIndexedAt *time.Time `json:"filesIndexedAt,omitempty"`
+	// Number of files in the archive (nil if the stats were not fetched yet)
+	Nfiles *int `json:"nfiles,omitempty"`
+	// Uncompressed size of all files in the archive
+	OriginalSize *int `json:"originalSize,omitempty"`
+	// Compressed size of all files in the archive
+	CompressedSize *int `json:"compressedSize,omitempty"`
+	// Compressed size of the chunks that only this archive references (space added by this archive)
+	DeduplicatedSize *int `json:"deduplicatedSize,omitempty"`
+	// Number of failed attempts to fetch the stats (the stats are not fetched anymore after a few failures)
+	StatsFailures int `json:"statsFailures"`
+	// Timestamp of the last full check (--verify-data) that covered this archive
+	DataVerifiedAt *time.Time `json:"dataVerifiedAt,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArchiveQuery when eager-loading is set.
 	Edges                   ArchiveEdges `json:"edges"`
@@ -49,9 +63,11 @@ type ArchiveEdges struct {
 	Repository *Repository `json:"repository,omitempty"`
 	// BackupProfile holds the value of the backup_profile edge.
 	BackupProfile *BackupProfile `json:"backupProfile,omitempty"`
+	// Files holds the value of the files edge.
+	Files []*ArchiveFile `json:"-"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // RepositoryOrErr returns the Repository value or an error if the edge
@@ -76,6 +92,15 @@ func (e ArchiveEdges) BackupProfileOrErr() (*BackupProfile, error) {
 	return nil, &NotLoadedError{edge: "backup_profile"}
 }
 
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArchiveEdges) FilesOrErr() ([]*ArchiveFile, error) {
+	if e.loadedTypes[2] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Archive) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -85,11 +110,11 @@ func (*Archive) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case archive.FieldDuration:
 			values[i] = new(sql.NullFloat64)
-		case archive.FieldID:
+		case archive.FieldID, archive.FieldNfiles, archive.FieldOriginalSize, archive.FieldCompressedSize, archive.FieldDeduplicatedSize, archive.FieldStatsFailures:
 			values[i] = new(sql.NullInt64)
 		case archive.FieldName, archive.FieldBorgID, archive.FieldComment, archive.FieldWarningMessage:
 			values[i] = new(sql.NullString)
-		case archive.FieldCreatedAt, archive.FieldUpdatedAt:
+		case archive.FieldCreatedAt, archive.FieldUpdatedAt, archive.FieldFilesIndexedAt, archive.FieldDataVerifiedAt:
 			values[i] = new(sql.NullTime)
 		case archive.ForeignKeys[0]: // archive_repository
 			values[i] = new(sql.NullInt64)
@@ -165,6 +190,54 @@ func (_m *Archive) assignValues(columns []string, values []any) error {
 				_m.WarningMessage = new(string)
 				*_m.WarningMessage = value.String
 			}
+		case archive.FieldFilesIndexedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field files_indexed_at", values[i])
+			} else if value.Valid {
+				_m.FilesIndexedAt = new(time.Time)
+				*_m.FilesIndexedAt = value.Time
+			}
+		case archive.FieldNfiles:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nfiles", values[i])
+			} else if value.Valid {
+				_m.Nfiles = new(int)
+				*_m.Nfiles = int(value.Int64)
+			}
+		case archive.FieldOriginalSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field original_size", values[i])
+			} else if value.Valid {
+				_m.OriginalSize = new(int)
+				*_m.OriginalSize = int(value.Int64)
+			}
+		case archive.FieldCompressedSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field compressed_size", values[i])
+			} else if value.Valid {
+				_m.CompressedSize = new(int)
+				*_m.CompressedSize = int(value.Int64)
+			}
+		case archive.FieldDeduplicatedSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deduplicated_size", values[i])
+			} else if value.Valid {
+				_m.DeduplicatedSize = new(int)
+				*_m.DeduplicatedSize = int(value.Int64)
+			}
+		case archive.FieldStatsFailures:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stats_failures", values[i])
+			} else if value.Valid {
+				_m.StatsFailures = int(value.Int64)
+			}
+		case archive.FieldDataVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field data_verified_at", values[i])
+			} else if value.Valid {
+				_m.DataVerifiedAt = new(time.Time)
+				*_m.DataVerifiedAt = value.Time
+			}
 		case archive.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field archive_repository", value)
@@ -202,6 +275,11 @@ func (_m *Archive) QueryBackupProfile() *BackupProfileQuery {
 	return NewArchiveClient(_m.config).QueryBackupProfile(_m)
 }
 
+// QueryFiles queries the "files" edge of the Archive entity.
+func (_m *Archive) QueryFiles() *ArchiveFileQuery {
+	return NewArchiveClient(_m.config).QueryFiles(_m)
+}
+
 // Update returns a builder for updating this Archive.
 // Note that you need to call Archive.Unwrap() before calling this method if this Archive
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -250,6 +328,39 @@ func (_m *Archive) String() string {
 		builder.WriteString("warning_message=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	if v := _m.FilesIndexedAt; v != nil {
+		builder.WriteString("files_indexed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Nfiles; v != nil {
+		builder.WriteString("nfiles=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.OriginalSize; v != nil {
+		builder.WriteString("original_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompressedSize; v != nil {
+		builder.WriteString("compressed_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeduplicatedSize; v != nil {
+		builder.WriteString("deduplicated_size=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("stats_failures=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StatsFailures))
+	builder.WriteString(", ")
+	if v := _m.DataVerifiedAt; v != nil {
+		builder.WriteString("data_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
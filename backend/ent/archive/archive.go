@@ -30,10 +30,26 @@ const (
 	FieldComment = "comment"
 	// FieldWarningMessage holds the string denoting the warning_message field in the database.
 	FieldWarningMessage = "warning_message"
+	// FieldFilesIndexedAt holds the string denoting the files_indexed_at field in the database.
+	FieldFilesIndexedAt = "files_indexed_at"
+	// FieldNfiles holds the string denoting the nfiles field in the database.
+	FieldNfiles = "nfiles"
+	// FieldOriginalSize holds the string denoting the original_size field in the database.
+	FieldOriginalSize = "original_size"
+	// FieldCompressedSize holds the string denoting the compressed_size field in the database.
+	FieldCompressedSize = "compressed_size"
+	// FieldDeduplicatedSize holds the string denoting the deduplicated_size field in the database.
+	FieldDeduplicatedSize = "deduplicated_size"
+	// FieldStatsFailures holds the string denoting the stats_failures field in the database.
+	FieldStatsFailures = "stats_failures"
+	// FieldDataVerifiedAt holds the string denoting the data_verified_at field in the database.
+	FieldDataVerifiedAt = "data_verified_at"
 	// EdgeRepository holds the string denoting the repository edge name in mutations.
 	EdgeRepository = "repository"
 	// EdgeBackupProfile holds the string denoting the backup_profile edge name in mutations.
 	EdgeBackupProfile = "backup_profile"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
 	// Table holds the table name of the archive in the database.
 	Table = "archives"
 	// RepositoryTable is the table that holds the repository relation/edge.
@@ -50,6 +66,13 @@ const (
 	BackupProfileInverseTable = "backup_profiles"
 	// BackupProfileColumn is the table column denoting the backup_profile relation/edge.
 	BackupProfileColumn = "backup_profile_archives"
+	// FilesTable is the table that holds the files relation/edge.
+	FilesTable = "archive_files"
+	// FilesInverseTable is the table name for the ArchiveFile entity.
+	// It exists in this package in order to avoid circular dependency with the "archivefile" package.
+	FilesInverseTable = "archive_files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "archive_file_archive"
 )
 
 // Columns holds all SQL columns for archive fields.
@@ -63,6 +86,13 @@ var Columns = []string{
 	FieldWillBePruned,
 	FieldComment,
 	FieldWarningMessage,
+	FieldFilesIndexedAt,
+	FieldNfiles,
+	FieldOriginalSize,
+	FieldCompressedSize,
+	FieldDeduplicatedSize,
+	FieldStatsFailures,
+	FieldDataVerifiedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "archives"
@@ -98,6 +128,8 @@ var (
 	DefaultWillBePruned bool
 	// DefaultComment holds the default value on creation for the "comment" field.
 	DefaultComment string
+	// DefaultStatsFailures holds the default value on creation for the "stats_failures" field.
+	DefaultStatsFailures int
 )
 
 // OrderOption defines the ordering options for the Archive queries.
@@ -148,6 +180,41 @@ func ByWarningMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWarningMessage, opts...).ToFunc()
 }
 
+// ByFilesIndexedAt orders the results by the files_indexed_at field.
+func ByFilesIndexedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilesIndexedAt, opts...).ToFunc()
+}
+
+// ByNfiles orders the results by the nfiles field.
+func ByNfiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNfiles, opts...).ToFunc()
+}
+
+// ByOriginalSize orders the results by the original_size field.
+func ByOriginalSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOriginalSize, opts...).ToFunc()
+}
+
+// ByCompressedSize orders the results by the compressed_size field.
+func ByCompressedSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompressedSize, opts...).ToFunc()
+}
+
+// ByDeduplicatedSize orders the results by the deduplicated_size field.
+func ByDeduplicatedSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeduplicatedSize, opts...).ToFunc()
+}
+
+// ByStatsFailures orders the results by the stats_failures field.
+func ByStatsFailures(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatsFailures, opts...).ToFunc()
+}
+
+// ByDataVerifiedAt orders the results by the data_verified_at field.
+func ByDataVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDataVerifiedAt, opts...).ToFunc()
+}
+
 // ByRepositoryField orders the results by repository field.
 func ByRepositoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -161,6 +228,20 @@ func ByBackupProfileField(field string, opts ...sql.OrderTermOption) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newBackupProfileStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByFilesCount orders the results by files count.
+func ByFilesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFilesStep(), opts...)
+	}
+}
+
+// ByFiles orders the results by files terms.
+func ByFiles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFilesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRepositoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -175,3 +256,10 @@ func newBackupProfileStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, BackupProfileTable, BackupProfileColumn),
 	)
 }
+func newFilesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FilesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, FilesTable, FilesColumn),
+	)
+}
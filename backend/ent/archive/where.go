@@ -95,6 +95,41 @@ func WarningMessage(v string) predicate.Archive {
 	return predicate.Archive(sql.FieldEQ(FieldWarningMessage, v))
 }
 
+// FilesIndexedAt applies equality check predicate on the "files_indexed_at" field. It's identical to FilesIndexedAtEQ.
+func FilesIndexedAt(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldFilesIndexedAt, v))
+}
+
+// Nfiles applies equality check predicate on the "nfiles" field. It's identical to NfilesEQ.
+func Nfiles(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldNfiles, v))
+}
+
+// OriginalSize applies equality check predicate on the "original_size" field. It's identical to OriginalSizeEQ.
+func OriginalSize(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldOriginalSize, v))
+}
+
+// CompressedSize applies equality check predicate on the "compressed_size" field. It's identical to CompressedSizeEQ.
+func CompressedSize(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCompressedSize, v))
+}
+
+// DeduplicatedSize applies equality check predicate on the "deduplicated_size" field. It's identical to DeduplicatedSizeEQ.
+func DeduplicatedSize(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldDeduplicatedSize, v))
+}
+
+// StatsFailures applies equality check predicate on the "stats_failures" field. It's identical to StatsFailuresEQ.
+func StatsFailures(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldStatsFailures, v))
+}
+
+// DataVerifiedAt applies equality check predicate on the "data_verified_at" field. It's identical to DataVerifiedAtEQ.
+func DataVerifiedAt(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldDataVerifiedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Archive {
 	return predicate.Archive(sql.FieldEQ(FieldCreatedAt, v))
@@ -505,6 +540,346 @@ func WarningMessageContainsFold(v string) predicate.Archive {
 	return predicate.Archive(sql.FieldContainsFold(FieldWarningMessage, v))
 }
 
+// FilesIndexedAtEQ applies the EQ predicate on the "files_indexed_at" field.
+func FilesIndexedAtEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtNEQ applies the NEQ predicate on the "files_indexed_at" field.
+func FilesIndexedAtNEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtIn applies the In predicate on the "files_indexed_at" field.
+func FilesIndexedAtIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldFilesIndexedAt, vs...))
+}
+
+// FilesIndexedAtNotIn applies the NotIn predicate on the "files_indexed_at" field.
+func FilesIndexedAtNotIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldFilesIndexedAt, vs...))
+}
+
+// FilesIndexedAtGT applies the GT predicate on the "files_indexed_at" field.
+func FilesIndexedAtGT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtGTE applies the GTE predicate on the "files_indexed_at" field.
+func FilesIndexedAtGTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtLT applies the LT predicate on the "files_indexed_at" field.
+func FilesIndexedAtLT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtLTE applies the LTE predicate on the "files_indexed_at" field.
+func FilesIndexedAtLTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldFilesIndexedAt, v))
+}
+
+// FilesIndexedAtIsNil applies the IsNil predicate on the "files_indexed_at" field.
+func FilesIndexedAtIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldFilesIndexedAt))
+}
+
+// FilesIndexedAtNotNil applies the NotNil predicate on the "files_indexed_at" field.
+func FilesIndexedAtNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldFilesIndexedAt))
+}
+
+// NfilesEQ applies the EQ predicate on the "nfiles" field.
+func NfilesEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldNfiles, v))
+}
+
+// NfilesNEQ applies the NEQ predicate on the "nfiles" field.
+func NfilesNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldNfiles, v))
+}
+
+// NfilesIn applies the In predicate on the "nfiles" field.
+func NfilesIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldNfiles, vs...))
+}
+
+// NfilesNotIn applies the NotIn predicate on the "nfiles" field.
+func NfilesNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldNfiles, vs...))
+}
+
+// NfilesGT applies the GT predicate on the "nfiles" field.
+func NfilesGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldNfiles, v))
+}
+
+// NfilesGTE applies the GTE predicate on the "nfiles" field.
+func NfilesGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldNfiles, v))
+}
+
+// NfilesLT applies the LT predicate on the "nfiles" field.
+func NfilesLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldNfiles, v))
+}
+
+// NfilesLTE applies the LTE predicate on the "nfiles" field.
+func NfilesLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldNfiles, v))
+}
+
+// NfilesIsNil applies the IsNil predicate on the "nfiles" field.
+func NfilesIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldNfiles))
+}
+
+// NfilesNotNil applies the NotNil predicate on the "nfiles" field.
+func NfilesNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldNfiles))
+}
+
+// OriginalSizeEQ applies the EQ predicate on the "original_size" field.
+func OriginalSizeEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldOriginalSize, v))
+}
+
+// OriginalSizeNEQ applies the NEQ predicate on the "original_size" field.
+func OriginalSizeNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldOriginalSize, v))
+}
+
+// OriginalSizeIn applies the In predicate on the "original_size" field.
+func OriginalSizeIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldOriginalSize, vs...))
+}
+
+// OriginalSizeNotIn applies the NotIn predicate on the "original_size" field.
+func OriginalSizeNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldOriginalSize, vs...))
+}
+
+// OriginalSizeGT applies the GT predicate on the "original_size" field.
+func OriginalSizeGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldOriginalSize, v))
+}
+
+// OriginalSizeGTE applies the GTE predicate on the "original_size" field.
+func OriginalSizeGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldOriginalSize, v))
+}
+
+// OriginalSizeLT applies the LT predicate on the "original_size" field.
+func OriginalSizeLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldOriginalSize, v))
+}
+
+// OriginalSizeLTE applies the LTE predicate on the "original_size" field.
+func OriginalSizeLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldOriginalSize, v))
+}
+
+// OriginalSizeIsNil applies the IsNil predicate on the "original_size" field.
+func OriginalSizeIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldOriginalSize))
+}
+
+// OriginalSizeNotNil applies the NotNil predicate on the "original_size" field.
+func OriginalSizeNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldOriginalSize))
+}
+
+// CompressedSizeEQ applies the EQ predicate on the "compressed_size" field.
+func CompressedSizeEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldCompressedSize, v))
+}
+
+// CompressedSizeNEQ applies the NEQ predicate on the "compressed_size" field.
+func CompressedSizeNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldCompressedSize, v))
+}
+
+// CompressedSizeIn applies the In predicate on the "compressed_size" field.
+func CompressedSizeIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldCompressedSize, vs...))
+}
+
+// CompressedSizeNotIn applies the NotIn predicate on the "compressed_size" field.
+func CompressedSizeNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldCompressedSize, vs...))
+}
+
+// CompressedSizeGT applies the GT predicate on the "compressed_size" field.
+func CompressedSizeGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldCompressedSize, v))
+}
+
+// CompressedSizeGTE applies the GTE predicate on the "compressed_size" field.
+func CompressedSizeGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldCompressedSize, v))
+}
+
+// CompressedSizeLT applies the LT predicate on the "compressed_size" field.
+func CompressedSizeLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldCompressedSize, v))
+}
+
+// CompressedSizeLTE applies the LTE predicate on the "compressed_size" field.
+func CompressedSizeLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldCompressedSize, v))
+}
+
+// CompressedSizeIsNil applies the IsNil predicate on the "compressed_size" field.
+func CompressedSizeIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldCompressedSize))
+}
+
+// CompressedSizeNotNil applies the NotNil predicate on the "compressed_size" field.
+func CompressedSizeNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldCompressedSize))
+}
+
+// DeduplicatedSizeEQ applies the EQ predicate on the "deduplicated_size" field.
+func DeduplicatedSizeEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeNEQ applies the NEQ predicate on the "deduplicated_size" field.
+func DeduplicatedSizeNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeIn applies the In predicate on the "deduplicated_size" field.
+func DeduplicatedSizeIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldDeduplicatedSize, vs...))
+}
+
+// DeduplicatedSizeNotIn applies the NotIn predicate on the "deduplicated_size" field.
+func DeduplicatedSizeNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldDeduplicatedSize, vs...))
+}
+
+// DeduplicatedSizeGT applies the GT predicate on the "deduplicated_size" field.
+func DeduplicatedSizeGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeGTE applies the GTE predicate on the "deduplicated_size" field.
+func DeduplicatedSizeGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeLT applies the LT predicate on the "deduplicated_size" field.
+func DeduplicatedSizeLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeLTE applies the LTE predicate on the "deduplicated_size" field.
+func DeduplicatedSizeLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldDeduplicatedSize, v))
+}
+
+// DeduplicatedSizeIsNil applies the IsNil predicate on the "deduplicated_size" field.
+func DeduplicatedSizeIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldDeduplicatedSize))
+}
+
+// DeduplicatedSizeNotNil applies the NotNil predicate on the "deduplicated_size" field.
+func DeduplicatedSizeNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldDeduplicatedSize))
+}
+
+// StatsFailuresEQ applies the EQ predicate on the "stats_failures" field.
+func StatsFailuresEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldStatsFailures, v))
+}
+
+// StatsFailuresNEQ applies the NEQ predicate on the "stats_failures" field.
+func StatsFailuresNEQ(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldStatsFailures, v))
+}
+
+// StatsFailuresIn applies the In predicate on the "stats_failures" field.
+func StatsFailuresIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldStatsFailures, vs...))
+}
+
+// StatsFailuresNotIn applies the NotIn predicate on the "stats_failures" field.
+func StatsFailuresNotIn(vs ...int) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldStatsFailures, vs...))
+}
+
+// StatsFailuresGT applies the GT predicate on the "stats_failures" field.
+func StatsFailuresGT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldStatsFailures, v))
+}
+
+// StatsFailuresGTE applies the GTE predicate on the "stats_failures" field.
+func StatsFailuresGTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldStatsFailures, v))
+}
+
+// StatsFailuresLT applies the LT predicate on the "stats_failures" field.
+func StatsFailuresLT(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldStatsFailures, v))
+}
+
+// StatsFailuresLTE applies the LTE predicate on the "stats_failures" field.
+func StatsFailuresLTE(v int) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldStatsFailures, v))
+}
+
+// DataVerifiedAtEQ applies the EQ predicate on the "data_verified_at" field.
+func DataVerifiedAtEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldEQ(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtNEQ applies the NEQ predicate on the "data_verified_at" field.
+func DataVerifiedAtNEQ(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNEQ(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtIn applies the In predicate on the "data_verified_at" field.
+func DataVerifiedAtIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldIn(FieldDataVerifiedAt, vs...))
+}
+
+// DataVerifiedAtNotIn applies the NotIn predicate on the "data_verified_at" field.
+func DataVerifiedAtNotIn(vs ...time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldNotIn(FieldDataVerifiedAt, vs...))
+}
+
+// DataVerifiedAtGT applies the GT predicate on the "data_verified_at" field.
+func DataVerifiedAtGT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGT(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtGTE applies the GTE predicate on the "data_verified_at" field.
+func DataVerifiedAtGTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldGTE(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtLT applies the LT predicate on the "data_verified_at" field.
+func DataVerifiedAtLT(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLT(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtLTE applies the LTE predicate on the "data_verified_at" field.
+func DataVerifiedAtLTE(v time.Time) predicate.Archive {
+	return predicate.Archive(sql.FieldLTE(FieldDataVerifiedAt, v))
+}
+
+// DataVerifiedAtIsNil applies the IsNil predicate on the "data_verified_at" field.
+func DataVerifiedAtIsNil() predicate.Archive {
+	return predicate.Archive(sql.FieldIsNull(FieldDataVerifiedAt))
+}
+
+// DataVerifiedAtNotNil applies the NotNil predicate on the "data_verified_at" field.
+func DataVerifiedAtNotNil() predicate.Archive {
+	return predicate.Archive(sql.FieldNotNull(FieldDataVerifiedAt))
+}
+
 // HasRepository applies the HasEdge predicate on the "repository" edge.
 func HasRepository() predicate.Archive {
 	return predicate.Archive(func(s *sql.Selector) {
@@ -551,6 +926,29 @@ func HasBackupProfileWith(preds ...predicate.BackupProfile) predicate.Archive {
 	})
 }
 
+// HasFiles applies the HasEdge predicate on the "files" edge.
+func HasFiles() predicate.Archive {
+	return predicate.Archive(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, FilesTable, FilesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFilesWith applies the HasEdge predicate on the "files" edge with a given conditions (other predicates).
+func HasFilesWith(preds ...predicate.ArchiveFile) predicate.Archive {
+	return predicate.Archive(func(s *sql.Selector) {
+		step := newFilesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Archive) predicate.Archive {
 	return predicate.Archive(sql.AndPredicates(predicates...))
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/repository"
 )
@@ -110,6 +111,104 @@ func (_c *ArchiveCreate) SetNillableWarningMessage(v *string) *ArchiveCreate {
 	return _c
 }
 
+// SetFilesIndexedAt sets the "files_indexed_at" field.
+func (_c *ArchiveCreate) SetFilesIndexedAt(v time.Time) *ArchiveCreate {
+	_c.mutation.SetFilesIndexedAt(v)
+	return _c
+}
+
+// SetNillableFilesIndexedAt sets the "files_indexed_at" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableFilesIndexedAt(v *time.Time) *ArchiveCreate {
+	if v != nil {
+		_c.SetFilesIndexedAt(*v)
+	}
+	return _c
+}
+
+// SetNfiles sets the "nfiles" field.
+func (_c *ArchiveCreate) SetNfiles(v int) *ArchiveCreate {
+	_c.mutation.SetNfiles(v)
+	return _c
+}
+
+// SetNillableNfiles sets the "nfiles" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableNfiles(v *int) *ArchiveCreate {
+	if v != nil {
+		_c.SetNfiles(*v)
+	}
+	return _c
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (_c *ArchiveCreate) SetOriginalSize(v int) *ArchiveCreate {
+	_c.mutation.SetOriginalSize(v)
+	return _c
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableOriginalSize(v *int) *ArchiveCreate {
+	if v != nil {
+		_c.SetOriginalSize(*v)
+	}
+	return _c
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_c *ArchiveCreate) SetCompressedSize(v int) *ArchiveCreate {
+	_c.mutation.SetCompressedSize(v)
+	return _c
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableCompressedSize(v *int) *ArchiveCreate {
+	if v != nil {
+		_c.SetCompressedSize(*v)
+	}
+	return _c
+}
+
+// SetDeduplicatedSize sets the "deduplicated_size" field.
+func (_c *ArchiveCreate) SetDeduplicatedSize(v int) *ArchiveCreate {
+	_c.mutation.SetDeduplicatedSize(v)
+	return _c
+}
+
+// SetNillableDeduplicatedSize sets the "deduplicated_size" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableDeduplicatedSize(v *int) *ArchiveCreate {
+	if v != nil {
+		_c.SetDeduplicatedSize(*v)
+	}
+	return _c
+}
+
+// SetStatsFailures sets the "stats_failures" field.
+func (_c *ArchiveCreate) SetStatsFailures(v int) *ArchiveCreate {
+	_c.mutation.SetStatsFailures(v)
+	return _c
+}
+
+// SetNillableStatsFailures sets the "stats_failures" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableStatsFailures(v *int) *ArchiveCreate {
+	if v != nil {
+		_c.SetStatsFailures(*v)
+	}
+	return _c
+}
+
+// SetDataVerifiedAt sets the "data_verified_at" field.
+func (_c *ArchiveCreate) SetDataVerifiedAt(v time.Time) *ArchiveCreate {
+	_c.mutation.SetDataVerifiedAt(v)
+	return _c
+}
+
+// SetNillableDataVerifiedAt sets the "data_verified_at" field if the given value is not nil.
+func (_c *ArchiveCreate) SetNillableDataVerifiedAt(v *time.Time) *ArchiveCreate {
+	if v != nil {
+		_c.SetDataVerifiedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ArchiveCreate) SetID(v int) *ArchiveCreate {
 	_c.mutation.SetID(v)
@@ -146,6 +245,21 @@ func (_c *ArchiveCreate) SetBackupProfile(v *BackupProfile) *ArchiveCreate {
 	return _c.SetBackupProfileID(v.ID)
 }
 
+// AddFileIDs adds the "files" edge to the ArchiveFile entity by IDs.
+func (_c *ArchiveCreate) AddFileIDs(ids ...int) *ArchiveCreate {
+	_c.mutation.AddFileIDs(ids...)
+	return _c
+}
+
+// AddFiles adds the "files" edges to the ArchiveFile entity.
+func (_c *ArchiveCreate) AddFiles(v ...*ArchiveFile) *ArchiveCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFileIDs(ids...)
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_c *ArchiveCreate) Mutation() *ArchiveMutation {
 	return _c.mutation
@@ -197,6 +311,10 @@ func (_c *ArchiveCreate) defaults() {
 		v := archive.DefaultComment
 		_c.mutation.SetComment(v)
 	}
+	if _, ok := _c.mutation.StatsFailures(); !ok {
+		v := archive.DefaultStatsFailures
+		_c.mutation.SetStatsFailures(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -219,6 +337,9 @@ func (_c *ArchiveCreate) check() error {
 	if _, ok := _c.mutation.WillBePruned(); !ok {
 		return &ValidationError{Name: "will_be_pruned", err: errors.New(`ent: missing required field "Archive.will_be_pruned"`)}
 	}
+	if _, ok := _c.mutation.StatsFailures(); !ok {
+		return &ValidationError{Name: "stats_failures", err: errors.New(`ent: missing required field "Archive.stats_failures"`)}
+	}
 	if len(_c.mutation.RepositoryIDs()) == 0 {
 		return &ValidationError{Name: "repository", err: errors.New(`ent: missing required edge "Archive.repository"`)}
 	}
@@ -286,6 +407,34 @@ func (_c *ArchiveCreate) createSpec() (*Archive, *sqlgraph.CreateSpec) {
 		_spec.SetField(archive.FieldWarningMessage, field.TypeString, value)
 		_node.WarningMessage = &value
 	}
+	if value, ok := _c.mutation.FilesIndexedAt(); ok {
+		_spec.SetField(archive.FieldFilesIndexedAt, field.TypeTime, value)
+		_node.FilesIndexedAt = &value
+	}
+	if value, ok := _c.mutation.Nfiles(); ok {
+		_spec.SetField(archive.FieldNfiles, field.TypeInt, value)
+		_node.Nfiles = &value
+	}
+	if value, ok := _c.mutation.OriginalSize(); ok {
+		_spec.SetField(archive.FieldOriginalSize, field.TypeInt, value)
+		_node.OriginalSize = &value
+	}
+	if value, ok := _c.mutation.CompressedSize(); ok {
+		_spec.SetField(archive.FieldCompressedSize, field.TypeInt, value)
+		_node.CompressedSize = &value
+	}
+	if value, ok := _c.mutation.DeduplicatedSize(); ok {
+		_spec.SetField(archive.FieldDeduplicatedSize, field.TypeInt, value)
+		_node.DeduplicatedSize = &value
+	}
+	if value, ok := _c.mutation.StatsFailures(); ok {
+		_spec.SetField(archive.FieldStatsFailures, field.TypeInt, value)
+		_node.StatsFailures = value
+	}
+	if value, ok := _c.mutation.DataVerifiedAt(); ok {
+		_spec.SetField(archive.FieldDataVerifiedAt, field.TypeTime, value)
+		_node.DataVerifiedAt = &value
+	}
 	if nodes := _c.mutation.RepositoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -320,6 +469,22 @@ func (_c *ArchiveCreate) createSpec() (*Archive, *sqlgraph.CreateSpec) {
 		_node.backup_profile_archives = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
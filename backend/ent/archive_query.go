@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -12,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/predicate"
 	"github.com/loomi-labs/arco/backend/ent/repository"
@@ -26,6 +28,7 @@ type ArchiveQuery struct {
 	predicates        []predicate.Archive
 	withRepository    *RepositoryQuery
 	withBackupProfile *BackupProfileQuery
+	withFiles         *ArchiveFileQuery
 	withFKs           bool
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -108,6 +111,28 @@ func (_q *ArchiveQuery) QueryBackupProfile() *BackupProfileQuery {
 	return query
 }
 
+// QueryFiles chains the current query on the "files" edge.
+func (_q *ArchiveQuery) QueryFiles() *ArchiveFileQuery {
+	query := (&ArchiveFileClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archive.Table, archive.FieldID, selector),
+			sqlgraph.To(archivefile.Table, archivefile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, archive.FilesTable, archive.FilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Archive entity from the query.
 // Returns a *NotFoundError when no Archive was found.
 func (_q *ArchiveQuery) First(ctx context.Context) (*Archive, error) {
@@ -302,6 +327,7 @@ func (_q *ArchiveQuery) Clone() *ArchiveQuery {
 		predicates:        append([]predicate.Archive{}, _q.predicates...),
 		withRepository:    _q.withRepository.Clone(),
 		withBackupProfile: _q.withBackupProfile.Clone(),
+		withFiles:         _q.withFiles.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -331,6 +357,17 @@ func (_q *ArchiveQuery) WithBackupProfile(opts ...func(*BackupProfileQuery)) *Ar
 	return _q
 }
 
+// WithFiles tells the query-builder to eager-load the nodes that are connected to
+// the "files" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ArchiveQuery) WithFiles(opts ...func(*ArchiveFileQuery)) *ArchiveQuery {
+	query := (&ArchiveFileClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFiles = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -410,9 +447,10 @@ func (_q *ArchiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arch
 		nodes       = []*Archive{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withRepository != nil,
 			_q.withBackupProfile != nil,
+			_q.withFiles != nil,
 		}
 	)
 	if _q.withRepository != nil || _q.withBackupProfile != nil {
@@ -454,6 +492,13 @@ func (_q *ArchiveQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arch
 			return nil, err
 		}
 	}
+	if query := _q.withFiles; query != nil {
+		if err := _q.loadFiles(ctx, query, nodes,
+			func(n *Archive) { n.Edges.Files = []*ArchiveFile{} },
+			func(n *Archive, e *ArchiveFile) { n.Edges.Files = append(n.Edges.Files, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -521,6 +566,37 @@ func (_q *ArchiveQuery) loadBackupProfile(ctx context.Context, query *BackupProf
 	}
 	return nil
 }
+func (_q *ArchiveQuery) loadFiles(ctx context.Context, query *ArchiveFileQuery, nodes []*Archive, init func(*Archive), assign func(*Archive, *ArchiveFile)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Archive)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ArchiveFile(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(archive.FilesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.archive_file_archive
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "archive_file_archive" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "archive_file_archive" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ArchiveQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/predicate"
 	"github.com/loomi-labs/arco/backend/ent/repository"
@@ -140,6 +141,175 @@ func (_u *ArchiveUpdate) ClearWarningMessage() *ArchiveUpdate {
 	return _u
 }
 
+// SetFilesIndexedAt sets the "files_indexed_at" field.
+func (_u *ArchiveUpdate) SetFilesIndexedAt(v time.Time) *ArchiveUpdate {
+	_u.mutation.SetFilesIndexedAt(v)
+	return _u
+}
+
+// SetNillableFilesIndexedAt sets the "files_indexed_at" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableFilesIndexedAt(v *time.Time) *ArchiveUpdate {
+	if v != nil {
+		_u.SetFilesIndexedAt(*v)
+	}
+	return _u
+}
+
+// ClearFilesIndexedAt clears the value of the "files_indexed_at" field.
+func (_u *ArchiveUpdate) ClearFilesIndexedAt() *ArchiveUpdate {
+	_u.mutation.ClearFilesIndexedAt()
+	return _u
+}
+
+// SetNfiles sets the "nfiles" field.
+func (_u *ArchiveUpdate) SetNfiles(v int) *ArchiveUpdate {
+	_u.mutation.ResetNfiles()
+	_u.mutation.SetNfiles(v)
+	return _u
+}
+
+// SetNillableNfiles sets the "nfiles" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableNfiles(v *int) *ArchiveUpdate {
+	if v != nil {
+		_u.SetNfiles(*v)
+	}
+	return _u
+}
+
+// AddNfiles adds value to the "nfiles" field.
+func (_u *ArchiveUpdate) AddNfiles(v int) *ArchiveUpdate {
+	_u.mutation.AddNfiles(v)
+	return _u
+}
+
+// ClearNfiles clears the value of the "nfiles" field.
+func (_u *ArchiveUpdate) ClearNfiles() *ArchiveUpdate {
+	_u.mutation.ClearNfiles()
+	return _u
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (_u *ArchiveUpdate) SetOriginalSize(v int) *ArchiveUpdate {
+	_u.mutation.ResetOriginalSize()
+	_u.mutation.SetOriginalSize(v)
+	return _u
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableOriginalSize(v *int) *ArchiveUpdate {
+	if v != nil {
+		_u.SetOriginalSize(*v)
+	}
+	return _u
+}
+
+// AddOriginalSize adds value to the "original_size" field.
+func (_u *ArchiveUpdate) AddOriginalSize(v int) *ArchiveUpdate {
+	_u.mutation.AddOriginalSize(v)
+	return _u
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (_u *ArchiveUpdate) ClearOriginalSize() *ArchiveUpdate {
+	_u.mutation.ClearOriginalSize()
+	return _u
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_u *ArchiveUpdate) SetCompressedSize(v int) *ArchiveUpdate {
+	_u.mutation.ResetCompressedSize()
+	_u.mutation.SetCompressedSize(v)
+	return _u
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableCompressedSize(v *int) *ArchiveUpdate {
+	if v != nil {
+		_u.SetCompressedSize(*v)
+	}
+	return _u
+}
+
+// AddCompressedSize adds value to the "compressed_size" field.
+func (_u *ArchiveUpdate) AddCompressedSize(v int) *ArchiveUpdate {
+	_u.mutation.AddCompressedSize(v)
+	return _u
+}
+
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (_u *ArchiveUpdate) ClearCompressedSize() *ArchiveUpdate {
+	_u.mutation.ClearCompressedSize()
+	return _u
+}
+
+// SetDeduplicatedSize sets the "deduplicated_size" field.
+func (_u *ArchiveUpdate) SetDeduplicatedSize(v int) *ArchiveUpdate {
+	_u.mutation.ResetDeduplicatedSize()
+	_u.mutation.SetDeduplicatedSize(v)
+	return _u
+}
+
+// SetNillableDeduplicatedSize sets the "deduplicated_size" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableDeduplicatedSize(v *int) *ArchiveUpdate {
+	if v != nil {
+		_u.SetDeduplicatedSize(*v)
+	}
+	return _u
+}
+
+// AddDeduplicatedSize adds value to the "deduplicated_size" field.
+func (_u *ArchiveUpdate) AddDeduplicatedSize(v int) *ArchiveUpdate {
+	_u.mutation.AddDeduplicatedSize(v)
+	return _u
+}
+
+// ClearDeduplicatedSize clears the value of the "deduplicated_size" field.
+func (_u *ArchiveUpdate) ClearDeduplicatedSize() *ArchiveUpdate {
+	_u.mutation.ClearDeduplicatedSize()
+	return _u
+}
+
+// SetStatsFailures sets the "stats_failures" field.
+func (_u *ArchiveUpdate) SetStatsFailures(v int) *ArchiveUpdate {
+	_u.mutation.ResetStatsFailures()
+	_u.mutation.SetStatsFailures(v)
+	return _u
+}
+
+// SetNillableStatsFailures sets the "stats_failures" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableStatsFailures(v *int) *ArchiveUpdate {
+	if v != nil {
+		_u.SetStatsFailures(*v)
+	}
+	return _u
+}
+
+// AddStatsFailures adds value to the "stats_failures" field.
+func (_u *ArchiveUpdate) AddStatsFailures(v int) *ArchiveUpdate {
+	_u.mutation.AddStatsFailures(v)
+	return _u
+}
+
+// SetDataVerifiedAt sets the "data_verified_at" field.
+func (_u *ArchiveUpdate) SetDataVerifiedAt(v time.Time) *ArchiveUpdate {
+	_u.mutation.SetDataVerifiedAt(v)
+	return _u
+}
+
+// SetNillableDataVerifiedAt sets the "data_verified_at" field if the given value is not nil.
+func (_u *ArchiveUpdate) SetNillableDataVerifiedAt(v *time.Time) *ArchiveUpdate {
+	if v != nil {
+		_u.SetDataVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearDataVerifiedAt clears the value of the "data_verified_at" field.
+func (_u *ArchiveUpdate) ClearDataVerifiedAt() *ArchiveUpdate {
+	_u.mutation.ClearDataVerifiedAt()
+	return _u
+}
+
 // SetRepositoryID sets the "repository" edge to the Repository entity by ID.
 func (_u *ArchiveUpdate) SetRepositoryID(id int) *ArchiveUpdate {
 	_u.mutation.SetRepositoryID(id)
@@ -170,6 +340,21 @@ func (_u *ArchiveUpdate) SetBackupProfile(v *BackupProfile) *ArchiveUpdate {
 	return _u.SetBackupProfileID(v.ID)
 }
 
+// AddFileIDs adds the "files" edge to the ArchiveFile entity by IDs.
+func (_u *ArchiveUpdate) AddFileIDs(ids ...int) *ArchiveUpdate {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the ArchiveFile entity.
+func (_u *ArchiveUpdate) AddFiles(v ...*ArchiveFile) *ArchiveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_u *ArchiveUpdate) Mutation() *ArchiveMutation {
 	return _u.mutation
@@ -187,6 +372,27 @@ func (_u *ArchiveUpdate) ClearBackupProfile() *ArchiveUpdate {
 	return _u
 }
 
+// ClearFiles clears all "files" edges to the ArchiveFile entity.
+func (_u *ArchiveUpdate) ClearFiles() *ArchiveUpdate {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to ArchiveFile entities by IDs.
+func (_u *ArchiveUpdate) RemoveFileIDs(ids ...int) *ArchiveUpdate {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to ArchiveFile entities.
+func (_u *ArchiveUpdate) RemoveFiles(v ...*ArchiveFile) *ArchiveUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ArchiveUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -279,6 +485,60 @@ func (_u *ArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.WarningMessageCleared() {
 		_spec.ClearField(archive.FieldWarningMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.FilesIndexedAt(); ok {
+		_spec.SetField(archive.FieldFilesIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FilesIndexedAtCleared() {
+		_spec.ClearField(archive.FieldFilesIndexedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Nfiles(); ok {
+		_spec.SetField(archive.FieldNfiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNfiles(); ok {
+		_spec.AddField(archive.FieldNfiles, field.TypeInt, value)
+	}
+	if _u.mutation.NfilesCleared() {
+		_spec.ClearField(archive.FieldNfiles, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OriginalSize(); ok {
+		_spec.SetField(archive.FieldOriginalSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalSize(); ok {
+		_spec.AddField(archive.FieldOriginalSize, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalSizeCleared() {
+		_spec.ClearField(archive.FieldOriginalSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CompressedSize(); ok {
+		_spec.SetField(archive.FieldCompressedSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompressedSize(); ok {
+		_spec.AddField(archive.FieldCompressedSize, field.TypeInt, value)
+	}
+	if _u.mutation.CompressedSizeCleared() {
+		_spec.ClearField(archive.FieldCompressedSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeduplicatedSize(); ok {
+		_spec.SetField(archive.FieldDeduplicatedSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeduplicatedSize(); ok {
+		_spec.AddField(archive.FieldDeduplicatedSize, field.TypeInt, value)
+	}
+	if _u.mutation.DeduplicatedSizeCleared() {
+		_spec.ClearField(archive.FieldDeduplicatedSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StatsFailures(); ok {
+		_spec.SetField(archive.FieldStatsFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatsFailures(); ok {
+		_spec.AddField(archive.FieldStatsFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DataVerifiedAt(); ok {
+		_spec.SetField(archive.FieldDataVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DataVerifiedAtCleared() {
+		_spec.ClearField(archive.FieldDataVerifiedAt, field.TypeTime)
+	}
 	if _u.mutation.RepositoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -337,6 +597,51 @@ func (_u *ArchiveUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -468,6 +773,175 @@ func (_u *ArchiveUpdateOne) ClearWarningMessage() *ArchiveUpdateOne {
 	return _u
 }
 
+// SetFilesIndexedAt sets the "files_indexed_at" field.
+func (_u *ArchiveUpdateOne) SetFilesIndexedAt(v time.Time) *ArchiveUpdateOne {
+	_u.mutation.SetFilesIndexedAt(v)
+	return _u
+}
+
+// SetNillableFilesIndexedAt sets the "files_indexed_at" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableFilesIndexedAt(v *time.Time) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetFilesIndexedAt(*v)
+	}
+	return _u
+}
+
+// ClearFilesIndexedAt clears the value of the "files_indexed_at" field.
+func (_u *ArchiveUpdateOne) ClearFilesIndexedAt() *ArchiveUpdateOne {
+	_u.mutation.ClearFilesIndexedAt()
+	return _u
+}
+
+// SetNfiles sets the "nfiles" field.
+func (_u *ArchiveUpdateOne) SetNfiles(v int) *ArchiveUpdateOne {
+	_u.mutation.ResetNfiles()
+	_u.mutation.SetNfiles(v)
+	return _u
+}
+
+// SetNillableNfiles sets the "nfiles" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableNfiles(v *int) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetNfiles(*v)
+	}
+	return _u
+}
+
+// AddNfiles adds value to the "nfiles" field.
+func (_u *ArchiveUpdateOne) AddNfiles(v int) *ArchiveUpdateOne {
+	_u.mutation.AddNfiles(v)
+	return _u
+}
+
+// ClearNfiles clears the value of the "nfiles" field.
+func (_u *ArchiveUpdateOne) ClearNfiles() *ArchiveUpdateOne {
+	_u.mutation.ClearNfiles()
+	return _u
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (_u *ArchiveUpdateOne) SetOriginalSize(v int) *ArchiveUpdateOne {
+	_u.mutation.ResetOriginalSize()
+	_u.mutation.SetOriginalSize(v)
+	return _u
+}
+
+// SetNillableOriginalSize sets the "original_size" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableOriginalSize(v *int) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetOriginalSize(*v)
+	}
+	return _u
+}
+
+// AddOriginalSize adds value to the "original_size" field.
+func (_u *ArchiveUpdateOne) AddOriginalSize(v int) *ArchiveUpdateOne {
+	_u.mutation.AddOriginalSize(v)
+	return _u
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (_u *ArchiveUpdateOne) ClearOriginalSize() *ArchiveUpdateOne {
+	_u.mutation.ClearOriginalSize()
+	return _u
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (_u *ArchiveUpdateOne) SetCompressedSize(v int) *ArchiveUpdateOne {
+	_u.mutation.ResetCompressedSize()
+	_u.mutation.SetCompressedSize(v)
+	return _u
+}
+
+// SetNillableCompressedSize sets the "compressed_size" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableCompressedSize(v *int) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetCompressedSize(*v)
+	}
+	return _u
+}
+
+// AddCompressedSize adds value to the "compressed_size" field.
+func (_u *ArchiveUpdateOne) AddCompressedSize(v int) *ArchiveUpdateOne {
+	_u.mutation.AddCompressedSize(v)
+	return _u
+}
+
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (_u *ArchiveUpdateOne) ClearCompressedSize() *ArchiveUpdateOne {
+	_u.mutation.ClearCompressedSize()
+	return _u
+}
+
+// SetDeduplicatedSize sets the "deduplicated_size" field.
+func (_u *ArchiveUpdateOne) SetDeduplicatedSize(v int) *ArchiveUpdateOne {
+	_u.mutation.ResetDeduplicatedSize()
+	_u.mutation.SetDeduplicatedSize(v)
+	return _u
+}
+
+// SetNillableDeduplicatedSize sets the "deduplicated_size" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableDeduplicatedSize(v *int) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetDeduplicatedSize(*v)
+	}
+	return _u
+}
+
+// AddDeduplicatedSize adds value to the "deduplicated_size" field.
+func (_u *ArchiveUpdateOne) AddDeduplicatedSize(v int) *ArchiveUpdateOne {
+	_u.mutation.AddDeduplicatedSize(v)
+	return _u
+}
+
+// ClearDeduplicatedSize clears the value of the "deduplicated_size" field.
+func (_u *ArchiveUpdateOne) ClearDeduplicatedSize() *ArchiveUpdateOne {
+	_u.mutation.ClearDeduplicatedSize()
+	return _u
+}
+
+// SetStatsFailures sets the "stats_failures" field.
+func (_u *ArchiveUpdateOne) SetStatsFailures(v int) *ArchiveUpdateOne {
+	_u.mutation.ResetStatsFailures()
+	_u.mutation.SetStatsFailures(v)
+	return _u
+}
+
+// SetNillableStatsFailures sets the "stats_failures" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableStatsFailures(v *int) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetStatsFailures(*v)
+	}
+	return _u
+}
+
+// AddStatsFailures adds value to the "stats_failures" field.
+func (_u *ArchiveUpdateOne) AddStatsFailures(v int) *ArchiveUpdateOne {
+	_u.mutation.AddStatsFailures(v)
+	return _u
+}
+
+// SetDataVerifiedAt sets the "data_verified_at" field.
+func (_u *ArchiveUpdateOne) SetDataVerifiedAt(v time.Time) *ArchiveUpdateOne {
+	_u.mutation.SetDataVerifiedAt(v)
+	return _u
+}
+
+// SetNillableDataVerifiedAt sets the "data_verified_at" field if the given value is not nil.
+func (_u *ArchiveUpdateOne) SetNillableDataVerifiedAt(v *time.Time) *ArchiveUpdateOne {
+	if v != nil {
+		_u.SetDataVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearDataVerifiedAt clears the value of the "data_verified_at" field.
+func (_u *ArchiveUpdateOne) ClearDataVerifiedAt() *ArchiveUpdateOne {
+	_u.mutation.ClearDataVerifiedAt()
+	return _u
+}
+
 // SetRepositoryID sets the "repository" edge to the Repository entity by ID.
 func (_u *ArchiveUpdateOne) SetRepositoryID(id int) *ArchiveUpdateOne {
 	_u.mutation.SetRepositoryID(id)
@@ -498,6 +972,21 @@ func (_u *ArchiveUpdateOne) SetBackupProfile(v *BackupProfile) *ArchiveUpdateOne
 	return _u.SetBackupProfileID(v.ID)
 }
 
+// AddFileIDs adds the "files" edge to the ArchiveFile entity by IDs.
+func (_u *ArchiveUpdateOne) AddFileIDs(ids ...int) *ArchiveUpdateOne {
+	_u.mutation.AddFileIDs(ids...)
+	return _u
+}
+
+// AddFiles adds the "files" edges to the ArchiveFile entity.
+func (_u *ArchiveUpdateOne) AddFiles(v ...*ArchiveFile) *ArchiveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFileIDs(ids...)
+}
+
 // Mutation returns the ArchiveMutation object of the builder.
 func (_u *ArchiveUpdateOne) Mutation() *ArchiveMutation {
 	return _u.mutation
@@ -515,6 +1004,27 @@ func (_u *ArchiveUpdateOne) ClearBackupProfile() *ArchiveUpdateOne {
 	return _u
 }
 
+// ClearFiles clears all "files" edges to the ArchiveFile entity.
+func (_u *ArchiveUpdateOne) ClearFiles() *ArchiveUpdateOne {
+	_u.mutation.ClearFiles()
+	return _u
+}
+
+// RemoveFileIDs removes the "files" edge to ArchiveFile entities by IDs.
+func (_u *ArchiveUpdateOne) RemoveFileIDs(ids ...int) *ArchiveUpdateOne {
+	_u.mutation.RemoveFileIDs(ids...)
+	return _u
+}
+
+// RemoveFiles removes "files" edges to ArchiveFile entities.
+func (_u *ArchiveUpdateOne) RemoveFiles(v ...*ArchiveFile) *ArchiveUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFileIDs(ids...)
+}
+
 // Where appends a list predicates to the ArchiveUpdate builder.
 func (_u *ArchiveUpdateOne) Where(ps ...predicate.Archive) *ArchiveUpdateOne {
 	_u.mutation.Where(ps...)
@@ -637,6 +1147,60 @@ func (_u *ArchiveUpdateOne) sqlSave(ctx context.Context) (_node *Archive, err er
 	if _u.mutation.WarningMessageCleared() {
 		_spec.ClearField(archive.FieldWarningMessage, field.TypeString)
 	}
+	if value, ok := _u.mutation.FilesIndexedAt(); ok {
+		_spec.SetField(archive.FieldFilesIndexedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FilesIndexedAtCleared() {
+		_spec.ClearField(archive.FieldFilesIndexedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Nfiles(); ok {
+		_spec.SetField(archive.FieldNfiles, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNfiles(); ok {
+		_spec.AddField(archive.FieldNfiles, field.TypeInt, value)
+	}
+	if _u.mutation.NfilesCleared() {
+		_spec.ClearField(archive.FieldNfiles, field.TypeInt)
+	}
+	if value, ok := _u.mutation.OriginalSize(); ok {
+		_spec.SetField(archive.FieldOriginalSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOriginalSize(); ok {
+		_spec.AddField(archive.FieldOriginalSize, field.TypeInt, value)
+	}
+	if _u.mutation.OriginalSizeCleared() {
+		_spec.ClearField(archive.FieldOriginalSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.CompressedSize(); ok {
+		_spec.SetField(archive.FieldCompressedSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCompressedSize(); ok {
+		_spec.AddField(archive.FieldCompressedSize, field.TypeInt, value)
+	}
+	if _u.mutation.CompressedSizeCleared() {
+		_spec.ClearField(archive.FieldCompressedSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.DeduplicatedSize(); ok {
+		_spec.SetField(archive.FieldDeduplicatedSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedDeduplicatedSize(); ok {
+		_spec.AddField(archive.FieldDeduplicatedSize, field.TypeInt, value)
+	}
+	if _u.mutation.DeduplicatedSizeCleared() {
+		_spec.ClearField(archive.FieldDeduplicatedSize, field.TypeInt)
+	}
+	if value, ok := _u.mutation.StatsFailures(); ok {
+		_spec.SetField(archive.FieldStatsFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStatsFailures(); ok {
+		_spec.AddField(archive.FieldStatsFailures, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.DataVerifiedAt(); ok {
+		_spec.SetField(archive.FieldDataVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DataVerifiedAtCleared() {
+		_spec.ClearField(archive.FieldDataVerifiedAt, field.TypeTime)
+	}
 	if _u.mutation.RepositoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -695,6 +1259,51 @@ func (_u *ArchiveUpdateOne) sqlSave(ctx context.Context) (_node *Archive, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFilesIDs(); len(nodes) > 0 && !_u.mutation.FilesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   archive.FilesTable,
+			Columns: []string{archive.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Archive{config: _u.config}
 	_spec.Assign = _node.assignValues
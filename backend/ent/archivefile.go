@@ -0,0 +1,167 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+)
+
+// ArchiveFile is the model entity for the ArchiveFile schema.
+type ArchiveFile struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id"`
+	// Path inside the archive (without leading slash)
+	Path string `json:"path"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size"`
+	// Mtime holds the value of the "mtime" field.
+	Mtime time.Time `json:"mtime"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArchiveFileQuery when eager-loading is set.
+	Edges                ArchiveFileEdges `json:"edges"`
+	archive_file_archive *int
+	selectValues         sql.SelectValues
+}
+
+// ArchiveFileEdges holds the relations/edges for other nodes in the graph.
+type ArchiveFileEdges struct {
+	// Archive holds the value of the archive edge.
+	Archive *Archive `json:"archive,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArchiveOrErr returns the Archive value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArchiveFileEdges) ArchiveOrErr() (*Archive, error) {
+	if e.Archive != nil {
+		return e.Archive, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: archive.Label}
+	}
+	return nil, &NotLoadedError{edge: "archive"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArchiveFile) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case archivefile.FieldID, archivefile.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case archivefile.FieldPath:
+			values[i] = new(sql.NullString)
+		case archivefile.FieldMtime:
+			values[i] = new(sql.NullTime)
+		case archivefile.ForeignKeys[0]: // archive_file_archive
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArchiveFile fields.
+func (_m *ArchiveFile) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case archivefile.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case archivefile.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				_m.Path = value.String
+			}
+		case archivefile.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				_m.Size = value.Int64
+			}
+		case archivefile.FieldMtime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field mtime", values[i])
+			} else if value.Valid {
+				_m.Mtime = value.Time
+			}
+		case archivefile.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field archive_file_archive", value)
+			} else if value.Valid {
+				_m.archive_file_archive = new(int)
+				*_m.archive_file_archive = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArchiveFile.
+// This includes values selected through modifiers, order, etc.
+func (_m *ArchiveFile) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryArchive queries the "archive" edge of the ArchiveFile entity.
+func (_m *ArchiveFile) QueryArchive() *ArchiveQuery {
+	return NewArchiveFileClient(_m.config).QueryArchive(_m)
+}
+
+// Update returns a builder for updating this ArchiveFile.
+// Note that you need to call ArchiveFile.Unwrap() before calling this method if this ArchiveFile
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ArchiveFile) Update() *ArchiveFileUpdateOne {
+	return NewArchiveFileClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ArchiveFile entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ArchiveFile) Unwrap() *ArchiveFile {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArchiveFile is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ArchiveFile) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArchiveFile(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("path=")
+	builder.WriteString(_m.Path)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Size))
+	builder.WriteString(", ")
+	builder.WriteString("mtime=")
+	builder.WriteString(_m.Mtime.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArchiveFiles is a parsable slice of ArchiveFile.
+type ArchiveFiles []*ArchiveFile
@@ -0,0 +1,98 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivefile
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the archivefile type in the database.
+	Label = "archive_file"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPath holds the string denoting the path field in the database.
+	FieldPath = "path"
+	// FieldSize holds the string denoting the size field in the database.
+	FieldSize = "size"
+	// FieldMtime holds the string denoting the mtime field in the database.
+	FieldMtime = "mtime"
+	// EdgeArchive holds the string denoting the archive edge name in mutations.
+	EdgeArchive = "archive"
+	// Table holds the table name of the archivefile in the database.
+	Table = "archive_files"
+	// ArchiveTable is the table that holds the archive relation/edge.
+	ArchiveTable = "archive_files"
+	// ArchiveInverseTable is the table name for the Archive entity.
+	// It exists in this package in order to avoid circular dependency with the "archive" package.
+	ArchiveInverseTable = "archives"
+	// ArchiveColumn is the table column denoting the archive relation/edge.
+	ArchiveColumn = "archive_file_archive"
+)
+
+// Columns holds all SQL columns for archivefile fields.
+var Columns = []string{
+	FieldID,
+	FieldPath,
+	FieldSize,
+	FieldMtime,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "archive_files"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"archive_file_archive",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// OrderOption defines the ordering options for the ArchiveFile queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPath orders the results by the path field.
+func ByPath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPath, opts...).ToFunc()
+}
+
+// BySize orders the results by the size field.
+func BySize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSize, opts...).ToFunc()
+}
+
+// ByMtime orders the results by the mtime field.
+func ByMtime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMtime, opts...).ToFunc()
+}
+
+// ByArchiveField orders the results by archive field.
+func ByArchiveField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newArchiveStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newArchiveStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ArchiveInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ArchiveTable, ArchiveColumn),
+	)
+}
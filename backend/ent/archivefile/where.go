@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package archivefile
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLTE(FieldID, id))
+}
+
+// Path applies equality check predicate on the "path" field. It's identical to PathEQ.
+func Path(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldPath, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldSize, v))
+}
+
+// Mtime applies equality check predicate on the "mtime" field. It's identical to MtimeEQ.
+func Mtime(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldMtime, v))
+}
+
+// PathEQ applies the EQ predicate on the "path" field.
+func PathEQ(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldPath, v))
+}
+
+// PathNEQ applies the NEQ predicate on the "path" field.
+func PathNEQ(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNEQ(FieldPath, v))
+}
+
+// PathIn applies the In predicate on the "path" field.
+func PathIn(vs ...string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldIn(FieldPath, vs...))
+}
+
+// PathNotIn applies the NotIn predicate on the "path" field.
+func PathNotIn(vs ...string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNotIn(FieldPath, vs...))
+}
+
+// PathGT applies the GT predicate on the "path" field.
+func PathGT(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGT(FieldPath, v))
+}
+
+// PathGTE applies the GTE predicate on the "path" field.
+func PathGTE(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGTE(FieldPath, v))
+}
+
+// PathLT applies the LT predicate on the "path" field.
+func PathLT(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLT(FieldPath, v))
+}
+
+// PathLTE applies the LTE predicate on the "path" field.
+func PathLTE(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLTE(FieldPath, v))
+}
+
+// PathContains applies the Contains predicate on the "path" field.
+func PathContains(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldContains(FieldPath, v))
+}
+
+// PathHasPrefix applies the HasPrefix predicate on the "path" field.
+func PathHasPrefix(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldHasPrefix(FieldPath, v))
+}
+
+// PathHasSuffix applies the HasSuffix predicate on the "path" field.
+func PathHasSuffix(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldHasSuffix(FieldPath, v))
+}
+
+// PathEqualFold applies the EqualFold predicate on the "path" field.
+func PathEqualFold(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEqualFold(FieldPath, v))
+}
+
+// PathContainsFold applies the ContainsFold predicate on the "path" field.
+func PathContainsFold(v string) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldContainsFold(FieldPath, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLTE(FieldSize, v))
+}
+
+// MtimeEQ applies the EQ predicate on the "mtime" field.
+func MtimeEQ(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldEQ(FieldMtime, v))
+}
+
+// MtimeNEQ applies the NEQ predicate on the "mtime" field.
+func MtimeNEQ(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNEQ(FieldMtime, v))
+}
+
+// MtimeIn applies the In predicate on the "mtime" field.
+func MtimeIn(vs ...time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldIn(FieldMtime, vs...))
+}
+
+// MtimeNotIn applies the NotIn predicate on the "mtime" field.
+func MtimeNotIn(vs ...time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldNotIn(FieldMtime, vs...))
+}
+
+// MtimeGT applies the GT predicate on the "mtime" field.
+func MtimeGT(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGT(FieldMtime, v))
+}
+
+// MtimeGTE applies the GTE predicate on the "mtime" field.
+func MtimeGTE(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldGTE(FieldMtime, v))
+}
+
+// MtimeLT applies the LT predicate on the "mtime" field.
+func MtimeLT(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLT(FieldMtime, v))
+}
+
+// MtimeLTE applies the LTE predicate on the "mtime" field.
+func MtimeLTE(v time.Time) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.FieldLTE(FieldMtime, v))
+}
+
+// HasArchive applies the HasEdge predicate on the "archive" edge.
+func HasArchive() predicate.ArchiveFile {
+	return predicate.ArchiveFile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ArchiveTable, ArchiveColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArchiveWith applies the HasEdge predicate on the "archive" edge with a given conditions (other predicates).
+func HasArchiveWith(preds ...predicate.Archive) predicate.ArchiveFile {
+	return predicate.ArchiveFile(func(s *sql.Selector) {
+		step := newArchiveStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArchiveFile) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArchiveFile) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArchiveFile) predicate.ArchiveFile {
+	return predicate.ArchiveFile(sql.NotPredicates(p))
+}
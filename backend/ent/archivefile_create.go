@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+)
+
+// ArchiveFileCreate is the builder for creating a ArchiveFile entity.
+type ArchiveFileCreate struct {
+	config
+	mutation *ArchiveFileMutation
+	hooks    []Hook
+}
+
+// SetPath sets the "path" field.
+func (_c *ArchiveFileCreate) SetPath(v string) *ArchiveFileCreate {
+	_c.mutation.SetPath(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *ArchiveFileCreate) SetSize(v int64) *ArchiveFileCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetMtime sets the "mtime" field.
+func (_c *ArchiveFileCreate) SetMtime(v time.Time) *ArchiveFileCreate {
+	_c.mutation.SetMtime(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ArchiveFileCreate) SetID(v int) *ArchiveFileCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetArchiveID sets the "archive" edge to the Archive entity by ID.
+func (_c *ArchiveFileCreate) SetArchiveID(id int) *ArchiveFileCreate {
+	_c.mutation.SetArchiveID(id)
+	return _c
+}
+
+// SetArchive sets the "archive" edge to the Archive entity.
+func (_c *ArchiveFileCreate) SetArchive(v *Archive) *ArchiveFileCreate {
+	return _c.SetArchiveID(v.ID)
+}
+
+// Mutation returns the ArchiveFileMutation object of the builder.
+func (_c *ArchiveFileCreate) Mutation() *ArchiveFileMutation {
+	return _c.mutation
+}
+
+// Save creates the ArchiveFile in the database.
+func (_c *ArchiveFileCreate) Save(ctx context.Context) (*ArchiveFile, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ArchiveFileCreate) SaveX(ctx context.Context) *ArchiveFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveFileCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveFileCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ArchiveFileCreate) check() error {
+	if _, ok := _c.mutation.Path(); !ok {
+		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "ArchiveFile.path"`)}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "ArchiveFile.size"`)}
+	}
+	if _, ok := _c.mutation.Mtime(); !ok {
+		return &ValidationError{Name: "mtime", err: errors.New(`ent: missing required field "ArchiveFile.mtime"`)}
+	}
+	if len(_c.mutation.ArchiveIDs()) == 0 {
+		return &ValidationError{Name: "archive", err: errors.New(`ent: missing required edge "ArchiveFile.archive"`)}
+	}
+	return nil
+}
+
+func (_c *ArchiveFileCreate) sqlSave(ctx context.Context) (*ArchiveFile, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ArchiveFileCreate) createSpec() (*ArchiveFile, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArchiveFile{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(archivefile.Table, sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Path(); ok {
+		_spec.SetField(archivefile.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(archivefile.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Mtime(); ok {
+		_spec.SetField(archivefile.FieldMtime, field.TypeTime, value)
+		_node.Mtime = value
+	}
+	if nodes := _c.mutation.ArchiveIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   archivefile.ArchiveTable,
+			Columns: []string{archivefile.ArchiveColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(archive.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.archive_file_archive = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArchiveFileCreateBulk is the builder for creating many ArchiveFile entities in bulk.
+type ArchiveFileCreateBulk struct {
+	config
+	err      error
+	builders []*ArchiveFileCreate
+}
+
+// Save creates the ArchiveFile entities in the database.
+func (_c *ArchiveFileCreateBulk) Save(ctx context.Context) ([]*ArchiveFile, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ArchiveFile, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArchiveFileMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ArchiveFileCreateBulk) SaveX(ctx context.Context) []*ArchiveFile {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ArchiveFileCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ArchiveFileCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
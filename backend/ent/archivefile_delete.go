@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// ArchiveFileDelete is the builder for deleting a ArchiveFile entity.
+type ArchiveFileDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArchiveFileMutation
+}
+
+// Where appends a list predicates to the ArchiveFileDelete builder.
+func (_d *ArchiveFileDelete) Where(ps ...predicate.ArchiveFile) *ArchiveFileDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *ArchiveFileDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ArchiveFileDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *ArchiveFileDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(archivefile.Table, sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// ArchiveFileDeleteOne is the builder for deleting a single ArchiveFile entity.
+type ArchiveFileDeleteOne struct {
+	_d *ArchiveFileDelete
+}
+
+// Where appends a list predicates to the ArchiveFileDelete builder.
+func (_d *ArchiveFileDeleteOne) Where(ps ...predicate.ArchiveFile) *ArchiveFileDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *ArchiveFileDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{archivefile.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *ArchiveFileDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
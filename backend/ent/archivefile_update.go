@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// ArchiveFileUpdate is the builder for updating ArchiveFile entities.
+type ArchiveFileUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ArchiveFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ArchiveFileUpdate builder.
+func (_u *ArchiveFileUpdate) Where(ps ...predicate.ArchiveFile) *ArchiveFileUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ArchiveFileMutation object of the builder.
+func (_u *ArchiveFileUpdate) Mutation() *ArchiveFileMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ArchiveFileUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveFileUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ArchiveFileUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveFileUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchiveFileUpdate) check() error {
+	if _u.mutation.ArchiveCleared() && len(_u.mutation.ArchiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArchiveFile.archive"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArchiveFileUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArchiveFileUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArchiveFileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivefile.Table, archivefile.Columns, sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ArchiveFileUpdateOne is the builder for updating a single ArchiveFile entity.
+type ArchiveFileUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ArchiveFileMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the ArchiveFileMutation object of the builder.
+func (_u *ArchiveFileUpdateOne) Mutation() *ArchiveFileMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ArchiveFileUpdate builder.
+func (_u *ArchiveFileUpdateOne) Where(ps ...predicate.ArchiveFile) *ArchiveFileUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ArchiveFileUpdateOne) Select(field string, fields ...string) *ArchiveFileUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ArchiveFile entity.
+func (_u *ArchiveFileUpdateOne) Save(ctx context.Context) (*ArchiveFile, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ArchiveFileUpdateOne) SaveX(ctx context.Context) *ArchiveFile {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ArchiveFileUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ArchiveFileUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ArchiveFileUpdateOne) check() error {
+	if _u.mutation.ArchiveCleared() && len(_u.mutation.ArchiveIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ArchiveFile.archive"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ArchiveFileUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ArchiveFileUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ArchiveFileUpdateOne) sqlSave(ctx context.Context) (_node *ArchiveFile, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(archivefile.Table, archivefile.Columns, sqlgraph.NewFieldSpec(archivefile.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArchiveFile.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, archivefile.FieldID)
+		for _, f := range fields {
+			if !archivefile.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != archivefile.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ArchiveFile{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{archivefile.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
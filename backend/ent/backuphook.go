@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+)
+
+// BackupHook is the model entity for the BackupHook schema.
+type BackupHook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Type holds the value of the "type" field.
+	Type backuphook.Type `json:"type"`
+	// Backup result for which a post-backup hook is run (ignored for pre-backup hooks)
+	RunOn backuphook.RunOn `json:"runOn"`
+	// Shell command executed with sh -c
+	Command string `json:"command"`
+	// Hooks of the same type are executed in ascending order
+	Position int `json:"position"`
+	// TimeoutSeconds holds the value of the "timeout_seconds" field.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+	// Abort the backup if this pre-backup hook fails
+	AbortOnFailure bool `json:"abortOnFailure"`
+	// LastRunAt holds the value of the "last_run_at" field.
+	LastRunAt *time.Time `json:"lastRunAt"`
+	// LastExitCode holds the value of the "last_exit_code" field.
+	LastExitCode *int `json:"lastExitCode"`
+	// Combined stdout and stderr of the last run (truncated)
+	LastOutput *string `json:"lastOutput"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BackupHookQuery when eager-loading is set.
+	Edges                BackupHookEdges `json:"edges"`
+	backup_profile_hooks *int
+	selectValues         sql.SelectValues
+}
+
+// BackupHookEdges holds the relations/edges for other nodes in the graph.
+type BackupHookEdges struct {
+	// BackupProfile holds the value of the backup_profile edge.
+	BackupProfile *BackupProfile `json:"backupProfile,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BackupProfileOrErr returns the BackupProfile value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BackupHookEdges) BackupProfileOrErr() (*BackupProfile, error) {
+	if e.BackupProfile != nil {
+		return e.BackupProfile, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: backupprofile.Label}
+	}
+	return nil, &NotLoadedError{edge: "backup_profile"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BackupHook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backuphook.FieldAbortOnFailure:
+			values[i] = new(sql.NullBool)
+		case backuphook.FieldID, backuphook.FieldPosition, backuphook.FieldTimeoutSeconds, backuphook.FieldLastExitCode:
+			values[i] = new(sql.NullInt64)
+		case backuphook.FieldType, backuphook.FieldRunOn, backuphook.FieldCommand, backuphook.FieldLastOutput:
+			values[i] = new(sql.NullString)
+		case backuphook.FieldCreatedAt, backuphook.FieldUpdatedAt, backuphook.FieldLastRunAt:
+			values[i] = new(sql.NullTime)
+		case backuphook.ForeignKeys[0]: // backup_profile_hooks
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BackupHook fields.
+func (_m *BackupHook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backuphook.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case backuphook.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case backuphook.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case backuphook.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = backuphook.Type(value.String)
+			}
+		case backuphook.FieldRunOn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field run_on", values[i])
+			} else if value.Valid {
+				_m.RunOn = backuphook.RunOn(value.String)
+			}
+		case backuphook.FieldCommand:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field command", values[i])
+			} else if value.Valid {
+				_m.Command = value.String
+			}
+		case backuphook.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case backuphook.FieldTimeoutSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field timeout_seconds", values[i])
+			} else if value.Valid {
+				_m.TimeoutSeconds = int(value.Int64)
+			}
+		case backuphook.FieldAbortOnFailure:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field abort_on_failure", values[i])
+			} else if value.Valid {
+				_m.AbortOnFailure = value.Bool
+			}
+		case backuphook.FieldLastRunAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_run_at", values[i])
+			} else if value.Valid {
+				_m.LastRunAt = new(time.Time)
+				*_m.LastRunAt = value.Time
+			}
+		case backuphook.FieldLastExitCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_exit_code", values[i])
+			} else if value.Valid {
+				_m.LastExitCode = new(int)
+				*_m.LastExitCode = int(value.Int64)
+			}
+		case backuphook.FieldLastOutput:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_output", values[i])
+			} else if value.Valid {
+				_m.LastOutput = new(string)
+				*_m.LastOutput = value.String
+			}
+		case backuphook.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field backup_profile_hooks", value)
+			} else if value.Valid {
+				_m.backup_profile_hooks = new(int)
+				*_m.backup_profile_hooks = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BackupHook.
+// This includes values selected through modifiers, order, etc.
+func (_m *BackupHook) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBackupProfile queries the "backup_profile" edge of the BackupHook entity.
+func (_m *BackupHook) QueryBackupProfile() *BackupProfileQuery {
+	return NewBackupHookClient(_m.config).QueryBackupProfile(_m)
+}
+
+// Update returns a builder for updating this BackupHook.
+// Note that you need to call BackupHook.Unwrap() before calling this method if this BackupHook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BackupHook) Update() *BackupHookUpdateOne {
+	return NewBackupHookClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BackupHook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BackupHook) Unwrap() *BackupHook {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BackupHook is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BackupHook) String() string {
+	var builder strings.Builder
+	builder.WriteString("BackupHook(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("run_on=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunOn))
+	builder.WriteString(", ")
+	builder.WriteString("command=")
+	builder.WriteString(_m.Command)
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("timeout_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeoutSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("abort_on_failure=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AbortOnFailure))
+	builder.WriteString(", ")
+	if v := _m.LastRunAt; v != nil {
+		builder.WriteString("last_run_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastExitCode; v != nil {
+		builder.WriteString("last_exit_code=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastOutput; v != nil {
+		builder.WriteString("last_output=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BackupHooks is a parsable slice of BackupHook.
+type BackupHooks []*BackupHook
@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuphook
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the backuphook type in the database.
+	Label = "backup_hook"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldRunOn holds the string denoting the run_on field in the database.
+	FieldRunOn = "run_on"
+	// FieldCommand holds the string denoting the command field in the database.
+	FieldCommand = "command"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldTimeoutSeconds holds the string denoting the timeout_seconds field in the database.
+	FieldTimeoutSeconds = "timeout_seconds"
+	// FieldAbortOnFailure holds the string denoting the abort_on_failure field in the database.
+	FieldAbortOnFailure = "abort_on_failure"
+	// FieldLastRunAt holds the string denoting the last_run_at field in the database.
+	FieldLastRunAt = "last_run_at"
+	// FieldLastExitCode holds the string denoting the last_exit_code field in the database.
+	FieldLastExitCode = "last_exit_code"
+	// FieldLastOutput holds the string denoting the last_output field in the database.
+	FieldLastOutput = "last_output"
+	// EdgeBackupProfile holds the string denoting the backup_profile edge name in mutations.
+	EdgeBackupProfile = "backup_profile"
+	// Table holds the table name of the backuphook in the database.
+	Table = "backup_hooks"
+	// BackupProfileTable is the table that holds the backup_profile relation/edge.
+	BackupProfileTable = "backup_hooks"
+	// BackupProfileInverseTable is the table name for the BackupProfile entity.
+	// It exists in this package in order to avoid circular dependency with the "backupprofile" package.
+	BackupProfileInverseTable = "backup_profiles"
+	// BackupProfileColumn is the table column denoting the backup_profile relation/edge.
+	BackupProfileColumn = "backup_profile_hooks"
+)
+
+// Columns holds all SQL columns for backuphook fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldType,
+	FieldRunOn,
+	FieldCommand,
+	FieldPosition,
+	FieldTimeoutSeconds,
+	FieldAbortOnFailure,
+	FieldLastRunAt,
+	FieldLastExitCode,
+	FieldLastOutput,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "backup_hooks"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"backup_profile_hooks",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// CommandValidator is a validator for the "command" field. It is called by the builders before save.
+	CommandValidator func(string) error
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultTimeoutSeconds holds the default value on creation for the "timeout_seconds" field.
+	DefaultTimeoutSeconds int
+	// TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	TimeoutSecondsValidator func(int) error
+	// DefaultAbortOnFailure holds the default value on creation for the "abort_on_failure" field.
+	DefaultAbortOnFailure bool
+)
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypePreBackup  Type = "pre_backup"
+	TypePostBackup Type = "post_backup"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypePreBackup, TypePostBackup:
+		return nil
+	default:
+		return fmt.Errorf("backuphook: invalid enum value for type field: %q", _type)
+	}
+}
+
+// RunOn defines the type for the "run_on" enum field.
+type RunOn string
+
+// RunOnAlways is the default value of the RunOn enum.
+const DefaultRunOn = RunOnAlways
+
+// RunOn values.
+const (
+	RunOnSuccess RunOn = "success"
+	RunOnFailure RunOn = "failure"
+	RunOnAlways  RunOn = "always"
+)
+
+func (ro RunOn) String() string {
+	return string(ro)
+}
+
+// RunOnValidator is a validator for the "run_on" field enum values. It is called by the builders before save.
+func RunOnValidator(ro RunOn) error {
+	switch ro {
+	case RunOnSuccess, RunOnFailure, RunOnAlways:
+		return nil
+	default:
+		return fmt.Errorf("backuphook: invalid enum value for run_on field: %q", ro)
+	}
+}
+
+// OrderOption defines the ordering options for the BackupHook queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByRunOn orders the results by the run_on field.
+func ByRunOn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunOn, opts...).ToFunc()
+}
+
+// ByCommand orders the results by the command field.
+func ByCommand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommand, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByTimeoutSeconds orders the results by the timeout_seconds field.
+func ByTimeoutSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeoutSeconds, opts...).ToFunc()
+}
+
+// ByAbortOnFailure orders the results by the abort_on_failure field.
+func ByAbortOnFailure(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAbortOnFailure, opts...).ToFunc()
+}
+
+// ByLastRunAt orders the results by the last_run_at field.
+func ByLastRunAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRunAt, opts...).ToFunc()
+}
+
+// ByLastExitCode orders the results by the last_exit_code field.
+func ByLastExitCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastExitCode, opts...).ToFunc()
+}
+
+// ByLastOutput orders the results by the last_output field.
+func ByLastOutput(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastOutput, opts...).ToFunc()
+}
+
+// ByBackupProfileField orders the results by backup_profile field.
+func ByBackupProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBackupProfileStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBackupProfileStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BackupProfileInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BackupProfileTable, BackupProfileColumn),
+	)
+}
@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backuphook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Command applies equality check predicate on the "command" field. It's identical to CommandEQ.
+func Command(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldCommand, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldPosition, v))
+}
+
+// TimeoutSeconds applies equality check predicate on the "timeout_seconds" field. It's identical to TimeoutSecondsEQ.
+func TimeoutSeconds(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// AbortOnFailure applies equality check predicate on the "abort_on_failure" field. It's identical to AbortOnFailureEQ.
+func AbortOnFailure(v bool) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldAbortOnFailure, v))
+}
+
+// LastRunAt applies equality check predicate on the "last_run_at" field. It's identical to LastRunAtEQ.
+func LastRunAt(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastExitCode applies equality check predicate on the "last_exit_code" field. It's identical to LastExitCodeEQ.
+func LastExitCode(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastExitCode, v))
+}
+
+// LastOutput applies equality check predicate on the "last_output" field. It's identical to LastOutputEQ.
+func LastOutput(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastOutput, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldType, vs...))
+}
+
+// RunOnEQ applies the EQ predicate on the "run_on" field.
+func RunOnEQ(v RunOn) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldRunOn, v))
+}
+
+// RunOnNEQ applies the NEQ predicate on the "run_on" field.
+func RunOnNEQ(v RunOn) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldRunOn, v))
+}
+
+// RunOnIn applies the In predicate on the "run_on" field.
+func RunOnIn(vs ...RunOn) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldRunOn, vs...))
+}
+
+// RunOnNotIn applies the NotIn predicate on the "run_on" field.
+func RunOnNotIn(vs ...RunOn) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldRunOn, vs...))
+}
+
+// CommandEQ applies the EQ predicate on the "command" field.
+func CommandEQ(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldCommand, v))
+}
+
+// CommandNEQ applies the NEQ predicate on the "command" field.
+func CommandNEQ(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldCommand, v))
+}
+
+// CommandIn applies the In predicate on the "command" field.
+func CommandIn(vs ...string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldCommand, vs...))
+}
+
+// CommandNotIn applies the NotIn predicate on the "command" field.
+func CommandNotIn(vs ...string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldCommand, vs...))
+}
+
+// CommandGT applies the GT predicate on the "command" field.
+func CommandGT(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldCommand, v))
+}
+
+// CommandGTE applies the GTE predicate on the "command" field.
+func CommandGTE(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldCommand, v))
+}
+
+// CommandLT applies the LT predicate on the "command" field.
+func CommandLT(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldCommand, v))
+}
+
+// CommandLTE applies the LTE predicate on the "command" field.
+func CommandLTE(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldCommand, v))
+}
+
+// CommandContains applies the Contains predicate on the "command" field.
+func CommandContains(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldContains(FieldCommand, v))
+}
+
+// CommandHasPrefix applies the HasPrefix predicate on the "command" field.
+func CommandHasPrefix(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldHasPrefix(FieldCommand, v))
+}
+
+// CommandHasSuffix applies the HasSuffix predicate on the "command" field.
+func CommandHasSuffix(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldHasSuffix(FieldCommand, v))
+}
+
+// CommandEqualFold applies the EqualFold predicate on the "command" field.
+func CommandEqualFold(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEqualFold(FieldCommand, v))
+}
+
+// CommandContainsFold applies the ContainsFold predicate on the "command" field.
+func CommandContainsFold(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldContainsFold(FieldCommand, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldPosition, v))
+}
+
+// TimeoutSecondsEQ applies the EQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsNEQ applies the NEQ predicate on the "timeout_seconds" field.
+func TimeoutSecondsNEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsIn applies the In predicate on the "timeout_seconds" field.
+func TimeoutSecondsIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsNotIn applies the NotIn predicate on the "timeout_seconds" field.
+func TimeoutSecondsNotIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldTimeoutSeconds, vs...))
+}
+
+// TimeoutSecondsGT applies the GT predicate on the "timeout_seconds" field.
+func TimeoutSecondsGT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsGTE applies the GTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsGTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLT applies the LT predicate on the "timeout_seconds" field.
+func TimeoutSecondsLT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldTimeoutSeconds, v))
+}
+
+// TimeoutSecondsLTE applies the LTE predicate on the "timeout_seconds" field.
+func TimeoutSecondsLTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldTimeoutSeconds, v))
+}
+
+// AbortOnFailureEQ applies the EQ predicate on the "abort_on_failure" field.
+func AbortOnFailureEQ(v bool) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldAbortOnFailure, v))
+}
+
+// AbortOnFailureNEQ applies the NEQ predicate on the "abort_on_failure" field.
+func AbortOnFailureNEQ(v bool) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldAbortOnFailure, v))
+}
+
+// LastRunAtEQ applies the EQ predicate on the "last_run_at" field.
+func LastRunAtEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtNEQ applies the NEQ predicate on the "last_run_at" field.
+func LastRunAtNEQ(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldLastRunAt, v))
+}
+
+// LastRunAtIn applies the In predicate on the "last_run_at" field.
+func LastRunAtIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtNotIn applies the NotIn predicate on the "last_run_at" field.
+func LastRunAtNotIn(vs ...time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldLastRunAt, vs...))
+}
+
+// LastRunAtGT applies the GT predicate on the "last_run_at" field.
+func LastRunAtGT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldLastRunAt, v))
+}
+
+// LastRunAtGTE applies the GTE predicate on the "last_run_at" field.
+func LastRunAtGTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldLastRunAt, v))
+}
+
+// LastRunAtLT applies the LT predicate on the "last_run_at" field.
+func LastRunAtLT(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldLastRunAt, v))
+}
+
+// LastRunAtLTE applies the LTE predicate on the "last_run_at" field.
+func LastRunAtLTE(v time.Time) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldLastRunAt, v))
+}
+
+// LastRunAtIsNil applies the IsNil predicate on the "last_run_at" field.
+func LastRunAtIsNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIsNull(FieldLastRunAt))
+}
+
+// LastRunAtNotNil applies the NotNil predicate on the "last_run_at" field.
+func LastRunAtNotNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotNull(FieldLastRunAt))
+}
+
+// LastExitCodeEQ applies the EQ predicate on the "last_exit_code" field.
+func LastExitCodeEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastExitCode, v))
+}
+
+// LastExitCodeNEQ applies the NEQ predicate on the "last_exit_code" field.
+func LastExitCodeNEQ(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldLastExitCode, v))
+}
+
+// LastExitCodeIn applies the In predicate on the "last_exit_code" field.
+func LastExitCodeIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldLastExitCode, vs...))
+}
+
+// LastExitCodeNotIn applies the NotIn predicate on the "last_exit_code" field.
+func LastExitCodeNotIn(vs ...int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldLastExitCode, vs...))
+}
+
+// LastExitCodeGT applies the GT predicate on the "last_exit_code" field.
+func LastExitCodeGT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldLastExitCode, v))
+}
+
+// LastExitCodeGTE applies the GTE predicate on the "last_exit_code" field.
+func LastExitCodeGTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldLastExitCode, v))
+}
+
+// LastExitCodeLT applies the LT predicate on the "last_exit_code" field.
+func LastExitCodeLT(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldLastExitCode, v))
+}
+
+// LastExitCodeLTE applies the LTE predicate on the "last_exit_code" field.
+func LastExitCodeLTE(v int) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldLastExitCode, v))
+}
+
+// LastExitCodeIsNil applies the IsNil predicate on the "last_exit_code" field.
+func LastExitCodeIsNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIsNull(FieldLastExitCode))
+}
+
+// LastExitCodeNotNil applies the NotNil predicate on the "last_exit_code" field.
+func LastExitCodeNotNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotNull(FieldLastExitCode))
+}
+
+// LastOutputEQ applies the EQ predicate on the "last_output" field.
+func LastOutputEQ(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEQ(FieldLastOutput, v))
+}
+
+// LastOutputNEQ applies the NEQ predicate on the "last_output" field.
+func LastOutputNEQ(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNEQ(FieldLastOutput, v))
+}
+
+// LastOutputIn applies the In predicate on the "last_output" field.
+func LastOutputIn(vs ...string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIn(FieldLastOutput, vs...))
+}
+
+// LastOutputNotIn applies the NotIn predicate on the "last_output" field.
+func LastOutputNotIn(vs ...string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotIn(FieldLastOutput, vs...))
+}
+
+// LastOutputGT applies the GT predicate on the "last_output" field.
+func LastOutputGT(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGT(FieldLastOutput, v))
+}
+
+// LastOutputGTE applies the GTE predicate on the "last_output" field.
+func LastOutputGTE(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldGTE(FieldLastOutput, v))
+}
+
+// LastOutputLT applies the LT predicate on the "last_output" field.
+func LastOutputLT(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLT(FieldLastOutput, v))
+}
+
+// LastOutputLTE applies the LTE predicate on the "last_output" field.
+func LastOutputLTE(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldLTE(FieldLastOutput, v))
+}
+
+// LastOutputContains applies the Contains predicate on the "last_output" field.
+func LastOutputContains(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldContains(FieldLastOutput, v))
+}
+
+// LastOutputHasPrefix applies the HasPrefix predicate on the "last_output" field.
+func LastOutputHasPrefix(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldHasPrefix(FieldLastOutput, v))
+}
+
+// LastOutputHasSuffix applies the HasSuffix predicate on the "last_output" field.
+func LastOutputHasSuffix(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldHasSuffix(FieldLastOutput, v))
+}
+
+// LastOutputIsNil applies the IsNil predicate on the "last_output" field.
+func LastOutputIsNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldIsNull(FieldLastOutput))
+}
+
+// LastOutputNotNil applies the NotNil predicate on the "last_output" field.
+func LastOutputNotNil() predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldNotNull(FieldLastOutput))
+}
+
+// LastOutputEqualFold applies the EqualFold predicate on the "last_output" field.
+func LastOutputEqualFold(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldEqualFold(FieldLastOutput, v))
+}
+
+// LastOutputContainsFold applies the ContainsFold predicate on the "last_output" field.
+func LastOutputContainsFold(v string) predicate.BackupHook {
+	return predicate.BackupHook(sql.FieldContainsFold(FieldLastOutput, v))
+}
+
+// HasBackupProfile applies the HasEdge predicate on the "backup_profile" edge.
+func HasBackupProfile() predicate.BackupHook {
+	return predicate.BackupHook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BackupProfileTable, BackupProfileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackupProfileWith applies the HasEdge predicate on the "backup_profile" edge with a given conditions (other predicates).
+func HasBackupProfileWith(preds ...predicate.BackupProfile) predicate.BackupHook {
+	return predicate.BackupHook(func(s *sql.Selector) {
+		step := newBackupProfileStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BackupHook) predicate.BackupHook {
+	return predicate.BackupHook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BackupHook) predicate.BackupHook {
+	return predicate.BackupHook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BackupHook) predicate.BackupHook {
+	return predicate.BackupHook(sql.NotPredicates(p))
+}
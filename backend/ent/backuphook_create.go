@@ -0,0 +1,476 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+)
+
+// BackupHookCreate is the builder for creating a BackupHook entity.
+type BackupHookCreate struct {
+	config
+	mutation *BackupHookMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BackupHookCreate) SetCreatedAt(v time.Time) *BackupHookCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableCreatedAt(v *time.Time) *BackupHookCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BackupHookCreate) SetUpdatedAt(v time.Time) *BackupHookCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableUpdatedAt(v *time.Time) *BackupHookCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *BackupHookCreate) SetType(v backuphook.Type) *BackupHookCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetRunOn sets the "run_on" field.
+func (_c *BackupHookCreate) SetRunOn(v backuphook.RunOn) *BackupHookCreate {
+	_c.mutation.SetRunOn(v)
+	return _c
+}
+
+// SetNillableRunOn sets the "run_on" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableRunOn(v *backuphook.RunOn) *BackupHookCreate {
+	if v != nil {
+		_c.SetRunOn(*v)
+	}
+	return _c
+}
+
+// SetCommand sets the "command" field.
+func (_c *BackupHookCreate) SetCommand(v string) *BackupHookCreate {
+	_c.mutation.SetCommand(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *BackupHookCreate) SetPosition(v int) *BackupHookCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillablePosition(v *int) *BackupHookCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_c *BackupHookCreate) SetTimeoutSeconds(v int) *BackupHookCreate {
+	_c.mutation.SetTimeoutSeconds(v)
+	return _c
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableTimeoutSeconds(v *int) *BackupHookCreate {
+	if v != nil {
+		_c.SetTimeoutSeconds(*v)
+	}
+	return _c
+}
+
+// SetAbortOnFailure sets the "abort_on_failure" field.
+func (_c *BackupHookCreate) SetAbortOnFailure(v bool) *BackupHookCreate {
+	_c.mutation.SetAbortOnFailure(v)
+	return _c
+}
+
+// SetNillableAbortOnFailure sets the "abort_on_failure" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableAbortOnFailure(v *bool) *BackupHookCreate {
+	if v != nil {
+		_c.SetAbortOnFailure(*v)
+	}
+	return _c
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_c *BackupHookCreate) SetLastRunAt(v time.Time) *BackupHookCreate {
+	_c.mutation.SetLastRunAt(v)
+	return _c
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableLastRunAt(v *time.Time) *BackupHookCreate {
+	if v != nil {
+		_c.SetLastRunAt(*v)
+	}
+	return _c
+}
+
+// SetLastExitCode sets the "last_exit_code" field.
+func (_c *BackupHookCreate) SetLastExitCode(v int) *BackupHookCreate {
+	_c.mutation.SetLastExitCode(v)
+	return _c
+}
+
+// SetNillableLastExitCode sets the "last_exit_code" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableLastExitCode(v *int) *BackupHookCreate {
+	if v != nil {
+		_c.SetLastExitCode(*v)
+	}
+	return _c
+}
+
+// SetLastOutput sets the "last_output" field.
+func (_c *BackupHookCreate) SetLastOutput(v string) *BackupHookCreate {
+	_c.mutation.SetLastOutput(v)
+	return _c
+}
+
+// SetNillableLastOutput sets the "last_output" field if the given value is not nil.
+func (_c *BackupHookCreate) SetNillableLastOutput(v *string) *BackupHookCreate {
+	if v != nil {
+		_c.SetLastOutput(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BackupHookCreate) SetID(v int) *BackupHookCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by ID.
+func (_c *BackupHookCreate) SetBackupProfileID(id int) *BackupHookCreate {
+	_c.mutation.SetBackupProfileID(id)
+	return _c
+}
+
+// SetBackupProfile sets the "backup_profile" edge to the BackupProfile entity.
+func (_c *BackupHookCreate) SetBackupProfile(v *BackupProfile) *BackupHookCreate {
+	return _c.SetBackupProfileID(v.ID)
+}
+
+// Mutation returns the BackupHookMutation object of the builder.
+func (_c *BackupHookCreate) Mutation() *BackupHookMutation {
+	return _c.mutation
+}
+
+// Save creates the BackupHook in the database.
+func (_c *BackupHookCreate) Save(ctx context.Context) (*BackupHook, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BackupHookCreate) SaveX(ctx context.Context) *BackupHook {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupHookCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupHookCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BackupHookCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := backuphook.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := backuphook.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.RunOn(); !ok {
+		v := backuphook.DefaultRunOn
+		_c.mutation.SetRunOn(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := backuphook.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		v := backuphook.DefaultTimeoutSeconds
+		_c.mutation.SetTimeoutSeconds(v)
+	}
+	if _, ok := _c.mutation.AbortOnFailure(); !ok {
+		v := backuphook.DefaultAbortOnFailure
+		_c.mutation.SetAbortOnFailure(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BackupHookCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BackupHook.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BackupHook.updated_at"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "BackupHook.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := backuphook.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BackupHook.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RunOn(); !ok {
+		return &ValidationError{Name: "run_on", err: errors.New(`ent: missing required field "BackupHook.run_on"`)}
+	}
+	if v, ok := _c.mutation.RunOn(); ok {
+		if err := backuphook.RunOnValidator(v); err != nil {
+			return &ValidationError{Name: "run_on", err: fmt.Errorf(`ent: validator failed for field "BackupHook.run_on": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Command(); !ok {
+		return &ValidationError{Name: "command", err: errors.New(`ent: missing required field "BackupHook.command"`)}
+	}
+	if v, ok := _c.mutation.Command(); ok {
+		if err := backuphook.CommandValidator(v); err != nil {
+			return &ValidationError{Name: "command", err: fmt.Errorf(`ent: validator failed for field "BackupHook.command": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "BackupHook.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := backuphook.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BackupHook.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeoutSeconds(); !ok {
+		return &ValidationError{Name: "timeout_seconds", err: errors.New(`ent: missing required field "BackupHook.timeout_seconds"`)}
+	}
+	if v, ok := _c.mutation.TimeoutSeconds(); ok {
+		if err := backuphook.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "BackupHook.timeout_seconds": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AbortOnFailure(); !ok {
+		return &ValidationError{Name: "abort_on_failure", err: errors.New(`ent: missing required field "BackupHook.abort_on_failure"`)}
+	}
+	if len(_c.mutation.BackupProfileIDs()) == 0 {
+		return &ValidationError{Name: "backup_profile", err: errors.New(`ent: missing required edge "BackupHook.backup_profile"`)}
+	}
+	return nil
+}
+
+func (_c *BackupHookCreate) sqlSave(ctx context.Context) (*BackupHook, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BackupHookCreate) createSpec() (*BackupHook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BackupHook{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backuphook.Table, sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(backuphook.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuphook.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(backuphook.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.RunOn(); ok {
+		_spec.SetField(backuphook.FieldRunOn, field.TypeEnum, value)
+		_node.RunOn = value
+	}
+	if value, ok := _c.mutation.Command(); ok {
+		_spec.SetField(backuphook.FieldCommand, field.TypeString, value)
+		_node.Command = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(backuphook.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(backuphook.FieldTimeoutSeconds, field.TypeInt, value)
+		_node.TimeoutSeconds = value
+	}
+	if value, ok := _c.mutation.AbortOnFailure(); ok {
+		_spec.SetField(backuphook.FieldAbortOnFailure, field.TypeBool, value)
+		_node.AbortOnFailure = value
+	}
+	if value, ok := _c.mutation.LastRunAt(); ok {
+		_spec.SetField(backuphook.FieldLastRunAt, field.TypeTime, value)
+		_node.LastRunAt = &value
+	}
+	if value, ok := _c.mutation.LastExitCode(); ok {
+		_spec.SetField(backuphook.FieldLastExitCode, field.TypeInt, value)
+		_node.LastExitCode = &value
+	}
+	if value, ok := _c.mutation.LastOutput(); ok {
+		_spec.SetField(backuphook.FieldLastOutput, field.TypeString, value)
+		_node.LastOutput = &value
+	}
+	if nodes := _c.mutation.BackupProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuphook.BackupProfileTable,
+			Columns: []string{backuphook.BackupProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.backup_profile_hooks = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BackupHookCreateBulk is the builder for creating many BackupHook entities in bulk.
+type BackupHookCreateBulk struct {
+	config
+	err      error
+	builders []*BackupHookCreate
+}
+
+// Save creates the BackupHook entities in the database.
+func (_c *BackupHookCreateBulk) Save(ctx context.Context) ([]*BackupHook, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BackupHook, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BackupHookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BackupHookCreateBulk) SaveX(ctx context.Context) []*BackupHook {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BackupHookCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BackupHookCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,813 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
+	"github.com/loomi-labs/arco/backend/ent/backupprofile"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// BackupHookUpdate is the builder for updating BackupHook entities.
+type BackupHookUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *BackupHookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the BackupHookUpdate builder.
+func (_u *BackupHookUpdate) Where(ps ...predicate.BackupHook) *BackupHookUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BackupHookUpdate) SetUpdatedAt(v time.Time) *BackupHookUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *BackupHookUpdate) SetType(v backuphook.Type) *BackupHookUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableType(v *backuphook.Type) *BackupHookUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetRunOn sets the "run_on" field.
+func (_u *BackupHookUpdate) SetRunOn(v backuphook.RunOn) *BackupHookUpdate {
+	_u.mutation.SetRunOn(v)
+	return _u
+}
+
+// SetNillableRunOn sets the "run_on" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableRunOn(v *backuphook.RunOn) *BackupHookUpdate {
+	if v != nil {
+		_u.SetRunOn(*v)
+	}
+	return _u
+}
+
+// SetCommand sets the "command" field.
+func (_u *BackupHookUpdate) SetCommand(v string) *BackupHookUpdate {
+	_u.mutation.SetCommand(v)
+	return _u
+}
+
+// SetNillableCommand sets the "command" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableCommand(v *string) *BackupHookUpdate {
+	if v != nil {
+		_u.SetCommand(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BackupHookUpdate) SetPosition(v int) *BackupHookUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillablePosition(v *int) *BackupHookUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BackupHookUpdate) AddPosition(v int) *BackupHookUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *BackupHookUpdate) SetTimeoutSeconds(v int) *BackupHookUpdate {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableTimeoutSeconds(v *int) *BackupHookUpdate {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *BackupHookUpdate) AddTimeoutSeconds(v int) *BackupHookUpdate {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// SetAbortOnFailure sets the "abort_on_failure" field.
+func (_u *BackupHookUpdate) SetAbortOnFailure(v bool) *BackupHookUpdate {
+	_u.mutation.SetAbortOnFailure(v)
+	return _u
+}
+
+// SetNillableAbortOnFailure sets the "abort_on_failure" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableAbortOnFailure(v *bool) *BackupHookUpdate {
+	if v != nil {
+		_u.SetAbortOnFailure(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *BackupHookUpdate) SetLastRunAt(v time.Time) *BackupHookUpdate {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableLastRunAt(v *time.Time) *BackupHookUpdate {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *BackupHookUpdate) ClearLastRunAt() *BackupHookUpdate {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastExitCode sets the "last_exit_code" field.
+func (_u *BackupHookUpdate) SetLastExitCode(v int) *BackupHookUpdate {
+	_u.mutation.ResetLastExitCode()
+	_u.mutation.SetLastExitCode(v)
+	return _u
+}
+
+// SetNillableLastExitCode sets the "last_exit_code" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableLastExitCode(v *int) *BackupHookUpdate {
+	if v != nil {
+		_u.SetLastExitCode(*v)
+	}
+	return _u
+}
+
+// AddLastExitCode adds value to the "last_exit_code" field.
+func (_u *BackupHookUpdate) AddLastExitCode(v int) *BackupHookUpdate {
+	_u.mutation.AddLastExitCode(v)
+	return _u
+}
+
+// ClearLastExitCode clears the value of the "last_exit_code" field.
+func (_u *BackupHookUpdate) ClearLastExitCode() *BackupHookUpdate {
+	_u.mutation.ClearLastExitCode()
+	return _u
+}
+
+// SetLastOutput sets the "last_output" field.
+func (_u *BackupHookUpdate) SetLastOutput(v string) *BackupHookUpdate {
+	_u.mutation.SetLastOutput(v)
+	return _u
+}
+
+// SetNillableLastOutput sets the "last_output" field if the given value is not nil.
+func (_u *BackupHookUpdate) SetNillableLastOutput(v *string) *BackupHookUpdate {
+	if v != nil {
+		_u.SetLastOutput(*v)
+	}
+	return _u
+}
+
+// ClearLastOutput clears the value of the "last_output" field.
+func (_u *BackupHookUpdate) ClearLastOutput() *BackupHookUpdate {
+	_u.mutation.ClearLastOutput()
+	return _u
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by ID.
+func (_u *BackupHookUpdate) SetBackupProfileID(id int) *BackupHookUpdate {
+	_u.mutation.SetBackupProfileID(id)
+	return _u
+}
+
+// SetBackupProfile sets the "backup_profile" edge to the BackupProfile entity.
+func (_u *BackupHookUpdate) SetBackupProfile(v *BackupProfile) *BackupHookUpdate {
+	return _u.SetBackupProfileID(v.ID)
+}
+
+// Mutation returns the BackupHookMutation object of the builder.
+func (_u *BackupHookUpdate) Mutation() *BackupHookMutation {
+	return _u.mutation
+}
+
+// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
+func (_u *BackupHookUpdate) ClearBackupProfile() *BackupHookUpdate {
+	_u.mutation.ClearBackupProfile()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BackupHookUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupHookUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BackupHookUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupHookUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BackupHookUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := backuphook.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupHookUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := backuphook.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BackupHook.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunOn(); ok {
+		if err := backuphook.RunOnValidator(v); err != nil {
+			return &ValidationError{Name: "run_on", err: fmt.Errorf(`ent: validator failed for field "BackupHook.run_on": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Command(); ok {
+		if err := backuphook.CommandValidator(v); err != nil {
+			return &ValidationError{Name: "command", err: fmt.Errorf(`ent: validator failed for field "BackupHook.command": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := backuphook.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BackupHook.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := backuphook.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "BackupHook.timeout_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.BackupProfileCleared() && len(_u.mutation.BackupProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupHook.backup_profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BackupHookUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BackupHookUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BackupHookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuphook.Table, backuphook.Columns, sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuphook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(backuphook.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RunOn(); ok {
+		_spec.SetField(backuphook.FieldRunOn, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Command(); ok {
+		_spec.SetField(backuphook.FieldCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(backuphook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(backuphook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(backuphook.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(backuphook.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AbortOnFailure(); ok {
+		_spec.SetField(backuphook.FieldAbortOnFailure, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(backuphook.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(backuphook.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastExitCode(); ok {
+		_spec.SetField(backuphook.FieldLastExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastExitCode(); ok {
+		_spec.AddField(backuphook.FieldLastExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.LastExitCodeCleared() {
+		_spec.ClearField(backuphook.FieldLastExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastOutput(); ok {
+		_spec.SetField(backuphook.FieldLastOutput, field.TypeString, value)
+	}
+	if _u.mutation.LastOutputCleared() {
+		_spec.ClearField(backuphook.FieldLastOutput, field.TypeString)
+	}
+	if _u.mutation.BackupProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuphook.BackupProfileTable,
+			Columns: []string{backuphook.BackupProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackupProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuphook.BackupProfileTable,
+			Columns: []string{backuphook.BackupProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuphook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BackupHookUpdateOne is the builder for updating a single BackupHook entity.
+type BackupHookUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *BackupHookMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BackupHookUpdateOne) SetUpdatedAt(v time.Time) *BackupHookUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *BackupHookUpdateOne) SetType(v backuphook.Type) *BackupHookUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableType(v *backuphook.Type) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetRunOn sets the "run_on" field.
+func (_u *BackupHookUpdateOne) SetRunOn(v backuphook.RunOn) *BackupHookUpdateOne {
+	_u.mutation.SetRunOn(v)
+	return _u
+}
+
+// SetNillableRunOn sets the "run_on" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableRunOn(v *backuphook.RunOn) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetRunOn(*v)
+	}
+	return _u
+}
+
+// SetCommand sets the "command" field.
+func (_u *BackupHookUpdateOne) SetCommand(v string) *BackupHookUpdateOne {
+	_u.mutation.SetCommand(v)
+	return _u
+}
+
+// SetNillableCommand sets the "command" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableCommand(v *string) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetCommand(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BackupHookUpdateOne) SetPosition(v int) *BackupHookUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillablePosition(v *int) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BackupHookUpdateOne) AddPosition(v int) *BackupHookUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (_u *BackupHookUpdateOne) SetTimeoutSeconds(v int) *BackupHookUpdateOne {
+	_u.mutation.ResetTimeoutSeconds()
+	_u.mutation.SetTimeoutSeconds(v)
+	return _u
+}
+
+// SetNillableTimeoutSeconds sets the "timeout_seconds" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableTimeoutSeconds(v *int) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetTimeoutSeconds(*v)
+	}
+	return _u
+}
+
+// AddTimeoutSeconds adds value to the "timeout_seconds" field.
+func (_u *BackupHookUpdateOne) AddTimeoutSeconds(v int) *BackupHookUpdateOne {
+	_u.mutation.AddTimeoutSeconds(v)
+	return _u
+}
+
+// SetAbortOnFailure sets the "abort_on_failure" field.
+func (_u *BackupHookUpdateOne) SetAbortOnFailure(v bool) *BackupHookUpdateOne {
+	_u.mutation.SetAbortOnFailure(v)
+	return _u
+}
+
+// SetNillableAbortOnFailure sets the "abort_on_failure" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableAbortOnFailure(v *bool) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetAbortOnFailure(*v)
+	}
+	return _u
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (_u *BackupHookUpdateOne) SetLastRunAt(v time.Time) *BackupHookUpdateOne {
+	_u.mutation.SetLastRunAt(v)
+	return _u
+}
+
+// SetNillableLastRunAt sets the "last_run_at" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableLastRunAt(v *time.Time) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetLastRunAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (_u *BackupHookUpdateOne) ClearLastRunAt() *BackupHookUpdateOne {
+	_u.mutation.ClearLastRunAt()
+	return _u
+}
+
+// SetLastExitCode sets the "last_exit_code" field.
+func (_u *BackupHookUpdateOne) SetLastExitCode(v int) *BackupHookUpdateOne {
+	_u.mutation.ResetLastExitCode()
+	_u.mutation.SetLastExitCode(v)
+	return _u
+}
+
+// SetNillableLastExitCode sets the "last_exit_code" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableLastExitCode(v *int) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetLastExitCode(*v)
+	}
+	return _u
+}
+
+// AddLastExitCode adds value to the "last_exit_code" field.
+func (_u *BackupHookUpdateOne) AddLastExitCode(v int) *BackupHookUpdateOne {
+	_u.mutation.AddLastExitCode(v)
+	return _u
+}
+
+// ClearLastExitCode clears the value of the "last_exit_code" field.
+func (_u *BackupHookUpdateOne) ClearLastExitCode() *BackupHookUpdateOne {
+	_u.mutation.ClearLastExitCode()
+	return _u
+}
+
+// SetLastOutput sets the "last_output" field.
+func (_u *BackupHookUpdateOne) SetLastOutput(v string) *BackupHookUpdateOne {
+	_u.mutation.SetLastOutput(v)
+	return _u
+}
+
+// SetNillableLastOutput sets the "last_output" field if the given value is not nil.
+func (_u *BackupHookUpdateOne) SetNillableLastOutput(v *string) *BackupHookUpdateOne {
+	if v != nil {
+		_u.SetLastOutput(*v)
+	}
+	return _u
+}
+
+// ClearLastOutput clears the value of the "last_output" field.
+func (_u *BackupHookUpdateOne) ClearLastOutput() *BackupHookUpdateOne {
+	_u.mutation.ClearLastOutput()
+	return _u
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by ID.
+func (_u *BackupHookUpdateOne) SetBackupProfileID(id int) *BackupHookUpdateOne {
+	_u.mutation.SetBackupProfileID(id)
+	return _u
+}
+
+// SetBackupProfile sets the "backup_profile" edge to the BackupProfile entity.
+func (_u *BackupHookUpdateOne) SetBackupProfile(v *BackupProfile) *BackupHookUpdateOne {
+	return _u.SetBackupProfileID(v.ID)
+}
+
+// Mutation returns the BackupHookMutation object of the builder.
+func (_u *BackupHookUpdateOne) Mutation() *BackupHookMutation {
+	return _u.mutation
+}
+
+// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
+func (_u *BackupHookUpdateOne) ClearBackupProfile() *BackupHookUpdateOne {
+	_u.mutation.ClearBackupProfile()
+	return _u
+}
+
+// Where appends a list predicates to the BackupHookUpdate builder.
+func (_u *BackupHookUpdateOne) Where(ps ...predicate.BackupHook) *BackupHookUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BackupHookUpdateOne) Select(field string, fields ...string) *BackupHookUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BackupHook entity.
+func (_u *BackupHookUpdateOne) Save(ctx context.Context) (*BackupHook, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BackupHookUpdateOne) SaveX(ctx context.Context) *BackupHook {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BackupHookUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BackupHookUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BackupHookUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := backuphook.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BackupHookUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := backuphook.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "BackupHook.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RunOn(); ok {
+		if err := backuphook.RunOnValidator(v); err != nil {
+			return &ValidationError{Name: "run_on", err: fmt.Errorf(`ent: validator failed for field "BackupHook.run_on": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Command(); ok {
+		if err := backuphook.CommandValidator(v); err != nil {
+			return &ValidationError{Name: "command", err: fmt.Errorf(`ent: validator failed for field "BackupHook.command": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Position(); ok {
+		if err := backuphook.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BackupHook.position": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeoutSeconds(); ok {
+		if err := backuphook.TimeoutSecondsValidator(v); err != nil {
+			return &ValidationError{Name: "timeout_seconds", err: fmt.Errorf(`ent: validator failed for field "BackupHook.timeout_seconds": %w`, err)}
+		}
+	}
+	if _u.mutation.BackupProfileCleared() && len(_u.mutation.BackupProfileIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BackupHook.backup_profile"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *BackupHookUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *BackupHookUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *BackupHookUpdateOne) sqlSave(ctx context.Context) (_node *BackupHook, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backuphook.Table, backuphook.Columns, sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BackupHook.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backuphook.FieldID)
+		for _, f := range fields {
+			if !backuphook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backuphook.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(backuphook.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(backuphook.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RunOn(); ok {
+		_spec.SetField(backuphook.FieldRunOn, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Command(); ok {
+		_spec.SetField(backuphook.FieldCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(backuphook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(backuphook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TimeoutSeconds(); ok {
+		_spec.SetField(backuphook.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTimeoutSeconds(); ok {
+		_spec.AddField(backuphook.FieldTimeoutSeconds, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AbortOnFailure(); ok {
+		_spec.SetField(backuphook.FieldAbortOnFailure, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastRunAt(); ok {
+		_spec.SetField(backuphook.FieldLastRunAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRunAtCleared() {
+		_spec.ClearField(backuphook.FieldLastRunAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastExitCode(); ok {
+		_spec.SetField(backuphook.FieldLastExitCode, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLastExitCode(); ok {
+		_spec.AddField(backuphook.FieldLastExitCode, field.TypeInt, value)
+	}
+	if _u.mutation.LastExitCodeCleared() {
+		_spec.ClearField(backuphook.FieldLastExitCode, field.TypeInt)
+	}
+	if value, ok := _u.mutation.LastOutput(); ok {
+		_spec.SetField(backuphook.FieldLastOutput, field.TypeString, value)
+	}
+	if _u.mutation.LastOutputCleared() {
+		_spec.ClearField(backuphook.FieldLastOutput, field.TypeString)
+	}
+	if _u.mutation.BackupProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuphook.BackupProfileTable,
+			Columns: []string{backuphook.BackupProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackupProfileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backuphook.BackupProfileTable,
+			Columns: []string{backuphook.BackupProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backupprofile.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &BackupHook{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backuphook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -32,14 +32,40 @@ type BackupProfile struct {
 	BackupPaths []string `json:"backupPaths"`
 	// ExcludePaths holds the value of the "exclude_paths" field.
 	ExcludePaths []string `json:"excludePaths"`
+	// Borg pattern rules (e.g. '- sh:**/node_modules'), evaluated before the exclude paths
+	Patterns []string `json:"patterns"`
 	// Exclude directories containing CACHEDIR.TAG file
 	ExcludeCaches bool `json:"excludeCaches"`
+	// Exclude directories containing a file with one of these names (e.g. .nobackup)
+	ExcludeIfPresent []string `json:"excludeIfPresent"`
+	// Honor gitignore-style .arcoignore files found in the backup paths. Every directory of the backup paths is checked for changes before each backup, which takes a while for very large trees
+	UseIgnoreFiles bool `json:"useIgnoreFiles"`
+	// Command whose output is backed up as a single file (e.g. pg_dump), empty if disabled
+	ContentCommand string `json:"contentCommand"`
+	// Path of the command output in the archive
+	ContentStdinName string `json:"contentStdinName"`
 	// Icon holds the value of the "icon" field.
 	Icon backupprofile.Icon `json:"icon"`
 	// Compression algorithm for backups
 	CompressionMode backupprofile.CompressionMode `json:"compressionMode"`
 	// Compression level (algorithm-specific range)
 	CompressionLevel *int `json:"compressionLevel"`
+	// Stay in the same file system and don't back up mount points (--one-file-system)
+	OneFileSystem bool `json:"oneFileSystem"`
+	// Only store numeric user and group identifiers (--numeric-ids)
+	NumericIds bool `json:"numericIds"`
+	// Don't store file flags like immutable or nodump (--noflags)
+	NoFlags bool `json:"noFlags"`
+	// Don't store access control lists (--noacls)
+	NoAcls bool `json:"noAcls"`
+	// Don't store extended attributes (--noxattrs)
+	NoXattrs bool `json:"noXattrs"`
+	// Detect sparse holes in input files (--sparse)
+	Sparse bool `json:"sparse"`
+	// Back up the content of device files and FIFOs instead of the special files (--read-special)
+	ReadSpecial bool `json:"readSpecial"`
+	// Seconds between checkpoints of long running backups, borg's default (30 minutes) if not set
+	CheckpointInterval *int `json:"checkpointInterval"`
 	// DataSectionCollapsed holds the value of the "data_section_collapsed" field.
 	DataSectionCollapsed bool `json:"dataSectionCollapsed"`
 	// ScheduleSectionCollapsed holds the value of the "schedule_section_collapsed" field.
@@ -62,11 +88,13 @@ type BackupProfileEdges struct {
 	BackupSchedule *BackupSchedule `json:"backupSchedule,omitempty"`
 	// PruningRule holds the value of the pruning_rule edge.
 	PruningRule *PruningRule `json:"pruningRule,omitempty"`
+	// Hooks holds the value of the hooks edge.
+	Hooks []*BackupHook `json:"hooks,omitempty"`
 	// Notifications holds the value of the notifications edge.
 	Notifications []*Notification `json:"notifications,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [6]bool
 }
 
 // RepositoriesOrErr returns the Repositories value or an error if the edge
@@ -109,10 +137,19 @@ func (e BackupProfileEdges) PruningRuleOrErr() (*PruningRule, error) {
 	return nil, &NotLoadedError{edge: "pruning_rule"}
 }
 
+// HooksOrErr returns the Hooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BackupProfileEdges) HooksOrErr() ([]*BackupHook, error) {
+	if e.loadedTypes[4] {
+		return e.Hooks, nil
+	}
+	return nil, &NotLoadedError{edge: "hooks"}
+}
+
 // NotificationsOrErr returns the Notifications value or an error if the edge
 // was not loaded in eager-loading.
 func (e BackupProfileEdges) NotificationsOrErr() ([]*Notification, error) {
-	if e.loadedTypes[4] {
+	if e.loadedTypes[5] {
 		return e.Notifications, nil
 	}
 	return nil, &NotLoadedError{edge: "notifications"}
@@ -123,13 +160,13 @@ func (*BackupProfile) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case backupprofile.FieldBackupPaths, backupprofile.FieldExcludePaths:
+		case backupprofile.FieldBackupPaths, backupprofile.FieldExcludePaths, backupprofile.FieldPatterns, backupprofile.FieldExcludeIfPresent:
 			values[i] = new([]byte)
-		case backupprofile.FieldExcludeCaches, backupprofile.FieldDataSectionCollapsed, backupprofile.FieldScheduleSectionCollapsed, backupprofile.FieldAdvancedSectionCollapsed:
+		case backupprofile.FieldExcludeCaches, backupprofile.FieldUseIgnoreFiles, backupprofile.FieldOneFileSystem, backupprofile.FieldNumericIds, backupprofile.FieldNoFlags, backupprofile.FieldNoAcls, backupprofile.FieldNoXattrs, backupprofile.FieldSparse, backupprofile.FieldReadSpecial, backupprofile.FieldDataSectionCollapsed, backupprofile.FieldScheduleSectionCollapsed, backupprofile.FieldAdvancedSectionCollapsed:
 			values[i] = new(sql.NullBool)
-		case backupprofile.FieldID, backupprofile.FieldCompressionLevel:
+		case backupprofile.FieldID, backupprofile.FieldCompressionLevel, backupprofile.FieldCheckpointInterval:
 			values[i] = new(sql.NullInt64)
-		case backupprofile.FieldName, backupprofile.FieldPrefix, backupprofile.FieldIcon, backupprofile.FieldCompressionMode:
+		case backupprofile.FieldName, backupprofile.FieldPrefix, backupprofile.FieldContentCommand, backupprofile.FieldContentStdinName, backupprofile.FieldIcon, backupprofile.FieldCompressionMode:
 			values[i] = new(sql.NullString)
 		case backupprofile.FieldCreatedAt, backupprofile.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -194,12 +231,46 @@ func (_m *BackupProfile) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field exclude_paths: %w", err)
 				}
 			}
+		case backupprofile.FieldPatterns:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field patterns", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Patterns); err != nil {
+					return fmt.Errorf("unmarshal field patterns: %w", err)
+				}
+			}
 		case backupprofile.FieldExcludeCaches:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field exclude_caches", values[i])
 			} else if value.Valid {
 				_m.ExcludeCaches = value.Bool
 			}
+		case backupprofile.FieldExcludeIfPresent:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field exclude_if_present", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ExcludeIfPresent); err != nil {
+					return fmt.Errorf("unmarshal field exclude_if_present: %w", err)
+				}
+			}
+		case backupprofile.FieldUseIgnoreFiles:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field use_ignore_files", values[i])
+			} else if value.Valid {
+				_m.UseIgnoreFiles = value.Bool
+			}
+		case backupprofile.FieldContentCommand:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_command", values[i])
+			} else if value.Valid {
+				_m.ContentCommand = value.String
+			}
+		case backupprofile.FieldContentStdinName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_stdin_name", values[i])
+			} else if value.Valid {
+				_m.ContentStdinName = value.String
+			}
 		case backupprofile.FieldIcon:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field icon", values[i])
@@ -219,6 +290,55 @@ func (_m *BackupProfile) assignValues(columns []string, values []any) error {
 				_m.CompressionLevel = new(int)
 				*_m.CompressionLevel = int(value.Int64)
 			}
+		case backupprofile.FieldOneFileSystem:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field one_file_system", values[i])
+			} else if value.Valid {
+				_m.OneFileSystem = value.Bool
+			}
+		case backupprofile.FieldNumericIds:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field numeric_ids", values[i])
+			} else if value.Valid {
+				_m.NumericIds = value.Bool
+			}
+		case backupprofile.FieldNoFlags:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field no_flags", values[i])
+			} else if value.Valid {
+				_m.NoFlags = value.Bool
+			}
+		case backupprofile.FieldNoAcls:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field no_acls", values[i])
+			} else if value.Valid {
+				_m.NoAcls = value.Bool
+			}
+		case backupprofile.FieldNoXattrs:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field no_xattrs", values[i])
+			} else if value.Valid {
+				_m.NoXattrs = value.Bool
+			}
+		case backupprofile.FieldSparse:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field sparse", values[i])
+			} else if value.Valid {
+				_m.Sparse = value.Bool
+			}
+		case backupprofile.FieldReadSpecial:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field read_special", values[i])
+			} else if value.Valid {
+				_m.ReadSpecial = value.Bool
+			}
+		case backupprofile.FieldCheckpointInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field checkpoint_interval", values[i])
+			} else if value.Valid {
+				_m.CheckpointInterval = new(int)
+				*_m.CheckpointInterval = int(value.Int64)
+			}
 		case backupprofile.FieldDataSectionCollapsed:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field data_section_collapsed", values[i])
@@ -270,6 +390,11 @@ func (_m *BackupProfile) QueryPruningRule() *PruningRuleQuery {
 	return NewBackupProfileClient(_m.config).QueryPruningRule(_m)
 }
 
+// QueryHooks queries the "hooks" edge of the BackupProfile entity.
+func (_m *BackupProfile) QueryHooks() *BackupHookQuery {
+	return NewBackupProfileClient(_m.config).QueryHooks(_m)
+}
+
 // QueryNotifications queries the "notifications" edge of the BackupProfile entity.
 func (_m *BackupProfile) QueryNotifications() *NotificationQuery {
 	return NewBackupProfileClient(_m.config).QueryNotifications(_m)
@@ -316,9 +441,24 @@ func (_m *BackupProfile) String() string {
 	builder.WriteString("exclude_paths=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExcludePaths))
 	builder.WriteString(", ")
+	builder.WriteString("patterns=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Patterns))
+	builder.WriteString(", ")
 	builder.WriteString("exclude_caches=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ExcludeCaches))
 	builder.WriteString(", ")
+	builder.WriteString("exclude_if_present=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcludeIfPresent))
+	builder.WriteString(", ")
+	builder.WriteString("use_ignore_files=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UseIgnoreFiles))
+	builder.WriteString(", ")
+	builder.WriteString("content_command=")
+	builder.WriteString(_m.ContentCommand)
+	builder.WriteString(", ")
+	builder.WriteString("content_stdin_name=")
+	builder.WriteString(_m.ContentStdinName)
+	builder.WriteString(", ")
 	builder.WriteString("icon=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Icon))
 	builder.WriteString(", ")
@@ -330,6 +470,32 @@ func (_m *BackupProfile) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("one_file_system=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OneFileSystem))
+	builder.WriteString(", ")
+	builder.WriteString("numeric_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NumericIds))
+	builder.WriteString(", ")
+	builder.WriteString("no_flags=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoFlags))
+	builder.WriteString(", ")
+	builder.WriteString("no_acls=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoAcls))
+	builder.WriteString(", ")
+	builder.WriteString("no_xattrs=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NoXattrs))
+	builder.WriteString(", ")
+	builder.WriteString("sparse=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Sparse))
+	builder.WriteString(", ")
+	builder.WriteString("read_special=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadSpecial))
+	builder.WriteString(", ")
+	if v := _m.CheckpointInterval; v != nil {
+		builder.WriteString("checkpoint_interval=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("data_section_collapsed=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DataSectionCollapsed))
 	builder.WriteString(", ")
@@ -27,14 +27,40 @@ const (
 	FieldBackupPaths = "backup_paths"
 	// FieldExcludePaths holds the string denoting the exclude_paths field in the database.
 	FieldExcludePaths = "exclude_paths"
+	// FieldPatterns holds the string denoting the patterns field in the database.
+	FieldPatterns = "patterns"
 	// FieldExcludeCaches holds the string denoting the exclude_caches field in the database.
 	FieldExcludeCaches = "exclude_caches"
+	// FieldExcludeIfPresent holds the string denoting the exclude_if_present field in the database.
+	FieldExcludeIfPresent = "exclude_if_present"
+	// FieldUseIgnoreFiles holds the string denoting the use_ignore_files field in the database.
+	FieldUseIgnoreFiles = "use_ignore_files"
+	// FieldContentCommand holds the string denoting the content_command field in the database.
+	FieldContentCommand = "content_command"
+	// FieldContentStdinName holds the string denoting the content_stdin_name field in the database.
+	FieldContentStdinName = "content_stdin_name"
 	// FieldIcon holds the string denoting the icon field in the database.
 	FieldIcon = "icon"
 	// FieldCompressionMode holds the string denoting the compression_mode field in the database.
 	FieldCompressionMode = "compression_mode"
 	// FieldCompressionLevel holds the string denoting the compression_level field in the database.
 	FieldCompressionLevel = "compression_level"
+	// FieldOneFileSystem holds the string denoting the one_file_system field in the database.
+	FieldOneFileSystem = "one_file_system"
+	// FieldNumericIds holds the string denoting the numeric_ids field in the database.
+	FieldNumericIds = "numeric_ids"
+	// FieldNoFlags holds the string denoting the no_flags field in the database.
+	FieldNoFlags = "no_flags"
+	// FieldNoAcls holds the string denoting the no_acls field in the database.
+	FieldNoAcls = "no_acls"
+	// FieldNoXattrs holds the string denoting the no_xattrs field in the database.
+	FieldNoXattrs = "no_xattrs"
+	// FieldSparse holds the string denoting the sparse field in the database.
+	FieldSparse = "sparse"
+	// FieldReadSpecial holds the string denoting the read_special field in the database.
+	FieldReadSpecial = "read_special"
+	// FieldCheckpointInterval holds the string denoting the checkpoint_interval field in the database.
+	FieldCheckpointInterval = "checkpoint_interval"
 	// FieldDataSectionCollapsed holds the string denoting the data_section_collapsed field in the database.
 	FieldDataSectionCollapsed = "data_section_collapsed"
 	// FieldScheduleSectionCollapsed holds the string denoting the schedule_section_collapsed field in the database.
@@ -49,6 +75,8 @@ const (
 	EdgeBackupSchedule = "backup_schedule"
 	// EdgePruningRule holds the string denoting the pruning_rule edge name in mutations.
 	EdgePruningRule = "pruning_rule"
+	// EdgeHooks holds the string denoting the hooks edge name in mutations.
+	EdgeHooks = "hooks"
 	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
 	EdgeNotifications = "notifications"
 	// Table holds the table name of the backupprofile in the database.
@@ -79,6 +107,13 @@ const (
 	PruningRuleInverseTable = "pruning_rules"
 	// PruningRuleColumn is the table column denoting the pruning_rule relation/edge.
 	PruningRuleColumn = "backup_profile_pruning_rule"
+	// HooksTable is the table that holds the hooks relation/edge.
+	HooksTable = "backup_hooks"
+	// HooksInverseTable is the table name for the BackupHook entity.
+	// It exists in this package in order to avoid circular dependency with the "backuphook" package.
+	HooksInverseTable = "backup_hooks"
+	// HooksColumn is the table column denoting the hooks relation/edge.
+	HooksColumn = "backup_profile_hooks"
 	// NotificationsTable is the table that holds the notifications relation/edge.
 	NotificationsTable = "notifications"
 	// NotificationsInverseTable is the table name for the Notification entity.
@@ -97,10 +132,23 @@ var Columns = []string{
 	FieldPrefix,
 	FieldBackupPaths,
 	FieldExcludePaths,
+	FieldPatterns,
 	FieldExcludeCaches,
+	FieldExcludeIfPresent,
+	FieldUseIgnoreFiles,
+	FieldContentCommand,
+	FieldContentStdinName,
 	FieldIcon,
 	FieldCompressionMode,
 	FieldCompressionLevel,
+	FieldOneFileSystem,
+	FieldNumericIds,
+	FieldNoFlags,
+	FieldNoAcls,
+	FieldNoXattrs,
+	FieldSparse,
+	FieldReadSpecial,
+	FieldCheckpointInterval,
 	FieldDataSectionCollapsed,
 	FieldScheduleSectionCollapsed,
 	FieldAdvancedSectionCollapsed,
@@ -137,10 +185,36 @@ var (
 	DefaultBackupPaths []string
 	// DefaultExcludePaths holds the default value on creation for the "exclude_paths" field.
 	DefaultExcludePaths []string
+	// DefaultPatterns holds the default value on creation for the "patterns" field.
+	DefaultPatterns []string
 	// DefaultExcludeCaches holds the default value on creation for the "exclude_caches" field.
 	DefaultExcludeCaches bool
+	// DefaultExcludeIfPresent holds the default value on creation for the "exclude_if_present" field.
+	DefaultExcludeIfPresent []string
+	// DefaultUseIgnoreFiles holds the default value on creation for the "use_ignore_files" field.
+	DefaultUseIgnoreFiles bool
+	// DefaultContentCommand holds the default value on creation for the "content_command" field.
+	DefaultContentCommand string
+	// DefaultContentStdinName holds the default value on creation for the "content_stdin_name" field.
+	DefaultContentStdinName string
 	// CompressionLevelValidator is a validator for the "compression_level" field. It is called by the builders before save.
 	CompressionLevelValidator func(int) error
+	// DefaultOneFileSystem holds the default value on creation for the "one_file_system" field.
+	DefaultOneFileSystem bool
+	// DefaultNumericIds holds the default value on creation for the "numeric_ids" field.
+	DefaultNumericIds bool
+	// DefaultNoFlags holds the default value on creation for the "no_flags" field.
+	DefaultNoFlags bool
+	// DefaultNoAcls holds the default value on creation for the "no_acls" field.
+	DefaultNoAcls bool
+	// DefaultNoXattrs holds the default value on creation for the "no_xattrs" field.
+	DefaultNoXattrs bool
+	// DefaultSparse holds the default value on creation for the "sparse" field.
+	DefaultSparse bool
+	// DefaultReadSpecial holds the default value on creation for the "read_special" field.
+	DefaultReadSpecial bool
+	// CheckpointIntervalValidator is a validator for the "checkpoint_interval" field. It is called by the builders before save.
+	CheckpointIntervalValidator func(int) error
 	// DefaultDataSectionCollapsed holds the default value on creation for the "data_section_collapsed" field.
 	DefaultDataSectionCollapsed bool
 	// DefaultScheduleSectionCollapsed holds the default value on creation for the "schedule_section_collapsed" field.
@@ -238,6 +312,21 @@ func ByExcludeCaches(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExcludeCaches, opts...).ToFunc()
 }
 
+// ByUseIgnoreFiles orders the results by the use_ignore_files field.
+func ByUseIgnoreFiles(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUseIgnoreFiles, opts...).ToFunc()
+}
+
+// ByContentCommand orders the results by the content_command field.
+func ByContentCommand(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentCommand, opts...).ToFunc()
+}
+
+// ByContentStdinName orders the results by the content_stdin_name field.
+func ByContentStdinName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentStdinName, opts...).ToFunc()
+}
+
 // ByIcon orders the results by the icon field.
 func ByIcon(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIcon, opts...).ToFunc()
@@ -253,6 +342,46 @@ func ByCompressionLevel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCompressionLevel, opts...).ToFunc()
 }
 
+// ByOneFileSystem orders the results by the one_file_system field.
+func ByOneFileSystem(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOneFileSystem, opts...).ToFunc()
+}
+
+// ByNumericIds orders the results by the numeric_ids field.
+func ByNumericIds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumericIds, opts...).ToFunc()
+}
+
+// ByNoFlags orders the results by the no_flags field.
+func ByNoFlags(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoFlags, opts...).ToFunc()
+}
+
+// ByNoAcls orders the results by the no_acls field.
+func ByNoAcls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoAcls, opts...).ToFunc()
+}
+
+// ByNoXattrs orders the results by the no_xattrs field.
+func ByNoXattrs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNoXattrs, opts...).ToFunc()
+}
+
+// BySparse orders the results by the sparse field.
+func BySparse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSparse, opts...).ToFunc()
+}
+
+// ByReadSpecial orders the results by the read_special field.
+func ByReadSpecial(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadSpecial, opts...).ToFunc()
+}
+
+// ByCheckpointInterval orders the results by the checkpoint_interval field.
+func ByCheckpointInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckpointInterval, opts...).ToFunc()
+}
+
 // ByDataSectionCollapsed orders the results by the data_section_collapsed field.
 func ByDataSectionCollapsed(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDataSectionCollapsed, opts...).ToFunc()
@@ -310,6 +439,20 @@ func ByPruningRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByHooksCount orders the results by hooks count.
+func ByHooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHooksStep(), opts...)
+	}
+}
+
+// ByHooks orders the results by hooks terms.
+func ByHooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByNotificationsCount orders the results by notifications count.
 func ByNotificationsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -351,6 +494,13 @@ func newPruningRuleStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, PruningRuleTable, PruningRuleColumn),
 	)
 }
+func newHooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HooksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HooksTable, HooksColumn),
+	)
+}
 func newNotificationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
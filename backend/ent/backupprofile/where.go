@@ -80,11 +80,66 @@ func ExcludeCaches(v bool) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldExcludeCaches, v))
 }
 
+// UseIgnoreFiles applies equality check predicate on the "use_ignore_files" field. It's identical to UseIgnoreFilesEQ.
+func UseIgnoreFiles(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldUseIgnoreFiles, v))
+}
+
+// ContentCommand applies equality check predicate on the "content_command" field. It's identical to ContentCommandEQ.
+func ContentCommand(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldContentCommand, v))
+}
+
+// ContentStdinName applies equality check predicate on the "content_stdin_name" field. It's identical to ContentStdinNameEQ.
+func ContentStdinName(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldContentStdinName, v))
+}
+
 // CompressionLevel applies equality check predicate on the "compression_level" field. It's identical to CompressionLevelEQ.
 func CompressionLevel(v int) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldCompressionLevel, v))
 }
 
+// OneFileSystem applies equality check predicate on the "one_file_system" field. It's identical to OneFileSystemEQ.
+func OneFileSystem(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldOneFileSystem, v))
+}
+
+// NumericIds applies equality check predicate on the "numeric_ids" field. It's identical to NumericIdsEQ.
+func NumericIds(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNumericIds, v))
+}
+
+// NoFlags applies equality check predicate on the "no_flags" field. It's identical to NoFlagsEQ.
+func NoFlags(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoFlags, v))
+}
+
+// NoAcls applies equality check predicate on the "no_acls" field. It's identical to NoAclsEQ.
+func NoAcls(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoAcls, v))
+}
+
+// NoXattrs applies equality check predicate on the "no_xattrs" field. It's identical to NoXattrsEQ.
+func NoXattrs(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoXattrs, v))
+}
+
+// Sparse applies equality check predicate on the "sparse" field. It's identical to SparseEQ.
+func Sparse(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldSparse, v))
+}
+
+// ReadSpecial applies equality check predicate on the "read_special" field. It's identical to ReadSpecialEQ.
+func ReadSpecial(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldReadSpecial, v))
+}
+
+// CheckpointInterval applies equality check predicate on the "checkpoint_interval" field. It's identical to CheckpointIntervalEQ.
+func CheckpointInterval(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldCheckpointInterval, v))
+}
+
 // DataSectionCollapsed applies equality check predicate on the "data_section_collapsed" field. It's identical to DataSectionCollapsedEQ.
 func DataSectionCollapsed(v bool) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldDataSectionCollapsed, v))
@@ -320,6 +375,16 @@ func ExcludePathsNotNil() predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldNotNull(FieldExcludePaths))
 }
 
+// PatternsIsNil applies the IsNil predicate on the "patterns" field.
+func PatternsIsNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIsNull(FieldPatterns))
+}
+
+// PatternsNotNil applies the NotNil predicate on the "patterns" field.
+func PatternsNotNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotNull(FieldPatterns))
+}
+
 // ExcludeCachesEQ applies the EQ predicate on the "exclude_caches" field.
 func ExcludeCachesEQ(v bool) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldExcludeCaches, v))
@@ -330,6 +395,156 @@ func ExcludeCachesNEQ(v bool) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldNEQ(FieldExcludeCaches, v))
 }
 
+// ExcludeIfPresentIsNil applies the IsNil predicate on the "exclude_if_present" field.
+func ExcludeIfPresentIsNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIsNull(FieldExcludeIfPresent))
+}
+
+// ExcludeIfPresentNotNil applies the NotNil predicate on the "exclude_if_present" field.
+func ExcludeIfPresentNotNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotNull(FieldExcludeIfPresent))
+}
+
+// UseIgnoreFilesEQ applies the EQ predicate on the "use_ignore_files" field.
+func UseIgnoreFilesEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldUseIgnoreFiles, v))
+}
+
+// UseIgnoreFilesNEQ applies the NEQ predicate on the "use_ignore_files" field.
+func UseIgnoreFilesNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldUseIgnoreFiles, v))
+}
+
+// ContentCommandEQ applies the EQ predicate on the "content_command" field.
+func ContentCommandEQ(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldContentCommand, v))
+}
+
+// ContentCommandNEQ applies the NEQ predicate on the "content_command" field.
+func ContentCommandNEQ(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldContentCommand, v))
+}
+
+// ContentCommandIn applies the In predicate on the "content_command" field.
+func ContentCommandIn(vs ...string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIn(FieldContentCommand, vs...))
+}
+
+// ContentCommandNotIn applies the NotIn predicate on the "content_command" field.
+func ContentCommandNotIn(vs ...string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotIn(FieldContentCommand, vs...))
+}
+
+// ContentCommandGT applies the GT predicate on the "content_command" field.
+func ContentCommandGT(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGT(FieldContentCommand, v))
+}
+
+// ContentCommandGTE applies the GTE predicate on the "content_command" field.
+func ContentCommandGTE(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGTE(FieldContentCommand, v))
+}
+
+// ContentCommandLT applies the LT predicate on the "content_command" field.
+func ContentCommandLT(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLT(FieldContentCommand, v))
+}
+
+// ContentCommandLTE applies the LTE predicate on the "content_command" field.
+func ContentCommandLTE(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLTE(FieldContentCommand, v))
+}
+
+// ContentCommandContains applies the Contains predicate on the "content_command" field.
+func ContentCommandContains(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldContains(FieldContentCommand, v))
+}
+
+// ContentCommandHasPrefix applies the HasPrefix predicate on the "content_command" field.
+func ContentCommandHasPrefix(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldHasPrefix(FieldContentCommand, v))
+}
+
+// ContentCommandHasSuffix applies the HasSuffix predicate on the "content_command" field.
+func ContentCommandHasSuffix(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldHasSuffix(FieldContentCommand, v))
+}
+
+// ContentCommandEqualFold applies the EqualFold predicate on the "content_command" field.
+func ContentCommandEqualFold(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEqualFold(FieldContentCommand, v))
+}
+
+// ContentCommandContainsFold applies the ContainsFold predicate on the "content_command" field.
+func ContentCommandContainsFold(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldContainsFold(FieldContentCommand, v))
+}
+
+// ContentStdinNameEQ applies the EQ predicate on the "content_stdin_name" field.
+func ContentStdinNameEQ(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldContentStdinName, v))
+}
+
+// ContentStdinNameNEQ applies the NEQ predicate on the "content_stdin_name" field.
+func ContentStdinNameNEQ(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldContentStdinName, v))
+}
+
+// ContentStdinNameIn applies the In predicate on the "content_stdin_name" field.
+func ContentStdinNameIn(vs ...string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIn(FieldContentStdinName, vs...))
+}
+
+// ContentStdinNameNotIn applies the NotIn predicate on the "content_stdin_name" field.
+func ContentStdinNameNotIn(vs ...string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotIn(FieldContentStdinName, vs...))
+}
+
+// ContentStdinNameGT applies the GT predicate on the "content_stdin_name" field.
+func ContentStdinNameGT(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGT(FieldContentStdinName, v))
+}
+
+// ContentStdinNameGTE applies the GTE predicate on the "content_stdin_name" field.
+func ContentStdinNameGTE(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGTE(FieldContentStdinName, v))
+}
+
+// ContentStdinNameLT applies the LT predicate on the "content_stdin_name" field.
+func ContentStdinNameLT(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLT(FieldContentStdinName, v))
+}
+
+// ContentStdinNameLTE applies the LTE predicate on the "content_stdin_name" field.
+func ContentStdinNameLTE(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLTE(FieldContentStdinName, v))
+}
+
+// ContentStdinNameContains applies the Contains predicate on the "content_stdin_name" field.
+func ContentStdinNameContains(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldContains(FieldContentStdinName, v))
+}
+
+// ContentStdinNameHasPrefix applies the HasPrefix predicate on the "content_stdin_name" field.
+func ContentStdinNameHasPrefix(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldHasPrefix(FieldContentStdinName, v))
+}
+
+// ContentStdinNameHasSuffix applies the HasSuffix predicate on the "content_stdin_name" field.
+func ContentStdinNameHasSuffix(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldHasSuffix(FieldContentStdinName, v))
+}
+
+// ContentStdinNameEqualFold applies the EqualFold predicate on the "content_stdin_name" field.
+func ContentStdinNameEqualFold(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEqualFold(FieldContentStdinName, v))
+}
+
+// ContentStdinNameContainsFold applies the ContainsFold predicate on the "content_stdin_name" field.
+func ContentStdinNameContainsFold(v string) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldContainsFold(FieldContentStdinName, v))
+}
+
 // IconEQ applies the EQ predicate on the "icon" field.
 func IconEQ(v Icon) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldIcon, v))
@@ -420,6 +635,126 @@ func CompressionLevelNotNil() predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldNotNull(FieldCompressionLevel))
 }
 
+// OneFileSystemEQ applies the EQ predicate on the "one_file_system" field.
+func OneFileSystemEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldOneFileSystem, v))
+}
+
+// OneFileSystemNEQ applies the NEQ predicate on the "one_file_system" field.
+func OneFileSystemNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldOneFileSystem, v))
+}
+
+// NumericIdsEQ applies the EQ predicate on the "numeric_ids" field.
+func NumericIdsEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNumericIds, v))
+}
+
+// NumericIdsNEQ applies the NEQ predicate on the "numeric_ids" field.
+func NumericIdsNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldNumericIds, v))
+}
+
+// NoFlagsEQ applies the EQ predicate on the "no_flags" field.
+func NoFlagsEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoFlags, v))
+}
+
+// NoFlagsNEQ applies the NEQ predicate on the "no_flags" field.
+func NoFlagsNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldNoFlags, v))
+}
+
+// NoAclsEQ applies the EQ predicate on the "no_acls" field.
+func NoAclsEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoAcls, v))
+}
+
+// NoAclsNEQ applies the NEQ predicate on the "no_acls" field.
+func NoAclsNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldNoAcls, v))
+}
+
+// NoXattrsEQ applies the EQ predicate on the "no_xattrs" field.
+func NoXattrsEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldNoXattrs, v))
+}
+
+// NoXattrsNEQ applies the NEQ predicate on the "no_xattrs" field.
+func NoXattrsNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldNoXattrs, v))
+}
+
+// SparseEQ applies the EQ predicate on the "sparse" field.
+func SparseEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldSparse, v))
+}
+
+// SparseNEQ applies the NEQ predicate on the "sparse" field.
+func SparseNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldSparse, v))
+}
+
+// ReadSpecialEQ applies the EQ predicate on the "read_special" field.
+func ReadSpecialEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldReadSpecial, v))
+}
+
+// ReadSpecialNEQ applies the NEQ predicate on the "read_special" field.
+func ReadSpecialNEQ(v bool) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldReadSpecial, v))
+}
+
+// CheckpointIntervalEQ applies the EQ predicate on the "checkpoint_interval" field.
+func CheckpointIntervalEQ(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldEQ(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalNEQ applies the NEQ predicate on the "checkpoint_interval" field.
+func CheckpointIntervalNEQ(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNEQ(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalIn applies the In predicate on the "checkpoint_interval" field.
+func CheckpointIntervalIn(vs ...int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIn(FieldCheckpointInterval, vs...))
+}
+
+// CheckpointIntervalNotIn applies the NotIn predicate on the "checkpoint_interval" field.
+func CheckpointIntervalNotIn(vs ...int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotIn(FieldCheckpointInterval, vs...))
+}
+
+// CheckpointIntervalGT applies the GT predicate on the "checkpoint_interval" field.
+func CheckpointIntervalGT(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGT(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalGTE applies the GTE predicate on the "checkpoint_interval" field.
+func CheckpointIntervalGTE(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldGTE(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalLT applies the LT predicate on the "checkpoint_interval" field.
+func CheckpointIntervalLT(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLT(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalLTE applies the LTE predicate on the "checkpoint_interval" field.
+func CheckpointIntervalLTE(v int) predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldLTE(FieldCheckpointInterval, v))
+}
+
+// CheckpointIntervalIsNil applies the IsNil predicate on the "checkpoint_interval" field.
+func CheckpointIntervalIsNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldIsNull(FieldCheckpointInterval))
+}
+
+// CheckpointIntervalNotNil applies the NotNil predicate on the "checkpoint_interval" field.
+func CheckpointIntervalNotNil() predicate.BackupProfile {
+	return predicate.BackupProfile(sql.FieldNotNull(FieldCheckpointInterval))
+}
+
 // DataSectionCollapsedEQ applies the EQ predicate on the "data_section_collapsed" field.
 func DataSectionCollapsedEQ(v bool) predicate.BackupProfile {
 	return predicate.BackupProfile(sql.FieldEQ(FieldDataSectionCollapsed, v))
@@ -542,6 +877,29 @@ func HasPruningRuleWith(preds ...predicate.PruningRule) predicate.BackupProfile
 	})
 }
 
+// HasHooks applies the HasEdge predicate on the "hooks" edge.
+func HasHooks() predicate.BackupProfile {
+	return predicate.BackupProfile(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HooksTable, HooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHooksWith applies the HasEdge predicate on the "hooks" edge with a given conditions (other predicates).
+func HasHooksWith(preds ...predicate.BackupHook) predicate.BackupProfile {
+	return predicate.BackupProfile(func(s *sql.Selector) {
+		step := newHooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasNotifications applies the HasEdge predicate on the "notifications" edge.
 func HasNotifications() predicate.BackupProfile {
 	return predicate.BackupProfile(func(s *sql.Selector) {
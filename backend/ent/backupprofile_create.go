@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/notification"
@@ -77,6 +78,12 @@ func (_c *BackupProfileCreate) SetExcludePaths(v []string) *BackupProfileCreate
 	return _c
 }
 
+// SetPatterns sets the "patterns" field.
+func (_c *BackupProfileCreate) SetPatterns(v []string) *BackupProfileCreate {
+	_c.mutation.SetPatterns(v)
+	return _c
+}
+
 // SetExcludeCaches sets the "exclude_caches" field.
 func (_c *BackupProfileCreate) SetExcludeCaches(v bool) *BackupProfileCreate {
 	_c.mutation.SetExcludeCaches(v)
@@ -91,6 +98,54 @@ func (_c *BackupProfileCreate) SetNillableExcludeCaches(v *bool) *BackupProfileC
 	return _c
 }
 
+// SetExcludeIfPresent sets the "exclude_if_present" field.
+func (_c *BackupProfileCreate) SetExcludeIfPresent(v []string) *BackupProfileCreate {
+	_c.mutation.SetExcludeIfPresent(v)
+	return _c
+}
+
+// SetUseIgnoreFiles sets the "use_ignore_files" field.
+func (_c *BackupProfileCreate) SetUseIgnoreFiles(v bool) *BackupProfileCreate {
+	_c.mutation.SetUseIgnoreFiles(v)
+	return _c
+}
+
+// SetNillableUseIgnoreFiles sets the "use_ignore_files" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableUseIgnoreFiles(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetUseIgnoreFiles(*v)
+	}
+	return _c
+}
+
+// SetContentCommand sets the "content_command" field.
+func (_c *BackupProfileCreate) SetContentCommand(v string) *BackupProfileCreate {
+	_c.mutation.SetContentCommand(v)
+	return _c
+}
+
+// SetNillableContentCommand sets the "content_command" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableContentCommand(v *string) *BackupProfileCreate {
+	if v != nil {
+		_c.SetContentCommand(*v)
+	}
+	return _c
+}
+
+// SetContentStdinName sets the "content_stdin_name" field.
+func (_c *BackupProfileCreate) SetContentStdinName(v string) *BackupProfileCreate {
+	_c.mutation.SetContentStdinName(v)
+	return _c
+}
+
+// SetNillableContentStdinName sets the "content_stdin_name" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableContentStdinName(v *string) *BackupProfileCreate {
+	if v != nil {
+		_c.SetContentStdinName(*v)
+	}
+	return _c
+}
+
 // SetIcon sets the "icon" field.
 func (_c *BackupProfileCreate) SetIcon(v backupprofile.Icon) *BackupProfileCreate {
 	_c.mutation.SetIcon(v)
@@ -125,6 +180,118 @@ func (_c *BackupProfileCreate) SetNillableCompressionLevel(v *int) *BackupProfil
 	return _c
 }
 
+// SetOneFileSystem sets the "one_file_system" field.
+func (_c *BackupProfileCreate) SetOneFileSystem(v bool) *BackupProfileCreate {
+	_c.mutation.SetOneFileSystem(v)
+	return _c
+}
+
+// SetNillableOneFileSystem sets the "one_file_system" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableOneFileSystem(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetOneFileSystem(*v)
+	}
+	return _c
+}
+
+// SetNumericIds sets the "numeric_ids" field.
+func (_c *BackupProfileCreate) SetNumericIds(v bool) *BackupProfileCreate {
+	_c.mutation.SetNumericIds(v)
+	return _c
+}
+
+// SetNillableNumericIds sets the "numeric_ids" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableNumericIds(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetNumericIds(*v)
+	}
+	return _c
+}
+
+// SetNoFlags sets the "no_flags" field.
+func (_c *BackupProfileCreate) SetNoFlags(v bool) *BackupProfileCreate {
+	_c.mutation.SetNoFlags(v)
+	return _c
+}
+
+// SetNillableNoFlags sets the "no_flags" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableNoFlags(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetNoFlags(*v)
+	}
+	return _c
+}
+
+// SetNoAcls sets the "no_acls" field.
+func (_c *BackupProfileCreate) SetNoAcls(v bool) *BackupProfileCreate {
+	_c.mutation.SetNoAcls(v)
+	return _c
+}
+
+// SetNillableNoAcls sets the "no_acls" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableNoAcls(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetNoAcls(*v)
+	}
+	return _c
+}
+
+// SetNoXattrs sets the "no_xattrs" field.
+func (_c *BackupProfileCreate) SetNoXattrs(v bool) *BackupProfileCreate {
+	_c.mutation.SetNoXattrs(v)
+	return _c
+}
+
+// SetNillableNoXattrs sets the "no_xattrs" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableNoXattrs(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetNoXattrs(*v)
+	}
+	return _c
+}
+
+// SetSparse sets the "sparse" field.
+func (_c *BackupProfileCreate) SetSparse(v bool) *BackupProfileCreate {
+	_c.mutation.SetSparse(v)
+	return _c
+}
+
+// SetNillableSparse sets the "sparse" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableSparse(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetSparse(*v)
+	}
+	return _c
+}
+
+// SetReadSpecial sets the "read_special" field.
+func (_c *BackupProfileCreate) SetReadSpecial(v bool) *BackupProfileCreate {
+	_c.mutation.SetReadSpecial(v)
+	return _c
+}
+
+// SetNillableReadSpecial sets the "read_special" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableReadSpecial(v *bool) *BackupProfileCreate {
+	if v != nil {
+		_c.SetReadSpecial(*v)
+	}
+	return _c
+}
+
+// SetCheckpointInterval sets the "checkpoint_interval" field.
+func (_c *BackupProfileCreate) SetCheckpointInterval(v int) *BackupProfileCreate {
+	_c.mutation.SetCheckpointInterval(v)
+	return _c
+}
+
+// SetNillableCheckpointInterval sets the "checkpoint_interval" field if the given value is not nil.
+func (_c *BackupProfileCreate) SetNillableCheckpointInterval(v *int) *BackupProfileCreate {
+	if v != nil {
+		_c.SetCheckpointInterval(*v)
+	}
+	return _c
+}
+
 // SetDataSectionCollapsed sets the "data_section_collapsed" field.
 func (_c *BackupProfileCreate) SetDataSectionCollapsed(v bool) *BackupProfileCreate {
 	_c.mutation.SetDataSectionCollapsed(v)
@@ -241,6 +408,21 @@ func (_c *BackupProfileCreate) SetPruningRule(v *PruningRule) *BackupProfileCrea
 	return _c.SetPruningRuleID(v.ID)
 }
 
+// AddHookIDs adds the "hooks" edge to the BackupHook entity by IDs.
+func (_c *BackupProfileCreate) AddHookIDs(ids ...int) *BackupProfileCreate {
+	_c.mutation.AddHookIDs(ids...)
+	return _c
+}
+
+// AddHooks adds the "hooks" edges to the BackupHook entity.
+func (_c *BackupProfileCreate) AddHooks(v ...*BackupHook) *BackupProfileCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddHookIDs(ids...)
+}
+
 // AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
 func (_c *BackupProfileCreate) AddNotificationIDs(ids ...int) *BackupProfileCreate {
 	_c.mutation.AddNotificationIDs(ids...)
@@ -307,14 +489,62 @@ func (_c *BackupProfileCreate) defaults() {
 		v := backupprofile.DefaultExcludePaths
 		_c.mutation.SetExcludePaths(v)
 	}
+	if _, ok := _c.mutation.Patterns(); !ok {
+		v := backupprofile.DefaultPatterns
+		_c.mutation.SetPatterns(v)
+	}
 	if _, ok := _c.mutation.ExcludeCaches(); !ok {
 		v := backupprofile.DefaultExcludeCaches
 		_c.mutation.SetExcludeCaches(v)
 	}
+	if _, ok := _c.mutation.ExcludeIfPresent(); !ok {
+		v := backupprofile.DefaultExcludeIfPresent
+		_c.mutation.SetExcludeIfPresent(v)
+	}
+	if _, ok := _c.mutation.UseIgnoreFiles(); !ok {
+		v := backupprofile.DefaultUseIgnoreFiles
+		_c.mutation.SetUseIgnoreFiles(v)
+	}
+	if _, ok := _c.mutation.ContentCommand(); !ok {
+		v := backupprofile.DefaultContentCommand
+		_c.mutation.SetContentCommand(v)
+	}
+	if _, ok := _c.mutation.ContentStdinName(); !ok {
+		v := backupprofile.DefaultContentStdinName
+		_c.mutation.SetContentStdinName(v)
+	}
 	if _, ok := _c.mutation.CompressionMode(); !ok {
 		v := backupprofile.DefaultCompressionMode
 		_c.mutation.SetCompressionMode(v)
 	}
+	if _, ok := _c.mutation.OneFileSystem(); !ok {
+		v := backupprofile.DefaultOneFileSystem
+		_c.mutation.SetOneFileSystem(v)
+	}
+	if _, ok := _c.mutation.NumericIds(); !ok {
+		v := backupprofile.DefaultNumericIds
+		_c.mutation.SetNumericIds(v)
+	}
+	if _, ok := _c.mutation.NoFlags(); !ok {
+		v := backupprofile.DefaultNoFlags
+		_c.mutation.SetNoFlags(v)
+	}
+	if _, ok := _c.mutation.NoAcls(); !ok {
+		v := backupprofile.DefaultNoAcls
+		_c.mutation.SetNoAcls(v)
+	}
+	if _, ok := _c.mutation.NoXattrs(); !ok {
+		v := backupprofile.DefaultNoXattrs
+		_c.mutation.SetNoXattrs(v)
+	}
+	if _, ok := _c.mutation.Sparse(); !ok {
+		v := backupprofile.DefaultSparse
+		_c.mutation.SetSparse(v)
+	}
+	if _, ok := _c.mutation.ReadSpecial(); !ok {
+		v := backupprofile.DefaultReadSpecial
+		_c.mutation.SetReadSpecial(v)
+	}
 	if _, ok := _c.mutation.DataSectionCollapsed(); !ok {
 		v := backupprofile.DefaultDataSectionCollapsed
 		_c.mutation.SetDataSectionCollapsed(v)
@@ -359,6 +589,15 @@ func (_c *BackupProfileCreate) check() error {
 	if _, ok := _c.mutation.ExcludeCaches(); !ok {
 		return &ValidationError{Name: "exclude_caches", err: errors.New(`ent: missing required field "BackupProfile.exclude_caches"`)}
 	}
+	if _, ok := _c.mutation.UseIgnoreFiles(); !ok {
+		return &ValidationError{Name: "use_ignore_files", err: errors.New(`ent: missing required field "BackupProfile.use_ignore_files"`)}
+	}
+	if _, ok := _c.mutation.ContentCommand(); !ok {
+		return &ValidationError{Name: "content_command", err: errors.New(`ent: missing required field "BackupProfile.content_command"`)}
+	}
+	if _, ok := _c.mutation.ContentStdinName(); !ok {
+		return &ValidationError{Name: "content_stdin_name", err: errors.New(`ent: missing required field "BackupProfile.content_stdin_name"`)}
+	}
 	if _, ok := _c.mutation.Icon(); !ok {
 		return &ValidationError{Name: "icon", err: errors.New(`ent: missing required field "BackupProfile.icon"`)}
 	}
@@ -380,6 +619,32 @@ func (_c *BackupProfileCreate) check() error {
 			return &ValidationError{Name: "compression_level", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.compression_level": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.OneFileSystem(); !ok {
+		return &ValidationError{Name: "one_file_system", err: errors.New(`ent: missing required field "BackupProfile.one_file_system"`)}
+	}
+	if _, ok := _c.mutation.NumericIds(); !ok {
+		return &ValidationError{Name: "numeric_ids", err: errors.New(`ent: missing required field "BackupProfile.numeric_ids"`)}
+	}
+	if _, ok := _c.mutation.NoFlags(); !ok {
+		return &ValidationError{Name: "no_flags", err: errors.New(`ent: missing required field "BackupProfile.no_flags"`)}
+	}
+	if _, ok := _c.mutation.NoAcls(); !ok {
+		return &ValidationError{Name: "no_acls", err: errors.New(`ent: missing required field "BackupProfile.no_acls"`)}
+	}
+	if _, ok := _c.mutation.NoXattrs(); !ok {
+		return &ValidationError{Name: "no_xattrs", err: errors.New(`ent: missing required field "BackupProfile.no_xattrs"`)}
+	}
+	if _, ok := _c.mutation.Sparse(); !ok {
+		return &ValidationError{Name: "sparse", err: errors.New(`ent: missing required field "BackupProfile.sparse"`)}
+	}
+	if _, ok := _c.mutation.ReadSpecial(); !ok {
+		return &ValidationError{Name: "read_special", err: errors.New(`ent: missing required field "BackupProfile.read_special"`)}
+	}
+	if v, ok := _c.mutation.CheckpointInterval(); ok {
+		if err := backupprofile.CheckpointIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "checkpoint_interval", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.checkpoint_interval": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.DataSectionCollapsed(); !ok {
 		return &ValidationError{Name: "data_section_collapsed", err: errors.New(`ent: missing required field "BackupProfile.data_section_collapsed"`)}
 	}
@@ -448,10 +713,30 @@ func (_c *BackupProfileCreate) createSpec() (*BackupProfile, *sqlgraph.CreateSpe
 		_spec.SetField(backupprofile.FieldExcludePaths, field.TypeJSON, value)
 		_node.ExcludePaths = value
 	}
+	if value, ok := _c.mutation.Patterns(); ok {
+		_spec.SetField(backupprofile.FieldPatterns, field.TypeJSON, value)
+		_node.Patterns = value
+	}
 	if value, ok := _c.mutation.ExcludeCaches(); ok {
 		_spec.SetField(backupprofile.FieldExcludeCaches, field.TypeBool, value)
 		_node.ExcludeCaches = value
 	}
+	if value, ok := _c.mutation.ExcludeIfPresent(); ok {
+		_spec.SetField(backupprofile.FieldExcludeIfPresent, field.TypeJSON, value)
+		_node.ExcludeIfPresent = value
+	}
+	if value, ok := _c.mutation.UseIgnoreFiles(); ok {
+		_spec.SetField(backupprofile.FieldUseIgnoreFiles, field.TypeBool, value)
+		_node.UseIgnoreFiles = value
+	}
+	if value, ok := _c.mutation.ContentCommand(); ok {
+		_spec.SetField(backupprofile.FieldContentCommand, field.TypeString, value)
+		_node.ContentCommand = value
+	}
+	if value, ok := _c.mutation.ContentStdinName(); ok {
+		_spec.SetField(backupprofile.FieldContentStdinName, field.TypeString, value)
+		_node.ContentStdinName = value
+	}
 	if value, ok := _c.mutation.Icon(); ok {
 		_spec.SetField(backupprofile.FieldIcon, field.TypeEnum, value)
 		_node.Icon = value
@@ -464,6 +749,38 @@ func (_c *BackupProfileCreate) createSpec() (*BackupProfile, *sqlgraph.CreateSpe
 		_spec.SetField(backupprofile.FieldCompressionLevel, field.TypeInt, value)
 		_node.CompressionLevel = &value
 	}
+	if value, ok := _c.mutation.OneFileSystem(); ok {
+		_spec.SetField(backupprofile.FieldOneFileSystem, field.TypeBool, value)
+		_node.OneFileSystem = value
+	}
+	if value, ok := _c.mutation.NumericIds(); ok {
+		_spec.SetField(backupprofile.FieldNumericIds, field.TypeBool, value)
+		_node.NumericIds = value
+	}
+	if value, ok := _c.mutation.NoFlags(); ok {
+		_spec.SetField(backupprofile.FieldNoFlags, field.TypeBool, value)
+		_node.NoFlags = value
+	}
+	if value, ok := _c.mutation.NoAcls(); ok {
+		_spec.SetField(backupprofile.FieldNoAcls, field.TypeBool, value)
+		_node.NoAcls = value
+	}
+	if value, ok := _c.mutation.NoXattrs(); ok {
+		_spec.SetField(backupprofile.FieldNoXattrs, field.TypeBool, value)
+		_node.NoXattrs = value
+	}
+	if value, ok := _c.mutation.Sparse(); ok {
+		_spec.SetField(backupprofile.FieldSparse, field.TypeBool, value)
+		_node.Sparse = value
+	}
+	if value, ok := _c.mutation.ReadSpecial(); ok {
+		_spec.SetField(backupprofile.FieldReadSpecial, field.TypeBool, value)
+		_node.ReadSpecial = value
+	}
+	if value, ok := _c.mutation.CheckpointInterval(); ok {
+		_spec.SetField(backupprofile.FieldCheckpointInterval, field.TypeInt, value)
+		_node.CheckpointInterval = &value
+	}
 	if value, ok := _c.mutation.DataSectionCollapsed(); ok {
 		_spec.SetField(backupprofile.FieldDataSectionCollapsed, field.TypeBool, value)
 		_node.DataSectionCollapsed = value
@@ -540,6 +857,22 @@ func (_c *BackupProfileCreate) createSpec() (*BackupProfile, *sqlgraph.CreateSpe
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.HooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.NotificationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/notification"
@@ -32,6 +33,7 @@ type BackupProfileQuery struct {
 	withArchives       *ArchiveQuery
 	withBackupSchedule *BackupScheduleQuery
 	withPruningRule    *PruningRuleQuery
+	withHooks          *BackupHookQuery
 	withNotifications  *NotificationQuery
 	modifiers          []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -158,6 +160,28 @@ func (_q *BackupProfileQuery) QueryPruningRule() *PruningRuleQuery {
 	return query
 }
 
+// QueryHooks chains the current query on the "hooks" edge.
+func (_q *BackupProfileQuery) QueryHooks() *BackupHookQuery {
+	query := (&BackupHookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backupprofile.Table, backupprofile.FieldID, selector),
+			sqlgraph.To(backuphook.Table, backuphook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backupprofile.HooksTable, backupprofile.HooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryNotifications chains the current query on the "notifications" edge.
 func (_q *BackupProfileQuery) QueryNotifications() *NotificationQuery {
 	query := (&NotificationClient{config: _q.config}).Query()
@@ -376,6 +400,7 @@ func (_q *BackupProfileQuery) Clone() *BackupProfileQuery {
 		withArchives:       _q.withArchives.Clone(),
 		withBackupSchedule: _q.withBackupSchedule.Clone(),
 		withPruningRule:    _q.withPruningRule.Clone(),
+		withHooks:          _q.withHooks.Clone(),
 		withNotifications:  _q.withNotifications.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -428,6 +453,17 @@ func (_q *BackupProfileQuery) WithPruningRule(opts ...func(*PruningRuleQuery)) *
 	return _q
 }
 
+// WithHooks tells the query-builder to eager-load the nodes that are connected to
+// the "hooks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BackupProfileQuery) WithHooks(opts ...func(*BackupHookQuery)) *BackupProfileQuery {
+	query := (&BackupHookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withHooks = query
+	return _q
+}
+
 // WithNotifications tells the query-builder to eager-load the nodes that are connected to
 // the "notifications" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *BackupProfileQuery) WithNotifications(opts ...func(*NotificationQuery)) *BackupProfileQuery {
@@ -517,11 +553,12 @@ func (_q *BackupProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*BackupProfile{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [6]bool{
 			_q.withRepositories != nil,
 			_q.withArchives != nil,
 			_q.withBackupSchedule != nil,
 			_q.withPruningRule != nil,
+			_q.withHooks != nil,
 			_q.withNotifications != nil,
 		}
 	)
@@ -572,6 +609,13 @@ func (_q *BackupProfileQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withHooks; query != nil {
+		if err := _q.loadHooks(ctx, query, nodes,
+			func(n *BackupProfile) { n.Edges.Hooks = []*BackupHook{} },
+			func(n *BackupProfile, e *BackupHook) { n.Edges.Hooks = append(n.Edges.Hooks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withNotifications; query != nil {
 		if err := _q.loadNotifications(ctx, query, nodes,
 			func(n *BackupProfile) { n.Edges.Notifications = []*Notification{} },
@@ -730,6 +774,37 @@ func (_q *BackupProfileQuery) loadPruningRule(ctx context.Context, query *Prunin
 	}
 	return nil
 }
+func (_q *BackupProfileQuery) loadHooks(ctx context.Context, query *BackupHookQuery, nodes []*BackupProfile, init func(*BackupProfile), assign func(*BackupProfile, *BackupHook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*BackupProfile)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BackupHook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(backupprofile.HooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.backup_profile_hooks
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "backup_profile_hooks" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "backup_profile_hooks" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *BackupProfileQuery) loadNotifications(ctx context.Context, query *NotificationQuery, nodes []*BackupProfile, init func(*BackupProfile), assign func(*BackupProfile, *Notification)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[int]*BackupProfile)
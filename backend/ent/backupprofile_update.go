@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/notification"
@@ -85,6 +86,24 @@ func (_u *BackupProfileUpdate) ClearExcludePaths() *BackupProfileUpdate {
 	return _u
 }
 
+// SetPatterns sets the "patterns" field.
+func (_u *BackupProfileUpdate) SetPatterns(v []string) *BackupProfileUpdate {
+	_u.mutation.SetPatterns(v)
+	return _u
+}
+
+// AppendPatterns appends value to the "patterns" field.
+func (_u *BackupProfileUpdate) AppendPatterns(v []string) *BackupProfileUpdate {
+	_u.mutation.AppendPatterns(v)
+	return _u
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (_u *BackupProfileUpdate) ClearPatterns() *BackupProfileUpdate {
+	_u.mutation.ClearPatterns()
+	return _u
+}
+
 // SetExcludeCaches sets the "exclude_caches" field.
 func (_u *BackupProfileUpdate) SetExcludeCaches(v bool) *BackupProfileUpdate {
 	_u.mutation.SetExcludeCaches(v)
@@ -99,6 +118,66 @@ func (_u *BackupProfileUpdate) SetNillableExcludeCaches(v *bool) *BackupProfileU
 	return _u
 }
 
+// SetExcludeIfPresent sets the "exclude_if_present" field.
+func (_u *BackupProfileUpdate) SetExcludeIfPresent(v []string) *BackupProfileUpdate {
+	_u.mutation.SetExcludeIfPresent(v)
+	return _u
+}
+
+// AppendExcludeIfPresent appends value to the "exclude_if_present" field.
+func (_u *BackupProfileUpdate) AppendExcludeIfPresent(v []string) *BackupProfileUpdate {
+	_u.mutation.AppendExcludeIfPresent(v)
+	return _u
+}
+
+// ClearExcludeIfPresent clears the value of the "exclude_if_present" field.
+func (_u *BackupProfileUpdate) ClearExcludeIfPresent() *BackupProfileUpdate {
+	_u.mutation.ClearExcludeIfPresent()
+	return _u
+}
+
+// SetUseIgnoreFiles sets the "use_ignore_files" field.
+func (_u *BackupProfileUpdate) SetUseIgnoreFiles(v bool) *BackupProfileUpdate {
+	_u.mutation.SetUseIgnoreFiles(v)
+	return _u
+}
+
+// SetNillableUseIgnoreFiles sets the "use_ignore_files" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableUseIgnoreFiles(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetUseIgnoreFiles(*v)
+	}
+	return _u
+}
+
+// SetContentCommand sets the "content_command" field.
+func (_u *BackupProfileUpdate) SetContentCommand(v string) *BackupProfileUpdate {
+	_u.mutation.SetContentCommand(v)
+	return _u
+}
+
+// SetNillableContentCommand sets the "content_command" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableContentCommand(v *string) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetContentCommand(*v)
+	}
+	return _u
+}
+
+// SetContentStdinName sets the "content_stdin_name" field.
+func (_u *BackupProfileUpdate) SetContentStdinName(v string) *BackupProfileUpdate {
+	_u.mutation.SetContentStdinName(v)
+	return _u
+}
+
+// SetNillableContentStdinName sets the "content_stdin_name" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableContentStdinName(v *string) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetContentStdinName(*v)
+	}
+	return _u
+}
+
 // SetIcon sets the "icon" field.
 func (_u *BackupProfileUpdate) SetIcon(v backupprofile.Icon) *BackupProfileUpdate {
 	_u.mutation.SetIcon(v)
@@ -154,6 +233,131 @@ func (_u *BackupProfileUpdate) ClearCompressionLevel() *BackupProfileUpdate {
 	return _u
 }
 
+// SetOneFileSystem sets the "one_file_system" field.
+func (_u *BackupProfileUpdate) SetOneFileSystem(v bool) *BackupProfileUpdate {
+	_u.mutation.SetOneFileSystem(v)
+	return _u
+}
+
+// SetNillableOneFileSystem sets the "one_file_system" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableOneFileSystem(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetOneFileSystem(*v)
+	}
+	return _u
+}
+
+// SetNumericIds sets the "numeric_ids" field.
+func (_u *BackupProfileUpdate) SetNumericIds(v bool) *BackupProfileUpdate {
+	_u.mutation.SetNumericIds(v)
+	return _u
+}
+
+// SetNillableNumericIds sets the "numeric_ids" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableNumericIds(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetNumericIds(*v)
+	}
+	return _u
+}
+
+// SetNoFlags sets the "no_flags" field.
+func (_u *BackupProfileUpdate) SetNoFlags(v bool) *BackupProfileUpdate {
+	_u.mutation.SetNoFlags(v)
+	return _u
+}
+
+// SetNillableNoFlags sets the "no_flags" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableNoFlags(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetNoFlags(*v)
+	}
+	return _u
+}
+
+// SetNoAcls sets the "no_acls" field.
+func (_u *BackupProfileUpdate) SetNoAcls(v bool) *BackupProfileUpdate {
+	_u.mutation.SetNoAcls(v)
+	return _u
+}
+
+// SetNillableNoAcls sets the "no_acls" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableNoAcls(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetNoAcls(*v)
+	}
+	return _u
+}
+
+// SetNoXattrs sets the "no_xattrs" field.
+func (_u *BackupProfileUpdate) SetNoXattrs(v bool) *BackupProfileUpdate {
+	_u.mutation.SetNoXattrs(v)
+	return _u
+}
+
+// SetNillableNoXattrs sets the "no_xattrs" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableNoXattrs(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetNoXattrs(*v)
+	}
+	return _u
+}
+
+// SetSparse sets the "sparse" field.
+func (_u *BackupProfileUpdate) SetSparse(v bool) *BackupProfileUpdate {
+	_u.mutation.SetSparse(v)
+	return _u
+}
+
+// SetNillableSparse sets the "sparse" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableSparse(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetSparse(*v)
+	}
+	return _u
+}
+
+// SetReadSpecial sets the "read_special" field.
+func (_u *BackupProfileUpdate) SetReadSpecial(v bool) *BackupProfileUpdate {
+	_u.mutation.SetReadSpecial(v)
+	return _u
+}
+
+// SetNillableReadSpecial sets the "read_special" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableReadSpecial(v *bool) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetReadSpecial(*v)
+	}
+	return _u
+}
+
+// SetCheckpointInterval sets the "checkpoint_interval" field.
+func (_u *BackupProfileUpdate) SetCheckpointInterval(v int) *BackupProfileUpdate {
+	_u.mutation.ResetCheckpointInterval()
+	_u.mutation.SetCheckpointInterval(v)
+	return _u
+}
+
+// SetNillableCheckpointInterval sets the "checkpoint_interval" field if the given value is not nil.
+func (_u *BackupProfileUpdate) SetNillableCheckpointInterval(v *int) *BackupProfileUpdate {
+	if v != nil {
+		_u.SetCheckpointInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckpointInterval adds value to the "checkpoint_interval" field.
+func (_u *BackupProfileUpdate) AddCheckpointInterval(v int) *BackupProfileUpdate {
+	_u.mutation.AddCheckpointInterval(v)
+	return _u
+}
+
+// ClearCheckpointInterval clears the value of the "checkpoint_interval" field.
+func (_u *BackupProfileUpdate) ClearCheckpointInterval() *BackupProfileUpdate {
+	_u.mutation.ClearCheckpointInterval()
+	return _u
+}
+
 // SetDataSectionCollapsed sets the "data_section_collapsed" field.
 func (_u *BackupProfileUpdate) SetDataSectionCollapsed(v bool) *BackupProfileUpdate {
 	_u.mutation.SetDataSectionCollapsed(v)
@@ -264,6 +468,21 @@ func (_u *BackupProfileUpdate) SetPruningRule(v *PruningRule) *BackupProfileUpda
 	return _u.SetPruningRuleID(v.ID)
 }
 
+// AddHookIDs adds the "hooks" edge to the BackupHook entity by IDs.
+func (_u *BackupProfileUpdate) AddHookIDs(ids ...int) *BackupProfileUpdate {
+	_u.mutation.AddHookIDs(ids...)
+	return _u
+}
+
+// AddHooks adds the "hooks" edges to the BackupHook entity.
+func (_u *BackupProfileUpdate) AddHooks(v ...*BackupHook) *BackupProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHookIDs(ids...)
+}
+
 // AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
 func (_u *BackupProfileUpdate) AddNotificationIDs(ids ...int) *BackupProfileUpdate {
 	_u.mutation.AddNotificationIDs(ids...)
@@ -338,6 +557,27 @@ func (_u *BackupProfileUpdate) ClearPruningRule() *BackupProfileUpdate {
 	return _u
 }
 
+// ClearHooks clears all "hooks" edges to the BackupHook entity.
+func (_u *BackupProfileUpdate) ClearHooks() *BackupProfileUpdate {
+	_u.mutation.ClearHooks()
+	return _u
+}
+
+// RemoveHookIDs removes the "hooks" edge to BackupHook entities by IDs.
+func (_u *BackupProfileUpdate) RemoveHookIDs(ids ...int) *BackupProfileUpdate {
+	_u.mutation.RemoveHookIDs(ids...)
+	return _u
+}
+
+// RemoveHooks removes "hooks" edges to BackupHook entities.
+func (_u *BackupProfileUpdate) RemoveHooks(v ...*BackupHook) *BackupProfileUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHookIDs(ids...)
+}
+
 // ClearNotifications clears all "notifications" edges to the Notification entity.
 func (_u *BackupProfileUpdate) ClearNotifications() *BackupProfileUpdate {
 	_u.mutation.ClearNotifications()
@@ -417,6 +657,11 @@ func (_u *BackupProfileUpdate) check() error {
 			return &ValidationError{Name: "compression_level", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.compression_level": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckpointInterval(); ok {
+		if err := backupprofile.CheckpointIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "checkpoint_interval", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.checkpoint_interval": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -463,9 +708,40 @@ func (_u *BackupProfileUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.ExcludePathsCleared() {
 		_spec.ClearField(backupprofile.FieldExcludePaths, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Patterns(); ok {
+		_spec.SetField(backupprofile.FieldPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupprofile.FieldPatterns, value)
+		})
+	}
+	if _u.mutation.PatternsCleared() {
+		_spec.ClearField(backupprofile.FieldPatterns, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ExcludeCaches(); ok {
 		_spec.SetField(backupprofile.FieldExcludeCaches, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ExcludeIfPresent(); ok {
+		_spec.SetField(backupprofile.FieldExcludeIfPresent, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExcludeIfPresent(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupprofile.FieldExcludeIfPresent, value)
+		})
+	}
+	if _u.mutation.ExcludeIfPresentCleared() {
+		_spec.ClearField(backupprofile.FieldExcludeIfPresent, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UseIgnoreFiles(); ok {
+		_spec.SetField(backupprofile.FieldUseIgnoreFiles, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContentCommand(); ok {
+		_spec.SetField(backupprofile.FieldContentCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentStdinName(); ok {
+		_spec.SetField(backupprofile.FieldContentStdinName, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Icon(); ok {
 		_spec.SetField(backupprofile.FieldIcon, field.TypeEnum, value)
 	}
@@ -481,6 +757,36 @@ func (_u *BackupProfileUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.CompressionLevelCleared() {
 		_spec.ClearField(backupprofile.FieldCompressionLevel, field.TypeInt)
 	}
+	if value, ok := _u.mutation.OneFileSystem(); ok {
+		_spec.SetField(backupprofile.FieldOneFileSystem, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NumericIds(); ok {
+		_spec.SetField(backupprofile.FieldNumericIds, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoFlags(); ok {
+		_spec.SetField(backupprofile.FieldNoFlags, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoAcls(); ok {
+		_spec.SetField(backupprofile.FieldNoAcls, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoXattrs(); ok {
+		_spec.SetField(backupprofile.FieldNoXattrs, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sparse(); ok {
+		_spec.SetField(backupprofile.FieldSparse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadSpecial(); ok {
+		_spec.SetField(backupprofile.FieldReadSpecial, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CheckpointInterval(); ok {
+		_spec.SetField(backupprofile.FieldCheckpointInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckpointInterval(); ok {
+		_spec.AddField(backupprofile.FieldCheckpointInterval, field.TypeInt, value)
+	}
+	if _u.mutation.CheckpointIntervalCleared() {
+		_spec.ClearField(backupprofile.FieldCheckpointInterval, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DataSectionCollapsed(); ok {
 		_spec.SetField(backupprofile.FieldDataSectionCollapsed, field.TypeBool, value)
 	}
@@ -638,6 +944,51 @@ func (_u *BackupProfileUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHooksIDs(); len(nodes) > 0 && !_u.mutation.HooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -755,6 +1106,24 @@ func (_u *BackupProfileUpdateOne) ClearExcludePaths() *BackupProfileUpdateOne {
 	return _u
 }
 
+// SetPatterns sets the "patterns" field.
+func (_u *BackupProfileUpdateOne) SetPatterns(v []string) *BackupProfileUpdateOne {
+	_u.mutation.SetPatterns(v)
+	return _u
+}
+
+// AppendPatterns appends value to the "patterns" field.
+func (_u *BackupProfileUpdateOne) AppendPatterns(v []string) *BackupProfileUpdateOne {
+	_u.mutation.AppendPatterns(v)
+	return _u
+}
+
+// ClearPatterns clears the value of the "patterns" field.
+func (_u *BackupProfileUpdateOne) ClearPatterns() *BackupProfileUpdateOne {
+	_u.mutation.ClearPatterns()
+	return _u
+}
+
 // SetExcludeCaches sets the "exclude_caches" field.
 func (_u *BackupProfileUpdateOne) SetExcludeCaches(v bool) *BackupProfileUpdateOne {
 	_u.mutation.SetExcludeCaches(v)
@@ -769,6 +1138,66 @@ func (_u *BackupProfileUpdateOne) SetNillableExcludeCaches(v *bool) *BackupProfi
 	return _u
 }
 
+// SetExcludeIfPresent sets the "exclude_if_present" field.
+func (_u *BackupProfileUpdateOne) SetExcludeIfPresent(v []string) *BackupProfileUpdateOne {
+	_u.mutation.SetExcludeIfPresent(v)
+	return _u
+}
+
+// AppendExcludeIfPresent appends value to the "exclude_if_present" field.
+func (_u *BackupProfileUpdateOne) AppendExcludeIfPresent(v []string) *BackupProfileUpdateOne {
+	_u.mutation.AppendExcludeIfPresent(v)
+	return _u
+}
+
+// ClearExcludeIfPresent clears the value of the "exclude_if_present" field.
+func (_u *BackupProfileUpdateOne) ClearExcludeIfPresent() *BackupProfileUpdateOne {
+	_u.mutation.ClearExcludeIfPresent()
+	return _u
+}
+
+// SetUseIgnoreFiles sets the "use_ignore_files" field.
+func (_u *BackupProfileUpdateOne) SetUseIgnoreFiles(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetUseIgnoreFiles(v)
+	return _u
+}
+
+// SetNillableUseIgnoreFiles sets the "use_ignore_files" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableUseIgnoreFiles(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetUseIgnoreFiles(*v)
+	}
+	return _u
+}
+
+// SetContentCommand sets the "content_command" field.
+func (_u *BackupProfileUpdateOne) SetContentCommand(v string) *BackupProfileUpdateOne {
+	_u.mutation.SetContentCommand(v)
+	return _u
+}
+
+// SetNillableContentCommand sets the "content_command" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableContentCommand(v *string) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetContentCommand(*v)
+	}
+	return _u
+}
+
+// SetContentStdinName sets the "content_stdin_name" field.
+func (_u *BackupProfileUpdateOne) SetContentStdinName(v string) *BackupProfileUpdateOne {
+	_u.mutation.SetContentStdinName(v)
+	return _u
+}
+
+// SetNillableContentStdinName sets the "content_stdin_name" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableContentStdinName(v *string) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetContentStdinName(*v)
+	}
+	return _u
+}
+
 // SetIcon sets the "icon" field.
 func (_u *BackupProfileUpdateOne) SetIcon(v backupprofile.Icon) *BackupProfileUpdateOne {
 	_u.mutation.SetIcon(v)
@@ -824,6 +1253,131 @@ func (_u *BackupProfileUpdateOne) ClearCompressionLevel() *BackupProfileUpdateOn
 	return _u
 }
 
+// SetOneFileSystem sets the "one_file_system" field.
+func (_u *BackupProfileUpdateOne) SetOneFileSystem(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetOneFileSystem(v)
+	return _u
+}
+
+// SetNillableOneFileSystem sets the "one_file_system" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableOneFileSystem(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetOneFileSystem(*v)
+	}
+	return _u
+}
+
+// SetNumericIds sets the "numeric_ids" field.
+func (_u *BackupProfileUpdateOne) SetNumericIds(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetNumericIds(v)
+	return _u
+}
+
+// SetNillableNumericIds sets the "numeric_ids" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableNumericIds(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetNumericIds(*v)
+	}
+	return _u
+}
+
+// SetNoFlags sets the "no_flags" field.
+func (_u *BackupProfileUpdateOne) SetNoFlags(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetNoFlags(v)
+	return _u
+}
+
+// SetNillableNoFlags sets the "no_flags" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableNoFlags(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetNoFlags(*v)
+	}
+	return _u
+}
+
+// SetNoAcls sets the "no_acls" field.
+func (_u *BackupProfileUpdateOne) SetNoAcls(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetNoAcls(v)
+	return _u
+}
+
+// SetNillableNoAcls sets the "no_acls" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableNoAcls(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetNoAcls(*v)
+	}
+	return _u
+}
+
+// SetNoXattrs sets the "no_xattrs" field.
+func (_u *BackupProfileUpdateOne) SetNoXattrs(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetNoXattrs(v)
+	return _u
+}
+
+// SetNillableNoXattrs sets the "no_xattrs" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableNoXattrs(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetNoXattrs(*v)
+	}
+	return _u
+}
+
+// SetSparse sets the "sparse" field.
+func (_u *BackupProfileUpdateOne) SetSparse(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetSparse(v)
+	return _u
+}
+
+// SetNillableSparse sets the "sparse" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableSparse(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetSparse(*v)
+	}
+	return _u
+}
+
+// SetReadSpecial sets the "read_special" field.
+func (_u *BackupProfileUpdateOne) SetReadSpecial(v bool) *BackupProfileUpdateOne {
+	_u.mutation.SetReadSpecial(v)
+	return _u
+}
+
+// SetNillableReadSpecial sets the "read_special" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableReadSpecial(v *bool) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetReadSpecial(*v)
+	}
+	return _u
+}
+
+// SetCheckpointInterval sets the "checkpoint_interval" field.
+func (_u *BackupProfileUpdateOne) SetCheckpointInterval(v int) *BackupProfileUpdateOne {
+	_u.mutation.ResetCheckpointInterval()
+	_u.mutation.SetCheckpointInterval(v)
+	return _u
+}
+
+// SetNillableCheckpointInterval sets the "checkpoint_interval" field if the given value is not nil.
+func (_u *BackupProfileUpdateOne) SetNillableCheckpointInterval(v *int) *BackupProfileUpdateOne {
+	if v != nil {
+		_u.SetCheckpointInterval(*v)
+	}
+	return _u
+}
+
+// AddCheckpointInterval adds value to the "checkpoint_interval" field.
+func (_u *BackupProfileUpdateOne) AddCheckpointInterval(v int) *BackupProfileUpdateOne {
+	_u.mutation.AddCheckpointInterval(v)
+	return _u
+}
+
+// ClearCheckpointInterval clears the value of the "checkpoint_interval" field.
+func (_u *BackupProfileUpdateOne) ClearCheckpointInterval() *BackupProfileUpdateOne {
+	_u.mutation.ClearCheckpointInterval()
+	return _u
+}
+
 // SetDataSectionCollapsed sets the "data_section_collapsed" field.
 func (_u *BackupProfileUpdateOne) SetDataSectionCollapsed(v bool) *BackupProfileUpdateOne {
 	_u.mutation.SetDataSectionCollapsed(v)
@@ -934,6 +1488,21 @@ func (_u *BackupProfileUpdateOne) SetPruningRule(v *PruningRule) *BackupProfileU
 	return _u.SetPruningRuleID(v.ID)
 }
 
+// AddHookIDs adds the "hooks" edge to the BackupHook entity by IDs.
+func (_u *BackupProfileUpdateOne) AddHookIDs(ids ...int) *BackupProfileUpdateOne {
+	_u.mutation.AddHookIDs(ids...)
+	return _u
+}
+
+// AddHooks adds the "hooks" edges to the BackupHook entity.
+func (_u *BackupProfileUpdateOne) AddHooks(v ...*BackupHook) *BackupProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddHookIDs(ids...)
+}
+
 // AddNotificationIDs adds the "notifications" edge to the Notification entity by IDs.
 func (_u *BackupProfileUpdateOne) AddNotificationIDs(ids ...int) *BackupProfileUpdateOne {
 	_u.mutation.AddNotificationIDs(ids...)
@@ -1008,6 +1577,27 @@ func (_u *BackupProfileUpdateOne) ClearPruningRule() *BackupProfileUpdateOne {
 	return _u
 }
 
+// ClearHooks clears all "hooks" edges to the BackupHook entity.
+func (_u *BackupProfileUpdateOne) ClearHooks() *BackupProfileUpdateOne {
+	_u.mutation.ClearHooks()
+	return _u
+}
+
+// RemoveHookIDs removes the "hooks" edge to BackupHook entities by IDs.
+func (_u *BackupProfileUpdateOne) RemoveHookIDs(ids ...int) *BackupProfileUpdateOne {
+	_u.mutation.RemoveHookIDs(ids...)
+	return _u
+}
+
+// RemoveHooks removes "hooks" edges to BackupHook entities.
+func (_u *BackupProfileUpdateOne) RemoveHooks(v ...*BackupHook) *BackupProfileUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveHookIDs(ids...)
+}
+
 // ClearNotifications clears all "notifications" edges to the Notification entity.
 func (_u *BackupProfileUpdateOne) ClearNotifications() *BackupProfileUpdateOne {
 	_u.mutation.ClearNotifications()
@@ -1100,6 +1690,11 @@ func (_u *BackupProfileUpdateOne) check() error {
 			return &ValidationError{Name: "compression_level", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.compression_level": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CheckpointInterval(); ok {
+		if err := backupprofile.CheckpointIntervalValidator(v); err != nil {
+			return &ValidationError{Name: "checkpoint_interval", err: fmt.Errorf(`ent: validator failed for field "BackupProfile.checkpoint_interval": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1163,9 +1758,40 @@ func (_u *BackupProfileUpdateOne) sqlSave(ctx context.Context) (_node *BackupPro
 	if _u.mutation.ExcludePathsCleared() {
 		_spec.ClearField(backupprofile.FieldExcludePaths, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.Patterns(); ok {
+		_spec.SetField(backupprofile.FieldPatterns, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPatterns(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupprofile.FieldPatterns, value)
+		})
+	}
+	if _u.mutation.PatternsCleared() {
+		_spec.ClearField(backupprofile.FieldPatterns, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.ExcludeCaches(); ok {
 		_spec.SetField(backupprofile.FieldExcludeCaches, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ExcludeIfPresent(); ok {
+		_spec.SetField(backupprofile.FieldExcludeIfPresent, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedExcludeIfPresent(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupprofile.FieldExcludeIfPresent, value)
+		})
+	}
+	if _u.mutation.ExcludeIfPresentCleared() {
+		_spec.ClearField(backupprofile.FieldExcludeIfPresent, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UseIgnoreFiles(); ok {
+		_spec.SetField(backupprofile.FieldUseIgnoreFiles, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ContentCommand(); ok {
+		_spec.SetField(backupprofile.FieldContentCommand, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentStdinName(); ok {
+		_spec.SetField(backupprofile.FieldContentStdinName, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Icon(); ok {
 		_spec.SetField(backupprofile.FieldIcon, field.TypeEnum, value)
 	}
@@ -1181,6 +1807,36 @@ func (_u *BackupProfileUpdateOne) sqlSave(ctx context.Context) (_node *BackupPro
 	if _u.mutation.CompressionLevelCleared() {
 		_spec.ClearField(backupprofile.FieldCompressionLevel, field.TypeInt)
 	}
+	if value, ok := _u.mutation.OneFileSystem(); ok {
+		_spec.SetField(backupprofile.FieldOneFileSystem, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NumericIds(); ok {
+		_spec.SetField(backupprofile.FieldNumericIds, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoFlags(); ok {
+		_spec.SetField(backupprofile.FieldNoFlags, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoAcls(); ok {
+		_spec.SetField(backupprofile.FieldNoAcls, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NoXattrs(); ok {
+		_spec.SetField(backupprofile.FieldNoXattrs, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Sparse(); ok {
+		_spec.SetField(backupprofile.FieldSparse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadSpecial(); ok {
+		_spec.SetField(backupprofile.FieldReadSpecial, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.CheckpointInterval(); ok {
+		_spec.SetField(backupprofile.FieldCheckpointInterval, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCheckpointInterval(); ok {
+		_spec.AddField(backupprofile.FieldCheckpointInterval, field.TypeInt, value)
+	}
+	if _u.mutation.CheckpointIntervalCleared() {
+		_spec.ClearField(backupprofile.FieldCheckpointInterval, field.TypeInt)
+	}
 	if value, ok := _u.mutation.DataSectionCollapsed(); ok {
 		_spec.SetField(backupprofile.FieldDataSectionCollapsed, field.TypeBool, value)
 	}
@@ -1338,6 +1994,51 @@ func (_u *BackupProfileUpdateOne) sqlSave(ctx context.Context) (_node *BackupPro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.HooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedHooksIDs(); len(nodes) > 0 && !_u.mutation.HooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.HooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   backupprofile.HooksTable,
+			Columns: []string{backupprofile.HooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backuphook.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.NotificationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
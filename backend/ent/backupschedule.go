@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 // BackupSchedule is the model entity for the BackupSchedule schema.
@@ -32,12 +34,36 @@ type BackupSchedule struct {
 	Weekday backupschedule.Weekday `json:"weekday"`
 	// WeeklyAt holds the value of the "weekly_at" field.
 	WeeklyAt time.Time `json:"weeklyAt"`
-	// Monthday holds the value of the "monthday" field.
+	// Day of the month, the last day of the month is used in shorter months
 	Monthday uint8 `json:"monthday"`
 	// MonthlyAt holds the value of the "monthly_at" field.
 	MonthlyAt time.Time `json:"monthlyAt"`
+	// Cron expressions of the cron mode (e.g. '0 12,18 * * mon-fri'), evaluated in local time
+	CronExpressions []string `json:"cronExpressions"`
+	// What to do with a run that was missed because the computer was asleep or Arco wasn't running
+	CatchUpPolicy backupschedule.CatchUpPolicy `json:"catchUpPolicy"`
+	// Minutes to wait before a missed run is caught up with the delayed policy
+	CatchUpDelayMinutes uint16 `json:"catchUpDelayMinutes"`
+	// Random delay of up to this many minutes added to every run, spreads the load of many clients on one server
+	JitterMinutes uint16 `json:"jitterMinutes"`
+	// Backups only start within one of these windows, at any time if empty (local time)
+	AllowedHours []types.TimeWindow `json:"allowedHours"`
+	// Backups never start during these windows, queued backups are held until the period is over (local time)
+	BlackoutPeriods []types.TimeWindow `json:"blackoutPeriods"`
+	// Scheduled backups wait while the computer runs on battery
+	RequireAcPower bool `json:"requireAcPower"`
+	// Scheduled backups wait while the network connection is metered
+	RequireUnmeteredNetwork bool `json:"requireUnmeteredNetwork"`
+	// Scheduled backups wait until the host or mount of the repository is reachable
+	RequireReachableRepository bool `json:"requireReachableRepository"`
+	// Run a backup to local repositories when the drive they are on is connected, independent of the mode
+	RunOnDriveConnect bool `json:"runOnDriveConnect"`
+	// What to do with the drive after the backups that were started by connecting it have finished
+	DriveActionAfterBackup backupschedule.DriveActionAfterBackup `json:"driveActionAfterBackup"`
 	// NextRun holds the value of the "next_run" field.
 	NextRun time.Time `json:"nextRun"`
+	// When the run that is past its next run time is started, set once the run is missed or deferred
+	CatchUpAt *time.Time `json:"catchUpAt"`
 	// LastRun holds the value of the "last_run" field.
 	LastRun *time.Time `json:"lastRun"`
 	// LastRunStatus holds the value of the "last_run_status" field.
@@ -74,11 +100,15 @@ func (*BackupSchedule) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case backupschedule.FieldID, backupschedule.FieldIntervalMinutes, backupschedule.FieldMonthday:
+		case backupschedule.FieldCronExpressions, backupschedule.FieldAllowedHours, backupschedule.FieldBlackoutPeriods:
+			values[i] = new([]byte)
+		case backupschedule.FieldRequireAcPower, backupschedule.FieldRequireUnmeteredNetwork, backupschedule.FieldRequireReachableRepository, backupschedule.FieldRunOnDriveConnect:
+			values[i] = new(sql.NullBool)
+		case backupschedule.FieldID, backupschedule.FieldIntervalMinutes, backupschedule.FieldMonthday, backupschedule.FieldCatchUpDelayMinutes, backupschedule.FieldJitterMinutes:
 			values[i] = new(sql.NullInt64)
-		case backupschedule.FieldMode, backupschedule.FieldWeekday, backupschedule.FieldLastRunStatus:
+		case backupschedule.FieldMode, backupschedule.FieldWeekday, backupschedule.FieldCatchUpPolicy, backupschedule.FieldDriveActionAfterBackup, backupschedule.FieldLastRunStatus:
 			values[i] = new(sql.NullString)
-		case backupschedule.FieldCreatedAt, backupschedule.FieldUpdatedAt, backupschedule.FieldDailyAt, backupschedule.FieldWeeklyAt, backupschedule.FieldMonthlyAt, backupschedule.FieldNextRun, backupschedule.FieldLastRun:
+		case backupschedule.FieldCreatedAt, backupschedule.FieldUpdatedAt, backupschedule.FieldDailyAt, backupschedule.FieldWeeklyAt, backupschedule.FieldMonthlyAt, backupschedule.FieldNextRun, backupschedule.FieldCatchUpAt, backupschedule.FieldLastRun:
 			values[i] = new(sql.NullTime)
 		case backupschedule.ForeignKeys[0]: // backup_profile_backup_schedule
 			values[i] = new(sql.NullInt64)
@@ -157,12 +187,91 @@ func (_m *BackupSchedule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.MonthlyAt = value.Time
 			}
+		case backupschedule.FieldCronExpressions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_expressions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CronExpressions); err != nil {
+					return fmt.Errorf("unmarshal field cron_expressions: %w", err)
+				}
+			}
+		case backupschedule.FieldCatchUpPolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_policy", values[i])
+			} else if value.Valid {
+				_m.CatchUpPolicy = backupschedule.CatchUpPolicy(value.String)
+			}
+		case backupschedule.FieldCatchUpDelayMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_delay_minutes", values[i])
+			} else if value.Valid {
+				_m.CatchUpDelayMinutes = uint16(value.Int64)
+			}
+		case backupschedule.FieldJitterMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field jitter_minutes", values[i])
+			} else if value.Valid {
+				_m.JitterMinutes = uint16(value.Int64)
+			}
+		case backupschedule.FieldAllowedHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field allowed_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AllowedHours); err != nil {
+					return fmt.Errorf("unmarshal field allowed_hours: %w", err)
+				}
+			}
+		case backupschedule.FieldBlackoutPeriods:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field blackout_periods", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.BlackoutPeriods); err != nil {
+					return fmt.Errorf("unmarshal field blackout_periods: %w", err)
+				}
+			}
+		case backupschedule.FieldRequireAcPower:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_ac_power", values[i])
+			} else if value.Valid {
+				_m.RequireAcPower = value.Bool
+			}
+		case backupschedule.FieldRequireUnmeteredNetwork:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_unmetered_network", values[i])
+			} else if value.Valid {
+				_m.RequireUnmeteredNetwork = value.Bool
+			}
+		case backupschedule.FieldRequireReachableRepository:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_reachable_repository", values[i])
+			} else if value.Valid {
+				_m.RequireReachableRepository = value.Bool
+			}
+		case backupschedule.FieldRunOnDriveConnect:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field run_on_drive_connect", values[i])
+			} else if value.Valid {
+				_m.RunOnDriveConnect = value.Bool
+			}
+		case backupschedule.FieldDriveActionAfterBackup:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field drive_action_after_backup", values[i])
+			} else if value.Valid {
+				_m.DriveActionAfterBackup = backupschedule.DriveActionAfterBackup(value.String)
+			}
 		case backupschedule.FieldNextRun:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field next_run", values[i])
 			} else if value.Valid {
 				_m.NextRun = value.Time
 			}
+		case backupschedule.FieldCatchUpAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field catch_up_at", values[i])
+			} else if value.Valid {
+				_m.CatchUpAt = new(time.Time)
+				*_m.CatchUpAt = value.Time
+			}
 		case backupschedule.FieldLastRun:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_run", values[i])
@@ -252,9 +361,47 @@ func (_m *BackupSchedule) String() string {
 	builder.WriteString("monthly_at=")
 	builder.WriteString(_m.MonthlyAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("cron_expressions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CronExpressions))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_policy=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("catch_up_delay_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CatchUpDelayMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("jitter_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.JitterMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("allowed_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AllowedHours))
+	builder.WriteString(", ")
+	builder.WriteString("blackout_periods=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlackoutPeriods))
+	builder.WriteString(", ")
+	builder.WriteString("require_ac_power=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireAcPower))
+	builder.WriteString(", ")
+	builder.WriteString("require_unmetered_network=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireUnmeteredNetwork))
+	builder.WriteString(", ")
+	builder.WriteString("require_reachable_repository=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireReachableRepository))
+	builder.WriteString(", ")
+	builder.WriteString("run_on_drive_connect=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RunOnDriveConnect))
+	builder.WriteString(", ")
+	builder.WriteString("drive_action_after_backup=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DriveActionAfterBackup))
+	builder.WriteString(", ")
 	builder.WriteString("next_run=")
 	builder.WriteString(_m.NextRun.Format(time.ANSIC))
 	builder.WriteString(", ")
+	if v := _m.CatchUpAt; v != nil {
+		builder.WriteString("catch_up_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := _m.LastRun; v != nil {
 		builder.WriteString("last_run=")
 		builder.WriteString(v.Format(time.ANSIC))
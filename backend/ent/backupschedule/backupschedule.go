@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 const (
@@ -33,8 +34,32 @@ const (
 	FieldMonthday = "monthday"
 	// FieldMonthlyAt holds the string denoting the monthly_at field in the database.
 	FieldMonthlyAt = "monthly_at"
+	// FieldCronExpressions holds the string denoting the cron_expressions field in the database.
+	FieldCronExpressions = "cron_expressions"
+	// FieldCatchUpPolicy holds the string denoting the catch_up_policy field in the database.
+	FieldCatchUpPolicy = "catch_up_policy"
+	// FieldCatchUpDelayMinutes holds the string denoting the catch_up_delay_minutes field in the database.
+	FieldCatchUpDelayMinutes = "catch_up_delay_minutes"
+	// FieldJitterMinutes holds the string denoting the jitter_minutes field in the database.
+	FieldJitterMinutes = "jitter_minutes"
+	// FieldAllowedHours holds the string denoting the allowed_hours field in the database.
+	FieldAllowedHours = "allowed_hours"
+	// FieldBlackoutPeriods holds the string denoting the blackout_periods field in the database.
+	FieldBlackoutPeriods = "blackout_periods"
+	// FieldRequireAcPower holds the string denoting the require_ac_power field in the database.
+	FieldRequireAcPower = "require_ac_power"
+	// FieldRequireUnmeteredNetwork holds the string denoting the require_unmetered_network field in the database.
+	FieldRequireUnmeteredNetwork = "require_unmetered_network"
+	// FieldRequireReachableRepository holds the string denoting the require_reachable_repository field in the database.
+	FieldRequireReachableRepository = "require_reachable_repository"
+	// FieldRunOnDriveConnect holds the string denoting the run_on_drive_connect field in the database.
+	FieldRunOnDriveConnect = "run_on_drive_connect"
+	// FieldDriveActionAfterBackup holds the string denoting the drive_action_after_backup field in the database.
+	FieldDriveActionAfterBackup = "drive_action_after_backup"
 	// FieldNextRun holds the string denoting the next_run field in the database.
 	FieldNextRun = "next_run"
+	// FieldCatchUpAt holds the string denoting the catch_up_at field in the database.
+	FieldCatchUpAt = "catch_up_at"
 	// FieldLastRun holds the string denoting the last_run field in the database.
 	FieldLastRun = "last_run"
 	// FieldLastRunStatus holds the string denoting the last_run_status field in the database.
@@ -64,7 +89,19 @@ var Columns = []string{
 	FieldWeeklyAt,
 	FieldMonthday,
 	FieldMonthlyAt,
+	FieldCronExpressions,
+	FieldCatchUpPolicy,
+	FieldCatchUpDelayMinutes,
+	FieldJitterMinutes,
+	FieldAllowedHours,
+	FieldBlackoutPeriods,
+	FieldRequireAcPower,
+	FieldRequireUnmeteredNetwork,
+	FieldRequireReachableRepository,
+	FieldRunOnDriveConnect,
+	FieldDriveActionAfterBackup,
 	FieldNextRun,
+	FieldCatchUpAt,
 	FieldLastRun,
 	FieldLastRunStatus,
 }
@@ -101,6 +138,28 @@ var (
 	DefaultIntervalMinutes uint16
 	// MonthdayValidator is a validator for the "monthday" field. It is called by the builders before save.
 	MonthdayValidator func(uint8) error
+	// DefaultCronExpressions holds the default value on creation for the "cron_expressions" field.
+	DefaultCronExpressions []string
+	// DefaultCatchUpDelayMinutes holds the default value on creation for the "catch_up_delay_minutes" field.
+	DefaultCatchUpDelayMinutes uint16
+	// CatchUpDelayMinutesValidator is a validator for the "catch_up_delay_minutes" field. It is called by the builders before save.
+	CatchUpDelayMinutesValidator func(uint16) error
+	// DefaultJitterMinutes holds the default value on creation for the "jitter_minutes" field.
+	DefaultJitterMinutes uint16
+	// JitterMinutesValidator is a validator for the "jitter_minutes" field. It is called by the builders before save.
+	JitterMinutesValidator func(uint16) error
+	// DefaultAllowedHours holds the default value on creation for the "allowed_hours" field.
+	DefaultAllowedHours []types.TimeWindow
+	// DefaultBlackoutPeriods holds the default value on creation for the "blackout_periods" field.
+	DefaultBlackoutPeriods []types.TimeWindow
+	// DefaultRequireAcPower holds the default value on creation for the "require_ac_power" field.
+	DefaultRequireAcPower bool
+	// DefaultRequireUnmeteredNetwork holds the default value on creation for the "require_unmetered_network" field.
+	DefaultRequireUnmeteredNetwork bool
+	// DefaultRequireReachableRepository holds the default value on creation for the "require_reachable_repository" field.
+	DefaultRequireReachableRepository bool
+	// DefaultRunOnDriveConnect holds the default value on creation for the "run_on_drive_connect" field.
+	DefaultRunOnDriveConnect bool
 )
 
 // Mode defines the type for the "mode" enum field.
@@ -116,6 +175,7 @@ const (
 	ModeDaily          Mode = "daily"
 	ModeWeekly         Mode = "weekly"
 	ModeMonthly        Mode = "monthly"
+	ModeCron           Mode = "cron"
 )
 
 func (m Mode) String() string {
@@ -125,7 +185,7 @@ func (m Mode) String() string {
 // ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
 func ModeValidator(m Mode) error {
 	switch m {
-	case ModeDisabled, ModeMinuteInterval, ModeDaily, ModeWeekly, ModeMonthly:
+	case ModeDisabled, ModeMinuteInterval, ModeDaily, ModeWeekly, ModeMonthly, ModeCron:
 		return nil
 	default:
 		return fmt.Errorf("backupschedule: invalid enum value for mode field: %q", m)
@@ -160,6 +220,60 @@ func WeekdayValidator(w Weekday) error {
 	}
 }
 
+// CatchUpPolicy defines the type for the "catch_up_policy" enum field.
+type CatchUpPolicy string
+
+// CatchUpPolicyImmediately is the default value of the CatchUpPolicy enum.
+const DefaultCatchUpPolicy = CatchUpPolicyImmediately
+
+// CatchUpPolicy values.
+const (
+	CatchUpPolicyImmediately CatchUpPolicy = "immediately"
+	CatchUpPolicyDelayed     CatchUpPolicy = "delayed"
+	CatchUpPolicySkip        CatchUpPolicy = "skip"
+)
+
+func (cup CatchUpPolicy) String() string {
+	return string(cup)
+}
+
+// CatchUpPolicyValidator is a validator for the "catch_up_policy" field enum values. It is called by the builders before save.
+func CatchUpPolicyValidator(cup CatchUpPolicy) error {
+	switch cup {
+	case CatchUpPolicyImmediately, CatchUpPolicyDelayed, CatchUpPolicySkip:
+		return nil
+	default:
+		return fmt.Errorf("backupschedule: invalid enum value for catch_up_policy field: %q", cup)
+	}
+}
+
+// DriveActionAfterBackup defines the type for the "drive_action_after_backup" enum field.
+type DriveActionAfterBackup string
+
+// DriveActionAfterBackupKeep is the default value of the DriveActionAfterBackup enum.
+const DefaultDriveActionAfterBackup = DriveActionAfterBackupKeep
+
+// DriveActionAfterBackup values.
+const (
+	DriveActionAfterBackupKeep    DriveActionAfterBackup = "keep"
+	DriveActionAfterBackupUnmount DriveActionAfterBackup = "unmount"
+	DriveActionAfterBackupEject   DriveActionAfterBackup = "eject"
+)
+
+func (daab DriveActionAfterBackup) String() string {
+	return string(daab)
+}
+
+// DriveActionAfterBackupValidator is a validator for the "drive_action_after_backup" field enum values. It is called by the builders before save.
+func DriveActionAfterBackupValidator(daab DriveActionAfterBackup) error {
+	switch daab {
+	case DriveActionAfterBackupKeep, DriveActionAfterBackupUnmount, DriveActionAfterBackupEject:
+		return nil
+	default:
+		return fmt.Errorf("backupschedule: invalid enum value for drive_action_after_backup field: %q", daab)
+	}
+}
+
 // OrderOption defines the ordering options for the BackupSchedule queries.
 type OrderOption func(*sql.Selector)
 
@@ -213,11 +327,56 @@ func ByMonthlyAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMonthlyAt, opts...).ToFunc()
 }
 
+// ByCatchUpPolicy orders the results by the catch_up_policy field.
+func ByCatchUpPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpPolicy, opts...).ToFunc()
+}
+
+// ByCatchUpDelayMinutes orders the results by the catch_up_delay_minutes field.
+func ByCatchUpDelayMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpDelayMinutes, opts...).ToFunc()
+}
+
+// ByJitterMinutes orders the results by the jitter_minutes field.
+func ByJitterMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldJitterMinutes, opts...).ToFunc()
+}
+
+// ByRequireAcPower orders the results by the require_ac_power field.
+func ByRequireAcPower(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireAcPower, opts...).ToFunc()
+}
+
+// ByRequireUnmeteredNetwork orders the results by the require_unmetered_network field.
+func ByRequireUnmeteredNetwork(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireUnmeteredNetwork, opts...).ToFunc()
+}
+
+// ByRequireReachableRepository orders the results by the require_reachable_repository field.
+func ByRequireReachableRepository(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireReachableRepository, opts...).ToFunc()
+}
+
+// ByRunOnDriveConnect orders the results by the run_on_drive_connect field.
+func ByRunOnDriveConnect(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunOnDriveConnect, opts...).ToFunc()
+}
+
+// ByDriveActionAfterBackup orders the results by the drive_action_after_backup field.
+func ByDriveActionAfterBackup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDriveActionAfterBackup, opts...).ToFunc()
+}
+
 // ByNextRun orders the results by the next_run field.
 func ByNextRun(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNextRun, opts...).ToFunc()
 }
 
+// ByCatchUpAt orders the results by the catch_up_at field.
+func ByCatchUpAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCatchUpAt, opts...).ToFunc()
+}
+
 // ByLastRun orders the results by the last_run field.
 func ByLastRun(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastRun, opts...).ToFunc()
@@ -90,11 +90,46 @@ func MonthlyAt(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldEQ(FieldMonthlyAt, v))
 }
 
+// CatchUpDelayMinutes applies equality check predicate on the "catch_up_delay_minutes" field. It's identical to CatchUpDelayMinutesEQ.
+func CatchUpDelayMinutes(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldCatchUpDelayMinutes, v))
+}
+
+// JitterMinutes applies equality check predicate on the "jitter_minutes" field. It's identical to JitterMinutesEQ.
+func JitterMinutes(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldJitterMinutes, v))
+}
+
+// RequireAcPower applies equality check predicate on the "require_ac_power" field. It's identical to RequireAcPowerEQ.
+func RequireAcPower(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireAcPower, v))
+}
+
+// RequireUnmeteredNetwork applies equality check predicate on the "require_unmetered_network" field. It's identical to RequireUnmeteredNetworkEQ.
+func RequireUnmeteredNetwork(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireUnmeteredNetwork, v))
+}
+
+// RequireReachableRepository applies equality check predicate on the "require_reachable_repository" field. It's identical to RequireReachableRepositoryEQ.
+func RequireReachableRepository(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireReachableRepository, v))
+}
+
+// RunOnDriveConnect applies equality check predicate on the "run_on_drive_connect" field. It's identical to RunOnDriveConnectEQ.
+func RunOnDriveConnect(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRunOnDriveConnect, v))
+}
+
 // NextRun applies equality check predicate on the "next_run" field. It's identical to NextRunEQ.
 func NextRun(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldEQ(FieldNextRun, v))
 }
 
+// CatchUpAt applies equality check predicate on the "catch_up_at" field. It's identical to CatchUpAtEQ.
+func CatchUpAt(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldCatchUpAt, v))
+}
+
 // LastRun applies equality check predicate on the "last_run" field. It's identical to LastRunEQ.
 func LastRun(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldEQ(FieldLastRun, v))
@@ -425,6 +460,196 @@ func MonthlyAtLTE(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldLTE(FieldMonthlyAt, v))
 }
 
+// CronExpressionsIsNil applies the IsNil predicate on the "cron_expressions" field.
+func CronExpressionsIsNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIsNull(FieldCronExpressions))
+}
+
+// CronExpressionsNotNil applies the NotNil predicate on the "cron_expressions" field.
+func CronExpressionsNotNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotNull(FieldCronExpressions))
+}
+
+// CatchUpPolicyEQ applies the EQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyEQ(v CatchUpPolicy) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyNEQ applies the NEQ predicate on the "catch_up_policy" field.
+func CatchUpPolicyNEQ(v CatchUpPolicy) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldCatchUpPolicy, v))
+}
+
+// CatchUpPolicyIn applies the In predicate on the "catch_up_policy" field.
+func CatchUpPolicyIn(vs ...CatchUpPolicy) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpPolicyNotIn applies the NotIn predicate on the "catch_up_policy" field.
+func CatchUpPolicyNotIn(vs ...CatchUpPolicy) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotIn(FieldCatchUpPolicy, vs...))
+}
+
+// CatchUpDelayMinutesEQ applies the EQ predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesEQ(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldCatchUpDelayMinutes, v))
+}
+
+// CatchUpDelayMinutesNEQ applies the NEQ predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesNEQ(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldCatchUpDelayMinutes, v))
+}
+
+// CatchUpDelayMinutesIn applies the In predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesIn(vs ...uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIn(FieldCatchUpDelayMinutes, vs...))
+}
+
+// CatchUpDelayMinutesNotIn applies the NotIn predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesNotIn(vs ...uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotIn(FieldCatchUpDelayMinutes, vs...))
+}
+
+// CatchUpDelayMinutesGT applies the GT predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesGT(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGT(FieldCatchUpDelayMinutes, v))
+}
+
+// CatchUpDelayMinutesGTE applies the GTE predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesGTE(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGTE(FieldCatchUpDelayMinutes, v))
+}
+
+// CatchUpDelayMinutesLT applies the LT predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesLT(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLT(FieldCatchUpDelayMinutes, v))
+}
+
+// CatchUpDelayMinutesLTE applies the LTE predicate on the "catch_up_delay_minutes" field.
+func CatchUpDelayMinutesLTE(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLTE(FieldCatchUpDelayMinutes, v))
+}
+
+// JitterMinutesEQ applies the EQ predicate on the "jitter_minutes" field.
+func JitterMinutesEQ(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldJitterMinutes, v))
+}
+
+// JitterMinutesNEQ applies the NEQ predicate on the "jitter_minutes" field.
+func JitterMinutesNEQ(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldJitterMinutes, v))
+}
+
+// JitterMinutesIn applies the In predicate on the "jitter_minutes" field.
+func JitterMinutesIn(vs ...uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIn(FieldJitterMinutes, vs...))
+}
+
+// JitterMinutesNotIn applies the NotIn predicate on the "jitter_minutes" field.
+func JitterMinutesNotIn(vs ...uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotIn(FieldJitterMinutes, vs...))
+}
+
+// JitterMinutesGT applies the GT predicate on the "jitter_minutes" field.
+func JitterMinutesGT(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGT(FieldJitterMinutes, v))
+}
+
+// JitterMinutesGTE applies the GTE predicate on the "jitter_minutes" field.
+func JitterMinutesGTE(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGTE(FieldJitterMinutes, v))
+}
+
+// JitterMinutesLT applies the LT predicate on the "jitter_minutes" field.
+func JitterMinutesLT(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLT(FieldJitterMinutes, v))
+}
+
+// JitterMinutesLTE applies the LTE predicate on the "jitter_minutes" field.
+func JitterMinutesLTE(v uint16) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLTE(FieldJitterMinutes, v))
+}
+
+// AllowedHoursIsNil applies the IsNil predicate on the "allowed_hours" field.
+func AllowedHoursIsNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIsNull(FieldAllowedHours))
+}
+
+// AllowedHoursNotNil applies the NotNil predicate on the "allowed_hours" field.
+func AllowedHoursNotNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotNull(FieldAllowedHours))
+}
+
+// BlackoutPeriodsIsNil applies the IsNil predicate on the "blackout_periods" field.
+func BlackoutPeriodsIsNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIsNull(FieldBlackoutPeriods))
+}
+
+// BlackoutPeriodsNotNil applies the NotNil predicate on the "blackout_periods" field.
+func BlackoutPeriodsNotNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotNull(FieldBlackoutPeriods))
+}
+
+// RequireAcPowerEQ applies the EQ predicate on the "require_ac_power" field.
+func RequireAcPowerEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireAcPower, v))
+}
+
+// RequireAcPowerNEQ applies the NEQ predicate on the "require_ac_power" field.
+func RequireAcPowerNEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldRequireAcPower, v))
+}
+
+// RequireUnmeteredNetworkEQ applies the EQ predicate on the "require_unmetered_network" field.
+func RequireUnmeteredNetworkEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireUnmeteredNetwork, v))
+}
+
+// RequireUnmeteredNetworkNEQ applies the NEQ predicate on the "require_unmetered_network" field.
+func RequireUnmeteredNetworkNEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldRequireUnmeteredNetwork, v))
+}
+
+// RequireReachableRepositoryEQ applies the EQ predicate on the "require_reachable_repository" field.
+func RequireReachableRepositoryEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRequireReachableRepository, v))
+}
+
+// RequireReachableRepositoryNEQ applies the NEQ predicate on the "require_reachable_repository" field.
+func RequireReachableRepositoryNEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldRequireReachableRepository, v))
+}
+
+// RunOnDriveConnectEQ applies the EQ predicate on the "run_on_drive_connect" field.
+func RunOnDriveConnectEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldRunOnDriveConnect, v))
+}
+
+// RunOnDriveConnectNEQ applies the NEQ predicate on the "run_on_drive_connect" field.
+func RunOnDriveConnectNEQ(v bool) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldRunOnDriveConnect, v))
+}
+
+// DriveActionAfterBackupEQ applies the EQ predicate on the "drive_action_after_backup" field.
+func DriveActionAfterBackupEQ(v DriveActionAfterBackup) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldDriveActionAfterBackup, v))
+}
+
+// DriveActionAfterBackupNEQ applies the NEQ predicate on the "drive_action_after_backup" field.
+func DriveActionAfterBackupNEQ(v DriveActionAfterBackup) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldDriveActionAfterBackup, v))
+}
+
+// DriveActionAfterBackupIn applies the In predicate on the "drive_action_after_backup" field.
+func DriveActionAfterBackupIn(vs ...DriveActionAfterBackup) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIn(FieldDriveActionAfterBackup, vs...))
+}
+
+// DriveActionAfterBackupNotIn applies the NotIn predicate on the "drive_action_after_backup" field.
+func DriveActionAfterBackupNotIn(vs ...DriveActionAfterBackup) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotIn(FieldDriveActionAfterBackup, vs...))
+}
+
 // NextRunEQ applies the EQ predicate on the "next_run" field.
 func NextRunEQ(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldEQ(FieldNextRun, v))
@@ -475,6 +700,56 @@ func NextRunNotNil() predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldNotNull(FieldNextRun))
 }
 
+// CatchUpAtEQ applies the EQ predicate on the "catch_up_at" field.
+func CatchUpAtEQ(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldEQ(FieldCatchUpAt, v))
+}
+
+// CatchUpAtNEQ applies the NEQ predicate on the "catch_up_at" field.
+func CatchUpAtNEQ(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNEQ(FieldCatchUpAt, v))
+}
+
+// CatchUpAtIn applies the In predicate on the "catch_up_at" field.
+func CatchUpAtIn(vs ...time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIn(FieldCatchUpAt, vs...))
+}
+
+// CatchUpAtNotIn applies the NotIn predicate on the "catch_up_at" field.
+func CatchUpAtNotIn(vs ...time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotIn(FieldCatchUpAt, vs...))
+}
+
+// CatchUpAtGT applies the GT predicate on the "catch_up_at" field.
+func CatchUpAtGT(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGT(FieldCatchUpAt, v))
+}
+
+// CatchUpAtGTE applies the GTE predicate on the "catch_up_at" field.
+func CatchUpAtGTE(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldGTE(FieldCatchUpAt, v))
+}
+
+// CatchUpAtLT applies the LT predicate on the "catch_up_at" field.
+func CatchUpAtLT(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLT(FieldCatchUpAt, v))
+}
+
+// CatchUpAtLTE applies the LTE predicate on the "catch_up_at" field.
+func CatchUpAtLTE(v time.Time) predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldLTE(FieldCatchUpAt, v))
+}
+
+// CatchUpAtIsNil applies the IsNil predicate on the "catch_up_at" field.
+func CatchUpAtIsNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldIsNull(FieldCatchUpAt))
+}
+
+// CatchUpAtNotNil applies the NotNil predicate on the "catch_up_at" field.
+func CatchUpAtNotNil() predicate.BackupSchedule {
+	return predicate.BackupSchedule(sql.FieldNotNull(FieldCatchUpAt))
+}
+
 // LastRunEQ applies the EQ predicate on the "last_run" field.
 func LastRunEQ(v time.Time) predicate.BackupSchedule {
 	return predicate.BackupSchedule(sql.FieldEQ(FieldLastRun, v))
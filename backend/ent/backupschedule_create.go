@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 // BackupScheduleCreate is the builder for creating a BackupSchedule entity.
@@ -107,6 +108,136 @@ func (_c *BackupScheduleCreate) SetMonthlyAt(v time.Time) *BackupScheduleCreate
 	return _c
 }
 
+// SetCronExpressions sets the "cron_expressions" field.
+func (_c *BackupScheduleCreate) SetCronExpressions(v []string) *BackupScheduleCreate {
+	_c.mutation.SetCronExpressions(v)
+	return _c
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_c *BackupScheduleCreate) SetCatchUpPolicy(v backupschedule.CatchUpPolicy) *BackupScheduleCreate {
+	_c.mutation.SetCatchUpPolicy(v)
+	return _c
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableCatchUpPolicy(v *backupschedule.CatchUpPolicy) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetCatchUpPolicy(*v)
+	}
+	return _c
+}
+
+// SetCatchUpDelayMinutes sets the "catch_up_delay_minutes" field.
+func (_c *BackupScheduleCreate) SetCatchUpDelayMinutes(v uint16) *BackupScheduleCreate {
+	_c.mutation.SetCatchUpDelayMinutes(v)
+	return _c
+}
+
+// SetNillableCatchUpDelayMinutes sets the "catch_up_delay_minutes" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableCatchUpDelayMinutes(v *uint16) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetCatchUpDelayMinutes(*v)
+	}
+	return _c
+}
+
+// SetJitterMinutes sets the "jitter_minutes" field.
+func (_c *BackupScheduleCreate) SetJitterMinutes(v uint16) *BackupScheduleCreate {
+	_c.mutation.SetJitterMinutes(v)
+	return _c
+}
+
+// SetNillableJitterMinutes sets the "jitter_minutes" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableJitterMinutes(v *uint16) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetJitterMinutes(*v)
+	}
+	return _c
+}
+
+// SetAllowedHours sets the "allowed_hours" field.
+func (_c *BackupScheduleCreate) SetAllowedHours(v []types.TimeWindow) *BackupScheduleCreate {
+	_c.mutation.SetAllowedHours(v)
+	return _c
+}
+
+// SetBlackoutPeriods sets the "blackout_periods" field.
+func (_c *BackupScheduleCreate) SetBlackoutPeriods(v []types.TimeWindow) *BackupScheduleCreate {
+	_c.mutation.SetBlackoutPeriods(v)
+	return _c
+}
+
+// SetRequireAcPower sets the "require_ac_power" field.
+func (_c *BackupScheduleCreate) SetRequireAcPower(v bool) *BackupScheduleCreate {
+	_c.mutation.SetRequireAcPower(v)
+	return _c
+}
+
+// SetNillableRequireAcPower sets the "require_ac_power" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableRequireAcPower(v *bool) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetRequireAcPower(*v)
+	}
+	return _c
+}
+
+// SetRequireUnmeteredNetwork sets the "require_unmetered_network" field.
+func (_c *BackupScheduleCreate) SetRequireUnmeteredNetwork(v bool) *BackupScheduleCreate {
+	_c.mutation.SetRequireUnmeteredNetwork(v)
+	return _c
+}
+
+// SetNillableRequireUnmeteredNetwork sets the "require_unmetered_network" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableRequireUnmeteredNetwork(v *bool) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetRequireUnmeteredNetwork(*v)
+	}
+	return _c
+}
+
+// SetRequireReachableRepository sets the "require_reachable_repository" field.
+func (_c *BackupScheduleCreate) SetRequireReachableRepository(v bool) *BackupScheduleCreate {
+	_c.mutation.SetRequireReachableRepository(v)
+	return _c
+}
+
+// SetNillableRequireReachableRepository sets the "require_reachable_repository" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableRequireReachableRepository(v *bool) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetRequireReachableRepository(*v)
+	}
+	return _c
+}
+
+// SetRunOnDriveConnect sets the "run_on_drive_connect" field.
+func (_c *BackupScheduleCreate) SetRunOnDriveConnect(v bool) *BackupScheduleCreate {
+	_c.mutation.SetRunOnDriveConnect(v)
+	return _c
+}
+
+// SetNillableRunOnDriveConnect sets the "run_on_drive_connect" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableRunOnDriveConnect(v *bool) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetRunOnDriveConnect(*v)
+	}
+	return _c
+}
+
+// SetDriveActionAfterBackup sets the "drive_action_after_backup" field.
+func (_c *BackupScheduleCreate) SetDriveActionAfterBackup(v backupschedule.DriveActionAfterBackup) *BackupScheduleCreate {
+	_c.mutation.SetDriveActionAfterBackup(v)
+	return _c
+}
+
+// SetNillableDriveActionAfterBackup sets the "drive_action_after_backup" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableDriveActionAfterBackup(v *backupschedule.DriveActionAfterBackup) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetDriveActionAfterBackup(*v)
+	}
+	return _c
+}
+
 // SetNextRun sets the "next_run" field.
 func (_c *BackupScheduleCreate) SetNextRun(v time.Time) *BackupScheduleCreate {
 	_c.mutation.SetNextRun(v)
@@ -121,6 +252,20 @@ func (_c *BackupScheduleCreate) SetNillableNextRun(v *time.Time) *BackupSchedule
 	return _c
 }
 
+// SetCatchUpAt sets the "catch_up_at" field.
+func (_c *BackupScheduleCreate) SetCatchUpAt(v time.Time) *BackupScheduleCreate {
+	_c.mutation.SetCatchUpAt(v)
+	return _c
+}
+
+// SetNillableCatchUpAt sets the "catch_up_at" field if the given value is not nil.
+func (_c *BackupScheduleCreate) SetNillableCatchUpAt(v *time.Time) *BackupScheduleCreate {
+	if v != nil {
+		_c.SetCatchUpAt(*v)
+	}
+	return _c
+}
+
 // SetLastRun sets the "last_run" field.
 func (_c *BackupScheduleCreate) SetLastRun(v time.Time) *BackupScheduleCreate {
 	_c.mutation.SetLastRun(v)
@@ -217,6 +362,50 @@ func (_c *BackupScheduleCreate) defaults() {
 		v := backupschedule.DefaultIntervalMinutes
 		_c.mutation.SetIntervalMinutes(v)
 	}
+	if _, ok := _c.mutation.CronExpressions(); !ok {
+		v := backupschedule.DefaultCronExpressions
+		_c.mutation.SetCronExpressions(v)
+	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		v := backupschedule.DefaultCatchUpPolicy
+		_c.mutation.SetCatchUpPolicy(v)
+	}
+	if _, ok := _c.mutation.CatchUpDelayMinutes(); !ok {
+		v := backupschedule.DefaultCatchUpDelayMinutes
+		_c.mutation.SetCatchUpDelayMinutes(v)
+	}
+	if _, ok := _c.mutation.JitterMinutes(); !ok {
+		v := backupschedule.DefaultJitterMinutes
+		_c.mutation.SetJitterMinutes(v)
+	}
+	if _, ok := _c.mutation.AllowedHours(); !ok {
+		v := backupschedule.DefaultAllowedHours
+		_c.mutation.SetAllowedHours(v)
+	}
+	if _, ok := _c.mutation.BlackoutPeriods(); !ok {
+		v := backupschedule.DefaultBlackoutPeriods
+		_c.mutation.SetBlackoutPeriods(v)
+	}
+	if _, ok := _c.mutation.RequireAcPower(); !ok {
+		v := backupschedule.DefaultRequireAcPower
+		_c.mutation.SetRequireAcPower(v)
+	}
+	if _, ok := _c.mutation.RequireUnmeteredNetwork(); !ok {
+		v := backupschedule.DefaultRequireUnmeteredNetwork
+		_c.mutation.SetRequireUnmeteredNetwork(v)
+	}
+	if _, ok := _c.mutation.RequireReachableRepository(); !ok {
+		v := backupschedule.DefaultRequireReachableRepository
+		_c.mutation.SetRequireReachableRepository(v)
+	}
+	if _, ok := _c.mutation.RunOnDriveConnect(); !ok {
+		v := backupschedule.DefaultRunOnDriveConnect
+		_c.mutation.SetRunOnDriveConnect(v)
+	}
+	if _, ok := _c.mutation.DriveActionAfterBackup(); !ok {
+		v := backupschedule.DefaultDriveActionAfterBackup
+		_c.mutation.SetDriveActionAfterBackup(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -263,6 +452,50 @@ func (_c *BackupScheduleCreate) check() error {
 	if _, ok := _c.mutation.MonthlyAt(); !ok {
 		return &ValidationError{Name: "monthly_at", err: errors.New(`ent: missing required field "BackupSchedule.monthly_at"`)}
 	}
+	if _, ok := _c.mutation.CatchUpPolicy(); !ok {
+		return &ValidationError{Name: "catch_up_policy", err: errors.New(`ent: missing required field "BackupSchedule.catch_up_policy"`)}
+	}
+	if v, ok := _c.mutation.CatchUpPolicy(); ok {
+		if err := backupschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CatchUpDelayMinutes(); !ok {
+		return &ValidationError{Name: "catch_up_delay_minutes", err: errors.New(`ent: missing required field "BackupSchedule.catch_up_delay_minutes"`)}
+	}
+	if v, ok := _c.mutation.CatchUpDelayMinutes(); ok {
+		if err := backupschedule.CatchUpDelayMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_delay_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_delay_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.JitterMinutes(); !ok {
+		return &ValidationError{Name: "jitter_minutes", err: errors.New(`ent: missing required field "BackupSchedule.jitter_minutes"`)}
+	}
+	if v, ok := _c.mutation.JitterMinutes(); ok {
+		if err := backupschedule.JitterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.jitter_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequireAcPower(); !ok {
+		return &ValidationError{Name: "require_ac_power", err: errors.New(`ent: missing required field "BackupSchedule.require_ac_power"`)}
+	}
+	if _, ok := _c.mutation.RequireUnmeteredNetwork(); !ok {
+		return &ValidationError{Name: "require_unmetered_network", err: errors.New(`ent: missing required field "BackupSchedule.require_unmetered_network"`)}
+	}
+	if _, ok := _c.mutation.RequireReachableRepository(); !ok {
+		return &ValidationError{Name: "require_reachable_repository", err: errors.New(`ent: missing required field "BackupSchedule.require_reachable_repository"`)}
+	}
+	if _, ok := _c.mutation.RunOnDriveConnect(); !ok {
+		return &ValidationError{Name: "run_on_drive_connect", err: errors.New(`ent: missing required field "BackupSchedule.run_on_drive_connect"`)}
+	}
+	if _, ok := _c.mutation.DriveActionAfterBackup(); !ok {
+		return &ValidationError{Name: "drive_action_after_backup", err: errors.New(`ent: missing required field "BackupSchedule.drive_action_after_backup"`)}
+	}
+	if v, ok := _c.mutation.DriveActionAfterBackup(); ok {
+		if err := backupschedule.DriveActionAfterBackupValidator(v); err != nil {
+			return &ValidationError{Name: "drive_action_after_backup", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.drive_action_after_backup": %w`, err)}
+		}
+	}
 	if len(_c.mutation.BackupProfileIDs()) == 0 {
 		return &ValidationError{Name: "backup_profile", err: errors.New(`ent: missing required edge "BackupSchedule.backup_profile"`)}
 	}
@@ -334,10 +567,58 @@ func (_c *BackupScheduleCreate) createSpec() (*BackupSchedule, *sqlgraph.CreateS
 		_spec.SetField(backupschedule.FieldMonthlyAt, field.TypeTime, value)
 		_node.MonthlyAt = value
 	}
+	if value, ok := _c.mutation.CronExpressions(); ok {
+		_spec.SetField(backupschedule.FieldCronExpressions, field.TypeJSON, value)
+		_node.CronExpressions = value
+	}
+	if value, ok := _c.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+		_node.CatchUpPolicy = value
+	}
+	if value, ok := _c.mutation.CatchUpDelayMinutes(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpDelayMinutes, field.TypeUint16, value)
+		_node.CatchUpDelayMinutes = value
+	}
+	if value, ok := _c.mutation.JitterMinutes(); ok {
+		_spec.SetField(backupschedule.FieldJitterMinutes, field.TypeUint16, value)
+		_node.JitterMinutes = value
+	}
+	if value, ok := _c.mutation.AllowedHours(); ok {
+		_spec.SetField(backupschedule.FieldAllowedHours, field.TypeJSON, value)
+		_node.AllowedHours = value
+	}
+	if value, ok := _c.mutation.BlackoutPeriods(); ok {
+		_spec.SetField(backupschedule.FieldBlackoutPeriods, field.TypeJSON, value)
+		_node.BlackoutPeriods = value
+	}
+	if value, ok := _c.mutation.RequireAcPower(); ok {
+		_spec.SetField(backupschedule.FieldRequireAcPower, field.TypeBool, value)
+		_node.RequireAcPower = value
+	}
+	if value, ok := _c.mutation.RequireUnmeteredNetwork(); ok {
+		_spec.SetField(backupschedule.FieldRequireUnmeteredNetwork, field.TypeBool, value)
+		_node.RequireUnmeteredNetwork = value
+	}
+	if value, ok := _c.mutation.RequireReachableRepository(); ok {
+		_spec.SetField(backupschedule.FieldRequireReachableRepository, field.TypeBool, value)
+		_node.RequireReachableRepository = value
+	}
+	if value, ok := _c.mutation.RunOnDriveConnect(); ok {
+		_spec.SetField(backupschedule.FieldRunOnDriveConnect, field.TypeBool, value)
+		_node.RunOnDriveConnect = value
+	}
+	if value, ok := _c.mutation.DriveActionAfterBackup(); ok {
+		_spec.SetField(backupschedule.FieldDriveActionAfterBackup, field.TypeEnum, value)
+		_node.DriveActionAfterBackup = value
+	}
 	if value, ok := _c.mutation.NextRun(); ok {
 		_spec.SetField(backupschedule.FieldNextRun, field.TypeTime, value)
 		_node.NextRun = value
 	}
+	if value, ok := _c.mutation.CatchUpAt(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpAt, field.TypeTime, value)
+		_node.CatchUpAt = &value
+	}
 	if value, ok := _c.mutation.LastRun(); ok {
 		_spec.SetField(backupschedule.FieldLastRun, field.TypeTime, value)
 		_node.LastRun = &value
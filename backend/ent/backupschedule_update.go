@@ -10,10 +10,12 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/predicate"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 // BackupScheduleUpdate is the builder for updating BackupSchedule entities.
@@ -148,6 +150,186 @@ func (_u *BackupScheduleUpdate) SetNillableMonthlyAt(v *time.Time) *BackupSchedu
 	return _u
 }
 
+// SetCronExpressions sets the "cron_expressions" field.
+func (_u *BackupScheduleUpdate) SetCronExpressions(v []string) *BackupScheduleUpdate {
+	_u.mutation.SetCronExpressions(v)
+	return _u
+}
+
+// AppendCronExpressions appends value to the "cron_expressions" field.
+func (_u *BackupScheduleUpdate) AppendCronExpressions(v []string) *BackupScheduleUpdate {
+	_u.mutation.AppendCronExpressions(v)
+	return _u
+}
+
+// ClearCronExpressions clears the value of the "cron_expressions" field.
+func (_u *BackupScheduleUpdate) ClearCronExpressions() *BackupScheduleUpdate {
+	_u.mutation.ClearCronExpressions()
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *BackupScheduleUpdate) SetCatchUpPolicy(v backupschedule.CatchUpPolicy) *BackupScheduleUpdate {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableCatchUpPolicy(v *backupschedule.CatchUpPolicy) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetCatchUpDelayMinutes sets the "catch_up_delay_minutes" field.
+func (_u *BackupScheduleUpdate) SetCatchUpDelayMinutes(v uint16) *BackupScheduleUpdate {
+	_u.mutation.ResetCatchUpDelayMinutes()
+	_u.mutation.SetCatchUpDelayMinutes(v)
+	return _u
+}
+
+// SetNillableCatchUpDelayMinutes sets the "catch_up_delay_minutes" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableCatchUpDelayMinutes(v *uint16) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetCatchUpDelayMinutes(*v)
+	}
+	return _u
+}
+
+// AddCatchUpDelayMinutes adds value to the "catch_up_delay_minutes" field.
+func (_u *BackupScheduleUpdate) AddCatchUpDelayMinutes(v int16) *BackupScheduleUpdate {
+	_u.mutation.AddCatchUpDelayMinutes(v)
+	return _u
+}
+
+// SetJitterMinutes sets the "jitter_minutes" field.
+func (_u *BackupScheduleUpdate) SetJitterMinutes(v uint16) *BackupScheduleUpdate {
+	_u.mutation.ResetJitterMinutes()
+	_u.mutation.SetJitterMinutes(v)
+	return _u
+}
+
+// SetNillableJitterMinutes sets the "jitter_minutes" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableJitterMinutes(v *uint16) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetJitterMinutes(*v)
+	}
+	return _u
+}
+
+// AddJitterMinutes adds value to the "jitter_minutes" field.
+func (_u *BackupScheduleUpdate) AddJitterMinutes(v int16) *BackupScheduleUpdate {
+	_u.mutation.AddJitterMinutes(v)
+	return _u
+}
+
+// SetAllowedHours sets the "allowed_hours" field.
+func (_u *BackupScheduleUpdate) SetAllowedHours(v []types.TimeWindow) *BackupScheduleUpdate {
+	_u.mutation.SetAllowedHours(v)
+	return _u
+}
+
+// AppendAllowedHours appends value to the "allowed_hours" field.
+func (_u *BackupScheduleUpdate) AppendAllowedHours(v []types.TimeWindow) *BackupScheduleUpdate {
+	_u.mutation.AppendAllowedHours(v)
+	return _u
+}
+
+// ClearAllowedHours clears the value of the "allowed_hours" field.
+func (_u *BackupScheduleUpdate) ClearAllowedHours() *BackupScheduleUpdate {
+	_u.mutation.ClearAllowedHours()
+	return _u
+}
+
+// SetBlackoutPeriods sets the "blackout_periods" field.
+func (_u *BackupScheduleUpdate) SetBlackoutPeriods(v []types.TimeWindow) *BackupScheduleUpdate {
+	_u.mutation.SetBlackoutPeriods(v)
+	return _u
+}
+
+// AppendBlackoutPeriods appends value to the "blackout_periods" field.
+func (_u *BackupScheduleUpdate) AppendBlackoutPeriods(v []types.TimeWindow) *BackupScheduleUpdate {
+	_u.mutation.AppendBlackoutPeriods(v)
+	return _u
+}
+
+// ClearBlackoutPeriods clears the value of the "blackout_periods" field.
+func (_u *BackupScheduleUpdate) ClearBlackoutPeriods() *BackupScheduleUpdate {
+	_u.mutation.ClearBlackoutPeriods()
+	return _u
+}
+
+// SetRequireAcPower sets the "require_ac_power" field.
+func (_u *BackupScheduleUpdate) SetRequireAcPower(v bool) *BackupScheduleUpdate {
+	_u.mutation.SetRequireAcPower(v)
+	return _u
+}
+
+// SetNillableRequireAcPower sets the "require_ac_power" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableRequireAcPower(v *bool) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetRequireAcPower(*v)
+	}
+	return _u
+}
+
+// SetRequireUnmeteredNetwork sets the "require_unmetered_network" field.
+func (_u *BackupScheduleUpdate) SetRequireUnmeteredNetwork(v bool) *BackupScheduleUpdate {
+	_u.mutation.SetRequireUnmeteredNetwork(v)
+	return _u
+}
+
+// SetNillableRequireUnmeteredNetwork sets the "require_unmetered_network" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableRequireUnmeteredNetwork(v *bool) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetRequireUnmeteredNetwork(*v)
+	}
+	return _u
+}
+
+// SetRequireReachableRepository sets the "require_reachable_repository" field.
+func (_u *BackupScheduleUpdate) SetRequireReachableRepository(v bool) *BackupScheduleUpdate {
+	_u.mutation.SetRequireReachableRepository(v)
+	return _u
+}
+
+// SetNillableRequireReachableRepository sets the "require_reachable_repository" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableRequireReachableRepository(v *bool) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetRequireReachableRepository(*v)
+	}
+	return _u
+}
+
+// SetRunOnDriveConnect sets the "run_on_drive_connect" field.
+func (_u *BackupScheduleUpdate) SetRunOnDriveConnect(v bool) *BackupScheduleUpdate {
+	_u.mutation.SetRunOnDriveConnect(v)
+	return _u
+}
+
+// SetNillableRunOnDriveConnect sets the "run_on_drive_connect" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableRunOnDriveConnect(v *bool) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetRunOnDriveConnect(*v)
+	}
+	return _u
+}
+
+// SetDriveActionAfterBackup sets the "drive_action_after_backup" field.
+func (_u *BackupScheduleUpdate) SetDriveActionAfterBackup(v backupschedule.DriveActionAfterBackup) *BackupScheduleUpdate {
+	_u.mutation.SetDriveActionAfterBackup(v)
+	return _u
+}
+
+// SetNillableDriveActionAfterBackup sets the "drive_action_after_backup" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableDriveActionAfterBackup(v *backupschedule.DriveActionAfterBackup) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetDriveActionAfterBackup(*v)
+	}
+	return _u
+}
+
 // SetNextRun sets the "next_run" field.
 func (_u *BackupScheduleUpdate) SetNextRun(v time.Time) *BackupScheduleUpdate {
 	_u.mutation.SetNextRun(v)
@@ -168,6 +350,26 @@ func (_u *BackupScheduleUpdate) ClearNextRun() *BackupScheduleUpdate {
 	return _u
 }
 
+// SetCatchUpAt sets the "catch_up_at" field.
+func (_u *BackupScheduleUpdate) SetCatchUpAt(v time.Time) *BackupScheduleUpdate {
+	_u.mutation.SetCatchUpAt(v)
+	return _u
+}
+
+// SetNillableCatchUpAt sets the "catch_up_at" field if the given value is not nil.
+func (_u *BackupScheduleUpdate) SetNillableCatchUpAt(v *time.Time) *BackupScheduleUpdate {
+	if v != nil {
+		_u.SetCatchUpAt(*v)
+	}
+	return _u
+}
+
+// ClearCatchUpAt clears the value of the "catch_up_at" field.
+func (_u *BackupScheduleUpdate) ClearCatchUpAt() *BackupScheduleUpdate {
+	_u.mutation.ClearCatchUpAt()
+	return _u
+}
+
 // SetLastRun sets the "last_run" field.
 func (_u *BackupScheduleUpdate) SetLastRun(v time.Time) *BackupScheduleUpdate {
 	_u.mutation.SetLastRun(v)
@@ -283,6 +485,26 @@ func (_u *BackupScheduleUpdate) check() error {
 			return &ValidationError{Name: "monthday", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.monthday": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := backupschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpDelayMinutes(); ok {
+		if err := backupschedule.CatchUpDelayMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_delay_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_delay_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.JitterMinutes(); ok {
+		if err := backupschedule.JitterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.jitter_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DriveActionAfterBackup(); ok {
+		if err := backupschedule.DriveActionAfterBackupValidator(v); err != nil {
+			return &ValidationError{Name: "drive_action_after_backup", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.drive_action_after_backup": %w`, err)}
+		}
+	}
 	if _u.mutation.BackupProfileCleared() && len(_u.mutation.BackupProfileIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "BackupSchedule.backup_profile"`)
 	}
@@ -337,12 +559,81 @@ func (_u *BackupScheduleUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.MonthlyAt(); ok {
 		_spec.SetField(backupschedule.FieldMonthlyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CronExpressions(); ok {
+		_spec.SetField(backupschedule.FieldCronExpressions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCronExpressions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldCronExpressions, value)
+		})
+	}
+	if _u.mutation.CronExpressionsCleared() {
+		_spec.ClearField(backupschedule.FieldCronExpressions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpDelayMinutes(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpDelayMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AddedCatchUpDelayMinutes(); ok {
+		_spec.AddField(backupschedule.FieldCatchUpDelayMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.JitterMinutes(); ok {
+		_spec.SetField(backupschedule.FieldJitterMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AddedJitterMinutes(); ok {
+		_spec.AddField(backupschedule.FieldJitterMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AllowedHours(); ok {
+		_spec.SetField(backupschedule.FieldAllowedHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldAllowedHours, value)
+		})
+	}
+	if _u.mutation.AllowedHoursCleared() {
+		_spec.ClearField(backupschedule.FieldAllowedHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BlackoutPeriods(); ok {
+		_spec.SetField(backupschedule.FieldBlackoutPeriods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBlackoutPeriods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldBlackoutPeriods, value)
+		})
+	}
+	if _u.mutation.BlackoutPeriodsCleared() {
+		_spec.ClearField(backupschedule.FieldBlackoutPeriods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequireAcPower(); ok {
+		_spec.SetField(backupschedule.FieldRequireAcPower, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireUnmeteredNetwork(); ok {
+		_spec.SetField(backupschedule.FieldRequireUnmeteredNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireReachableRepository(); ok {
+		_spec.SetField(backupschedule.FieldRequireReachableRepository, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RunOnDriveConnect(); ok {
+		_spec.SetField(backupschedule.FieldRunOnDriveConnect, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DriveActionAfterBackup(); ok {
+		_spec.SetField(backupschedule.FieldDriveActionAfterBackup, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.NextRun(); ok {
 		_spec.SetField(backupschedule.FieldNextRun, field.TypeTime, value)
 	}
 	if _u.mutation.NextRunCleared() {
 		_spec.ClearField(backupschedule.FieldNextRun, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CatchUpAt(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpAt, field.TypeTime, value)
+	}
+	if _u.mutation.CatchUpAtCleared() {
+		_spec.ClearField(backupschedule.FieldCatchUpAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastRun(); ok {
 		_spec.SetField(backupschedule.FieldLastRun, field.TypeTime, value)
 	}
@@ -524,6 +815,186 @@ func (_u *BackupScheduleUpdateOne) SetNillableMonthlyAt(v *time.Time) *BackupSch
 	return _u
 }
 
+// SetCronExpressions sets the "cron_expressions" field.
+func (_u *BackupScheduleUpdateOne) SetCronExpressions(v []string) *BackupScheduleUpdateOne {
+	_u.mutation.SetCronExpressions(v)
+	return _u
+}
+
+// AppendCronExpressions appends value to the "cron_expressions" field.
+func (_u *BackupScheduleUpdateOne) AppendCronExpressions(v []string) *BackupScheduleUpdateOne {
+	_u.mutation.AppendCronExpressions(v)
+	return _u
+}
+
+// ClearCronExpressions clears the value of the "cron_expressions" field.
+func (_u *BackupScheduleUpdateOne) ClearCronExpressions() *BackupScheduleUpdateOne {
+	_u.mutation.ClearCronExpressions()
+	return _u
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (_u *BackupScheduleUpdateOne) SetCatchUpPolicy(v backupschedule.CatchUpPolicy) *BackupScheduleUpdateOne {
+	_u.mutation.SetCatchUpPolicy(v)
+	return _u
+}
+
+// SetNillableCatchUpPolicy sets the "catch_up_policy" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableCatchUpPolicy(v *backupschedule.CatchUpPolicy) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatchUpPolicy(*v)
+	}
+	return _u
+}
+
+// SetCatchUpDelayMinutes sets the "catch_up_delay_minutes" field.
+func (_u *BackupScheduleUpdateOne) SetCatchUpDelayMinutes(v uint16) *BackupScheduleUpdateOne {
+	_u.mutation.ResetCatchUpDelayMinutes()
+	_u.mutation.SetCatchUpDelayMinutes(v)
+	return _u
+}
+
+// SetNillableCatchUpDelayMinutes sets the "catch_up_delay_minutes" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableCatchUpDelayMinutes(v *uint16) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatchUpDelayMinutes(*v)
+	}
+	return _u
+}
+
+// AddCatchUpDelayMinutes adds value to the "catch_up_delay_minutes" field.
+func (_u *BackupScheduleUpdateOne) AddCatchUpDelayMinutes(v int16) *BackupScheduleUpdateOne {
+	_u.mutation.AddCatchUpDelayMinutes(v)
+	return _u
+}
+
+// SetJitterMinutes sets the "jitter_minutes" field.
+func (_u *BackupScheduleUpdateOne) SetJitterMinutes(v uint16) *BackupScheduleUpdateOne {
+	_u.mutation.ResetJitterMinutes()
+	_u.mutation.SetJitterMinutes(v)
+	return _u
+}
+
+// SetNillableJitterMinutes sets the "jitter_minutes" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableJitterMinutes(v *uint16) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetJitterMinutes(*v)
+	}
+	return _u
+}
+
+// AddJitterMinutes adds value to the "jitter_minutes" field.
+func (_u *BackupScheduleUpdateOne) AddJitterMinutes(v int16) *BackupScheduleUpdateOne {
+	_u.mutation.AddJitterMinutes(v)
+	return _u
+}
+
+// SetAllowedHours sets the "allowed_hours" field.
+func (_u *BackupScheduleUpdateOne) SetAllowedHours(v []types.TimeWindow) *BackupScheduleUpdateOne {
+	_u.mutation.SetAllowedHours(v)
+	return _u
+}
+
+// AppendAllowedHours appends value to the "allowed_hours" field.
+func (_u *BackupScheduleUpdateOne) AppendAllowedHours(v []types.TimeWindow) *BackupScheduleUpdateOne {
+	_u.mutation.AppendAllowedHours(v)
+	return _u
+}
+
+// ClearAllowedHours clears the value of the "allowed_hours" field.
+func (_u *BackupScheduleUpdateOne) ClearAllowedHours() *BackupScheduleUpdateOne {
+	_u.mutation.ClearAllowedHours()
+	return _u
+}
+
+// SetBlackoutPeriods sets the "blackout_periods" field.
+func (_u *BackupScheduleUpdateOne) SetBlackoutPeriods(v []types.TimeWindow) *BackupScheduleUpdateOne {
+	_u.mutation.SetBlackoutPeriods(v)
+	return _u
+}
+
+// AppendBlackoutPeriods appends value to the "blackout_periods" field.
+func (_u *BackupScheduleUpdateOne) AppendBlackoutPeriods(v []types.TimeWindow) *BackupScheduleUpdateOne {
+	_u.mutation.AppendBlackoutPeriods(v)
+	return _u
+}
+
+// ClearBlackoutPeriods clears the value of the "blackout_periods" field.
+func (_u *BackupScheduleUpdateOne) ClearBlackoutPeriods() *BackupScheduleUpdateOne {
+	_u.mutation.ClearBlackoutPeriods()
+	return _u
+}
+
+// SetRequireAcPower sets the "require_ac_power" field.
+func (_u *BackupScheduleUpdateOne) SetRequireAcPower(v bool) *BackupScheduleUpdateOne {
+	_u.mutation.SetRequireAcPower(v)
+	return _u
+}
+
+// SetNillableRequireAcPower sets the "require_ac_power" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableRequireAcPower(v *bool) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetRequireAcPower(*v)
+	}
+	return _u
+}
+
+// SetRequireUnmeteredNetwork sets the "require_unmetered_network" field.
+func (_u *BackupScheduleUpdateOne) SetRequireUnmeteredNetwork(v bool) *BackupScheduleUpdateOne {
+	_u.mutation.SetRequireUnmeteredNetwork(v)
+	return _u
+}
+
+// SetNillableRequireUnmeteredNetwork sets the "require_unmetered_network" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableRequireUnmeteredNetwork(v *bool) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetRequireUnmeteredNetwork(*v)
+	}
+	return _u
+}
+
+// SetRequireReachableRepository sets the "require_reachable_repository" field.
+func (_u *BackupScheduleUpdateOne) SetRequireReachableRepository(v bool) *BackupScheduleUpdateOne {
+	_u.mutation.SetRequireReachableRepository(v)
+	return _u
+}
+
+// SetNillableRequireReachableRepository sets the "require_reachable_repository" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableRequireReachableRepository(v *bool) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetRequireReachableRepository(*v)
+	}
+	return _u
+}
+
+// SetRunOnDriveConnect sets the "run_on_drive_connect" field.
+func (_u *BackupScheduleUpdateOne) SetRunOnDriveConnect(v bool) *BackupScheduleUpdateOne {
+	_u.mutation.SetRunOnDriveConnect(v)
+	return _u
+}
+
+// SetNillableRunOnDriveConnect sets the "run_on_drive_connect" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableRunOnDriveConnect(v *bool) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetRunOnDriveConnect(*v)
+	}
+	return _u
+}
+
+// SetDriveActionAfterBackup sets the "drive_action_after_backup" field.
+func (_u *BackupScheduleUpdateOne) SetDriveActionAfterBackup(v backupschedule.DriveActionAfterBackup) *BackupScheduleUpdateOne {
+	_u.mutation.SetDriveActionAfterBackup(v)
+	return _u
+}
+
+// SetNillableDriveActionAfterBackup sets the "drive_action_after_backup" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableDriveActionAfterBackup(v *backupschedule.DriveActionAfterBackup) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetDriveActionAfterBackup(*v)
+	}
+	return _u
+}
+
 // SetNextRun sets the "next_run" field.
 func (_u *BackupScheduleUpdateOne) SetNextRun(v time.Time) *BackupScheduleUpdateOne {
 	_u.mutation.SetNextRun(v)
@@ -544,6 +1015,26 @@ func (_u *BackupScheduleUpdateOne) ClearNextRun() *BackupScheduleUpdateOne {
 	return _u
 }
 
+// SetCatchUpAt sets the "catch_up_at" field.
+func (_u *BackupScheduleUpdateOne) SetCatchUpAt(v time.Time) *BackupScheduleUpdateOne {
+	_u.mutation.SetCatchUpAt(v)
+	return _u
+}
+
+// SetNillableCatchUpAt sets the "catch_up_at" field if the given value is not nil.
+func (_u *BackupScheduleUpdateOne) SetNillableCatchUpAt(v *time.Time) *BackupScheduleUpdateOne {
+	if v != nil {
+		_u.SetCatchUpAt(*v)
+	}
+	return _u
+}
+
+// ClearCatchUpAt clears the value of the "catch_up_at" field.
+func (_u *BackupScheduleUpdateOne) ClearCatchUpAt() *BackupScheduleUpdateOne {
+	_u.mutation.ClearCatchUpAt()
+	return _u
+}
+
 // SetLastRun sets the "last_run" field.
 func (_u *BackupScheduleUpdateOne) SetLastRun(v time.Time) *BackupScheduleUpdateOne {
 	_u.mutation.SetLastRun(v)
@@ -672,6 +1163,26 @@ func (_u *BackupScheduleUpdateOne) check() error {
 			return &ValidationError{Name: "monthday", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.monthday": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.CatchUpPolicy(); ok {
+		if err := backupschedule.CatchUpPolicyValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_policy", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_policy": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CatchUpDelayMinutes(); ok {
+		if err := backupschedule.CatchUpDelayMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "catch_up_delay_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.catch_up_delay_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.JitterMinutes(); ok {
+		if err := backupschedule.JitterMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "jitter_minutes", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.jitter_minutes": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DriveActionAfterBackup(); ok {
+		if err := backupschedule.DriveActionAfterBackupValidator(v); err != nil {
+			return &ValidationError{Name: "drive_action_after_backup", err: fmt.Errorf(`ent: validator failed for field "BackupSchedule.drive_action_after_backup": %w`, err)}
+		}
+	}
 	if _u.mutation.BackupProfileCleared() && len(_u.mutation.BackupProfileIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "BackupSchedule.backup_profile"`)
 	}
@@ -743,12 +1254,81 @@ func (_u *BackupScheduleUpdateOne) sqlSave(ctx context.Context) (_node *BackupSc
 	if value, ok := _u.mutation.MonthlyAt(); ok {
 		_spec.SetField(backupschedule.FieldMonthlyAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.CronExpressions(); ok {
+		_spec.SetField(backupschedule.FieldCronExpressions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCronExpressions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldCronExpressions, value)
+		})
+	}
+	if _u.mutation.CronExpressionsCleared() {
+		_spec.ClearField(backupschedule.FieldCronExpressions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CatchUpPolicy(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpPolicy, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.CatchUpDelayMinutes(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpDelayMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AddedCatchUpDelayMinutes(); ok {
+		_spec.AddField(backupschedule.FieldCatchUpDelayMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.JitterMinutes(); ok {
+		_spec.SetField(backupschedule.FieldJitterMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AddedJitterMinutes(); ok {
+		_spec.AddField(backupschedule.FieldJitterMinutes, field.TypeUint16, value)
+	}
+	if value, ok := _u.mutation.AllowedHours(); ok {
+		_spec.SetField(backupschedule.FieldAllowedHours, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAllowedHours(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldAllowedHours, value)
+		})
+	}
+	if _u.mutation.AllowedHoursCleared() {
+		_spec.ClearField(backupschedule.FieldAllowedHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.BlackoutPeriods(); ok {
+		_spec.SetField(backupschedule.FieldBlackoutPeriods, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedBlackoutPeriods(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backupschedule.FieldBlackoutPeriods, value)
+		})
+	}
+	if _u.mutation.BlackoutPeriodsCleared() {
+		_spec.ClearField(backupschedule.FieldBlackoutPeriods, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RequireAcPower(); ok {
+		_spec.SetField(backupschedule.FieldRequireAcPower, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireUnmeteredNetwork(); ok {
+		_spec.SetField(backupschedule.FieldRequireUnmeteredNetwork, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequireReachableRepository(); ok {
+		_spec.SetField(backupschedule.FieldRequireReachableRepository, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RunOnDriveConnect(); ok {
+		_spec.SetField(backupschedule.FieldRunOnDriveConnect, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DriveActionAfterBackup(); ok {
+		_spec.SetField(backupschedule.FieldDriveActionAfterBackup, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.NextRun(); ok {
 		_spec.SetField(backupschedule.FieldNextRun, field.TypeTime, value)
 	}
 	if _u.mutation.NextRunCleared() {
 		_spec.ClearField(backupschedule.FieldNextRun, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CatchUpAt(); ok {
+		_spec.SetField(backupschedule.FieldCatchUpAt, field.TypeTime, value)
+	}
+	if _u.mutation.CatchUpAtCleared() {
+		_spec.ClearField(backupschedule.FieldCatchUpAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.LastRun(); ok {
 		_spec.SetField(backupschedule.FieldLastRun, field.TypeTime, value)
 	}
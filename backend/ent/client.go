@@ -17,13 +17,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/loomi-labs/arco/backend/ent/analyticsevent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/authsession"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/pruningrule"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 	"github.com/loomi-labs/arco/backend/ent/settings"
 	"github.com/loomi-labs/arco/backend/ent/user"
 )
@@ -37,8 +40,12 @@ type Client struct {
 	AnalyticsEvent *AnalyticsEventClient
 	// Archive is the client for interacting with the Archive builders.
 	Archive *ArchiveClient
+	// ArchiveFile is the client for interacting with the ArchiveFile builders.
+	ArchiveFile *ArchiveFileClient
 	// AuthSession is the client for interacting with the AuthSession builders.
 	AuthSession *AuthSessionClient
+	// BackupHook is the client for interacting with the BackupHook builders.
+	BackupHook *BackupHookClient
 	// BackupProfile is the client for interacting with the BackupProfile builders.
 	BackupProfile *BackupProfileClient
 	// BackupSchedule is the client for interacting with the BackupSchedule builders.
@@ -51,6 +58,8 @@ type Client struct {
 	PruningRule *PruningRuleClient
 	// Repository is the client for interacting with the Repository builders.
 	Repository *RepositoryClient
+	// RepositoryStatsSnapshot is the client for interacting with the RepositoryStatsSnapshot builders.
+	RepositoryStatsSnapshot *RepositoryStatsSnapshotClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
 	// User is the client for interacting with the User builders.
@@ -68,13 +77,16 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AnalyticsEvent = NewAnalyticsEventClient(c.config)
 	c.Archive = NewArchiveClient(c.config)
+	c.ArchiveFile = NewArchiveFileClient(c.config)
 	c.AuthSession = NewAuthSessionClient(c.config)
+	c.BackupHook = NewBackupHookClient(c.config)
 	c.BackupProfile = NewBackupProfileClient(c.config)
 	c.BackupSchedule = NewBackupScheduleClient(c.config)
 	c.CloudRepository = NewCloudRepositoryClient(c.config)
 	c.Notification = NewNotificationClient(c.config)
 	c.PruningRule = NewPruningRuleClient(c.config)
 	c.Repository = NewRepositoryClient(c.config)
+	c.RepositoryStatsSnapshot = NewRepositoryStatsSnapshotClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -167,19 +179,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AnalyticsEvent:  NewAnalyticsEventClient(cfg),
-		Archive:         NewArchiveClient(cfg),
-		AuthSession:     NewAuthSessionClient(cfg),
-		BackupProfile:   NewBackupProfileClient(cfg),
-		BackupSchedule:  NewBackupScheduleClient(cfg),
-		CloudRepository: NewCloudRepositoryClient(cfg),
-		Notification:    NewNotificationClient(cfg),
-		PruningRule:     NewPruningRuleClient(cfg),
-		Repository:      NewRepositoryClient(cfg),
-		Settings:        NewSettingsClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AnalyticsEvent:          NewAnalyticsEventClient(cfg),
+		Archive:                 NewArchiveClient(cfg),
+		ArchiveFile:             NewArchiveFileClient(cfg),
+		AuthSession:             NewAuthSessionClient(cfg),
+		BackupHook:              NewBackupHookClient(cfg),
+		BackupProfile:           NewBackupProfileClient(cfg),
+		BackupSchedule:          NewBackupScheduleClient(cfg),
+		CloudRepository:         NewCloudRepositoryClient(cfg),
+		Notification:            NewNotificationClient(cfg),
+		PruningRule:             NewPruningRuleClient(cfg),
+		Repository:              NewRepositoryClient(cfg),
+		RepositoryStatsSnapshot: NewRepositoryStatsSnapshotClient(cfg),
+		Settings:                NewSettingsClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
@@ -197,19 +212,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		AnalyticsEvent:  NewAnalyticsEventClient(cfg),
-		Archive:         NewArchiveClient(cfg),
-		AuthSession:     NewAuthSessionClient(cfg),
-		BackupProfile:   NewBackupProfileClient(cfg),
-		BackupSchedule:  NewBackupScheduleClient(cfg),
-		CloudRepository: NewCloudRepositoryClient(cfg),
-		Notification:    NewNotificationClient(cfg),
-		PruningRule:     NewPruningRuleClient(cfg),
-		Repository:      NewRepositoryClient(cfg),
-		Settings:        NewSettingsClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                     ctx,
+		config:                  cfg,
+		AnalyticsEvent:          NewAnalyticsEventClient(cfg),
+		Archive:                 NewArchiveClient(cfg),
+		ArchiveFile:             NewArchiveFileClient(cfg),
+		AuthSession:             NewAuthSessionClient(cfg),
+		BackupHook:              NewBackupHookClient(cfg),
+		BackupProfile:           NewBackupProfileClient(cfg),
+		BackupSchedule:          NewBackupScheduleClient(cfg),
+		CloudRepository:         NewCloudRepositoryClient(cfg),
+		Notification:            NewNotificationClient(cfg),
+		PruningRule:             NewPruningRuleClient(cfg),
+		Repository:              NewRepositoryClient(cfg),
+		RepositoryStatsSnapshot: NewRepositoryStatsSnapshotClient(cfg),
+		Settings:                NewSettingsClient(cfg),
+		User:                    NewUserClient(cfg),
 	}, nil
 }
 
@@ -239,9 +257,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AnalyticsEvent, c.Archive, c.AuthSession, c.BackupProfile, c.BackupSchedule,
-		c.CloudRepository, c.Notification, c.PruningRule, c.Repository, c.Settings,
-		c.User,
+		c.AnalyticsEvent, c.Archive, c.ArchiveFile, c.AuthSession, c.BackupHook,
+		c.BackupProfile, c.BackupSchedule, c.CloudRepository, c.Notification,
+		c.PruningRule, c.Repository, c.RepositoryStatsSnapshot, c.Settings, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -251,9 +269,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AnalyticsEvent, c.Archive, c.AuthSession, c.BackupProfile, c.BackupSchedule,
-		c.CloudRepository, c.Notification, c.PruningRule, c.Repository, c.Settings,
-		c.User,
+		c.AnalyticsEvent, c.Archive, c.ArchiveFile, c.AuthSession, c.BackupHook,
+		c.BackupProfile, c.BackupSchedule, c.CloudRepository, c.Notification,
+		c.PruningRule, c.Repository, c.RepositoryStatsSnapshot, c.Settings, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -266,8 +284,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AnalyticsEvent.mutate(ctx, m)
 	case *ArchiveMutation:
 		return c.Archive.mutate(ctx, m)
+	case *ArchiveFileMutation:
+		return c.ArchiveFile.mutate(ctx, m)
 	case *AuthSessionMutation:
 		return c.AuthSession.mutate(ctx, m)
+	case *BackupHookMutation:
+		return c.BackupHook.mutate(ctx, m)
 	case *BackupProfileMutation:
 		return c.BackupProfile.mutate(ctx, m)
 	case *BackupScheduleMutation:
@@ -280,6 +302,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PruningRule.mutate(ctx, m)
 	case *RepositoryMutation:
 		return c.Repository.mutate(ctx, m)
+	case *RepositoryStatsSnapshotMutation:
+		return c.RepositoryStatsSnapshot.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
 	case *UserMutation:
@@ -562,6 +586,22 @@ func (c *ArchiveClient) QueryBackupProfile(_m *Archive) *BackupProfileQuery {
 	return query
 }
 
+// QueryFiles queries the files edge of a Archive.
+func (c *ArchiveClient) QueryFiles(_m *Archive) *ArchiveFileQuery {
+	query := (&ArchiveFileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archive.Table, archive.FieldID, id),
+			sqlgraph.To(archivefile.Table, archivefile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, archive.FilesTable, archive.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *ArchiveClient) Hooks() []Hook {
 	return c.hooks.Archive
@@ -587,6 +627,155 @@ func (c *ArchiveClient) mutate(ctx context.Context, m *ArchiveMutation) (Value,
 	}
 }
 
+// ArchiveFileClient is a client for the ArchiveFile schema.
+type ArchiveFileClient struct {
+	config
+}
+
+// NewArchiveFileClient returns a client for the ArchiveFile from the given config.
+func NewArchiveFileClient(c config) *ArchiveFileClient {
+	return &ArchiveFileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `archivefile.Hooks(f(g(h())))`.
+func (c *ArchiveFileClient) Use(hooks ...Hook) {
+	c.hooks.ArchiveFile = append(c.hooks.ArchiveFile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `archivefile.Intercept(f(g(h())))`.
+func (c *ArchiveFileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ArchiveFile = append(c.inters.ArchiveFile, interceptors...)
+}
+
+// Create returns a builder for creating a ArchiveFile entity.
+func (c *ArchiveFileClient) Create() *ArchiveFileCreate {
+	mutation := newArchiveFileMutation(c.config, OpCreate)
+	return &ArchiveFileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ArchiveFile entities.
+func (c *ArchiveFileClient) CreateBulk(builders ...*ArchiveFileCreate) *ArchiveFileCreateBulk {
+	return &ArchiveFileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ArchiveFileClient) MapCreateBulk(slice any, setFunc func(*ArchiveFileCreate, int)) *ArchiveFileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ArchiveFileCreateBulk{err: fmt.Errorf("calling to ArchiveFileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ArchiveFileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ArchiveFileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ArchiveFile.
+func (c *ArchiveFileClient) Update() *ArchiveFileUpdate {
+	mutation := newArchiveFileMutation(c.config, OpUpdate)
+	return &ArchiveFileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ArchiveFileClient) UpdateOne(_m *ArchiveFile) *ArchiveFileUpdateOne {
+	mutation := newArchiveFileMutation(c.config, OpUpdateOne, withArchiveFile(_m))
+	return &ArchiveFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ArchiveFileClient) UpdateOneID(id int) *ArchiveFileUpdateOne {
+	mutation := newArchiveFileMutation(c.config, OpUpdateOne, withArchiveFileID(id))
+	return &ArchiveFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ArchiveFile.
+func (c *ArchiveFileClient) Delete() *ArchiveFileDelete {
+	mutation := newArchiveFileMutation(c.config, OpDelete)
+	return &ArchiveFileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ArchiveFileClient) DeleteOne(_m *ArchiveFile) *ArchiveFileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ArchiveFileClient) DeleteOneID(id int) *ArchiveFileDeleteOne {
+	builder := c.Delete().Where(archivefile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ArchiveFileDeleteOne{builder}
+}
+
+// Query returns a query builder for ArchiveFile.
+func (c *ArchiveFileClient) Query() *ArchiveFileQuery {
+	return &ArchiveFileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeArchiveFile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ArchiveFile entity by its id.
+func (c *ArchiveFileClient) Get(ctx context.Context, id int) (*ArchiveFile, error) {
+	return c.Query().Where(archivefile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ArchiveFileClient) GetX(ctx context.Context, id int) *ArchiveFile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryArchive queries the archive edge of a ArchiveFile.
+func (c *ArchiveFileClient) QueryArchive(_m *ArchiveFile) *ArchiveQuery {
+	query := (&ArchiveClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(archivefile.Table, archivefile.FieldID, id),
+			sqlgraph.To(archive.Table, archive.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, archivefile.ArchiveTable, archivefile.ArchiveColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ArchiveFileClient) Hooks() []Hook {
+	return c.hooks.ArchiveFile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ArchiveFileClient) Interceptors() []Interceptor {
+	return c.inters.ArchiveFile
+}
+
+func (c *ArchiveFileClient) mutate(ctx context.Context, m *ArchiveFileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ArchiveFileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ArchiveFileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ArchiveFileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ArchiveFileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ArchiveFile mutation op: %q", m.Op())
+	}
+}
+
 // AuthSessionClient is a client for the AuthSession schema.
 type AuthSessionClient struct {
 	config
@@ -720,6 +909,155 @@ func (c *AuthSessionClient) mutate(ctx context.Context, m *AuthSessionMutation)
 	}
 }
 
+// BackupHookClient is a client for the BackupHook schema.
+type BackupHookClient struct {
+	config
+}
+
+// NewBackupHookClient returns a client for the BackupHook from the given config.
+func NewBackupHookClient(c config) *BackupHookClient {
+	return &BackupHookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backuphook.Hooks(f(g(h())))`.
+func (c *BackupHookClient) Use(hooks ...Hook) {
+	c.hooks.BackupHook = append(c.hooks.BackupHook, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backuphook.Intercept(f(g(h())))`.
+func (c *BackupHookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackupHook = append(c.inters.BackupHook, interceptors...)
+}
+
+// Create returns a builder for creating a BackupHook entity.
+func (c *BackupHookClient) Create() *BackupHookCreate {
+	mutation := newBackupHookMutation(c.config, OpCreate)
+	return &BackupHookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BackupHook entities.
+func (c *BackupHookClient) CreateBulk(builders ...*BackupHookCreate) *BackupHookCreateBulk {
+	return &BackupHookCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BackupHookClient) MapCreateBulk(slice any, setFunc func(*BackupHookCreate, int)) *BackupHookCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BackupHookCreateBulk{err: fmt.Errorf("calling to BackupHookClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BackupHookCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BackupHookCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BackupHook.
+func (c *BackupHookClient) Update() *BackupHookUpdate {
+	mutation := newBackupHookMutation(c.config, OpUpdate)
+	return &BackupHookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BackupHookClient) UpdateOne(_m *BackupHook) *BackupHookUpdateOne {
+	mutation := newBackupHookMutation(c.config, OpUpdateOne, withBackupHook(_m))
+	return &BackupHookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BackupHookClient) UpdateOneID(id int) *BackupHookUpdateOne {
+	mutation := newBackupHookMutation(c.config, OpUpdateOne, withBackupHookID(id))
+	return &BackupHookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BackupHook.
+func (c *BackupHookClient) Delete() *BackupHookDelete {
+	mutation := newBackupHookMutation(c.config, OpDelete)
+	return &BackupHookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BackupHookClient) DeleteOne(_m *BackupHook) *BackupHookDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BackupHookClient) DeleteOneID(id int) *BackupHookDeleteOne {
+	builder := c.Delete().Where(backuphook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BackupHookDeleteOne{builder}
+}
+
+// Query returns a query builder for BackupHook.
+func (c *BackupHookClient) Query() *BackupHookQuery {
+	return &BackupHookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBackupHook},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BackupHook entity by its id.
+func (c *BackupHookClient) Get(ctx context.Context, id int) (*BackupHook, error) {
+	return c.Query().Where(backuphook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BackupHookClient) GetX(ctx context.Context, id int) *BackupHook {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBackupProfile queries the backup_profile edge of a BackupHook.
+func (c *BackupHookClient) QueryBackupProfile(_m *BackupHook) *BackupProfileQuery {
+	query := (&BackupProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backuphook.Table, backuphook.FieldID, id),
+			sqlgraph.To(backupprofile.Table, backupprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backuphook.BackupProfileTable, backuphook.BackupProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BackupHookClient) Hooks() []Hook {
+	return c.hooks.BackupHook
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackupHookClient) Interceptors() []Interceptor {
+	return c.inters.BackupHook
+}
+
+func (c *BackupHookClient) mutate(ctx context.Context, m *BackupHookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackupHookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackupHookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackupHookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackupHookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackupHook mutation op: %q", m.Op())
+	}
+}
+
 // BackupProfileClient is a client for the BackupProfile schema.
 type BackupProfileClient struct {
 	config
@@ -892,6 +1230,22 @@ func (c *BackupProfileClient) QueryPruningRule(_m *BackupProfile) *PruningRuleQu
 	return query
 }
 
+// QueryHooks queries the hooks edge of a BackupProfile.
+func (c *BackupProfileClient) QueryHooks(_m *BackupProfile) *BackupHookQuery {
+	query := (&BackupHookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backupprofile.Table, backupprofile.FieldID, id),
+			sqlgraph.To(backuphook.Table, backuphook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, backupprofile.HooksTable, backupprofile.HooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryNotifications queries the notifications edge of a BackupProfile.
 func (c *BackupProfileClient) QueryNotifications(_m *BackupProfile) *NotificationQuery {
 	query := (&NotificationClient{config: c.config}).Query()
@@ -1701,6 +2055,22 @@ func (c *RepositoryClient) QueryNotifications(_m *Repository) *NotificationQuery
 	return query
 }
 
+// QueryStatsSnapshots queries the stats_snapshots edge of a Repository.
+func (c *RepositoryClient) QueryStatsSnapshots(_m *Repository) *RepositoryStatsSnapshotQuery {
+	query := (&RepositoryStatsSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repository.Table, repository.FieldID, id),
+			sqlgraph.To(repositorystatssnapshot.Table, repositorystatssnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, repository.StatsSnapshotsTable, repository.StatsSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryCloudRepository queries the cloud_repository edge of a Repository.
 func (c *RepositoryClient) QueryCloudRepository(_m *Repository) *CloudRepositoryQuery {
 	query := (&CloudRepositoryClient{config: c.config}).Query()
@@ -1742,6 +2112,155 @@ func (c *RepositoryClient) mutate(ctx context.Context, m *RepositoryMutation) (V
 	}
 }
 
+// RepositoryStatsSnapshotClient is a client for the RepositoryStatsSnapshot schema.
+type RepositoryStatsSnapshotClient struct {
+	config
+}
+
+// NewRepositoryStatsSnapshotClient returns a client for the RepositoryStatsSnapshot from the given config.
+func NewRepositoryStatsSnapshotClient(c config) *RepositoryStatsSnapshotClient {
+	return &RepositoryStatsSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `repositorystatssnapshot.Hooks(f(g(h())))`.
+func (c *RepositoryStatsSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.RepositoryStatsSnapshot = append(c.hooks.RepositoryStatsSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `repositorystatssnapshot.Intercept(f(g(h())))`.
+func (c *RepositoryStatsSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RepositoryStatsSnapshot = append(c.inters.RepositoryStatsSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a RepositoryStatsSnapshot entity.
+func (c *RepositoryStatsSnapshotClient) Create() *RepositoryStatsSnapshotCreate {
+	mutation := newRepositoryStatsSnapshotMutation(c.config, OpCreate)
+	return &RepositoryStatsSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RepositoryStatsSnapshot entities.
+func (c *RepositoryStatsSnapshotClient) CreateBulk(builders ...*RepositoryStatsSnapshotCreate) *RepositoryStatsSnapshotCreateBulk {
+	return &RepositoryStatsSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RepositoryStatsSnapshotClient) MapCreateBulk(slice any, setFunc func(*RepositoryStatsSnapshotCreate, int)) *RepositoryStatsSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RepositoryStatsSnapshotCreateBulk{err: fmt.Errorf("calling to RepositoryStatsSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RepositoryStatsSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RepositoryStatsSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RepositoryStatsSnapshot.
+func (c *RepositoryStatsSnapshotClient) Update() *RepositoryStatsSnapshotUpdate {
+	mutation := newRepositoryStatsSnapshotMutation(c.config, OpUpdate)
+	return &RepositoryStatsSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RepositoryStatsSnapshotClient) UpdateOne(_m *RepositoryStatsSnapshot) *RepositoryStatsSnapshotUpdateOne {
+	mutation := newRepositoryStatsSnapshotMutation(c.config, OpUpdateOne, withRepositoryStatsSnapshot(_m))
+	return &RepositoryStatsSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RepositoryStatsSnapshotClient) UpdateOneID(id int) *RepositoryStatsSnapshotUpdateOne {
+	mutation := newRepositoryStatsSnapshotMutation(c.config, OpUpdateOne, withRepositoryStatsSnapshotID(id))
+	return &RepositoryStatsSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RepositoryStatsSnapshot.
+func (c *RepositoryStatsSnapshotClient) Delete() *RepositoryStatsSnapshotDelete {
+	mutation := newRepositoryStatsSnapshotMutation(c.config, OpDelete)
+	return &RepositoryStatsSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RepositoryStatsSnapshotClient) DeleteOne(_m *RepositoryStatsSnapshot) *RepositoryStatsSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RepositoryStatsSnapshotClient) DeleteOneID(id int) *RepositoryStatsSnapshotDeleteOne {
+	builder := c.Delete().Where(repositorystatssnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RepositoryStatsSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for RepositoryStatsSnapshot.
+func (c *RepositoryStatsSnapshotClient) Query() *RepositoryStatsSnapshotQuery {
+	return &RepositoryStatsSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRepositoryStatsSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RepositoryStatsSnapshot entity by its id.
+func (c *RepositoryStatsSnapshotClient) Get(ctx context.Context, id int) (*RepositoryStatsSnapshot, error) {
+	return c.Query().Where(repositorystatssnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RepositoryStatsSnapshotClient) GetX(ctx context.Context, id int) *RepositoryStatsSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRepository queries the repository edge of a RepositoryStatsSnapshot.
+func (c *RepositoryStatsSnapshotClient) QueryRepository(_m *RepositoryStatsSnapshot) *RepositoryQuery {
+	query := (&RepositoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repositorystatssnapshot.Table, repositorystatssnapshot.FieldID, id),
+			sqlgraph.To(repository.Table, repository.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, repositorystatssnapshot.RepositoryTable, repositorystatssnapshot.RepositoryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RepositoryStatsSnapshotClient) Hooks() []Hook {
+	return c.hooks.RepositoryStatsSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *RepositoryStatsSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.RepositoryStatsSnapshot
+}
+
+func (c *RepositoryStatsSnapshotClient) mutate(ctx context.Context, m *RepositoryStatsSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RepositoryStatsSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RepositoryStatsSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RepositoryStatsSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RepositoryStatsSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RepositoryStatsSnapshot mutation op: %q", m.Op())
+	}
+}
+
 // SettingsClient is a client for the Settings schema.
 type SettingsClient struct {
 	config
@@ -2011,13 +2530,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AnalyticsEvent, Archive, AuthSession, BackupProfile, BackupSchedule,
-		CloudRepository, Notification, PruningRule, Repository, Settings,
-		User []ent.Hook
+		AnalyticsEvent, Archive, ArchiveFile, AuthSession, BackupHook, BackupProfile,
+		BackupSchedule, CloudRepository, Notification, PruningRule, Repository,
+		RepositoryStatsSnapshot, Settings, User []ent.Hook
 	}
 	inters struct {
-		AnalyticsEvent, Archive, AuthSession, BackupProfile, BackupSchedule,
-		CloudRepository, Notification, PruningRule, Repository, Settings,
-		User []ent.Interceptor
+		AnalyticsEvent, Archive, ArchiveFile, AuthSession, BackupHook, BackupProfile,
+		BackupSchedule, CloudRepository, Notification, PruningRule, Repository,
+		RepositoryStatsSnapshot, Settings, User []ent.Interceptor
 	}
 )
@@ -14,13 +14,16 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/loomi-labs/arco/backend/ent/analyticsevent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/authsession"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/pruningrule"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 	"github.com/loomi-labs/arco/backend/ent/settings"
 	"github.com/loomi-labs/arco/backend/ent/user"
 )
@@ -83,17 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			analyticsevent.Table:  analyticsevent.ValidColumn,
-			archive.Table:         archive.ValidColumn,
-			authsession.Table:     authsession.ValidColumn,
-			backupprofile.Table:   backupprofile.ValidColumn,
-			backupschedule.Table:  backupschedule.ValidColumn,
-			cloudrepository.Table: cloudrepository.ValidColumn,
-			notification.Table:    notification.ValidColumn,
-			pruningrule.Table:     pruningrule.ValidColumn,
-			repository.Table:      repository.ValidColumn,
-			settings.Table:        settings.ValidColumn,
-			user.Table:            user.ValidColumn,
+			analyticsevent.Table:          analyticsevent.ValidColumn,
+			archive.Table:                 archive.ValidColumn,
+			archivefile.Table:             archivefile.ValidColumn,
+			authsession.Table:             authsession.ValidColumn,
+			backuphook.Table:              backuphook.ValidColumn,
+			backupprofile.Table:           backupprofile.ValidColumn,
+			backupschedule.Table:          backupschedule.ValidColumn,
+			cloudrepository.Table:         cloudrepository.ValidColumn,
+			notification.Table:            notification.ValidColumn,
+			pruningrule.Table:             pruningrule.ValidColumn,
+			repository.Table:              repository.ValidColumn,
+			repositorystatssnapshot.Table: repositorystatssnapshot.ValidColumn,
+			settings.Table:                settings.ValidColumn,
+			user.Table:                    user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -33,6 +33,18 @@ func (f ArchiveFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchiveMutation", m)
 }
 
+// The ArchiveFileFunc type is an adapter to allow the use of ordinary
+// function as ArchiveFile mutator.
+type ArchiveFileFunc func(context.Context, *ent.ArchiveFileMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ArchiveFileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ArchiveFileMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ArchiveFileMutation", m)
+}
+
 // The AuthSessionFunc type is an adapter to allow the use of ordinary
 // function as AuthSession mutator.
 type AuthSessionFunc func(context.Context, *ent.AuthSessionMutation) (ent.Value, error)
@@ -45,6 +57,18 @@ func (f AuthSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthSessionMutation", m)
 }
 
+// The BackupHookFunc type is an adapter to allow the use of ordinary
+// function as BackupHook mutator.
+type BackupHookFunc func(context.Context, *ent.BackupHookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BackupHookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BackupHookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BackupHookMutation", m)
+}
+
 // The BackupProfileFunc type is an adapter to allow the use of ordinary
 // function as BackupProfile mutator.
 type BackupProfileFunc func(context.Context, *ent.BackupProfileMutation) (ent.Value, error)
@@ -117,6 +141,18 @@ func (f RepositoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepositoryMutation", m)
 }
 
+// The RepositoryStatsSnapshotFunc type is an adapter to allow the use of ordinary
+// function as RepositoryStatsSnapshot mutator.
+type RepositoryStatsSnapshotFunc func(context.Context, *ent.RepositoryStatsSnapshotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RepositoryStatsSnapshotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RepositoryStatsSnapshotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RepositoryStatsSnapshotMutation", m)
+}
+
 // The SettingsFunc type is an adapter to allow the use of ordinary
 // function as Settings mutator.
 type SettingsFunc func(context.Context, *ent.SettingsMutation) (ent.Value, error)
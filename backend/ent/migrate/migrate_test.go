@@ -15,6 +15,8 @@ import (
 	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/loomi-labs/arco/backend/ent"
+	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	_ "github.com/loomi-labs/arco/backend/ent/runtime"
 	"github.com/loomi-labs/arco/backend/util"
 	_ "github.com/mattn/go-sqlite3"
@@ -51,6 +53,25 @@ var migrationValidators = map[string]func(t *testing.T, ctx context.Context, db
 	"20260327153722_gen": validateFeedbackPrompt,
 	"20260331130829_gen": validateAnalytics,
 	"20260721133129_add_font_scale_and_high_contrast": validateFontScaleAndHighContrast,
+	"20261017020553_gen": validateFileIndex,
+	"20261017030000_gen": validateArchiveStats,
+	"20261017030100_gen": validateRepositoryStatsSnapshots,
+	"20261017030200_gen": validateCheckSchedule,
+	"20261017030300_gen": validateCheckCoverage,
+	"20261017030400_gen": validateBackupHooks,
+	"20261017030500_gen": validateContentCommand,
+	"20261017030600_gen": validatePatterns,
+	"20261017030700_gen": validateExcludeMarkers,
+	"20261017030800_gen": validateCreateOptions,
+	"20261017030900_gen": validateCronExpressions,
+	"20261017031000_gen": validateCatchUpPolicy,
+	"20261017031100_gen": validateStartWindow,
+	"20261017031200_gen": validateRunConditions,
+	"20261017031300_gen": validateDriveTrigger,
+	"20261017031400_gen": validateArchiveFilePathIndex,
+	"20261017031500_gen": validateCatchUpAt,
+	"20261017031600_gen": validateArchiveStatsFailures,
+	"20261017031700_gen": validateStatsSnapshotIndexOrder,
 }
 
 // TestMigrationCoverage ensures every migration file has a registered validator.
@@ -122,13 +143,16 @@ func TestSchemaCompleteness(t *testing.T) {
 	knownEntities := []string{
 		"analyticsevent.go",
 		"archive.go",
+		"archivefile.go",
 		"authsession.go",
+		"backuphook.go",
 		"backupprofile.go",
 		"backupschedule.go",
 		"cloudrepository.go",
 		"notification.go",
 		"pruningrule.go",
 		"repository.go",
+		"repositorystatssnapshot.go",
 		"settings.go",
 		"user.go",
 	}
@@ -192,8 +216,10 @@ func (r relationship) String() string {
 // TestRelationshipCoverage fails if a FK exists in the DB that isn't listed here,
 // forcing you to add seed data and validation for new relationships.
 var knownRelationships = []relationship{
+	{Table: "archive_files", Column: "archive_file_archive", References: "archives", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "archives", Column: "archive_repository", References: "repositories", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "archives", Column: "backup_profile_archives", References: "backup_profiles", OnUpdate: "NO ACTION", OnDelete: "SET NULL"},
+	{Table: "backup_hooks", Column: "backup_profile_hooks", References: "backup_profiles", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "backup_profile_repositories", Column: "backup_profile_id", References: "backup_profiles", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "backup_profile_repositories", Column: "repository_id", References: "repositories", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "backup_schedules", Column: "backup_profile_backup_schedule", References: "backup_profiles", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
@@ -201,6 +227,7 @@ var knownRelationships = []relationship{
 	{Table: "notifications", Column: "notification_repository", References: "repositories", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "pruning_rules", Column: "backup_profile_pruning_rule", References: "backup_profiles", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 	{Table: "repositories", Column: "cloud_repository_repository", References: "cloud_repositories", OnUpdate: "NO ACTION", OnDelete: "SET NULL"},
+	{Table: "repository_stats_snapshots", Column: "repository_stats_snapshot_repository", References: "repositories", OnUpdate: "NO ACTION", OnDelete: "CASCADE"},
 }
 
 // TestRelationshipCoverage discovers all FK definitions in the migrated schema
@@ -1025,6 +1052,420 @@ func validateFontScaleAndHighContrast(t *testing.T, ctx context.Context, _ *sql.
 	}
 }
 
+// validateFileIndex checks that the file index table and tracking columns were added with defaults.
+func validateFileIndex(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "archives", "files_indexed_at") {
+		t.Error("files_indexed_at column should exist on archives")
+	}
+
+	// Existing repositories must not be indexed without opting in.
+	repos, err := client.Repository.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query repositories: %v", err)
+	}
+	for _, repo := range repos {
+		if repo.FileIndexEnabled {
+			t.Errorf("repository %d should have file_index_enabled=false", repo.ID)
+		}
+	}
+
+	// Existing archives have not been indexed yet.
+	indexed, err := client.Archive.Query().Where(archive.FilesIndexedAtNotNil()).Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to query archives: %v", err)
+	}
+	if indexed != 0 {
+		t.Errorf("expected 0 indexed archives, got %d", indexed)
+	}
+
+	// Verify archive_files table exists and is queryable.
+	count, err := client.ArchiveFile.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("archive_files table should exist: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0 archive files, got %d", count)
+	}
+
+	if !indexExists(t, db, "archive_files", "archive_file_archive") {
+		t.Error("index on archive_file_archive column should exist on archive_files")
+	}
+}
+
+// validateArchiveStats checks that the archive stats columns were added and are empty for existing archives.
+func validateArchiveStats(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, column := range []string{"nfiles", "original_size", "compressed_size", "deduplicated_size"} {
+		if !columnExists(t, db, "archives", column) {
+			t.Errorf("%s column should exist on archives", column)
+		}
+	}
+
+	// Stats of existing archives are fetched on the next refresh.
+	withStats, err := client.Archive.Query().Where(archive.NfilesNotNil()).Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to query archives: %v", err)
+	}
+	if withStats != 0 {
+		t.Errorf("expected 0 archives with stats, got %d", withStats)
+	}
+}
+
+// validateRepositoryStatsSnapshots checks that the stats history table was created empty.
+func validateRepositoryStatsSnapshots(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	count, err := client.RepositoryStatsSnapshot.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("repository_stats_snapshots table should exist: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0 repository stats snapshots, got %d", count)
+	}
+
+	if !indexExists(t, db, "repository_stats_snapshots", "repository_stats_snapshot_repository") {
+		t.Error("index on repository_stats_snapshot_repository column should exist on repository_stats_snapshots")
+	}
+}
+
+// validateCheckSchedule checks that the check schedule columns were added and are disabled for existing repositories.
+func validateCheckSchedule(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, column := range []string{"quick_check_interval_days", "full_check_interval_weeks"} {
+		if !columnExists(t, db, "repositories", column) {
+			t.Errorf("%s column should exist on repositories", column)
+		}
+	}
+
+	repos, err := client.Repository.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query repositories: %v", err)
+	}
+	for _, r := range repos {
+		if r.QuickCheckIntervalDays != 0 || r.FullCheckIntervalWeeks != 0 {
+			t.Errorf("repository %d: expected scheduled checks to be disabled, got quick=%d full=%d", r.ID, r.QuickCheckIntervalDays, r.FullCheckIntervalWeeks)
+		}
+	}
+}
+
+// validateCheckCoverage checks that the verification coverage columns were added and no archive is marked as verified.
+func validateCheckCoverage(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "archives", "data_verified_at") {
+		t.Error("data_verified_at column should exist on archives")
+	}
+	for _, column := range []string{"rolling_check_archives", "check_cycle_started_at"} {
+		if !columnExists(t, db, "repositories", column) {
+			t.Errorf("%s column should exist on repositories", column)
+		}
+	}
+
+	verified, err := client.Archive.Query().Where(archive.DataVerifiedAtNotNil()).Count(ctx)
+	if err != nil {
+		t.Fatalf("failed to query archives: %v", err)
+	}
+	if verified != 0 {
+		t.Errorf("expected 0 verified archives, got %d", verified)
+	}
+}
+
+// validateBackupHooks checks that the backup hooks table was created empty.
+func validateBackupHooks(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	count, err := client.BackupHook.Query().Count(ctx)
+	if err != nil {
+		t.Fatalf("backup_hooks table should exist: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0 backup hooks, got %d", count)
+	}
+
+	if !indexExists(t, db, "backup_hooks", "backup_profile_hooks") {
+		t.Error("index on backup_profile_hooks column should exist on backup_hooks")
+	}
+}
+
+// validateContentCommand checks that the content command columns were added and are disabled for existing profiles.
+func validateContentCommand(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, column := range []string{"content_command", "content_stdin_name"} {
+		if !columnExists(t, db, "backup_profiles", column) {
+			t.Errorf("%s column should exist on backup_profiles", column)
+		}
+	}
+
+	profiles, err := client.BackupProfile.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup profiles: %v", err)
+	}
+	for _, p := range profiles {
+		if p.ContentCommand != "" || p.ContentStdinName != "stdin" {
+			t.Errorf("backup profile %d: expected no content command, got command=%q stdinName=%q", p.ID, p.ContentCommand, p.ContentStdinName)
+		}
+	}
+}
+
+// validatePatterns checks that the patterns column was added and existing profiles have no pattern rules.
+func validatePatterns(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "backup_profiles", "patterns") {
+		t.Error("patterns column should exist on backup_profiles")
+	}
+
+	profiles, err := client.BackupProfile.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup profiles: %v", err)
+	}
+	for _, p := range profiles {
+		if len(p.Patterns) != 0 {
+			t.Errorf("backup profile %d: expected no patterns, got %v", p.ID, p.Patterns)
+		}
+	}
+}
+
+// validateExcludeMarkers checks that the marker and ignore file columns were added and are disabled for existing profiles.
+func validateExcludeMarkers(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, column := range []string{"exclude_if_present", "use_ignore_files"} {
+		if !columnExists(t, db, "backup_profiles", column) {
+			t.Errorf("%s column should exist on backup_profiles", column)
+		}
+	}
+
+	profiles, err := client.BackupProfile.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup profiles: %v", err)
+	}
+	for _, p := range profiles {
+		if len(p.ExcludeIfPresent) != 0 || p.UseIgnoreFiles {
+			t.Errorf("backup profile %d: expected no markers and no ignore files, got excludeIfPresent=%v useIgnoreFiles=%v", p.ID, p.ExcludeIfPresent, p.UseIgnoreFiles)
+		}
+	}
+}
+
+func validateCreateOptions(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, column := range []string{"one_file_system", "numeric_ids", "no_flags", "no_acls", "no_xattrs", "sparse", "read_special", "checkpoint_interval"} {
+		if !columnExists(t, db, "backup_profiles", column) {
+			t.Errorf("%s column should exist on backup_profiles", column)
+		}
+	}
+
+	profiles, err := client.BackupProfile.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup profiles: %v", err)
+	}
+	for _, p := range profiles {
+		if p.OneFileSystem || p.NumericIds || p.NoFlags || p.NoAcls || p.NoXattrs || p.Sparse || p.ReadSpecial {
+			t.Errorf("backup profile %d: expected all create options to be disabled", p.ID)
+		}
+		if p.CheckpointInterval != nil {
+			t.Errorf("backup profile %d: expected no checkpoint interval, got %d", p.ID, *p.CheckpointInterval)
+		}
+	}
+}
+
+func validateCronExpressions(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "backup_schedules", "cron_expressions") {
+		t.Error("cron_expressions column should exist on backup_schedules")
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if len(s.CronExpressions) != 0 {
+			t.Errorf("backup schedule %d: expected no cron expressions, got %v", s.ID, s.CronExpressions)
+		}
+	}
+}
+
+func validateCatchUpPolicy(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, col := range []string{"catch_up_policy", "catch_up_delay_minutes"} {
+		if !columnExists(t, db, "backup_schedules", col) {
+			t.Errorf("%s column should exist on backup_schedules", col)
+		}
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if s.CatchUpPolicy != backupschedule.CatchUpPolicyImmediately {
+			t.Errorf("backup schedule %d: expected catch up policy %q, got %q", s.ID, backupschedule.CatchUpPolicyImmediately, s.CatchUpPolicy)
+		}
+		if s.CatchUpDelayMinutes != 10 {
+			t.Errorf("backup schedule %d: expected catch up delay of 10 minutes, got %d", s.ID, s.CatchUpDelayMinutes)
+		}
+	}
+}
+
+func validateStartWindow(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, col := range []string{"jitter_minutes", "allowed_hours", "blackout_periods"} {
+		if !columnExists(t, db, "backup_schedules", col) {
+			t.Errorf("%s column should exist on backup_schedules", col)
+		}
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if s.JitterMinutes != 0 {
+			t.Errorf("backup schedule %d: expected no jitter, got %d minutes", s.ID, s.JitterMinutes)
+		}
+		if len(s.AllowedHours) != 0 || len(s.BlackoutPeriods) != 0 {
+			t.Errorf("backup schedule %d: expected no allowed hours and blackout periods, got %v and %v", s.ID, s.AllowedHours, s.BlackoutPeriods)
+		}
+	}
+}
+
+func validateRunConditions(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, col := range []string{"require_ac_power", "require_unmetered_network", "require_reachable_repository"} {
+		if !columnExists(t, db, "backup_schedules", col) {
+			t.Errorf("%s column should exist on backup_schedules", col)
+		}
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if s.RequireAcPower || s.RequireUnmeteredNetwork || s.RequireReachableRepository {
+			t.Errorf("backup schedule %d: expected no run conditions", s.ID)
+		}
+	}
+}
+
+func validateDriveTrigger(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	for _, col := range []string{"run_on_drive_connect", "drive_action_after_backup"} {
+		if !columnExists(t, db, "backup_schedules", col) {
+			t.Errorf("%s column should exist on backup_schedules", col)
+		}
+	}
+	for _, col := range []string{"volume_uuid", "volume_label"} {
+		if !columnExists(t, db, "repositories", col) {
+			t.Errorf("%s column should exist on repositories", col)
+		}
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if s.RunOnDriveConnect || s.DriveActionAfterBackup != "keep" {
+			t.Errorf("backup schedule %d: expected no drive trigger, got %t and %q", s.ID, s.RunOnDriveConnect, s.DriveActionAfterBackup)
+		}
+	}
+
+	repos, err := client.Repository.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query repositories: %v", err)
+	}
+	for _, r := range repos {
+		if r.VolumeUUID != "" || r.VolumeLabel != "" {
+			t.Errorf("repository %d: expected no volume identity, got %q and %q", r.ID, r.VolumeUUID, r.VolumeLabel)
+		}
+	}
+}
+
+func validateArchiveFilePathIndex(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !indexExists(t, db, "archive_files", "path") {
+		t.Error("index on path should exist on archive_files")
+	}
+}
+
+func validateCatchUpAt(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "backup_schedules", "catch_up_at") {
+		t.Error("catch_up_at column should exist on backup_schedules")
+	}
+
+	schedules, err := client.BackupSchedule.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query backup schedules: %v", err)
+	}
+	for _, s := range schedules {
+		if s.CatchUpAt != nil {
+			t.Errorf("backup schedule %d: expected no catch-up time, got %v", s.ID, s.CatchUpAt)
+		}
+	}
+}
+
+func validateArchiveStatsFailures(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	if !columnExists(t, db, "archives", "stats_failures") {
+		t.Error("stats_failures column should exist on archives")
+	}
+
+	archives, err := client.Archive.Query().All(ctx)
+	if err != nil {
+		t.Fatalf("failed to query archives: %v", err)
+	}
+	for _, a := range archives {
+		if a.StatsFailures != 0 {
+			t.Errorf("archive %d: expected 0 stats failures, got %d", a.ID, a.StatsFailures)
+		}
+	}
+}
+
+func validateStatsSnapshotIndexOrder(t *testing.T, ctx context.Context, db *sql.DB, client *ent.Client) {
+	t.Helper()
+
+	rows, err := db.Query("PRAGMA index_info('repositorystatssnapshot_repository_created_at')")
+	if err != nil {
+		t.Fatalf("failed to get index info: %v", err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	for rows.Next() {
+		var seqno, cid int
+		var name string
+		if err := rows.Scan(&seqno, &cid, &name); err != nil {
+			t.Fatalf("failed to scan index info: %v", err)
+		}
+		columns = append(columns, name)
+	}
+	expected := []string{"repository_stats_snapshot_repository", "created_at"}
+	if strings.Join(columns, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected index columns %v, got %v", expected, columns)
+	}
+
+	if _, err := client.RepositoryStatsSnapshot.Query().Count(ctx); err != nil {
+		t.Errorf("failed to query repository stats snapshots: %v", err)
+	}
+}
+
 // --- helpers ---
 
 func toSet(ss []string) map[string]bool {
@@ -0,0 +1,8 @@
+-- Add column "files_indexed_at" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `files_indexed_at` datetime NULL;
+-- Add column "file_index_enabled" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `file_index_enabled` bool NOT NULL DEFAULT false;
+-- Create "archive_files" table
+CREATE TABLE `archive_files` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `path` text NOT NULL, `size` integer NOT NULL, `mtime` datetime NOT NULL, `archive_file_archive` integer NOT NULL, CONSTRAINT `archive_files_archives_archive` FOREIGN KEY (`archive_file_archive`) REFERENCES `archives` (`id`) ON DELETE CASCADE);
+-- Create index "archivefile_archive_file_archive" to table: "archive_files"
+CREATE INDEX `archivefile_archive_file_archive` ON `archive_files` (`archive_file_archive`);
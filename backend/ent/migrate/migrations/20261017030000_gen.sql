@@ -0,0 +1,8 @@
+-- Add column "nfiles" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `nfiles` integer NULL;
+-- Add column "original_size" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `original_size` integer NULL;
+-- Add column "compressed_size" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `compressed_size` integer NULL;
+-- Add column "deduplicated_size" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `deduplicated_size` integer NULL;
@@ -0,0 +1,4 @@
+-- Create "repository_stats_snapshots" table
+CREATE TABLE `repository_stats_snapshots` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `total_size` integer NOT NULL, `total_csize` integer NOT NULL, `unique_size` integer NOT NULL, `unique_csize` integer NOT NULL, `repository_stats_snapshot_repository` integer NOT NULL, CONSTRAINT `repository_stats_snapshots_repositories_repository` FOREIGN KEY (`repository_stats_snapshot_repository`) REFERENCES `repositories` (`id`) ON DELETE CASCADE);
+-- Create index "repositorystatssnapshot_repository_created_at" to table: "repository_stats_snapshots"
+CREATE INDEX `repositorystatssnapshot_repository_created_at` ON `repository_stats_snapshots` (`created_at`, `repository_stats_snapshot_repository`);
@@ -0,0 +1,4 @@
+-- Add column "quick_check_interval_days" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `quick_check_interval_days` integer NOT NULL DEFAULT 0;
+-- Add column "full_check_interval_weeks" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `full_check_interval_weeks` integer NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+-- Add column "data_verified_at" to table: "archives"
+ALTER TABLE `archives` ADD COLUMN `data_verified_at` datetime NULL;
+-- Add column "rolling_check_archives" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `rolling_check_archives` integer NOT NULL DEFAULT 0;
+-- Add column "check_cycle_started_at" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `check_cycle_started_at` datetime NULL;
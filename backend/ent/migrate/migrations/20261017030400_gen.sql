@@ -0,0 +1,4 @@
+-- Create "backup_hooks" table
+CREATE TABLE `backup_hooks` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `type` text NOT NULL, `run_on` text NOT NULL DEFAULT ('always'), `command` text NOT NULL, `position` integer NOT NULL DEFAULT (0), `timeout_seconds` integer NOT NULL DEFAULT (300), `abort_on_failure` bool NOT NULL DEFAULT (false), `last_run_at` datetime NULL, `last_exit_code` integer NULL, `last_output` text NULL, `backup_profile_hooks` integer NOT NULL, CONSTRAINT `backup_hooks_backup_profiles_hooks` FOREIGN KEY (`backup_profile_hooks`) REFERENCES `backup_profiles` (`id`) ON DELETE CASCADE);
+-- Create index "backuphook_type_position_backup_profile_hooks" to table: "backup_hooks"
+CREATE INDEX `backuphook_type_position_backup_profile_hooks` ON `backup_hooks` (`type`, `position`, `backup_profile_hooks`);
@@ -0,0 +1,4 @@
+-- Add column "content_command" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `content_command` text NOT NULL DEFAULT '';
+-- Add column "content_stdin_name" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `content_stdin_name` text NOT NULL DEFAULT 'stdin';
@@ -0,0 +1,2 @@
+-- Add column "patterns" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `patterns` json NULL;
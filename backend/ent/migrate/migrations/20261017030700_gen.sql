@@ -0,0 +1,4 @@
+-- Add column "exclude_if_present" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `exclude_if_present` json NULL;
+-- Add column "use_ignore_files" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `use_ignore_files` bool NOT NULL DEFAULT false;
@@ -0,0 +1,16 @@
+-- Add column "one_file_system" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `one_file_system` bool NOT NULL DEFAULT false;
+-- Add column "numeric_ids" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `numeric_ids` bool NOT NULL DEFAULT false;
+-- Add column "no_flags" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `no_flags` bool NOT NULL DEFAULT false;
+-- Add column "no_acls" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `no_acls` bool NOT NULL DEFAULT false;
+-- Add column "no_xattrs" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `no_xattrs` bool NOT NULL DEFAULT false;
+-- Add column "sparse" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `sparse` bool NOT NULL DEFAULT false;
+-- Add column "read_special" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `read_special` bool NOT NULL DEFAULT false;
+-- Add column "checkpoint_interval" to table: "backup_profiles"
+ALTER TABLE `backup_profiles` ADD COLUMN `checkpoint_interval` integer NULL;
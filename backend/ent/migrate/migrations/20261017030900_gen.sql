@@ -0,0 +1,2 @@
+-- Add column "cron_expressions" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `cron_expressions` json NULL;
@@ -0,0 +1,4 @@
+-- Add column "catch_up_policy" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `catch_up_policy` text NOT NULL DEFAULT 'immediately';
+-- Add column "catch_up_delay_minutes" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `catch_up_delay_minutes` integer NOT NULL DEFAULT 10;
@@ -0,0 +1,6 @@
+-- Add column "jitter_minutes" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `jitter_minutes` integer NOT NULL DEFAULT 0;
+-- Add column "allowed_hours" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `allowed_hours` json NULL;
+-- Add column "blackout_periods" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `blackout_periods` json NULL;
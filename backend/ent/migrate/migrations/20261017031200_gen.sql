@@ -0,0 +1,6 @@
+-- Add column "require_ac_power" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `require_ac_power` bool NOT NULL DEFAULT false;
+-- Add column "require_unmetered_network" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `require_unmetered_network` bool NOT NULL DEFAULT false;
+-- Add column "require_reachable_repository" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `require_reachable_repository` bool NOT NULL DEFAULT false;
@@ -0,0 +1,8 @@
+-- Add column "run_on_drive_connect" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `run_on_drive_connect` bool NOT NULL DEFAULT false;
+-- Add column "drive_action_after_backup" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `drive_action_after_backup` text NOT NULL DEFAULT 'keep';
+-- Add column "volume_uuid" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `volume_uuid` text NULL;
+-- Add column "volume_label" to table: "repositories"
+ALTER TABLE `repositories` ADD COLUMN `volume_label` text NULL;
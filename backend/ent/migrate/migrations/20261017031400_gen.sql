@@ -0,0 +1,2 @@
+-- Create index "archivefile_path_archive_file_archive" to table: "archive_files"
+CREATE INDEX `archivefile_path_archive_file_archive` ON `archive_files` (`path`, `archive_file_archive`);
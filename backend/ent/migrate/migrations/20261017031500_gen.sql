@@ -0,0 +1,2 @@
+-- Add column "catch_up_at" to table: "backup_schedules"
+ALTER TABLE `backup_schedules` ADD COLUMN `catch_up_at` datetime NULL;
@@ -0,0 +1,12 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_archives" table
+CREATE TABLE `new_archives` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `updated_at` datetime NOT NULL, `name` text NOT NULL, `duration` real NOT NULL, `borg_id` text NOT NULL, `will_be_pruned` bool NOT NULL DEFAULT (false), `comment` text NULL DEFAULT (''), `warning_message` text NULL, `files_indexed_at` datetime NULL, `nfiles` integer NULL, `original_size` integer NULL, `compressed_size` integer NULL, `deduplicated_size` integer NULL, `stats_failures` integer NOT NULL DEFAULT (0), `data_verified_at` datetime NULL, `archive_repository` integer NOT NULL, `backup_profile_archives` integer NULL, CONSTRAINT `archives_repositories_repository` FOREIGN KEY (`archive_repository`) REFERENCES `repositories` (`id`) ON DELETE CASCADE, CONSTRAINT `archives_backup_profiles_archives` FOREIGN KEY (`backup_profile_archives`) REFERENCES `backup_profiles` (`id`) ON DELETE SET NULL);
+-- Copy rows from old table "archives" to new temporary table "new_archives"
+INSERT INTO `new_archives` (`id`, `created_at`, `updated_at`, `name`, `duration`, `borg_id`, `will_be_pruned`, `comment`, `warning_message`, `files_indexed_at`, `nfiles`, `original_size`, `compressed_size`, `deduplicated_size`, `data_verified_at`, `archive_repository`, `backup_profile_archives`) SELECT `id`, `created_at`, `updated_at`, `name`, `duration`, `borg_id`, `will_be_pruned`, `comment`, `warning_message`, `files_indexed_at`, `nfiles`, `original_size`, `compressed_size`, `deduplicated_size`, `data_verified_at`, `archive_repository`, `backup_profile_archives` FROM `archives`;
+-- Drop "archives" table after copying rows
+DROP TABLE `archives`;
+-- Rename temporary table "new_archives" to "archives"
+ALTER TABLE `new_archives` RENAME TO `archives`;
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
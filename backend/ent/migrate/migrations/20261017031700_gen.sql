@@ -0,0 +1,14 @@
+-- Disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- Create "new_repository_stats_snapshots" table
+CREATE TABLE `new_repository_stats_snapshots` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_at` datetime NOT NULL, `total_size` integer NOT NULL, `total_csize` integer NOT NULL, `unique_size` integer NOT NULL, `unique_csize` integer NOT NULL, `repository_stats_snapshot_repository` integer NOT NULL, CONSTRAINT `repository_stats_snapshots_repositories_repository` FOREIGN KEY (`repository_stats_snapshot_repository`) REFERENCES `repositories` (`id`) ON DELETE CASCADE);
+-- Copy rows from old table "repository_stats_snapshots" to new temporary table "new_repository_stats_snapshots"
+INSERT INTO `new_repository_stats_snapshots` (`id`, `created_at`, `total_size`, `total_csize`, `unique_size`, `unique_csize`, `repository_stats_snapshot_repository`) SELECT `id`, `created_at`, `total_size`, `total_csize`, `unique_size`, `unique_csize`, `repository_stats_snapshot_repository` FROM `repository_stats_snapshots`;
+-- Drop "repository_stats_snapshots" table after copying rows
+DROP TABLE `repository_stats_snapshots`;
+-- Rename temporary table "new_repository_stats_snapshots" to "repository_stats_snapshots"
+ALTER TABLE `new_repository_stats_snapshots` RENAME TO `repository_stats_snapshots`;
+-- Create index "repositorystatssnapshot_repository_created_at" to table: "repository_stats_snapshots"
+CREATE INDEX `repositorystatssnapshot_repository_created_at` ON `repository_stats_snapshots` (`repository_stats_snapshot_repository`, `created_at`);
+-- Enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
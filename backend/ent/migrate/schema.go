@@ -46,6 +46,13 @@ var (
 		{Name: "will_be_pruned", Type: field.TypeBool, Default: false},
 		{Name: "comment", Type: field.TypeString, Nullable: true, Default: ""},
 		{Name: "warning_message", Type: field.TypeString, Nullable: true},
+		{Name: "files_indexed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "nfiles", Type: field.TypeInt, Nullable: true},
+		{Name: "original_size", Type: field.TypeInt, Nullable: true},
+		{Name: "compressed_size", Type: field.TypeInt, Nullable: true},
+		{Name: "deduplicated_size", Type: field.TypeInt, Nullable: true},
+		{Name: "stats_failures", Type: field.TypeInt, Default: 0},
+		{Name: "data_verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "archive_repository", Type: field.TypeInt},
 		{Name: "backup_profile_archives", Type: field.TypeInt, Nullable: true},
 	}
@@ -57,18 +64,52 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "archives_repositories_repository",
-				Columns:    []*schema.Column{ArchivesColumns[9]},
+				Columns:    []*schema.Column{ArchivesColumns[16]},
 				RefColumns: []*schema.Column{RepositoriesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "archives_backup_profiles_archives",
-				Columns:    []*schema.Column{ArchivesColumns[10]},
+				Columns:    []*schema.Column{ArchivesColumns[17]},
 				RefColumns: []*schema.Column{BackupProfilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ArchiveFilesColumns holds the columns for the "archive_files" table.
+	ArchiveFilesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "path", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "mtime", Type: field.TypeTime},
+		{Name: "archive_file_archive", Type: field.TypeInt},
+	}
+	// ArchiveFilesTable holds the schema information for the "archive_files" table.
+	ArchiveFilesTable = &schema.Table{
+		Name:       "archive_files",
+		Columns:    ArchiveFilesColumns,
+		PrimaryKey: []*schema.Column{ArchiveFilesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "archive_files_archives_archive",
+				Columns:    []*schema.Column{ArchiveFilesColumns[4]},
+				RefColumns: []*schema.Column{ArchivesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "archivefile_archive_file_archive",
+				Unique:  false,
+				Columns: []*schema.Column{ArchiveFilesColumns[4]},
+			},
+			{
+				Name:    "archivefile_path_archive_file_archive",
+				Unique:  false,
+				Columns: []*schema.Column{ArchiveFilesColumns[1], ArchiveFilesColumns[4]},
+			},
+		},
+	}
 	// AuthSessionsColumns holds the columns for the "auth_sessions" table.
 	AuthSessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -84,6 +125,43 @@ var (
 		Columns:    AuthSessionsColumns,
 		PrimaryKey: []*schema.Column{AuthSessionsColumns[0]},
 	}
+	// BackupHooksColumns holds the columns for the "backup_hooks" table.
+	BackupHooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"pre_backup", "post_backup"}},
+		{Name: "run_on", Type: field.TypeEnum, Enums: []string{"success", "failure", "always"}, Default: "always"},
+		{Name: "command", Type: field.TypeString},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "timeout_seconds", Type: field.TypeInt, Default: 300},
+		{Name: "abort_on_failure", Type: field.TypeBool, Default: false},
+		{Name: "last_run_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_exit_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_output", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "backup_profile_hooks", Type: field.TypeInt},
+	}
+	// BackupHooksTable holds the schema information for the "backup_hooks" table.
+	BackupHooksTable = &schema.Table{
+		Name:       "backup_hooks",
+		Columns:    BackupHooksColumns,
+		PrimaryKey: []*schema.Column{BackupHooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backup_hooks_backup_profiles_hooks",
+				Columns:    []*schema.Column{BackupHooksColumns[12]},
+				RefColumns: []*schema.Column{BackupProfilesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backuphook_type_position_backup_profile_hooks",
+				Unique:  false,
+				Columns: []*schema.Column{BackupHooksColumns[3], BackupHooksColumns[6], BackupHooksColumns[12]},
+			},
+		},
+	}
 	// BackupProfilesColumns holds the columns for the "backup_profiles" table.
 	BackupProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -93,10 +171,23 @@ var (
 		{Name: "prefix", Type: field.TypeString, Unique: true},
 		{Name: "backup_paths", Type: field.TypeJSON},
 		{Name: "exclude_paths", Type: field.TypeJSON, Nullable: true},
+		{Name: "patterns", Type: field.TypeJSON, Nullable: true},
 		{Name: "exclude_caches", Type: field.TypeBool, Default: false},
+		{Name: "exclude_if_present", Type: field.TypeJSON, Nullable: true},
+		{Name: "use_ignore_files", Type: field.TypeBool, Default: false},
+		{Name: "content_command", Type: field.TypeString, Default: ""},
+		{Name: "content_stdin_name", Type: field.TypeString, Default: "stdin"},
 		{Name: "icon", Type: field.TypeEnum, Enums: []string{"home", "briefcase", "book", "envelope", "camera", "fire"}},
 		{Name: "compression_mode", Type: field.TypeEnum, Enums: []string{"none", "lz4", "zstd", "zlib", "lzma"}, Default: "lz4"},
 		{Name: "compression_level", Type: field.TypeInt, Nullable: true},
+		{Name: "one_file_system", Type: field.TypeBool, Default: false},
+		{Name: "numeric_ids", Type: field.TypeBool, Default: false},
+		{Name: "no_flags", Type: field.TypeBool, Default: false},
+		{Name: "no_acls", Type: field.TypeBool, Default: false},
+		{Name: "no_xattrs", Type: field.TypeBool, Default: false},
+		{Name: "sparse", Type: field.TypeBool, Default: false},
+		{Name: "read_special", Type: field.TypeBool, Default: false},
+		{Name: "checkpoint_interval", Type: field.TypeInt, Nullable: true},
 		{Name: "data_section_collapsed", Type: field.TypeBool, Default: false},
 		{Name: "schedule_section_collapsed", Type: field.TypeBool, Default: false},
 		{Name: "advanced_section_collapsed", Type: field.TypeBool, Default: true},
@@ -112,14 +203,26 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "mode", Type: field.TypeEnum, Enums: []string{"disabled", "minute_interval", "daily", "weekly", "monthly"}, Default: "disabled"},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"disabled", "minute_interval", "daily", "weekly", "monthly", "cron"}, Default: "disabled"},
 		{Name: "interval_minutes", Type: field.TypeUint16, Default: 60},
 		{Name: "daily_at", Type: field.TypeTime},
 		{Name: "weekday", Type: field.TypeEnum, Enums: []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}},
 		{Name: "weekly_at", Type: field.TypeTime},
 		{Name: "monthday", Type: field.TypeUint8},
 		{Name: "monthly_at", Type: field.TypeTime},
+		{Name: "cron_expressions", Type: field.TypeJSON, Nullable: true},
+		{Name: "catch_up_policy", Type: field.TypeEnum, Enums: []string{"immediately", "delayed", "skip"}, Default: "immediately"},
+		{Name: "catch_up_delay_minutes", Type: field.TypeUint16, Default: 10},
+		{Name: "jitter_minutes", Type: field.TypeUint16, Default: 0},
+		{Name: "allowed_hours", Type: field.TypeJSON, Nullable: true},
+		{Name: "blackout_periods", Type: field.TypeJSON, Nullable: true},
+		{Name: "require_ac_power", Type: field.TypeBool, Default: false},
+		{Name: "require_unmetered_network", Type: field.TypeBool, Default: false},
+		{Name: "require_reachable_repository", Type: field.TypeBool, Default: false},
+		{Name: "run_on_drive_connect", Type: field.TypeBool, Default: false},
+		{Name: "drive_action_after_backup", Type: field.TypeEnum, Enums: []string{"keep", "unmount", "eject"}, Default: "keep"},
 		{Name: "next_run", Type: field.TypeTime, Nullable: true},
+		{Name: "catch_up_at", Type: field.TypeTime, Nullable: true},
 		{Name: "last_run", Type: field.TypeTime, Nullable: true},
 		{Name: "last_run_status", Type: field.TypeString, Nullable: true},
 		{Name: "backup_profile_backup_schedule", Type: field.TypeInt, Unique: true},
@@ -132,7 +235,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "backup_schedules_backup_profiles_backup_schedule",
-				Columns:    []*schema.Column{BackupSchedulesColumns[13]},
+				Columns:    []*schema.Column{BackupSchedulesColumns[25]},
 				RefColumns: []*schema.Column{BackupProfilesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -141,7 +244,7 @@ var (
 			{
 				Name:    "backupschedule_next_run",
 				Unique:  false,
-				Columns: []*schema.Column{BackupSchedulesColumns[10]},
+				Columns: []*schema.Column{BackupSchedulesColumns[21]},
 			},
 		},
 	}
@@ -166,7 +269,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "message", Type: field.TypeString},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"failed_backup_run", "failed_pruning_run", "warning_pruning_run", "failed_quick_check", "failed_full_check", "warning_quick_check", "warning_full_check"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"failed_backup_run", "failed_pruning_run", "warning_pruning_run", "failed_quick_check", "failed_full_check", "warning_quick_check", "warning_full_check", "failed_restore_run", "warning_restore_run", "failed_export_run", "warning_export_run", "failed_import_run", "overdue_quick_check", "overdue_full_check"}},
 		{Name: "seen", Type: field.TypeBool, Default: false},
 		{Name: "action", Type: field.TypeEnum, Nullable: true, Enums: []string{"unlockRepository"}},
 		{Name: "notification_backup_profile", Type: field.TypeInt},
@@ -231,16 +334,23 @@ var (
 		{Name: "name", Type: field.TypeString, Unique: true, Size: 30},
 		{Name: "url", Type: field.TypeString, Unique: true},
 		{Name: "has_password", Type: field.TypeBool, Default: false},
+		{Name: "volume_uuid", Type: field.TypeString, Nullable: true},
+		{Name: "volume_label", Type: field.TypeString, Nullable: true},
 		{Name: "last_quick_check_at", Type: field.TypeTime, Nullable: true},
 		{Name: "quick_check_error", Type: field.TypeJSON, Nullable: true},
 		{Name: "last_full_check_at", Type: field.TypeTime, Nullable: true},
 		{Name: "full_check_error", Type: field.TypeJSON, Nullable: true},
+		{Name: "quick_check_interval_days", Type: field.TypeInt, Default: 0},
+		{Name: "full_check_interval_weeks", Type: field.TypeInt, Default: 0},
+		{Name: "rolling_check_archives", Type: field.TypeInt, Default: 0},
+		{Name: "check_cycle_started_at", Type: field.TypeTime, Nullable: true},
 		{Name: "stats_total_chunks", Type: field.TypeInt, Default: 0},
 		{Name: "stats_total_size", Type: field.TypeInt, Default: 0},
 		{Name: "stats_total_csize", Type: field.TypeInt, Default: 0},
 		{Name: "stats_total_unique_chunks", Type: field.TypeInt, Default: 0},
 		{Name: "stats_unique_size", Type: field.TypeInt, Default: 0},
 		{Name: "stats_unique_csize", Type: field.TypeInt, Default: 0},
+		{Name: "file_index_enabled", Type: field.TypeBool, Default: false},
 		{Name: "cloud_repository_repository", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// RepositoriesTable holds the schema information for the "repositories" table.
@@ -251,12 +361,43 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "repositories_cloud_repositories_repository",
-				Columns:    []*schema.Column{RepositoriesColumns[16]},
+				Columns:    []*schema.Column{RepositoriesColumns[23]},
 				RefColumns: []*schema.Column{CloudRepositoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// RepositoryStatsSnapshotsColumns holds the columns for the "repository_stats_snapshots" table.
+	RepositoryStatsSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "total_size", Type: field.TypeInt},
+		{Name: "total_csize", Type: field.TypeInt},
+		{Name: "unique_size", Type: field.TypeInt},
+		{Name: "unique_csize", Type: field.TypeInt},
+		{Name: "repository_stats_snapshot_repository", Type: field.TypeInt},
+	}
+	// RepositoryStatsSnapshotsTable holds the schema information for the "repository_stats_snapshots" table.
+	RepositoryStatsSnapshotsTable = &schema.Table{
+		Name:       "repository_stats_snapshots",
+		Columns:    RepositoryStatsSnapshotsColumns,
+		PrimaryKey: []*schema.Column{RepositoryStatsSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "repository_stats_snapshots_repositories_repository",
+				Columns:    []*schema.Column{RepositoryStatsSnapshotsColumns[6]},
+				RefColumns: []*schema.Column{RepositoriesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "repositorystatssnapshot_repository_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RepositoryStatsSnapshotsColumns[6], RepositoryStatsSnapshotsColumns[1]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -325,13 +466,16 @@ var (
 	Tables = []*schema.Table{
 		AnalyticsEventsTable,
 		ArchivesTable,
+		ArchiveFilesTable,
 		AuthSessionsTable,
+		BackupHooksTable,
 		BackupProfilesTable,
 		BackupSchedulesTable,
 		CloudRepositoriesTable,
 		NotificationsTable,
 		PruningRulesTable,
 		RepositoriesTable,
+		RepositoryStatsSnapshotsTable,
 		SettingsTable,
 		UsersTable,
 		BackupProfileRepositoriesTable,
@@ -341,6 +485,8 @@ var (
 func init() {
 	ArchivesTable.ForeignKeys[0].RefTable = RepositoriesTable
 	ArchivesTable.ForeignKeys[1].RefTable = BackupProfilesTable
+	ArchiveFilesTable.ForeignKeys[0].RefTable = ArchivesTable
+	BackupHooksTable.ForeignKeys[0].RefTable = BackupProfilesTable
 	BackupProfilesTable.Annotation = &entsql.Annotation{}
 	BackupProfilesTable.Annotation.Checks = map[string]string{
 		"compression_level_valid": "(\n\t\t\t\t\t(compression_mode IN ('none', 'lz4') AND compression_level IS NULL) OR\n\t\t\t\t\t(compression_mode = 'zstd' AND compression_level >= 1 AND compression_level <= 22) OR\n\t\t\t\t\t(compression_mode = 'zlib' AND compression_level >= 0 AND compression_level <= 9) OR\n\t\t\t\t\t(compression_mode = 'lzma' AND compression_level >= 0 AND compression_level <= 6)\n\t\t\t\t)",
@@ -350,6 +496,7 @@ func init() {
 	NotificationsTable.ForeignKeys[1].RefTable = RepositoriesTable
 	PruningRulesTable.ForeignKeys[0].RefTable = BackupProfilesTable
 	RepositoriesTable.ForeignKeys[0].RefTable = CloudRepositoriesTable
+	RepositoryStatsSnapshotsTable.ForeignKeys[0].RefTable = RepositoriesTable
 	BackupProfileRepositoriesTable.ForeignKeys[0].RefTable = BackupProfilesTable
 	BackupProfileRepositoriesTable.ForeignKeys[1].RefTable = RepositoriesTable
 }
@@ -14,7 +14,9 @@ import (
 	"github.com/google/uuid"
 	"github.com/loomi-labs/arco/backend/ent/analyticsevent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
+	"github.com/loomi-labs/arco/backend/ent/archivefile"
 	"github.com/loomi-labs/arco/backend/ent/authsession"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
@@ -22,8 +24,10 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/predicate"
 	"github.com/loomi-labs/arco/backend/ent/pruningrule"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 	"github.com/loomi-labs/arco/backend/ent/settings"
 	"github.com/loomi-labs/arco/backend/ent/user"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 const (
@@ -35,17 +39,20 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAnalyticsEvent  = "AnalyticsEvent"
-	TypeArchive         = "Archive"
-	TypeAuthSession     = "AuthSession"
-	TypeBackupProfile   = "BackupProfile"
-	TypeBackupSchedule  = "BackupSchedule"
-	TypeCloudRepository = "CloudRepository"
-	TypeNotification    = "Notification"
-	TypePruningRule     = "PruningRule"
-	TypeRepository      = "Repository"
-	TypeSettings        = "Settings"
-	TypeUser            = "User"
+	TypeAnalyticsEvent          = "AnalyticsEvent"
+	TypeArchive                 = "Archive"
+	TypeArchiveFile             = "ArchiveFile"
+	TypeAuthSession             = "AuthSession"
+	TypeBackupHook              = "BackupHook"
+	TypeBackupProfile           = "BackupProfile"
+	TypeBackupSchedule          = "BackupSchedule"
+	TypeCloudRepository         = "CloudRepository"
+	TypeNotification            = "Notification"
+	TypePruningRule             = "PruningRule"
+	TypeRepository              = "Repository"
+	TypeRepositoryStatsSnapshot = "RepositoryStatsSnapshot"
+	TypeSettings                = "Settings"
+	TypeUser                    = "User"
 )
 
 // AnalyticsEventMutation represents an operation that mutates the AnalyticsEvent nodes in the graph.
@@ -843,11 +850,26 @@ type ArchiveMutation struct {
 	will_be_pruned        *bool
 	comment               *string
 	warning_message       *string
+	files_indexed_at      *time.Time
+	nfiles                *int
+	addnfiles             *int
+	original_size         *int
+	addoriginal_size      *int
+	compressed_size       *int
+	addcompressed_size    *int
+	deduplicated_size     *int
+	adddeduplicated_size  *int
+	stats_failures        *int
+	addstats_failures     *int
+	data_verified_at      *time.Time
 	clearedFields         map[string]struct{}
 	repository            *int
 	clearedrepository     bool
 	backup_profile        *int
 	clearedbackup_profile bool
+	files                 map[int]struct{}
+	removedfiles          map[int]struct{}
+	clearedfiles          bool
 	done                  bool
 	oldValue              func(context.Context) (*Archive, error)
 	predicates            []predicate.Archive
@@ -1291,6 +1313,440 @@ func (m *ArchiveMutation) ResetWarningMessage() {
 	delete(m.clearedFields, archive.FieldWarningMessage)
 }
 
+// SetFilesIndexedAt sets the "files_indexed_at" field.
+func (m *ArchiveMutation) SetFilesIndexedAt(t time.Time) {
+	m.files_indexed_at = &t
+}
+
+// FilesIndexedAt returns the value of the "files_indexed_at" field in the mutation.
+func (m *ArchiveMutation) FilesIndexedAt() (r time.Time, exists bool) {
+	v := m.files_indexed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilesIndexedAt returns the old "files_indexed_at" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldFilesIndexedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilesIndexedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilesIndexedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilesIndexedAt: %w", err)
+	}
+	return oldValue.FilesIndexedAt, nil
+}
+
+// ClearFilesIndexedAt clears the value of the "files_indexed_at" field.
+func (m *ArchiveMutation) ClearFilesIndexedAt() {
+	m.files_indexed_at = nil
+	m.clearedFields[archive.FieldFilesIndexedAt] = struct{}{}
+}
+
+// FilesIndexedAtCleared returns if the "files_indexed_at" field was cleared in this mutation.
+func (m *ArchiveMutation) FilesIndexedAtCleared() bool {
+	_, ok := m.clearedFields[archive.FieldFilesIndexedAt]
+	return ok
+}
+
+// ResetFilesIndexedAt resets all changes to the "files_indexed_at" field.
+func (m *ArchiveMutation) ResetFilesIndexedAt() {
+	m.files_indexed_at = nil
+	delete(m.clearedFields, archive.FieldFilesIndexedAt)
+}
+
+// SetNfiles sets the "nfiles" field.
+func (m *ArchiveMutation) SetNfiles(i int) {
+	m.nfiles = &i
+	m.addnfiles = nil
+}
+
+// Nfiles returns the value of the "nfiles" field in the mutation.
+func (m *ArchiveMutation) Nfiles() (r int, exists bool) {
+	v := m.nfiles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNfiles returns the old "nfiles" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldNfiles(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNfiles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNfiles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNfiles: %w", err)
+	}
+	return oldValue.Nfiles, nil
+}
+
+// AddNfiles adds i to the "nfiles" field.
+func (m *ArchiveMutation) AddNfiles(i int) {
+	if m.addnfiles != nil {
+		*m.addnfiles += i
+	} else {
+		m.addnfiles = &i
+	}
+}
+
+// AddedNfiles returns the value that was added to the "nfiles" field in this mutation.
+func (m *ArchiveMutation) AddedNfiles() (r int, exists bool) {
+	v := m.addnfiles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearNfiles clears the value of the "nfiles" field.
+func (m *ArchiveMutation) ClearNfiles() {
+	m.nfiles = nil
+	m.addnfiles = nil
+	m.clearedFields[archive.FieldNfiles] = struct{}{}
+}
+
+// NfilesCleared returns if the "nfiles" field was cleared in this mutation.
+func (m *ArchiveMutation) NfilesCleared() bool {
+	_, ok := m.clearedFields[archive.FieldNfiles]
+	return ok
+}
+
+// ResetNfiles resets all changes to the "nfiles" field.
+func (m *ArchiveMutation) ResetNfiles() {
+	m.nfiles = nil
+	m.addnfiles = nil
+	delete(m.clearedFields, archive.FieldNfiles)
+}
+
+// SetOriginalSize sets the "original_size" field.
+func (m *ArchiveMutation) SetOriginalSize(i int) {
+	m.original_size = &i
+	m.addoriginal_size = nil
+}
+
+// OriginalSize returns the value of the "original_size" field in the mutation.
+func (m *ArchiveMutation) OriginalSize() (r int, exists bool) {
+	v := m.original_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginalSize returns the old "original_size" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldOriginalSize(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginalSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginalSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginalSize: %w", err)
+	}
+	return oldValue.OriginalSize, nil
+}
+
+// AddOriginalSize adds i to the "original_size" field.
+func (m *ArchiveMutation) AddOriginalSize(i int) {
+	if m.addoriginal_size != nil {
+		*m.addoriginal_size += i
+	} else {
+		m.addoriginal_size = &i
+	}
+}
+
+// AddedOriginalSize returns the value that was added to the "original_size" field in this mutation.
+func (m *ArchiveMutation) AddedOriginalSize() (r int, exists bool) {
+	v := m.addoriginal_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearOriginalSize clears the value of the "original_size" field.
+func (m *ArchiveMutation) ClearOriginalSize() {
+	m.original_size = nil
+	m.addoriginal_size = nil
+	m.clearedFields[archive.FieldOriginalSize] = struct{}{}
+}
+
+// OriginalSizeCleared returns if the "original_size" field was cleared in this mutation.
+func (m *ArchiveMutation) OriginalSizeCleared() bool {
+	_, ok := m.clearedFields[archive.FieldOriginalSize]
+	return ok
+}
+
+// ResetOriginalSize resets all changes to the "original_size" field.
+func (m *ArchiveMutation) ResetOriginalSize() {
+	m.original_size = nil
+	m.addoriginal_size = nil
+	delete(m.clearedFields, archive.FieldOriginalSize)
+}
+
+// SetCompressedSize sets the "compressed_size" field.
+func (m *ArchiveMutation) SetCompressedSize(i int) {
+	m.compressed_size = &i
+	m.addcompressed_size = nil
+}
+
+// CompressedSize returns the value of the "compressed_size" field in the mutation.
+func (m *ArchiveMutation) CompressedSize() (r int, exists bool) {
+	v := m.compressed_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompressedSize returns the old "compressed_size" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldCompressedSize(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressedSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressedSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressedSize: %w", err)
+	}
+	return oldValue.CompressedSize, nil
+}
+
+// AddCompressedSize adds i to the "compressed_size" field.
+func (m *ArchiveMutation) AddCompressedSize(i int) {
+	if m.addcompressed_size != nil {
+		*m.addcompressed_size += i
+	} else {
+		m.addcompressed_size = &i
+	}
+}
+
+// AddedCompressedSize returns the value that was added to the "compressed_size" field in this mutation.
+func (m *ArchiveMutation) AddedCompressedSize() (r int, exists bool) {
+	v := m.addcompressed_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCompressedSize clears the value of the "compressed_size" field.
+func (m *ArchiveMutation) ClearCompressedSize() {
+	m.compressed_size = nil
+	m.addcompressed_size = nil
+	m.clearedFields[archive.FieldCompressedSize] = struct{}{}
+}
+
+// CompressedSizeCleared returns if the "compressed_size" field was cleared in this mutation.
+func (m *ArchiveMutation) CompressedSizeCleared() bool {
+	_, ok := m.clearedFields[archive.FieldCompressedSize]
+	return ok
+}
+
+// ResetCompressedSize resets all changes to the "compressed_size" field.
+func (m *ArchiveMutation) ResetCompressedSize() {
+	m.compressed_size = nil
+	m.addcompressed_size = nil
+	delete(m.clearedFields, archive.FieldCompressedSize)
+}
+
+// SetDeduplicatedSize sets the "deduplicated_size" field.
+func (m *ArchiveMutation) SetDeduplicatedSize(i int) {
+	m.deduplicated_size = &i
+	m.adddeduplicated_size = nil
+}
+
+// DeduplicatedSize returns the value of the "deduplicated_size" field in the mutation.
+func (m *ArchiveMutation) DeduplicatedSize() (r int, exists bool) {
+	v := m.deduplicated_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeduplicatedSize returns the old "deduplicated_size" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldDeduplicatedSize(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeduplicatedSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeduplicatedSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeduplicatedSize: %w", err)
+	}
+	return oldValue.DeduplicatedSize, nil
+}
+
+// AddDeduplicatedSize adds i to the "deduplicated_size" field.
+func (m *ArchiveMutation) AddDeduplicatedSize(i int) {
+	if m.adddeduplicated_size != nil {
+		*m.adddeduplicated_size += i
+	} else {
+		m.adddeduplicated_size = &i
+	}
+}
+
+// AddedDeduplicatedSize returns the value that was added to the "deduplicated_size" field in this mutation.
+func (m *ArchiveMutation) AddedDeduplicatedSize() (r int, exists bool) {
+	v := m.adddeduplicated_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeduplicatedSize clears the value of the "deduplicated_size" field.
+func (m *ArchiveMutation) ClearDeduplicatedSize() {
+	m.deduplicated_size = nil
+	m.adddeduplicated_size = nil
+	m.clearedFields[archive.FieldDeduplicatedSize] = struct{}{}
+}
+
+// DeduplicatedSizeCleared returns if the "deduplicated_size" field was cleared in this mutation.
+func (m *ArchiveMutation) DeduplicatedSizeCleared() bool {
+	_, ok := m.clearedFields[archive.FieldDeduplicatedSize]
+	return ok
+}
+
+// ResetDeduplicatedSize resets all changes to the "deduplicated_size" field.
+func (m *ArchiveMutation) ResetDeduplicatedSize() {
+	m.deduplicated_size = nil
+	m.adddeduplicated_size = nil
+	delete(m.clearedFields, archive.FieldDeduplicatedSize)
+}
+
+// SetStatsFailures sets the "stats_failures" field.
+func (m *ArchiveMutation) SetStatsFailures(i int) {
+	m.stats_failures = &i
+	m.addstats_failures = nil
+}
+
+// StatsFailures returns the value of the "stats_failures" field in the mutation.
+func (m *ArchiveMutation) StatsFailures() (r int, exists bool) {
+	v := m.stats_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsFailures returns the old "stats_failures" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldStatsFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsFailures: %w", err)
+	}
+	return oldValue.StatsFailures, nil
+}
+
+// AddStatsFailures adds i to the "stats_failures" field.
+func (m *ArchiveMutation) AddStatsFailures(i int) {
+	if m.addstats_failures != nil {
+		*m.addstats_failures += i
+	} else {
+		m.addstats_failures = &i
+	}
+}
+
+// AddedStatsFailures returns the value that was added to the "stats_failures" field in this mutation.
+func (m *ArchiveMutation) AddedStatsFailures() (r int, exists bool) {
+	v := m.addstats_failures
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsFailures resets all changes to the "stats_failures" field.
+func (m *ArchiveMutation) ResetStatsFailures() {
+	m.stats_failures = nil
+	m.addstats_failures = nil
+}
+
+// SetDataVerifiedAt sets the "data_verified_at" field.
+func (m *ArchiveMutation) SetDataVerifiedAt(t time.Time) {
+	m.data_verified_at = &t
+}
+
+// DataVerifiedAt returns the value of the "data_verified_at" field in the mutation.
+func (m *ArchiveMutation) DataVerifiedAt() (r time.Time, exists bool) {
+	v := m.data_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataVerifiedAt returns the old "data_verified_at" field's value of the Archive entity.
+// If the Archive object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ArchiveMutation) OldDataVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataVerifiedAt: %w", err)
+	}
+	return oldValue.DataVerifiedAt, nil
+}
+
+// ClearDataVerifiedAt clears the value of the "data_verified_at" field.
+func (m *ArchiveMutation) ClearDataVerifiedAt() {
+	m.data_verified_at = nil
+	m.clearedFields[archive.FieldDataVerifiedAt] = struct{}{}
+}
+
+// DataVerifiedAtCleared returns if the "data_verified_at" field was cleared in this mutation.
+func (m *ArchiveMutation) DataVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[archive.FieldDataVerifiedAt]
+	return ok
+}
+
+// ResetDataVerifiedAt resets all changes to the "data_verified_at" field.
+func (m *ArchiveMutation) ResetDataVerifiedAt() {
+	m.data_verified_at = nil
+	delete(m.clearedFields, archive.FieldDataVerifiedAt)
+}
+
 // SetRepositoryID sets the "repository" edge to the Repository entity by id.
 func (m *ArchiveMutation) SetRepositoryID(id int) {
 	m.repository = &id
@@ -1369,6 +1825,60 @@ func (m *ArchiveMutation) ResetBackupProfile() {
 	m.clearedbackup_profile = false
 }
 
+// AddFileIDs adds the "files" edge to the ArchiveFile entity by ids.
+func (m *ArchiveMutation) AddFileIDs(ids ...int) {
+	if m.files == nil {
+		m.files = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFiles clears the "files" edge to the ArchiveFile entity.
+func (m *ArchiveMutation) ClearFiles() {
+	m.clearedfiles = true
+}
+
+// FilesCleared reports if the "files" edge to the ArchiveFile entity was cleared.
+func (m *ArchiveMutation) FilesCleared() bool {
+	return m.clearedfiles
+}
+
+// RemoveFileIDs removes the "files" edge to the ArchiveFile entity by IDs.
+func (m *ArchiveMutation) RemoveFileIDs(ids ...int) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.files, ids[i])
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed IDs of the "files" edge to the ArchiveFile entity.
+func (m *ArchiveMutation) RemovedFilesIDs() (ids []int) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the "files" edge IDs in the mutation.
+func (m *ArchiveMutation) FilesIDs() (ids []int) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles resets all changes to the "files" edge.
+func (m *ArchiveMutation) ResetFiles() {
+	m.files = nil
+	m.clearedfiles = false
+	m.removedfiles = nil
+}
+
 // Where appends a list predicates to the ArchiveMutation builder.
 func (m *ArchiveMutation) Where(ps ...predicate.Archive) {
 	m.predicates = append(m.predicates, ps...)
@@ -1403,7 +1913,7 @@ func (m *ArchiveMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ArchiveMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 15)
 	if m.created_at != nil {
 		fields = append(fields, archive.FieldCreatedAt)
 	}
@@ -1428,8 +1938,29 @@ func (m *ArchiveMutation) Fields() []string {
 	if m.warning_message != nil {
 		fields = append(fields, archive.FieldWarningMessage)
 	}
-	return fields
-}
+	if m.files_indexed_at != nil {
+		fields = append(fields, archive.FieldFilesIndexedAt)
+	}
+	if m.nfiles != nil {
+		fields = append(fields, archive.FieldNfiles)
+	}
+	if m.original_size != nil {
+		fields = append(fields, archive.FieldOriginalSize)
+	}
+	if m.compressed_size != nil {
+		fields = append(fields, archive.FieldCompressedSize)
+	}
+	if m.deduplicated_size != nil {
+		fields = append(fields, archive.FieldDeduplicatedSize)
+	}
+	if m.stats_failures != nil {
+		fields = append(fields, archive.FieldStatsFailures)
+	}
+	if m.data_verified_at != nil {
+		fields = append(fields, archive.FieldDataVerifiedAt)
+	}
+	return fields
+}
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
@@ -1452,6 +1983,20 @@ func (m *ArchiveMutation) Field(name string) (ent.Value, bool) {
 		return m.Comment()
 	case archive.FieldWarningMessage:
 		return m.WarningMessage()
+	case archive.FieldFilesIndexedAt:
+		return m.FilesIndexedAt()
+	case archive.FieldNfiles:
+		return m.Nfiles()
+	case archive.FieldOriginalSize:
+		return m.OriginalSize()
+	case archive.FieldCompressedSize:
+		return m.CompressedSize()
+	case archive.FieldDeduplicatedSize:
+		return m.DeduplicatedSize()
+	case archive.FieldStatsFailures:
+		return m.StatsFailures()
+	case archive.FieldDataVerifiedAt:
+		return m.DataVerifiedAt()
 	}
 	return nil, false
 }
@@ -1477,6 +2022,20 @@ func (m *ArchiveMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldComment(ctx)
 	case archive.FieldWarningMessage:
 		return m.OldWarningMessage(ctx)
+	case archive.FieldFilesIndexedAt:
+		return m.OldFilesIndexedAt(ctx)
+	case archive.FieldNfiles:
+		return m.OldNfiles(ctx)
+	case archive.FieldOriginalSize:
+		return m.OldOriginalSize(ctx)
+	case archive.FieldCompressedSize:
+		return m.OldCompressedSize(ctx)
+	case archive.FieldDeduplicatedSize:
+		return m.OldDeduplicatedSize(ctx)
+	case archive.FieldStatsFailures:
+		return m.OldStatsFailures(ctx)
+	case archive.FieldDataVerifiedAt:
+		return m.OldDataVerifiedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Archive field %s", name)
 }
@@ -1542,6 +2101,55 @@ func (m *ArchiveMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetWarningMessage(v)
 		return nil
+	case archive.FieldFilesIndexedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilesIndexedAt(v)
+		return nil
+	case archive.FieldNfiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNfiles(v)
+		return nil
+	case archive.FieldOriginalSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginalSize(v)
+		return nil
+	case archive.FieldCompressedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressedSize(v)
+		return nil
+	case archive.FieldDeduplicatedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeduplicatedSize(v)
+		return nil
+	case archive.FieldStatsFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsFailures(v)
+		return nil
+	case archive.FieldDataVerifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataVerifiedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Archive field %s", name)
 }
@@ -1553,6 +2161,21 @@ func (m *ArchiveMutation) AddedFields() []string {
 	if m.addduration != nil {
 		fields = append(fields, archive.FieldDuration)
 	}
+	if m.addnfiles != nil {
+		fields = append(fields, archive.FieldNfiles)
+	}
+	if m.addoriginal_size != nil {
+		fields = append(fields, archive.FieldOriginalSize)
+	}
+	if m.addcompressed_size != nil {
+		fields = append(fields, archive.FieldCompressedSize)
+	}
+	if m.adddeduplicated_size != nil {
+		fields = append(fields, archive.FieldDeduplicatedSize)
+	}
+	if m.addstats_failures != nil {
+		fields = append(fields, archive.FieldStatsFailures)
+	}
 	return fields
 }
 
@@ -1563,6 +2186,16 @@ func (m *ArchiveMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case archive.FieldDuration:
 		return m.AddedDuration()
+	case archive.FieldNfiles:
+		return m.AddedNfiles()
+	case archive.FieldOriginalSize:
+		return m.AddedOriginalSize()
+	case archive.FieldCompressedSize:
+		return m.AddedCompressedSize()
+	case archive.FieldDeduplicatedSize:
+		return m.AddedDeduplicatedSize()
+	case archive.FieldStatsFailures:
+		return m.AddedStatsFailures()
 	}
 	return nil, false
 }
@@ -1579,6 +2212,41 @@ func (m *ArchiveMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDuration(v)
 		return nil
+	case archive.FieldNfiles:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNfiles(v)
+		return nil
+	case archive.FieldOriginalSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOriginalSize(v)
+		return nil
+	case archive.FieldCompressedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCompressedSize(v)
+		return nil
+	case archive.FieldDeduplicatedSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeduplicatedSize(v)
+		return nil
+	case archive.FieldStatsFailures:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsFailures(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Archive numeric field %s", name)
 }
@@ -1593,6 +2261,24 @@ func (m *ArchiveMutation) ClearedFields() []string {
 	if m.FieldCleared(archive.FieldWarningMessage) {
 		fields = append(fields, archive.FieldWarningMessage)
 	}
+	if m.FieldCleared(archive.FieldFilesIndexedAt) {
+		fields = append(fields, archive.FieldFilesIndexedAt)
+	}
+	if m.FieldCleared(archive.FieldNfiles) {
+		fields = append(fields, archive.FieldNfiles)
+	}
+	if m.FieldCleared(archive.FieldOriginalSize) {
+		fields = append(fields, archive.FieldOriginalSize)
+	}
+	if m.FieldCleared(archive.FieldCompressedSize) {
+		fields = append(fields, archive.FieldCompressedSize)
+	}
+	if m.FieldCleared(archive.FieldDeduplicatedSize) {
+		fields = append(fields, archive.FieldDeduplicatedSize)
+	}
+	if m.FieldCleared(archive.FieldDataVerifiedAt) {
+		fields = append(fields, archive.FieldDataVerifiedAt)
+	}
 	return fields
 }
 
@@ -1613,6 +2299,24 @@ func (m *ArchiveMutation) ClearField(name string) error {
 	case archive.FieldWarningMessage:
 		m.ClearWarningMessage()
 		return nil
+	case archive.FieldFilesIndexedAt:
+		m.ClearFilesIndexedAt()
+		return nil
+	case archive.FieldNfiles:
+		m.ClearNfiles()
+		return nil
+	case archive.FieldOriginalSize:
+		m.ClearOriginalSize()
+		return nil
+	case archive.FieldCompressedSize:
+		m.ClearCompressedSize()
+		return nil
+	case archive.FieldDeduplicatedSize:
+		m.ClearDeduplicatedSize()
+		return nil
+	case archive.FieldDataVerifiedAt:
+		m.ClearDataVerifiedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Archive nullable field %s", name)
 }
@@ -1645,19 +2349,43 @@ func (m *ArchiveMutation) ResetField(name string) error {
 	case archive.FieldWarningMessage:
 		m.ResetWarningMessage()
 		return nil
+	case archive.FieldFilesIndexedAt:
+		m.ResetFilesIndexedAt()
+		return nil
+	case archive.FieldNfiles:
+		m.ResetNfiles()
+		return nil
+	case archive.FieldOriginalSize:
+		m.ResetOriginalSize()
+		return nil
+	case archive.FieldCompressedSize:
+		m.ResetCompressedSize()
+		return nil
+	case archive.FieldDeduplicatedSize:
+		m.ResetDeduplicatedSize()
+		return nil
+	case archive.FieldStatsFailures:
+		m.ResetStatsFailures()
+		return nil
+	case archive.FieldDataVerifiedAt:
+		m.ResetDataVerifiedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Archive field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *ArchiveMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.repository != nil {
 		edges = append(edges, archive.EdgeRepository)
 	}
 	if m.backup_profile != nil {
 		edges = append(edges, archive.EdgeBackupProfile)
 	}
+	if m.files != nil {
+		edges = append(edges, archive.EdgeFiles)
+	}
 	return edges
 }
 
@@ -1673,31 +2401,51 @@ func (m *ArchiveMutation) AddedIDs(name string) []ent.Value {
 		if id := m.backup_profile; id != nil {
 			return []ent.Value{*id}
 		}
+	case archive.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *ArchiveMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
+	if m.removedfiles != nil {
+		edges = append(edges, archive.EdgeFiles)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *ArchiveMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case archive.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *ArchiveMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedrepository {
 		edges = append(edges, archive.EdgeRepository)
 	}
 	if m.clearedbackup_profile {
 		edges = append(edges, archive.EdgeBackupProfile)
 	}
+	if m.clearedfiles {
+		edges = append(edges, archive.EdgeFiles)
+	}
 	return edges
 }
 
@@ -1709,6 +2457,8 @@ func (m *ArchiveMutation) EdgeCleared(name string) bool {
 		return m.clearedrepository
 	case archive.EdgeBackupProfile:
 		return m.clearedbackup_profile
+	case archive.EdgeFiles:
+		return m.clearedfiles
 	}
 	return false
 }
@@ -1737,38 +2487,42 @@ func (m *ArchiveMutation) ResetEdge(name string) error {
 	case archive.EdgeBackupProfile:
 		m.ResetBackupProfile()
 		return nil
+	case archive.EdgeFiles:
+		m.ResetFiles()
+		return nil
 	}
 	return fmt.Errorf("unknown Archive edge %s", name)
 }
 
-// AuthSessionMutation represents an operation that mutates the AuthSession nodes in the graph.
-type AuthSessionMutation struct {
+// ArchiveFileMutation represents an operation that mutates the ArchiveFile nodes in the graph.
+type ArchiveFileMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	session_id    *string
-	status        *authsession.Status
-	expires_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*AuthSession, error)
-	predicates    []predicate.AuthSession
-}
-
-var _ ent.Mutation = (*AuthSessionMutation)(nil)
-
-// authsessionOption allows management of the mutation configuration using functional options.
-type authsessionOption func(*AuthSessionMutation)
-
-// newAuthSessionMutation creates new mutation for the AuthSession entity.
-func newAuthSessionMutation(c config, op Op, opts ...authsessionOption) *AuthSessionMutation {
-	m := &AuthSessionMutation{
+	op             Op
+	typ            string
+	id             *int
+	_path          *string
+	size           *int64
+	addsize        *int64
+	mtime          *time.Time
+	clearedFields  map[string]struct{}
+	archive        *int
+	clearedarchive bool
+	done           bool
+	oldValue       func(context.Context) (*ArchiveFile, error)
+	predicates     []predicate.ArchiveFile
+}
+
+var _ ent.Mutation = (*ArchiveFileMutation)(nil)
+
+// archivefileOption allows management of the mutation configuration using functional options.
+type archivefileOption func(*ArchiveFileMutation)
+
+// newArchiveFileMutation creates new mutation for the ArchiveFile entity.
+func newArchiveFileMutation(c config, op Op, opts ...archivefileOption) *ArchiveFileMutation {
+	m := &ArchiveFileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAuthSession,
+		typ:           TypeArchiveFile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1777,20 +2531,20 @@ func newAuthSessionMutation(c config, op Op, opts ...authsessionOption) *AuthSes
 	return m
 }
 
-// withAuthSessionID sets the ID field of the mutation.
-func withAuthSessionID(id int) authsessionOption {
-	return func(m *AuthSessionMutation) {
+// withArchiveFileID sets the ID field of the mutation.
+func withArchiveFileID(id int) archivefileOption {
+	return func(m *ArchiveFileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *AuthSession
+			value *ArchiveFile
 		)
-		m.oldValue = func(ctx context.Context) (*AuthSession, error) {
+		m.oldValue = func(ctx context.Context) (*ArchiveFile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().AuthSession.Get(ctx, id)
+					value, err = m.Client().ArchiveFile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1799,10 +2553,10 @@ func withAuthSessionID(id int) authsessionOption {
 	}
 }
 
-// withAuthSession sets the old AuthSession of the mutation.
-func withAuthSession(node *AuthSession) authsessionOption {
-	return func(m *AuthSessionMutation) {
-		m.oldValue = func(context.Context) (*AuthSession, error) {
+// withArchiveFile sets the old ArchiveFile of the mutation.
+func withArchiveFile(node *ArchiveFile) archivefileOption {
+	return func(m *ArchiveFileMutation) {
+		m.oldValue = func(context.Context) (*ArchiveFile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1811,7 +2565,7 @@ func withAuthSession(node *AuthSession) authsessionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AuthSessionMutation) Client() *Client {
+func (m ArchiveFileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1819,7 +2573,7 @@ func (m AuthSessionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AuthSessionMutation) Tx() (*Tx, error) {
+func (m ArchiveFileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1829,14 +2583,14 @@ func (m AuthSessionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of AuthSession entities.
-func (m *AuthSessionMutation) SetID(id int) {
+// operation is only accepted on creation of ArchiveFile entities.
+func (m *ArchiveFileMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AuthSessionMutation) ID() (id int, exists bool) {
+func (m *ArchiveFileMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1847,7 +2601,7 @@ func (m *AuthSessionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AuthSessionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ArchiveFileMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1856,201 +2610,188 @@ func (m *AuthSessionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().AuthSession.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ArchiveFile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *AuthSessionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPath sets the "path" field.
+func (m *ArchiveFileMutation) SetPath(s string) {
+	m._path = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AuthSessionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Path returns the value of the "path" field in the mutation.
+func (m *ArchiveFileMutation) Path() (r string, exists bool) {
+	v := m._path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AuthSession entity.
-// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
+// OldPath returns the old "path" field's value of the ArchiveFile entity.
+// If the ArchiveFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveFileMutation) OldPath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPath: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Path, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AuthSessionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPath resets all changes to the "path" field.
+func (m *ArchiveFileMutation) ResetPath() {
+	m._path = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *AuthSessionMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetSize sets the "size" field.
+func (m *ArchiveFileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AuthSessionMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Size returns the value of the "size" field in the mutation.
+func (m *ArchiveFileMutation) Size() (r int64, exists bool) {
+	v := m.size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the AuthSession entity.
-// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
+// OldSize returns the old "size" field's value of the ArchiveFile entity.
+// If the ArchiveFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthSessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ArchiveFileMutation) OldSize(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AuthSessionMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Size, nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *AuthSessionMutation) SetSessionID(s string) {
-	m.session_id = &s
+// AddSize adds i to the "size" field.
+func (m *ArchiveFileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *AuthSessionMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *ArchiveFileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the AuthSession entity.
-// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthSessionMutation) OldSessionID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
-	}
-	return oldValue.SessionID, nil
-}
-
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *AuthSessionMutation) ResetSessionID() {
-	m.session_id = nil
+// ResetSize resets all changes to the "size" field.
+func (m *ArchiveFileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *AuthSessionMutation) SetStatus(a authsession.Status) {
-	m.status = &a
+// SetMtime sets the "mtime" field.
+func (m *ArchiveFileMutation) SetMtime(t time.Time) {
+	m.mtime = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *AuthSessionMutation) Status() (r authsession.Status, exists bool) {
-	v := m.status
+// Mtime returns the value of the "mtime" field in the mutation.
+func (m *ArchiveFileMutation) Mtime() (r time.Time, exists bool) {
+	v := m.mtime
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the AuthSession entity.
-// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
+// OldMtime returns the old "mtime" field's value of the ArchiveFile entity.
+// If the ArchiveFile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthSessionMutation) OldStatus(ctx context.Context) (v authsession.Status, err error) {
+func (m *ArchiveFileMutation) OldMtime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldMtime is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldMtime requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldMtime: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Mtime, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *AuthSessionMutation) ResetStatus() {
-	m.status = nil
+// ResetMtime resets all changes to the "mtime" field.
+func (m *ArchiveFileMutation) ResetMtime() {
+	m.mtime = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *AuthSessionMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetArchiveID sets the "archive" edge to the Archive entity by id.
+func (m *ArchiveFileMutation) SetArchiveID(id int) {
+	m.archive = &id
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *AuthSessionMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearArchive clears the "archive" edge to the Archive entity.
+func (m *ArchiveFileMutation) ClearArchive() {
+	m.clearedarchive = true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the AuthSession entity.
-// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AuthSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+// ArchiveCleared reports if the "archive" edge to the Archive entity was cleared.
+func (m *ArchiveFileMutation) ArchiveCleared() bool {
+	return m.clearedarchive
+}
+
+// ArchiveID returns the "archive" edge ID in the mutation.
+func (m *ArchiveFileMutation) ArchiveID() (id int, exists bool) {
+	if m.archive != nil {
+		return *m.archive, true
 	}
-	return oldValue.ExpiresAt, nil
+	return
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *AuthSessionMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ArchiveIDs returns the "archive" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ArchiveID instead. It exists only for internal usage by the builders.
+func (m *ArchiveFileMutation) ArchiveIDs() (ids []int) {
+	if id := m.archive; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// Where appends a list predicates to the AuthSessionMutation builder.
-func (m *AuthSessionMutation) Where(ps ...predicate.AuthSession) {
+// ResetArchive resets all changes to the "archive" edge.
+func (m *ArchiveFileMutation) ResetArchive() {
+	m.archive = nil
+	m.clearedarchive = false
+}
+
+// Where appends a list predicates to the ArchiveFileMutation builder.
+func (m *ArchiveFileMutation) Where(ps ...predicate.ArchiveFile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AuthSessionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ArchiveFileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthSessionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthSession, len(ps))
+func (m *ArchiveFileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ArchiveFile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2058,39 +2799,33 @@ func (m *AuthSessionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AuthSessionMutation) Op() Op {
+func (m *ArchiveFileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AuthSessionMutation) SetOp(op Op) {
+func (m *ArchiveFileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (AuthSession).
-func (m *AuthSessionMutation) Type() string {
+// Type returns the node type of this mutation (ArchiveFile).
+func (m *ArchiveFileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AuthSessionMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.created_at != nil {
-		fields = append(fields, authsession.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, authsession.FieldUpdatedAt)
+func (m *ArchiveFileMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m._path != nil {
+		fields = append(fields, archivefile.FieldPath)
 	}
-	if m.session_id != nil {
-		fields = append(fields, authsession.FieldSessionID)
-	}
-	if m.status != nil {
-		fields = append(fields, authsession.FieldStatus)
+	if m.size != nil {
+		fields = append(fields, archivefile.FieldSize)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, authsession.FieldExpiresAt)
+	if m.mtime != nil {
+		fields = append(fields, archivefile.FieldMtime)
 	}
 	return fields
 }
@@ -2098,18 +2833,14 @@ func (m *AuthSessionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AuthSessionMutation) Field(name string) (ent.Value, bool) {
+func (m *ArchiveFileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case authsession.FieldCreatedAt:
-		return m.CreatedAt()
-	case authsession.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case authsession.FieldSessionID:
-		return m.SessionID()
-	case authsession.FieldStatus:
-		return m.Status()
-	case authsession.FieldExpiresAt:
-		return m.ExpiresAt()
+	case archivefile.FieldPath:
+		return m.Path()
+	case archivefile.FieldSize:
+		return m.Size()
+	case archivefile.FieldMtime:
+		return m.Mtime()
 	}
 	return nil, false
 }
@@ -2117,230 +2848,223 @@ func (m *AuthSessionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ArchiveFileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authsession.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case authsession.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case authsession.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case authsession.FieldStatus:
-		return m.OldStatus(ctx)
-	case authsession.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
+	case archivefile.FieldPath:
+		return m.OldPath(ctx)
+	case archivefile.FieldSize:
+		return m.OldSize(ctx)
+	case archivefile.FieldMtime:
+		return m.OldMtime(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthSession field %s", name)
+	return nil, fmt.Errorf("unknown ArchiveFile field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthSessionMutation) SetField(name string, value ent.Value) error {
+func (m *ArchiveFileMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authsession.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case authsession.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case authsession.FieldSessionID:
+	case archivefile.FieldPath:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSessionID(v)
+		m.SetPath(v)
 		return nil
-	case authsession.FieldStatus:
-		v, ok := value.(authsession.Status)
+	case archivefile.FieldSize:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetSize(v)
 		return nil
-	case authsession.FieldExpiresAt:
+	case archivefile.FieldMtime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetMtime(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthSession field %s", name)
+	return fmt.Errorf("unknown ArchiveFile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthSessionMutation) AddedFields() []string {
-	return nil
+func (m *ArchiveFileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, archivefile.FieldSize)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthSessionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ArchiveFileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case archivefile.FieldSize:
+		return m.AddedSize()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthSessionMutation) AddField(name string, value ent.Value) error {
+func (m *ArchiveFileMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case archivefile.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
 	}
-	return fmt.Errorf("unknown AuthSession numeric field %s", name)
+	return fmt.Errorf("unknown ArchiveFile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AuthSessionMutation) ClearedFields() []string {
+func (m *ArchiveFileMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AuthSessionMutation) FieldCleared(name string) bool {
+func (m *ArchiveFileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AuthSessionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthSession nullable field %s", name)
+func (m *ArchiveFileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ArchiveFile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AuthSessionMutation) ResetField(name string) error {
+func (m *ArchiveFileMutation) ResetField(name string) error {
 	switch name {
-	case authsession.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case authsession.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case authsession.FieldSessionID:
-		m.ResetSessionID()
+	case archivefile.FieldPath:
+		m.ResetPath()
 		return nil
-	case authsession.FieldStatus:
-		m.ResetStatus()
+	case archivefile.FieldSize:
+		m.ResetSize()
 		return nil
-	case authsession.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case archivefile.FieldMtime:
+		m.ResetMtime()
 		return nil
 	}
-	return fmt.Errorf("unknown AuthSession field %s", name)
+	return fmt.Errorf("unknown ArchiveFile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthSessionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ArchiveFileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.archive != nil {
+		edges = append(edges, archivefile.EdgeArchive)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AuthSessionMutation) AddedIDs(name string) []ent.Value {
+func (m *ArchiveFileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case archivefile.EdgeArchive:
+		if id := m.archive; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthSessionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ArchiveFileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AuthSessionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ArchiveFileMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthSessionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ArchiveFileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedarchive {
+		edges = append(edges, archivefile.EdgeArchive)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AuthSessionMutation) EdgeCleared(name string) bool {
+func (m *ArchiveFileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case archivefile.EdgeArchive:
+		return m.clearedarchive
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AuthSessionMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown AuthSession unique edge %s", name)
+func (m *ArchiveFileMutation) ClearEdge(name string) error {
+	switch name {
+	case archivefile.EdgeArchive:
+		m.ClearArchive()
+		return nil
+	}
+	return fmt.Errorf("unknown ArchiveFile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AuthSessionMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown AuthSession edge %s", name)
+func (m *ArchiveFileMutation) ResetEdge(name string) error {
+	switch name {
+	case archivefile.EdgeArchive:
+		m.ResetArchive()
+		return nil
+	}
+	return fmt.Errorf("unknown ArchiveFile edge %s", name)
 }
 
-// BackupProfileMutation represents an operation that mutates the BackupProfile nodes in the graph.
-type BackupProfileMutation struct {
+// AuthSessionMutation represents an operation that mutates the AuthSession nodes in the graph.
+type AuthSessionMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	name                       *string
-	prefix                     *string
-	backup_paths               *[]string
-	appendbackup_paths         []string
-	exclude_paths              *[]string
-	appendexclude_paths        []string
-	exclude_caches             *bool
-	icon                       *backupprofile.Icon
-	compression_mode           *backupprofile.CompressionMode
-	compression_level          *int
-	addcompression_level       *int
-	data_section_collapsed     *bool
-	schedule_section_collapsed *bool
-	advanced_section_collapsed *bool
-	clearedFields              map[string]struct{}
-	repositories               map[int]struct{}
-	removedrepositories        map[int]struct{}
-	clearedrepositories        bool
-	archives                   map[int]struct{}
-	removedarchives            map[int]struct{}
-	clearedarchives            bool
-	backup_schedule            *int
-	clearedbackup_schedule     bool
-	pruning_rule               *int
-	clearedpruning_rule        bool
-	notifications              map[int]struct{}
-	removednotifications       map[int]struct{}
-	clearednotifications       bool
-	done                       bool
-	oldValue                   func(context.Context) (*BackupProfile, error)
-	predicates                 []predicate.BackupProfile
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	session_id    *string
+	status        *authsession.Status
+	expires_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuthSession, error)
+	predicates    []predicate.AuthSession
 }
 
-var _ ent.Mutation = (*BackupProfileMutation)(nil)
+var _ ent.Mutation = (*AuthSessionMutation)(nil)
 
-// backupprofileOption allows management of the mutation configuration using functional options.
-type backupprofileOption func(*BackupProfileMutation)
+// authsessionOption allows management of the mutation configuration using functional options.
+type authsessionOption func(*AuthSessionMutation)
 
-// newBackupProfileMutation creates new mutation for the BackupProfile entity.
-func newBackupProfileMutation(c config, op Op, opts ...backupprofileOption) *BackupProfileMutation {
-	m := &BackupProfileMutation{
+// newAuthSessionMutation creates new mutation for the AuthSession entity.
+func newAuthSessionMutation(c config, op Op, opts ...authsessionOption) *AuthSessionMutation {
+	m := &AuthSessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackupProfile,
+		typ:           TypeAuthSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2349,20 +3073,20 @@ func newBackupProfileMutation(c config, op Op, opts ...backupprofileOption) *Bac
 	return m
 }
 
-// withBackupProfileID sets the ID field of the mutation.
-func withBackupProfileID(id int) backupprofileOption {
-	return func(m *BackupProfileMutation) {
+// withAuthSessionID sets the ID field of the mutation.
+func withAuthSessionID(id int) authsessionOption {
+	return func(m *AuthSessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BackupProfile
+			value *AuthSession
 		)
-		m.oldValue = func(ctx context.Context) (*BackupProfile, error) {
+		m.oldValue = func(ctx context.Context) (*AuthSession, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BackupProfile.Get(ctx, id)
+					value, err = m.Client().AuthSession.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2371,10 +3095,10 @@ func withBackupProfileID(id int) backupprofileOption {
 	}
 }
 
-// withBackupProfile sets the old BackupProfile of the mutation.
-func withBackupProfile(node *BackupProfile) backupprofileOption {
-	return func(m *BackupProfileMutation) {
-		m.oldValue = func(context.Context) (*BackupProfile, error) {
+// withAuthSession sets the old AuthSession of the mutation.
+func withAuthSession(node *AuthSession) authsessionOption {
+	return func(m *AuthSessionMutation) {
+		m.oldValue = func(context.Context) (*AuthSession, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2383,7 +3107,7 @@ func withBackupProfile(node *BackupProfile) backupprofileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackupProfileMutation) Client() *Client {
+func (m AuthSessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2391,7 +3115,7 @@ func (m BackupProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackupProfileMutation) Tx() (*Tx, error) {
+func (m AuthSessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2401,14 +3125,14 @@ func (m BackupProfileMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BackupProfile entities.
-func (m *BackupProfileMutation) SetID(id int) {
+// operation is only accepted on creation of AuthSession entities.
+func (m *AuthSessionMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackupProfileMutation) ID() (id int, exists bool) {
+func (m *AuthSessionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2419,7 +3143,7 @@ func (m *BackupProfileMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackupProfileMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuthSessionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2428,19 +3152,19 @@ func (m *BackupProfileMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BackupProfile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuthSession.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *BackupProfileMutation) SetCreatedAt(t time.Time) {
+func (m *AuthSessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BackupProfileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *AuthSessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2448,10 +3172,10 @@ func (m *BackupProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthSessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2466,17 +3190,17 @@ func (m *BackupProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BackupProfileMutation) ResetCreatedAt() {
+func (m *AuthSessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *BackupProfileMutation) SetUpdatedAt(t time.Time) {
+func (m *AuthSessionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BackupProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *AuthSessionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2484,10 +3208,10 @@ func (m *BackupProfileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuthSessionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2502,733 +3226,1041 @@ func (m *BackupProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BackupProfileMutation) ResetUpdatedAt() {
+func (m *AuthSessionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *BackupProfileMutation) SetName(s string) {
-	m.name = &s
+// SetSessionID sets the "session_id" field.
+func (m *AuthSessionMutation) SetSessionID(s string) {
+	m.session_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *BackupProfileMutation) Name() (r string, exists bool) {
-	v := m.name
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *AuthSessionMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuthSessionMutation) OldSessionID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.SessionID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *BackupProfileMutation) ResetName() {
-	m.name = nil
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *AuthSessionMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// SetPrefix sets the "prefix" field.
-func (m *BackupProfileMutation) SetPrefix(s string) {
-	m.prefix = &s
+// SetStatus sets the "status" field.
+func (m *AuthSessionMutation) SetStatus(a authsession.Status) {
+	m.status = &a
 }
 
-// Prefix returns the value of the "prefix" field in the mutation.
-func (m *BackupProfileMutation) Prefix() (r string, exists bool) {
-	v := m.prefix
+// Status returns the value of the "status" field in the mutation.
+func (m *AuthSessionMutation) Status() (r authsession.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrefix returns the old "prefix" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldPrefix(ctx context.Context) (v string, err error) {
+func (m *AuthSessionMutation) OldStatus(ctx context.Context) (v authsession.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrefix requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Prefix, nil
+	return oldValue.Status, nil
 }
 
-// ResetPrefix resets all changes to the "prefix" field.
-func (m *BackupProfileMutation) ResetPrefix() {
-	m.prefix = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *AuthSessionMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetBackupPaths sets the "backup_paths" field.
-func (m *BackupProfileMutation) SetBackupPaths(s []string) {
-	m.backup_paths = &s
-	m.appendbackup_paths = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *AuthSessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// BackupPaths returns the value of the "backup_paths" field in the mutation.
-func (m *BackupProfileMutation) BackupPaths() (r []string, exists bool) {
-	v := m.backup_paths
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AuthSessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBackupPaths returns the old "backup_paths" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AuthSession entity.
+// If the AuthSession object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldBackupPaths(ctx context.Context) (v []string, err error) {
+func (m *AuthSessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBackupPaths is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBackupPaths requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBackupPaths: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.BackupPaths, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// AppendBackupPaths adds s to the "backup_paths" field.
-func (m *BackupProfileMutation) AppendBackupPaths(s []string) {
-	m.appendbackup_paths = append(m.appendbackup_paths, s...)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AuthSessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AppendedBackupPaths returns the list of values that were appended to the "backup_paths" field in this mutation.
-func (m *BackupProfileMutation) AppendedBackupPaths() ([]string, bool) {
-	if len(m.appendbackup_paths) == 0 {
-		return nil, false
+// Where appends a list predicates to the AuthSessionMutation builder.
+func (m *AuthSessionMutation) Where(ps ...predicate.AuthSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuthSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return m.appendbackup_paths, true
+	m.Where(p...)
 }
 
-// ResetBackupPaths resets all changes to the "backup_paths" field.
-func (m *BackupProfileMutation) ResetBackupPaths() {
-	m.backup_paths = nil
-	m.appendbackup_paths = nil
+// Op returns the operation name.
+func (m *AuthSessionMutation) Op() Op {
+	return m.op
 }
 
-// SetExcludePaths sets the "exclude_paths" field.
-func (m *BackupProfileMutation) SetExcludePaths(s []string) {
-	m.exclude_paths = &s
-	m.appendexclude_paths = nil
+// SetOp allows setting the mutation operation.
+func (m *AuthSessionMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// ExcludePaths returns the value of the "exclude_paths" field in the mutation.
-func (m *BackupProfileMutation) ExcludePaths() (r []string, exists bool) {
-	v := m.exclude_paths
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (AuthSession).
+func (m *AuthSessionMutation) Type() string {
+	return m.typ
 }
 
-// OldExcludePaths returns the old "exclude_paths" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldExcludePaths(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExcludePaths is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthSessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, authsession.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExcludePaths requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, authsession.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExcludePaths: %w", err)
+	if m.session_id != nil {
+		fields = append(fields, authsession.FieldSessionID)
 	}
-	return oldValue.ExcludePaths, nil
-}
-
-// AppendExcludePaths adds s to the "exclude_paths" field.
-func (m *BackupProfileMutation) AppendExcludePaths(s []string) {
-	m.appendexclude_paths = append(m.appendexclude_paths, s...)
-}
-
-// AppendedExcludePaths returns the list of values that were appended to the "exclude_paths" field in this mutation.
-func (m *BackupProfileMutation) AppendedExcludePaths() ([]string, bool) {
-	if len(m.appendexclude_paths) == 0 {
-		return nil, false
+	if m.status != nil {
+		fields = append(fields, authsession.FieldStatus)
 	}
-	return m.appendexclude_paths, true
-}
-
-// ClearExcludePaths clears the value of the "exclude_paths" field.
-func (m *BackupProfileMutation) ClearExcludePaths() {
-	m.exclude_paths = nil
-	m.appendexclude_paths = nil
-	m.clearedFields[backupprofile.FieldExcludePaths] = struct{}{}
-}
-
-// ExcludePathsCleared returns if the "exclude_paths" field was cleared in this mutation.
-func (m *BackupProfileMutation) ExcludePathsCleared() bool {
-	_, ok := m.clearedFields[backupprofile.FieldExcludePaths]
-	return ok
-}
-
-// ResetExcludePaths resets all changes to the "exclude_paths" field.
-func (m *BackupProfileMutation) ResetExcludePaths() {
-	m.exclude_paths = nil
-	m.appendexclude_paths = nil
-	delete(m.clearedFields, backupprofile.FieldExcludePaths)
+	if m.expires_at != nil {
+		fields = append(fields, authsession.FieldExpiresAt)
+	}
+	return fields
 }
 
-// SetExcludeCaches sets the "exclude_caches" field.
-func (m *BackupProfileMutation) SetExcludeCaches(b bool) {
-	m.exclude_caches = &b
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authsession.FieldCreatedAt:
+		return m.CreatedAt()
+	case authsession.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case authsession.FieldSessionID:
+		return m.SessionID()
+	case authsession.FieldStatus:
+		return m.Status()
+	case authsession.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
 }
 
-// ExcludeCaches returns the value of the "exclude_caches" field in the mutation.
-func (m *BackupProfileMutation) ExcludeCaches() (r bool, exists bool) {
-	v := m.exclude_caches
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authsession.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case authsession.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case authsession.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case authsession.FieldStatus:
+		return m.OldStatus(ctx)
+	case authsession.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// OldExcludeCaches returns the old "exclude_caches" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldExcludeCaches(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExcludeCaches is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExcludeCaches requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExcludeCaches: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authsession.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authsession.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case authsession.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case authsession.FieldStatus:
+		v, ok := value.(authsession.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case authsession.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
 	}
-	return oldValue.ExcludeCaches, nil
+	return fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// ResetExcludeCaches resets all changes to the "exclude_caches" field.
-func (m *BackupProfileMutation) ResetExcludeCaches() {
-	m.exclude_caches = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthSessionMutation) AddedFields() []string {
+	return nil
 }
 
-// SetIcon sets the "icon" field.
-func (m *BackupProfileMutation) SetIcon(b backupprofile.Icon) {
-	m.icon = &b
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthSessionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// Icon returns the value of the "icon" field in the mutation.
-func (m *BackupProfileMutation) Icon() (r backupprofile.Icon, exists bool) {
-	v := m.icon
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuthSession numeric field %s", name)
 }
 
-// OldIcon returns the old "icon" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldIcon(ctx context.Context) (v backupprofile.Icon, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIcon requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
-	}
-	return oldValue.Icon, nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthSessionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetIcon resets all changes to the "icon" field.
-func (m *BackupProfileMutation) ResetIcon() {
-	m.icon = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetCompressionMode sets the "compression_mode" field.
-func (m *BackupProfileMutation) SetCompressionMode(bm backupprofile.CompressionMode) {
-	m.compression_mode = &bm
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthSessionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown AuthSession nullable field %s", name)
 }
 
-// CompressionMode returns the value of the "compression_mode" field in the mutation.
-func (m *BackupProfileMutation) CompressionMode() (r backupprofile.CompressionMode, exists bool) {
-	v := m.compression_mode
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthSessionMutation) ResetField(name string) error {
+	switch name {
+	case authsession.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case authsession.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case authsession.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case authsession.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case authsession.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown AuthSession field %s", name)
 }
 
-// OldCompressionMode returns the old "compression_mode" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldCompressionMode(ctx context.Context) (v backupprofile.CompressionMode, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompressionMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompressionMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompressionMode: %w", err)
-	}
-	return oldValue.CompressionMode, nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// ResetCompressionMode resets all changes to the "compression_mode" field.
-func (m *BackupProfileMutation) ResetCompressionMode() {
-	m.compression_mode = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthSessionMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// SetCompressionLevel sets the "compression_level" field.
-func (m *BackupProfileMutation) SetCompressionLevel(i int) {
-	m.compression_level = &i
-	m.addcompression_level = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// CompressionLevel returns the value of the "compression_level" field in the mutation.
-func (m *BackupProfileMutation) CompressionLevel() (r int, exists bool) {
-	v := m.compression_level
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldCompressionLevel returns the old "compression_level" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldCompressionLevel(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompressionLevel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompressionLevel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompressionLevel: %w", err)
-	}
-	return oldValue.CompressionLevel, nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddCompressionLevel adds i to the "compression_level" field.
-func (m *BackupProfileMutation) AddCompressionLevel(i int) {
-	if m.addcompression_level != nil {
-		*m.addcompression_level += i
-	} else {
-		m.addcompression_level = &i
-	}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthSessionMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// AddedCompressionLevel returns the value that was added to the "compression_level" field in this mutation.
-func (m *BackupProfileMutation) AddedCompressionLevel() (r int, exists bool) {
-	v := m.addcompression_level
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthSessionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuthSession unique edge %s", name)
 }
 
-// ClearCompressionLevel clears the value of the "compression_level" field.
-func (m *BackupProfileMutation) ClearCompressionLevel() {
-	m.compression_level = nil
-	m.addcompression_level = nil
-	m.clearedFields[backupprofile.FieldCompressionLevel] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthSessionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuthSession edge %s", name)
 }
 
-// CompressionLevelCleared returns if the "compression_level" field was cleared in this mutation.
-func (m *BackupProfileMutation) CompressionLevelCleared() bool {
-	_, ok := m.clearedFields[backupprofile.FieldCompressionLevel]
-	return ok
+// BackupHookMutation represents an operation that mutates the BackupHook nodes in the graph.
+type BackupHookMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	_type                 *backuphook.Type
+	run_on                *backuphook.RunOn
+	command               *string
+	position              *int
+	addposition           *int
+	timeout_seconds       *int
+	addtimeout_seconds    *int
+	abort_on_failure      *bool
+	last_run_at           *time.Time
+	last_exit_code        *int
+	addlast_exit_code     *int
+	last_output           *string
+	clearedFields         map[string]struct{}
+	backup_profile        *int
+	clearedbackup_profile bool
+	done                  bool
+	oldValue              func(context.Context) (*BackupHook, error)
+	predicates            []predicate.BackupHook
 }
 
-// ResetCompressionLevel resets all changes to the "compression_level" field.
-func (m *BackupProfileMutation) ResetCompressionLevel() {
-	m.compression_level = nil
-	m.addcompression_level = nil
-	delete(m.clearedFields, backupprofile.FieldCompressionLevel)
-}
+var _ ent.Mutation = (*BackupHookMutation)(nil)
 
-// SetDataSectionCollapsed sets the "data_section_collapsed" field.
-func (m *BackupProfileMutation) SetDataSectionCollapsed(b bool) {
-	m.data_section_collapsed = &b
-}
+// backuphookOption allows management of the mutation configuration using functional options.
+type backuphookOption func(*BackupHookMutation)
 
-// DataSectionCollapsed returns the value of the "data_section_collapsed" field in the mutation.
-func (m *BackupProfileMutation) DataSectionCollapsed() (r bool, exists bool) {
-	v := m.data_section_collapsed
+// newBackupHookMutation creates new mutation for the BackupHook entity.
+func newBackupHookMutation(c config, op Op, opts ...backuphookOption) *BackupHookMutation {
+	m := &BackupHookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBackupHook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBackupHookID sets the ID field of the mutation.
+func withBackupHookID(id int) backuphookOption {
+	return func(m *BackupHookMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BackupHook
+		)
+		m.oldValue = func(ctx context.Context) (*BackupHook, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BackupHook.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBackupHook sets the old BackupHook of the mutation.
+func withBackupHook(node *BackupHook) backuphookOption {
+	return func(m *BackupHookMutation) {
+		m.oldValue = func(context.Context) (*BackupHook, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BackupHookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BackupHookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BackupHook entities.
+func (m *BackupHookMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BackupHookMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BackupHookMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BackupHook.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BackupHookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BackupHookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDataSectionCollapsed returns the old "data_section_collapsed" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldDataSectionCollapsed(ctx context.Context) (v bool, err error) {
+func (m *BackupHookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDataSectionCollapsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDataSectionCollapsed requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDataSectionCollapsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DataSectionCollapsed, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDataSectionCollapsed resets all changes to the "data_section_collapsed" field.
-func (m *BackupProfileMutation) ResetDataSectionCollapsed() {
-	m.data_section_collapsed = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BackupHookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetScheduleSectionCollapsed sets the "schedule_section_collapsed" field.
-func (m *BackupProfileMutation) SetScheduleSectionCollapsed(b bool) {
-	m.schedule_section_collapsed = &b
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackupHookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ScheduleSectionCollapsed returns the value of the "schedule_section_collapsed" field in the mutation.
-func (m *BackupProfileMutation) ScheduleSectionCollapsed() (r bool, exists bool) {
-	v := m.schedule_section_collapsed
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackupHookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScheduleSectionCollapsed returns the old "schedule_section_collapsed" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldScheduleSectionCollapsed(ctx context.Context) (v bool, err error) {
+func (m *BackupHookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScheduleSectionCollapsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScheduleSectionCollapsed requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScheduleSectionCollapsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ScheduleSectionCollapsed, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetScheduleSectionCollapsed resets all changes to the "schedule_section_collapsed" field.
-func (m *BackupProfileMutation) ResetScheduleSectionCollapsed() {
-	m.schedule_section_collapsed = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackupHookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetAdvancedSectionCollapsed sets the "advanced_section_collapsed" field.
-func (m *BackupProfileMutation) SetAdvancedSectionCollapsed(b bool) {
-	m.advanced_section_collapsed = &b
+// SetType sets the "type" field.
+func (m *BackupHookMutation) SetType(b backuphook.Type) {
+	m._type = &b
 }
 
-// AdvancedSectionCollapsed returns the value of the "advanced_section_collapsed" field in the mutation.
-func (m *BackupProfileMutation) AdvancedSectionCollapsed() (r bool, exists bool) {
-	v := m.advanced_section_collapsed
+// GetType returns the value of the "type" field in the mutation.
+func (m *BackupHookMutation) GetType() (r backuphook.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAdvancedSectionCollapsed returns the old "advanced_section_collapsed" field's value of the BackupProfile entity.
-// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupProfileMutation) OldAdvancedSectionCollapsed(ctx context.Context) (v bool, err error) {
+func (m *BackupHookMutation) OldType(ctx context.Context) (v backuphook.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAdvancedSectionCollapsed is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAdvancedSectionCollapsed requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAdvancedSectionCollapsed: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.AdvancedSectionCollapsed, nil
-}
-
-// ResetAdvancedSectionCollapsed resets all changes to the "advanced_section_collapsed" field.
-func (m *BackupProfileMutation) ResetAdvancedSectionCollapsed() {
-	m.advanced_section_collapsed = nil
+	return oldValue.Type, nil
 }
 
-// AddRepositoryIDs adds the "repositories" edge to the Repository entity by ids.
-func (m *BackupProfileMutation) AddRepositoryIDs(ids ...int) {
-	if m.repositories == nil {
-		m.repositories = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.repositories[ids[i]] = struct{}{}
-	}
+// ResetType resets all changes to the "type" field.
+func (m *BackupHookMutation) ResetType() {
+	m._type = nil
 }
 
-// ClearRepositories clears the "repositories" edge to the Repository entity.
-func (m *BackupProfileMutation) ClearRepositories() {
-	m.clearedrepositories = true
+// SetRunOn sets the "run_on" field.
+func (m *BackupHookMutation) SetRunOn(bo backuphook.RunOn) {
+	m.run_on = &bo
 }
 
-// RepositoriesCleared reports if the "repositories" edge to the Repository entity was cleared.
-func (m *BackupProfileMutation) RepositoriesCleared() bool {
-	return m.clearedrepositories
+// RunOn returns the value of the "run_on" field in the mutation.
+func (m *BackupHookMutation) RunOn() (r backuphook.RunOn, exists bool) {
+	v := m.run_on
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveRepositoryIDs removes the "repositories" edge to the Repository entity by IDs.
-func (m *BackupProfileMutation) RemoveRepositoryIDs(ids ...int) {
-	if m.removedrepositories == nil {
-		m.removedrepositories = make(map[int]struct{})
+// OldRunOn returns the old "run_on" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldRunOn(ctx context.Context) (v backuphook.RunOn, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRunOn is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.repositories, ids[i])
-		m.removedrepositories[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRunOn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRunOn: %w", err)
 	}
+	return oldValue.RunOn, nil
 }
 
-// RemovedRepositories returns the removed IDs of the "repositories" edge to the Repository entity.
-func (m *BackupProfileMutation) RemovedRepositoriesIDs() (ids []int) {
-	for id := range m.removedrepositories {
-		ids = append(ids, id)
-	}
-	return
+// ResetRunOn resets all changes to the "run_on" field.
+func (m *BackupHookMutation) ResetRunOn() {
+	m.run_on = nil
 }
 
-// RepositoriesIDs returns the "repositories" edge IDs in the mutation.
-func (m *BackupProfileMutation) RepositoriesIDs() (ids []int) {
-	for id := range m.repositories {
-		ids = append(ids, id)
-	}
-	return
+// SetCommand sets the "command" field.
+func (m *BackupHookMutation) SetCommand(s string) {
+	m.command = &s
 }
 
-// ResetRepositories resets all changes to the "repositories" edge.
-func (m *BackupProfileMutation) ResetRepositories() {
-	m.repositories = nil
-	m.clearedrepositories = false
-	m.removedrepositories = nil
+// Command returns the value of the "command" field in the mutation.
+func (m *BackupHookMutation) Command() (r string, exists bool) {
+	v := m.command
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddArchiveIDs adds the "archives" edge to the Archive entity by ids.
-func (m *BackupProfileMutation) AddArchiveIDs(ids ...int) {
-	if m.archives == nil {
-		m.archives = make(map[int]struct{})
+// OldCommand returns the old "command" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldCommand(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommand is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.archives[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommand requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommand: %w", err)
+	}
+	return oldValue.Command, nil
 }
 
-// ClearArchives clears the "archives" edge to the Archive entity.
-func (m *BackupProfileMutation) ClearArchives() {
-	m.clearedarchives = true
+// ResetCommand resets all changes to the "command" field.
+func (m *BackupHookMutation) ResetCommand() {
+	m.command = nil
 }
 
-// ArchivesCleared reports if the "archives" edge to the Archive entity was cleared.
-func (m *BackupProfileMutation) ArchivesCleared() bool {
-	return m.clearedarchives
+// SetPosition sets the "position" field.
+func (m *BackupHookMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// RemoveArchiveIDs removes the "archives" edge to the Archive entity by IDs.
-func (m *BackupProfileMutation) RemoveArchiveIDs(ids ...int) {
-	if m.removedarchives == nil {
-		m.removedarchives = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.archives, ids[i])
-		m.removedarchives[ids[i]] = struct{}{}
+// Position returns the value of the "position" field in the mutation.
+func (m *BackupHookMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedArchives returns the removed IDs of the "archives" edge to the Archive entity.
-func (m *BackupProfileMutation) RemovedArchivesIDs() (ids []int) {
-	for id := range m.removedarchives {
-		ids = append(ids, id)
+// OldPosition returns the old "position" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
 }
 
-// ArchivesIDs returns the "archives" edge IDs in the mutation.
-func (m *BackupProfileMutation) ArchivesIDs() (ids []int) {
-	for id := range m.archives {
-		ids = append(ids, id)
+// AddPosition adds i to the "position" field.
+func (m *BackupHookMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
 	}
-	return
 }
 
-// ResetArchives resets all changes to the "archives" edge.
-func (m *BackupProfileMutation) ResetArchives() {
-	m.archives = nil
-	m.clearedarchives = false
-	m.removedarchives = nil
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *BackupHookMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetBackupScheduleID sets the "backup_schedule" edge to the BackupSchedule entity by id.
-func (m *BackupProfileMutation) SetBackupScheduleID(id int) {
-	m.backup_schedule = &id
+// ResetPosition resets all changes to the "position" field.
+func (m *BackupHookMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// ClearBackupSchedule clears the "backup_schedule" edge to the BackupSchedule entity.
-func (m *BackupProfileMutation) ClearBackupSchedule() {
-	m.clearedbackup_schedule = true
+// SetTimeoutSeconds sets the "timeout_seconds" field.
+func (m *BackupHookMutation) SetTimeoutSeconds(i int) {
+	m.timeout_seconds = &i
+	m.addtimeout_seconds = nil
 }
 
-// BackupScheduleCleared reports if the "backup_schedule" edge to the BackupSchedule entity was cleared.
-func (m *BackupProfileMutation) BackupScheduleCleared() bool {
-	return m.clearedbackup_schedule
+// TimeoutSeconds returns the value of the "timeout_seconds" field in the mutation.
+func (m *BackupHookMutation) TimeoutSeconds() (r int, exists bool) {
+	v := m.timeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BackupScheduleID returns the "backup_schedule" edge ID in the mutation.
-func (m *BackupProfileMutation) BackupScheduleID() (id int, exists bool) {
-	if m.backup_schedule != nil {
-		return *m.backup_schedule, true
+// OldTimeoutSeconds returns the old "timeout_seconds" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldTimeoutSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeoutSeconds is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeoutSeconds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeoutSeconds: %w", err)
+	}
+	return oldValue.TimeoutSeconds, nil
 }
 
-// BackupScheduleIDs returns the "backup_schedule" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BackupScheduleID instead. It exists only for internal usage by the builders.
-func (m *BackupProfileMutation) BackupScheduleIDs() (ids []int) {
-	if id := m.backup_schedule; id != nil {
-		ids = append(ids, *id)
+// AddTimeoutSeconds adds i to the "timeout_seconds" field.
+func (m *BackupHookMutation) AddTimeoutSeconds(i int) {
+	if m.addtimeout_seconds != nil {
+		*m.addtimeout_seconds += i
+	} else {
+		m.addtimeout_seconds = &i
 	}
-	return
 }
 
-// ResetBackupSchedule resets all changes to the "backup_schedule" edge.
-func (m *BackupProfileMutation) ResetBackupSchedule() {
-	m.backup_schedule = nil
-	m.clearedbackup_schedule = false
+// AddedTimeoutSeconds returns the value that was added to the "timeout_seconds" field in this mutation.
+func (m *BackupHookMutation) AddedTimeoutSeconds() (r int, exists bool) {
+	v := m.addtimeout_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetPruningRuleID sets the "pruning_rule" edge to the PruningRule entity by id.
-func (m *BackupProfileMutation) SetPruningRuleID(id int) {
-	m.pruning_rule = &id
+// ResetTimeoutSeconds resets all changes to the "timeout_seconds" field.
+func (m *BackupHookMutation) ResetTimeoutSeconds() {
+	m.timeout_seconds = nil
+	m.addtimeout_seconds = nil
 }
 
-// ClearPruningRule clears the "pruning_rule" edge to the PruningRule entity.
-func (m *BackupProfileMutation) ClearPruningRule() {
-	m.clearedpruning_rule = true
+// SetAbortOnFailure sets the "abort_on_failure" field.
+func (m *BackupHookMutation) SetAbortOnFailure(b bool) {
+	m.abort_on_failure = &b
 }
 
-// PruningRuleCleared reports if the "pruning_rule" edge to the PruningRule entity was cleared.
-func (m *BackupProfileMutation) PruningRuleCleared() bool {
-	return m.clearedpruning_rule
+// AbortOnFailure returns the value of the "abort_on_failure" field in the mutation.
+func (m *BackupHookMutation) AbortOnFailure() (r bool, exists bool) {
+	v := m.abort_on_failure
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PruningRuleID returns the "pruning_rule" edge ID in the mutation.
-func (m *BackupProfileMutation) PruningRuleID() (id int, exists bool) {
-	if m.pruning_rule != nil {
-		return *m.pruning_rule, true
+// OldAbortOnFailure returns the old "abort_on_failure" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldAbortOnFailure(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAbortOnFailure is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAbortOnFailure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAbortOnFailure: %w", err)
+	}
+	return oldValue.AbortOnFailure, nil
 }
 
-// PruningRuleIDs returns the "pruning_rule" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PruningRuleID instead. It exists only for internal usage by the builders.
-func (m *BackupProfileMutation) PruningRuleIDs() (ids []int) {
-	if id := m.pruning_rule; id != nil {
-		ids = append(ids, *id)
+// ResetAbortOnFailure resets all changes to the "abort_on_failure" field.
+func (m *BackupHookMutation) ResetAbortOnFailure() {
+	m.abort_on_failure = nil
+}
+
+// SetLastRunAt sets the "last_run_at" field.
+func (m *BackupHookMutation) SetLastRunAt(t time.Time) {
+	m.last_run_at = &t
+}
+
+// LastRunAt returns the value of the "last_run_at" field in the mutation.
+func (m *BackupHookMutation) LastRunAt() (r time.Time, exists bool) {
+	v := m.last_run_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPruningRule resets all changes to the "pruning_rule" edge.
-func (m *BackupProfileMutation) ResetPruningRule() {
-	m.pruning_rule = nil
-	m.clearedpruning_rule = false
+// OldLastRunAt returns the old "last_run_at" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldLastRunAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunAt: %w", err)
+	}
+	return oldValue.LastRunAt, nil
 }
 
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
-func (m *BackupProfileMutation) AddNotificationIDs(ids ...int) {
-	if m.notifications == nil {
-		m.notifications = make(map[int]struct{})
+// ClearLastRunAt clears the value of the "last_run_at" field.
+func (m *BackupHookMutation) ClearLastRunAt() {
+	m.last_run_at = nil
+	m.clearedFields[backuphook.FieldLastRunAt] = struct{}{}
+}
+
+// LastRunAtCleared returns if the "last_run_at" field was cleared in this mutation.
+func (m *BackupHookMutation) LastRunAtCleared() bool {
+	_, ok := m.clearedFields[backuphook.FieldLastRunAt]
+	return ok
+}
+
+// ResetLastRunAt resets all changes to the "last_run_at" field.
+func (m *BackupHookMutation) ResetLastRunAt() {
+	m.last_run_at = nil
+	delete(m.clearedFields, backuphook.FieldLastRunAt)
+}
+
+// SetLastExitCode sets the "last_exit_code" field.
+func (m *BackupHookMutation) SetLastExitCode(i int) {
+	m.last_exit_code = &i
+	m.addlast_exit_code = nil
+}
+
+// LastExitCode returns the value of the "last_exit_code" field in the mutation.
+func (m *BackupHookMutation) LastExitCode() (r int, exists bool) {
+	v := m.last_exit_code
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.notifications[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastExitCode returns the old "last_exit_code" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldLastExitCode(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastExitCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastExitCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastExitCode: %w", err)
 	}
+	return oldValue.LastExitCode, nil
 }
 
-// ClearNotifications clears the "notifications" edge to the Notification entity.
-func (m *BackupProfileMutation) ClearNotifications() {
-	m.clearednotifications = true
+// AddLastExitCode adds i to the "last_exit_code" field.
+func (m *BackupHookMutation) AddLastExitCode(i int) {
+	if m.addlast_exit_code != nil {
+		*m.addlast_exit_code += i
+	} else {
+		m.addlast_exit_code = &i
+	}
 }
 
-// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
-func (m *BackupProfileMutation) NotificationsCleared() bool {
-	return m.clearednotifications
+// AddedLastExitCode returns the value that was added to the "last_exit_code" field in this mutation.
+func (m *BackupHookMutation) AddedLastExitCode() (r int, exists bool) {
+	v := m.addlast_exit_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
-func (m *BackupProfileMutation) RemoveNotificationIDs(ids ...int) {
-	if m.removednotifications == nil {
-		m.removednotifications = make(map[int]struct{})
+// ClearLastExitCode clears the value of the "last_exit_code" field.
+func (m *BackupHookMutation) ClearLastExitCode() {
+	m.last_exit_code = nil
+	m.addlast_exit_code = nil
+	m.clearedFields[backuphook.FieldLastExitCode] = struct{}{}
+}
+
+// LastExitCodeCleared returns if the "last_exit_code" field was cleared in this mutation.
+func (m *BackupHookMutation) LastExitCodeCleared() bool {
+	_, ok := m.clearedFields[backuphook.FieldLastExitCode]
+	return ok
+}
+
+// ResetLastExitCode resets all changes to the "last_exit_code" field.
+func (m *BackupHookMutation) ResetLastExitCode() {
+	m.last_exit_code = nil
+	m.addlast_exit_code = nil
+	delete(m.clearedFields, backuphook.FieldLastExitCode)
+}
+
+// SetLastOutput sets the "last_output" field.
+func (m *BackupHookMutation) SetLastOutput(s string) {
+	m.last_output = &s
+}
+
+// LastOutput returns the value of the "last_output" field in the mutation.
+func (m *BackupHookMutation) LastOutput() (r string, exists bool) {
+	v := m.last_output
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.notifications, ids[i])
-		m.removednotifications[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldLastOutput returns the old "last_output" field's value of the BackupHook entity.
+// If the BackupHook object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupHookMutation) OldLastOutput(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastOutput is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastOutput requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastOutput: %w", err)
 	}
+	return oldValue.LastOutput, nil
 }
 
-// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
-func (m *BackupProfileMutation) RemovedNotificationsIDs() (ids []int) {
-	for id := range m.removednotifications {
-		ids = append(ids, id)
+// ClearLastOutput clears the value of the "last_output" field.
+func (m *BackupHookMutation) ClearLastOutput() {
+	m.last_output = nil
+	m.clearedFields[backuphook.FieldLastOutput] = struct{}{}
+}
+
+// LastOutputCleared returns if the "last_output" field was cleared in this mutation.
+func (m *BackupHookMutation) LastOutputCleared() bool {
+	_, ok := m.clearedFields[backuphook.FieldLastOutput]
+	return ok
+}
+
+// ResetLastOutput resets all changes to the "last_output" field.
+func (m *BackupHookMutation) ResetLastOutput() {
+	m.last_output = nil
+	delete(m.clearedFields, backuphook.FieldLastOutput)
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
+func (m *BackupHookMutation) SetBackupProfileID(id int) {
+	m.backup_profile = &id
+}
+
+// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
+func (m *BackupHookMutation) ClearBackupProfile() {
+	m.clearedbackup_profile = true
+}
+
+// BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
+func (m *BackupHookMutation) BackupProfileCleared() bool {
+	return m.clearedbackup_profile
+}
+
+// BackupProfileID returns the "backup_profile" edge ID in the mutation.
+func (m *BackupHookMutation) BackupProfileID() (id int, exists bool) {
+	if m.backup_profile != nil {
+		return *m.backup_profile, true
 	}
 	return
 }
 
-// NotificationsIDs returns the "notifications" edge IDs in the mutation.
-func (m *BackupProfileMutation) NotificationsIDs() (ids []int) {
-	for id := range m.notifications {
-		ids = append(ids, id)
+// BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackupProfileID instead. It exists only for internal usage by the builders.
+func (m *BackupHookMutation) BackupProfileIDs() (ids []int) {
+	if id := m.backup_profile; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetNotifications resets all changes to the "notifications" edge.
-func (m *BackupProfileMutation) ResetNotifications() {
-	m.notifications = nil
-	m.clearednotifications = false
-	m.removednotifications = nil
+// ResetBackupProfile resets all changes to the "backup_profile" edge.
+func (m *BackupHookMutation) ResetBackupProfile() {
+	m.backup_profile = nil
+	m.clearedbackup_profile = false
 }
 
-// Where appends a list predicates to the BackupProfileMutation builder.
-func (m *BackupProfileMutation) Where(ps ...predicate.BackupProfile) {
+// Where appends a list predicates to the BackupHookMutation builder.
+func (m *BackupHookMutation) Where(ps ...predicate.BackupHook) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the BackupProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupHookMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackupProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BackupProfile, len(ps))
+func (m *BackupHookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupHook, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3236,63 +4268,57 @@ func (m *BackupProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *BackupProfileMutation) Op() Op {
+func (m *BackupHookMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *BackupProfileMutation) SetOp(op Op) {
+func (m *BackupHookMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (BackupProfile).
-func (m *BackupProfileMutation) Type() string {
+// Type returns the node type of this mutation (BackupHook).
+func (m *BackupHookMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *BackupProfileMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+func (m *BackupHookMutation) Fields() []string {
+	fields := make([]string, 0, 11)
 	if m.created_at != nil {
-		fields = append(fields, backupprofile.FieldCreatedAt)
+		fields = append(fields, backuphook.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, backupprofile.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, backupprofile.FieldName)
-	}
-	if m.prefix != nil {
-		fields = append(fields, backupprofile.FieldPrefix)
+		fields = append(fields, backuphook.FieldUpdatedAt)
 	}
-	if m.backup_paths != nil {
-		fields = append(fields, backupprofile.FieldBackupPaths)
+	if m._type != nil {
+		fields = append(fields, backuphook.FieldType)
 	}
-	if m.exclude_paths != nil {
-		fields = append(fields, backupprofile.FieldExcludePaths)
+	if m.run_on != nil {
+		fields = append(fields, backuphook.FieldRunOn)
 	}
-	if m.exclude_caches != nil {
-		fields = append(fields, backupprofile.FieldExcludeCaches)
+	if m.command != nil {
+		fields = append(fields, backuphook.FieldCommand)
 	}
-	if m.icon != nil {
-		fields = append(fields, backupprofile.FieldIcon)
+	if m.position != nil {
+		fields = append(fields, backuphook.FieldPosition)
 	}
-	if m.compression_mode != nil {
-		fields = append(fields, backupprofile.FieldCompressionMode)
+	if m.timeout_seconds != nil {
+		fields = append(fields, backuphook.FieldTimeoutSeconds)
 	}
-	if m.compression_level != nil {
-		fields = append(fields, backupprofile.FieldCompressionLevel)
+	if m.abort_on_failure != nil {
+		fields = append(fields, backuphook.FieldAbortOnFailure)
 	}
-	if m.data_section_collapsed != nil {
-		fields = append(fields, backupprofile.FieldDataSectionCollapsed)
+	if m.last_run_at != nil {
+		fields = append(fields, backuphook.FieldLastRunAt)
 	}
-	if m.schedule_section_collapsed != nil {
-		fields = append(fields, backupprofile.FieldScheduleSectionCollapsed)
+	if m.last_exit_code != nil {
+		fields = append(fields, backuphook.FieldLastExitCode)
 	}
-	if m.advanced_section_collapsed != nil {
-		fields = append(fields, backupprofile.FieldAdvancedSectionCollapsed)
+	if m.last_output != nil {
+		fields = append(fields, backuphook.FieldLastOutput)
 	}
 	return fields
 }
@@ -3300,34 +4326,30 @@ func (m *BackupProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *BackupProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupHookMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case backupprofile.FieldCreatedAt:
+	case backuphook.FieldCreatedAt:
 		return m.CreatedAt()
-	case backupprofile.FieldUpdatedAt:
+	case backuphook.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case backupprofile.FieldName:
-		return m.Name()
-	case backupprofile.FieldPrefix:
-		return m.Prefix()
-	case backupprofile.FieldBackupPaths:
-		return m.BackupPaths()
-	case backupprofile.FieldExcludePaths:
-		return m.ExcludePaths()
-	case backupprofile.FieldExcludeCaches:
-		return m.ExcludeCaches()
-	case backupprofile.FieldIcon:
-		return m.Icon()
-	case backupprofile.FieldCompressionMode:
-		return m.CompressionMode()
-	case backupprofile.FieldCompressionLevel:
-		return m.CompressionLevel()
-	case backupprofile.FieldDataSectionCollapsed:
-		return m.DataSectionCollapsed()
-	case backupprofile.FieldScheduleSectionCollapsed:
-		return m.ScheduleSectionCollapsed()
-	case backupprofile.FieldAdvancedSectionCollapsed:
-		return m.AdvancedSectionCollapsed()
+	case backuphook.FieldType:
+		return m.GetType()
+	case backuphook.FieldRunOn:
+		return m.RunOn()
+	case backuphook.FieldCommand:
+		return m.Command()
+	case backuphook.FieldPosition:
+		return m.Position()
+	case backuphook.FieldTimeoutSeconds:
+		return m.TimeoutSeconds()
+	case backuphook.FieldAbortOnFailure:
+		return m.AbortOnFailure()
+	case backuphook.FieldLastRunAt:
+		return m.LastRunAt()
+	case backuphook.FieldLastExitCode:
+		return m.LastExitCode()
+	case backuphook.FieldLastOutput:
+		return m.LastOutput()
 	}
 	return nil, false
 }
@@ -3335,144 +4357,132 @@ func (m *BackupProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *BackupProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupHookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case backupprofile.FieldCreatedAt:
+	case backuphook.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case backupprofile.FieldUpdatedAt:
+	case backuphook.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case backupprofile.FieldName:
-		return m.OldName(ctx)
-	case backupprofile.FieldPrefix:
-		return m.OldPrefix(ctx)
-	case backupprofile.FieldBackupPaths:
-		return m.OldBackupPaths(ctx)
-	case backupprofile.FieldExcludePaths:
-		return m.OldExcludePaths(ctx)
-	case backupprofile.FieldExcludeCaches:
-		return m.OldExcludeCaches(ctx)
-	case backupprofile.FieldIcon:
-		return m.OldIcon(ctx)
-	case backupprofile.FieldCompressionMode:
-		return m.OldCompressionMode(ctx)
-	case backupprofile.FieldCompressionLevel:
-		return m.OldCompressionLevel(ctx)
-	case backupprofile.FieldDataSectionCollapsed:
-		return m.OldDataSectionCollapsed(ctx)
-	case backupprofile.FieldScheduleSectionCollapsed:
-		return m.OldScheduleSectionCollapsed(ctx)
-	case backupprofile.FieldAdvancedSectionCollapsed:
-		return m.OldAdvancedSectionCollapsed(ctx)
-	}
-	return nil, fmt.Errorf("unknown BackupProfile field %s", name)
+	case backuphook.FieldType:
+		return m.OldType(ctx)
+	case backuphook.FieldRunOn:
+		return m.OldRunOn(ctx)
+	case backuphook.FieldCommand:
+		return m.OldCommand(ctx)
+	case backuphook.FieldPosition:
+		return m.OldPosition(ctx)
+	case backuphook.FieldTimeoutSeconds:
+		return m.OldTimeoutSeconds(ctx)
+	case backuphook.FieldAbortOnFailure:
+		return m.OldAbortOnFailure(ctx)
+	case backuphook.FieldLastRunAt:
+		return m.OldLastRunAt(ctx)
+	case backuphook.FieldLastExitCode:
+		return m.OldLastExitCode(ctx)
+	case backuphook.FieldLastOutput:
+		return m.OldLastOutput(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupHook field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupProfileMutation) SetField(name string, value ent.Value) error {
+func (m *BackupHookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case backupprofile.FieldCreatedAt:
+	case backuphook.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case backupprofile.FieldUpdatedAt:
+	case backuphook.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case backupprofile.FieldName:
-		v, ok := value.(string)
+	case backuphook.FieldType:
+		v, ok := value.(backuphook.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetType(v)
 		return nil
-	case backupprofile.FieldPrefix:
-		v, ok := value.(string)
+	case backuphook.FieldRunOn:
+		v, ok := value.(backuphook.RunOn)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrefix(v)
+		m.SetRunOn(v)
 		return nil
-	case backupprofile.FieldBackupPaths:
-		v, ok := value.([]string)
+	case backuphook.FieldCommand:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBackupPaths(v)
+		m.SetCommand(v)
 		return nil
-	case backupprofile.FieldExcludePaths:
-		v, ok := value.([]string)
+	case backuphook.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExcludePaths(v)
+		m.SetPosition(v)
 		return nil
-	case backupprofile.FieldExcludeCaches:
-		v, ok := value.(bool)
+	case backuphook.FieldTimeoutSeconds:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExcludeCaches(v)
+		m.SetTimeoutSeconds(v)
 		return nil
-	case backupprofile.FieldIcon:
-		v, ok := value.(backupprofile.Icon)
+	case backuphook.FieldAbortOnFailure:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIcon(v)
+		m.SetAbortOnFailure(v)
 		return nil
-	case backupprofile.FieldCompressionMode:
-		v, ok := value.(backupprofile.CompressionMode)
+	case backuphook.FieldLastRunAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompressionMode(v)
+		m.SetLastRunAt(v)
 		return nil
-	case backupprofile.FieldCompressionLevel:
+	case backuphook.FieldLastExitCode:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCompressionLevel(v)
-		return nil
-	case backupprofile.FieldDataSectionCollapsed:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDataSectionCollapsed(v)
-		return nil
-	case backupprofile.FieldScheduleSectionCollapsed:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetScheduleSectionCollapsed(v)
+		m.SetLastExitCode(v)
 		return nil
-	case backupprofile.FieldAdvancedSectionCollapsed:
-		v, ok := value.(bool)
+	case backuphook.FieldLastOutput:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAdvancedSectionCollapsed(v)
+		m.SetLastOutput(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile field %s", name)
+	return fmt.Errorf("unknown BackupHook field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BackupProfileMutation) AddedFields() []string {
+func (m *BackupHookMutation) AddedFields() []string {
 	var fields []string
-	if m.addcompression_level != nil {
-		fields = append(fields, backupprofile.FieldCompressionLevel)
+	if m.addposition != nil {
+		fields = append(fields, backuphook.FieldPosition)
+	}
+	if m.addtimeout_seconds != nil {
+		fields = append(fields, backuphook.FieldTimeoutSeconds)
+	}
+	if m.addlast_exit_code != nil {
+		fields = append(fields, backuphook.FieldLastExitCode)
 	}
 	return fields
 }
@@ -3480,10 +4490,14 @@ func (m *BackupProfileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BackupProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupHookMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case backupprofile.FieldCompressionLevel:
-		return m.AddedCompressionLevel()
+	case backuphook.FieldPosition:
+		return m.AddedPosition()
+	case backuphook.FieldTimeoutSeconds:
+		return m.AddedTimeoutSeconds()
+	case backuphook.FieldLastExitCode:
+		return m.AddedLastExitCode()
 	}
 	return nil, false
 }
@@ -3491,311 +4505,259 @@ func (m *BackupProfileMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BackupProfileMutation) AddField(name string, value ent.Value) error {
+func (m *BackupHookMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case backupprofile.FieldCompressionLevel:
+	case backuphook.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCompressionLevel(v)
+		m.AddPosition(v)
+		return nil
+	case backuphook.FieldTimeoutSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeoutSeconds(v)
+		return nil
+	case backuphook.FieldLastExitCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastExitCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile numeric field %s", name)
+	return fmt.Errorf("unknown BackupHook numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BackupProfileMutation) ClearedFields() []string {
+func (m *BackupHookMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(backupprofile.FieldExcludePaths) {
-		fields = append(fields, backupprofile.FieldExcludePaths)
+	if m.FieldCleared(backuphook.FieldLastRunAt) {
+		fields = append(fields, backuphook.FieldLastRunAt)
 	}
-	if m.FieldCleared(backupprofile.FieldCompressionLevel) {
-		fields = append(fields, backupprofile.FieldCompressionLevel)
+	if m.FieldCleared(backuphook.FieldLastExitCode) {
+		fields = append(fields, backuphook.FieldLastExitCode)
+	}
+	if m.FieldCleared(backuphook.FieldLastOutput) {
+		fields = append(fields, backuphook.FieldLastOutput)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BackupProfileMutation) FieldCleared(name string) bool {
+func (m *BackupHookMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BackupProfileMutation) ClearField(name string) error {
+func (m *BackupHookMutation) ClearField(name string) error {
 	switch name {
-	case backupprofile.FieldExcludePaths:
-		m.ClearExcludePaths()
+	case backuphook.FieldLastRunAt:
+		m.ClearLastRunAt()
 		return nil
-	case backupprofile.FieldCompressionLevel:
-		m.ClearCompressionLevel()
+	case backuphook.FieldLastExitCode:
+		m.ClearLastExitCode()
+		return nil
+	case backuphook.FieldLastOutput:
+		m.ClearLastOutput()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile nullable field %s", name)
+	return fmt.Errorf("unknown BackupHook nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BackupProfileMutation) ResetField(name string) error {
+func (m *BackupHookMutation) ResetField(name string) error {
 	switch name {
-	case backupprofile.FieldCreatedAt:
+	case backuphook.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case backupprofile.FieldUpdatedAt:
+	case backuphook.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case backupprofile.FieldName:
-		m.ResetName()
-		return nil
-	case backupprofile.FieldPrefix:
-		m.ResetPrefix()
-		return nil
-	case backupprofile.FieldBackupPaths:
-		m.ResetBackupPaths()
+	case backuphook.FieldType:
+		m.ResetType()
 		return nil
-	case backupprofile.FieldExcludePaths:
-		m.ResetExcludePaths()
+	case backuphook.FieldRunOn:
+		m.ResetRunOn()
 		return nil
-	case backupprofile.FieldExcludeCaches:
-		m.ResetExcludeCaches()
+	case backuphook.FieldCommand:
+		m.ResetCommand()
 		return nil
-	case backupprofile.FieldIcon:
-		m.ResetIcon()
+	case backuphook.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case backupprofile.FieldCompressionMode:
-		m.ResetCompressionMode()
+	case backuphook.FieldTimeoutSeconds:
+		m.ResetTimeoutSeconds()
 		return nil
-	case backupprofile.FieldCompressionLevel:
-		m.ResetCompressionLevel()
+	case backuphook.FieldAbortOnFailure:
+		m.ResetAbortOnFailure()
 		return nil
-	case backupprofile.FieldDataSectionCollapsed:
-		m.ResetDataSectionCollapsed()
+	case backuphook.FieldLastRunAt:
+		m.ResetLastRunAt()
 		return nil
-	case backupprofile.FieldScheduleSectionCollapsed:
-		m.ResetScheduleSectionCollapsed()
+	case backuphook.FieldLastExitCode:
+		m.ResetLastExitCode()
 		return nil
-	case backupprofile.FieldAdvancedSectionCollapsed:
-		m.ResetAdvancedSectionCollapsed()
+	case backuphook.FieldLastOutput:
+		m.ResetLastOutput()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile field %s", name)
+	return fmt.Errorf("unknown BackupHook field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackupProfileMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.repositories != nil {
-		edges = append(edges, backupprofile.EdgeRepositories)
-	}
-	if m.archives != nil {
-		edges = append(edges, backupprofile.EdgeArchives)
-	}
-	if m.backup_schedule != nil {
-		edges = append(edges, backupprofile.EdgeBackupSchedule)
-	}
-	if m.pruning_rule != nil {
-		edges = append(edges, backupprofile.EdgePruningRule)
-	}
-	if m.notifications != nil {
-		edges = append(edges, backupprofile.EdgeNotifications)
+func (m *BackupHookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.backup_profile != nil {
+		edges = append(edges, backuphook.EdgeBackupProfile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BackupProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupHookMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case backupprofile.EdgeRepositories:
-		ids := make([]ent.Value, 0, len(m.repositories))
-		for id := range m.repositories {
-			ids = append(ids, id)
-		}
-		return ids
-	case backupprofile.EdgeArchives:
-		ids := make([]ent.Value, 0, len(m.archives))
-		for id := range m.archives {
-			ids = append(ids, id)
-		}
-		return ids
-	case backupprofile.EdgeBackupSchedule:
-		if id := m.backup_schedule; id != nil {
-			return []ent.Value{*id}
-		}
-	case backupprofile.EdgePruningRule:
-		if id := m.pruning_rule; id != nil {
+	case backuphook.EdgeBackupProfile:
+		if id := m.backup_profile; id != nil {
 			return []ent.Value{*id}
 		}
-	case backupprofile.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.notifications))
-		for id := range m.notifications {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackupProfileMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedrepositories != nil {
-		edges = append(edges, backupprofile.EdgeRepositories)
-	}
-	if m.removedarchives != nil {
-		edges = append(edges, backupprofile.EdgeArchives)
-	}
-	if m.removednotifications != nil {
-		edges = append(edges, backupprofile.EdgeNotifications)
-	}
+func (m *BackupHookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BackupProfileMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case backupprofile.EdgeRepositories:
-		ids := make([]ent.Value, 0, len(m.removedrepositories))
-		for id := range m.removedrepositories {
-			ids = append(ids, id)
-		}
-		return ids
-	case backupprofile.EdgeArchives:
-		ids := make([]ent.Value, 0, len(m.removedarchives))
-		for id := range m.removedarchives {
-			ids = append(ids, id)
-		}
-		return ids
-	case backupprofile.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.removednotifications))
-		for id := range m.removednotifications {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BackupHookMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackupProfileMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedrepositories {
-		edges = append(edges, backupprofile.EdgeRepositories)
-	}
-	if m.clearedarchives {
-		edges = append(edges, backupprofile.EdgeArchives)
-	}
-	if m.clearedbackup_schedule {
-		edges = append(edges, backupprofile.EdgeBackupSchedule)
-	}
-	if m.clearedpruning_rule {
-		edges = append(edges, backupprofile.EdgePruningRule)
-	}
-	if m.clearednotifications {
-		edges = append(edges, backupprofile.EdgeNotifications)
+func (m *BackupHookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbackup_profile {
+		edges = append(edges, backuphook.EdgeBackupProfile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BackupProfileMutation) EdgeCleared(name string) bool {
+func (m *BackupHookMutation) EdgeCleared(name string) bool {
 	switch name {
-	case backupprofile.EdgeRepositories:
-		return m.clearedrepositories
-	case backupprofile.EdgeArchives:
-		return m.clearedarchives
-	case backupprofile.EdgeBackupSchedule:
-		return m.clearedbackup_schedule
-	case backupprofile.EdgePruningRule:
-		return m.clearedpruning_rule
-	case backupprofile.EdgeNotifications:
-		return m.clearednotifications
+	case backuphook.EdgeBackupProfile:
+		return m.clearedbackup_profile
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BackupProfileMutation) ClearEdge(name string) error {
+func (m *BackupHookMutation) ClearEdge(name string) error {
 	switch name {
-	case backupprofile.EdgeBackupSchedule:
-		m.ClearBackupSchedule()
-		return nil
-	case backupprofile.EdgePruningRule:
-		m.ClearPruningRule()
+	case backuphook.EdgeBackupProfile:
+		m.ClearBackupProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile unique edge %s", name)
+	return fmt.Errorf("unknown BackupHook unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BackupProfileMutation) ResetEdge(name string) error {
+func (m *BackupHookMutation) ResetEdge(name string) error {
 	switch name {
-	case backupprofile.EdgeRepositories:
-		m.ResetRepositories()
-		return nil
-	case backupprofile.EdgeArchives:
-		m.ResetArchives()
-		return nil
-	case backupprofile.EdgeBackupSchedule:
-		m.ResetBackupSchedule()
-		return nil
-	case backupprofile.EdgePruningRule:
-		m.ResetPruningRule()
-		return nil
-	case backupprofile.EdgeNotifications:
-		m.ResetNotifications()
+	case backuphook.EdgeBackupProfile:
+		m.ResetBackupProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown BackupProfile edge %s", name)
+	return fmt.Errorf("unknown BackupHook edge %s", name)
 }
 
-// BackupScheduleMutation represents an operation that mutates the BackupSchedule nodes in the graph.
-type BackupScheduleMutation struct {
+// BackupProfileMutation represents an operation that mutates the BackupProfile nodes in the graph.
+type BackupProfileMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	mode                  *backupschedule.Mode
-	interval_minutes      *uint16
-	addinterval_minutes   *int16
-	daily_at              *time.Time
-	weekday               *backupschedule.Weekday
-	weekly_at             *time.Time
-	monthday              *uint8
-	addmonthday           *int8
-	monthly_at            *time.Time
-	next_run              *time.Time
-	last_run              *time.Time
-	last_run_status       *string
-	clearedFields         map[string]struct{}
-	backup_profile        *int
-	clearedbackup_profile bool
-	done                  bool
-	oldValue              func(context.Context) (*BackupSchedule, error)
-	predicates            []predicate.BackupSchedule
+	op                         Op
+	typ                        string
+	id                         *int
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	name                       *string
+	prefix                     *string
+	backup_paths               *[]string
+	appendbackup_paths         []string
+	exclude_paths              *[]string
+	appendexclude_paths        []string
+	patterns                   *[]string
+	appendpatterns             []string
+	exclude_caches             *bool
+	exclude_if_present         *[]string
+	appendexclude_if_present   []string
+	use_ignore_files           *bool
+	content_command            *string
+	content_stdin_name         *string
+	icon                       *backupprofile.Icon
+	compression_mode           *backupprofile.CompressionMode
+	compression_level          *int
+	addcompression_level       *int
+	one_file_system            *bool
+	numeric_ids                *bool
+	no_flags                   *bool
+	no_acls                    *bool
+	no_xattrs                  *bool
+	sparse                     *bool
+	read_special               *bool
+	checkpoint_interval        *int
+	addcheckpoint_interval     *int
+	data_section_collapsed     *bool
+	schedule_section_collapsed *bool
+	advanced_section_collapsed *bool
+	clearedFields              map[string]struct{}
+	repositories               map[int]struct{}
+	removedrepositories        map[int]struct{}
+	clearedrepositories        bool
+	archives                   map[int]struct{}
+	removedarchives            map[int]struct{}
+	clearedarchives            bool
+	backup_schedule            *int
+	clearedbackup_schedule     bool
+	pruning_rule               *int
+	clearedpruning_rule        bool
+	_hooks                     map[int]struct{}
+	removed_hooks              map[int]struct{}
+	cleared_hooks              bool
+	notifications              map[int]struct{}
+	removednotifications       map[int]struct{}
+	clearednotifications       bool
+	done                       bool
+	oldValue                   func(context.Context) (*BackupProfile, error)
+	predicates                 []predicate.BackupProfile
 }
 
-var _ ent.Mutation = (*BackupScheduleMutation)(nil)
+var _ ent.Mutation = (*BackupProfileMutation)(nil)
 
-// backupscheduleOption allows management of the mutation configuration using functional options.
-type backupscheduleOption func(*BackupScheduleMutation)
+// backupprofileOption allows management of the mutation configuration using functional options.
+type backupprofileOption func(*BackupProfileMutation)
 
-// newBackupScheduleMutation creates new mutation for the BackupSchedule entity.
-func newBackupScheduleMutation(c config, op Op, opts ...backupscheduleOption) *BackupScheduleMutation {
-	m := &BackupScheduleMutation{
+// newBackupProfileMutation creates new mutation for the BackupProfile entity.
+func newBackupProfileMutation(c config, op Op, opts ...backupprofileOption) *BackupProfileMutation {
+	m := &BackupProfileMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeBackupSchedule,
+		typ:           TypeBackupProfile,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3804,20 +4766,20 @@ func newBackupScheduleMutation(c config, op Op, opts ...backupscheduleOption) *B
 	return m
 }
 
-// withBackupScheduleID sets the ID field of the mutation.
-func withBackupScheduleID(id int) backupscheduleOption {
-	return func(m *BackupScheduleMutation) {
+// withBackupProfileID sets the ID field of the mutation.
+func withBackupProfileID(id int) backupprofileOption {
+	return func(m *BackupProfileMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *BackupSchedule
+			value *BackupProfile
 		)
-		m.oldValue = func(ctx context.Context) (*BackupSchedule, error) {
+		m.oldValue = func(ctx context.Context) (*BackupProfile, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().BackupSchedule.Get(ctx, id)
+					value, err = m.Client().BackupProfile.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3826,10 +4788,10 @@ func withBackupScheduleID(id int) backupscheduleOption {
 	}
 }
 
-// withBackupSchedule sets the old BackupSchedule of the mutation.
-func withBackupSchedule(node *BackupSchedule) backupscheduleOption {
-	return func(m *BackupScheduleMutation) {
-		m.oldValue = func(context.Context) (*BackupSchedule, error) {
+// withBackupProfile sets the old BackupProfile of the mutation.
+func withBackupProfile(node *BackupProfile) backupprofileOption {
+	return func(m *BackupProfileMutation) {
+		m.oldValue = func(context.Context) (*BackupProfile, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3838,7 +4800,7 @@ func withBackupSchedule(node *BackupSchedule) backupscheduleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BackupScheduleMutation) Client() *Client {
+func (m BackupProfileMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3846,7 +4808,7 @@ func (m BackupScheduleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m BackupScheduleMutation) Tx() (*Tx, error) {
+func (m BackupProfileMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3856,14 +4818,14 @@ func (m BackupScheduleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of BackupSchedule entities.
-func (m *BackupScheduleMutation) SetID(id int) {
+// operation is only accepted on creation of BackupProfile entities.
+func (m *BackupProfileMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *BackupScheduleMutation) ID() (id int, exists bool) {
+func (m *BackupProfileMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3874,7 +4836,7 @@ func (m *BackupScheduleMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *BackupScheduleMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BackupProfileMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3883,19 +4845,19 @@ func (m *BackupScheduleMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().BackupSchedule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupProfile.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *BackupScheduleMutation) SetCreatedAt(t time.Time) {
+func (m *BackupProfileMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BackupScheduleMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BackupProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3903,10 +4865,10 @@ func (m *BackupScheduleMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3921,17 +4883,17 @@ func (m *BackupScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BackupScheduleMutation) ResetCreatedAt() {
+func (m *BackupProfileMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *BackupScheduleMutation) SetUpdatedAt(t time.Time) {
+func (m *BackupProfileMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BackupScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BackupProfileMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3939,10 +4901,10 @@ func (m *BackupScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3957,1300 +4919,1347 @@ func (m *BackupScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time,
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BackupScheduleMutation) ResetUpdatedAt() {
+func (m *BackupProfileMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetMode sets the "mode" field.
-func (m *BackupScheduleMutation) SetMode(b backupschedule.Mode) {
-	m.mode = &b
+// SetName sets the "name" field.
+func (m *BackupProfileMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Mode returns the value of the "mode" field in the mutation.
-func (m *BackupScheduleMutation) Mode() (r backupschedule.Mode, exists bool) {
-	v := m.mode
+// Name returns the value of the "name" field in the mutation.
+func (m *BackupProfileMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMode returns the old "mode" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldMode(ctx context.Context) (v backupschedule.Mode, err error) {
+func (m *BackupProfileMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMode requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Mode, nil
+	return oldValue.Name, nil
 }
 
-// ResetMode resets all changes to the "mode" field.
-func (m *BackupScheduleMutation) ResetMode() {
-	m.mode = nil
+// ResetName resets all changes to the "name" field.
+func (m *BackupProfileMutation) ResetName() {
+	m.name = nil
 }
 
-// SetIntervalMinutes sets the "interval_minutes" field.
-func (m *BackupScheduleMutation) SetIntervalMinutes(u uint16) {
-	m.interval_minutes = &u
-	m.addinterval_minutes = nil
+// SetPrefix sets the "prefix" field.
+func (m *BackupProfileMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// IntervalMinutes returns the value of the "interval_minutes" field in the mutation.
-func (m *BackupScheduleMutation) IntervalMinutes() (r uint16, exists bool) {
-	v := m.interval_minutes
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *BackupProfileMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIntervalMinutes returns the old "interval_minutes" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldIntervalMinutes(ctx context.Context) (v uint16, err error) {
+func (m *BackupProfileMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIntervalMinutes is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIntervalMinutes requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIntervalMinutes: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.IntervalMinutes, nil
+	return oldValue.Prefix, nil
 }
 
-// AddIntervalMinutes adds u to the "interval_minutes" field.
-func (m *BackupScheduleMutation) AddIntervalMinutes(u int16) {
-	if m.addinterval_minutes != nil {
-		*m.addinterval_minutes += u
-	} else {
-		m.addinterval_minutes = &u
-	}
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *BackupProfileMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// AddedIntervalMinutes returns the value that was added to the "interval_minutes" field in this mutation.
-func (m *BackupScheduleMutation) AddedIntervalMinutes() (r int16, exists bool) {
-	v := m.addinterval_minutes
+// SetBackupPaths sets the "backup_paths" field.
+func (m *BackupProfileMutation) SetBackupPaths(s []string) {
+	m.backup_paths = &s
+	m.appendbackup_paths = nil
+}
+
+// BackupPaths returns the value of the "backup_paths" field in the mutation.
+func (m *BackupProfileMutation) BackupPaths() (r []string, exists bool) {
+	v := m.backup_paths
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetIntervalMinutes resets all changes to the "interval_minutes" field.
-func (m *BackupScheduleMutation) ResetIntervalMinutes() {
-	m.interval_minutes = nil
-	m.addinterval_minutes = nil
-}
-
-// SetDailyAt sets the "daily_at" field.
-func (m *BackupScheduleMutation) SetDailyAt(t time.Time) {
-	m.daily_at = &t
-}
-
-// DailyAt returns the value of the "daily_at" field in the mutation.
-func (m *BackupScheduleMutation) DailyAt() (r time.Time, exists bool) {
-	v := m.daily_at
+// OldBackupPaths returns the old "backup_paths" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldBackupPaths(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBackupPaths is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBackupPaths requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBackupPaths: %w", err)
+	}
+	return oldValue.BackupPaths, nil
+}
+
+// AppendBackupPaths adds s to the "backup_paths" field.
+func (m *BackupProfileMutation) AppendBackupPaths(s []string) {
+	m.appendbackup_paths = append(m.appendbackup_paths, s...)
+}
+
+// AppendedBackupPaths returns the list of values that were appended to the "backup_paths" field in this mutation.
+func (m *BackupProfileMutation) AppendedBackupPaths() ([]string, bool) {
+	if len(m.appendbackup_paths) == 0 {
+		return nil, false
+	}
+	return m.appendbackup_paths, true
+}
+
+// ResetBackupPaths resets all changes to the "backup_paths" field.
+func (m *BackupProfileMutation) ResetBackupPaths() {
+	m.backup_paths = nil
+	m.appendbackup_paths = nil
+}
+
+// SetExcludePaths sets the "exclude_paths" field.
+func (m *BackupProfileMutation) SetExcludePaths(s []string) {
+	m.exclude_paths = &s
+	m.appendexclude_paths = nil
+}
+
+// ExcludePaths returns the value of the "exclude_paths" field in the mutation.
+func (m *BackupProfileMutation) ExcludePaths() (r []string, exists bool) {
+	v := m.exclude_paths
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDailyAt returns the old "daily_at" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldExcludePaths returns the old "exclude_paths" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldDailyAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldExcludePaths(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDailyAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExcludePaths is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDailyAt requires an ID field in the mutation")
+		return v, errors.New("OldExcludePaths requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDailyAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExcludePaths: %w", err)
 	}
-	return oldValue.DailyAt, nil
+	return oldValue.ExcludePaths, nil
 }
 
-// ResetDailyAt resets all changes to the "daily_at" field.
-func (m *BackupScheduleMutation) ResetDailyAt() {
-	m.daily_at = nil
+// AppendExcludePaths adds s to the "exclude_paths" field.
+func (m *BackupProfileMutation) AppendExcludePaths(s []string) {
+	m.appendexclude_paths = append(m.appendexclude_paths, s...)
 }
 
-// SetWeekday sets the "weekday" field.
-func (m *BackupScheduleMutation) SetWeekday(b backupschedule.Weekday) {
-	m.weekday = &b
+// AppendedExcludePaths returns the list of values that were appended to the "exclude_paths" field in this mutation.
+func (m *BackupProfileMutation) AppendedExcludePaths() ([]string, bool) {
+	if len(m.appendexclude_paths) == 0 {
+		return nil, false
+	}
+	return m.appendexclude_paths, true
 }
 
-// Weekday returns the value of the "weekday" field in the mutation.
-func (m *BackupScheduleMutation) Weekday() (r backupschedule.Weekday, exists bool) {
-	v := m.weekday
+// ClearExcludePaths clears the value of the "exclude_paths" field.
+func (m *BackupProfileMutation) ClearExcludePaths() {
+	m.exclude_paths = nil
+	m.appendexclude_paths = nil
+	m.clearedFields[backupprofile.FieldExcludePaths] = struct{}{}
+}
+
+// ExcludePathsCleared returns if the "exclude_paths" field was cleared in this mutation.
+func (m *BackupProfileMutation) ExcludePathsCleared() bool {
+	_, ok := m.clearedFields[backupprofile.FieldExcludePaths]
+	return ok
+}
+
+// ResetExcludePaths resets all changes to the "exclude_paths" field.
+func (m *BackupProfileMutation) ResetExcludePaths() {
+	m.exclude_paths = nil
+	m.appendexclude_paths = nil
+	delete(m.clearedFields, backupprofile.FieldExcludePaths)
+}
+
+// SetPatterns sets the "patterns" field.
+func (m *BackupProfileMutation) SetPatterns(s []string) {
+	m.patterns = &s
+	m.appendpatterns = nil
+}
+
+// Patterns returns the value of the "patterns" field in the mutation.
+func (m *BackupProfileMutation) Patterns() (r []string, exists bool) {
+	v := m.patterns
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeekday returns the old "weekday" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldPatterns returns the old "patterns" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldWeekday(ctx context.Context) (v backupschedule.Weekday, err error) {
+func (m *BackupProfileMutation) OldPatterns(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeekday is only allowed on UpdateOne operations")
+		return v, errors.New("OldPatterns is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeekday requires an ID field in the mutation")
+		return v, errors.New("OldPatterns requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeekday: %w", err)
+		return v, fmt.Errorf("querying old value for OldPatterns: %w", err)
 	}
-	return oldValue.Weekday, nil
+	return oldValue.Patterns, nil
 }
 
-// ResetWeekday resets all changes to the "weekday" field.
-func (m *BackupScheduleMutation) ResetWeekday() {
-	m.weekday = nil
+// AppendPatterns adds s to the "patterns" field.
+func (m *BackupProfileMutation) AppendPatterns(s []string) {
+	m.appendpatterns = append(m.appendpatterns, s...)
 }
 
-// SetWeeklyAt sets the "weekly_at" field.
-func (m *BackupScheduleMutation) SetWeeklyAt(t time.Time) {
-	m.weekly_at = &t
+// AppendedPatterns returns the list of values that were appended to the "patterns" field in this mutation.
+func (m *BackupProfileMutation) AppendedPatterns() ([]string, bool) {
+	if len(m.appendpatterns) == 0 {
+		return nil, false
+	}
+	return m.appendpatterns, true
 }
 
-// WeeklyAt returns the value of the "weekly_at" field in the mutation.
-func (m *BackupScheduleMutation) WeeklyAt() (r time.Time, exists bool) {
-	v := m.weekly_at
+// ClearPatterns clears the value of the "patterns" field.
+func (m *BackupProfileMutation) ClearPatterns() {
+	m.patterns = nil
+	m.appendpatterns = nil
+	m.clearedFields[backupprofile.FieldPatterns] = struct{}{}
+}
+
+// PatternsCleared returns if the "patterns" field was cleared in this mutation.
+func (m *BackupProfileMutation) PatternsCleared() bool {
+	_, ok := m.clearedFields[backupprofile.FieldPatterns]
+	return ok
+}
+
+// ResetPatterns resets all changes to the "patterns" field.
+func (m *BackupProfileMutation) ResetPatterns() {
+	m.patterns = nil
+	m.appendpatterns = nil
+	delete(m.clearedFields, backupprofile.FieldPatterns)
+}
+
+// SetExcludeCaches sets the "exclude_caches" field.
+func (m *BackupProfileMutation) SetExcludeCaches(b bool) {
+	m.exclude_caches = &b
+}
+
+// ExcludeCaches returns the value of the "exclude_caches" field in the mutation.
+func (m *BackupProfileMutation) ExcludeCaches() (r bool, exists bool) {
+	v := m.exclude_caches
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWeeklyAt returns the old "weekly_at" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldExcludeCaches returns the old "exclude_caches" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldWeeklyAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldExcludeCaches(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWeeklyAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExcludeCaches is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWeeklyAt requires an ID field in the mutation")
+		return v, errors.New("OldExcludeCaches requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWeeklyAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExcludeCaches: %w", err)
 	}
-	return oldValue.WeeklyAt, nil
+	return oldValue.ExcludeCaches, nil
 }
 
-// ResetWeeklyAt resets all changes to the "weekly_at" field.
-func (m *BackupScheduleMutation) ResetWeeklyAt() {
-	m.weekly_at = nil
+// ResetExcludeCaches resets all changes to the "exclude_caches" field.
+func (m *BackupProfileMutation) ResetExcludeCaches() {
+	m.exclude_caches = nil
 }
 
-// SetMonthday sets the "monthday" field.
-func (m *BackupScheduleMutation) SetMonthday(u uint8) {
-	m.monthday = &u
-	m.addmonthday = nil
+// SetExcludeIfPresent sets the "exclude_if_present" field.
+func (m *BackupProfileMutation) SetExcludeIfPresent(s []string) {
+	m.exclude_if_present = &s
+	m.appendexclude_if_present = nil
 }
 
-// Monthday returns the value of the "monthday" field in the mutation.
-func (m *BackupScheduleMutation) Monthday() (r uint8, exists bool) {
-	v := m.monthday
+// ExcludeIfPresent returns the value of the "exclude_if_present" field in the mutation.
+func (m *BackupProfileMutation) ExcludeIfPresent() (r []string, exists bool) {
+	v := m.exclude_if_present
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMonthday returns the old "monthday" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldExcludeIfPresent returns the old "exclude_if_present" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldMonthday(ctx context.Context) (v uint8, err error) {
+func (m *BackupProfileMutation) OldExcludeIfPresent(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonthday is only allowed on UpdateOne operations")
+		return v, errors.New("OldExcludeIfPresent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonthday requires an ID field in the mutation")
+		return v, errors.New("OldExcludeIfPresent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonthday: %w", err)
+		return v, fmt.Errorf("querying old value for OldExcludeIfPresent: %w", err)
 	}
-	return oldValue.Monthday, nil
+	return oldValue.ExcludeIfPresent, nil
 }
 
-// AddMonthday adds u to the "monthday" field.
-func (m *BackupScheduleMutation) AddMonthday(u int8) {
-	if m.addmonthday != nil {
-		*m.addmonthday += u
-	} else {
-		m.addmonthday = &u
-	}
+// AppendExcludeIfPresent adds s to the "exclude_if_present" field.
+func (m *BackupProfileMutation) AppendExcludeIfPresent(s []string) {
+	m.appendexclude_if_present = append(m.appendexclude_if_present, s...)
 }
 
-// AddedMonthday returns the value that was added to the "monthday" field in this mutation.
-func (m *BackupScheduleMutation) AddedMonthday() (r int8, exists bool) {
-	v := m.addmonthday
-	if v == nil {
-		return
+// AppendedExcludeIfPresent returns the list of values that were appended to the "exclude_if_present" field in this mutation.
+func (m *BackupProfileMutation) AppendedExcludeIfPresent() ([]string, bool) {
+	if len(m.appendexclude_if_present) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendexclude_if_present, true
 }
 
-// ResetMonthday resets all changes to the "monthday" field.
-func (m *BackupScheduleMutation) ResetMonthday() {
-	m.monthday = nil
-	m.addmonthday = nil
+// ClearExcludeIfPresent clears the value of the "exclude_if_present" field.
+func (m *BackupProfileMutation) ClearExcludeIfPresent() {
+	m.exclude_if_present = nil
+	m.appendexclude_if_present = nil
+	m.clearedFields[backupprofile.FieldExcludeIfPresent] = struct{}{}
 }
 
-// SetMonthlyAt sets the "monthly_at" field.
-func (m *BackupScheduleMutation) SetMonthlyAt(t time.Time) {
-	m.monthly_at = &t
+// ExcludeIfPresentCleared returns if the "exclude_if_present" field was cleared in this mutation.
+func (m *BackupProfileMutation) ExcludeIfPresentCleared() bool {
+	_, ok := m.clearedFields[backupprofile.FieldExcludeIfPresent]
+	return ok
 }
 
-// MonthlyAt returns the value of the "monthly_at" field in the mutation.
-func (m *BackupScheduleMutation) MonthlyAt() (r time.Time, exists bool) {
-	v := m.monthly_at
+// ResetExcludeIfPresent resets all changes to the "exclude_if_present" field.
+func (m *BackupProfileMutation) ResetExcludeIfPresent() {
+	m.exclude_if_present = nil
+	m.appendexclude_if_present = nil
+	delete(m.clearedFields, backupprofile.FieldExcludeIfPresent)
+}
+
+// SetUseIgnoreFiles sets the "use_ignore_files" field.
+func (m *BackupProfileMutation) SetUseIgnoreFiles(b bool) {
+	m.use_ignore_files = &b
+}
+
+// UseIgnoreFiles returns the value of the "use_ignore_files" field in the mutation.
+func (m *BackupProfileMutation) UseIgnoreFiles() (r bool, exists bool) {
+	v := m.use_ignore_files
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMonthlyAt returns the old "monthly_at" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldUseIgnoreFiles returns the old "use_ignore_files" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldMonthlyAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldUseIgnoreFiles(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMonthlyAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUseIgnoreFiles is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMonthlyAt requires an ID field in the mutation")
+		return v, errors.New("OldUseIgnoreFiles requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMonthlyAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUseIgnoreFiles: %w", err)
 	}
-	return oldValue.MonthlyAt, nil
+	return oldValue.UseIgnoreFiles, nil
 }
 
-// ResetMonthlyAt resets all changes to the "monthly_at" field.
-func (m *BackupScheduleMutation) ResetMonthlyAt() {
-	m.monthly_at = nil
+// ResetUseIgnoreFiles resets all changes to the "use_ignore_files" field.
+func (m *BackupProfileMutation) ResetUseIgnoreFiles() {
+	m.use_ignore_files = nil
 }
 
-// SetNextRun sets the "next_run" field.
-func (m *BackupScheduleMutation) SetNextRun(t time.Time) {
-	m.next_run = &t
+// SetContentCommand sets the "content_command" field.
+func (m *BackupProfileMutation) SetContentCommand(s string) {
+	m.content_command = &s
 }
 
-// NextRun returns the value of the "next_run" field in the mutation.
-func (m *BackupScheduleMutation) NextRun() (r time.Time, exists bool) {
-	v := m.next_run
+// ContentCommand returns the value of the "content_command" field in the mutation.
+func (m *BackupProfileMutation) ContentCommand() (r string, exists bool) {
+	v := m.content_command
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNextRun returns the old "next_run" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldContentCommand returns the old "content_command" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldNextRun(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldContentCommand(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRun is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentCommand is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRun requires an ID field in the mutation")
+		return v, errors.New("OldContentCommand requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRun: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentCommand: %w", err)
 	}
-	return oldValue.NextRun, nil
-}
-
-// ClearNextRun clears the value of the "next_run" field.
-func (m *BackupScheduleMutation) ClearNextRun() {
-	m.next_run = nil
-	m.clearedFields[backupschedule.FieldNextRun] = struct{}{}
-}
-
-// NextRunCleared returns if the "next_run" field was cleared in this mutation.
-func (m *BackupScheduleMutation) NextRunCleared() bool {
-	_, ok := m.clearedFields[backupschedule.FieldNextRun]
-	return ok
+	return oldValue.ContentCommand, nil
 }
 
-// ResetNextRun resets all changes to the "next_run" field.
-func (m *BackupScheduleMutation) ResetNextRun() {
-	m.next_run = nil
-	delete(m.clearedFields, backupschedule.FieldNextRun)
+// ResetContentCommand resets all changes to the "content_command" field.
+func (m *BackupProfileMutation) ResetContentCommand() {
+	m.content_command = nil
 }
 
-// SetLastRun sets the "last_run" field.
-func (m *BackupScheduleMutation) SetLastRun(t time.Time) {
-	m.last_run = &t
+// SetContentStdinName sets the "content_stdin_name" field.
+func (m *BackupProfileMutation) SetContentStdinName(s string) {
+	m.content_stdin_name = &s
 }
 
-// LastRun returns the value of the "last_run" field in the mutation.
-func (m *BackupScheduleMutation) LastRun() (r time.Time, exists bool) {
-	v := m.last_run
+// ContentStdinName returns the value of the "content_stdin_name" field in the mutation.
+func (m *BackupProfileMutation) ContentStdinName() (r string, exists bool) {
+	v := m.content_stdin_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastRun returns the old "last_run" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldContentStdinName returns the old "content_stdin_name" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldLastRun(ctx context.Context) (v *time.Time, err error) {
+func (m *BackupProfileMutation) OldContentStdinName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRun is only allowed on UpdateOne operations")
+		return v, errors.New("OldContentStdinName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRun requires an ID field in the mutation")
+		return v, errors.New("OldContentStdinName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRun: %w", err)
+		return v, fmt.Errorf("querying old value for OldContentStdinName: %w", err)
 	}
-	return oldValue.LastRun, nil
+	return oldValue.ContentStdinName, nil
 }
 
-// ClearLastRun clears the value of the "last_run" field.
-func (m *BackupScheduleMutation) ClearLastRun() {
-	m.last_run = nil
-	m.clearedFields[backupschedule.FieldLastRun] = struct{}{}
+// ResetContentStdinName resets all changes to the "content_stdin_name" field.
+func (m *BackupProfileMutation) ResetContentStdinName() {
+	m.content_stdin_name = nil
 }
 
-// LastRunCleared returns if the "last_run" field was cleared in this mutation.
-func (m *BackupScheduleMutation) LastRunCleared() bool {
-	_, ok := m.clearedFields[backupschedule.FieldLastRun]
-	return ok
-}
-
-// ResetLastRun resets all changes to the "last_run" field.
-func (m *BackupScheduleMutation) ResetLastRun() {
-	m.last_run = nil
-	delete(m.clearedFields, backupschedule.FieldLastRun)
-}
-
-// SetLastRunStatus sets the "last_run_status" field.
-func (m *BackupScheduleMutation) SetLastRunStatus(s string) {
-	m.last_run_status = &s
+// SetIcon sets the "icon" field.
+func (m *BackupProfileMutation) SetIcon(b backupprofile.Icon) {
+	m.icon = &b
 }
 
-// LastRunStatus returns the value of the "last_run_status" field in the mutation.
-func (m *BackupScheduleMutation) LastRunStatus() (r string, exists bool) {
-	v := m.last_run_status
+// Icon returns the value of the "icon" field in the mutation.
+func (m *BackupProfileMutation) Icon() (r backupprofile.Icon, exists bool) {
+	v := m.icon
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastRunStatus returns the old "last_run_status" field's value of the BackupSchedule entity.
-// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// OldIcon returns the old "icon" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BackupScheduleMutation) OldLastRunStatus(ctx context.Context) (v *string, err error) {
+func (m *BackupProfileMutation) OldIcon(ctx context.Context) (v backupprofile.Icon, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRunStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldIcon is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRunStatus requires an ID field in the mutation")
+		return v, errors.New("OldIcon requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRunStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldIcon: %w", err)
 	}
-	return oldValue.LastRunStatus, nil
+	return oldValue.Icon, nil
 }
 
-// ClearLastRunStatus clears the value of the "last_run_status" field.
-func (m *BackupScheduleMutation) ClearLastRunStatus() {
-	m.last_run_status = nil
-	m.clearedFields[backupschedule.FieldLastRunStatus] = struct{}{}
+// ResetIcon resets all changes to the "icon" field.
+func (m *BackupProfileMutation) ResetIcon() {
+	m.icon = nil
 }
 
-// LastRunStatusCleared returns if the "last_run_status" field was cleared in this mutation.
-func (m *BackupScheduleMutation) LastRunStatusCleared() bool {
-	_, ok := m.clearedFields[backupschedule.FieldLastRunStatus]
-	return ok
+// SetCompressionMode sets the "compression_mode" field.
+func (m *BackupProfileMutation) SetCompressionMode(bm backupprofile.CompressionMode) {
+	m.compression_mode = &bm
 }
 
-// ResetLastRunStatus resets all changes to the "last_run_status" field.
-func (m *BackupScheduleMutation) ResetLastRunStatus() {
-	m.last_run_status = nil
-	delete(m.clearedFields, backupschedule.FieldLastRunStatus)
+// CompressionMode returns the value of the "compression_mode" field in the mutation.
+func (m *BackupProfileMutation) CompressionMode() (r backupprofile.CompressionMode, exists bool) {
+	v := m.compression_mode
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
-func (m *BackupScheduleMutation) SetBackupProfileID(id int) {
-	m.backup_profile = &id
+// OldCompressionMode returns the old "compression_mode" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldCompressionMode(ctx context.Context) (v backupprofile.CompressionMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressionMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressionMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressionMode: %w", err)
+	}
+	return oldValue.CompressionMode, nil
 }
 
-// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
-func (m *BackupScheduleMutation) ClearBackupProfile() {
-	m.clearedbackup_profile = true
+// ResetCompressionMode resets all changes to the "compression_mode" field.
+func (m *BackupProfileMutation) ResetCompressionMode() {
+	m.compression_mode = nil
 }
 
-// BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
-func (m *BackupScheduleMutation) BackupProfileCleared() bool {
-	return m.clearedbackup_profile
+// SetCompressionLevel sets the "compression_level" field.
+func (m *BackupProfileMutation) SetCompressionLevel(i int) {
+	m.compression_level = &i
+	m.addcompression_level = nil
 }
 
-// BackupProfileID returns the "backup_profile" edge ID in the mutation.
-func (m *BackupScheduleMutation) BackupProfileID() (id int, exists bool) {
-	if m.backup_profile != nil {
-		return *m.backup_profile, true
+// CompressionLevel returns the value of the "compression_level" field in the mutation.
+func (m *BackupProfileMutation) CompressionLevel() (r int, exists bool) {
+	v := m.compression_level
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BackupProfileID instead. It exists only for internal usage by the builders.
-func (m *BackupScheduleMutation) BackupProfileIDs() (ids []int) {
-	if id := m.backup_profile; id != nil {
-		ids = append(ids, *id)
+// OldCompressionLevel returns the old "compression_level" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldCompressionLevel(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompressionLevel is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompressionLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompressionLevel: %w", err)
+	}
+	return oldValue.CompressionLevel, nil
 }
 
-// ResetBackupProfile resets all changes to the "backup_profile" edge.
-func (m *BackupScheduleMutation) ResetBackupProfile() {
-	m.backup_profile = nil
-	m.clearedbackup_profile = false
+// AddCompressionLevel adds i to the "compression_level" field.
+func (m *BackupProfileMutation) AddCompressionLevel(i int) {
+	if m.addcompression_level != nil {
+		*m.addcompression_level += i
+	} else {
+		m.addcompression_level = &i
+	}
 }
 
-// Where appends a list predicates to the BackupScheduleMutation builder.
-func (m *BackupScheduleMutation) Where(ps ...predicate.BackupSchedule) {
-	m.predicates = append(m.predicates, ps...)
+// AddedCompressionLevel returns the value that was added to the "compression_level" field in this mutation.
+func (m *BackupProfileMutation) AddedCompressionLevel() (r int, exists bool) {
+	v := m.addcompression_level
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the BackupScheduleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BackupScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.BackupSchedule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearCompressionLevel clears the value of the "compression_level" field.
+func (m *BackupProfileMutation) ClearCompressionLevel() {
+	m.compression_level = nil
+	m.addcompression_level = nil
+	m.clearedFields[backupprofile.FieldCompressionLevel] = struct{}{}
 }
 
-// Op returns the operation name.
-func (m *BackupScheduleMutation) Op() Op {
-	return m.op
+// CompressionLevelCleared returns if the "compression_level" field was cleared in this mutation.
+func (m *BackupProfileMutation) CompressionLevelCleared() bool {
+	_, ok := m.clearedFields[backupprofile.FieldCompressionLevel]
+	return ok
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BackupScheduleMutation) SetOp(op Op) {
-	m.op = op
+// ResetCompressionLevel resets all changes to the "compression_level" field.
+func (m *BackupProfileMutation) ResetCompressionLevel() {
+	m.compression_level = nil
+	m.addcompression_level = nil
+	delete(m.clearedFields, backupprofile.FieldCompressionLevel)
 }
 
-// Type returns the node type of this mutation (BackupSchedule).
-func (m *BackupScheduleMutation) Type() string {
-	return m.typ
+// SetOneFileSystem sets the "one_file_system" field.
+func (m *BackupProfileMutation) SetOneFileSystem(b bool) {
+	m.one_file_system = &b
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BackupScheduleMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.created_at != nil {
-		fields = append(fields, backupschedule.FieldCreatedAt)
+// OneFileSystem returns the value of the "one_file_system" field in the mutation.
+func (m *BackupProfileMutation) OneFileSystem() (r bool, exists bool) {
+	v := m.one_file_system
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, backupschedule.FieldUpdatedAt)
+	return *v, true
+}
+
+// OldOneFileSystem returns the old "one_file_system" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldOneFileSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOneFileSystem is only allowed on UpdateOne operations")
 	}
-	if m.mode != nil {
-		fields = append(fields, backupschedule.FieldMode)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOneFileSystem requires an ID field in the mutation")
 	}
-	if m.interval_minutes != nil {
-		fields = append(fields, backupschedule.FieldIntervalMinutes)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOneFileSystem: %w", err)
 	}
-	if m.daily_at != nil {
-		fields = append(fields, backupschedule.FieldDailyAt)
+	return oldValue.OneFileSystem, nil
+}
+
+// ResetOneFileSystem resets all changes to the "one_file_system" field.
+func (m *BackupProfileMutation) ResetOneFileSystem() {
+	m.one_file_system = nil
+}
+
+// SetNumericIds sets the "numeric_ids" field.
+func (m *BackupProfileMutation) SetNumericIds(b bool) {
+	m.numeric_ids = &b
+}
+
+// NumericIds returns the value of the "numeric_ids" field in the mutation.
+func (m *BackupProfileMutation) NumericIds() (r bool, exists bool) {
+	v := m.numeric_ids
+	if v == nil {
+		return
 	}
-	if m.weekday != nil {
-		fields = append(fields, backupschedule.FieldWeekday)
+	return *v, true
+}
+
+// OldNumericIds returns the old "numeric_ids" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldNumericIds(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumericIds is only allowed on UpdateOne operations")
 	}
-	if m.weekly_at != nil {
-		fields = append(fields, backupschedule.FieldWeeklyAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumericIds requires an ID field in the mutation")
 	}
-	if m.monthday != nil {
-		fields = append(fields, backupschedule.FieldMonthday)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumericIds: %w", err)
 	}
-	if m.monthly_at != nil {
-		fields = append(fields, backupschedule.FieldMonthlyAt)
+	return oldValue.NumericIds, nil
+}
+
+// ResetNumericIds resets all changes to the "numeric_ids" field.
+func (m *BackupProfileMutation) ResetNumericIds() {
+	m.numeric_ids = nil
+}
+
+// SetNoFlags sets the "no_flags" field.
+func (m *BackupProfileMutation) SetNoFlags(b bool) {
+	m.no_flags = &b
+}
+
+// NoFlags returns the value of the "no_flags" field in the mutation.
+func (m *BackupProfileMutation) NoFlags() (r bool, exists bool) {
+	v := m.no_flags
+	if v == nil {
+		return
 	}
-	if m.next_run != nil {
-		fields = append(fields, backupschedule.FieldNextRun)
+	return *v, true
+}
+
+// OldNoFlags returns the old "no_flags" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldNoFlags(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoFlags is only allowed on UpdateOne operations")
 	}
-	if m.last_run != nil {
-		fields = append(fields, backupschedule.FieldLastRun)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoFlags requires an ID field in the mutation")
 	}
-	if m.last_run_status != nil {
-		fields = append(fields, backupschedule.FieldLastRunStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoFlags: %w", err)
 	}
-	return fields
+	return oldValue.NoFlags, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BackupScheduleMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case backupschedule.FieldCreatedAt:
-		return m.CreatedAt()
-	case backupschedule.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case backupschedule.FieldMode:
-		return m.Mode()
-	case backupschedule.FieldIntervalMinutes:
-		return m.IntervalMinutes()
-	case backupschedule.FieldDailyAt:
-		return m.DailyAt()
-	case backupschedule.FieldWeekday:
-		return m.Weekday()
-	case backupschedule.FieldWeeklyAt:
-		return m.WeeklyAt()
-	case backupschedule.FieldMonthday:
-		return m.Monthday()
-	case backupschedule.FieldMonthlyAt:
-		return m.MonthlyAt()
-	case backupschedule.FieldNextRun:
-		return m.NextRun()
-	case backupschedule.FieldLastRun:
-		return m.LastRun()
-	case backupschedule.FieldLastRunStatus:
-		return m.LastRunStatus()
-	}
-	return nil, false
+// ResetNoFlags resets all changes to the "no_flags" field.
+func (m *BackupProfileMutation) ResetNoFlags() {
+	m.no_flags = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BackupScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case backupschedule.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case backupschedule.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case backupschedule.FieldMode:
-		return m.OldMode(ctx)
-	case backupschedule.FieldIntervalMinutes:
-		return m.OldIntervalMinutes(ctx)
-	case backupschedule.FieldDailyAt:
-		return m.OldDailyAt(ctx)
-	case backupschedule.FieldWeekday:
-		return m.OldWeekday(ctx)
-	case backupschedule.FieldWeeklyAt:
-		return m.OldWeeklyAt(ctx)
-	case backupschedule.FieldMonthday:
-		return m.OldMonthday(ctx)
-	case backupschedule.FieldMonthlyAt:
-		return m.OldMonthlyAt(ctx)
-	case backupschedule.FieldNextRun:
-		return m.OldNextRun(ctx)
-	case backupschedule.FieldLastRun:
-		return m.OldLastRun(ctx)
-	case backupschedule.FieldLastRunStatus:
-		return m.OldLastRunStatus(ctx)
-	}
-	return nil, fmt.Errorf("unknown BackupSchedule field %s", name)
+// SetNoAcls sets the "no_acls" field.
+func (m *BackupProfileMutation) SetNoAcls(b bool) {
+	m.no_acls = &b
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BackupScheduleMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case backupschedule.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case backupschedule.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case backupschedule.FieldMode:
-		v, ok := value.(backupschedule.Mode)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMode(v)
-		return nil
-	case backupschedule.FieldIntervalMinutes:
-		v, ok := value.(uint16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIntervalMinutes(v)
-		return nil
-	case backupschedule.FieldDailyAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDailyAt(v)
-		return nil
-	case backupschedule.FieldWeekday:
-		v, ok := value.(backupschedule.Weekday)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWeekday(v)
-		return nil
-	case backupschedule.FieldWeeklyAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWeeklyAt(v)
-		return nil
-	case backupschedule.FieldMonthday:
-		v, ok := value.(uint8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMonthday(v)
-		return nil
-	case backupschedule.FieldMonthlyAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMonthlyAt(v)
-		return nil
-	case backupschedule.FieldNextRun:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetNextRun(v)
-		return nil
-	case backupschedule.FieldLastRun:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastRun(v)
-		return nil
-	case backupschedule.FieldLastRunStatus:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastRunStatus(v)
-		return nil
+// NoAcls returns the value of the "no_acls" field in the mutation.
+func (m *BackupProfileMutation) NoAcls() (r bool, exists bool) {
+	v := m.no_acls
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown BackupSchedule field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BackupScheduleMutation) AddedFields() []string {
-	var fields []string
-	if m.addinterval_minutes != nil {
-		fields = append(fields, backupschedule.FieldIntervalMinutes)
+// OldNoAcls returns the old "no_acls" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldNoAcls(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoAcls is only allowed on UpdateOne operations")
 	}
-	if m.addmonthday != nil {
-		fields = append(fields, backupschedule.FieldMonthday)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoAcls requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoAcls: %w", err)
+	}
+	return oldValue.NoAcls, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BackupScheduleMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case backupschedule.FieldIntervalMinutes:
-		return m.AddedIntervalMinutes()
-	case backupschedule.FieldMonthday:
-		return m.AddedMonthday()
-	}
-	return nil, false
+// ResetNoAcls resets all changes to the "no_acls" field.
+func (m *BackupProfileMutation) ResetNoAcls() {
+	m.no_acls = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BackupScheduleMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case backupschedule.FieldIntervalMinutes:
-		v, ok := value.(int16)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIntervalMinutes(v)
-		return nil
-	case backupschedule.FieldMonthday:
-		v, ok := value.(int8)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMonthday(v)
-		return nil
+// SetNoXattrs sets the "no_xattrs" field.
+func (m *BackupProfileMutation) SetNoXattrs(b bool) {
+	m.no_xattrs = &b
+}
+
+// NoXattrs returns the value of the "no_xattrs" field in the mutation.
+func (m *BackupProfileMutation) NoXattrs() (r bool, exists bool) {
+	v := m.no_xattrs
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown BackupSchedule numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BackupScheduleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(backupschedule.FieldNextRun) {
-		fields = append(fields, backupschedule.FieldNextRun)
+// OldNoXattrs returns the old "no_xattrs" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldNoXattrs(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNoXattrs is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(backupschedule.FieldLastRun) {
-		fields = append(fields, backupschedule.FieldLastRun)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNoXattrs requires an ID field in the mutation")
 	}
-	if m.FieldCleared(backupschedule.FieldLastRunStatus) {
-		fields = append(fields, backupschedule.FieldLastRunStatus)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNoXattrs: %w", err)
 	}
-	return fields
+	return oldValue.NoXattrs, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BackupScheduleMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetNoXattrs resets all changes to the "no_xattrs" field.
+func (m *BackupProfileMutation) ResetNoXattrs() {
+	m.no_xattrs = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BackupScheduleMutation) ClearField(name string) error {
-	switch name {
-	case backupschedule.FieldNextRun:
-		m.ClearNextRun()
-		return nil
-	case backupschedule.FieldLastRun:
-		m.ClearLastRun()
-		return nil
-	case backupschedule.FieldLastRunStatus:
-		m.ClearLastRunStatus()
-		return nil
+// SetSparse sets the "sparse" field.
+func (m *BackupProfileMutation) SetSparse(b bool) {
+	m.sparse = &b
+}
+
+// Sparse returns the value of the "sparse" field in the mutation.
+func (m *BackupProfileMutation) Sparse() (r bool, exists bool) {
+	v := m.sparse
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown BackupSchedule nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BackupScheduleMutation) ResetField(name string) error {
-	switch name {
-	case backupschedule.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case backupschedule.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case backupschedule.FieldMode:
-		m.ResetMode()
-		return nil
-	case backupschedule.FieldIntervalMinutes:
-		m.ResetIntervalMinutes()
-		return nil
-	case backupschedule.FieldDailyAt:
-		m.ResetDailyAt()
-		return nil
-	case backupschedule.FieldWeekday:
-		m.ResetWeekday()
-		return nil
-	case backupschedule.FieldWeeklyAt:
-		m.ResetWeeklyAt()
-		return nil
-	case backupschedule.FieldMonthday:
-		m.ResetMonthday()
-		return nil
-	case backupschedule.FieldMonthlyAt:
-		m.ResetMonthlyAt()
-		return nil
-	case backupschedule.FieldNextRun:
-		m.ResetNextRun()
-		return nil
-	case backupschedule.FieldLastRun:
-		m.ResetLastRun()
-		return nil
-	case backupschedule.FieldLastRunStatus:
-		m.ResetLastRunStatus()
-		return nil
+// OldSparse returns the old "sparse" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldSparse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSparse is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown BackupSchedule field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BackupScheduleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.backup_profile != nil {
-		edges = append(edges, backupschedule.EdgeBackupProfile)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSparse requires an ID field in the mutation")
 	}
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BackupScheduleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case backupschedule.EdgeBackupProfile:
-		if id := m.backup_profile; id != nil {
-			return []ent.Value{*id}
-		}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSparse: %w", err)
 	}
-	return nil
+	return oldValue.Sparse, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BackupScheduleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetSparse resets all changes to the "sparse" field.
+func (m *BackupProfileMutation) ResetSparse() {
+	m.sparse = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BackupScheduleMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetReadSpecial sets the "read_special" field.
+func (m *BackupProfileMutation) SetReadSpecial(b bool) {
+	m.read_special = &b
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BackupScheduleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedbackup_profile {
-		edges = append(edges, backupschedule.EdgeBackupProfile)
+// ReadSpecial returns the value of the "read_special" field in the mutation.
+func (m *BackupProfileMutation) ReadSpecial() (r bool, exists bool) {
+	v := m.read_special
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BackupScheduleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case backupschedule.EdgeBackupProfile:
-		return m.clearedbackup_profile
+// OldReadSpecial returns the old "read_special" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldReadSpecial(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadSpecial is only allowed on UpdateOne operations")
 	}
-	return false
-}
-
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BackupScheduleMutation) ClearEdge(name string) error {
-	switch name {
-	case backupschedule.EdgeBackupProfile:
-		m.ClearBackupProfile()
-		return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadSpecial requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown BackupSchedule unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BackupScheduleMutation) ResetEdge(name string) error {
-	switch name {
-	case backupschedule.EdgeBackupProfile:
-		m.ResetBackupProfile()
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadSpecial: %w", err)
 	}
-	return fmt.Errorf("unknown BackupSchedule edge %s", name)
+	return oldValue.ReadSpecial, nil
 }
 
-// CloudRepositoryMutation represents an operation that mutates the CloudRepository nodes in the graph.
-type CloudRepositoryMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	cloud_id              *string
-	storage_used_bytes    *int64
-	addstorage_used_bytes *int64
-	location              *cloudrepository.Location
-	clearedFields         map[string]struct{}
-	repository            *int
-	clearedrepository     bool
-	done                  bool
-	oldValue              func(context.Context) (*CloudRepository, error)
-	predicates            []predicate.CloudRepository
+// ResetReadSpecial resets all changes to the "read_special" field.
+func (m *BackupProfileMutation) ResetReadSpecial() {
+	m.read_special = nil
 }
 
-var _ ent.Mutation = (*CloudRepositoryMutation)(nil)
-
-// cloudrepositoryOption allows management of the mutation configuration using functional options.
-type cloudrepositoryOption func(*CloudRepositoryMutation)
-
-// newCloudRepositoryMutation creates new mutation for the CloudRepository entity.
-func newCloudRepositoryMutation(c config, op Op, opts ...cloudrepositoryOption) *CloudRepositoryMutation {
-	m := &CloudRepositoryMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeCloudRepository,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetCheckpointInterval sets the "checkpoint_interval" field.
+func (m *BackupProfileMutation) SetCheckpointInterval(i int) {
+	m.checkpoint_interval = &i
+	m.addcheckpoint_interval = nil
 }
 
-// withCloudRepositoryID sets the ID field of the mutation.
-func withCloudRepositoryID(id int) cloudrepositoryOption {
-	return func(m *CloudRepositoryMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *CloudRepository
-		)
-		m.oldValue = func(ctx context.Context) (*CloudRepository, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().CloudRepository.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// CheckpointInterval returns the value of the "checkpoint_interval" field in the mutation.
+func (m *BackupProfileMutation) CheckpointInterval() (r int, exists bool) {
+	v := m.checkpoint_interval
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withCloudRepository sets the old CloudRepository of the mutation.
-func withCloudRepository(node *CloudRepository) cloudrepositoryOption {
-	return func(m *CloudRepositoryMutation) {
-		m.oldValue = func(context.Context) (*CloudRepository, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldCheckpointInterval returns the old "checkpoint_interval" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupProfileMutation) OldCheckpointInterval(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckpointInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckpointInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckpointInterval: %w", err)
 	}
+	return oldValue.CheckpointInterval, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CloudRepositoryMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddCheckpointInterval adds i to the "checkpoint_interval" field.
+func (m *BackupProfileMutation) AddCheckpointInterval(i int) {
+	if m.addcheckpoint_interval != nil {
+		*m.addcheckpoint_interval += i
+	} else {
+		m.addcheckpoint_interval = &i
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m CloudRepositoryMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddedCheckpointInterval returns the value that was added to the "checkpoint_interval" field in this mutation.
+func (m *BackupProfileMutation) AddedCheckpointInterval() (r int, exists bool) {
+	v := m.addcheckpoint_interval
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CloudRepository entities.
-func (m *CloudRepositoryMutation) SetID(id int) {
-	m.id = &id
+// ClearCheckpointInterval clears the value of the "checkpoint_interval" field.
+func (m *BackupProfileMutation) ClearCheckpointInterval() {
+	m.checkpoint_interval = nil
+	m.addcheckpoint_interval = nil
+	m.clearedFields[backupprofile.FieldCheckpointInterval] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *CloudRepositoryMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// CheckpointIntervalCleared returns if the "checkpoint_interval" field was cleared in this mutation.
+func (m *BackupProfileMutation) CheckpointIntervalCleared() bool {
+	_, ok := m.clearedFields[backupprofile.FieldCheckpointInterval]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *CloudRepositoryMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CloudRepository.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetCheckpointInterval resets all changes to the "checkpoint_interval" field.
+func (m *BackupProfileMutation) ResetCheckpointInterval() {
+	m.checkpoint_interval = nil
+	m.addcheckpoint_interval = nil
+	delete(m.clearedFields, backupprofile.FieldCheckpointInterval)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CloudRepositoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetDataSectionCollapsed sets the "data_section_collapsed" field.
+func (m *BackupProfileMutation) SetDataSectionCollapsed(b bool) {
+	m.data_section_collapsed = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CloudRepositoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// DataSectionCollapsed returns the value of the "data_section_collapsed" field in the mutation.
+func (m *BackupProfileMutation) DataSectionCollapsed() (r bool, exists bool) {
+	v := m.data_section_collapsed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CloudRepository entity.
-// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
+// OldDataSectionCollapsed returns the old "data_section_collapsed" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CloudRepositoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldDataSectionCollapsed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldDataSectionCollapsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldDataSectionCollapsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldDataSectionCollapsed: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CloudRepositoryMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.DataSectionCollapsed, nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CloudRepositoryMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetDataSectionCollapsed resets all changes to the "data_section_collapsed" field.
+func (m *BackupProfileMutation) ResetDataSectionCollapsed() {
+	m.data_section_collapsed = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CloudRepositoryMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// SetScheduleSectionCollapsed sets the "schedule_section_collapsed" field.
+func (m *BackupProfileMutation) SetScheduleSectionCollapsed(b bool) {
+	m.schedule_section_collapsed = &b
+}
+
+// ScheduleSectionCollapsed returns the value of the "schedule_section_collapsed" field in the mutation.
+func (m *BackupProfileMutation) ScheduleSectionCollapsed() (r bool, exists bool) {
+	v := m.schedule_section_collapsed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CloudRepository entity.
-// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
+// OldScheduleSectionCollapsed returns the old "schedule_section_collapsed" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CloudRepositoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupProfileMutation) OldScheduleSectionCollapsed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldScheduleSectionCollapsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldScheduleSectionCollapsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldScheduleSectionCollapsed: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.ScheduleSectionCollapsed, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CloudRepositoryMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetScheduleSectionCollapsed resets all changes to the "schedule_section_collapsed" field.
+func (m *BackupProfileMutation) ResetScheduleSectionCollapsed() {
+	m.schedule_section_collapsed = nil
 }
 
-// SetCloudID sets the "cloud_id" field.
-func (m *CloudRepositoryMutation) SetCloudID(s string) {
-	m.cloud_id = &s
+// SetAdvancedSectionCollapsed sets the "advanced_section_collapsed" field.
+func (m *BackupProfileMutation) SetAdvancedSectionCollapsed(b bool) {
+	m.advanced_section_collapsed = &b
 }
 
-// CloudID returns the value of the "cloud_id" field in the mutation.
-func (m *CloudRepositoryMutation) CloudID() (r string, exists bool) {
-	v := m.cloud_id
+// AdvancedSectionCollapsed returns the value of the "advanced_section_collapsed" field in the mutation.
+func (m *BackupProfileMutation) AdvancedSectionCollapsed() (r bool, exists bool) {
+	v := m.advanced_section_collapsed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCloudID returns the old "cloud_id" field's value of the CloudRepository entity.
-// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
+// OldAdvancedSectionCollapsed returns the old "advanced_section_collapsed" field's value of the BackupProfile entity.
+// If the BackupProfile object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CloudRepositoryMutation) OldCloudID(ctx context.Context) (v string, err error) {
+func (m *BackupProfileMutation) OldAdvancedSectionCollapsed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCloudID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAdvancedSectionCollapsed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCloudID requires an ID field in the mutation")
+		return v, errors.New("OldAdvancedSectionCollapsed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCloudID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAdvancedSectionCollapsed: %w", err)
 	}
-	return oldValue.CloudID, nil
+	return oldValue.AdvancedSectionCollapsed, nil
 }
 
-// ResetCloudID resets all changes to the "cloud_id" field.
-func (m *CloudRepositoryMutation) ResetCloudID() {
-	m.cloud_id = nil
+// ResetAdvancedSectionCollapsed resets all changes to the "advanced_section_collapsed" field.
+func (m *BackupProfileMutation) ResetAdvancedSectionCollapsed() {
+	m.advanced_section_collapsed = nil
 }
 
-// SetStorageUsedBytes sets the "storage_used_bytes" field.
-func (m *CloudRepositoryMutation) SetStorageUsedBytes(i int64) {
-	m.storage_used_bytes = &i
-	m.addstorage_used_bytes = nil
+// AddRepositoryIDs adds the "repositories" edge to the Repository entity by ids.
+func (m *BackupProfileMutation) AddRepositoryIDs(ids ...int) {
+	if m.repositories == nil {
+		m.repositories = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.repositories[ids[i]] = struct{}{}
+	}
 }
 
-// StorageUsedBytes returns the value of the "storage_used_bytes" field in the mutation.
-func (m *CloudRepositoryMutation) StorageUsedBytes() (r int64, exists bool) {
-	v := m.storage_used_bytes
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearRepositories clears the "repositories" edge to the Repository entity.
+func (m *BackupProfileMutation) ClearRepositories() {
+	m.clearedrepositories = true
 }
 
-// OldStorageUsedBytes returns the old "storage_used_bytes" field's value of the CloudRepository entity.
-// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CloudRepositoryMutation) OldStorageUsedBytes(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStorageUsedBytes is only allowed on UpdateOne operations")
+// RepositoriesCleared reports if the "repositories" edge to the Repository entity was cleared.
+func (m *BackupProfileMutation) RepositoriesCleared() bool {
+	return m.clearedrepositories
+}
+
+// RemoveRepositoryIDs removes the "repositories" edge to the Repository entity by IDs.
+func (m *BackupProfileMutation) RemoveRepositoryIDs(ids ...int) {
+	if m.removedrepositories == nil {
+		m.removedrepositories = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStorageUsedBytes requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.repositories, ids[i])
+		m.removedrepositories[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStorageUsedBytes: %w", err)
+}
+
+// RemovedRepositories returns the removed IDs of the "repositories" edge to the Repository entity.
+func (m *BackupProfileMutation) RemovedRepositoriesIDs() (ids []int) {
+	for id := range m.removedrepositories {
+		ids = append(ids, id)
 	}
-	return oldValue.StorageUsedBytes, nil
+	return
 }
 
-// AddStorageUsedBytes adds i to the "storage_used_bytes" field.
-func (m *CloudRepositoryMutation) AddStorageUsedBytes(i int64) {
-	if m.addstorage_used_bytes != nil {
-		*m.addstorage_used_bytes += i
-	} else {
-		m.addstorage_used_bytes = &i
+// RepositoriesIDs returns the "repositories" edge IDs in the mutation.
+func (m *BackupProfileMutation) RepositoriesIDs() (ids []int) {
+	for id := range m.repositories {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedStorageUsedBytes returns the value that was added to the "storage_used_bytes" field in this mutation.
-func (m *CloudRepositoryMutation) AddedStorageUsedBytes() (r int64, exists bool) {
-	v := m.addstorage_used_bytes
-	if v == nil {
-		return
+// ResetRepositories resets all changes to the "repositories" edge.
+func (m *BackupProfileMutation) ResetRepositories() {
+	m.repositories = nil
+	m.clearedrepositories = false
+	m.removedrepositories = nil
+}
+
+// AddArchiveIDs adds the "archives" edge to the Archive entity by ids.
+func (m *BackupProfileMutation) AddArchiveIDs(ids ...int) {
+	if m.archives == nil {
+		m.archives = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.archives[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetStorageUsedBytes resets all changes to the "storage_used_bytes" field.
-func (m *CloudRepositoryMutation) ResetStorageUsedBytes() {
-	m.storage_used_bytes = nil
-	m.addstorage_used_bytes = nil
+// ClearArchives clears the "archives" edge to the Archive entity.
+func (m *BackupProfileMutation) ClearArchives() {
+	m.clearedarchives = true
 }
 
-// SetLocation sets the "location" field.
-func (m *CloudRepositoryMutation) SetLocation(c cloudrepository.Location) {
-	m.location = &c
+// ArchivesCleared reports if the "archives" edge to the Archive entity was cleared.
+func (m *BackupProfileMutation) ArchivesCleared() bool {
+	return m.clearedarchives
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *CloudRepositoryMutation) Location() (r cloudrepository.Location, exists bool) {
-	v := m.location
-	if v == nil {
-		return
+// RemoveArchiveIDs removes the "archives" edge to the Archive entity by IDs.
+func (m *BackupProfileMutation) RemoveArchiveIDs(ids ...int) {
+	if m.removedarchives == nil {
+		m.removedarchives = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.archives, ids[i])
+		m.removedarchives[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the CloudRepository entity.
-// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CloudRepositoryMutation) OldLocation(ctx context.Context) (v cloudrepository.Location, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+// RemovedArchives returns the removed IDs of the "archives" edge to the Archive entity.
+func (m *BackupProfileMutation) RemovedArchivesIDs() (ids []int) {
+	for id := range m.removedarchives {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	return
+}
+
+// ArchivesIDs returns the "archives" edge IDs in the mutation.
+func (m *BackupProfileMutation) ArchivesIDs() (ids []int) {
+	for id := range m.archives {
+		ids = append(ids, id)
 	}
-	return oldValue.Location, nil
+	return
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *CloudRepositoryMutation) ResetLocation() {
-	m.location = nil
+// ResetArchives resets all changes to the "archives" edge.
+func (m *BackupProfileMutation) ResetArchives() {
+	m.archives = nil
+	m.clearedarchives = false
+	m.removedarchives = nil
 }
 
-// SetRepositoryID sets the "repository" edge to the Repository entity by id.
-func (m *CloudRepositoryMutation) SetRepositoryID(id int) {
-	m.repository = &id
+// SetBackupScheduleID sets the "backup_schedule" edge to the BackupSchedule entity by id.
+func (m *BackupProfileMutation) SetBackupScheduleID(id int) {
+	m.backup_schedule = &id
 }
 
-// ClearRepository clears the "repository" edge to the Repository entity.
-func (m *CloudRepositoryMutation) ClearRepository() {
-	m.clearedrepository = true
+// ClearBackupSchedule clears the "backup_schedule" edge to the BackupSchedule entity.
+func (m *BackupProfileMutation) ClearBackupSchedule() {
+	m.clearedbackup_schedule = true
 }
 
-// RepositoryCleared reports if the "repository" edge to the Repository entity was cleared.
-func (m *CloudRepositoryMutation) RepositoryCleared() bool {
-	return m.clearedrepository
+// BackupScheduleCleared reports if the "backup_schedule" edge to the BackupSchedule entity was cleared.
+func (m *BackupProfileMutation) BackupScheduleCleared() bool {
+	return m.clearedbackup_schedule
 }
 
-// RepositoryID returns the "repository" edge ID in the mutation.
-func (m *CloudRepositoryMutation) RepositoryID() (id int, exists bool) {
-	if m.repository != nil {
-		return *m.repository, true
+// BackupScheduleID returns the "backup_schedule" edge ID in the mutation.
+func (m *BackupProfileMutation) BackupScheduleID() (id int, exists bool) {
+	if m.backup_schedule != nil {
+		return *m.backup_schedule, true
 	}
 	return
 }
 
-// RepositoryIDs returns the "repository" edge IDs in the mutation.
+// BackupScheduleIDs returns the "backup_schedule" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RepositoryID instead. It exists only for internal usage by the builders.
-func (m *CloudRepositoryMutation) RepositoryIDs() (ids []int) {
-	if id := m.repository; id != nil {
+// BackupScheduleID instead. It exists only for internal usage by the builders.
+func (m *BackupProfileMutation) BackupScheduleIDs() (ids []int) {
+	if id := m.backup_schedule; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRepository resets all changes to the "repository" edge.
-func (m *CloudRepositoryMutation) ResetRepository() {
-	m.repository = nil
-	m.clearedrepository = false
+// ResetBackupSchedule resets all changes to the "backup_schedule" edge.
+func (m *BackupProfileMutation) ResetBackupSchedule() {
+	m.backup_schedule = nil
+	m.clearedbackup_schedule = false
 }
 
-// Where appends a list predicates to the CloudRepositoryMutation builder.
-func (m *CloudRepositoryMutation) Where(ps ...predicate.CloudRepository) {
+// SetPruningRuleID sets the "pruning_rule" edge to the PruningRule entity by id.
+func (m *BackupProfileMutation) SetPruningRuleID(id int) {
+	m.pruning_rule = &id
+}
+
+// ClearPruningRule clears the "pruning_rule" edge to the PruningRule entity.
+func (m *BackupProfileMutation) ClearPruningRule() {
+	m.clearedpruning_rule = true
+}
+
+// PruningRuleCleared reports if the "pruning_rule" edge to the PruningRule entity was cleared.
+func (m *BackupProfileMutation) PruningRuleCleared() bool {
+	return m.clearedpruning_rule
+}
+
+// PruningRuleID returns the "pruning_rule" edge ID in the mutation.
+func (m *BackupProfileMutation) PruningRuleID() (id int, exists bool) {
+	if m.pruning_rule != nil {
+		return *m.pruning_rule, true
+	}
+	return
+}
+
+// PruningRuleIDs returns the "pruning_rule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PruningRuleID instead. It exists only for internal usage by the builders.
+func (m *BackupProfileMutation) PruningRuleIDs() (ids []int) {
+	if id := m.pruning_rule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPruningRule resets all changes to the "pruning_rule" edge.
+func (m *BackupProfileMutation) ResetPruningRule() {
+	m.pruning_rule = nil
+	m.clearedpruning_rule = false
+}
+
+// AddHookIDs adds the "hooks" edge to the BackupHook entity by ids.
+func (m *BackupProfileMutation) AddHookIDs(ids ...int) {
+	if m._hooks == nil {
+		m._hooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m._hooks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearHooks clears the "hooks" edge to the BackupHook entity.
+func (m *BackupProfileMutation) ClearHooks() {
+	m.cleared_hooks = true
+}
+
+// HooksCleared reports if the "hooks" edge to the BackupHook entity was cleared.
+func (m *BackupProfileMutation) HooksCleared() bool {
+	return m.cleared_hooks
+}
+
+// RemoveHookIDs removes the "hooks" edge to the BackupHook entity by IDs.
+func (m *BackupProfileMutation) RemoveHookIDs(ids ...int) {
+	if m.removed_hooks == nil {
+		m.removed_hooks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m._hooks, ids[i])
+		m.removed_hooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedHooks returns the removed IDs of the "hooks" edge to the BackupHook entity.
+func (m *BackupProfileMutation) RemovedHooksIDs() (ids []int) {
+	for id := range m.removed_hooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// HooksIDs returns the "hooks" edge IDs in the mutation.
+func (m *BackupProfileMutation) HooksIDs() (ids []int) {
+	for id := range m._hooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetHooks resets all changes to the "hooks" edge.
+func (m *BackupProfileMutation) ResetHooks() {
+	m._hooks = nil
+	m.cleared_hooks = false
+	m.removed_hooks = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *BackupProfileMutation) AddNotificationIDs(ids ...int) {
+	if m.notifications == nil {
+		m.notifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *BackupProfileMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *BackupProfileMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *BackupProfileMutation) RemoveNotificationIDs(ids ...int) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *BackupProfileMutation) RemovedNotificationsIDs() (ids []int) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *BackupProfileMutation) NotificationsIDs() (ids []int) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *BackupProfileMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// Where appends a list predicates to the BackupProfileMutation builder.
+func (m *BackupProfileMutation) Where(ps ...predicate.BackupProfile) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CloudRepositoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupProfileMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CloudRepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CloudRepository, len(ps))
+func (m *BackupProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupProfile, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5258,39 +6267,102 @@ func (m *CloudRepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CloudRepositoryMutation) Op() Op {
+func (m *BackupProfileMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CloudRepositoryMutation) SetOp(op Op) {
+func (m *BackupProfileMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CloudRepository).
-func (m *CloudRepositoryMutation) Type() string {
+// Type returns the node type of this mutation (BackupProfile).
+func (m *BackupProfileMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CloudRepositoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *BackupProfileMutation) Fields() []string {
+	fields := make([]string, 0, 26)
 	if m.created_at != nil {
-		fields = append(fields, cloudrepository.FieldCreatedAt)
+		fields = append(fields, backupprofile.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, cloudrepository.FieldUpdatedAt)
+		fields = append(fields, backupprofile.FieldUpdatedAt)
 	}
-	if m.cloud_id != nil {
-		fields = append(fields, cloudrepository.FieldCloudID)
+	if m.name != nil {
+		fields = append(fields, backupprofile.FieldName)
 	}
-	if m.storage_used_bytes != nil {
-		fields = append(fields, cloudrepository.FieldStorageUsedBytes)
+	if m.prefix != nil {
+		fields = append(fields, backupprofile.FieldPrefix)
 	}
-	if m.location != nil {
-		fields = append(fields, cloudrepository.FieldLocation)
+	if m.backup_paths != nil {
+		fields = append(fields, backupprofile.FieldBackupPaths)
+	}
+	if m.exclude_paths != nil {
+		fields = append(fields, backupprofile.FieldExcludePaths)
+	}
+	if m.patterns != nil {
+		fields = append(fields, backupprofile.FieldPatterns)
+	}
+	if m.exclude_caches != nil {
+		fields = append(fields, backupprofile.FieldExcludeCaches)
+	}
+	if m.exclude_if_present != nil {
+		fields = append(fields, backupprofile.FieldExcludeIfPresent)
+	}
+	if m.use_ignore_files != nil {
+		fields = append(fields, backupprofile.FieldUseIgnoreFiles)
+	}
+	if m.content_command != nil {
+		fields = append(fields, backupprofile.FieldContentCommand)
+	}
+	if m.content_stdin_name != nil {
+		fields = append(fields, backupprofile.FieldContentStdinName)
+	}
+	if m.icon != nil {
+		fields = append(fields, backupprofile.FieldIcon)
+	}
+	if m.compression_mode != nil {
+		fields = append(fields, backupprofile.FieldCompressionMode)
+	}
+	if m.compression_level != nil {
+		fields = append(fields, backupprofile.FieldCompressionLevel)
+	}
+	if m.one_file_system != nil {
+		fields = append(fields, backupprofile.FieldOneFileSystem)
+	}
+	if m.numeric_ids != nil {
+		fields = append(fields, backupprofile.FieldNumericIds)
+	}
+	if m.no_flags != nil {
+		fields = append(fields, backupprofile.FieldNoFlags)
+	}
+	if m.no_acls != nil {
+		fields = append(fields, backupprofile.FieldNoAcls)
+	}
+	if m.no_xattrs != nil {
+		fields = append(fields, backupprofile.FieldNoXattrs)
+	}
+	if m.sparse != nil {
+		fields = append(fields, backupprofile.FieldSparse)
+	}
+	if m.read_special != nil {
+		fields = append(fields, backupprofile.FieldReadSpecial)
+	}
+	if m.checkpoint_interval != nil {
+		fields = append(fields, backupprofile.FieldCheckpointInterval)
+	}
+	if m.data_section_collapsed != nil {
+		fields = append(fields, backupprofile.FieldDataSectionCollapsed)
+	}
+	if m.schedule_section_collapsed != nil {
+		fields = append(fields, backupprofile.FieldScheduleSectionCollapsed)
+	}
+	if m.advanced_section_collapsed != nil {
+		fields = append(fields, backupprofile.FieldAdvancedSectionCollapsed)
 	}
 	return fields
 }
@@ -5298,18 +6370,60 @@ func (m *CloudRepositoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CloudRepositoryMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupProfileMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cloudrepository.FieldCreatedAt:
+	case backupprofile.FieldCreatedAt:
 		return m.CreatedAt()
-	case cloudrepository.FieldUpdatedAt:
+	case backupprofile.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case cloudrepository.FieldCloudID:
-		return m.CloudID()
-	case cloudrepository.FieldStorageUsedBytes:
-		return m.StorageUsedBytes()
-	case cloudrepository.FieldLocation:
-		return m.Location()
+	case backupprofile.FieldName:
+		return m.Name()
+	case backupprofile.FieldPrefix:
+		return m.Prefix()
+	case backupprofile.FieldBackupPaths:
+		return m.BackupPaths()
+	case backupprofile.FieldExcludePaths:
+		return m.ExcludePaths()
+	case backupprofile.FieldPatterns:
+		return m.Patterns()
+	case backupprofile.FieldExcludeCaches:
+		return m.ExcludeCaches()
+	case backupprofile.FieldExcludeIfPresent:
+		return m.ExcludeIfPresent()
+	case backupprofile.FieldUseIgnoreFiles:
+		return m.UseIgnoreFiles()
+	case backupprofile.FieldContentCommand:
+		return m.ContentCommand()
+	case backupprofile.FieldContentStdinName:
+		return m.ContentStdinName()
+	case backupprofile.FieldIcon:
+		return m.Icon()
+	case backupprofile.FieldCompressionMode:
+		return m.CompressionMode()
+	case backupprofile.FieldCompressionLevel:
+		return m.CompressionLevel()
+	case backupprofile.FieldOneFileSystem:
+		return m.OneFileSystem()
+	case backupprofile.FieldNumericIds:
+		return m.NumericIds()
+	case backupprofile.FieldNoFlags:
+		return m.NoFlags()
+	case backupprofile.FieldNoAcls:
+		return m.NoAcls()
+	case backupprofile.FieldNoXattrs:
+		return m.NoXattrs()
+	case backupprofile.FieldSparse:
+		return m.Sparse()
+	case backupprofile.FieldReadSpecial:
+		return m.ReadSpecial()
+	case backupprofile.FieldCheckpointInterval:
+		return m.CheckpointInterval()
+	case backupprofile.FieldDataSectionCollapsed:
+		return m.DataSectionCollapsed()
+	case backupprofile.FieldScheduleSectionCollapsed:
+		return m.ScheduleSectionCollapsed()
+	case backupprofile.FieldAdvancedSectionCollapsed:
+		return m.AdvancedSectionCollapsed()
 	}
 	return nil, false
 }
@@ -5317,72 +6431,264 @@ func (m *CloudRepositoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CloudRepositoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cloudrepository.FieldCreatedAt:
+	case backupprofile.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case cloudrepository.FieldUpdatedAt:
+	case backupprofile.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case cloudrepository.FieldCloudID:
-		return m.OldCloudID(ctx)
-	case cloudrepository.FieldStorageUsedBytes:
-		return m.OldStorageUsedBytes(ctx)
-	case cloudrepository.FieldLocation:
-		return m.OldLocation(ctx)
-	}
-	return nil, fmt.Errorf("unknown CloudRepository field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *CloudRepositoryMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case cloudrepository.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case cloudrepository.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case backupprofile.FieldName:
+		return m.OldName(ctx)
+	case backupprofile.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case backupprofile.FieldBackupPaths:
+		return m.OldBackupPaths(ctx)
+	case backupprofile.FieldExcludePaths:
+		return m.OldExcludePaths(ctx)
+	case backupprofile.FieldPatterns:
+		return m.OldPatterns(ctx)
+	case backupprofile.FieldExcludeCaches:
+		return m.OldExcludeCaches(ctx)
+	case backupprofile.FieldExcludeIfPresent:
+		return m.OldExcludeIfPresent(ctx)
+	case backupprofile.FieldUseIgnoreFiles:
+		return m.OldUseIgnoreFiles(ctx)
+	case backupprofile.FieldContentCommand:
+		return m.OldContentCommand(ctx)
+	case backupprofile.FieldContentStdinName:
+		return m.OldContentStdinName(ctx)
+	case backupprofile.FieldIcon:
+		return m.OldIcon(ctx)
+	case backupprofile.FieldCompressionMode:
+		return m.OldCompressionMode(ctx)
+	case backupprofile.FieldCompressionLevel:
+		return m.OldCompressionLevel(ctx)
+	case backupprofile.FieldOneFileSystem:
+		return m.OldOneFileSystem(ctx)
+	case backupprofile.FieldNumericIds:
+		return m.OldNumericIds(ctx)
+	case backupprofile.FieldNoFlags:
+		return m.OldNoFlags(ctx)
+	case backupprofile.FieldNoAcls:
+		return m.OldNoAcls(ctx)
+	case backupprofile.FieldNoXattrs:
+		return m.OldNoXattrs(ctx)
+	case backupprofile.FieldSparse:
+		return m.OldSparse(ctx)
+	case backupprofile.FieldReadSpecial:
+		return m.OldReadSpecial(ctx)
+	case backupprofile.FieldCheckpointInterval:
+		return m.OldCheckpointInterval(ctx)
+	case backupprofile.FieldDataSectionCollapsed:
+		return m.OldDataSectionCollapsed(ctx)
+	case backupprofile.FieldScheduleSectionCollapsed:
+		return m.OldScheduleSectionCollapsed(ctx)
+	case backupprofile.FieldAdvancedSectionCollapsed:
+		return m.OldAdvancedSectionCollapsed(ctx)
+	}
+	return nil, fmt.Errorf("unknown BackupProfile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backupprofile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case backupprofile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case cloudrepository.FieldCloudID:
+	case backupprofile.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCloudID(v)
+		m.SetName(v)
 		return nil
-	case cloudrepository.FieldStorageUsedBytes:
-		v, ok := value.(int64)
+	case backupprofile.FieldPrefix:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStorageUsedBytes(v)
+		m.SetPrefix(v)
 		return nil
-	case cloudrepository.FieldLocation:
-		v, ok := value.(cloudrepository.Location)
+	case backupprofile.FieldBackupPaths:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetBackupPaths(v)
+		return nil
+	case backupprofile.FieldExcludePaths:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludePaths(v)
+		return nil
+	case backupprofile.FieldPatterns:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPatterns(v)
+		return nil
+	case backupprofile.FieldExcludeCaches:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludeCaches(v)
+		return nil
+	case backupprofile.FieldExcludeIfPresent:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcludeIfPresent(v)
+		return nil
+	case backupprofile.FieldUseIgnoreFiles:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUseIgnoreFiles(v)
+		return nil
+	case backupprofile.FieldContentCommand:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentCommand(v)
+		return nil
+	case backupprofile.FieldContentStdinName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentStdinName(v)
+		return nil
+	case backupprofile.FieldIcon:
+		v, ok := value.(backupprofile.Icon)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIcon(v)
+		return nil
+	case backupprofile.FieldCompressionMode:
+		v, ok := value.(backupprofile.CompressionMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressionMode(v)
+		return nil
+	case backupprofile.FieldCompressionLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompressionLevel(v)
+		return nil
+	case backupprofile.FieldOneFileSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOneFileSystem(v)
+		return nil
+	case backupprofile.FieldNumericIds:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumericIds(v)
+		return nil
+	case backupprofile.FieldNoFlags:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoFlags(v)
+		return nil
+	case backupprofile.FieldNoAcls:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoAcls(v)
+		return nil
+	case backupprofile.FieldNoXattrs:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNoXattrs(v)
+		return nil
+	case backupprofile.FieldSparse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSparse(v)
+		return nil
+	case backupprofile.FieldReadSpecial:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadSpecial(v)
+		return nil
+	case backupprofile.FieldCheckpointInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckpointInterval(v)
+		return nil
+	case backupprofile.FieldDataSectionCollapsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataSectionCollapsed(v)
+		return nil
+	case backupprofile.FieldScheduleSectionCollapsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScheduleSectionCollapsed(v)
+		return nil
+	case backupprofile.FieldAdvancedSectionCollapsed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvancedSectionCollapsed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CloudRepository field %s", name)
+	return fmt.Errorf("unknown BackupProfile field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CloudRepositoryMutation) AddedFields() []string {
+func (m *BackupProfileMutation) AddedFields() []string {
 	var fields []string
-	if m.addstorage_used_bytes != nil {
-		fields = append(fields, cloudrepository.FieldStorageUsedBytes)
+	if m.addcompression_level != nil {
+		fields = append(fields, backupprofile.FieldCompressionLevel)
+	}
+	if m.addcheckpoint_interval != nil {
+		fields = append(fields, backupprofile.FieldCheckpointInterval)
 	}
 	return fields
 }
@@ -5390,10 +6696,12 @@ func (m *CloudRepositoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CloudRepositoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackupProfileMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case cloudrepository.FieldStorageUsedBytes:
-		return m.AddedStorageUsedBytes()
+	case backupprofile.FieldCompressionLevel:
+		return m.AddedCompressionLevel()
+	case backupprofile.FieldCheckpointInterval:
+		return m.AddedCheckpointInterval()
 	}
 	return nil, false
 }
@@ -5401,168 +6709,418 @@ func (m *CloudRepositoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CloudRepositoryMutation) AddField(name string, value ent.Value) error {
+func (m *BackupProfileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cloudrepository.FieldStorageUsedBytes:
-		v, ok := value.(int64)
+	case backupprofile.FieldCompressionLevel:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStorageUsedBytes(v)
+		m.AddCompressionLevel(v)
+		return nil
+	case backupprofile.FieldCheckpointInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCheckpointInterval(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CloudRepository numeric field %s", name)
+	return fmt.Errorf("unknown BackupProfile numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CloudRepositoryMutation) ClearedFields() []string {
-	return nil
+func (m *BackupProfileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backupprofile.FieldExcludePaths) {
+		fields = append(fields, backupprofile.FieldExcludePaths)
+	}
+	if m.FieldCleared(backupprofile.FieldPatterns) {
+		fields = append(fields, backupprofile.FieldPatterns)
+	}
+	if m.FieldCleared(backupprofile.FieldExcludeIfPresent) {
+		fields = append(fields, backupprofile.FieldExcludeIfPresent)
+	}
+	if m.FieldCleared(backupprofile.FieldCompressionLevel) {
+		fields = append(fields, backupprofile.FieldCompressionLevel)
+	}
+	if m.FieldCleared(backupprofile.FieldCheckpointInterval) {
+		fields = append(fields, backupprofile.FieldCheckpointInterval)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CloudRepositoryMutation) FieldCleared(name string) bool {
+func (m *BackupProfileMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CloudRepositoryMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown CloudRepository nullable field %s", name)
+func (m *BackupProfileMutation) ClearField(name string) error {
+	switch name {
+	case backupprofile.FieldExcludePaths:
+		m.ClearExcludePaths()
+		return nil
+	case backupprofile.FieldPatterns:
+		m.ClearPatterns()
+		return nil
+	case backupprofile.FieldExcludeIfPresent:
+		m.ClearExcludeIfPresent()
+		return nil
+	case backupprofile.FieldCompressionLevel:
+		m.ClearCompressionLevel()
+		return nil
+	case backupprofile.FieldCheckpointInterval:
+		m.ClearCheckpointInterval()
+		return nil
+	}
+	return fmt.Errorf("unknown BackupProfile nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CloudRepositoryMutation) ResetField(name string) error {
+func (m *BackupProfileMutation) ResetField(name string) error {
 	switch name {
-	case cloudrepository.FieldCreatedAt:
+	case backupprofile.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case cloudrepository.FieldUpdatedAt:
+	case backupprofile.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case cloudrepository.FieldCloudID:
-		m.ResetCloudID()
+	case backupprofile.FieldName:
+		m.ResetName()
 		return nil
-	case cloudrepository.FieldStorageUsedBytes:
-		m.ResetStorageUsedBytes()
+	case backupprofile.FieldPrefix:
+		m.ResetPrefix()
 		return nil
-	case cloudrepository.FieldLocation:
-		m.ResetLocation()
+	case backupprofile.FieldBackupPaths:
+		m.ResetBackupPaths()
+		return nil
+	case backupprofile.FieldExcludePaths:
+		m.ResetExcludePaths()
+		return nil
+	case backupprofile.FieldPatterns:
+		m.ResetPatterns()
+		return nil
+	case backupprofile.FieldExcludeCaches:
+		m.ResetExcludeCaches()
+		return nil
+	case backupprofile.FieldExcludeIfPresent:
+		m.ResetExcludeIfPresent()
+		return nil
+	case backupprofile.FieldUseIgnoreFiles:
+		m.ResetUseIgnoreFiles()
+		return nil
+	case backupprofile.FieldContentCommand:
+		m.ResetContentCommand()
+		return nil
+	case backupprofile.FieldContentStdinName:
+		m.ResetContentStdinName()
+		return nil
+	case backupprofile.FieldIcon:
+		m.ResetIcon()
+		return nil
+	case backupprofile.FieldCompressionMode:
+		m.ResetCompressionMode()
+		return nil
+	case backupprofile.FieldCompressionLevel:
+		m.ResetCompressionLevel()
+		return nil
+	case backupprofile.FieldOneFileSystem:
+		m.ResetOneFileSystem()
+		return nil
+	case backupprofile.FieldNumericIds:
+		m.ResetNumericIds()
+		return nil
+	case backupprofile.FieldNoFlags:
+		m.ResetNoFlags()
+		return nil
+	case backupprofile.FieldNoAcls:
+		m.ResetNoAcls()
+		return nil
+	case backupprofile.FieldNoXattrs:
+		m.ResetNoXattrs()
+		return nil
+	case backupprofile.FieldSparse:
+		m.ResetSparse()
+		return nil
+	case backupprofile.FieldReadSpecial:
+		m.ResetReadSpecial()
+		return nil
+	case backupprofile.FieldCheckpointInterval:
+		m.ResetCheckpointInterval()
+		return nil
+	case backupprofile.FieldDataSectionCollapsed:
+		m.ResetDataSectionCollapsed()
+		return nil
+	case backupprofile.FieldScheduleSectionCollapsed:
+		m.ResetScheduleSectionCollapsed()
+		return nil
+	case backupprofile.FieldAdvancedSectionCollapsed:
+		m.ResetAdvancedSectionCollapsed()
 		return nil
 	}
-	return fmt.Errorf("unknown CloudRepository field %s", name)
+	return fmt.Errorf("unknown BackupProfile field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CloudRepositoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.repository != nil {
-		edges = append(edges, cloudrepository.EdgeRepository)
+func (m *BackupProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.repositories != nil {
+		edges = append(edges, backupprofile.EdgeRepositories)
+	}
+	if m.archives != nil {
+		edges = append(edges, backupprofile.EdgeArchives)
+	}
+	if m.backup_schedule != nil {
+		edges = append(edges, backupprofile.EdgeBackupSchedule)
+	}
+	if m.pruning_rule != nil {
+		edges = append(edges, backupprofile.EdgePruningRule)
+	}
+	if m._hooks != nil {
+		edges = append(edges, backupprofile.EdgeHooks)
+	}
+	if m.notifications != nil {
+		edges = append(edges, backupprofile.EdgeNotifications)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CloudRepositoryMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupProfileMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case cloudrepository.EdgeRepository:
-		if id := m.repository; id != nil {
+	case backupprofile.EdgeRepositories:
+		ids := make([]ent.Value, 0, len(m.repositories))
+		for id := range m.repositories {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeArchives:
+		ids := make([]ent.Value, 0, len(m.archives))
+		for id := range m.archives {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeBackupSchedule:
+		if id := m.backup_schedule; id != nil {
+			return []ent.Value{*id}
+		}
+	case backupprofile.EdgePruningRule:
+		if id := m.pruning_rule; id != nil {
 			return []ent.Value{*id}
 		}
+	case backupprofile.EdgeHooks:
+		ids := make([]ent.Value, 0, len(m._hooks))
+		for id := range m._hooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CloudRepositoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *BackupProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedrepositories != nil {
+		edges = append(edges, backupprofile.EdgeRepositories)
+	}
+	if m.removedarchives != nil {
+		edges = append(edges, backupprofile.EdgeArchives)
+	}
+	if m.removed_hooks != nil {
+		edges = append(edges, backupprofile.EdgeHooks)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, backupprofile.EdgeNotifications)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CloudRepositoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackupProfileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case backupprofile.EdgeRepositories:
+		ids := make([]ent.Value, 0, len(m.removedrepositories))
+		for id := range m.removedrepositories {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeArchives:
+		ids := make([]ent.Value, 0, len(m.removedarchives))
+		for id := range m.removedarchives {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeHooks:
+		ids := make([]ent.Value, 0, len(m.removed_hooks))
+		for id := range m.removed_hooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case backupprofile.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CloudRepositoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedrepository {
-		edges = append(edges, cloudrepository.EdgeRepository)
+func (m *BackupProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedrepositories {
+		edges = append(edges, backupprofile.EdgeRepositories)
+	}
+	if m.clearedarchives {
+		edges = append(edges, backupprofile.EdgeArchives)
+	}
+	if m.clearedbackup_schedule {
+		edges = append(edges, backupprofile.EdgeBackupSchedule)
+	}
+	if m.clearedpruning_rule {
+		edges = append(edges, backupprofile.EdgePruningRule)
+	}
+	if m.cleared_hooks {
+		edges = append(edges, backupprofile.EdgeHooks)
+	}
+	if m.clearednotifications {
+		edges = append(edges, backupprofile.EdgeNotifications)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CloudRepositoryMutation) EdgeCleared(name string) bool {
+func (m *BackupProfileMutation) EdgeCleared(name string) bool {
 	switch name {
-	case cloudrepository.EdgeRepository:
-		return m.clearedrepository
+	case backupprofile.EdgeRepositories:
+		return m.clearedrepositories
+	case backupprofile.EdgeArchives:
+		return m.clearedarchives
+	case backupprofile.EdgeBackupSchedule:
+		return m.clearedbackup_schedule
+	case backupprofile.EdgePruningRule:
+		return m.clearedpruning_rule
+	case backupprofile.EdgeHooks:
+		return m.cleared_hooks
+	case backupprofile.EdgeNotifications:
+		return m.clearednotifications
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CloudRepositoryMutation) ClearEdge(name string) error {
+func (m *BackupProfileMutation) ClearEdge(name string) error {
 	switch name {
-	case cloudrepository.EdgeRepository:
-		m.ClearRepository()
+	case backupprofile.EdgeBackupSchedule:
+		m.ClearBackupSchedule()
+		return nil
+	case backupprofile.EdgePruningRule:
+		m.ClearPruningRule()
 		return nil
 	}
-	return fmt.Errorf("unknown CloudRepository unique edge %s", name)
+	return fmt.Errorf("unknown BackupProfile unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CloudRepositoryMutation) ResetEdge(name string) error {
+func (m *BackupProfileMutation) ResetEdge(name string) error {
 	switch name {
-	case cloudrepository.EdgeRepository:
-		m.ResetRepository()
+	case backupprofile.EdgeRepositories:
+		m.ResetRepositories()
+		return nil
+	case backupprofile.EdgeArchives:
+		m.ResetArchives()
+		return nil
+	case backupprofile.EdgeBackupSchedule:
+		m.ResetBackupSchedule()
+		return nil
+	case backupprofile.EdgePruningRule:
+		m.ResetPruningRule()
+		return nil
+	case backupprofile.EdgeHooks:
+		m.ResetHooks()
+		return nil
+	case backupprofile.EdgeNotifications:
+		m.ResetNotifications()
 		return nil
 	}
-	return fmt.Errorf("unknown CloudRepository edge %s", name)
+	return fmt.Errorf("unknown BackupProfile edge %s", name)
 }
 
-// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
-type NotificationMutation struct {
+// BackupScheduleMutation represents an operation that mutates the BackupSchedule nodes in the graph.
+type BackupScheduleMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	message               *string
-	_type                 *notification.Type
-	seen                  *bool
-	action                *notification.Action
-	clearedFields         map[string]struct{}
-	backup_profile        *int
-	clearedbackup_profile bool
-	repository            *int
-	clearedrepository     bool
-	done                  bool
-	oldValue              func(context.Context) (*Notification, error)
-	predicates            []predicate.Notification
+	op                           Op
+	typ                          string
+	id                           *int
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	mode                         *backupschedule.Mode
+	interval_minutes             *uint16
+	addinterval_minutes          *int16
+	daily_at                     *time.Time
+	weekday                      *backupschedule.Weekday
+	weekly_at                    *time.Time
+	monthday                     *uint8
+	addmonthday                  *int8
+	monthly_at                   *time.Time
+	cron_expressions             *[]string
+	appendcron_expressions       []string
+	catch_up_policy              *backupschedule.CatchUpPolicy
+	catch_up_delay_minutes       *uint16
+	addcatch_up_delay_minutes    *int16
+	jitter_minutes               *uint16
+	addjitter_minutes            *int16
+	allowed_hours                *[]types.TimeWindow
+	appendallowed_hours          []types.TimeWindow
+	blackout_periods             *[]types.TimeWindow
+	appendblackout_periods       []types.TimeWindow
+	require_ac_power             *bool
+	require_unmetered_network    *bool
+	require_reachable_repository *bool
+	run_on_drive_connect         *bool
+	drive_action_after_backup    *backupschedule.DriveActionAfterBackup
+	next_run                     *time.Time
+	catch_up_at                  *time.Time
+	last_run                     *time.Time
+	last_run_status              *string
+	clearedFields                map[string]struct{}
+	backup_profile               *int
+	clearedbackup_profile        bool
+	done                         bool
+	oldValue                     func(context.Context) (*BackupSchedule, error)
+	predicates                   []predicate.BackupSchedule
 }
 
-var _ ent.Mutation = (*NotificationMutation)(nil)
+var _ ent.Mutation = (*BackupScheduleMutation)(nil)
 
-// notificationOption allows management of the mutation configuration using functional options.
-type notificationOption func(*NotificationMutation)
+// backupscheduleOption allows management of the mutation configuration using functional options.
+type backupscheduleOption func(*BackupScheduleMutation)
 
-// newNotificationMutation creates new mutation for the Notification entity.
-func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
-	m := &NotificationMutation{
+// newBackupScheduleMutation creates new mutation for the BackupSchedule entity.
+func newBackupScheduleMutation(c config, op Op, opts ...backupscheduleOption) *BackupScheduleMutation {
+	m := &BackupScheduleMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeNotification,
+		typ:           TypeBackupSchedule,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5571,20 +7129,20 @@ func newNotificationMutation(c config, op Op, opts ...notificationOption) *Notif
 	return m
 }
 
-// withNotificationID sets the ID field of the mutation.
-func withNotificationID(id int) notificationOption {
-	return func(m *NotificationMutation) {
+// withBackupScheduleID sets the ID field of the mutation.
+func withBackupScheduleID(id int) backupscheduleOption {
+	return func(m *BackupScheduleMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Notification
+			value *BackupSchedule
 		)
-		m.oldValue = func(ctx context.Context) (*Notification, error) {
+		m.oldValue = func(ctx context.Context) (*BackupSchedule, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Notification.Get(ctx, id)
+					value, err = m.Client().BackupSchedule.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5593,10 +7151,10 @@ func withNotificationID(id int) notificationOption {
 	}
 }
 
-// withNotification sets the old Notification of the mutation.
-func withNotification(node *Notification) notificationOption {
-	return func(m *NotificationMutation) {
-		m.oldValue = func(context.Context) (*Notification, error) {
+// withBackupSchedule sets the old BackupSchedule of the mutation.
+func withBackupSchedule(node *BackupSchedule) backupscheduleOption {
+	return func(m *BackupScheduleMutation) {
+		m.oldValue = func(context.Context) (*BackupSchedule, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5605,7 +7163,7 @@ func withNotification(node *Notification) notificationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m NotificationMutation) Client() *Client {
+func (m BackupScheduleMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5613,7 +7171,7 @@ func (m NotificationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m NotificationMutation) Tx() (*Tx, error) {
+func (m BackupScheduleMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5623,14 +7181,14 @@ func (m NotificationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Notification entities.
-func (m *NotificationMutation) SetID(id int) {
+// operation is only accepted on creation of BackupSchedule entities.
+func (m *BackupScheduleMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *NotificationMutation) ID() (id int, exists bool) {
+func (m *BackupScheduleMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5641,7 +7199,7 @@ func (m *NotificationMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BackupScheduleMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5650,19 +7208,19 @@ func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackupSchedule.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+func (m *BackupScheduleMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BackupScheduleMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5670,10 +7228,10 @@ func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupScheduleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5688,17 +7246,17 @@ func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *NotificationMutation) ResetCreatedAt() {
+func (m *BackupScheduleMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+func (m *BackupScheduleMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BackupScheduleMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5706,10 +7264,10 @@ func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupScheduleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5724,1247 +7282,979 @@ func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *NotificationMutation) ResetUpdatedAt() {
+func (m *BackupScheduleMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetMessage sets the "message" field.
-func (m *NotificationMutation) SetMessage(s string) {
-	m.message = &s
+// SetMode sets the "mode" field.
+func (m *BackupScheduleMutation) SetMode(b backupschedule.Mode) {
+	m.mode = &b
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *NotificationMutation) Message() (r string, exists bool) {
-	v := m.message
+// Mode returns the value of the "mode" field in the mutation.
+func (m *BackupScheduleMutation) Mode() (r backupschedule.Mode, exists bool) {
+	v := m.mode
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldMode returns the old "mode" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *BackupScheduleMutation) OldMode(ctx context.Context) (v backupschedule.Mode, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldMode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.Mode, nil
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *NotificationMutation) ResetMessage() {
-	m.message = nil
+// ResetMode resets all changes to the "mode" field.
+func (m *BackupScheduleMutation) ResetMode() {
+	m.mode = nil
 }
 
-// SetType sets the "type" field.
-func (m *NotificationMutation) SetType(n notification.Type) {
-	m._type = &n
+// SetIntervalMinutes sets the "interval_minutes" field.
+func (m *BackupScheduleMutation) SetIntervalMinutes(u uint16) {
+	m.interval_minutes = &u
+	m.addinterval_minutes = nil
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
-	v := m._type
+// IntervalMinutes returns the value of the "interval_minutes" field in the mutation.
+func (m *BackupScheduleMutation) IntervalMinutes() (r uint16, exists bool) {
+	v := m.interval_minutes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldIntervalMinutes returns the old "interval_minutes" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+func (m *BackupScheduleMutation) OldIntervalMinutes(ctx context.Context) (v uint16, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldIntervalMinutes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldIntervalMinutes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldIntervalMinutes: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.IntervalMinutes, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *NotificationMutation) ResetType() {
-	m._type = nil
+// AddIntervalMinutes adds u to the "interval_minutes" field.
+func (m *BackupScheduleMutation) AddIntervalMinutes(u int16) {
+	if m.addinterval_minutes != nil {
+		*m.addinterval_minutes += u
+	} else {
+		m.addinterval_minutes = &u
+	}
 }
 
-// SetSeen sets the "seen" field.
-func (m *NotificationMutation) SetSeen(b bool) {
-	m.seen = &b
+// AddedIntervalMinutes returns the value that was added to the "interval_minutes" field in this mutation.
+func (m *BackupScheduleMutation) AddedIntervalMinutes() (r int16, exists bool) {
+	v := m.addinterval_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Seen returns the value of the "seen" field in the mutation.
-func (m *NotificationMutation) Seen() (r bool, exists bool) {
-	v := m.seen
+// ResetIntervalMinutes resets all changes to the "interval_minutes" field.
+func (m *BackupScheduleMutation) ResetIntervalMinutes() {
+	m.interval_minutes = nil
+	m.addinterval_minutes = nil
+}
+
+// SetDailyAt sets the "daily_at" field.
+func (m *BackupScheduleMutation) SetDailyAt(t time.Time) {
+	m.daily_at = &t
+}
+
+// DailyAt returns the value of the "daily_at" field in the mutation.
+func (m *BackupScheduleMutation) DailyAt() (r time.Time, exists bool) {
+	v := m.daily_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSeen returns the old "seen" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldDailyAt returns the old "daily_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldSeen(ctx context.Context) (v bool, err error) {
+func (m *BackupScheduleMutation) OldDailyAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSeen is only allowed on UpdateOne operations")
+		return v, errors.New("OldDailyAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSeen requires an ID field in the mutation")
+		return v, errors.New("OldDailyAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSeen: %w", err)
+		return v, fmt.Errorf("querying old value for OldDailyAt: %w", err)
 	}
-	return oldValue.Seen, nil
+	return oldValue.DailyAt, nil
 }
 
-// ResetSeen resets all changes to the "seen" field.
-func (m *NotificationMutation) ResetSeen() {
-	m.seen = nil
+// ResetDailyAt resets all changes to the "daily_at" field.
+func (m *BackupScheduleMutation) ResetDailyAt() {
+	m.daily_at = nil
 }
 
-// SetAction sets the "action" field.
-func (m *NotificationMutation) SetAction(n notification.Action) {
-	m.action = &n
+// SetWeekday sets the "weekday" field.
+func (m *BackupScheduleMutation) SetWeekday(b backupschedule.Weekday) {
+	m.weekday = &b
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *NotificationMutation) Action() (r notification.Action, exists bool) {
-	v := m.action
+// Weekday returns the value of the "weekday" field in the mutation.
+func (m *BackupScheduleMutation) Weekday() (r backupschedule.Weekday, exists bool) {
+	v := m.weekday
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the Notification entity.
-// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// OldWeekday returns the old "weekday" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *NotificationMutation) OldAction(ctx context.Context) (v notification.Action, err error) {
+func (m *BackupScheduleMutation) OldWeekday(ctx context.Context) (v backupschedule.Weekday, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldWeekday is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldWeekday requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldWeekday: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.Weekday, nil
 }
 
-// ClearAction clears the value of the "action" field.
-func (m *NotificationMutation) ClearAction() {
-	m.action = nil
-	m.clearedFields[notification.FieldAction] = struct{}{}
+// ResetWeekday resets all changes to the "weekday" field.
+func (m *BackupScheduleMutation) ResetWeekday() {
+	m.weekday = nil
 }
 
-// ActionCleared returns if the "action" field was cleared in this mutation.
-func (m *NotificationMutation) ActionCleared() bool {
-	_, ok := m.clearedFields[notification.FieldAction]
-	return ok
+// SetWeeklyAt sets the "weekly_at" field.
+func (m *BackupScheduleMutation) SetWeeklyAt(t time.Time) {
+	m.weekly_at = &t
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *NotificationMutation) ResetAction() {
-	m.action = nil
-	delete(m.clearedFields, notification.FieldAction)
+// WeeklyAt returns the value of the "weekly_at" field in the mutation.
+func (m *BackupScheduleMutation) WeeklyAt() (r time.Time, exists bool) {
+	v := m.weekly_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
-func (m *NotificationMutation) SetBackupProfileID(id int) {
-	m.backup_profile = &id
+// OldWeeklyAt returns the old "weekly_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldWeeklyAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeeklyAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeeklyAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeeklyAt: %w", err)
+	}
+	return oldValue.WeeklyAt, nil
 }
 
-// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
-func (m *NotificationMutation) ClearBackupProfile() {
-	m.clearedbackup_profile = true
+// ResetWeeklyAt resets all changes to the "weekly_at" field.
+func (m *BackupScheduleMutation) ResetWeeklyAt() {
+	m.weekly_at = nil
 }
 
-// BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
-func (m *NotificationMutation) BackupProfileCleared() bool {
-	return m.clearedbackup_profile
+// SetMonthday sets the "monthday" field.
+func (m *BackupScheduleMutation) SetMonthday(u uint8) {
+	m.monthday = &u
+	m.addmonthday = nil
 }
 
-// BackupProfileID returns the "backup_profile" edge ID in the mutation.
-func (m *NotificationMutation) BackupProfileID() (id int, exists bool) {
-	if m.backup_profile != nil {
-		return *m.backup_profile, true
+// Monthday returns the value of the "monthday" field in the mutation.
+func (m *BackupScheduleMutation) Monthday() (r uint8, exists bool) {
+	v := m.monthday
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BackupProfileID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) BackupProfileIDs() (ids []int) {
-	if id := m.backup_profile; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
+// OldMonthday returns the old "monthday" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldMonthday(ctx context.Context) (v uint8, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthday: %w", err)
+	}
+	return oldValue.Monthday, nil
+}
 
-// ResetBackupProfile resets all changes to the "backup_profile" edge.
-func (m *NotificationMutation) ResetBackupProfile() {
-	m.backup_profile = nil
-	m.clearedbackup_profile = false
+// AddMonthday adds u to the "monthday" field.
+func (m *BackupScheduleMutation) AddMonthday(u int8) {
+	if m.addmonthday != nil {
+		*m.addmonthday += u
+	} else {
+		m.addmonthday = &u
+	}
 }
 
-// SetRepositoryID sets the "repository" edge to the Repository entity by id.
-func (m *NotificationMutation) SetRepositoryID(id int) {
-	m.repository = &id
+// AddedMonthday returns the value that was added to the "monthday" field in this mutation.
+func (m *BackupScheduleMutation) AddedMonthday() (r int8, exists bool) {
+	v := m.addmonthday
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearRepository clears the "repository" edge to the Repository entity.
-func (m *NotificationMutation) ClearRepository() {
-	m.clearedrepository = true
+// ResetMonthday resets all changes to the "monthday" field.
+func (m *BackupScheduleMutation) ResetMonthday() {
+	m.monthday = nil
+	m.addmonthday = nil
 }
 
-// RepositoryCleared reports if the "repository" edge to the Repository entity was cleared.
-func (m *NotificationMutation) RepositoryCleared() bool {
-	return m.clearedrepository
+// SetMonthlyAt sets the "monthly_at" field.
+func (m *BackupScheduleMutation) SetMonthlyAt(t time.Time) {
+	m.monthly_at = &t
 }
 
-// RepositoryID returns the "repository" edge ID in the mutation.
-func (m *NotificationMutation) RepositoryID() (id int, exists bool) {
-	if m.repository != nil {
-		return *m.repository, true
+// MonthlyAt returns the value of the "monthly_at" field in the mutation.
+func (m *BackupScheduleMutation) MonthlyAt() (r time.Time, exists bool) {
+	v := m.monthly_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RepositoryIDs returns the "repository" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RepositoryID instead. It exists only for internal usage by the builders.
-func (m *NotificationMutation) RepositoryIDs() (ids []int) {
-	if id := m.repository; id != nil {
-		ids = append(ids, *id)
+// OldMonthlyAt returns the old "monthly_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldMonthlyAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyAt: %w", err)
+	}
+	return oldValue.MonthlyAt, nil
 }
 
-// ResetRepository resets all changes to the "repository" edge.
-func (m *NotificationMutation) ResetRepository() {
-	m.repository = nil
-	m.clearedrepository = false
+// ResetMonthlyAt resets all changes to the "monthly_at" field.
+func (m *BackupScheduleMutation) ResetMonthlyAt() {
+	m.monthly_at = nil
 }
 
-// Where appends a list predicates to the NotificationMutation builder.
-func (m *NotificationMutation) Where(ps ...predicate.Notification) {
-	m.predicates = append(m.predicates, ps...)
+// SetCronExpressions sets the "cron_expressions" field.
+func (m *BackupScheduleMutation) SetCronExpressions(s []string) {
+	m.cron_expressions = &s
+	m.appendcron_expressions = nil
 }
 
-// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Notification, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// CronExpressions returns the value of the "cron_expressions" field in the mutation.
+func (m *BackupScheduleMutation) CronExpressions() (r []string, exists bool) {
+	v := m.cron_expressions
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *NotificationMutation) Op() Op {
-	return m.op
+// OldCronExpressions returns the old "cron_expressions" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldCronExpressions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCronExpressions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCronExpressions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCronExpressions: %w", err)
+	}
+	return oldValue.CronExpressions, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *NotificationMutation) SetOp(op Op) {
-	m.op = op
+// AppendCronExpressions adds s to the "cron_expressions" field.
+func (m *BackupScheduleMutation) AppendCronExpressions(s []string) {
+	m.appendcron_expressions = append(m.appendcron_expressions, s...)
 }
 
-// Type returns the node type of this mutation (Notification).
-func (m *NotificationMutation) Type() string {
-	return m.typ
+// AppendedCronExpressions returns the list of values that were appended to the "cron_expressions" field in this mutation.
+func (m *BackupScheduleMutation) AppendedCronExpressions() ([]string, bool) {
+	if len(m.appendcron_expressions) == 0 {
+		return nil, false
+	}
+	return m.appendcron_expressions, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *NotificationMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.created_at != nil {
-		fields = append(fields, notification.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, notification.FieldUpdatedAt)
-	}
-	if m.message != nil {
-		fields = append(fields, notification.FieldMessage)
-	}
-	if m._type != nil {
-		fields = append(fields, notification.FieldType)
-	}
-	if m.seen != nil {
-		fields = append(fields, notification.FieldSeen)
-	}
-	if m.action != nil {
-		fields = append(fields, notification.FieldAction)
-	}
-	return fields
+// ClearCronExpressions clears the value of the "cron_expressions" field.
+func (m *BackupScheduleMutation) ClearCronExpressions() {
+	m.cron_expressions = nil
+	m.appendcron_expressions = nil
+	m.clearedFields[backupschedule.FieldCronExpressions] = struct{}{}
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case notification.FieldCreatedAt:
-		return m.CreatedAt()
-	case notification.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case notification.FieldMessage:
-		return m.Message()
-	case notification.FieldType:
-		return m.GetType()
-	case notification.FieldSeen:
-		return m.Seen()
-	case notification.FieldAction:
-		return m.Action()
-	}
-	return nil, false
+// CronExpressionsCleared returns if the "cron_expressions" field was cleared in this mutation.
+func (m *BackupScheduleMutation) CronExpressionsCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldCronExpressions]
+	return ok
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case notification.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case notification.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case notification.FieldMessage:
-		return m.OldMessage(ctx)
-	case notification.FieldType:
-		return m.OldType(ctx)
-	case notification.FieldSeen:
-		return m.OldSeen(ctx)
-	case notification.FieldAction:
-		return m.OldAction(ctx)
+// ResetCronExpressions resets all changes to the "cron_expressions" field.
+func (m *BackupScheduleMutation) ResetCronExpressions() {
+	m.cron_expressions = nil
+	m.appendcron_expressions = nil
+	delete(m.clearedFields, backupschedule.FieldCronExpressions)
+}
+
+// SetCatchUpPolicy sets the "catch_up_policy" field.
+func (m *BackupScheduleMutation) SetCatchUpPolicy(bup backupschedule.CatchUpPolicy) {
+	m.catch_up_policy = &bup
+}
+
+// CatchUpPolicy returns the value of the "catch_up_policy" field in the mutation.
+func (m *BackupScheduleMutation) CatchUpPolicy() (r backupschedule.CatchUpPolicy, exists bool) {
+	v := m.catch_up_policy
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Notification field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case notification.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case notification.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case notification.FieldMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessage(v)
-		return nil
-	case notification.FieldType:
-		v, ok := value.(notification.Type)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetType(v)
-		return nil
-	case notification.FieldSeen:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSeen(v)
-		return nil
-	case notification.FieldAction:
-		v, ok := value.(notification.Action)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAction(v)
-		return nil
+// OldCatchUpPolicy returns the old "catch_up_policy" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldCatchUpPolicy(ctx context.Context) (v backupschedule.CatchUpPolicy, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatchUpPolicy is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatchUpPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatchUpPolicy: %w", err)
+	}
+	return oldValue.CatchUpPolicy, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *NotificationMutation) AddedFields() []string {
-	return nil
+// ResetCatchUpPolicy resets all changes to the "catch_up_policy" field.
+func (m *BackupScheduleMutation) ResetCatchUpPolicy() {
+	m.catch_up_policy = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetCatchUpDelayMinutes sets the "catch_up_delay_minutes" field.
+func (m *BackupScheduleMutation) SetCatchUpDelayMinutes(u uint16) {
+	m.catch_up_delay_minutes = &u
+	m.addcatch_up_delay_minutes = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *NotificationMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// CatchUpDelayMinutes returns the value of the "catch_up_delay_minutes" field in the mutation.
+func (m *BackupScheduleMutation) CatchUpDelayMinutes() (r uint16, exists bool) {
+	v := m.catch_up_delay_minutes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Notification numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *NotificationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(notification.FieldAction) {
-		fields = append(fields, notification.FieldAction)
+// OldCatchUpDelayMinutes returns the old "catch_up_delay_minutes" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldCatchUpDelayMinutes(ctx context.Context) (v uint16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCatchUpDelayMinutes is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCatchUpDelayMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCatchUpDelayMinutes: %w", err)
+	}
+	return oldValue.CatchUpDelayMinutes, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *NotificationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AddCatchUpDelayMinutes adds u to the "catch_up_delay_minutes" field.
+func (m *BackupScheduleMutation) AddCatchUpDelayMinutes(u int16) {
+	if m.addcatch_up_delay_minutes != nil {
+		*m.addcatch_up_delay_minutes += u
+	} else {
+		m.addcatch_up_delay_minutes = &u
+	}
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *NotificationMutation) ClearField(name string) error {
-	switch name {
-	case notification.FieldAction:
-		m.ClearAction()
-		return nil
+// AddedCatchUpDelayMinutes returns the value that was added to the "catch_up_delay_minutes" field in this mutation.
+func (m *BackupScheduleMutation) AddedCatchUpDelayMinutes() (r int16, exists bool) {
+	v := m.addcatch_up_delay_minutes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Notification nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *NotificationMutation) ResetField(name string) error {
-	switch name {
-	case notification.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case notification.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case notification.FieldMessage:
-		m.ResetMessage()
-		return nil
-	case notification.FieldType:
-		m.ResetType()
-		return nil
-	case notification.FieldSeen:
-		m.ResetSeen()
-		return nil
-	case notification.FieldAction:
-		m.ResetAction()
-		return nil
+// ResetCatchUpDelayMinutes resets all changes to the "catch_up_delay_minutes" field.
+func (m *BackupScheduleMutation) ResetCatchUpDelayMinutes() {
+	m.catch_up_delay_minutes = nil
+	m.addcatch_up_delay_minutes = nil
+}
+
+// SetJitterMinutes sets the "jitter_minutes" field.
+func (m *BackupScheduleMutation) SetJitterMinutes(u uint16) {
+	m.jitter_minutes = &u
+	m.addjitter_minutes = nil
+}
+
+// JitterMinutes returns the value of the "jitter_minutes" field in the mutation.
+func (m *BackupScheduleMutation) JitterMinutes() (r uint16, exists bool) {
+	v := m.jitter_minutes
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Notification field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *NotificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.backup_profile != nil {
-		edges = append(edges, notification.EdgeBackupProfile)
+// OldJitterMinutes returns the old "jitter_minutes" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldJitterMinutes(ctx context.Context) (v uint16, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJitterMinutes is only allowed on UpdateOne operations")
 	}
-	if m.repository != nil {
-		edges = append(edges, notification.EdgeRepository)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJitterMinutes requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJitterMinutes: %w", err)
+	}
+	return oldValue.JitterMinutes, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case notification.EdgeBackupProfile:
-		if id := m.backup_profile; id != nil {
-			return []ent.Value{*id}
-		}
-	case notification.EdgeRepository:
-		if id := m.repository; id != nil {
-			return []ent.Value{*id}
-		}
+// AddJitterMinutes adds u to the "jitter_minutes" field.
+func (m *BackupScheduleMutation) AddJitterMinutes(u int16) {
+	if m.addjitter_minutes != nil {
+		*m.addjitter_minutes += u
+	} else {
+		m.addjitter_minutes = &u
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *NotificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// AddedJitterMinutes returns the value that was added to the "jitter_minutes" field in this mutation.
+func (m *BackupScheduleMutation) AddedJitterMinutes() (r int16, exists bool) {
+	v := m.addjitter_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetJitterMinutes resets all changes to the "jitter_minutes" field.
+func (m *BackupScheduleMutation) ResetJitterMinutes() {
+	m.jitter_minutes = nil
+	m.addjitter_minutes = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *NotificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedbackup_profile {
-		edges = append(edges, notification.EdgeBackupProfile)
-	}
-	if m.clearedrepository {
-		edges = append(edges, notification.EdgeRepository)
-	}
-	return edges
+// SetAllowedHours sets the "allowed_hours" field.
+func (m *BackupScheduleMutation) SetAllowedHours(tw []types.TimeWindow) {
+	m.allowed_hours = &tw
+	m.appendallowed_hours = nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *NotificationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case notification.EdgeBackupProfile:
-		return m.clearedbackup_profile
-	case notification.EdgeRepository:
-		return m.clearedrepository
+// AllowedHours returns the value of the "allowed_hours" field in the mutation.
+func (m *BackupScheduleMutation) AllowedHours() (r []types.TimeWindow, exists bool) {
+	v := m.allowed_hours
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *NotificationMutation) ClearEdge(name string) error {
-	switch name {
-	case notification.EdgeBackupProfile:
-		m.ClearBackupProfile()
-		return nil
-	case notification.EdgeRepository:
-		m.ClearRepository()
-		return nil
+// OldAllowedHours returns the old "allowed_hours" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldAllowedHours(ctx context.Context) (v []types.TimeWindow, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAllowedHours is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown Notification unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAllowedHours requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAllowedHours: %w", err)
+	}
+	return oldValue.AllowedHours, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *NotificationMutation) ResetEdge(name string) error {
-	switch name {
-	case notification.EdgeBackupProfile:
-		m.ResetBackupProfile()
-		return nil
-	case notification.EdgeRepository:
-		m.ResetRepository()
-		return nil
+// AppendAllowedHours adds tw to the "allowed_hours" field.
+func (m *BackupScheduleMutation) AppendAllowedHours(tw []types.TimeWindow) {
+	m.appendallowed_hours = append(m.appendallowed_hours, tw...)
+}
+
+// AppendedAllowedHours returns the list of values that were appended to the "allowed_hours" field in this mutation.
+func (m *BackupScheduleMutation) AppendedAllowedHours() ([]types.TimeWindow, bool) {
+	if len(m.appendallowed_hours) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown Notification edge %s", name)
+	return m.appendallowed_hours, true
 }
 
-// PruningRuleMutation represents an operation that mutates the PruningRule nodes in the graph.
-type PruningRuleMutation struct {
-	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	is_enabled            *bool
-	keep_hourly           *int
-	addkeep_hourly        *int
-	keep_daily            *int
-	addkeep_daily         *int
-	keep_weekly           *int
-	addkeep_weekly        *int
-	keep_monthly          *int
-	addkeep_monthly       *int
-	keep_yearly           *int
-	addkeep_yearly        *int
-	keep_within_days      *int
-	addkeep_within_days   *int
-	next_run              *time.Time
-	last_run              *time.Time
-	last_run_status       *string
-	clearedFields         map[string]struct{}
-	backup_profile        *int
-	clearedbackup_profile bool
-	done                  bool
-	oldValue              func(context.Context) (*PruningRule, error)
-	predicates            []predicate.PruningRule
+// ClearAllowedHours clears the value of the "allowed_hours" field.
+func (m *BackupScheduleMutation) ClearAllowedHours() {
+	m.allowed_hours = nil
+	m.appendallowed_hours = nil
+	m.clearedFields[backupschedule.FieldAllowedHours] = struct{}{}
 }
 
-var _ ent.Mutation = (*PruningRuleMutation)(nil)
+// AllowedHoursCleared returns if the "allowed_hours" field was cleared in this mutation.
+func (m *BackupScheduleMutation) AllowedHoursCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldAllowedHours]
+	return ok
+}
 
-// pruningruleOption allows management of the mutation configuration using functional options.
-type pruningruleOption func(*PruningRuleMutation)
+// ResetAllowedHours resets all changes to the "allowed_hours" field.
+func (m *BackupScheduleMutation) ResetAllowedHours() {
+	m.allowed_hours = nil
+	m.appendallowed_hours = nil
+	delete(m.clearedFields, backupschedule.FieldAllowedHours)
+}
 
-// newPruningRuleMutation creates new mutation for the PruningRule entity.
-func newPruningRuleMutation(c config, op Op, opts ...pruningruleOption) *PruningRuleMutation {
-	m := &PruningRuleMutation{
-		config:        c,
-		op:            op,
-		typ:           TypePruningRule,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetBlackoutPeriods sets the "blackout_periods" field.
+func (m *BackupScheduleMutation) SetBlackoutPeriods(tw []types.TimeWindow) {
+	m.blackout_periods = &tw
+	m.appendblackout_periods = nil
 }
 
-// withPruningRuleID sets the ID field of the mutation.
-func withPruningRuleID(id int) pruningruleOption {
-	return func(m *PruningRuleMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *PruningRule
-		)
-		m.oldValue = func(ctx context.Context) (*PruningRule, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().PruningRule.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// BlackoutPeriods returns the value of the "blackout_periods" field in the mutation.
+func (m *BackupScheduleMutation) BlackoutPeriods() (r []types.TimeWindow, exists bool) {
+	v := m.blackout_periods
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withPruningRule sets the old PruningRule of the mutation.
-func withPruningRule(node *PruningRule) pruningruleOption {
-	return func(m *PruningRuleMutation) {
-		m.oldValue = func(context.Context) (*PruningRule, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldBlackoutPeriods returns the old "blackout_periods" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldBlackoutPeriods(ctx context.Context) (v []types.TimeWindow, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlackoutPeriods is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlackoutPeriods requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlackoutPeriods: %w", err)
+	}
+	return oldValue.BlackoutPeriods, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PruningRuleMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AppendBlackoutPeriods adds tw to the "blackout_periods" field.
+func (m *BackupScheduleMutation) AppendBlackoutPeriods(tw []types.TimeWindow) {
+	m.appendblackout_periods = append(m.appendblackout_periods, tw...)
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m PruningRuleMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AppendedBlackoutPeriods returns the list of values that were appended to the "blackout_periods" field in this mutation.
+func (m *BackupScheduleMutation) AppendedBlackoutPeriods() ([]types.TimeWindow, bool) {
+	if len(m.appendblackout_periods) == 0 {
+		return nil, false
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return m.appendblackout_periods, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PruningRule entities.
-func (m *PruningRuleMutation) SetID(id int) {
-	m.id = &id
+// ClearBlackoutPeriods clears the value of the "blackout_periods" field.
+func (m *BackupScheduleMutation) ClearBlackoutPeriods() {
+	m.blackout_periods = nil
+	m.appendblackout_periods = nil
+	m.clearedFields[backupschedule.FieldBlackoutPeriods] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *PruningRuleMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// BlackoutPeriodsCleared returns if the "blackout_periods" field was cleared in this mutation.
+func (m *BackupScheduleMutation) BlackoutPeriodsCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldBlackoutPeriods]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *PruningRuleMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PruningRule.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetBlackoutPeriods resets all changes to the "blackout_periods" field.
+func (m *BackupScheduleMutation) ResetBlackoutPeriods() {
+	m.blackout_periods = nil
+	m.appendblackout_periods = nil
+	delete(m.clearedFields, backupschedule.FieldBlackoutPeriods)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PruningRuleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRequireAcPower sets the "require_ac_power" field.
+func (m *BackupScheduleMutation) SetRequireAcPower(b bool) {
+	m.require_ac_power = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PruningRuleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// RequireAcPower returns the value of the "require_ac_power" field in the mutation.
+func (m *BackupScheduleMutation) RequireAcPower() (r bool, exists bool) {
+	v := m.require_ac_power
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldRequireAcPower returns the old "require_ac_power" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupScheduleMutation) OldRequireAcPower(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequireAcPower is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRequireAcPower requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequireAcPower: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.RequireAcPower, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PruningRuleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetRequireAcPower resets all changes to the "require_ac_power" field.
+func (m *BackupScheduleMutation) ResetRequireAcPower() {
+	m.require_ac_power = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PruningRuleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetRequireUnmeteredNetwork sets the "require_unmetered_network" field.
+func (m *BackupScheduleMutation) SetRequireUnmeteredNetwork(b bool) {
+	m.require_unmetered_network = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PruningRuleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// RequireUnmeteredNetwork returns the value of the "require_unmetered_network" field in the mutation.
+func (m *BackupScheduleMutation) RequireUnmeteredNetwork() (r bool, exists bool) {
+	v := m.require_unmetered_network
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldRequireUnmeteredNetwork returns the old "require_unmetered_network" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackupScheduleMutation) OldRequireUnmeteredNetwork(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequireUnmeteredNetwork is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRequireUnmeteredNetwork requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequireUnmeteredNetwork: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.RequireUnmeteredNetwork, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PruningRuleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRequireUnmeteredNetwork resets all changes to the "require_unmetered_network" field.
+func (m *BackupScheduleMutation) ResetRequireUnmeteredNetwork() {
+	m.require_unmetered_network = nil
 }
 
-// SetIsEnabled sets the "is_enabled" field.
-func (m *PruningRuleMutation) SetIsEnabled(b bool) {
-	m.is_enabled = &b
+// SetRequireReachableRepository sets the "require_reachable_repository" field.
+func (m *BackupScheduleMutation) SetRequireReachableRepository(b bool) {
+	m.require_reachable_repository = &b
 }
 
-// IsEnabled returns the value of the "is_enabled" field in the mutation.
-func (m *PruningRuleMutation) IsEnabled() (r bool, exists bool) {
-	v := m.is_enabled
+// RequireReachableRepository returns the value of the "require_reachable_repository" field in the mutation.
+func (m *BackupScheduleMutation) RequireReachableRepository() (r bool, exists bool) {
+	v := m.require_reachable_repository
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnabled returns the old "is_enabled" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldRequireReachableRepository returns the old "require_reachable_repository" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+func (m *BackupScheduleMutation) OldRequireReachableRepository(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequireReachableRepository is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+		return v, errors.New("OldRequireReachableRepository requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequireReachableRepository: %w", err)
 	}
-	return oldValue.IsEnabled, nil
+	return oldValue.RequireReachableRepository, nil
 }
 
-// ResetIsEnabled resets all changes to the "is_enabled" field.
-func (m *PruningRuleMutation) ResetIsEnabled() {
-	m.is_enabled = nil
+// ResetRequireReachableRepository resets all changes to the "require_reachable_repository" field.
+func (m *BackupScheduleMutation) ResetRequireReachableRepository() {
+	m.require_reachable_repository = nil
 }
 
-// SetKeepHourly sets the "keep_hourly" field.
-func (m *PruningRuleMutation) SetKeepHourly(i int) {
-	m.keep_hourly = &i
-	m.addkeep_hourly = nil
+// SetRunOnDriveConnect sets the "run_on_drive_connect" field.
+func (m *BackupScheduleMutation) SetRunOnDriveConnect(b bool) {
+	m.run_on_drive_connect = &b
 }
 
-// KeepHourly returns the value of the "keep_hourly" field in the mutation.
-func (m *PruningRuleMutation) KeepHourly() (r int, exists bool) {
-	v := m.keep_hourly
+// RunOnDriveConnect returns the value of the "run_on_drive_connect" field in the mutation.
+func (m *BackupScheduleMutation) RunOnDriveConnect() (r bool, exists bool) {
+	v := m.run_on_drive_connect
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeepHourly returns the old "keep_hourly" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldRunOnDriveConnect returns the old "run_on_drive_connect" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepHourly(ctx context.Context) (v int, err error) {
+func (m *BackupScheduleMutation) OldRunOnDriveConnect(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepHourly is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunOnDriveConnect is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepHourly requires an ID field in the mutation")
+		return v, errors.New("OldRunOnDriveConnect requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepHourly: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunOnDriveConnect: %w", err)
 	}
-	return oldValue.KeepHourly, nil
+	return oldValue.RunOnDriveConnect, nil
 }
 
-// AddKeepHourly adds i to the "keep_hourly" field.
-func (m *PruningRuleMutation) AddKeepHourly(i int) {
-	if m.addkeep_hourly != nil {
-		*m.addkeep_hourly += i
-	} else {
-		m.addkeep_hourly = &i
-	}
+// ResetRunOnDriveConnect resets all changes to the "run_on_drive_connect" field.
+func (m *BackupScheduleMutation) ResetRunOnDriveConnect() {
+	m.run_on_drive_connect = nil
 }
 
-// AddedKeepHourly returns the value that was added to the "keep_hourly" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepHourly() (r int, exists bool) {
-	v := m.addkeep_hourly
+// SetDriveActionAfterBackup sets the "drive_action_after_backup" field.
+func (m *BackupScheduleMutation) SetDriveActionAfterBackup(baab backupschedule.DriveActionAfterBackup) {
+	m.drive_action_after_backup = &baab
+}
+
+// DriveActionAfterBackup returns the value of the "drive_action_after_backup" field in the mutation.
+func (m *BackupScheduleMutation) DriveActionAfterBackup() (r backupschedule.DriveActionAfterBackup, exists bool) {
+	v := m.drive_action_after_backup
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetKeepHourly resets all changes to the "keep_hourly" field.
-func (m *PruningRuleMutation) ResetKeepHourly() {
-	m.keep_hourly = nil
-	m.addkeep_hourly = nil
+// OldDriveActionAfterBackup returns the old "drive_action_after_backup" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackupScheduleMutation) OldDriveActionAfterBackup(ctx context.Context) (v backupschedule.DriveActionAfterBackup, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDriveActionAfterBackup is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDriveActionAfterBackup requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDriveActionAfterBackup: %w", err)
+	}
+	return oldValue.DriveActionAfterBackup, nil
 }
 
-// SetKeepDaily sets the "keep_daily" field.
-func (m *PruningRuleMutation) SetKeepDaily(i int) {
-	m.keep_daily = &i
-	m.addkeep_daily = nil
+// ResetDriveActionAfterBackup resets all changes to the "drive_action_after_backup" field.
+func (m *BackupScheduleMutation) ResetDriveActionAfterBackup() {
+	m.drive_action_after_backup = nil
 }
 
-// KeepDaily returns the value of the "keep_daily" field in the mutation.
-func (m *PruningRuleMutation) KeepDaily() (r int, exists bool) {
-	v := m.keep_daily
+// SetNextRun sets the "next_run" field.
+func (m *BackupScheduleMutation) SetNextRun(t time.Time) {
+	m.next_run = &t
+}
+
+// NextRun returns the value of the "next_run" field in the mutation.
+func (m *BackupScheduleMutation) NextRun() (r time.Time, exists bool) {
+	v := m.next_run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeepDaily returns the old "keep_daily" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldNextRun returns the old "next_run" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepDaily(ctx context.Context) (v int, err error) {
+func (m *BackupScheduleMutation) OldNextRun(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepDaily is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextRun is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepDaily requires an ID field in the mutation")
+		return v, errors.New("OldNextRun requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepDaily: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextRun: %w", err)
 	}
-	return oldValue.KeepDaily, nil
+	return oldValue.NextRun, nil
 }
 
-// AddKeepDaily adds i to the "keep_daily" field.
-func (m *PruningRuleMutation) AddKeepDaily(i int) {
-	if m.addkeep_daily != nil {
-		*m.addkeep_daily += i
-	} else {
-		m.addkeep_daily = &i
-	}
+// ClearNextRun clears the value of the "next_run" field.
+func (m *BackupScheduleMutation) ClearNextRun() {
+	m.next_run = nil
+	m.clearedFields[backupschedule.FieldNextRun] = struct{}{}
 }
 
-// AddedKeepDaily returns the value that was added to the "keep_daily" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepDaily() (r int, exists bool) {
-	v := m.addkeep_daily
-	if v == nil {
-		return
-	}
-	return *v, true
+// NextRunCleared returns if the "next_run" field was cleared in this mutation.
+func (m *BackupScheduleMutation) NextRunCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldNextRun]
+	return ok
 }
 
-// ResetKeepDaily resets all changes to the "keep_daily" field.
-func (m *PruningRuleMutation) ResetKeepDaily() {
-	m.keep_daily = nil
-	m.addkeep_daily = nil
+// ResetNextRun resets all changes to the "next_run" field.
+func (m *BackupScheduleMutation) ResetNextRun() {
+	m.next_run = nil
+	delete(m.clearedFields, backupschedule.FieldNextRun)
 }
 
-// SetKeepWeekly sets the "keep_weekly" field.
-func (m *PruningRuleMutation) SetKeepWeekly(i int) {
-	m.keep_weekly = &i
-	m.addkeep_weekly = nil
+// SetCatchUpAt sets the "catch_up_at" field.
+func (m *BackupScheduleMutation) SetCatchUpAt(t time.Time) {
+	m.catch_up_at = &t
 }
 
-// KeepWeekly returns the value of the "keep_weekly" field in the mutation.
-func (m *PruningRuleMutation) KeepWeekly() (r int, exists bool) {
-	v := m.keep_weekly
+// CatchUpAt returns the value of the "catch_up_at" field in the mutation.
+func (m *BackupScheduleMutation) CatchUpAt() (r time.Time, exists bool) {
+	v := m.catch_up_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeepWeekly returns the old "keep_weekly" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldCatchUpAt returns the old "catch_up_at" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepWeekly(ctx context.Context) (v int, err error) {
+func (m *BackupScheduleMutation) OldCatchUpAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepWeekly is only allowed on UpdateOne operations")
+		return v, errors.New("OldCatchUpAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepWeekly requires an ID field in the mutation")
+		return v, errors.New("OldCatchUpAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepWeekly: %w", err)
+		return v, fmt.Errorf("querying old value for OldCatchUpAt: %w", err)
 	}
-	return oldValue.KeepWeekly, nil
+	return oldValue.CatchUpAt, nil
 }
 
-// AddKeepWeekly adds i to the "keep_weekly" field.
-func (m *PruningRuleMutation) AddKeepWeekly(i int) {
-	if m.addkeep_weekly != nil {
-		*m.addkeep_weekly += i
-	} else {
-		m.addkeep_weekly = &i
-	}
+// ClearCatchUpAt clears the value of the "catch_up_at" field.
+func (m *BackupScheduleMutation) ClearCatchUpAt() {
+	m.catch_up_at = nil
+	m.clearedFields[backupschedule.FieldCatchUpAt] = struct{}{}
 }
 
-// AddedKeepWeekly returns the value that was added to the "keep_weekly" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepWeekly() (r int, exists bool) {
-	v := m.addkeep_weekly
-	if v == nil {
-		return
-	}
-	return *v, true
+// CatchUpAtCleared returns if the "catch_up_at" field was cleared in this mutation.
+func (m *BackupScheduleMutation) CatchUpAtCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldCatchUpAt]
+	return ok
 }
 
-// ResetKeepWeekly resets all changes to the "keep_weekly" field.
-func (m *PruningRuleMutation) ResetKeepWeekly() {
-	m.keep_weekly = nil
-	m.addkeep_weekly = nil
+// ResetCatchUpAt resets all changes to the "catch_up_at" field.
+func (m *BackupScheduleMutation) ResetCatchUpAt() {
+	m.catch_up_at = nil
+	delete(m.clearedFields, backupschedule.FieldCatchUpAt)
 }
 
-// SetKeepMonthly sets the "keep_monthly" field.
-func (m *PruningRuleMutation) SetKeepMonthly(i int) {
-	m.keep_monthly = &i
-	m.addkeep_monthly = nil
+// SetLastRun sets the "last_run" field.
+func (m *BackupScheduleMutation) SetLastRun(t time.Time) {
+	m.last_run = &t
 }
 
-// KeepMonthly returns the value of the "keep_monthly" field in the mutation.
-func (m *PruningRuleMutation) KeepMonthly() (r int, exists bool) {
-	v := m.keep_monthly
+// LastRun returns the value of the "last_run" field in the mutation.
+func (m *BackupScheduleMutation) LastRun() (r time.Time, exists bool) {
+	v := m.last_run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKeepMonthly returns the old "keep_monthly" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldLastRun returns the old "last_run" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepMonthly(ctx context.Context) (v int, err error) {
+func (m *BackupScheduleMutation) OldLastRun(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepMonthly is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastRun is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepMonthly requires an ID field in the mutation")
+		return v, errors.New("OldLastRun requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepMonthly: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastRun: %w", err)
 	}
-	return oldValue.KeepMonthly, nil
-}
-
-// AddKeepMonthly adds i to the "keep_monthly" field.
-func (m *PruningRuleMutation) AddKeepMonthly(i int) {
-	if m.addkeep_monthly != nil {
-		*m.addkeep_monthly += i
-	} else {
-		m.addkeep_monthly = &i
-	}
-}
-
-// AddedKeepMonthly returns the value that was added to the "keep_monthly" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepMonthly() (r int, exists bool) {
-	v := m.addkeep_monthly
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetKeepMonthly resets all changes to the "keep_monthly" field.
-func (m *PruningRuleMutation) ResetKeepMonthly() {
-	m.keep_monthly = nil
-	m.addkeep_monthly = nil
-}
-
-// SetKeepYearly sets the "keep_yearly" field.
-func (m *PruningRuleMutation) SetKeepYearly(i int) {
-	m.keep_yearly = &i
-	m.addkeep_yearly = nil
-}
-
-// KeepYearly returns the value of the "keep_yearly" field in the mutation.
-func (m *PruningRuleMutation) KeepYearly() (r int, exists bool) {
-	v := m.keep_yearly
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKeepYearly returns the old "keep_yearly" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepYearly(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepYearly is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepYearly requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepYearly: %w", err)
-	}
-	return oldValue.KeepYearly, nil
-}
-
-// AddKeepYearly adds i to the "keep_yearly" field.
-func (m *PruningRuleMutation) AddKeepYearly(i int) {
-	if m.addkeep_yearly != nil {
-		*m.addkeep_yearly += i
-	} else {
-		m.addkeep_yearly = &i
-	}
-}
-
-// AddedKeepYearly returns the value that was added to the "keep_yearly" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepYearly() (r int, exists bool) {
-	v := m.addkeep_yearly
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetKeepYearly resets all changes to the "keep_yearly" field.
-func (m *PruningRuleMutation) ResetKeepYearly() {
-	m.keep_yearly = nil
-	m.addkeep_yearly = nil
-}
-
-// SetKeepWithinDays sets the "keep_within_days" field.
-func (m *PruningRuleMutation) SetKeepWithinDays(i int) {
-	m.keep_within_days = &i
-	m.addkeep_within_days = nil
-}
-
-// KeepWithinDays returns the value of the "keep_within_days" field in the mutation.
-func (m *PruningRuleMutation) KeepWithinDays() (r int, exists bool) {
-	v := m.keep_within_days
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldKeepWithinDays returns the old "keep_within_days" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldKeepWithinDays(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKeepWithinDays is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKeepWithinDays requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKeepWithinDays: %w", err)
-	}
-	return oldValue.KeepWithinDays, nil
-}
-
-// AddKeepWithinDays adds i to the "keep_within_days" field.
-func (m *PruningRuleMutation) AddKeepWithinDays(i int) {
-	if m.addkeep_within_days != nil {
-		*m.addkeep_within_days += i
-	} else {
-		m.addkeep_within_days = &i
-	}
-}
-
-// AddedKeepWithinDays returns the value that was added to the "keep_within_days" field in this mutation.
-func (m *PruningRuleMutation) AddedKeepWithinDays() (r int, exists bool) {
-	v := m.addkeep_within_days
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetKeepWithinDays resets all changes to the "keep_within_days" field.
-func (m *PruningRuleMutation) ResetKeepWithinDays() {
-	m.keep_within_days = nil
-	m.addkeep_within_days = nil
-}
-
-// SetNextRun sets the "next_run" field.
-func (m *PruningRuleMutation) SetNextRun(t time.Time) {
-	m.next_run = &t
-}
-
-// NextRun returns the value of the "next_run" field in the mutation.
-func (m *PruningRuleMutation) NextRun() (r time.Time, exists bool) {
-	v := m.next_run
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldNextRun returns the old "next_run" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldNextRun(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNextRun is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNextRun requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNextRun: %w", err)
-	}
-	return oldValue.NextRun, nil
-}
-
-// ClearNextRun clears the value of the "next_run" field.
-func (m *PruningRuleMutation) ClearNextRun() {
-	m.next_run = nil
-	m.clearedFields[pruningrule.FieldNextRun] = struct{}{}
-}
-
-// NextRunCleared returns if the "next_run" field was cleared in this mutation.
-func (m *PruningRuleMutation) NextRunCleared() bool {
-	_, ok := m.clearedFields[pruningrule.FieldNextRun]
-	return ok
-}
-
-// ResetNextRun resets all changes to the "next_run" field.
-func (m *PruningRuleMutation) ResetNextRun() {
-	m.next_run = nil
-	delete(m.clearedFields, pruningrule.FieldNextRun)
-}
-
-// SetLastRun sets the "last_run" field.
-func (m *PruningRuleMutation) SetLastRun(t time.Time) {
-	m.last_run = &t
-}
-
-// LastRun returns the value of the "last_run" field in the mutation.
-func (m *PruningRuleMutation) LastRun() (r time.Time, exists bool) {
-	v := m.last_run
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldLastRun returns the old "last_run" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldLastRun(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastRun is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastRun requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastRun: %w", err)
-	}
-	return oldValue.LastRun, nil
+	return oldValue.LastRun, nil
 }
 
 // ClearLastRun clears the value of the "last_run" field.
-func (m *PruningRuleMutation) ClearLastRun() {
+func (m *BackupScheduleMutation) ClearLastRun() {
 	m.last_run = nil
-	m.clearedFields[pruningrule.FieldLastRun] = struct{}{}
+	m.clearedFields[backupschedule.FieldLastRun] = struct{}{}
 }
 
 // LastRunCleared returns if the "last_run" field was cleared in this mutation.
-func (m *PruningRuleMutation) LastRunCleared() bool {
-	_, ok := m.clearedFields[pruningrule.FieldLastRun]
+func (m *BackupScheduleMutation) LastRunCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldLastRun]
 	return ok
 }
 
 // ResetLastRun resets all changes to the "last_run" field.
-func (m *PruningRuleMutation) ResetLastRun() {
+func (m *BackupScheduleMutation) ResetLastRun() {
 	m.last_run = nil
-	delete(m.clearedFields, pruningrule.FieldLastRun)
+	delete(m.clearedFields, backupschedule.FieldLastRun)
 }
 
 // SetLastRunStatus sets the "last_run_status" field.
-func (m *PruningRuleMutation) SetLastRunStatus(s string) {
+func (m *BackupScheduleMutation) SetLastRunStatus(s string) {
 	m.last_run_status = &s
 }
 
 // LastRunStatus returns the value of the "last_run_status" field in the mutation.
-func (m *PruningRuleMutation) LastRunStatus() (r string, exists bool) {
+func (m *BackupScheduleMutation) LastRunStatus() (r string, exists bool) {
 	v := m.last_run_status
 	if v == nil {
 		return
@@ -6972,10 +8262,10 @@ func (m *PruningRuleMutation) LastRunStatus() (r string, exists bool) {
 	return *v, true
 }
 
-// OldLastRunStatus returns the old "last_run_status" field's value of the PruningRule entity.
-// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// OldLastRunStatus returns the old "last_run_status" field's value of the BackupSchedule entity.
+// If the BackupSchedule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PruningRuleMutation) OldLastRunStatus(ctx context.Context) (v *string, err error) {
+func (m *BackupScheduleMutation) OldLastRunStatus(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldLastRunStatus is only allowed on UpdateOne operations")
 	}
@@ -6990,40 +8280,40 @@ func (m *PruningRuleMutation) OldLastRunStatus(ctx context.Context) (v *string,
 }
 
 // ClearLastRunStatus clears the value of the "last_run_status" field.
-func (m *PruningRuleMutation) ClearLastRunStatus() {
+func (m *BackupScheduleMutation) ClearLastRunStatus() {
 	m.last_run_status = nil
-	m.clearedFields[pruningrule.FieldLastRunStatus] = struct{}{}
+	m.clearedFields[backupschedule.FieldLastRunStatus] = struct{}{}
 }
 
 // LastRunStatusCleared returns if the "last_run_status" field was cleared in this mutation.
-func (m *PruningRuleMutation) LastRunStatusCleared() bool {
-	_, ok := m.clearedFields[pruningrule.FieldLastRunStatus]
+func (m *BackupScheduleMutation) LastRunStatusCleared() bool {
+	_, ok := m.clearedFields[backupschedule.FieldLastRunStatus]
 	return ok
 }
 
 // ResetLastRunStatus resets all changes to the "last_run_status" field.
-func (m *PruningRuleMutation) ResetLastRunStatus() {
+func (m *BackupScheduleMutation) ResetLastRunStatus() {
 	m.last_run_status = nil
-	delete(m.clearedFields, pruningrule.FieldLastRunStatus)
+	delete(m.clearedFields, backupschedule.FieldLastRunStatus)
 }
 
 // SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
-func (m *PruningRuleMutation) SetBackupProfileID(id int) {
+func (m *BackupScheduleMutation) SetBackupProfileID(id int) {
 	m.backup_profile = &id
 }
 
 // ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
-func (m *PruningRuleMutation) ClearBackupProfile() {
+func (m *BackupScheduleMutation) ClearBackupProfile() {
 	m.clearedbackup_profile = true
 }
 
 // BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
-func (m *PruningRuleMutation) BackupProfileCleared() bool {
+func (m *BackupScheduleMutation) BackupProfileCleared() bool {
 	return m.clearedbackup_profile
 }
 
 // BackupProfileID returns the "backup_profile" edge ID in the mutation.
-func (m *PruningRuleMutation) BackupProfileID() (id int, exists bool) {
+func (m *BackupScheduleMutation) BackupProfileID() (id int, exists bool) {
 	if m.backup_profile != nil {
 		return *m.backup_profile, true
 	}
@@ -7033,7 +8323,7 @@ func (m *PruningRuleMutation) BackupProfileID() (id int, exists bool) {
 // BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // BackupProfileID instead. It exists only for internal usage by the builders.
-func (m *PruningRuleMutation) BackupProfileIDs() (ids []int) {
+func (m *BackupScheduleMutation) BackupProfileIDs() (ids []int) {
 	if id := m.backup_profile; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7041,20 +8331,20 @@ func (m *PruningRuleMutation) BackupProfileIDs() (ids []int) {
 }
 
 // ResetBackupProfile resets all changes to the "backup_profile" edge.
-func (m *PruningRuleMutation) ResetBackupProfile() {
+func (m *BackupScheduleMutation) ResetBackupProfile() {
 	m.backup_profile = nil
 	m.clearedbackup_profile = false
 }
 
-// Where appends a list predicates to the PruningRuleMutation builder.
-func (m *PruningRuleMutation) Where(ps ...predicate.PruningRule) {
+// Where appends a list predicates to the BackupScheduleMutation builder.
+func (m *BackupScheduleMutation) Where(ps ...predicate.BackupSchedule) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PruningRuleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackupScheduleMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PruningRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PruningRule, len(ps))
+func (m *BackupScheduleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackupSchedule, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7062,60 +8352,96 @@ func (m *PruningRuleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PruningRuleMutation) Op() Op {
+func (m *BackupScheduleMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PruningRuleMutation) SetOp(op Op) {
+func (m *BackupScheduleMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PruningRule).
-func (m *PruningRuleMutation) Type() string {
+// Type returns the node type of this mutation (BackupSchedule).
+func (m *BackupScheduleMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PruningRuleMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+func (m *BackupScheduleMutation) Fields() []string {
+	fields := make([]string, 0, 24)
 	if m.created_at != nil {
-		fields = append(fields, pruningrule.FieldCreatedAt)
+		fields = append(fields, backupschedule.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, pruningrule.FieldUpdatedAt)
+		fields = append(fields, backupschedule.FieldUpdatedAt)
 	}
-	if m.is_enabled != nil {
-		fields = append(fields, pruningrule.FieldIsEnabled)
+	if m.mode != nil {
+		fields = append(fields, backupschedule.FieldMode)
 	}
-	if m.keep_hourly != nil {
-		fields = append(fields, pruningrule.FieldKeepHourly)
+	if m.interval_minutes != nil {
+		fields = append(fields, backupschedule.FieldIntervalMinutes)
 	}
-	if m.keep_daily != nil {
-		fields = append(fields, pruningrule.FieldKeepDaily)
+	if m.daily_at != nil {
+		fields = append(fields, backupschedule.FieldDailyAt)
 	}
-	if m.keep_weekly != nil {
-		fields = append(fields, pruningrule.FieldKeepWeekly)
+	if m.weekday != nil {
+		fields = append(fields, backupschedule.FieldWeekday)
 	}
-	if m.keep_monthly != nil {
-		fields = append(fields, pruningrule.FieldKeepMonthly)
+	if m.weekly_at != nil {
+		fields = append(fields, backupschedule.FieldWeeklyAt)
 	}
-	if m.keep_yearly != nil {
-		fields = append(fields, pruningrule.FieldKeepYearly)
+	if m.monthday != nil {
+		fields = append(fields, backupschedule.FieldMonthday)
 	}
-	if m.keep_within_days != nil {
-		fields = append(fields, pruningrule.FieldKeepWithinDays)
+	if m.monthly_at != nil {
+		fields = append(fields, backupschedule.FieldMonthlyAt)
+	}
+	if m.cron_expressions != nil {
+		fields = append(fields, backupschedule.FieldCronExpressions)
+	}
+	if m.catch_up_policy != nil {
+		fields = append(fields, backupschedule.FieldCatchUpPolicy)
+	}
+	if m.catch_up_delay_minutes != nil {
+		fields = append(fields, backupschedule.FieldCatchUpDelayMinutes)
+	}
+	if m.jitter_minutes != nil {
+		fields = append(fields, backupschedule.FieldJitterMinutes)
+	}
+	if m.allowed_hours != nil {
+		fields = append(fields, backupschedule.FieldAllowedHours)
+	}
+	if m.blackout_periods != nil {
+		fields = append(fields, backupschedule.FieldBlackoutPeriods)
+	}
+	if m.require_ac_power != nil {
+		fields = append(fields, backupschedule.FieldRequireAcPower)
+	}
+	if m.require_unmetered_network != nil {
+		fields = append(fields, backupschedule.FieldRequireUnmeteredNetwork)
+	}
+	if m.require_reachable_repository != nil {
+		fields = append(fields, backupschedule.FieldRequireReachableRepository)
+	}
+	if m.run_on_drive_connect != nil {
+		fields = append(fields, backupschedule.FieldRunOnDriveConnect)
+	}
+	if m.drive_action_after_backup != nil {
+		fields = append(fields, backupschedule.FieldDriveActionAfterBackup)
 	}
 	if m.next_run != nil {
-		fields = append(fields, pruningrule.FieldNextRun)
+		fields = append(fields, backupschedule.FieldNextRun)
+	}
+	if m.catch_up_at != nil {
+		fields = append(fields, backupschedule.FieldCatchUpAt)
 	}
 	if m.last_run != nil {
-		fields = append(fields, pruningrule.FieldLastRun)
+		fields = append(fields, backupschedule.FieldLastRun)
 	}
 	if m.last_run_status != nil {
-		fields = append(fields, pruningrule.FieldLastRunStatus)
+		fields = append(fields, backupschedule.FieldLastRunStatus)
 	}
 	return fields
 }
@@ -7123,31 +8449,55 @@ func (m *PruningRuleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PruningRuleMutation) Field(name string) (ent.Value, bool) {
+func (m *BackupScheduleMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pruningrule.FieldCreatedAt:
+	case backupschedule.FieldCreatedAt:
 		return m.CreatedAt()
-	case pruningrule.FieldUpdatedAt:
+	case backupschedule.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case pruningrule.FieldIsEnabled:
-		return m.IsEnabled()
-	case pruningrule.FieldKeepHourly:
-		return m.KeepHourly()
-	case pruningrule.FieldKeepDaily:
-		return m.KeepDaily()
-	case pruningrule.FieldKeepWeekly:
-		return m.KeepWeekly()
-	case pruningrule.FieldKeepMonthly:
-		return m.KeepMonthly()
-	case pruningrule.FieldKeepYearly:
-		return m.KeepYearly()
-	case pruningrule.FieldKeepWithinDays:
-		return m.KeepWithinDays()
-	case pruningrule.FieldNextRun:
+	case backupschedule.FieldMode:
+		return m.Mode()
+	case backupschedule.FieldIntervalMinutes:
+		return m.IntervalMinutes()
+	case backupschedule.FieldDailyAt:
+		return m.DailyAt()
+	case backupschedule.FieldWeekday:
+		return m.Weekday()
+	case backupschedule.FieldWeeklyAt:
+		return m.WeeklyAt()
+	case backupschedule.FieldMonthday:
+		return m.Monthday()
+	case backupschedule.FieldMonthlyAt:
+		return m.MonthlyAt()
+	case backupschedule.FieldCronExpressions:
+		return m.CronExpressions()
+	case backupschedule.FieldCatchUpPolicy:
+		return m.CatchUpPolicy()
+	case backupschedule.FieldCatchUpDelayMinutes:
+		return m.CatchUpDelayMinutes()
+	case backupschedule.FieldJitterMinutes:
+		return m.JitterMinutes()
+	case backupschedule.FieldAllowedHours:
+		return m.AllowedHours()
+	case backupschedule.FieldBlackoutPeriods:
+		return m.BlackoutPeriods()
+	case backupschedule.FieldRequireAcPower:
+		return m.RequireAcPower()
+	case backupschedule.FieldRequireUnmeteredNetwork:
+		return m.RequireUnmeteredNetwork()
+	case backupschedule.FieldRequireReachableRepository:
+		return m.RequireReachableRepository()
+	case backupschedule.FieldRunOnDriveConnect:
+		return m.RunOnDriveConnect()
+	case backupschedule.FieldDriveActionAfterBackup:
+		return m.DriveActionAfterBackup()
+	case backupschedule.FieldNextRun:
 		return m.NextRun()
-	case pruningrule.FieldLastRun:
+	case backupschedule.FieldCatchUpAt:
+		return m.CatchUpAt()
+	case backupschedule.FieldLastRun:
 		return m.LastRun()
-	case pruningrule.FieldLastRunStatus:
+	case backupschedule.FieldLastRunStatus:
 		return m.LastRunStatus()
 	}
 	return nil, false
@@ -7156,324 +8506,468 @@ func (m *PruningRuleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PruningRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackupScheduleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pruningrule.FieldCreatedAt:
+	case backupschedule.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pruningrule.FieldUpdatedAt:
+	case backupschedule.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case pruningrule.FieldIsEnabled:
-		return m.OldIsEnabled(ctx)
-	case pruningrule.FieldKeepHourly:
-		return m.OldKeepHourly(ctx)
-	case pruningrule.FieldKeepDaily:
-		return m.OldKeepDaily(ctx)
-	case pruningrule.FieldKeepWeekly:
-		return m.OldKeepWeekly(ctx)
-	case pruningrule.FieldKeepMonthly:
-		return m.OldKeepMonthly(ctx)
-	case pruningrule.FieldKeepYearly:
-		return m.OldKeepYearly(ctx)
-	case pruningrule.FieldKeepWithinDays:
-		return m.OldKeepWithinDays(ctx)
-	case pruningrule.FieldNextRun:
+	case backupschedule.FieldMode:
+		return m.OldMode(ctx)
+	case backupschedule.FieldIntervalMinutes:
+		return m.OldIntervalMinutes(ctx)
+	case backupschedule.FieldDailyAt:
+		return m.OldDailyAt(ctx)
+	case backupschedule.FieldWeekday:
+		return m.OldWeekday(ctx)
+	case backupschedule.FieldWeeklyAt:
+		return m.OldWeeklyAt(ctx)
+	case backupschedule.FieldMonthday:
+		return m.OldMonthday(ctx)
+	case backupschedule.FieldMonthlyAt:
+		return m.OldMonthlyAt(ctx)
+	case backupschedule.FieldCronExpressions:
+		return m.OldCronExpressions(ctx)
+	case backupschedule.FieldCatchUpPolicy:
+		return m.OldCatchUpPolicy(ctx)
+	case backupschedule.FieldCatchUpDelayMinutes:
+		return m.OldCatchUpDelayMinutes(ctx)
+	case backupschedule.FieldJitterMinutes:
+		return m.OldJitterMinutes(ctx)
+	case backupschedule.FieldAllowedHours:
+		return m.OldAllowedHours(ctx)
+	case backupschedule.FieldBlackoutPeriods:
+		return m.OldBlackoutPeriods(ctx)
+	case backupschedule.FieldRequireAcPower:
+		return m.OldRequireAcPower(ctx)
+	case backupschedule.FieldRequireUnmeteredNetwork:
+		return m.OldRequireUnmeteredNetwork(ctx)
+	case backupschedule.FieldRequireReachableRepository:
+		return m.OldRequireReachableRepository(ctx)
+	case backupschedule.FieldRunOnDriveConnect:
+		return m.OldRunOnDriveConnect(ctx)
+	case backupschedule.FieldDriveActionAfterBackup:
+		return m.OldDriveActionAfterBackup(ctx)
+	case backupschedule.FieldNextRun:
 		return m.OldNextRun(ctx)
-	case pruningrule.FieldLastRun:
+	case backupschedule.FieldCatchUpAt:
+		return m.OldCatchUpAt(ctx)
+	case backupschedule.FieldLastRun:
 		return m.OldLastRun(ctx)
-	case pruningrule.FieldLastRunStatus:
+	case backupschedule.FieldLastRunStatus:
 		return m.OldLastRunStatus(ctx)
 	}
-	return nil, fmt.Errorf("unknown PruningRule field %s", name)
+	return nil, fmt.Errorf("unknown BackupSchedule field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PruningRuleMutation) SetField(name string, value ent.Value) error {
+func (m *BackupScheduleMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pruningrule.FieldCreatedAt:
+	case backupschedule.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pruningrule.FieldUpdatedAt:
+	case backupschedule.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case pruningrule.FieldIsEnabled:
-		v, ok := value.(bool)
+	case backupschedule.FieldMode:
+		v, ok := value.(backupschedule.Mode)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsEnabled(v)
+		m.SetMode(v)
 		return nil
-	case pruningrule.FieldKeepHourly:
-		v, ok := value.(int)
+	case backupschedule.FieldIntervalMinutes:
+		v, ok := value.(uint16)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepHourly(v)
+		m.SetIntervalMinutes(v)
 		return nil
-	case pruningrule.FieldKeepDaily:
-		v, ok := value.(int)
+	case backupschedule.FieldDailyAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepDaily(v)
+		m.SetDailyAt(v)
 		return nil
-	case pruningrule.FieldKeepWeekly:
-		v, ok := value.(int)
+	case backupschedule.FieldWeekday:
+		v, ok := value.(backupschedule.Weekday)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepWeekly(v)
+		m.SetWeekday(v)
 		return nil
-	case pruningrule.FieldKeepMonthly:
-		v, ok := value.(int)
+	case backupschedule.FieldWeeklyAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepMonthly(v)
+		m.SetWeeklyAt(v)
 		return nil
-	case pruningrule.FieldKeepYearly:
-		v, ok := value.(int)
+	case backupschedule.FieldMonthday:
+		v, ok := value.(uint8)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepYearly(v)
+		m.SetMonthday(v)
 		return nil
-	case pruningrule.FieldKeepWithinDays:
-		v, ok := value.(int)
+	case backupschedule.FieldMonthlyAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKeepWithinDays(v)
+		m.SetMonthlyAt(v)
 		return nil
-	case pruningrule.FieldNextRun:
-		v, ok := value.(time.Time)
+	case backupschedule.FieldCronExpressions:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNextRun(v)
+		m.SetCronExpressions(v)
 		return nil
-	case pruningrule.FieldLastRun:
-		v, ok := value.(time.Time)
+	case backupschedule.FieldCatchUpPolicy:
+		v, ok := value.(backupschedule.CatchUpPolicy)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastRun(v)
+		m.SetCatchUpPolicy(v)
 		return nil
-	case pruningrule.FieldLastRunStatus:
-		v, ok := value.(string)
+	case backupschedule.FieldCatchUpDelayMinutes:
+		v, ok := value.(uint16)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastRunStatus(v)
+		m.SetCatchUpDelayMinutes(v)
 		return nil
-	}
-	return fmt.Errorf("unknown PruningRule field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PruningRuleMutation) AddedFields() []string {
-	var fields []string
-	if m.addkeep_hourly != nil {
-		fields = append(fields, pruningrule.FieldKeepHourly)
-	}
-	if m.addkeep_daily != nil {
-		fields = append(fields, pruningrule.FieldKeepDaily)
-	}
-	if m.addkeep_weekly != nil {
-		fields = append(fields, pruningrule.FieldKeepWeekly)
-	}
-	if m.addkeep_monthly != nil {
-		fields = append(fields, pruningrule.FieldKeepMonthly)
-	}
-	if m.addkeep_yearly != nil {
-		fields = append(fields, pruningrule.FieldKeepYearly)
-	}
-	if m.addkeep_within_days != nil {
-		fields = append(fields, pruningrule.FieldKeepWithinDays)
-	}
-	return fields
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PruningRuleMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case pruningrule.FieldKeepHourly:
-		return m.AddedKeepHourly()
-	case pruningrule.FieldKeepDaily:
-		return m.AddedKeepDaily()
-	case pruningrule.FieldKeepWeekly:
-		return m.AddedKeepWeekly()
-	case pruningrule.FieldKeepMonthly:
-		return m.AddedKeepMonthly()
-	case pruningrule.FieldKeepYearly:
-		return m.AddedKeepYearly()
-	case pruningrule.FieldKeepWithinDays:
-		return m.AddedKeepWithinDays()
-	}
-	return nil, false
-}
-
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PruningRuleMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case pruningrule.FieldKeepHourly:
-		v, ok := value.(int)
+	case backupschedule.FieldJitterMinutes:
+		v, ok := value.(uint16)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepHourly(v)
+		m.SetJitterMinutes(v)
 		return nil
-	case pruningrule.FieldKeepDaily:
-		v, ok := value.(int)
+	case backupschedule.FieldAllowedHours:
+		v, ok := value.([]types.TimeWindow)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepDaily(v)
+		m.SetAllowedHours(v)
 		return nil
-	case pruningrule.FieldKeepWeekly:
-		v, ok := value.(int)
+	case backupschedule.FieldBlackoutPeriods:
+		v, ok := value.([]types.TimeWindow)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepWeekly(v)
+		m.SetBlackoutPeriods(v)
 		return nil
-	case pruningrule.FieldKeepMonthly:
-		v, ok := value.(int)
+	case backupschedule.FieldRequireAcPower:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepMonthly(v)
+		m.SetRequireAcPower(v)
 		return nil
-	case pruningrule.FieldKeepYearly:
-		v, ok := value.(int)
+	case backupschedule.FieldRequireUnmeteredNetwork:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepYearly(v)
+		m.SetRequireUnmeteredNetwork(v)
 		return nil
-	case pruningrule.FieldKeepWithinDays:
-		v, ok := value.(int)
+	case backupschedule.FieldRequireReachableRepository:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKeepWithinDays(v)
+		m.SetRequireReachableRepository(v)
+		return nil
+	case backupschedule.FieldRunOnDriveConnect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunOnDriveConnect(v)
+		return nil
+	case backupschedule.FieldDriveActionAfterBackup:
+		v, ok := value.(backupschedule.DriveActionAfterBackup)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDriveActionAfterBackup(v)
+		return nil
+	case backupschedule.FieldNextRun:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRun(v)
+		return nil
+	case backupschedule.FieldCatchUpAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCatchUpAt(v)
+		return nil
+	case backupschedule.FieldLastRun:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRun(v)
+		return nil
+	case backupschedule.FieldLastRunStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunStatus(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PruningRule numeric field %s", name)
+	return fmt.Errorf("unknown BackupSchedule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BackupScheduleMutation) AddedFields() []string {
+	var fields []string
+	if m.addinterval_minutes != nil {
+		fields = append(fields, backupschedule.FieldIntervalMinutes)
+	}
+	if m.addmonthday != nil {
+		fields = append(fields, backupschedule.FieldMonthday)
+	}
+	if m.addcatch_up_delay_minutes != nil {
+		fields = append(fields, backupschedule.FieldCatchUpDelayMinutes)
+	}
+	if m.addjitter_minutes != nil {
+		fields = append(fields, backupschedule.FieldJitterMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BackupScheduleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backupschedule.FieldIntervalMinutes:
+		return m.AddedIntervalMinutes()
+	case backupschedule.FieldMonthday:
+		return m.AddedMonthday()
+	case backupschedule.FieldCatchUpDelayMinutes:
+		return m.AddedCatchUpDelayMinutes()
+	case backupschedule.FieldJitterMinutes:
+		return m.AddedJitterMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BackupScheduleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case backupschedule.FieldIntervalMinutes:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalMinutes(v)
+		return nil
+	case backupschedule.FieldMonthday:
+		v, ok := value.(int8)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthday(v)
+		return nil
+	case backupschedule.FieldCatchUpDelayMinutes:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCatchUpDelayMinutes(v)
+		return nil
+	case backupschedule.FieldJitterMinutes:
+		v, ok := value.(int16)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddJitterMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BackupSchedule numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PruningRuleMutation) ClearedFields() []string {
+func (m *BackupScheduleMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(pruningrule.FieldNextRun) {
-		fields = append(fields, pruningrule.FieldNextRun)
+	if m.FieldCleared(backupschedule.FieldCronExpressions) {
+		fields = append(fields, backupschedule.FieldCronExpressions)
 	}
-	if m.FieldCleared(pruningrule.FieldLastRun) {
-		fields = append(fields, pruningrule.FieldLastRun)
+	if m.FieldCleared(backupschedule.FieldAllowedHours) {
+		fields = append(fields, backupschedule.FieldAllowedHours)
 	}
-	if m.FieldCleared(pruningrule.FieldLastRunStatus) {
-		fields = append(fields, pruningrule.FieldLastRunStatus)
+	if m.FieldCleared(backupschedule.FieldBlackoutPeriods) {
+		fields = append(fields, backupschedule.FieldBlackoutPeriods)
+	}
+	if m.FieldCleared(backupschedule.FieldNextRun) {
+		fields = append(fields, backupschedule.FieldNextRun)
+	}
+	if m.FieldCleared(backupschedule.FieldCatchUpAt) {
+		fields = append(fields, backupschedule.FieldCatchUpAt)
+	}
+	if m.FieldCleared(backupschedule.FieldLastRun) {
+		fields = append(fields, backupschedule.FieldLastRun)
+	}
+	if m.FieldCleared(backupschedule.FieldLastRunStatus) {
+		fields = append(fields, backupschedule.FieldLastRunStatus)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PruningRuleMutation) FieldCleared(name string) bool {
+func (m *BackupScheduleMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PruningRuleMutation) ClearField(name string) error {
+func (m *BackupScheduleMutation) ClearField(name string) error {
 	switch name {
-	case pruningrule.FieldNextRun:
+	case backupschedule.FieldCronExpressions:
+		m.ClearCronExpressions()
+		return nil
+	case backupschedule.FieldAllowedHours:
+		m.ClearAllowedHours()
+		return nil
+	case backupschedule.FieldBlackoutPeriods:
+		m.ClearBlackoutPeriods()
+		return nil
+	case backupschedule.FieldNextRun:
 		m.ClearNextRun()
 		return nil
-	case pruningrule.FieldLastRun:
+	case backupschedule.FieldCatchUpAt:
+		m.ClearCatchUpAt()
+		return nil
+	case backupschedule.FieldLastRun:
 		m.ClearLastRun()
 		return nil
-	case pruningrule.FieldLastRunStatus:
+	case backupschedule.FieldLastRunStatus:
 		m.ClearLastRunStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown PruningRule nullable field %s", name)
+	return fmt.Errorf("unknown BackupSchedule nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PruningRuleMutation) ResetField(name string) error {
+func (m *BackupScheduleMutation) ResetField(name string) error {
 	switch name {
-	case pruningrule.FieldCreatedAt:
+	case backupschedule.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pruningrule.FieldUpdatedAt:
+	case backupschedule.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case pruningrule.FieldIsEnabled:
-		m.ResetIsEnabled()
+	case backupschedule.FieldMode:
+		m.ResetMode()
 		return nil
-	case pruningrule.FieldKeepHourly:
-		m.ResetKeepHourly()
+	case backupschedule.FieldIntervalMinutes:
+		m.ResetIntervalMinutes()
 		return nil
-	case pruningrule.FieldKeepDaily:
-		m.ResetKeepDaily()
+	case backupschedule.FieldDailyAt:
+		m.ResetDailyAt()
 		return nil
-	case pruningrule.FieldKeepWeekly:
-		m.ResetKeepWeekly()
+	case backupschedule.FieldWeekday:
+		m.ResetWeekday()
 		return nil
-	case pruningrule.FieldKeepMonthly:
-		m.ResetKeepMonthly()
+	case backupschedule.FieldWeeklyAt:
+		m.ResetWeeklyAt()
 		return nil
-	case pruningrule.FieldKeepYearly:
-		m.ResetKeepYearly()
+	case backupschedule.FieldMonthday:
+		m.ResetMonthday()
 		return nil
-	case pruningrule.FieldKeepWithinDays:
-		m.ResetKeepWithinDays()
+	case backupschedule.FieldMonthlyAt:
+		m.ResetMonthlyAt()
 		return nil
-	case pruningrule.FieldNextRun:
+	case backupschedule.FieldCronExpressions:
+		m.ResetCronExpressions()
+		return nil
+	case backupschedule.FieldCatchUpPolicy:
+		m.ResetCatchUpPolicy()
+		return nil
+	case backupschedule.FieldCatchUpDelayMinutes:
+		m.ResetCatchUpDelayMinutes()
+		return nil
+	case backupschedule.FieldJitterMinutes:
+		m.ResetJitterMinutes()
+		return nil
+	case backupschedule.FieldAllowedHours:
+		m.ResetAllowedHours()
+		return nil
+	case backupschedule.FieldBlackoutPeriods:
+		m.ResetBlackoutPeriods()
+		return nil
+	case backupschedule.FieldRequireAcPower:
+		m.ResetRequireAcPower()
+		return nil
+	case backupschedule.FieldRequireUnmeteredNetwork:
+		m.ResetRequireUnmeteredNetwork()
+		return nil
+	case backupschedule.FieldRequireReachableRepository:
+		m.ResetRequireReachableRepository()
+		return nil
+	case backupschedule.FieldRunOnDriveConnect:
+		m.ResetRunOnDriveConnect()
+		return nil
+	case backupschedule.FieldDriveActionAfterBackup:
+		m.ResetDriveActionAfterBackup()
+		return nil
+	case backupschedule.FieldNextRun:
 		m.ResetNextRun()
 		return nil
-	case pruningrule.FieldLastRun:
+	case backupschedule.FieldCatchUpAt:
+		m.ResetCatchUpAt()
+		return nil
+	case backupschedule.FieldLastRun:
 		m.ResetLastRun()
 		return nil
-	case pruningrule.FieldLastRunStatus:
+	case backupschedule.FieldLastRunStatus:
 		m.ResetLastRunStatus()
 		return nil
 	}
-	return fmt.Errorf("unknown PruningRule field %s", name)
+	return fmt.Errorf("unknown BackupSchedule field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PruningRuleMutation) AddedEdges() []string {
+func (m *BackupScheduleMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.backup_profile != nil {
-		edges = append(edges, pruningrule.EdgeBackupProfile)
+		edges = append(edges, backupschedule.EdgeBackupProfile)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PruningRuleMutation) AddedIDs(name string) []ent.Value {
+func (m *BackupScheduleMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pruningrule.EdgeBackupProfile:
+	case backupschedule.EdgeBackupProfile:
 		if id := m.backup_profile; id != nil {
 			return []ent.Value{*id}
 		}
@@ -7482,31 +8976,31 @@ func (m *PruningRuleMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PruningRuleMutation) RemovedEdges() []string {
+func (m *BackupScheduleMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PruningRuleMutation) RemovedIDs(name string) []ent.Value {
+func (m *BackupScheduleMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PruningRuleMutation) ClearedEdges() []string {
+func (m *BackupScheduleMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.clearedbackup_profile {
-		edges = append(edges, pruningrule.EdgeBackupProfile)
+		edges = append(edges, backupschedule.EdgeBackupProfile)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PruningRuleMutation) EdgeCleared(name string) bool {
+func (m *BackupScheduleMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pruningrule.EdgeBackupProfile:
+	case backupschedule.EdgeBackupProfile:
 		return m.clearedbackup_profile
 	}
 	return false
@@ -7514,83 +9008,57 @@ func (m *PruningRuleMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PruningRuleMutation) ClearEdge(name string) error {
+func (m *BackupScheduleMutation) ClearEdge(name string) error {
 	switch name {
-	case pruningrule.EdgeBackupProfile:
+	case backupschedule.EdgeBackupProfile:
 		m.ClearBackupProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown PruningRule unique edge %s", name)
+	return fmt.Errorf("unknown BackupSchedule unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PruningRuleMutation) ResetEdge(name string) error {
+func (m *BackupScheduleMutation) ResetEdge(name string) error {
 	switch name {
-	case pruningrule.EdgeBackupProfile:
+	case backupschedule.EdgeBackupProfile:
 		m.ResetBackupProfile()
 		return nil
 	}
-	return fmt.Errorf("unknown PruningRule edge %s", name)
+	return fmt.Errorf("unknown BackupSchedule edge %s", name)
 }
 
-// RepositoryMutation represents an operation that mutates the Repository nodes in the graph.
-type RepositoryMutation struct {
-	config
-	op                           Op
-	typ                          string
-	id                           *int
-	created_at                   *time.Time
-	updated_at                   *time.Time
-	name                         *string
-	url                          *string
-	has_password                 *bool
-	last_quick_check_at          *time.Time
-	quick_check_error            *[]string
-	appendquick_check_error      []string
-	last_full_check_at           *time.Time
-	full_check_error             *[]string
-	appendfull_check_error       []string
-	stats_total_chunks           *int
-	addstats_total_chunks        *int
-	stats_total_size             *int
-	addstats_total_size          *int
-	stats_total_csize            *int
-	addstats_total_csize         *int
-	stats_total_unique_chunks    *int
-	addstats_total_unique_chunks *int
-	stats_unique_size            *int
-	addstats_unique_size         *int
-	stats_unique_csize           *int
-	addstats_unique_csize        *int
-	clearedFields                map[string]struct{}
-	backup_profiles              map[int]struct{}
-	removedbackup_profiles       map[int]struct{}
-	clearedbackup_profiles       bool
-	archives                     map[int]struct{}
-	removedarchives              map[int]struct{}
-	clearedarchives              bool
-	notifications                map[int]struct{}
-	removednotifications         map[int]struct{}
-	clearednotifications         bool
-	cloud_repository             *int
-	clearedcloud_repository      bool
-	done                         bool
-	oldValue                     func(context.Context) (*Repository, error)
-	predicates                   []predicate.Repository
+// CloudRepositoryMutation represents an operation that mutates the CloudRepository nodes in the graph.
+type CloudRepositoryMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	cloud_id              *string
+	storage_used_bytes    *int64
+	addstorage_used_bytes *int64
+	location              *cloudrepository.Location
+	clearedFields         map[string]struct{}
+	repository            *int
+	clearedrepository     bool
+	done                  bool
+	oldValue              func(context.Context) (*CloudRepository, error)
+	predicates            []predicate.CloudRepository
 }
 
-var _ ent.Mutation = (*RepositoryMutation)(nil)
+var _ ent.Mutation = (*CloudRepositoryMutation)(nil)
 
-// repositoryOption allows management of the mutation configuration using functional options.
-type repositoryOption func(*RepositoryMutation)
+// cloudrepositoryOption allows management of the mutation configuration using functional options.
+type cloudrepositoryOption func(*CloudRepositoryMutation)
 
-// newRepositoryMutation creates new mutation for the Repository entity.
-func newRepositoryMutation(c config, op Op, opts ...repositoryOption) *RepositoryMutation {
-	m := &RepositoryMutation{
+// newCloudRepositoryMutation creates new mutation for the CloudRepository entity.
+func newCloudRepositoryMutation(c config, op Op, opts ...cloudrepositoryOption) *CloudRepositoryMutation {
+	m := &CloudRepositoryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRepository,
+		typ:           TypeCloudRepository,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7599,20 +9067,20 @@ func newRepositoryMutation(c config, op Op, opts ...repositoryOption) *Repositor
 	return m
 }
 
-// withRepositoryID sets the ID field of the mutation.
-func withRepositoryID(id int) repositoryOption {
-	return func(m *RepositoryMutation) {
+// withCloudRepositoryID sets the ID field of the mutation.
+func withCloudRepositoryID(id int) cloudrepositoryOption {
+	return func(m *CloudRepositoryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Repository
+			value *CloudRepository
 		)
-		m.oldValue = func(ctx context.Context) (*Repository, error) {
+		m.oldValue = func(ctx context.Context) (*CloudRepository, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Repository.Get(ctx, id)
+					value, err = m.Client().CloudRepository.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7621,10 +9089,10 @@ func withRepositoryID(id int) repositoryOption {
 	}
 }
 
-// withRepository sets the old Repository of the mutation.
-func withRepository(node *Repository) repositoryOption {
-	return func(m *RepositoryMutation) {
-		m.oldValue = func(context.Context) (*Repository, error) {
+// withCloudRepository sets the old CloudRepository of the mutation.
+func withCloudRepository(node *CloudRepository) cloudrepositoryOption {
+	return func(m *CloudRepositoryMutation) {
+		m.oldValue = func(context.Context) (*CloudRepository, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7633,7 +9101,7 @@ func withRepository(node *Repository) repositoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RepositoryMutation) Client() *Client {
+func (m CloudRepositoryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7641,7 +9109,7 @@ func (m RepositoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RepositoryMutation) Tx() (*Tx, error) {
+func (m CloudRepositoryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7651,14 +9119,14 @@ func (m RepositoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Repository entities.
-func (m *RepositoryMutation) SetID(id int) {
+// operation is only accepted on creation of CloudRepository entities.
+func (m *CloudRepositoryMutation) SetID(id int) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RepositoryMutation) ID() (id int, exists bool) {
+func (m *CloudRepositoryMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7669,7 +9137,7 @@ func (m *RepositoryMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RepositoryMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *CloudRepositoryMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7678,19 +9146,19 @@ func (m *RepositoryMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Repository.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().CloudRepository.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *RepositoryMutation) SetCreatedAt(t time.Time) {
+func (m *CloudRepositoryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RepositoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *CloudRepositoryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7698,10 +9166,10 @@ func (m *RepositoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the CloudRepository entity.
+// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CloudRepositoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7716,17 +9184,17 @@ func (m *RepositoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RepositoryMutation) ResetCreatedAt() {
+func (m *CloudRepositoryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *RepositoryMutation) SetUpdatedAt(t time.Time) {
+func (m *CloudRepositoryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RepositoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *CloudRepositoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -7734,10 +9202,10 @@ func (m *RepositoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the CloudRepository entity.
+// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *CloudRepositoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -7752,892 +9220,5265 @@ func (m *RepositoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RepositoryMutation) ResetUpdatedAt() {
+func (m *CloudRepositoryMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetName sets the "name" field.
-func (m *RepositoryMutation) SetName(s string) {
-	m.name = &s
+// SetCloudID sets the "cloud_id" field.
+func (m *CloudRepositoryMutation) SetCloudID(s string) {
+	m.cloud_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RepositoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// CloudID returns the value of the "cloud_id" field in the mutation.
+func (m *CloudRepositoryMutation) CloudID() (r string, exists bool) {
+	v := m.cloud_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldCloudID returns the old "cloud_id" field's value of the CloudRepository entity.
+// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *CloudRepositoryMutation) OldCloudID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCloudID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCloudID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCloudID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CloudID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RepositoryMutation) ResetName() {
-	m.name = nil
+// ResetCloudID resets all changes to the "cloud_id" field.
+func (m *CloudRepositoryMutation) ResetCloudID() {
+	m.cloud_id = nil
 }
 
-// SetURL sets the "url" field.
-func (m *RepositoryMutation) SetURL(s string) {
-	m.url = &s
+// SetStorageUsedBytes sets the "storage_used_bytes" field.
+func (m *CloudRepositoryMutation) SetStorageUsedBytes(i int64) {
+	m.storage_used_bytes = &i
+	m.addstorage_used_bytes = nil
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *RepositoryMutation) URL() (r string, exists bool) {
-	v := m.url
+// StorageUsedBytes returns the value of the "storage_used_bytes" field in the mutation.
+func (m *CloudRepositoryMutation) StorageUsedBytes() (r int64, exists bool) {
+	v := m.storage_used_bytes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldStorageUsedBytes returns the old "storage_used_bytes" field's value of the CloudRepository entity.
+// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *CloudRepositoryMutation) OldStorageUsedBytes(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldStorageUsedBytes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldStorageUsedBytes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldStorageUsedBytes: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.StorageUsedBytes, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *RepositoryMutation) ResetURL() {
-	m.url = nil
+// AddStorageUsedBytes adds i to the "storage_used_bytes" field.
+func (m *CloudRepositoryMutation) AddStorageUsedBytes(i int64) {
+	if m.addstorage_used_bytes != nil {
+		*m.addstorage_used_bytes += i
+	} else {
+		m.addstorage_used_bytes = &i
+	}
 }
 
-// SetHasPassword sets the "has_password" field.
-func (m *RepositoryMutation) SetHasPassword(b bool) {
-	m.has_password = &b
+// AddedStorageUsedBytes returns the value that was added to the "storage_used_bytes" field in this mutation.
+func (m *CloudRepositoryMutation) AddedStorageUsedBytes() (r int64, exists bool) {
+	v := m.addstorage_used_bytes
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// HasPassword returns the value of the "has_password" field in the mutation.
-func (m *RepositoryMutation) HasPassword() (r bool, exists bool) {
-	v := m.has_password
+// ResetStorageUsedBytes resets all changes to the "storage_used_bytes" field.
+func (m *CloudRepositoryMutation) ResetStorageUsedBytes() {
+	m.storage_used_bytes = nil
+	m.addstorage_used_bytes = nil
+}
+
+// SetLocation sets the "location" field.
+func (m *CloudRepositoryMutation) SetLocation(c cloudrepository.Location) {
+	m.location = &c
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *CloudRepositoryMutation) Location() (r cloudrepository.Location, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldHasPassword returns the old "has_password" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the CloudRepository entity.
+// If the CloudRepository object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldHasPassword(ctx context.Context) (v bool, err error) {
+func (m *CloudRepositoryMutation) OldLocation(ctx context.Context) (v cloudrepository.Location, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldHasPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldHasPassword requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldHasPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.HasPassword, nil
+	return oldValue.Location, nil
 }
 
-// ResetHasPassword resets all changes to the "has_password" field.
-func (m *RepositoryMutation) ResetHasPassword() {
-	m.has_password = nil
+// ResetLocation resets all changes to the "location" field.
+func (m *CloudRepositoryMutation) ResetLocation() {
+	m.location = nil
 }
 
-// SetLastQuickCheckAt sets the "last_quick_check_at" field.
-func (m *RepositoryMutation) SetLastQuickCheckAt(t time.Time) {
-	m.last_quick_check_at = &t
+// SetRepositoryID sets the "repository" edge to the Repository entity by id.
+func (m *CloudRepositoryMutation) SetRepositoryID(id int) {
+	m.repository = &id
 }
 
-// LastQuickCheckAt returns the value of the "last_quick_check_at" field in the mutation.
-func (m *RepositoryMutation) LastQuickCheckAt() (r time.Time, exists bool) {
-	v := m.last_quick_check_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearRepository clears the "repository" edge to the Repository entity.
+func (m *CloudRepositoryMutation) ClearRepository() {
+	m.clearedrepository = true
 }
 
-// OldLastQuickCheckAt returns the old "last_quick_check_at" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldLastQuickCheckAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastQuickCheckAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastQuickCheckAt requires an ID field in the mutation")
+// RepositoryCleared reports if the "repository" edge to the Repository entity was cleared.
+func (m *CloudRepositoryMutation) RepositoryCleared() bool {
+	return m.clearedrepository
+}
+
+// RepositoryID returns the "repository" edge ID in the mutation.
+func (m *CloudRepositoryMutation) RepositoryID() (id int, exists bool) {
+	if m.repository != nil {
+		return *m.repository, true
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastQuickCheckAt: %w", err)
+	return
+}
+
+// RepositoryIDs returns the "repository" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RepositoryID instead. It exists only for internal usage by the builders.
+func (m *CloudRepositoryMutation) RepositoryIDs() (ids []int) {
+	if id := m.repository; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.LastQuickCheckAt, nil
+	return
 }
 
-// ClearLastQuickCheckAt clears the value of the "last_quick_check_at" field.
-func (m *RepositoryMutation) ClearLastQuickCheckAt() {
-	m.last_quick_check_at = nil
-	m.clearedFields[repository.FieldLastQuickCheckAt] = struct{}{}
+// ResetRepository resets all changes to the "repository" edge.
+func (m *CloudRepositoryMutation) ResetRepository() {
+	m.repository = nil
+	m.clearedrepository = false
 }
 
-// LastQuickCheckAtCleared returns if the "last_quick_check_at" field was cleared in this mutation.
-func (m *RepositoryMutation) LastQuickCheckAtCleared() bool {
-	_, ok := m.clearedFields[repository.FieldLastQuickCheckAt]
-	return ok
+// Where appends a list predicates to the CloudRepositoryMutation builder.
+func (m *CloudRepositoryMutation) Where(ps ...predicate.CloudRepository) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetLastQuickCheckAt resets all changes to the "last_quick_check_at" field.
-func (m *RepositoryMutation) ResetLastQuickCheckAt() {
-	m.last_quick_check_at = nil
-	delete(m.clearedFields, repository.FieldLastQuickCheckAt)
+// WhereP appends storage-level predicates to the CloudRepositoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CloudRepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CloudRepository, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetQuickCheckError sets the "quick_check_error" field.
-func (m *RepositoryMutation) SetQuickCheckError(s []string) {
-	m.quick_check_error = &s
-	m.appendquick_check_error = nil
+// Op returns the operation name.
+func (m *CloudRepositoryMutation) Op() Op {
+	return m.op
 }
 
-// QuickCheckError returns the value of the "quick_check_error" field in the mutation.
-func (m *RepositoryMutation) QuickCheckError() (r []string, exists bool) {
-	v := m.quick_check_error
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *CloudRepositoryMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldQuickCheckError returns the old "quick_check_error" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldQuickCheckError(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuickCheckError is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (CloudRepository).
+func (m *CloudRepositoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CloudRepositoryMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_at != nil {
+		fields = append(fields, cloudrepository.FieldCreatedAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuickCheckError requires an ID field in the mutation")
+	if m.updated_at != nil {
+		fields = append(fields, cloudrepository.FieldUpdatedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuickCheckError: %w", err)
+	if m.cloud_id != nil {
+		fields = append(fields, cloudrepository.FieldCloudID)
 	}
-	return oldValue.QuickCheckError, nil
-}
-
-// AppendQuickCheckError adds s to the "quick_check_error" field.
-func (m *RepositoryMutation) AppendQuickCheckError(s []string) {
-	m.appendquick_check_error = append(m.appendquick_check_error, s...)
+	if m.storage_used_bytes != nil {
+		fields = append(fields, cloudrepository.FieldStorageUsedBytes)
+	}
+	if m.location != nil {
+		fields = append(fields, cloudrepository.FieldLocation)
+	}
+	return fields
 }
 
-// AppendedQuickCheckError returns the list of values that were appended to the "quick_check_error" field in this mutation.
-func (m *RepositoryMutation) AppendedQuickCheckError() ([]string, bool) {
-	if len(m.appendquick_check_error) == 0 {
-		return nil, false
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CloudRepositoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cloudrepository.FieldCreatedAt:
+		return m.CreatedAt()
+	case cloudrepository.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case cloudrepository.FieldCloudID:
+		return m.CloudID()
+	case cloudrepository.FieldStorageUsedBytes:
+		return m.StorageUsedBytes()
+	case cloudrepository.FieldLocation:
+		return m.Location()
 	}
-	return m.appendquick_check_error, true
+	return nil, false
 }
 
-// ClearQuickCheckError clears the value of the "quick_check_error" field.
-func (m *RepositoryMutation) ClearQuickCheckError() {
-	m.quick_check_error = nil
-	m.appendquick_check_error = nil
-	m.clearedFields[repository.FieldQuickCheckError] = struct{}{}
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CloudRepositoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cloudrepository.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cloudrepository.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case cloudrepository.FieldCloudID:
+		return m.OldCloudID(ctx)
+	case cloudrepository.FieldStorageUsedBytes:
+		return m.OldStorageUsedBytes(ctx)
+	case cloudrepository.FieldLocation:
+		return m.OldLocation(ctx)
+	}
+	return nil, fmt.Errorf("unknown CloudRepository field %s", name)
 }
 
-// QuickCheckErrorCleared returns if the "quick_check_error" field was cleared in this mutation.
-func (m *RepositoryMutation) QuickCheckErrorCleared() bool {
-	_, ok := m.clearedFields[repository.FieldQuickCheckError]
-	return ok
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CloudRepositoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cloudrepository.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cloudrepository.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case cloudrepository.FieldCloudID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCloudID(v)
+		return nil
+	case cloudrepository.FieldStorageUsedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStorageUsedBytes(v)
+		return nil
+	case cloudrepository.FieldLocation:
+		v, ok := value.(cloudrepository.Location)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CloudRepository field %s", name)
 }
 
-// ResetQuickCheckError resets all changes to the "quick_check_error" field.
-func (m *RepositoryMutation) ResetQuickCheckError() {
-	m.quick_check_error = nil
-	m.appendquick_check_error = nil
-	delete(m.clearedFields, repository.FieldQuickCheckError)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CloudRepositoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addstorage_used_bytes != nil {
+		fields = append(fields, cloudrepository.FieldStorageUsedBytes)
+	}
+	return fields
 }
 
-// SetLastFullCheckAt sets the "last_full_check_at" field.
-func (m *RepositoryMutation) SetLastFullCheckAt(t time.Time) {
-	m.last_full_check_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CloudRepositoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cloudrepository.FieldStorageUsedBytes:
+		return m.AddedStorageUsedBytes()
+	}
+	return nil, false
 }
 
-// LastFullCheckAt returns the value of the "last_full_check_at" field in the mutation.
-func (m *RepositoryMutation) LastFullCheckAt() (r time.Time, exists bool) {
-	v := m.last_full_check_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CloudRepositoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cloudrepository.FieldStorageUsedBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStorageUsedBytes(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown CloudRepository numeric field %s", name)
 }
 
-// OldLastFullCheckAt returns the old "last_full_check_at" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldLastFullCheckAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastFullCheckAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CloudRepositoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CloudRepositoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CloudRepositoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown CloudRepository nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CloudRepositoryMutation) ResetField(name string) error {
+	switch name {
+	case cloudrepository.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cloudrepository.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case cloudrepository.FieldCloudID:
+		m.ResetCloudID()
+		return nil
+	case cloudrepository.FieldStorageUsedBytes:
+		m.ResetStorageUsedBytes()
+		return nil
+	case cloudrepository.FieldLocation:
+		m.ResetLocation()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastFullCheckAt requires an ID field in the mutation")
+	return fmt.Errorf("unknown CloudRepository field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CloudRepositoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.repository != nil {
+		edges = append(edges, cloudrepository.EdgeRepository)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastFullCheckAt: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CloudRepositoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cloudrepository.EdgeRepository:
+		if id := m.repository; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.LastFullCheckAt, nil
+	return nil
 }
 
-// ClearLastFullCheckAt clears the value of the "last_full_check_at" field.
-func (m *RepositoryMutation) ClearLastFullCheckAt() {
-	m.last_full_check_at = nil
-	m.clearedFields[repository.FieldLastFullCheckAt] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CloudRepositoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// LastFullCheckAtCleared returns if the "last_full_check_at" field was cleared in this mutation.
-func (m *RepositoryMutation) LastFullCheckAtCleared() bool {
-	_, ok := m.clearedFields[repository.FieldLastFullCheckAt]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CloudRepositoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetLastFullCheckAt resets all changes to the "last_full_check_at" field.
-func (m *RepositoryMutation) ResetLastFullCheckAt() {
-	m.last_full_check_at = nil
-	delete(m.clearedFields, repository.FieldLastFullCheckAt)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CloudRepositoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrepository {
+		edges = append(edges, cloudrepository.EdgeRepository)
+	}
+	return edges
 }
 
-// SetFullCheckError sets the "full_check_error" field.
-func (m *RepositoryMutation) SetFullCheckError(s []string) {
-	m.full_check_error = &s
-	m.appendfull_check_error = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CloudRepositoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cloudrepository.EdgeRepository:
+		return m.clearedrepository
+	}
+	return false
 }
 
-// FullCheckError returns the value of the "full_check_error" field in the mutation.
-func (m *RepositoryMutation) FullCheckError() (r []string, exists bool) {
-	v := m.full_check_error
-	if v == nil {
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CloudRepositoryMutation) ClearEdge(name string) error {
+	switch name {
+	case cloudrepository.EdgeRepository:
+		m.ClearRepository()
+		return nil
+	}
+	return fmt.Errorf("unknown CloudRepository unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CloudRepositoryMutation) ResetEdge(name string) error {
+	switch name {
+	case cloudrepository.EdgeRepository:
+		m.ResetRepository()
+		return nil
+	}
+	return fmt.Errorf("unknown CloudRepository edge %s", name)
+}
+
+// NotificationMutation represents an operation that mutates the Notification nodes in the graph.
+type NotificationMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	message               *string
+	_type                 *notification.Type
+	seen                  *bool
+	action                *notification.Action
+	clearedFields         map[string]struct{}
+	backup_profile        *int
+	clearedbackup_profile bool
+	repository            *int
+	clearedrepository     bool
+	done                  bool
+	oldValue              func(context.Context) (*Notification, error)
+	predicates            []predicate.Notification
+}
+
+var _ ent.Mutation = (*NotificationMutation)(nil)
+
+// notificationOption allows management of the mutation configuration using functional options.
+type notificationOption func(*NotificationMutation)
+
+// newNotificationMutation creates new mutation for the Notification entity.
+func newNotificationMutation(c config, op Op, opts ...notificationOption) *NotificationMutation {
+	m := &NotificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeNotification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withNotificationID sets the ID field of the mutation.
+func withNotificationID(id int) notificationOption {
+	return func(m *NotificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Notification
+		)
+		m.oldValue = func(ctx context.Context) (*Notification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Notification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withNotification sets the old Notification of the mutation.
+func withNotification(node *Notification) notificationOption {
+	return func(m *NotificationMutation) {
+		m.oldValue = func(context.Context) (*Notification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m NotificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m NotificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Notification entities.
+func (m *NotificationMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *NotificationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *NotificationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Notification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *NotificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *NotificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *NotificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *NotificationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *NotificationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *NotificationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *NotificationMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *NotificationMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *NotificationMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetType sets the "type" field.
+func (m *NotificationMutation) SetType(n notification.Type) {
+	m._type = &n
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *NotificationMutation) GetType() (r notification.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldType(ctx context.Context) (v notification.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *NotificationMutation) ResetType() {
+	m._type = nil
+}
+
+// SetSeen sets the "seen" field.
+func (m *NotificationMutation) SetSeen(b bool) {
+	m.seen = &b
+}
+
+// Seen returns the value of the "seen" field in the mutation.
+func (m *NotificationMutation) Seen() (r bool, exists bool) {
+	v := m.seen
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeen returns the old "seen" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldSeen(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeen: %w", err)
+	}
+	return oldValue.Seen, nil
+}
+
+// ResetSeen resets all changes to the "seen" field.
+func (m *NotificationMutation) ResetSeen() {
+	m.seen = nil
+}
+
+// SetAction sets the "action" field.
+func (m *NotificationMutation) SetAction(n notification.Action) {
+	m.action = &n
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *NotificationMutation) Action() (r notification.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the Notification entity.
+// If the Notification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *NotificationMutation) OldAction(ctx context.Context) (v notification.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ClearAction clears the value of the "action" field.
+func (m *NotificationMutation) ClearAction() {
+	m.action = nil
+	m.clearedFields[notification.FieldAction] = struct{}{}
+}
+
+// ActionCleared returns if the "action" field was cleared in this mutation.
+func (m *NotificationMutation) ActionCleared() bool {
+	_, ok := m.clearedFields[notification.FieldAction]
+	return ok
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *NotificationMutation) ResetAction() {
+	m.action = nil
+	delete(m.clearedFields, notification.FieldAction)
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
+func (m *NotificationMutation) SetBackupProfileID(id int) {
+	m.backup_profile = &id
+}
+
+// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
+func (m *NotificationMutation) ClearBackupProfile() {
+	m.clearedbackup_profile = true
+}
+
+// BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
+func (m *NotificationMutation) BackupProfileCleared() bool {
+	return m.clearedbackup_profile
+}
+
+// BackupProfileID returns the "backup_profile" edge ID in the mutation.
+func (m *NotificationMutation) BackupProfileID() (id int, exists bool) {
+	if m.backup_profile != nil {
+		return *m.backup_profile, true
+	}
+	return
+}
+
+// BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackupProfileID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) BackupProfileIDs() (ids []int) {
+	if id := m.backup_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackupProfile resets all changes to the "backup_profile" edge.
+func (m *NotificationMutation) ResetBackupProfile() {
+	m.backup_profile = nil
+	m.clearedbackup_profile = false
+}
+
+// SetRepositoryID sets the "repository" edge to the Repository entity by id.
+func (m *NotificationMutation) SetRepositoryID(id int) {
+	m.repository = &id
+}
+
+// ClearRepository clears the "repository" edge to the Repository entity.
+func (m *NotificationMutation) ClearRepository() {
+	m.clearedrepository = true
+}
+
+// RepositoryCleared reports if the "repository" edge to the Repository entity was cleared.
+func (m *NotificationMutation) RepositoryCleared() bool {
+	return m.clearedrepository
+}
+
+// RepositoryID returns the "repository" edge ID in the mutation.
+func (m *NotificationMutation) RepositoryID() (id int, exists bool) {
+	if m.repository != nil {
+		return *m.repository, true
+	}
+	return
+}
+
+// RepositoryIDs returns the "repository" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RepositoryID instead. It exists only for internal usage by the builders.
+func (m *NotificationMutation) RepositoryIDs() (ids []int) {
+	if id := m.repository; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRepository resets all changes to the "repository" edge.
+func (m *NotificationMutation) ResetRepository() {
+	m.repository = nil
+	m.clearedrepository = false
+}
+
+// Where appends a list predicates to the NotificationMutation builder.
+func (m *NotificationMutation) Where(ps ...predicate.Notification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the NotificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *NotificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Notification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *NotificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *NotificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Notification).
+func (m *NotificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *NotificationMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, notification.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, notification.FieldUpdatedAt)
+	}
+	if m.message != nil {
+		fields = append(fields, notification.FieldMessage)
+	}
+	if m._type != nil {
+		fields = append(fields, notification.FieldType)
+	}
+	if m.seen != nil {
+		fields = append(fields, notification.FieldSeen)
+	}
+	if m.action != nil {
+		fields = append(fields, notification.FieldAction)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *NotificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case notification.FieldCreatedAt:
+		return m.CreatedAt()
+	case notification.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case notification.FieldMessage:
+		return m.Message()
+	case notification.FieldType:
+		return m.GetType()
+	case notification.FieldSeen:
+		return m.Seen()
+	case notification.FieldAction:
+		return m.Action()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *NotificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case notification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case notification.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case notification.FieldMessage:
+		return m.OldMessage(ctx)
+	case notification.FieldType:
+		return m.OldType(ctx)
+	case notification.FieldSeen:
+		return m.OldSeen(ctx)
+	case notification.FieldAction:
+		return m.OldAction(ctx)
+	}
+	return nil, fmt.Errorf("unknown Notification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case notification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case notification.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case notification.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case notification.FieldType:
+		v, ok := value.(notification.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case notification.FieldSeen:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeen(v)
+		return nil
+	case notification.FieldAction:
+		v, ok := value.(notification.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *NotificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *NotificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *NotificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Notification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *NotificationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(notification.FieldAction) {
+		fields = append(fields, notification.FieldAction)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *NotificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *NotificationMutation) ClearField(name string) error {
+	switch name {
+	case notification.FieldAction:
+		m.ClearAction()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *NotificationMutation) ResetField(name string) error {
+	switch name {
+	case notification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case notification.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case notification.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case notification.FieldType:
+		m.ResetType()
+		return nil
+	case notification.FieldSeen:
+		m.ResetSeen()
+		return nil
+	case notification.FieldAction:
+		m.ResetAction()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *NotificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.backup_profile != nil {
+		edges = append(edges, notification.EdgeBackupProfile)
+	}
+	if m.repository != nil {
+		edges = append(edges, notification.EdgeRepository)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *NotificationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case notification.EdgeBackupProfile:
+		if id := m.backup_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	case notification.EdgeRepository:
+		if id := m.repository; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *NotificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *NotificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *NotificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedbackup_profile {
+		edges = append(edges, notification.EdgeBackupProfile)
+	}
+	if m.clearedrepository {
+		edges = append(edges, notification.EdgeRepository)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *NotificationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case notification.EdgeBackupProfile:
+		return m.clearedbackup_profile
+	case notification.EdgeRepository:
+		return m.clearedrepository
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *NotificationMutation) ClearEdge(name string) error {
+	switch name {
+	case notification.EdgeBackupProfile:
+		m.ClearBackupProfile()
+		return nil
+	case notification.EdgeRepository:
+		m.ClearRepository()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *NotificationMutation) ResetEdge(name string) error {
+	switch name {
+	case notification.EdgeBackupProfile:
+		m.ResetBackupProfile()
+		return nil
+	case notification.EdgeRepository:
+		m.ResetRepository()
+		return nil
+	}
+	return fmt.Errorf("unknown Notification edge %s", name)
+}
+
+// PruningRuleMutation represents an operation that mutates the PruningRule nodes in the graph.
+type PruningRuleMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	created_at            *time.Time
+	updated_at            *time.Time
+	is_enabled            *bool
+	keep_hourly           *int
+	addkeep_hourly        *int
+	keep_daily            *int
+	addkeep_daily         *int
+	keep_weekly           *int
+	addkeep_weekly        *int
+	keep_monthly          *int
+	addkeep_monthly       *int
+	keep_yearly           *int
+	addkeep_yearly        *int
+	keep_within_days      *int
+	addkeep_within_days   *int
+	next_run              *time.Time
+	last_run              *time.Time
+	last_run_status       *string
+	clearedFields         map[string]struct{}
+	backup_profile        *int
+	clearedbackup_profile bool
+	done                  bool
+	oldValue              func(context.Context) (*PruningRule, error)
+	predicates            []predicate.PruningRule
+}
+
+var _ ent.Mutation = (*PruningRuleMutation)(nil)
+
+// pruningruleOption allows management of the mutation configuration using functional options.
+type pruningruleOption func(*PruningRuleMutation)
+
+// newPruningRuleMutation creates new mutation for the PruningRule entity.
+func newPruningRuleMutation(c config, op Op, opts ...pruningruleOption) *PruningRuleMutation {
+	m := &PruningRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePruningRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPruningRuleID sets the ID field of the mutation.
+func withPruningRuleID(id int) pruningruleOption {
+	return func(m *PruningRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PruningRule
+		)
+		m.oldValue = func(ctx context.Context) (*PruningRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PruningRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPruningRule sets the old PruningRule of the mutation.
+func withPruningRule(node *PruningRule) pruningruleOption {
+	return func(m *PruningRuleMutation) {
+		m.oldValue = func(context.Context) (*PruningRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PruningRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PruningRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PruningRule entities.
+func (m *PruningRuleMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PruningRuleMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PruningRuleMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PruningRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PruningRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PruningRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PruningRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PruningRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PruningRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PruningRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (m *PruningRuleMutation) SetIsEnabled(b bool) {
+	m.is_enabled = &b
+}
+
+// IsEnabled returns the value of the "is_enabled" field in the mutation.
+func (m *PruningRuleMutation) IsEnabled() (r bool, exists bool) {
+	v := m.is_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnabled returns the old "is_enabled" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+	}
+	return oldValue.IsEnabled, nil
+}
+
+// ResetIsEnabled resets all changes to the "is_enabled" field.
+func (m *PruningRuleMutation) ResetIsEnabled() {
+	m.is_enabled = nil
+}
+
+// SetKeepHourly sets the "keep_hourly" field.
+func (m *PruningRuleMutation) SetKeepHourly(i int) {
+	m.keep_hourly = &i
+	m.addkeep_hourly = nil
+}
+
+// KeepHourly returns the value of the "keep_hourly" field in the mutation.
+func (m *PruningRuleMutation) KeepHourly() (r int, exists bool) {
+	v := m.keep_hourly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepHourly returns the old "keep_hourly" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepHourly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepHourly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepHourly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepHourly: %w", err)
+	}
+	return oldValue.KeepHourly, nil
+}
+
+// AddKeepHourly adds i to the "keep_hourly" field.
+func (m *PruningRuleMutation) AddKeepHourly(i int) {
+	if m.addkeep_hourly != nil {
+		*m.addkeep_hourly += i
+	} else {
+		m.addkeep_hourly = &i
+	}
+}
+
+// AddedKeepHourly returns the value that was added to the "keep_hourly" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepHourly() (r int, exists bool) {
+	v := m.addkeep_hourly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepHourly resets all changes to the "keep_hourly" field.
+func (m *PruningRuleMutation) ResetKeepHourly() {
+	m.keep_hourly = nil
+	m.addkeep_hourly = nil
+}
+
+// SetKeepDaily sets the "keep_daily" field.
+func (m *PruningRuleMutation) SetKeepDaily(i int) {
+	m.keep_daily = &i
+	m.addkeep_daily = nil
+}
+
+// KeepDaily returns the value of the "keep_daily" field in the mutation.
+func (m *PruningRuleMutation) KeepDaily() (r int, exists bool) {
+	v := m.keep_daily
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepDaily returns the old "keep_daily" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepDaily(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepDaily is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepDaily requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepDaily: %w", err)
+	}
+	return oldValue.KeepDaily, nil
+}
+
+// AddKeepDaily adds i to the "keep_daily" field.
+func (m *PruningRuleMutation) AddKeepDaily(i int) {
+	if m.addkeep_daily != nil {
+		*m.addkeep_daily += i
+	} else {
+		m.addkeep_daily = &i
+	}
+}
+
+// AddedKeepDaily returns the value that was added to the "keep_daily" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepDaily() (r int, exists bool) {
+	v := m.addkeep_daily
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepDaily resets all changes to the "keep_daily" field.
+func (m *PruningRuleMutation) ResetKeepDaily() {
+	m.keep_daily = nil
+	m.addkeep_daily = nil
+}
+
+// SetKeepWeekly sets the "keep_weekly" field.
+func (m *PruningRuleMutation) SetKeepWeekly(i int) {
+	m.keep_weekly = &i
+	m.addkeep_weekly = nil
+}
+
+// KeepWeekly returns the value of the "keep_weekly" field in the mutation.
+func (m *PruningRuleMutation) KeepWeekly() (r int, exists bool) {
+	v := m.keep_weekly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepWeekly returns the old "keep_weekly" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepWeekly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepWeekly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepWeekly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepWeekly: %w", err)
+	}
+	return oldValue.KeepWeekly, nil
+}
+
+// AddKeepWeekly adds i to the "keep_weekly" field.
+func (m *PruningRuleMutation) AddKeepWeekly(i int) {
+	if m.addkeep_weekly != nil {
+		*m.addkeep_weekly += i
+	} else {
+		m.addkeep_weekly = &i
+	}
+}
+
+// AddedKeepWeekly returns the value that was added to the "keep_weekly" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepWeekly() (r int, exists bool) {
+	v := m.addkeep_weekly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepWeekly resets all changes to the "keep_weekly" field.
+func (m *PruningRuleMutation) ResetKeepWeekly() {
+	m.keep_weekly = nil
+	m.addkeep_weekly = nil
+}
+
+// SetKeepMonthly sets the "keep_monthly" field.
+func (m *PruningRuleMutation) SetKeepMonthly(i int) {
+	m.keep_monthly = &i
+	m.addkeep_monthly = nil
+}
+
+// KeepMonthly returns the value of the "keep_monthly" field in the mutation.
+func (m *PruningRuleMutation) KeepMonthly() (r int, exists bool) {
+	v := m.keep_monthly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepMonthly returns the old "keep_monthly" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepMonthly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepMonthly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepMonthly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepMonthly: %w", err)
+	}
+	return oldValue.KeepMonthly, nil
+}
+
+// AddKeepMonthly adds i to the "keep_monthly" field.
+func (m *PruningRuleMutation) AddKeepMonthly(i int) {
+	if m.addkeep_monthly != nil {
+		*m.addkeep_monthly += i
+	} else {
+		m.addkeep_monthly = &i
+	}
+}
+
+// AddedKeepMonthly returns the value that was added to the "keep_monthly" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepMonthly() (r int, exists bool) {
+	v := m.addkeep_monthly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepMonthly resets all changes to the "keep_monthly" field.
+func (m *PruningRuleMutation) ResetKeepMonthly() {
+	m.keep_monthly = nil
+	m.addkeep_monthly = nil
+}
+
+// SetKeepYearly sets the "keep_yearly" field.
+func (m *PruningRuleMutation) SetKeepYearly(i int) {
+	m.keep_yearly = &i
+	m.addkeep_yearly = nil
+}
+
+// KeepYearly returns the value of the "keep_yearly" field in the mutation.
+func (m *PruningRuleMutation) KeepYearly() (r int, exists bool) {
+	v := m.keep_yearly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepYearly returns the old "keep_yearly" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepYearly(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepYearly is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepYearly requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepYearly: %w", err)
+	}
+	return oldValue.KeepYearly, nil
+}
+
+// AddKeepYearly adds i to the "keep_yearly" field.
+func (m *PruningRuleMutation) AddKeepYearly(i int) {
+	if m.addkeep_yearly != nil {
+		*m.addkeep_yearly += i
+	} else {
+		m.addkeep_yearly = &i
+	}
+}
+
+// AddedKeepYearly returns the value that was added to the "keep_yearly" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepYearly() (r int, exists bool) {
+	v := m.addkeep_yearly
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepYearly resets all changes to the "keep_yearly" field.
+func (m *PruningRuleMutation) ResetKeepYearly() {
+	m.keep_yearly = nil
+	m.addkeep_yearly = nil
+}
+
+// SetKeepWithinDays sets the "keep_within_days" field.
+func (m *PruningRuleMutation) SetKeepWithinDays(i int) {
+	m.keep_within_days = &i
+	m.addkeep_within_days = nil
+}
+
+// KeepWithinDays returns the value of the "keep_within_days" field in the mutation.
+func (m *PruningRuleMutation) KeepWithinDays() (r int, exists bool) {
+	v := m.keep_within_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeepWithinDays returns the old "keep_within_days" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldKeepWithinDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeepWithinDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeepWithinDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeepWithinDays: %w", err)
+	}
+	return oldValue.KeepWithinDays, nil
+}
+
+// AddKeepWithinDays adds i to the "keep_within_days" field.
+func (m *PruningRuleMutation) AddKeepWithinDays(i int) {
+	if m.addkeep_within_days != nil {
+		*m.addkeep_within_days += i
+	} else {
+		m.addkeep_within_days = &i
+	}
+}
+
+// AddedKeepWithinDays returns the value that was added to the "keep_within_days" field in this mutation.
+func (m *PruningRuleMutation) AddedKeepWithinDays() (r int, exists bool) {
+	v := m.addkeep_within_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeepWithinDays resets all changes to the "keep_within_days" field.
+func (m *PruningRuleMutation) ResetKeepWithinDays() {
+	m.keep_within_days = nil
+	m.addkeep_within_days = nil
+}
+
+// SetNextRun sets the "next_run" field.
+func (m *PruningRuleMutation) SetNextRun(t time.Time) {
+	m.next_run = &t
+}
+
+// NextRun returns the value of the "next_run" field in the mutation.
+func (m *PruningRuleMutation) NextRun() (r time.Time, exists bool) {
+	v := m.next_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextRun returns the old "next_run" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldNextRun(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextRun: %w", err)
+	}
+	return oldValue.NextRun, nil
+}
+
+// ClearNextRun clears the value of the "next_run" field.
+func (m *PruningRuleMutation) ClearNextRun() {
+	m.next_run = nil
+	m.clearedFields[pruningrule.FieldNextRun] = struct{}{}
+}
+
+// NextRunCleared returns if the "next_run" field was cleared in this mutation.
+func (m *PruningRuleMutation) NextRunCleared() bool {
+	_, ok := m.clearedFields[pruningrule.FieldNextRun]
+	return ok
+}
+
+// ResetNextRun resets all changes to the "next_run" field.
+func (m *PruningRuleMutation) ResetNextRun() {
+	m.next_run = nil
+	delete(m.clearedFields, pruningrule.FieldNextRun)
+}
+
+// SetLastRun sets the "last_run" field.
+func (m *PruningRuleMutation) SetLastRun(t time.Time) {
+	m.last_run = &t
+}
+
+// LastRun returns the value of the "last_run" field in the mutation.
+func (m *PruningRuleMutation) LastRun() (r time.Time, exists bool) {
+	v := m.last_run
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRun returns the old "last_run" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldLastRun(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRun is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRun requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRun: %w", err)
+	}
+	return oldValue.LastRun, nil
+}
+
+// ClearLastRun clears the value of the "last_run" field.
+func (m *PruningRuleMutation) ClearLastRun() {
+	m.last_run = nil
+	m.clearedFields[pruningrule.FieldLastRun] = struct{}{}
+}
+
+// LastRunCleared returns if the "last_run" field was cleared in this mutation.
+func (m *PruningRuleMutation) LastRunCleared() bool {
+	_, ok := m.clearedFields[pruningrule.FieldLastRun]
+	return ok
+}
+
+// ResetLastRun resets all changes to the "last_run" field.
+func (m *PruningRuleMutation) ResetLastRun() {
+	m.last_run = nil
+	delete(m.clearedFields, pruningrule.FieldLastRun)
+}
+
+// SetLastRunStatus sets the "last_run_status" field.
+func (m *PruningRuleMutation) SetLastRunStatus(s string) {
+	m.last_run_status = &s
+}
+
+// LastRunStatus returns the value of the "last_run_status" field in the mutation.
+func (m *PruningRuleMutation) LastRunStatus() (r string, exists bool) {
+	v := m.last_run_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastRunStatus returns the old "last_run_status" field's value of the PruningRule entity.
+// If the PruningRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PruningRuleMutation) OldLastRunStatus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastRunStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastRunStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastRunStatus: %w", err)
+	}
+	return oldValue.LastRunStatus, nil
+}
+
+// ClearLastRunStatus clears the value of the "last_run_status" field.
+func (m *PruningRuleMutation) ClearLastRunStatus() {
+	m.last_run_status = nil
+	m.clearedFields[pruningrule.FieldLastRunStatus] = struct{}{}
+}
+
+// LastRunStatusCleared returns if the "last_run_status" field was cleared in this mutation.
+func (m *PruningRuleMutation) LastRunStatusCleared() bool {
+	_, ok := m.clearedFields[pruningrule.FieldLastRunStatus]
+	return ok
+}
+
+// ResetLastRunStatus resets all changes to the "last_run_status" field.
+func (m *PruningRuleMutation) ResetLastRunStatus() {
+	m.last_run_status = nil
+	delete(m.clearedFields, pruningrule.FieldLastRunStatus)
+}
+
+// SetBackupProfileID sets the "backup_profile" edge to the BackupProfile entity by id.
+func (m *PruningRuleMutation) SetBackupProfileID(id int) {
+	m.backup_profile = &id
+}
+
+// ClearBackupProfile clears the "backup_profile" edge to the BackupProfile entity.
+func (m *PruningRuleMutation) ClearBackupProfile() {
+	m.clearedbackup_profile = true
+}
+
+// BackupProfileCleared reports if the "backup_profile" edge to the BackupProfile entity was cleared.
+func (m *PruningRuleMutation) BackupProfileCleared() bool {
+	return m.clearedbackup_profile
+}
+
+// BackupProfileID returns the "backup_profile" edge ID in the mutation.
+func (m *PruningRuleMutation) BackupProfileID() (id int, exists bool) {
+	if m.backup_profile != nil {
+		return *m.backup_profile, true
+	}
+	return
+}
+
+// BackupProfileIDs returns the "backup_profile" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackupProfileID instead. It exists only for internal usage by the builders.
+func (m *PruningRuleMutation) BackupProfileIDs() (ids []int) {
+	if id := m.backup_profile; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackupProfile resets all changes to the "backup_profile" edge.
+func (m *PruningRuleMutation) ResetBackupProfile() {
+	m.backup_profile = nil
+	m.clearedbackup_profile = false
+}
+
+// Where appends a list predicates to the PruningRuleMutation builder.
+func (m *PruningRuleMutation) Where(ps ...predicate.PruningRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PruningRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PruningRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PruningRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PruningRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PruningRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PruningRule).
+func (m *PruningRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PruningRuleMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.created_at != nil {
+		fields = append(fields, pruningrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pruningrule.FieldUpdatedAt)
+	}
+	if m.is_enabled != nil {
+		fields = append(fields, pruningrule.FieldIsEnabled)
+	}
+	if m.keep_hourly != nil {
+		fields = append(fields, pruningrule.FieldKeepHourly)
+	}
+	if m.keep_daily != nil {
+		fields = append(fields, pruningrule.FieldKeepDaily)
+	}
+	if m.keep_weekly != nil {
+		fields = append(fields, pruningrule.FieldKeepWeekly)
+	}
+	if m.keep_monthly != nil {
+		fields = append(fields, pruningrule.FieldKeepMonthly)
+	}
+	if m.keep_yearly != nil {
+		fields = append(fields, pruningrule.FieldKeepYearly)
+	}
+	if m.keep_within_days != nil {
+		fields = append(fields, pruningrule.FieldKeepWithinDays)
+	}
+	if m.next_run != nil {
+		fields = append(fields, pruningrule.FieldNextRun)
+	}
+	if m.last_run != nil {
+		fields = append(fields, pruningrule.FieldLastRun)
+	}
+	if m.last_run_status != nil {
+		fields = append(fields, pruningrule.FieldLastRunStatus)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PruningRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pruningrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case pruningrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case pruningrule.FieldIsEnabled:
+		return m.IsEnabled()
+	case pruningrule.FieldKeepHourly:
+		return m.KeepHourly()
+	case pruningrule.FieldKeepDaily:
+		return m.KeepDaily()
+	case pruningrule.FieldKeepWeekly:
+		return m.KeepWeekly()
+	case pruningrule.FieldKeepMonthly:
+		return m.KeepMonthly()
+	case pruningrule.FieldKeepYearly:
+		return m.KeepYearly()
+	case pruningrule.FieldKeepWithinDays:
+		return m.KeepWithinDays()
+	case pruningrule.FieldNextRun:
+		return m.NextRun()
+	case pruningrule.FieldLastRun:
+		return m.LastRun()
+	case pruningrule.FieldLastRunStatus:
+		return m.LastRunStatus()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PruningRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pruningrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pruningrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case pruningrule.FieldIsEnabled:
+		return m.OldIsEnabled(ctx)
+	case pruningrule.FieldKeepHourly:
+		return m.OldKeepHourly(ctx)
+	case pruningrule.FieldKeepDaily:
+		return m.OldKeepDaily(ctx)
+	case pruningrule.FieldKeepWeekly:
+		return m.OldKeepWeekly(ctx)
+	case pruningrule.FieldKeepMonthly:
+		return m.OldKeepMonthly(ctx)
+	case pruningrule.FieldKeepYearly:
+		return m.OldKeepYearly(ctx)
+	case pruningrule.FieldKeepWithinDays:
+		return m.OldKeepWithinDays(ctx)
+	case pruningrule.FieldNextRun:
+		return m.OldNextRun(ctx)
+	case pruningrule.FieldLastRun:
+		return m.OldLastRun(ctx)
+	case pruningrule.FieldLastRunStatus:
+		return m.OldLastRunStatus(ctx)
+	}
+	return nil, fmt.Errorf("unknown PruningRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PruningRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pruningrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pruningrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case pruningrule.FieldIsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnabled(v)
+		return nil
+	case pruningrule.FieldKeepHourly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepHourly(v)
+		return nil
+	case pruningrule.FieldKeepDaily:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepDaily(v)
+		return nil
+	case pruningrule.FieldKeepWeekly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepWeekly(v)
+		return nil
+	case pruningrule.FieldKeepMonthly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepMonthly(v)
+		return nil
+	case pruningrule.FieldKeepYearly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepYearly(v)
+		return nil
+	case pruningrule.FieldKeepWithinDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeepWithinDays(v)
+		return nil
+	case pruningrule.FieldNextRun:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextRun(v)
+		return nil
+	case pruningrule.FieldLastRun:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRun(v)
+		return nil
+	case pruningrule.FieldLastRunStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastRunStatus(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PruningRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addkeep_hourly != nil {
+		fields = append(fields, pruningrule.FieldKeepHourly)
+	}
+	if m.addkeep_daily != nil {
+		fields = append(fields, pruningrule.FieldKeepDaily)
+	}
+	if m.addkeep_weekly != nil {
+		fields = append(fields, pruningrule.FieldKeepWeekly)
+	}
+	if m.addkeep_monthly != nil {
+		fields = append(fields, pruningrule.FieldKeepMonthly)
+	}
+	if m.addkeep_yearly != nil {
+		fields = append(fields, pruningrule.FieldKeepYearly)
+	}
+	if m.addkeep_within_days != nil {
+		fields = append(fields, pruningrule.FieldKeepWithinDays)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PruningRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case pruningrule.FieldKeepHourly:
+		return m.AddedKeepHourly()
+	case pruningrule.FieldKeepDaily:
+		return m.AddedKeepDaily()
+	case pruningrule.FieldKeepWeekly:
+		return m.AddedKeepWeekly()
+	case pruningrule.FieldKeepMonthly:
+		return m.AddedKeepMonthly()
+	case pruningrule.FieldKeepYearly:
+		return m.AddedKeepYearly()
+	case pruningrule.FieldKeepWithinDays:
+		return m.AddedKeepWithinDays()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PruningRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case pruningrule.FieldKeepHourly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepHourly(v)
+		return nil
+	case pruningrule.FieldKeepDaily:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepDaily(v)
+		return nil
+	case pruningrule.FieldKeepWeekly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepWeekly(v)
+		return nil
+	case pruningrule.FieldKeepMonthly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepMonthly(v)
+		return nil
+	case pruningrule.FieldKeepYearly:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepYearly(v)
+		return nil
+	case pruningrule.FieldKeepWithinDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKeepWithinDays(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PruningRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(pruningrule.FieldNextRun) {
+		fields = append(fields, pruningrule.FieldNextRun)
+	}
+	if m.FieldCleared(pruningrule.FieldLastRun) {
+		fields = append(fields, pruningrule.FieldLastRun)
+	}
+	if m.FieldCleared(pruningrule.FieldLastRunStatus) {
+		fields = append(fields, pruningrule.FieldLastRunStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PruningRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PruningRuleMutation) ClearField(name string) error {
+	switch name {
+	case pruningrule.FieldNextRun:
+		m.ClearNextRun()
+		return nil
+	case pruningrule.FieldLastRun:
+		m.ClearLastRun()
+		return nil
+	case pruningrule.FieldLastRunStatus:
+		m.ClearLastRunStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PruningRuleMutation) ResetField(name string) error {
+	switch name {
+	case pruningrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pruningrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case pruningrule.FieldIsEnabled:
+		m.ResetIsEnabled()
+		return nil
+	case pruningrule.FieldKeepHourly:
+		m.ResetKeepHourly()
+		return nil
+	case pruningrule.FieldKeepDaily:
+		m.ResetKeepDaily()
+		return nil
+	case pruningrule.FieldKeepWeekly:
+		m.ResetKeepWeekly()
+		return nil
+	case pruningrule.FieldKeepMonthly:
+		m.ResetKeepMonthly()
+		return nil
+	case pruningrule.FieldKeepYearly:
+		m.ResetKeepYearly()
+		return nil
+	case pruningrule.FieldKeepWithinDays:
+		m.ResetKeepWithinDays()
+		return nil
+	case pruningrule.FieldNextRun:
+		m.ResetNextRun()
+		return nil
+	case pruningrule.FieldLastRun:
+		m.ResetLastRun()
+		return nil
+	case pruningrule.FieldLastRunStatus:
+		m.ResetLastRunStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PruningRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.backup_profile != nil {
+		edges = append(edges, pruningrule.EdgeBackupProfile)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PruningRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pruningrule.EdgeBackupProfile:
+		if id := m.backup_profile; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PruningRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PruningRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PruningRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbackup_profile {
+		edges = append(edges, pruningrule.EdgeBackupProfile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PruningRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pruningrule.EdgeBackupProfile:
+		return m.clearedbackup_profile
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PruningRuleMutation) ClearEdge(name string) error {
+	switch name {
+	case pruningrule.EdgeBackupProfile:
+		m.ClearBackupProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PruningRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case pruningrule.EdgeBackupProfile:
+		m.ResetBackupProfile()
+		return nil
+	}
+	return fmt.Errorf("unknown PruningRule edge %s", name)
+}
+
+// RepositoryMutation represents an operation that mutates the Repository nodes in the graph.
+type RepositoryMutation struct {
+	config
+	op                           Op
+	typ                          string
+	id                           *int
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	name                         *string
+	url                          *string
+	has_password                 *bool
+	volume_uuid                  *string
+	volume_label                 *string
+	last_quick_check_at          *time.Time
+	quick_check_error            *[]string
+	appendquick_check_error      []string
+	last_full_check_at           *time.Time
+	full_check_error             *[]string
+	appendfull_check_error       []string
+	quick_check_interval_days    *int
+	addquick_check_interval_days *int
+	full_check_interval_weeks    *int
+	addfull_check_interval_weeks *int
+	rolling_check_archives       *int
+	addrolling_check_archives    *int
+	check_cycle_started_at       *time.Time
+	stats_total_chunks           *int
+	addstats_total_chunks        *int
+	stats_total_size             *int
+	addstats_total_size          *int
+	stats_total_csize            *int
+	addstats_total_csize         *int
+	stats_total_unique_chunks    *int
+	addstats_total_unique_chunks *int
+	stats_unique_size            *int
+	addstats_unique_size         *int
+	stats_unique_csize           *int
+	addstats_unique_csize        *int
+	file_index_enabled           *bool
+	clearedFields                map[string]struct{}
+	backup_profiles              map[int]struct{}
+	removedbackup_profiles       map[int]struct{}
+	clearedbackup_profiles       bool
+	archives                     map[int]struct{}
+	removedarchives              map[int]struct{}
+	clearedarchives              bool
+	notifications                map[int]struct{}
+	removednotifications         map[int]struct{}
+	clearednotifications         bool
+	stats_snapshots              map[int]struct{}
+	removedstats_snapshots       map[int]struct{}
+	clearedstats_snapshots       bool
+	cloud_repository             *int
+	clearedcloud_repository      bool
+	done                         bool
+	oldValue                     func(context.Context) (*Repository, error)
+	predicates                   []predicate.Repository
+}
+
+var _ ent.Mutation = (*RepositoryMutation)(nil)
+
+// repositoryOption allows management of the mutation configuration using functional options.
+type repositoryOption func(*RepositoryMutation)
+
+// newRepositoryMutation creates new mutation for the Repository entity.
+func newRepositoryMutation(c config, op Op, opts ...repositoryOption) *RepositoryMutation {
+	m := &RepositoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRepository,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRepositoryID sets the ID field of the mutation.
+func withRepositoryID(id int) repositoryOption {
+	return func(m *RepositoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Repository
+		)
+		m.oldValue = func(ctx context.Context) (*Repository, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Repository.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRepository sets the old Repository of the mutation.
+func withRepository(node *Repository) repositoryOption {
+	return func(m *RepositoryMutation) {
+		m.oldValue = func(context.Context) (*Repository, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RepositoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RepositoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Repository entities.
+func (m *RepositoryMutation) SetID(id int) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RepositoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RepositoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Repository.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RepositoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RepositoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RepositoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RepositoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RepositoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RepositoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *RepositoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RepositoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RepositoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetURL sets the "url" field.
+func (m *RepositoryMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *RepositoryMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *RepositoryMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetHasPassword sets the "has_password" field.
+func (m *RepositoryMutation) SetHasPassword(b bool) {
+	m.has_password = &b
+}
+
+// HasPassword returns the value of the "has_password" field in the mutation.
+func (m *RepositoryMutation) HasPassword() (r bool, exists bool) {
+	v := m.has_password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHasPassword returns the old "has_password" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldHasPassword(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHasPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHasPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHasPassword: %w", err)
+	}
+	return oldValue.HasPassword, nil
+}
+
+// ResetHasPassword resets all changes to the "has_password" field.
+func (m *RepositoryMutation) ResetHasPassword() {
+	m.has_password = nil
+}
+
+// SetVolumeUUID sets the "volume_uuid" field.
+func (m *RepositoryMutation) SetVolumeUUID(s string) {
+	m.volume_uuid = &s
+}
+
+// VolumeUUID returns the value of the "volume_uuid" field in the mutation.
+func (m *RepositoryMutation) VolumeUUID() (r string, exists bool) {
+	v := m.volume_uuid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolumeUUID returns the old "volume_uuid" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldVolumeUUID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolumeUUID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolumeUUID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolumeUUID: %w", err)
+	}
+	return oldValue.VolumeUUID, nil
+}
+
+// ClearVolumeUUID clears the value of the "volume_uuid" field.
+func (m *RepositoryMutation) ClearVolumeUUID() {
+	m.volume_uuid = nil
+	m.clearedFields[repository.FieldVolumeUUID] = struct{}{}
+}
+
+// VolumeUUIDCleared returns if the "volume_uuid" field was cleared in this mutation.
+func (m *RepositoryMutation) VolumeUUIDCleared() bool {
+	_, ok := m.clearedFields[repository.FieldVolumeUUID]
+	return ok
+}
+
+// ResetVolumeUUID resets all changes to the "volume_uuid" field.
+func (m *RepositoryMutation) ResetVolumeUUID() {
+	m.volume_uuid = nil
+	delete(m.clearedFields, repository.FieldVolumeUUID)
+}
+
+// SetVolumeLabel sets the "volume_label" field.
+func (m *RepositoryMutation) SetVolumeLabel(s string) {
+	m.volume_label = &s
+}
+
+// VolumeLabel returns the value of the "volume_label" field in the mutation.
+func (m *RepositoryMutation) VolumeLabel() (r string, exists bool) {
+	v := m.volume_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolumeLabel returns the old "volume_label" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldVolumeLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolumeLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolumeLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolumeLabel: %w", err)
+	}
+	return oldValue.VolumeLabel, nil
+}
+
+// ClearVolumeLabel clears the value of the "volume_label" field.
+func (m *RepositoryMutation) ClearVolumeLabel() {
+	m.volume_label = nil
+	m.clearedFields[repository.FieldVolumeLabel] = struct{}{}
+}
+
+// VolumeLabelCleared returns if the "volume_label" field was cleared in this mutation.
+func (m *RepositoryMutation) VolumeLabelCleared() bool {
+	_, ok := m.clearedFields[repository.FieldVolumeLabel]
+	return ok
+}
+
+// ResetVolumeLabel resets all changes to the "volume_label" field.
+func (m *RepositoryMutation) ResetVolumeLabel() {
+	m.volume_label = nil
+	delete(m.clearedFields, repository.FieldVolumeLabel)
+}
+
+// SetLastQuickCheckAt sets the "last_quick_check_at" field.
+func (m *RepositoryMutation) SetLastQuickCheckAt(t time.Time) {
+	m.last_quick_check_at = &t
+}
+
+// LastQuickCheckAt returns the value of the "last_quick_check_at" field in the mutation.
+func (m *RepositoryMutation) LastQuickCheckAt() (r time.Time, exists bool) {
+	v := m.last_quick_check_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastQuickCheckAt returns the old "last_quick_check_at" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldLastQuickCheckAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastQuickCheckAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastQuickCheckAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastQuickCheckAt: %w", err)
+	}
+	return oldValue.LastQuickCheckAt, nil
+}
+
+// ClearLastQuickCheckAt clears the value of the "last_quick_check_at" field.
+func (m *RepositoryMutation) ClearLastQuickCheckAt() {
+	m.last_quick_check_at = nil
+	m.clearedFields[repository.FieldLastQuickCheckAt] = struct{}{}
+}
+
+// LastQuickCheckAtCleared returns if the "last_quick_check_at" field was cleared in this mutation.
+func (m *RepositoryMutation) LastQuickCheckAtCleared() bool {
+	_, ok := m.clearedFields[repository.FieldLastQuickCheckAt]
+	return ok
+}
+
+// ResetLastQuickCheckAt resets all changes to the "last_quick_check_at" field.
+func (m *RepositoryMutation) ResetLastQuickCheckAt() {
+	m.last_quick_check_at = nil
+	delete(m.clearedFields, repository.FieldLastQuickCheckAt)
+}
+
+// SetQuickCheckError sets the "quick_check_error" field.
+func (m *RepositoryMutation) SetQuickCheckError(s []string) {
+	m.quick_check_error = &s
+	m.appendquick_check_error = nil
+}
+
+// QuickCheckError returns the value of the "quick_check_error" field in the mutation.
+func (m *RepositoryMutation) QuickCheckError() (r []string, exists bool) {
+	v := m.quick_check_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuickCheckError returns the old "quick_check_error" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldQuickCheckError(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuickCheckError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuickCheckError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuickCheckError: %w", err)
+	}
+	return oldValue.QuickCheckError, nil
+}
+
+// AppendQuickCheckError adds s to the "quick_check_error" field.
+func (m *RepositoryMutation) AppendQuickCheckError(s []string) {
+	m.appendquick_check_error = append(m.appendquick_check_error, s...)
+}
+
+// AppendedQuickCheckError returns the list of values that were appended to the "quick_check_error" field in this mutation.
+func (m *RepositoryMutation) AppendedQuickCheckError() ([]string, bool) {
+	if len(m.appendquick_check_error) == 0 {
+		return nil, false
+	}
+	return m.appendquick_check_error, true
+}
+
+// ClearQuickCheckError clears the value of the "quick_check_error" field.
+func (m *RepositoryMutation) ClearQuickCheckError() {
+	m.quick_check_error = nil
+	m.appendquick_check_error = nil
+	m.clearedFields[repository.FieldQuickCheckError] = struct{}{}
+}
+
+// QuickCheckErrorCleared returns if the "quick_check_error" field was cleared in this mutation.
+func (m *RepositoryMutation) QuickCheckErrorCleared() bool {
+	_, ok := m.clearedFields[repository.FieldQuickCheckError]
+	return ok
+}
+
+// ResetQuickCheckError resets all changes to the "quick_check_error" field.
+func (m *RepositoryMutation) ResetQuickCheckError() {
+	m.quick_check_error = nil
+	m.appendquick_check_error = nil
+	delete(m.clearedFields, repository.FieldQuickCheckError)
+}
+
+// SetLastFullCheckAt sets the "last_full_check_at" field.
+func (m *RepositoryMutation) SetLastFullCheckAt(t time.Time) {
+	m.last_full_check_at = &t
+}
+
+// LastFullCheckAt returns the value of the "last_full_check_at" field in the mutation.
+func (m *RepositoryMutation) LastFullCheckAt() (r time.Time, exists bool) {
+	v := m.last_full_check_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastFullCheckAt returns the old "last_full_check_at" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldLastFullCheckAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastFullCheckAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastFullCheckAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastFullCheckAt: %w", err)
+	}
+	return oldValue.LastFullCheckAt, nil
+}
+
+// ClearLastFullCheckAt clears the value of the "last_full_check_at" field.
+func (m *RepositoryMutation) ClearLastFullCheckAt() {
+	m.last_full_check_at = nil
+	m.clearedFields[repository.FieldLastFullCheckAt] = struct{}{}
+}
+
+// LastFullCheckAtCleared returns if the "last_full_check_at" field was cleared in this mutation.
+func (m *RepositoryMutation) LastFullCheckAtCleared() bool {
+	_, ok := m.clearedFields[repository.FieldLastFullCheckAt]
+	return ok
+}
+
+// ResetLastFullCheckAt resets all changes to the "last_full_check_at" field.
+func (m *RepositoryMutation) ResetLastFullCheckAt() {
+	m.last_full_check_at = nil
+	delete(m.clearedFields, repository.FieldLastFullCheckAt)
+}
+
+// SetFullCheckError sets the "full_check_error" field.
+func (m *RepositoryMutation) SetFullCheckError(s []string) {
+	m.full_check_error = &s
+	m.appendfull_check_error = nil
+}
+
+// FullCheckError returns the value of the "full_check_error" field in the mutation.
+func (m *RepositoryMutation) FullCheckError() (r []string, exists bool) {
+	v := m.full_check_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullCheckError returns the old "full_check_error" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldFullCheckError(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullCheckError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullCheckError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullCheckError: %w", err)
+	}
+	return oldValue.FullCheckError, nil
+}
+
+// AppendFullCheckError adds s to the "full_check_error" field.
+func (m *RepositoryMutation) AppendFullCheckError(s []string) {
+	m.appendfull_check_error = append(m.appendfull_check_error, s...)
+}
+
+// AppendedFullCheckError returns the list of values that were appended to the "full_check_error" field in this mutation.
+func (m *RepositoryMutation) AppendedFullCheckError() ([]string, bool) {
+	if len(m.appendfull_check_error) == 0 {
+		return nil, false
+	}
+	return m.appendfull_check_error, true
+}
+
+// ClearFullCheckError clears the value of the "full_check_error" field.
+func (m *RepositoryMutation) ClearFullCheckError() {
+	m.full_check_error = nil
+	m.appendfull_check_error = nil
+	m.clearedFields[repository.FieldFullCheckError] = struct{}{}
+}
+
+// FullCheckErrorCleared returns if the "full_check_error" field was cleared in this mutation.
+func (m *RepositoryMutation) FullCheckErrorCleared() bool {
+	_, ok := m.clearedFields[repository.FieldFullCheckError]
+	return ok
+}
+
+// ResetFullCheckError resets all changes to the "full_check_error" field.
+func (m *RepositoryMutation) ResetFullCheckError() {
+	m.full_check_error = nil
+	m.appendfull_check_error = nil
+	delete(m.clearedFields, repository.FieldFullCheckError)
+}
+
+// SetQuickCheckIntervalDays sets the "quick_check_interval_days" field.
+func (m *RepositoryMutation) SetQuickCheckIntervalDays(i int) {
+	m.quick_check_interval_days = &i
+	m.addquick_check_interval_days = nil
+}
+
+// QuickCheckIntervalDays returns the value of the "quick_check_interval_days" field in the mutation.
+func (m *RepositoryMutation) QuickCheckIntervalDays() (r int, exists bool) {
+	v := m.quick_check_interval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuickCheckIntervalDays returns the old "quick_check_interval_days" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldQuickCheckIntervalDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuickCheckIntervalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuickCheckIntervalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuickCheckIntervalDays: %w", err)
+	}
+	return oldValue.QuickCheckIntervalDays, nil
+}
+
+// AddQuickCheckIntervalDays adds i to the "quick_check_interval_days" field.
+func (m *RepositoryMutation) AddQuickCheckIntervalDays(i int) {
+	if m.addquick_check_interval_days != nil {
+		*m.addquick_check_interval_days += i
+	} else {
+		m.addquick_check_interval_days = &i
+	}
+}
+
+// AddedQuickCheckIntervalDays returns the value that was added to the "quick_check_interval_days" field in this mutation.
+func (m *RepositoryMutation) AddedQuickCheckIntervalDays() (r int, exists bool) {
+	v := m.addquick_check_interval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuickCheckIntervalDays resets all changes to the "quick_check_interval_days" field.
+func (m *RepositoryMutation) ResetQuickCheckIntervalDays() {
+	m.quick_check_interval_days = nil
+	m.addquick_check_interval_days = nil
+}
+
+// SetFullCheckIntervalWeeks sets the "full_check_interval_weeks" field.
+func (m *RepositoryMutation) SetFullCheckIntervalWeeks(i int) {
+	m.full_check_interval_weeks = &i
+	m.addfull_check_interval_weeks = nil
+}
+
+// FullCheckIntervalWeeks returns the value of the "full_check_interval_weeks" field in the mutation.
+func (m *RepositoryMutation) FullCheckIntervalWeeks() (r int, exists bool) {
+	v := m.full_check_interval_weeks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFullCheckIntervalWeeks returns the old "full_check_interval_weeks" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldFullCheckIntervalWeeks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFullCheckIntervalWeeks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFullCheckIntervalWeeks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFullCheckIntervalWeeks: %w", err)
+	}
+	return oldValue.FullCheckIntervalWeeks, nil
+}
+
+// AddFullCheckIntervalWeeks adds i to the "full_check_interval_weeks" field.
+func (m *RepositoryMutation) AddFullCheckIntervalWeeks(i int) {
+	if m.addfull_check_interval_weeks != nil {
+		*m.addfull_check_interval_weeks += i
+	} else {
+		m.addfull_check_interval_weeks = &i
+	}
+}
+
+// AddedFullCheckIntervalWeeks returns the value that was added to the "full_check_interval_weeks" field in this mutation.
+func (m *RepositoryMutation) AddedFullCheckIntervalWeeks() (r int, exists bool) {
+	v := m.addfull_check_interval_weeks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFullCheckIntervalWeeks resets all changes to the "full_check_interval_weeks" field.
+func (m *RepositoryMutation) ResetFullCheckIntervalWeeks() {
+	m.full_check_interval_weeks = nil
+	m.addfull_check_interval_weeks = nil
+}
+
+// SetRollingCheckArchives sets the "rolling_check_archives" field.
+func (m *RepositoryMutation) SetRollingCheckArchives(i int) {
+	m.rolling_check_archives = &i
+	m.addrolling_check_archives = nil
+}
+
+// RollingCheckArchives returns the value of the "rolling_check_archives" field in the mutation.
+func (m *RepositoryMutation) RollingCheckArchives() (r int, exists bool) {
+	v := m.rolling_check_archives
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRollingCheckArchives returns the old "rolling_check_archives" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldRollingCheckArchives(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRollingCheckArchives is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRollingCheckArchives requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRollingCheckArchives: %w", err)
+	}
+	return oldValue.RollingCheckArchives, nil
+}
+
+// AddRollingCheckArchives adds i to the "rolling_check_archives" field.
+func (m *RepositoryMutation) AddRollingCheckArchives(i int) {
+	if m.addrolling_check_archives != nil {
+		*m.addrolling_check_archives += i
+	} else {
+		m.addrolling_check_archives = &i
+	}
+}
+
+// AddedRollingCheckArchives returns the value that was added to the "rolling_check_archives" field in this mutation.
+func (m *RepositoryMutation) AddedRollingCheckArchives() (r int, exists bool) {
+	v := m.addrolling_check_archives
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRollingCheckArchives resets all changes to the "rolling_check_archives" field.
+func (m *RepositoryMutation) ResetRollingCheckArchives() {
+	m.rolling_check_archives = nil
+	m.addrolling_check_archives = nil
+}
+
+// SetCheckCycleStartedAt sets the "check_cycle_started_at" field.
+func (m *RepositoryMutation) SetCheckCycleStartedAt(t time.Time) {
+	m.check_cycle_started_at = &t
+}
+
+// CheckCycleStartedAt returns the value of the "check_cycle_started_at" field in the mutation.
+func (m *RepositoryMutation) CheckCycleStartedAt() (r time.Time, exists bool) {
+	v := m.check_cycle_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckCycleStartedAt returns the old "check_cycle_started_at" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldCheckCycleStartedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckCycleStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckCycleStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckCycleStartedAt: %w", err)
+	}
+	return oldValue.CheckCycleStartedAt, nil
+}
+
+// ClearCheckCycleStartedAt clears the value of the "check_cycle_started_at" field.
+func (m *RepositoryMutation) ClearCheckCycleStartedAt() {
+	m.check_cycle_started_at = nil
+	m.clearedFields[repository.FieldCheckCycleStartedAt] = struct{}{}
+}
+
+// CheckCycleStartedAtCleared returns if the "check_cycle_started_at" field was cleared in this mutation.
+func (m *RepositoryMutation) CheckCycleStartedAtCleared() bool {
+	_, ok := m.clearedFields[repository.FieldCheckCycleStartedAt]
+	return ok
+}
+
+// ResetCheckCycleStartedAt resets all changes to the "check_cycle_started_at" field.
+func (m *RepositoryMutation) ResetCheckCycleStartedAt() {
+	m.check_cycle_started_at = nil
+	delete(m.clearedFields, repository.FieldCheckCycleStartedAt)
+}
+
+// SetStatsTotalChunks sets the "stats_total_chunks" field.
+func (m *RepositoryMutation) SetStatsTotalChunks(i int) {
+	m.stats_total_chunks = &i
+	m.addstats_total_chunks = nil
+}
+
+// StatsTotalChunks returns the value of the "stats_total_chunks" field in the mutation.
+func (m *RepositoryMutation) StatsTotalChunks() (r int, exists bool) {
+	v := m.stats_total_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsTotalChunks returns the old "stats_total_chunks" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsTotalChunks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsTotalChunks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsTotalChunks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsTotalChunks: %w", err)
+	}
+	return oldValue.StatsTotalChunks, nil
+}
+
+// AddStatsTotalChunks adds i to the "stats_total_chunks" field.
+func (m *RepositoryMutation) AddStatsTotalChunks(i int) {
+	if m.addstats_total_chunks != nil {
+		*m.addstats_total_chunks += i
+	} else {
+		m.addstats_total_chunks = &i
+	}
+}
+
+// AddedStatsTotalChunks returns the value that was added to the "stats_total_chunks" field in this mutation.
+func (m *RepositoryMutation) AddedStatsTotalChunks() (r int, exists bool) {
+	v := m.addstats_total_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsTotalChunks resets all changes to the "stats_total_chunks" field.
+func (m *RepositoryMutation) ResetStatsTotalChunks() {
+	m.stats_total_chunks = nil
+	m.addstats_total_chunks = nil
+}
+
+// SetStatsTotalSize sets the "stats_total_size" field.
+func (m *RepositoryMutation) SetStatsTotalSize(i int) {
+	m.stats_total_size = &i
+	m.addstats_total_size = nil
+}
+
+// StatsTotalSize returns the value of the "stats_total_size" field in the mutation.
+func (m *RepositoryMutation) StatsTotalSize() (r int, exists bool) {
+	v := m.stats_total_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsTotalSize returns the old "stats_total_size" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsTotalSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsTotalSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsTotalSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsTotalSize: %w", err)
+	}
+	return oldValue.StatsTotalSize, nil
+}
+
+// AddStatsTotalSize adds i to the "stats_total_size" field.
+func (m *RepositoryMutation) AddStatsTotalSize(i int) {
+	if m.addstats_total_size != nil {
+		*m.addstats_total_size += i
+	} else {
+		m.addstats_total_size = &i
+	}
+}
+
+// AddedStatsTotalSize returns the value that was added to the "stats_total_size" field in this mutation.
+func (m *RepositoryMutation) AddedStatsTotalSize() (r int, exists bool) {
+	v := m.addstats_total_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsTotalSize resets all changes to the "stats_total_size" field.
+func (m *RepositoryMutation) ResetStatsTotalSize() {
+	m.stats_total_size = nil
+	m.addstats_total_size = nil
+}
+
+// SetStatsTotalCsize sets the "stats_total_csize" field.
+func (m *RepositoryMutation) SetStatsTotalCsize(i int) {
+	m.stats_total_csize = &i
+	m.addstats_total_csize = nil
+}
+
+// StatsTotalCsize returns the value of the "stats_total_csize" field in the mutation.
+func (m *RepositoryMutation) StatsTotalCsize() (r int, exists bool) {
+	v := m.stats_total_csize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsTotalCsize returns the old "stats_total_csize" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsTotalCsize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsTotalCsize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsTotalCsize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsTotalCsize: %w", err)
+	}
+	return oldValue.StatsTotalCsize, nil
+}
+
+// AddStatsTotalCsize adds i to the "stats_total_csize" field.
+func (m *RepositoryMutation) AddStatsTotalCsize(i int) {
+	if m.addstats_total_csize != nil {
+		*m.addstats_total_csize += i
+	} else {
+		m.addstats_total_csize = &i
+	}
+}
+
+// AddedStatsTotalCsize returns the value that was added to the "stats_total_csize" field in this mutation.
+func (m *RepositoryMutation) AddedStatsTotalCsize() (r int, exists bool) {
+	v := m.addstats_total_csize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsTotalCsize resets all changes to the "stats_total_csize" field.
+func (m *RepositoryMutation) ResetStatsTotalCsize() {
+	m.stats_total_csize = nil
+	m.addstats_total_csize = nil
+}
+
+// SetStatsTotalUniqueChunks sets the "stats_total_unique_chunks" field.
+func (m *RepositoryMutation) SetStatsTotalUniqueChunks(i int) {
+	m.stats_total_unique_chunks = &i
+	m.addstats_total_unique_chunks = nil
+}
+
+// StatsTotalUniqueChunks returns the value of the "stats_total_unique_chunks" field in the mutation.
+func (m *RepositoryMutation) StatsTotalUniqueChunks() (r int, exists bool) {
+	v := m.stats_total_unique_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsTotalUniqueChunks returns the old "stats_total_unique_chunks" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsTotalUniqueChunks(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsTotalUniqueChunks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsTotalUniqueChunks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsTotalUniqueChunks: %w", err)
+	}
+	return oldValue.StatsTotalUniqueChunks, nil
+}
+
+// AddStatsTotalUniqueChunks adds i to the "stats_total_unique_chunks" field.
+func (m *RepositoryMutation) AddStatsTotalUniqueChunks(i int) {
+	if m.addstats_total_unique_chunks != nil {
+		*m.addstats_total_unique_chunks += i
+	} else {
+		m.addstats_total_unique_chunks = &i
+	}
+}
+
+// AddedStatsTotalUniqueChunks returns the value that was added to the "stats_total_unique_chunks" field in this mutation.
+func (m *RepositoryMutation) AddedStatsTotalUniqueChunks() (r int, exists bool) {
+	v := m.addstats_total_unique_chunks
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsTotalUniqueChunks resets all changes to the "stats_total_unique_chunks" field.
+func (m *RepositoryMutation) ResetStatsTotalUniqueChunks() {
+	m.stats_total_unique_chunks = nil
+	m.addstats_total_unique_chunks = nil
+}
+
+// SetStatsUniqueSize sets the "stats_unique_size" field.
+func (m *RepositoryMutation) SetStatsUniqueSize(i int) {
+	m.stats_unique_size = &i
+	m.addstats_unique_size = nil
+}
+
+// StatsUniqueSize returns the value of the "stats_unique_size" field in the mutation.
+func (m *RepositoryMutation) StatsUniqueSize() (r int, exists bool) {
+	v := m.stats_unique_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsUniqueSize returns the old "stats_unique_size" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsUniqueSize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsUniqueSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsUniqueSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsUniqueSize: %w", err)
+	}
+	return oldValue.StatsUniqueSize, nil
+}
+
+// AddStatsUniqueSize adds i to the "stats_unique_size" field.
+func (m *RepositoryMutation) AddStatsUniqueSize(i int) {
+	if m.addstats_unique_size != nil {
+		*m.addstats_unique_size += i
+	} else {
+		m.addstats_unique_size = &i
+	}
+}
+
+// AddedStatsUniqueSize returns the value that was added to the "stats_unique_size" field in this mutation.
+func (m *RepositoryMutation) AddedStatsUniqueSize() (r int, exists bool) {
+	v := m.addstats_unique_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsUniqueSize resets all changes to the "stats_unique_size" field.
+func (m *RepositoryMutation) ResetStatsUniqueSize() {
+	m.stats_unique_size = nil
+	m.addstats_unique_size = nil
+}
+
+// SetStatsUniqueCsize sets the "stats_unique_csize" field.
+func (m *RepositoryMutation) SetStatsUniqueCsize(i int) {
+	m.stats_unique_csize = &i
+	m.addstats_unique_csize = nil
+}
+
+// StatsUniqueCsize returns the value of the "stats_unique_csize" field in the mutation.
+func (m *RepositoryMutation) StatsUniqueCsize() (r int, exists bool) {
+	v := m.stats_unique_csize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatsUniqueCsize returns the old "stats_unique_csize" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldStatsUniqueCsize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatsUniqueCsize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatsUniqueCsize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatsUniqueCsize: %w", err)
+	}
+	return oldValue.StatsUniqueCsize, nil
+}
+
+// AddStatsUniqueCsize adds i to the "stats_unique_csize" field.
+func (m *RepositoryMutation) AddStatsUniqueCsize(i int) {
+	if m.addstats_unique_csize != nil {
+		*m.addstats_unique_csize += i
+	} else {
+		m.addstats_unique_csize = &i
+	}
+}
+
+// AddedStatsUniqueCsize returns the value that was added to the "stats_unique_csize" field in this mutation.
+func (m *RepositoryMutation) AddedStatsUniqueCsize() (r int, exists bool) {
+	v := m.addstats_unique_csize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatsUniqueCsize resets all changes to the "stats_unique_csize" field.
+func (m *RepositoryMutation) ResetStatsUniqueCsize() {
+	m.stats_unique_csize = nil
+	m.addstats_unique_csize = nil
+}
+
+// SetFileIndexEnabled sets the "file_index_enabled" field.
+func (m *RepositoryMutation) SetFileIndexEnabled(b bool) {
+	m.file_index_enabled = &b
+}
+
+// FileIndexEnabled returns the value of the "file_index_enabled" field in the mutation.
+func (m *RepositoryMutation) FileIndexEnabled() (r bool, exists bool) {
+	v := m.file_index_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFileIndexEnabled returns the old "file_index_enabled" field's value of the Repository entity.
+// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryMutation) OldFileIndexEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFileIndexEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFileIndexEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFileIndexEnabled: %w", err)
+	}
+	return oldValue.FileIndexEnabled, nil
+}
+
+// ResetFileIndexEnabled resets all changes to the "file_index_enabled" field.
+func (m *RepositoryMutation) ResetFileIndexEnabled() {
+	m.file_index_enabled = nil
+}
+
+// AddBackupProfileIDs adds the "backup_profiles" edge to the BackupProfile entity by ids.
+func (m *RepositoryMutation) AddBackupProfileIDs(ids ...int) {
+	if m.backup_profiles == nil {
+		m.backup_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.backup_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBackupProfiles clears the "backup_profiles" edge to the BackupProfile entity.
+func (m *RepositoryMutation) ClearBackupProfiles() {
+	m.clearedbackup_profiles = true
+}
+
+// BackupProfilesCleared reports if the "backup_profiles" edge to the BackupProfile entity was cleared.
+func (m *RepositoryMutation) BackupProfilesCleared() bool {
+	return m.clearedbackup_profiles
+}
+
+// RemoveBackupProfileIDs removes the "backup_profiles" edge to the BackupProfile entity by IDs.
+func (m *RepositoryMutation) RemoveBackupProfileIDs(ids ...int) {
+	if m.removedbackup_profiles == nil {
+		m.removedbackup_profiles = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.backup_profiles, ids[i])
+		m.removedbackup_profiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBackupProfiles returns the removed IDs of the "backup_profiles" edge to the BackupProfile entity.
+func (m *RepositoryMutation) RemovedBackupProfilesIDs() (ids []int) {
+	for id := range m.removedbackup_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BackupProfilesIDs returns the "backup_profiles" edge IDs in the mutation.
+func (m *RepositoryMutation) BackupProfilesIDs() (ids []int) {
+	for id := range m.backup_profiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBackupProfiles resets all changes to the "backup_profiles" edge.
+func (m *RepositoryMutation) ResetBackupProfiles() {
+	m.backup_profiles = nil
+	m.clearedbackup_profiles = false
+	m.removedbackup_profiles = nil
+}
+
+// AddArchiveIDs adds the "archives" edge to the Archive entity by ids.
+func (m *RepositoryMutation) AddArchiveIDs(ids ...int) {
+	if m.archives == nil {
+		m.archives = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.archives[ids[i]] = struct{}{}
+	}
+}
+
+// ClearArchives clears the "archives" edge to the Archive entity.
+func (m *RepositoryMutation) ClearArchives() {
+	m.clearedarchives = true
+}
+
+// ArchivesCleared reports if the "archives" edge to the Archive entity was cleared.
+func (m *RepositoryMutation) ArchivesCleared() bool {
+	return m.clearedarchives
+}
+
+// RemoveArchiveIDs removes the "archives" edge to the Archive entity by IDs.
+func (m *RepositoryMutation) RemoveArchiveIDs(ids ...int) {
+	if m.removedarchives == nil {
+		m.removedarchives = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.archives, ids[i])
+		m.removedarchives[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedArchives returns the removed IDs of the "archives" edge to the Archive entity.
+func (m *RepositoryMutation) RemovedArchivesIDs() (ids []int) {
+	for id := range m.removedarchives {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ArchivesIDs returns the "archives" edge IDs in the mutation.
+func (m *RepositoryMutation) ArchivesIDs() (ids []int) {
+	for id := range m.archives {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetArchives resets all changes to the "archives" edge.
+func (m *RepositoryMutation) ResetArchives() {
+	m.archives = nil
+	m.clearedarchives = false
+	m.removedarchives = nil
+}
+
+// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
+func (m *RepositoryMutation) AddNotificationIDs(ids ...int) {
+	if m.notifications == nil {
+		m.notifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.notifications[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotifications clears the "notifications" edge to the Notification entity.
+func (m *RepositoryMutation) ClearNotifications() {
+	m.clearednotifications = true
+}
+
+// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
+func (m *RepositoryMutation) NotificationsCleared() bool {
+	return m.clearednotifications
+}
+
+// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
+func (m *RepositoryMutation) RemoveNotificationIDs(ids ...int) {
+	if m.removednotifications == nil {
+		m.removednotifications = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.notifications, ids[i])
+		m.removednotifications[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
+func (m *RepositoryMutation) RemovedNotificationsIDs() (ids []int) {
+	for id := range m.removednotifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotificationsIDs returns the "notifications" edge IDs in the mutation.
+func (m *RepositoryMutation) NotificationsIDs() (ids []int) {
+	for id := range m.notifications {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotifications resets all changes to the "notifications" edge.
+func (m *RepositoryMutation) ResetNotifications() {
+	m.notifications = nil
+	m.clearednotifications = false
+	m.removednotifications = nil
+}
+
+// AddStatsSnapshotIDs adds the "stats_snapshots" edge to the RepositoryStatsSnapshot entity by ids.
+func (m *RepositoryMutation) AddStatsSnapshotIDs(ids ...int) {
+	if m.stats_snapshots == nil {
+		m.stats_snapshots = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.stats_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatsSnapshots clears the "stats_snapshots" edge to the RepositoryStatsSnapshot entity.
+func (m *RepositoryMutation) ClearStatsSnapshots() {
+	m.clearedstats_snapshots = true
+}
+
+// StatsSnapshotsCleared reports if the "stats_snapshots" edge to the RepositoryStatsSnapshot entity was cleared.
+func (m *RepositoryMutation) StatsSnapshotsCleared() bool {
+	return m.clearedstats_snapshots
+}
+
+// RemoveStatsSnapshotIDs removes the "stats_snapshots" edge to the RepositoryStatsSnapshot entity by IDs.
+func (m *RepositoryMutation) RemoveStatsSnapshotIDs(ids ...int) {
+	if m.removedstats_snapshots == nil {
+		m.removedstats_snapshots = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.stats_snapshots, ids[i])
+		m.removedstats_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatsSnapshots returns the removed IDs of the "stats_snapshots" edge to the RepositoryStatsSnapshot entity.
+func (m *RepositoryMutation) RemovedStatsSnapshotsIDs() (ids []int) {
+	for id := range m.removedstats_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatsSnapshotsIDs returns the "stats_snapshots" edge IDs in the mutation.
+func (m *RepositoryMutation) StatsSnapshotsIDs() (ids []int) {
+	for id := range m.stats_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatsSnapshots resets all changes to the "stats_snapshots" edge.
+func (m *RepositoryMutation) ResetStatsSnapshots() {
+	m.stats_snapshots = nil
+	m.clearedstats_snapshots = false
+	m.removedstats_snapshots = nil
+}
+
+// SetCloudRepositoryID sets the "cloud_repository" edge to the CloudRepository entity by id.
+func (m *RepositoryMutation) SetCloudRepositoryID(id int) {
+	m.cloud_repository = &id
+}
+
+// ClearCloudRepository clears the "cloud_repository" edge to the CloudRepository entity.
+func (m *RepositoryMutation) ClearCloudRepository() {
+	m.clearedcloud_repository = true
+}
+
+// CloudRepositoryCleared reports if the "cloud_repository" edge to the CloudRepository entity was cleared.
+func (m *RepositoryMutation) CloudRepositoryCleared() bool {
+	return m.clearedcloud_repository
+}
+
+// CloudRepositoryID returns the "cloud_repository" edge ID in the mutation.
+func (m *RepositoryMutation) CloudRepositoryID() (id int, exists bool) {
+	if m.cloud_repository != nil {
+		return *m.cloud_repository, true
+	}
+	return
+}
+
+// CloudRepositoryIDs returns the "cloud_repository" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CloudRepositoryID instead. It exists only for internal usage by the builders.
+func (m *RepositoryMutation) CloudRepositoryIDs() (ids []int) {
+	if id := m.cloud_repository; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCloudRepository resets all changes to the "cloud_repository" edge.
+func (m *RepositoryMutation) ResetCloudRepository() {
+	m.cloud_repository = nil
+	m.clearedcloud_repository = false
+}
+
+// Where appends a list predicates to the RepositoryMutation builder.
+func (m *RepositoryMutation) Where(ps ...predicate.Repository) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RepositoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Repository, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RepositoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RepositoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Repository).
+func (m *RepositoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RepositoryMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.created_at != nil {
+		fields = append(fields, repository.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, repository.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, repository.FieldName)
+	}
+	if m.url != nil {
+		fields = append(fields, repository.FieldURL)
+	}
+	if m.has_password != nil {
+		fields = append(fields, repository.FieldHasPassword)
+	}
+	if m.volume_uuid != nil {
+		fields = append(fields, repository.FieldVolumeUUID)
+	}
+	if m.volume_label != nil {
+		fields = append(fields, repository.FieldVolumeLabel)
+	}
+	if m.last_quick_check_at != nil {
+		fields = append(fields, repository.FieldLastQuickCheckAt)
+	}
+	if m.quick_check_error != nil {
+		fields = append(fields, repository.FieldQuickCheckError)
+	}
+	if m.last_full_check_at != nil {
+		fields = append(fields, repository.FieldLastFullCheckAt)
+	}
+	if m.full_check_error != nil {
+		fields = append(fields, repository.FieldFullCheckError)
+	}
+	if m.quick_check_interval_days != nil {
+		fields = append(fields, repository.FieldQuickCheckIntervalDays)
+	}
+	if m.full_check_interval_weeks != nil {
+		fields = append(fields, repository.FieldFullCheckIntervalWeeks)
+	}
+	if m.rolling_check_archives != nil {
+		fields = append(fields, repository.FieldRollingCheckArchives)
+	}
+	if m.check_cycle_started_at != nil {
+		fields = append(fields, repository.FieldCheckCycleStartedAt)
+	}
+	if m.stats_total_chunks != nil {
+		fields = append(fields, repository.FieldStatsTotalChunks)
+	}
+	if m.stats_total_size != nil {
+		fields = append(fields, repository.FieldStatsTotalSize)
+	}
+	if m.stats_total_csize != nil {
+		fields = append(fields, repository.FieldStatsTotalCsize)
+	}
+	if m.stats_total_unique_chunks != nil {
+		fields = append(fields, repository.FieldStatsTotalUniqueChunks)
+	}
+	if m.stats_unique_size != nil {
+		fields = append(fields, repository.FieldStatsUniqueSize)
+	}
+	if m.stats_unique_csize != nil {
+		fields = append(fields, repository.FieldStatsUniqueCsize)
+	}
+	if m.file_index_enabled != nil {
+		fields = append(fields, repository.FieldFileIndexEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RepositoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case repository.FieldCreatedAt:
+		return m.CreatedAt()
+	case repository.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case repository.FieldName:
+		return m.Name()
+	case repository.FieldURL:
+		return m.URL()
+	case repository.FieldHasPassword:
+		return m.HasPassword()
+	case repository.FieldVolumeUUID:
+		return m.VolumeUUID()
+	case repository.FieldVolumeLabel:
+		return m.VolumeLabel()
+	case repository.FieldLastQuickCheckAt:
+		return m.LastQuickCheckAt()
+	case repository.FieldQuickCheckError:
+		return m.QuickCheckError()
+	case repository.FieldLastFullCheckAt:
+		return m.LastFullCheckAt()
+	case repository.FieldFullCheckError:
+		return m.FullCheckError()
+	case repository.FieldQuickCheckIntervalDays:
+		return m.QuickCheckIntervalDays()
+	case repository.FieldFullCheckIntervalWeeks:
+		return m.FullCheckIntervalWeeks()
+	case repository.FieldRollingCheckArchives:
+		return m.RollingCheckArchives()
+	case repository.FieldCheckCycleStartedAt:
+		return m.CheckCycleStartedAt()
+	case repository.FieldStatsTotalChunks:
+		return m.StatsTotalChunks()
+	case repository.FieldStatsTotalSize:
+		return m.StatsTotalSize()
+	case repository.FieldStatsTotalCsize:
+		return m.StatsTotalCsize()
+	case repository.FieldStatsTotalUniqueChunks:
+		return m.StatsTotalUniqueChunks()
+	case repository.FieldStatsUniqueSize:
+		return m.StatsUniqueSize()
+	case repository.FieldStatsUniqueCsize:
+		return m.StatsUniqueCsize()
+	case repository.FieldFileIndexEnabled:
+		return m.FileIndexEnabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RepositoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case repository.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case repository.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case repository.FieldName:
+		return m.OldName(ctx)
+	case repository.FieldURL:
+		return m.OldURL(ctx)
+	case repository.FieldHasPassword:
+		return m.OldHasPassword(ctx)
+	case repository.FieldVolumeUUID:
+		return m.OldVolumeUUID(ctx)
+	case repository.FieldVolumeLabel:
+		return m.OldVolumeLabel(ctx)
+	case repository.FieldLastQuickCheckAt:
+		return m.OldLastQuickCheckAt(ctx)
+	case repository.FieldQuickCheckError:
+		return m.OldQuickCheckError(ctx)
+	case repository.FieldLastFullCheckAt:
+		return m.OldLastFullCheckAt(ctx)
+	case repository.FieldFullCheckError:
+		return m.OldFullCheckError(ctx)
+	case repository.FieldQuickCheckIntervalDays:
+		return m.OldQuickCheckIntervalDays(ctx)
+	case repository.FieldFullCheckIntervalWeeks:
+		return m.OldFullCheckIntervalWeeks(ctx)
+	case repository.FieldRollingCheckArchives:
+		return m.OldRollingCheckArchives(ctx)
+	case repository.FieldCheckCycleStartedAt:
+		return m.OldCheckCycleStartedAt(ctx)
+	case repository.FieldStatsTotalChunks:
+		return m.OldStatsTotalChunks(ctx)
+	case repository.FieldStatsTotalSize:
+		return m.OldStatsTotalSize(ctx)
+	case repository.FieldStatsTotalCsize:
+		return m.OldStatsTotalCsize(ctx)
+	case repository.FieldStatsTotalUniqueChunks:
+		return m.OldStatsTotalUniqueChunks(ctx)
+	case repository.FieldStatsUniqueSize:
+		return m.OldStatsUniqueSize(ctx)
+	case repository.FieldStatsUniqueCsize:
+		return m.OldStatsUniqueCsize(ctx)
+	case repository.FieldFileIndexEnabled:
+		return m.OldFileIndexEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown Repository field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepositoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case repository.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case repository.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case repository.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case repository.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case repository.FieldHasPassword:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHasPassword(v)
+		return nil
+	case repository.FieldVolumeUUID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumeUUID(v)
+		return nil
+	case repository.FieldVolumeLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolumeLabel(v)
+		return nil
+	case repository.FieldLastQuickCheckAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastQuickCheckAt(v)
+		return nil
+	case repository.FieldQuickCheckError:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuickCheckError(v)
+		return nil
+	case repository.FieldLastFullCheckAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastFullCheckAt(v)
+		return nil
+	case repository.FieldFullCheckError:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullCheckError(v)
+		return nil
+	case repository.FieldQuickCheckIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuickCheckIntervalDays(v)
+		return nil
+	case repository.FieldFullCheckIntervalWeeks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFullCheckIntervalWeeks(v)
+		return nil
+	case repository.FieldRollingCheckArchives:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRollingCheckArchives(v)
+		return nil
+	case repository.FieldCheckCycleStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckCycleStartedAt(v)
+		return nil
+	case repository.FieldStatsTotalChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsTotalChunks(v)
+		return nil
+	case repository.FieldStatsTotalSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsTotalSize(v)
+		return nil
+	case repository.FieldStatsTotalCsize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsTotalCsize(v)
+		return nil
+	case repository.FieldStatsTotalUniqueChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsTotalUniqueChunks(v)
+		return nil
+	case repository.FieldStatsUniqueSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsUniqueSize(v)
+		return nil
+	case repository.FieldStatsUniqueCsize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatsUniqueCsize(v)
+		return nil
+	case repository.FieldFileIndexEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFileIndexEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Repository field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RepositoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addquick_check_interval_days != nil {
+		fields = append(fields, repository.FieldQuickCheckIntervalDays)
+	}
+	if m.addfull_check_interval_weeks != nil {
+		fields = append(fields, repository.FieldFullCheckIntervalWeeks)
+	}
+	if m.addrolling_check_archives != nil {
+		fields = append(fields, repository.FieldRollingCheckArchives)
+	}
+	if m.addstats_total_chunks != nil {
+		fields = append(fields, repository.FieldStatsTotalChunks)
+	}
+	if m.addstats_total_size != nil {
+		fields = append(fields, repository.FieldStatsTotalSize)
+	}
+	if m.addstats_total_csize != nil {
+		fields = append(fields, repository.FieldStatsTotalCsize)
+	}
+	if m.addstats_total_unique_chunks != nil {
+		fields = append(fields, repository.FieldStatsTotalUniqueChunks)
+	}
+	if m.addstats_unique_size != nil {
+		fields = append(fields, repository.FieldStatsUniqueSize)
+	}
+	if m.addstats_unique_csize != nil {
+		fields = append(fields, repository.FieldStatsUniqueCsize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RepositoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case repository.FieldQuickCheckIntervalDays:
+		return m.AddedQuickCheckIntervalDays()
+	case repository.FieldFullCheckIntervalWeeks:
+		return m.AddedFullCheckIntervalWeeks()
+	case repository.FieldRollingCheckArchives:
+		return m.AddedRollingCheckArchives()
+	case repository.FieldStatsTotalChunks:
+		return m.AddedStatsTotalChunks()
+	case repository.FieldStatsTotalSize:
+		return m.AddedStatsTotalSize()
+	case repository.FieldStatsTotalCsize:
+		return m.AddedStatsTotalCsize()
+	case repository.FieldStatsTotalUniqueChunks:
+		return m.AddedStatsTotalUniqueChunks()
+	case repository.FieldStatsUniqueSize:
+		return m.AddedStatsUniqueSize()
+	case repository.FieldStatsUniqueCsize:
+		return m.AddedStatsUniqueCsize()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepositoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case repository.FieldQuickCheckIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuickCheckIntervalDays(v)
+		return nil
+	case repository.FieldFullCheckIntervalWeeks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFullCheckIntervalWeeks(v)
+		return nil
+	case repository.FieldRollingCheckArchives:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRollingCheckArchives(v)
+		return nil
+	case repository.FieldStatsTotalChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsTotalChunks(v)
+		return nil
+	case repository.FieldStatsTotalSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsTotalSize(v)
+		return nil
+	case repository.FieldStatsTotalCsize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsTotalCsize(v)
+		return nil
+	case repository.FieldStatsTotalUniqueChunks:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsTotalUniqueChunks(v)
+		return nil
+	case repository.FieldStatsUniqueSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsUniqueSize(v)
+		return nil
+	case repository.FieldStatsUniqueCsize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatsUniqueCsize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Repository numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RepositoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(repository.FieldVolumeUUID) {
+		fields = append(fields, repository.FieldVolumeUUID)
+	}
+	if m.FieldCleared(repository.FieldVolumeLabel) {
+		fields = append(fields, repository.FieldVolumeLabel)
+	}
+	if m.FieldCleared(repository.FieldLastQuickCheckAt) {
+		fields = append(fields, repository.FieldLastQuickCheckAt)
+	}
+	if m.FieldCleared(repository.FieldQuickCheckError) {
+		fields = append(fields, repository.FieldQuickCheckError)
+	}
+	if m.FieldCleared(repository.FieldLastFullCheckAt) {
+		fields = append(fields, repository.FieldLastFullCheckAt)
+	}
+	if m.FieldCleared(repository.FieldFullCheckError) {
+		fields = append(fields, repository.FieldFullCheckError)
+	}
+	if m.FieldCleared(repository.FieldCheckCycleStartedAt) {
+		fields = append(fields, repository.FieldCheckCycleStartedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RepositoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RepositoryMutation) ClearField(name string) error {
+	switch name {
+	case repository.FieldVolumeUUID:
+		m.ClearVolumeUUID()
+		return nil
+	case repository.FieldVolumeLabel:
+		m.ClearVolumeLabel()
+		return nil
+	case repository.FieldLastQuickCheckAt:
+		m.ClearLastQuickCheckAt()
+		return nil
+	case repository.FieldQuickCheckError:
+		m.ClearQuickCheckError()
+		return nil
+	case repository.FieldLastFullCheckAt:
+		m.ClearLastFullCheckAt()
+		return nil
+	case repository.FieldFullCheckError:
+		m.ClearFullCheckError()
+		return nil
+	case repository.FieldCheckCycleStartedAt:
+		m.ClearCheckCycleStartedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Repository nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RepositoryMutation) ResetField(name string) error {
+	switch name {
+	case repository.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case repository.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case repository.FieldName:
+		m.ResetName()
+		return nil
+	case repository.FieldURL:
+		m.ResetURL()
+		return nil
+	case repository.FieldHasPassword:
+		m.ResetHasPassword()
+		return nil
+	case repository.FieldVolumeUUID:
+		m.ResetVolumeUUID()
+		return nil
+	case repository.FieldVolumeLabel:
+		m.ResetVolumeLabel()
+		return nil
+	case repository.FieldLastQuickCheckAt:
+		m.ResetLastQuickCheckAt()
+		return nil
+	case repository.FieldQuickCheckError:
+		m.ResetQuickCheckError()
+		return nil
+	case repository.FieldLastFullCheckAt:
+		m.ResetLastFullCheckAt()
+		return nil
+	case repository.FieldFullCheckError:
+		m.ResetFullCheckError()
+		return nil
+	case repository.FieldQuickCheckIntervalDays:
+		m.ResetQuickCheckIntervalDays()
+		return nil
+	case repository.FieldFullCheckIntervalWeeks:
+		m.ResetFullCheckIntervalWeeks()
+		return nil
+	case repository.FieldRollingCheckArchives:
+		m.ResetRollingCheckArchives()
+		return nil
+	case repository.FieldCheckCycleStartedAt:
+		m.ResetCheckCycleStartedAt()
+		return nil
+	case repository.FieldStatsTotalChunks:
+		m.ResetStatsTotalChunks()
+		return nil
+	case repository.FieldStatsTotalSize:
+		m.ResetStatsTotalSize()
+		return nil
+	case repository.FieldStatsTotalCsize:
+		m.ResetStatsTotalCsize()
+		return nil
+	case repository.FieldStatsTotalUniqueChunks:
+		m.ResetStatsTotalUniqueChunks()
+		return nil
+	case repository.FieldStatsUniqueSize:
+		m.ResetStatsUniqueSize()
+		return nil
+	case repository.FieldStatsUniqueCsize:
+		m.ResetStatsUniqueCsize()
+		return nil
+	case repository.FieldFileIndexEnabled:
+		m.ResetFileIndexEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown Repository field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RepositoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.backup_profiles != nil {
+		edges = append(edges, repository.EdgeBackupProfiles)
+	}
+	if m.archives != nil {
+		edges = append(edges, repository.EdgeArchives)
+	}
+	if m.notifications != nil {
+		edges = append(edges, repository.EdgeNotifications)
+	}
+	if m.stats_snapshots != nil {
+		edges = append(edges, repository.EdgeStatsSnapshots)
+	}
+	if m.cloud_repository != nil {
+		edges = append(edges, repository.EdgeCloudRepository)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RepositoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case repository.EdgeBackupProfiles:
+		ids := make([]ent.Value, 0, len(m.backup_profiles))
+		for id := range m.backup_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeArchives:
+		ids := make([]ent.Value, 0, len(m.archives))
+		for id := range m.archives {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.notifications))
+		for id := range m.notifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeStatsSnapshots:
+		ids := make([]ent.Value, 0, len(m.stats_snapshots))
+		for id := range m.stats_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeCloudRepository:
+		if id := m.cloud_repository; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RepositoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedbackup_profiles != nil {
+		edges = append(edges, repository.EdgeBackupProfiles)
+	}
+	if m.removedarchives != nil {
+		edges = append(edges, repository.EdgeArchives)
+	}
+	if m.removednotifications != nil {
+		edges = append(edges, repository.EdgeNotifications)
+	}
+	if m.removedstats_snapshots != nil {
+		edges = append(edges, repository.EdgeStatsSnapshots)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RepositoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case repository.EdgeBackupProfiles:
+		ids := make([]ent.Value, 0, len(m.removedbackup_profiles))
+		for id := range m.removedbackup_profiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeArchives:
+		ids := make([]ent.Value, 0, len(m.removedarchives))
+		for id := range m.removedarchives {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeNotifications:
+		ids := make([]ent.Value, 0, len(m.removednotifications))
+		for id := range m.removednotifications {
+			ids = append(ids, id)
+		}
+		return ids
+	case repository.EdgeStatsSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedstats_snapshots))
+		for id := range m.removedstats_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// OldFullCheckError returns the old "full_check_error" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldFullCheckError(ctx context.Context) (v []string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFullCheckError is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RepositoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedbackup_profiles {
+		edges = append(edges, repository.EdgeBackupProfiles)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFullCheckError requires an ID field in the mutation")
+	if m.clearedarchives {
+		edges = append(edges, repository.EdgeArchives)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFullCheckError: %w", err)
+	if m.clearednotifications {
+		edges = append(edges, repository.EdgeNotifications)
 	}
-	return oldValue.FullCheckError, nil
-}
-
-// AppendFullCheckError adds s to the "full_check_error" field.
-func (m *RepositoryMutation) AppendFullCheckError(s []string) {
-	m.appendfull_check_error = append(m.appendfull_check_error, s...)
-}
-
-// AppendedFullCheckError returns the list of values that were appended to the "full_check_error" field in this mutation.
-func (m *RepositoryMutation) AppendedFullCheckError() ([]string, bool) {
-	if len(m.appendfull_check_error) == 0 {
-		return nil, false
+	if m.clearedstats_snapshots {
+		edges = append(edges, repository.EdgeStatsSnapshots)
 	}
-	return m.appendfull_check_error, true
-}
-
-// ClearFullCheckError clears the value of the "full_check_error" field.
-func (m *RepositoryMutation) ClearFullCheckError() {
-	m.full_check_error = nil
-	m.appendfull_check_error = nil
-	m.clearedFields[repository.FieldFullCheckError] = struct{}{}
-}
-
-// FullCheckErrorCleared returns if the "full_check_error" field was cleared in this mutation.
-func (m *RepositoryMutation) FullCheckErrorCleared() bool {
-	_, ok := m.clearedFields[repository.FieldFullCheckError]
-	return ok
+	if m.clearedcloud_repository {
+		edges = append(edges, repository.EdgeCloudRepository)
+	}
+	return edges
 }
 
-// ResetFullCheckError resets all changes to the "full_check_error" field.
-func (m *RepositoryMutation) ResetFullCheckError() {
-	m.full_check_error = nil
-	m.appendfull_check_error = nil
-	delete(m.clearedFields, repository.FieldFullCheckError)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RepositoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case repository.EdgeBackupProfiles:
+		return m.clearedbackup_profiles
+	case repository.EdgeArchives:
+		return m.clearedarchives
+	case repository.EdgeNotifications:
+		return m.clearednotifications
+	case repository.EdgeStatsSnapshots:
+		return m.clearedstats_snapshots
+	case repository.EdgeCloudRepository:
+		return m.clearedcloud_repository
+	}
+	return false
 }
 
-// SetStatsTotalChunks sets the "stats_total_chunks" field.
-func (m *RepositoryMutation) SetStatsTotalChunks(i int) {
-	m.stats_total_chunks = &i
-	m.addstats_total_chunks = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RepositoryMutation) ClearEdge(name string) error {
+	switch name {
+	case repository.EdgeCloudRepository:
+		m.ClearCloudRepository()
+		return nil
+	}
+	return fmt.Errorf("unknown Repository unique edge %s", name)
 }
 
-// StatsTotalChunks returns the value of the "stats_total_chunks" field in the mutation.
-func (m *RepositoryMutation) StatsTotalChunks() (r int, exists bool) {
-	v := m.stats_total_chunks
-	if v == nil {
-		return
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RepositoryMutation) ResetEdge(name string) error {
+	switch name {
+	case repository.EdgeBackupProfiles:
+		m.ResetBackupProfiles()
+		return nil
+	case repository.EdgeArchives:
+		m.ResetArchives()
+		return nil
+	case repository.EdgeNotifications:
+		m.ResetNotifications()
+		return nil
+	case repository.EdgeStatsSnapshots:
+		m.ResetStatsSnapshots()
+		return nil
+	case repository.EdgeCloudRepository:
+		m.ResetCloudRepository()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Repository edge %s", name)
 }
 
-// OldStatsTotalChunks returns the old "stats_total_chunks" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsTotalChunks(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsTotalChunks is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsTotalChunks requires an ID field in the mutation")
+// RepositoryStatsSnapshotMutation represents an operation that mutates the RepositoryStatsSnapshot nodes in the graph.
+type RepositoryStatsSnapshotMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	total_size        *int
+	addtotal_size     *int
+	total_csize       *int
+	addtotal_csize    *int
+	unique_size       *int
+	addunique_size    *int
+	unique_csize      *int
+	addunique_csize   *int
+	clearedFields     map[string]struct{}
+	repository        *int
+	clearedrepository bool
+	done              bool
+	oldValue          func(context.Context) (*RepositoryStatsSnapshot, error)
+	predicates        []predicate.RepositoryStatsSnapshot
+}
+
+var _ ent.Mutation = (*RepositoryStatsSnapshotMutation)(nil)
+
+// repositorystatssnapshotOption allows management of the mutation configuration using functional options.
+type repositorystatssnapshotOption func(*RepositoryStatsSnapshotMutation)
+
+// newRepositoryStatsSnapshotMutation creates new mutation for the RepositoryStatsSnapshot entity.
+func newRepositoryStatsSnapshotMutation(c config, op Op, opts ...repositorystatssnapshotOption) *RepositoryStatsSnapshotMutation {
+	m := &RepositoryStatsSnapshotMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRepositoryStatsSnapshot,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsTotalChunks: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.StatsTotalChunks, nil
+	return m
 }
 
-// AddStatsTotalChunks adds i to the "stats_total_chunks" field.
-func (m *RepositoryMutation) AddStatsTotalChunks(i int) {
-	if m.addstats_total_chunks != nil {
-		*m.addstats_total_chunks += i
-	} else {
-		m.addstats_total_chunks = &i
+// withRepositoryStatsSnapshotID sets the ID field of the mutation.
+func withRepositoryStatsSnapshotID(id int) repositorystatssnapshotOption {
+	return func(m *RepositoryStatsSnapshotMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RepositoryStatsSnapshot
+		)
+		m.oldValue = func(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RepositoryStatsSnapshot.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedStatsTotalChunks returns the value that was added to the "stats_total_chunks" field in this mutation.
-func (m *RepositoryMutation) AddedStatsTotalChunks() (r int, exists bool) {
-	v := m.addstats_total_chunks
-	if v == nil {
-		return
+// withRepositoryStatsSnapshot sets the old RepositoryStatsSnapshot of the mutation.
+func withRepositoryStatsSnapshot(node *RepositoryStatsSnapshot) repositorystatssnapshotOption {
+	return func(m *RepositoryStatsSnapshotMutation) {
+		m.oldValue = func(context.Context) (*RepositoryStatsSnapshot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
-}
-
-// ResetStatsTotalChunks resets all changes to the "stats_total_chunks" field.
-func (m *RepositoryMutation) ResetStatsTotalChunks() {
-	m.stats_total_chunks = nil
-	m.addstats_total_chunks = nil
 }
 
-// SetStatsTotalSize sets the "stats_total_size" field.
-func (m *RepositoryMutation) SetStatsTotalSize(i int) {
-	m.stats_total_size = &i
-	m.addstats_total_size = nil
-}
-
-// StatsTotalSize returns the value of the "stats_total_size" field in the mutation.
-func (m *RepositoryMutation) StatsTotalSize() (r int, exists bool) {
-	v := m.stats_total_size
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RepositoryStatsSnapshotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldStatsTotalSize returns the old "stats_total_size" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsTotalSize(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsTotalSize is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsTotalSize requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsTotalSize: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RepositoryStatsSnapshotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.StatsTotalSize, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddStatsTotalSize adds i to the "stats_total_size" field.
-func (m *RepositoryMutation) AddStatsTotalSize(i int) {
-	if m.addstats_total_size != nil {
-		*m.addstats_total_size += i
-	} else {
-		m.addstats_total_size = &i
-	}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RepositoryStatsSnapshot entities.
+func (m *RepositoryStatsSnapshotMutation) SetID(id int) {
+	m.id = &id
 }
 
-// AddedStatsTotalSize returns the value that was added to the "stats_total_size" field in this mutation.
-func (m *RepositoryMutation) AddedStatsTotalSize() (r int, exists bool) {
-	v := m.addstats_total_size
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RepositoryStatsSnapshotMutation) ID() (id int, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// ResetStatsTotalSize resets all changes to the "stats_total_size" field.
-func (m *RepositoryMutation) ResetStatsTotalSize() {
-	m.stats_total_size = nil
-	m.addstats_total_size = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RepositoryStatsSnapshotMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RepositoryStatsSnapshot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetStatsTotalCsize sets the "stats_total_csize" field.
-func (m *RepositoryMutation) SetStatsTotalCsize(i int) {
-	m.stats_total_csize = &i
-	m.addstats_total_csize = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *RepositoryStatsSnapshotMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// StatsTotalCsize returns the value of the "stats_total_csize" field in the mutation.
-func (m *RepositoryMutation) StatsTotalCsize() (r int, exists bool) {
-	v := m.stats_total_csize
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatsTotalCsize returns the old "stats_total_csize" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsTotalCsize(ctx context.Context) (v int, err error) {
+func (m *RepositoryStatsSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsTotalCsize is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsTotalCsize requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsTotalCsize: %w", err)
-	}
-	return oldValue.StatsTotalCsize, nil
-}
-
-// AddStatsTotalCsize adds i to the "stats_total_csize" field.
-func (m *RepositoryMutation) AddStatsTotalCsize(i int) {
-	if m.addstats_total_csize != nil {
-		*m.addstats_total_csize += i
-	} else {
-		m.addstats_total_csize = &i
-	}
-}
-
-// AddedStatsTotalCsize returns the value that was added to the "stats_total_csize" field in this mutation.
-func (m *RepositoryMutation) AddedStatsTotalCsize() (r int, exists bool) {
-	v := m.addstats_total_csize
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStatsTotalCsize resets all changes to the "stats_total_csize" field.
-func (m *RepositoryMutation) ResetStatsTotalCsize() {
-	m.stats_total_csize = nil
-	m.addstats_total_csize = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RepositoryStatsSnapshotMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatsTotalUniqueChunks sets the "stats_total_unique_chunks" field.
-func (m *RepositoryMutation) SetStatsTotalUniqueChunks(i int) {
-	m.stats_total_unique_chunks = &i
-	m.addstats_total_unique_chunks = nil
+// SetTotalSize sets the "total_size" field.
+func (m *RepositoryStatsSnapshotMutation) SetTotalSize(i int) {
+	m.total_size = &i
+	m.addtotal_size = nil
 }
 
-// StatsTotalUniqueChunks returns the value of the "stats_total_unique_chunks" field in the mutation.
-func (m *RepositoryMutation) StatsTotalUniqueChunks() (r int, exists bool) {
-	v := m.stats_total_unique_chunks
+// TotalSize returns the value of the "total_size" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) TotalSize() (r int, exists bool) {
+	v := m.total_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatsTotalUniqueChunks returns the old "stats_total_unique_chunks" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalSize returns the old "total_size" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsTotalUniqueChunks(ctx context.Context) (v int, err error) {
+func (m *RepositoryStatsSnapshotMutation) OldTotalSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsTotalUniqueChunks is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsTotalUniqueChunks requires an ID field in the mutation")
+		return v, errors.New("OldTotalSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsTotalUniqueChunks: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalSize: %w", err)
 	}
-	return oldValue.StatsTotalUniqueChunks, nil
+	return oldValue.TotalSize, nil
 }
 
-// AddStatsTotalUniqueChunks adds i to the "stats_total_unique_chunks" field.
-func (m *RepositoryMutation) AddStatsTotalUniqueChunks(i int) {
-	if m.addstats_total_unique_chunks != nil {
-		*m.addstats_total_unique_chunks += i
+// AddTotalSize adds i to the "total_size" field.
+func (m *RepositoryStatsSnapshotMutation) AddTotalSize(i int) {
+	if m.addtotal_size != nil {
+		*m.addtotal_size += i
 	} else {
-		m.addstats_total_unique_chunks = &i
+		m.addtotal_size = &i
 	}
 }
 
-// AddedStatsTotalUniqueChunks returns the value that was added to the "stats_total_unique_chunks" field in this mutation.
-func (m *RepositoryMutation) AddedStatsTotalUniqueChunks() (r int, exists bool) {
-	v := m.addstats_total_unique_chunks
+// AddedTotalSize returns the value that was added to the "total_size" field in this mutation.
+func (m *RepositoryStatsSnapshotMutation) AddedTotalSize() (r int, exists bool) {
+	v := m.addtotal_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatsTotalUniqueChunks resets all changes to the "stats_total_unique_chunks" field.
-func (m *RepositoryMutation) ResetStatsTotalUniqueChunks() {
-	m.stats_total_unique_chunks = nil
-	m.addstats_total_unique_chunks = nil
+// ResetTotalSize resets all changes to the "total_size" field.
+func (m *RepositoryStatsSnapshotMutation) ResetTotalSize() {
+	m.total_size = nil
+	m.addtotal_size = nil
 }
 
-// SetStatsUniqueSize sets the "stats_unique_size" field.
-func (m *RepositoryMutation) SetStatsUniqueSize(i int) {
-	m.stats_unique_size = &i
-	m.addstats_unique_size = nil
+// SetTotalCsize sets the "total_csize" field.
+func (m *RepositoryStatsSnapshotMutation) SetTotalCsize(i int) {
+	m.total_csize = &i
+	m.addtotal_csize = nil
 }
 
-// StatsUniqueSize returns the value of the "stats_unique_size" field in the mutation.
-func (m *RepositoryMutation) StatsUniqueSize() (r int, exists bool) {
-	v := m.stats_unique_size
+// TotalCsize returns the value of the "total_csize" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) TotalCsize() (r int, exists bool) {
+	v := m.total_csize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatsUniqueSize returns the old "stats_unique_size" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalCsize returns the old "total_csize" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsUniqueSize(ctx context.Context) (v int, err error) {
+func (m *RepositoryStatsSnapshotMutation) OldTotalCsize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsUniqueSize is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalCsize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsUniqueSize requires an ID field in the mutation")
+		return v, errors.New("OldTotalCsize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsUniqueSize: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalCsize: %w", err)
 	}
-	return oldValue.StatsUniqueSize, nil
+	return oldValue.TotalCsize, nil
 }
 
-// AddStatsUniqueSize adds i to the "stats_unique_size" field.
-func (m *RepositoryMutation) AddStatsUniqueSize(i int) {
-	if m.addstats_unique_size != nil {
-		*m.addstats_unique_size += i
+// AddTotalCsize adds i to the "total_csize" field.
+func (m *RepositoryStatsSnapshotMutation) AddTotalCsize(i int) {
+	if m.addtotal_csize != nil {
+		*m.addtotal_csize += i
 	} else {
-		m.addstats_unique_size = &i
+		m.addtotal_csize = &i
 	}
 }
 
-// AddedStatsUniqueSize returns the value that was added to the "stats_unique_size" field in this mutation.
-func (m *RepositoryMutation) AddedStatsUniqueSize() (r int, exists bool) {
-	v := m.addstats_unique_size
+// AddedTotalCsize returns the value that was added to the "total_csize" field in this mutation.
+func (m *RepositoryStatsSnapshotMutation) AddedTotalCsize() (r int, exists bool) {
+	v := m.addtotal_csize
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatsUniqueSize resets all changes to the "stats_unique_size" field.
-func (m *RepositoryMutation) ResetStatsUniqueSize() {
-	m.stats_unique_size = nil
-	m.addstats_unique_size = nil
+// ResetTotalCsize resets all changes to the "total_csize" field.
+func (m *RepositoryStatsSnapshotMutation) ResetTotalCsize() {
+	m.total_csize = nil
+	m.addtotal_csize = nil
 }
 
-// SetStatsUniqueCsize sets the "stats_unique_csize" field.
-func (m *RepositoryMutation) SetStatsUniqueCsize(i int) {
-	m.stats_unique_csize = &i
-	m.addstats_unique_csize = nil
+// SetUniqueSize sets the "unique_size" field.
+func (m *RepositoryStatsSnapshotMutation) SetUniqueSize(i int) {
+	m.unique_size = &i
+	m.addunique_size = nil
 }
 
-// StatsUniqueCsize returns the value of the "stats_unique_csize" field in the mutation.
-func (m *RepositoryMutation) StatsUniqueCsize() (r int, exists bool) {
-	v := m.stats_unique_csize
+// UniqueSize returns the value of the "unique_size" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) UniqueSize() (r int, exists bool) {
+	v := m.unique_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatsUniqueCsize returns the old "stats_unique_csize" field's value of the Repository entity.
-// If the Repository object wasn't provided to the builder, the object is fetched from the database.
+// OldUniqueSize returns the old "unique_size" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RepositoryMutation) OldStatsUniqueCsize(ctx context.Context) (v int, err error) {
+func (m *RepositoryStatsSnapshotMutation) OldUniqueSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatsUniqueCsize is only allowed on UpdateOne operations")
+		return v, errors.New("OldUniqueSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatsUniqueCsize requires an ID field in the mutation")
+		return v, errors.New("OldUniqueSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatsUniqueCsize: %w", err)
+		return v, fmt.Errorf("querying old value for OldUniqueSize: %w", err)
 	}
-	return oldValue.StatsUniqueCsize, nil
+	return oldValue.UniqueSize, nil
 }
 
-// AddStatsUniqueCsize adds i to the "stats_unique_csize" field.
-func (m *RepositoryMutation) AddStatsUniqueCsize(i int) {
-	if m.addstats_unique_csize != nil {
-		*m.addstats_unique_csize += i
+// AddUniqueSize adds i to the "unique_size" field.
+func (m *RepositoryStatsSnapshotMutation) AddUniqueSize(i int) {
+	if m.addunique_size != nil {
+		*m.addunique_size += i
 	} else {
-		m.addstats_unique_csize = &i
+		m.addunique_size = &i
 	}
 }
 
-// AddedStatsUniqueCsize returns the value that was added to the "stats_unique_csize" field in this mutation.
-func (m *RepositoryMutation) AddedStatsUniqueCsize() (r int, exists bool) {
-	v := m.addstats_unique_csize
+// AddedUniqueSize returns the value that was added to the "unique_size" field in this mutation.
+func (m *RepositoryStatsSnapshotMutation) AddedUniqueSize() (r int, exists bool) {
+	v := m.addunique_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatsUniqueCsize resets all changes to the "stats_unique_csize" field.
-func (m *RepositoryMutation) ResetStatsUniqueCsize() {
-	m.stats_unique_csize = nil
-	m.addstats_unique_csize = nil
-}
-
-// AddBackupProfileIDs adds the "backup_profiles" edge to the BackupProfile entity by ids.
-func (m *RepositoryMutation) AddBackupProfileIDs(ids ...int) {
-	if m.backup_profiles == nil {
-		m.backup_profiles = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.backup_profiles[ids[i]] = struct{}{}
-	}
-}
-
-// ClearBackupProfiles clears the "backup_profiles" edge to the BackupProfile entity.
-func (m *RepositoryMutation) ClearBackupProfiles() {
-	m.clearedbackup_profiles = true
-}
-
-// BackupProfilesCleared reports if the "backup_profiles" edge to the BackupProfile entity was cleared.
-func (m *RepositoryMutation) BackupProfilesCleared() bool {
-	return m.clearedbackup_profiles
-}
-
-// RemoveBackupProfileIDs removes the "backup_profiles" edge to the BackupProfile entity by IDs.
-func (m *RepositoryMutation) RemoveBackupProfileIDs(ids ...int) {
-	if m.removedbackup_profiles == nil {
-		m.removedbackup_profiles = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.backup_profiles, ids[i])
-		m.removedbackup_profiles[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedBackupProfiles returns the removed IDs of the "backup_profiles" edge to the BackupProfile entity.
-func (m *RepositoryMutation) RemovedBackupProfilesIDs() (ids []int) {
-	for id := range m.removedbackup_profiles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// BackupProfilesIDs returns the "backup_profiles" edge IDs in the mutation.
-func (m *RepositoryMutation) BackupProfilesIDs() (ids []int) {
-	for id := range m.backup_profiles {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetBackupProfiles resets all changes to the "backup_profiles" edge.
-func (m *RepositoryMutation) ResetBackupProfiles() {
-	m.backup_profiles = nil
-	m.clearedbackup_profiles = false
-	m.removedbackup_profiles = nil
-}
-
-// AddArchiveIDs adds the "archives" edge to the Archive entity by ids.
-func (m *RepositoryMutation) AddArchiveIDs(ids ...int) {
-	if m.archives == nil {
-		m.archives = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.archives[ids[i]] = struct{}{}
-	}
-}
-
-// ClearArchives clears the "archives" edge to the Archive entity.
-func (m *RepositoryMutation) ClearArchives() {
-	m.clearedarchives = true
-}
-
-// ArchivesCleared reports if the "archives" edge to the Archive entity was cleared.
-func (m *RepositoryMutation) ArchivesCleared() bool {
-	return m.clearedarchives
-}
-
-// RemoveArchiveIDs removes the "archives" edge to the Archive entity by IDs.
-func (m *RepositoryMutation) RemoveArchiveIDs(ids ...int) {
-	if m.removedarchives == nil {
-		m.removedarchives = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.archives, ids[i])
-		m.removedarchives[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedArchives returns the removed IDs of the "archives" edge to the Archive entity.
-func (m *RepositoryMutation) RemovedArchivesIDs() (ids []int) {
-	for id := range m.removedarchives {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ArchivesIDs returns the "archives" edge IDs in the mutation.
-func (m *RepositoryMutation) ArchivesIDs() (ids []int) {
-	for id := range m.archives {
-		ids = append(ids, id)
-	}
-	return
+// ResetUniqueSize resets all changes to the "unique_size" field.
+func (m *RepositoryStatsSnapshotMutation) ResetUniqueSize() {
+	m.unique_size = nil
+	m.addunique_size = nil
 }
 
-// ResetArchives resets all changes to the "archives" edge.
-func (m *RepositoryMutation) ResetArchives() {
-	m.archives = nil
-	m.clearedarchives = false
-	m.removedarchives = nil
+// SetUniqueCsize sets the "unique_csize" field.
+func (m *RepositoryStatsSnapshotMutation) SetUniqueCsize(i int) {
+	m.unique_csize = &i
+	m.addunique_csize = nil
 }
 
-// AddNotificationIDs adds the "notifications" edge to the Notification entity by ids.
-func (m *RepositoryMutation) AddNotificationIDs(ids ...int) {
-	if m.notifications == nil {
-		m.notifications = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.notifications[ids[i]] = struct{}{}
+// UniqueCsize returns the value of the "unique_csize" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) UniqueCsize() (r int, exists bool) {
+	v := m.unique_csize
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearNotifications clears the "notifications" edge to the Notification entity.
-func (m *RepositoryMutation) ClearNotifications() {
-	m.clearednotifications = true
-}
-
-// NotificationsCleared reports if the "notifications" edge to the Notification entity was cleared.
-func (m *RepositoryMutation) NotificationsCleared() bool {
-	return m.clearednotifications
-}
-
-// RemoveNotificationIDs removes the "notifications" edge to the Notification entity by IDs.
-func (m *RepositoryMutation) RemoveNotificationIDs(ids ...int) {
-	if m.removednotifications == nil {
-		m.removednotifications = make(map[int]struct{})
+// OldUniqueCsize returns the old "unique_csize" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryStatsSnapshotMutation) OldUniqueCsize(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUniqueCsize is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.notifications, ids[i])
-		m.removednotifications[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUniqueCsize requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUniqueCsize: %w", err)
+	}
+	return oldValue.UniqueCsize, nil
 }
 
-// RemovedNotifications returns the removed IDs of the "notifications" edge to the Notification entity.
-func (m *RepositoryMutation) RemovedNotificationsIDs() (ids []int) {
-	for id := range m.removednotifications {
-		ids = append(ids, id)
+// AddUniqueCsize adds i to the "unique_csize" field.
+func (m *RepositoryStatsSnapshotMutation) AddUniqueCsize(i int) {
+	if m.addunique_csize != nil {
+		*m.addunique_csize += i
+	} else {
+		m.addunique_csize = &i
 	}
-	return
 }
 
-// NotificationsIDs returns the "notifications" edge IDs in the mutation.
-func (m *RepositoryMutation) NotificationsIDs() (ids []int) {
-	for id := range m.notifications {
-		ids = append(ids, id)
+// AddedUniqueCsize returns the value that was added to the "unique_csize" field in this mutation.
+func (m *RepositoryStatsSnapshotMutation) AddedUniqueCsize() (r int, exists bool) {
+	v := m.addunique_csize
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetNotifications resets all changes to the "notifications" edge.
-func (m *RepositoryMutation) ResetNotifications() {
-	m.notifications = nil
-	m.clearednotifications = false
-	m.removednotifications = nil
+// ResetUniqueCsize resets all changes to the "unique_csize" field.
+func (m *RepositoryStatsSnapshotMutation) ResetUniqueCsize() {
+	m.unique_csize = nil
+	m.addunique_csize = nil
 }
 
-// SetCloudRepositoryID sets the "cloud_repository" edge to the CloudRepository entity by id.
-func (m *RepositoryMutation) SetCloudRepositoryID(id int) {
-	m.cloud_repository = &id
+// SetRepositoryID sets the "repository_id" field.
+func (m *RepositoryStatsSnapshotMutation) SetRepositoryID(i int) {
+	m.repository = &i
 }
 
-// ClearCloudRepository clears the "cloud_repository" edge to the CloudRepository entity.
-func (m *RepositoryMutation) ClearCloudRepository() {
-	m.clearedcloud_repository = true
+// RepositoryID returns the value of the "repository_id" field in the mutation.
+func (m *RepositoryStatsSnapshotMutation) RepositoryID() (r int, exists bool) {
+	v := m.repository
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CloudRepositoryCleared reports if the "cloud_repository" edge to the CloudRepository entity was cleared.
-func (m *RepositoryMutation) CloudRepositoryCleared() bool {
-	return m.clearedcloud_repository
+// OldRepositoryID returns the old "repository_id" field's value of the RepositoryStatsSnapshot entity.
+// If the RepositoryStatsSnapshot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RepositoryStatsSnapshotMutation) OldRepositoryID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepositoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepositoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepositoryID: %w", err)
+	}
+	return oldValue.RepositoryID, nil
 }
 
-// CloudRepositoryID returns the "cloud_repository" edge ID in the mutation.
-func (m *RepositoryMutation) CloudRepositoryID() (id int, exists bool) {
-	if m.cloud_repository != nil {
-		return *m.cloud_repository, true
-	}
-	return
+// ResetRepositoryID resets all changes to the "repository_id" field.
+func (m *RepositoryStatsSnapshotMutation) ResetRepositoryID() {
+	m.repository = nil
 }
 
-// CloudRepositoryIDs returns the "cloud_repository" edge IDs in the mutation.
+// ClearRepository clears the "repository" edge to the Repository entity.
+func (m *RepositoryStatsSnapshotMutation) ClearRepository() {
+	m.clearedrepository = true
+	m.clearedFields[repositorystatssnapshot.FieldRepositoryID] = struct{}{}
+}
+
+// RepositoryCleared reports if the "repository" edge to the Repository entity was cleared.
+func (m *RepositoryStatsSnapshotMutation) RepositoryCleared() bool {
+	return m.clearedrepository
+}
+
+// RepositoryIDs returns the "repository" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CloudRepositoryID instead. It exists only for internal usage by the builders.
-func (m *RepositoryMutation) CloudRepositoryIDs() (ids []int) {
-	if id := m.cloud_repository; id != nil {
+// RepositoryID instead. It exists only for internal usage by the builders.
+func (m *RepositoryStatsSnapshotMutation) RepositoryIDs() (ids []int) {
+	if id := m.repository; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCloudRepository resets all changes to the "cloud_repository" edge.
-func (m *RepositoryMutation) ResetCloudRepository() {
-	m.cloud_repository = nil
-	m.clearedcloud_repository = false
+// ResetRepository resets all changes to the "repository" edge.
+func (m *RepositoryStatsSnapshotMutation) ResetRepository() {
+	m.repository = nil
+	m.clearedrepository = false
 }
 
-// Where appends a list predicates to the RepositoryMutation builder.
-func (m *RepositoryMutation) Where(ps ...predicate.Repository) {
+// Where appends a list predicates to the RepositoryStatsSnapshotMutation builder.
+func (m *RepositoryStatsSnapshotMutation) Where(ps ...predicate.RepositoryStatsSnapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RepositoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the RepositoryStatsSnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Repository, len(ps))
+func (m *RepositoryStatsSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RepositoryStatsSnapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8645,69 +14486,42 @@ func (m *RepositoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RepositoryMutation) Op() Op {
+func (m *RepositoryStatsSnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RepositoryMutation) SetOp(op Op) {
+func (m *RepositoryStatsSnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Repository).
-func (m *RepositoryMutation) Type() string {
+// Type returns the node type of this mutation (RepositoryStatsSnapshot).
+func (m *RepositoryStatsSnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RepositoryMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *RepositoryStatsSnapshotMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, repository.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, repository.FieldUpdatedAt)
-	}
-	if m.name != nil {
-		fields = append(fields, repository.FieldName)
-	}
-	if m.url != nil {
-		fields = append(fields, repository.FieldURL)
-	}
-	if m.has_password != nil {
-		fields = append(fields, repository.FieldHasPassword)
-	}
-	if m.last_quick_check_at != nil {
-		fields = append(fields, repository.FieldLastQuickCheckAt)
-	}
-	if m.quick_check_error != nil {
-		fields = append(fields, repository.FieldQuickCheckError)
-	}
-	if m.last_full_check_at != nil {
-		fields = append(fields, repository.FieldLastFullCheckAt)
-	}
-	if m.full_check_error != nil {
-		fields = append(fields, repository.FieldFullCheckError)
-	}
-	if m.stats_total_chunks != nil {
-		fields = append(fields, repository.FieldStatsTotalChunks)
+		fields = append(fields, repositorystatssnapshot.FieldCreatedAt)
 	}
-	if m.stats_total_size != nil {
-		fields = append(fields, repository.FieldStatsTotalSize)
+	if m.total_size != nil {
+		fields = append(fields, repositorystatssnapshot.FieldTotalSize)
 	}
-	if m.stats_total_csize != nil {
-		fields = append(fields, repository.FieldStatsTotalCsize)
+	if m.total_csize != nil {
+		fields = append(fields, repositorystatssnapshot.FieldTotalCsize)
 	}
-	if m.stats_total_unique_chunks != nil {
-		fields = append(fields, repository.FieldStatsTotalUniqueChunks)
+	if m.unique_size != nil {
+		fields = append(fields, repositorystatssnapshot.FieldUniqueSize)
 	}
-	if m.stats_unique_size != nil {
-		fields = append(fields, repository.FieldStatsUniqueSize)
+	if m.unique_csize != nil {
+		fields = append(fields, repositorystatssnapshot.FieldUniqueCsize)
 	}
-	if m.stats_unique_csize != nil {
-		fields = append(fields, repository.FieldStatsUniqueCsize)
+	if m.repository != nil {
+		fields = append(fields, repositorystatssnapshot.FieldRepositoryID)
 	}
 	return fields
 }
@@ -8715,38 +14529,20 @@ func (m *RepositoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RepositoryMutation) Field(name string) (ent.Value, bool) {
+func (m *RepositoryStatsSnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case repository.FieldCreatedAt:
+	case repositorystatssnapshot.FieldCreatedAt:
 		return m.CreatedAt()
-	case repository.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case repository.FieldName:
-		return m.Name()
-	case repository.FieldURL:
-		return m.URL()
-	case repository.FieldHasPassword:
-		return m.HasPassword()
-	case repository.FieldLastQuickCheckAt:
-		return m.LastQuickCheckAt()
-	case repository.FieldQuickCheckError:
-		return m.QuickCheckError()
-	case repository.FieldLastFullCheckAt:
-		return m.LastFullCheckAt()
-	case repository.FieldFullCheckError:
-		return m.FullCheckError()
-	case repository.FieldStatsTotalChunks:
-		return m.StatsTotalChunks()
-	case repository.FieldStatsTotalSize:
-		return m.StatsTotalSize()
-	case repository.FieldStatsTotalCsize:
-		return m.StatsTotalCsize()
-	case repository.FieldStatsTotalUniqueChunks:
-		return m.StatsTotalUniqueChunks()
-	case repository.FieldStatsUniqueSize:
-		return m.StatsUniqueSize()
-	case repository.FieldStatsUniqueCsize:
-		return m.StatsUniqueCsize()
+	case repositorystatssnapshot.FieldTotalSize:
+		return m.TotalSize()
+	case repositorystatssnapshot.FieldTotalCsize:
+		return m.TotalCsize()
+	case repositorystatssnapshot.FieldUniqueSize:
+		return m.UniqueSize()
+	case repositorystatssnapshot.FieldUniqueCsize:
+		return m.UniqueCsize()
+	case repositorystatssnapshot.FieldRepositoryID:
+		return m.RepositoryID()
 	}
 	return nil, false
 }
@@ -8754,177 +14550,90 @@ func (m *RepositoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RepositoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RepositoryStatsSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case repository.FieldCreatedAt:
+	case repositorystatssnapshot.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case repository.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case repository.FieldName:
-		return m.OldName(ctx)
-	case repository.FieldURL:
-		return m.OldURL(ctx)
-	case repository.FieldHasPassword:
-		return m.OldHasPassword(ctx)
-	case repository.FieldLastQuickCheckAt:
-		return m.OldLastQuickCheckAt(ctx)
-	case repository.FieldQuickCheckError:
-		return m.OldQuickCheckError(ctx)
-	case repository.FieldLastFullCheckAt:
-		return m.OldLastFullCheckAt(ctx)
-	case repository.FieldFullCheckError:
-		return m.OldFullCheckError(ctx)
-	case repository.FieldStatsTotalChunks:
-		return m.OldStatsTotalChunks(ctx)
-	case repository.FieldStatsTotalSize:
-		return m.OldStatsTotalSize(ctx)
-	case repository.FieldStatsTotalCsize:
-		return m.OldStatsTotalCsize(ctx)
-	case repository.FieldStatsTotalUniqueChunks:
-		return m.OldStatsTotalUniqueChunks(ctx)
-	case repository.FieldStatsUniqueSize:
-		return m.OldStatsUniqueSize(ctx)
-	case repository.FieldStatsUniqueCsize:
-		return m.OldStatsUniqueCsize(ctx)
+	case repositorystatssnapshot.FieldTotalSize:
+		return m.OldTotalSize(ctx)
+	case repositorystatssnapshot.FieldTotalCsize:
+		return m.OldTotalCsize(ctx)
+	case repositorystatssnapshot.FieldUniqueSize:
+		return m.OldUniqueSize(ctx)
+	case repositorystatssnapshot.FieldUniqueCsize:
+		return m.OldUniqueCsize(ctx)
+	case repositorystatssnapshot.FieldRepositoryID:
+		return m.OldRepositoryID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Repository field %s", name)
-}
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RepositoryMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case repository.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case repository.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case repository.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case repository.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case repository.FieldHasPassword:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetHasPassword(v)
-		return nil
-	case repository.FieldLastQuickCheckAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastQuickCheckAt(v)
-		return nil
-	case repository.FieldQuickCheckError:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuickCheckError(v)
-		return nil
-	case repository.FieldLastFullCheckAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastFullCheckAt(v)
-		return nil
-	case repository.FieldFullCheckError:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFullCheckError(v)
-		return nil
-	case repository.FieldStatsTotalChunks:
-		v, ok := value.(int)
+	return nil, fmt.Errorf("unknown RepositoryStatsSnapshot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RepositoryStatsSnapshotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case repositorystatssnapshot.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsTotalChunks(v)
+		m.SetCreatedAt(v)
 		return nil
-	case repository.FieldStatsTotalSize:
+	case repositorystatssnapshot.FieldTotalSize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsTotalSize(v)
+		m.SetTotalSize(v)
 		return nil
-	case repository.FieldStatsTotalCsize:
+	case repositorystatssnapshot.FieldTotalCsize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsTotalCsize(v)
+		m.SetTotalCsize(v)
 		return nil
-	case repository.FieldStatsTotalUniqueChunks:
+	case repositorystatssnapshot.FieldUniqueSize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsTotalUniqueChunks(v)
+		m.SetUniqueSize(v)
 		return nil
-	case repository.FieldStatsUniqueSize:
+	case repositorystatssnapshot.FieldUniqueCsize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsUniqueSize(v)
+		m.SetUniqueCsize(v)
 		return nil
-	case repository.FieldStatsUniqueCsize:
+	case repositorystatssnapshot.FieldRepositoryID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatsUniqueCsize(v)
+		m.SetRepositoryID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Repository field %s", name)
+	return fmt.Errorf("unknown RepositoryStatsSnapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RepositoryMutation) AddedFields() []string {
+func (m *RepositoryStatsSnapshotMutation) AddedFields() []string {
 	var fields []string
-	if m.addstats_total_chunks != nil {
-		fields = append(fields, repository.FieldStatsTotalChunks)
-	}
-	if m.addstats_total_size != nil {
-		fields = append(fields, repository.FieldStatsTotalSize)
-	}
-	if m.addstats_total_csize != nil {
-		fields = append(fields, repository.FieldStatsTotalCsize)
+	if m.addtotal_size != nil {
+		fields = append(fields, repositorystatssnapshot.FieldTotalSize)
 	}
-	if m.addstats_total_unique_chunks != nil {
-		fields = append(fields, repository.FieldStatsTotalUniqueChunks)
+	if m.addtotal_csize != nil {
+		fields = append(fields, repositorystatssnapshot.FieldTotalCsize)
 	}
-	if m.addstats_unique_size != nil {
-		fields = append(fields, repository.FieldStatsUniqueSize)
+	if m.addunique_size != nil {
+		fields = append(fields, repositorystatssnapshot.FieldUniqueSize)
 	}
-	if m.addstats_unique_csize != nil {
-		fields = append(fields, repository.FieldStatsUniqueCsize)
+	if m.addunique_csize != nil {
+		fields = append(fields, repositorystatssnapshot.FieldUniqueCsize)
 	}
 	return fields
 }
@@ -8932,20 +14641,16 @@ func (m *RepositoryMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RepositoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RepositoryStatsSnapshotMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case repository.FieldStatsTotalChunks:
-		return m.AddedStatsTotalChunks()
-	case repository.FieldStatsTotalSize:
-		return m.AddedStatsTotalSize()
-	case repository.FieldStatsTotalCsize:
-		return m.AddedStatsTotalCsize()
-	case repository.FieldStatsTotalUniqueChunks:
-		return m.AddedStatsTotalUniqueChunks()
-	case repository.FieldStatsUniqueSize:
-		return m.AddedStatsUniqueSize()
-	case repository.FieldStatsUniqueCsize:
-		return m.AddedStatsUniqueCsize()
+	case repositorystatssnapshot.FieldTotalSize:
+		return m.AddedTotalSize()
+	case repositorystatssnapshot.FieldTotalCsize:
+		return m.AddedTotalCsize()
+	case repositorystatssnapshot.FieldUniqueSize:
+		return m.AddedUniqueSize()
+	case repositorystatssnapshot.FieldUniqueCsize:
+		return m.AddedUniqueCsize()
 	}
 	return nil, false
 }
@@ -8953,195 +14658,100 @@ func (m *RepositoryMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RepositoryMutation) AddField(name string, value ent.Value) error {
+func (m *RepositoryStatsSnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case repository.FieldStatsTotalChunks:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatsTotalChunks(v)
-		return nil
-	case repository.FieldStatsTotalSize:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatsTotalSize(v)
-		return nil
-	case repository.FieldStatsTotalCsize:
+	case repositorystatssnapshot.FieldTotalSize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatsTotalCsize(v)
+		m.AddTotalSize(v)
 		return nil
-	case repository.FieldStatsTotalUniqueChunks:
+	case repositorystatssnapshot.FieldTotalCsize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatsTotalUniqueChunks(v)
+		m.AddTotalCsize(v)
 		return nil
-	case repository.FieldStatsUniqueSize:
+	case repositorystatssnapshot.FieldUniqueSize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatsUniqueSize(v)
+		m.AddUniqueSize(v)
 		return nil
-	case repository.FieldStatsUniqueCsize:
+	case repositorystatssnapshot.FieldUniqueCsize:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatsUniqueCsize(v)
+		m.AddUniqueCsize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Repository numeric field %s", name)
+	return fmt.Errorf("unknown RepositoryStatsSnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RepositoryMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(repository.FieldLastQuickCheckAt) {
-		fields = append(fields, repository.FieldLastQuickCheckAt)
-	}
-	if m.FieldCleared(repository.FieldQuickCheckError) {
-		fields = append(fields, repository.FieldQuickCheckError)
-	}
-	if m.FieldCleared(repository.FieldLastFullCheckAt) {
-		fields = append(fields, repository.FieldLastFullCheckAt)
-	}
-	if m.FieldCleared(repository.FieldFullCheckError) {
-		fields = append(fields, repository.FieldFullCheckError)
-	}
-	return fields
+func (m *RepositoryStatsSnapshotMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RepositoryMutation) FieldCleared(name string) bool {
+func (m *RepositoryStatsSnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RepositoryMutation) ClearField(name string) error {
-	switch name {
-	case repository.FieldLastQuickCheckAt:
-		m.ClearLastQuickCheckAt()
-		return nil
-	case repository.FieldQuickCheckError:
-		m.ClearQuickCheckError()
-		return nil
-	case repository.FieldLastFullCheckAt:
-		m.ClearLastFullCheckAt()
-		return nil
-	case repository.FieldFullCheckError:
-		m.ClearFullCheckError()
-		return nil
-	}
-	return fmt.Errorf("unknown Repository nullable field %s", name)
+func (m *RepositoryStatsSnapshotMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RepositoryStatsSnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RepositoryMutation) ResetField(name string) error {
+func (m *RepositoryStatsSnapshotMutation) ResetField(name string) error {
 	switch name {
-	case repository.FieldCreatedAt:
+	case repositorystatssnapshot.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case repository.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case repository.FieldName:
-		m.ResetName()
-		return nil
-	case repository.FieldURL:
-		m.ResetURL()
-		return nil
-	case repository.FieldHasPassword:
-		m.ResetHasPassword()
-		return nil
-	case repository.FieldLastQuickCheckAt:
-		m.ResetLastQuickCheckAt()
-		return nil
-	case repository.FieldQuickCheckError:
-		m.ResetQuickCheckError()
-		return nil
-	case repository.FieldLastFullCheckAt:
-		m.ResetLastFullCheckAt()
-		return nil
-	case repository.FieldFullCheckError:
-		m.ResetFullCheckError()
-		return nil
-	case repository.FieldStatsTotalChunks:
-		m.ResetStatsTotalChunks()
-		return nil
-	case repository.FieldStatsTotalSize:
-		m.ResetStatsTotalSize()
+	case repositorystatssnapshot.FieldTotalSize:
+		m.ResetTotalSize()
 		return nil
-	case repository.FieldStatsTotalCsize:
-		m.ResetStatsTotalCsize()
+	case repositorystatssnapshot.FieldTotalCsize:
+		m.ResetTotalCsize()
 		return nil
-	case repository.FieldStatsTotalUniqueChunks:
-		m.ResetStatsTotalUniqueChunks()
+	case repositorystatssnapshot.FieldUniqueSize:
+		m.ResetUniqueSize()
 		return nil
-	case repository.FieldStatsUniqueSize:
-		m.ResetStatsUniqueSize()
+	case repositorystatssnapshot.FieldUniqueCsize:
+		m.ResetUniqueCsize()
 		return nil
-	case repository.FieldStatsUniqueCsize:
-		m.ResetStatsUniqueCsize()
+	case repositorystatssnapshot.FieldRepositoryID:
+		m.ResetRepositoryID()
 		return nil
 	}
-	return fmt.Errorf("unknown Repository field %s", name)
+	return fmt.Errorf("unknown RepositoryStatsSnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RepositoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.backup_profiles != nil {
-		edges = append(edges, repository.EdgeBackupProfiles)
-	}
-	if m.archives != nil {
-		edges = append(edges, repository.EdgeArchives)
-	}
-	if m.notifications != nil {
-		edges = append(edges, repository.EdgeNotifications)
-	}
-	if m.cloud_repository != nil {
-		edges = append(edges, repository.EdgeCloudRepository)
+func (m *RepositoryStatsSnapshotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.repository != nil {
+		edges = append(edges, repositorystatssnapshot.EdgeRepository)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RepositoryMutation) AddedIDs(name string) []ent.Value {
+func (m *RepositoryStatsSnapshotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case repository.EdgeBackupProfiles:
-		ids := make([]ent.Value, 0, len(m.backup_profiles))
-		for id := range m.backup_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case repository.EdgeArchives:
-		ids := make([]ent.Value, 0, len(m.archives))
-		for id := range m.archives {
-			ids = append(ids, id)
-		}
-		return ids
-	case repository.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.notifications))
-		for id := range m.notifications {
-			ids = append(ids, id)
-		}
-		return ids
-	case repository.EdgeCloudRepository:
-		if id := m.cloud_repository; id != nil {
+	case repositorystatssnapshot.EdgeRepository:
+		if id := m.repository; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -9149,109 +14759,56 @@ func (m *RepositoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RepositoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedbackup_profiles != nil {
-		edges = append(edges, repository.EdgeBackupProfiles)
-	}
-	if m.removedarchives != nil {
-		edges = append(edges, repository.EdgeArchives)
-	}
-	if m.removednotifications != nil {
-		edges = append(edges, repository.EdgeNotifications)
-	}
+func (m *RepositoryStatsSnapshotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RepositoryMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case repository.EdgeBackupProfiles:
-		ids := make([]ent.Value, 0, len(m.removedbackup_profiles))
-		for id := range m.removedbackup_profiles {
-			ids = append(ids, id)
-		}
-		return ids
-	case repository.EdgeArchives:
-		ids := make([]ent.Value, 0, len(m.removedarchives))
-		for id := range m.removedarchives {
-			ids = append(ids, id)
-		}
-		return ids
-	case repository.EdgeNotifications:
-		ids := make([]ent.Value, 0, len(m.removednotifications))
-		for id := range m.removednotifications {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RepositoryStatsSnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RepositoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedbackup_profiles {
-		edges = append(edges, repository.EdgeBackupProfiles)
-	}
-	if m.clearedarchives {
-		edges = append(edges, repository.EdgeArchives)
-	}
-	if m.clearednotifications {
-		edges = append(edges, repository.EdgeNotifications)
-	}
-	if m.clearedcloud_repository {
-		edges = append(edges, repository.EdgeCloudRepository)
+func (m *RepositoryStatsSnapshotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrepository {
+		edges = append(edges, repositorystatssnapshot.EdgeRepository)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RepositoryMutation) EdgeCleared(name string) bool {
+func (m *RepositoryStatsSnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case repository.EdgeBackupProfiles:
-		return m.clearedbackup_profiles
-	case repository.EdgeArchives:
-		return m.clearedarchives
-	case repository.EdgeNotifications:
-		return m.clearednotifications
-	case repository.EdgeCloudRepository:
-		return m.clearedcloud_repository
+	case repositorystatssnapshot.EdgeRepository:
+		return m.clearedrepository
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RepositoryMutation) ClearEdge(name string) error {
+func (m *RepositoryStatsSnapshotMutation) ClearEdge(name string) error {
 	switch name {
-	case repository.EdgeCloudRepository:
-		m.ClearCloudRepository()
+	case repositorystatssnapshot.EdgeRepository:
+		m.ClearRepository()
 		return nil
 	}
-	return fmt.Errorf("unknown Repository unique edge %s", name)
+	return fmt.Errorf("unknown RepositoryStatsSnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RepositoryMutation) ResetEdge(name string) error {
+func (m *RepositoryStatsSnapshotMutation) ResetEdge(name string) error {
 	switch name {
-	case repository.EdgeBackupProfiles:
-		m.ResetBackupProfiles()
-		return nil
-	case repository.EdgeArchives:
-		m.ResetArchives()
-		return nil
-	case repository.EdgeNotifications:
-		m.ResetNotifications()
-		return nil
-	case repository.EdgeCloudRepository:
-		m.ResetCloudRepository()
+	case repositorystatssnapshot.EdgeRepository:
+		m.ResetRepository()
 		return nil
 	}
-	return fmt.Errorf("unknown Repository edge %s", name)
+	return fmt.Errorf("unknown RepositoryStatsSnapshot edge %s", name)
 }
 
 // SettingsMutation represents an operation that mutates the Settings nodes in the graph.
@@ -105,6 +105,13 @@ const (
 	TypeFailedFullCheck   Type = "failed_full_check"
 	TypeWarningQuickCheck Type = "warning_quick_check"
 	TypeWarningFullCheck  Type = "warning_full_check"
+	TypeFailedRestoreRun  Type = "failed_restore_run"
+	TypeWarningRestoreRun Type = "warning_restore_run"
+	TypeFailedExportRun   Type = "failed_export_run"
+	TypeWarningExportRun  Type = "warning_export_run"
+	TypeFailedImportRun   Type = "failed_import_run"
+	TypeOverdueQuickCheck Type = "overdue_quick_check"
+	TypeOverdueFullCheck  Type = "overdue_full_check"
 )
 
 func (_type Type) String() string {
@@ -114,7 +121,7 @@ func (_type Type) String() string {
 // TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
 func TypeValidator(_type Type) error {
 	switch _type {
-	case TypeFailedBackupRun, TypeFailedPruningRun, TypeWarningPruningRun, TypeFailedQuickCheck, TypeFailedFullCheck, TypeWarningQuickCheck, TypeWarningFullCheck:
+	case TypeFailedBackupRun, TypeFailedPruningRun, TypeWarningPruningRun, TypeFailedQuickCheck, TypeFailedFullCheck, TypeWarningQuickCheck, TypeWarningFullCheck, TypeFailedRestoreRun, TypeWarningRestoreRun, TypeFailedExportRun, TypeWarningExportRun, TypeFailedImportRun, TypeOverdueQuickCheck, TypeOverdueFullCheck:
 		return nil
 	default:
 		return fmt.Errorf("notification: invalid enum value for type field: %q", _type)
@@ -12,9 +12,15 @@ type AnalyticsEvent func(*sql.Selector)
 // Archive is the predicate function for archive builders.
 type Archive func(*sql.Selector)
 
+// ArchiveFile is the predicate function for archivefile builders.
+type ArchiveFile func(*sql.Selector)
+
 // AuthSession is the predicate function for authsession builders.
 type AuthSession func(*sql.Selector)
 
+// BackupHook is the predicate function for backuphook builders.
+type BackupHook func(*sql.Selector)
+
 // BackupProfile is the predicate function for backupprofile builders.
 type BackupProfile func(*sql.Selector)
 
@@ -33,6 +39,9 @@ type PruningRule func(*sql.Selector)
 // Repository is the predicate function for repository builders.
 type Repository func(*sql.Selector)
 
+// RepositoryStatsSnapshot is the predicate function for repositorystatssnapshot builders.
+type RepositoryStatsSnapshot func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
 
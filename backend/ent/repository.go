@@ -29,6 +29,10 @@ type Repository struct {
 	URL string `json:"url"`
 	// Whether this repository has a password stored in the keyring
 	HasPassword bool `json:"hasPassword"`
+	// Filesystem UUID of the volume the repository was created on, used to recognize its drive when it is connected
+	VolumeUUID string `json:"volumeUuid"`
+	// Filesystem label of the volume the repository was created on, used if the filesystem has no UUID
+	VolumeLabel string `json:"volumeLabel"`
 	// Timestamp of last quick check (--repository-only)
 	LastQuickCheckAt *time.Time `json:"lastQuickCheckAt"`
 	// Error messages from last quick check, empty array if successful
@@ -37,6 +41,14 @@ type Repository struct {
 	LastFullCheckAt *time.Time `json:"lastFullCheckAt"`
 	// Error messages from last full check, empty array if successful
 	FullCheckError []string `json:"fullCheckError"`
+	// Days between scheduled quick checks, 0 disables scheduled quick checks
+	QuickCheckIntervalDays int `json:"quickCheckIntervalDays"`
+	// Weeks between scheduled full checks (--verify-data), 0 disables scheduled full checks
+	FullCheckIntervalWeeks int `json:"fullCheckIntervalWeeks"`
+	// Number of archives verified per scheduled full check, 0 verifies all archives at once
+	RollingCheckArchives int `json:"rollingCheckArchives"`
+	// Start of the current verification cycle, archives verified before this are checked again
+	CheckCycleStartedAt *time.Time `json:"checkCycleStartedAt"`
 	// Total number of all chunks across all archives (including duplicates)
 	StatsTotalChunks int `json:"statsTotalChunks"`
 	// Total uncompressed size of all chunks multiplied by their reference counts
@@ -49,6 +61,8 @@ type Repository struct {
 	StatsUniqueSize int `json:"statsUniqueSize"`
 	// Compressed size of unique chunks only (actual storage consumed on disk)
 	StatsUniqueCsize int `json:"statsUniqueCsize"`
+	// Whether the files of all archives are indexed locally for searching
+	FileIndexEnabled bool `json:"fileIndexEnabled"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the RepositoryQuery when eager-loading is set.
 	Edges                       RepositoryEdges `json:"edges"`
@@ -64,11 +78,13 @@ type RepositoryEdges struct {
 	Archives []*Archive `json:"archives,omitempty"`
 	// Notifications holds the value of the notifications edge.
 	Notifications []*Notification `json:"notifications,omitempty"`
+	// StatsSnapshots holds the value of the stats_snapshots edge.
+	StatsSnapshots []*RepositoryStatsSnapshot `json:"-"`
 	// CloudRepository holds the value of the cloud_repository edge.
 	CloudRepository *CloudRepository `json:"cloudRepository,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // BackupProfilesOrErr returns the BackupProfiles value or an error if the edge
@@ -98,12 +114,21 @@ func (e RepositoryEdges) NotificationsOrErr() ([]*Notification, error) {
 	return nil, &NotLoadedError{edge: "notifications"}
 }
 
+// StatsSnapshotsOrErr returns the StatsSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e RepositoryEdges) StatsSnapshotsOrErr() ([]*RepositoryStatsSnapshot, error) {
+	if e.loadedTypes[3] {
+		return e.StatsSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "stats_snapshots"}
+}
+
 // CloudRepositoryOrErr returns the CloudRepository value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e RepositoryEdges) CloudRepositoryOrErr() (*CloudRepository, error) {
 	if e.CloudRepository != nil {
 		return e.CloudRepository, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: cloudrepository.Label}
 	}
 	return nil, &NotLoadedError{edge: "cloud_repository"}
@@ -116,13 +141,13 @@ func (*Repository) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case repository.FieldQuickCheckError, repository.FieldFullCheckError:
 			values[i] = new([]byte)
-		case repository.FieldHasPassword:
+		case repository.FieldHasPassword, repository.FieldFileIndexEnabled:
 			values[i] = new(sql.NullBool)
-		case repository.FieldID, repository.FieldStatsTotalChunks, repository.FieldStatsTotalSize, repository.FieldStatsTotalCsize, repository.FieldStatsTotalUniqueChunks, repository.FieldStatsUniqueSize, repository.FieldStatsUniqueCsize:
+		case repository.FieldID, repository.FieldQuickCheckIntervalDays, repository.FieldFullCheckIntervalWeeks, repository.FieldRollingCheckArchives, repository.FieldStatsTotalChunks, repository.FieldStatsTotalSize, repository.FieldStatsTotalCsize, repository.FieldStatsTotalUniqueChunks, repository.FieldStatsUniqueSize, repository.FieldStatsUniqueCsize:
 			values[i] = new(sql.NullInt64)
-		case repository.FieldName, repository.FieldURL:
+		case repository.FieldName, repository.FieldURL, repository.FieldVolumeUUID, repository.FieldVolumeLabel:
 			values[i] = new(sql.NullString)
-		case repository.FieldCreatedAt, repository.FieldUpdatedAt, repository.FieldLastQuickCheckAt, repository.FieldLastFullCheckAt:
+		case repository.FieldCreatedAt, repository.FieldUpdatedAt, repository.FieldLastQuickCheckAt, repository.FieldLastFullCheckAt, repository.FieldCheckCycleStartedAt:
 			values[i] = new(sql.NullTime)
 		case repository.ForeignKeys[0]: // cloud_repository_repository
 			values[i] = new(sql.NullInt64)
@@ -177,6 +202,18 @@ func (_m *Repository) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.HasPassword = value.Bool
 			}
+		case repository.FieldVolumeUUID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_uuid", values[i])
+			} else if value.Valid {
+				_m.VolumeUUID = value.String
+			}
+		case repository.FieldVolumeLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field volume_label", values[i])
+			} else if value.Valid {
+				_m.VolumeLabel = value.String
+			}
 		case repository.FieldLastQuickCheckAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field last_quick_check_at", values[i])
@@ -207,6 +244,31 @@ func (_m *Repository) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field full_check_error: %w", err)
 				}
 			}
+		case repository.FieldQuickCheckIntervalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quick_check_interval_days", values[i])
+			} else if value.Valid {
+				_m.QuickCheckIntervalDays = int(value.Int64)
+			}
+		case repository.FieldFullCheckIntervalWeeks:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field full_check_interval_weeks", values[i])
+			} else if value.Valid {
+				_m.FullCheckIntervalWeeks = int(value.Int64)
+			}
+		case repository.FieldRollingCheckArchives:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rolling_check_archives", values[i])
+			} else if value.Valid {
+				_m.RollingCheckArchives = int(value.Int64)
+			}
+		case repository.FieldCheckCycleStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field check_cycle_started_at", values[i])
+			} else if value.Valid {
+				_m.CheckCycleStartedAt = new(time.Time)
+				*_m.CheckCycleStartedAt = value.Time
+			}
 		case repository.FieldStatsTotalChunks:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field stats_total_chunks", values[i])
@@ -243,6 +305,12 @@ func (_m *Repository) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StatsUniqueCsize = int(value.Int64)
 			}
+		case repository.FieldFileIndexEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field file_index_enabled", values[i])
+			} else if value.Valid {
+				_m.FileIndexEnabled = value.Bool
+			}
 		case repository.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field cloud_repository_repository", value)
@@ -278,6 +346,11 @@ func (_m *Repository) QueryNotifications() *NotificationQuery {
 	return NewRepositoryClient(_m.config).QueryNotifications(_m)
 }
 
+// QueryStatsSnapshots queries the "stats_snapshots" edge of the Repository entity.
+func (_m *Repository) QueryStatsSnapshots() *RepositoryStatsSnapshotQuery {
+	return NewRepositoryClient(_m.config).QueryStatsSnapshots(_m)
+}
+
 // QueryCloudRepository queries the "cloud_repository" edge of the Repository entity.
 func (_m *Repository) QueryCloudRepository() *CloudRepositoryQuery {
 	return NewRepositoryClient(_m.config).QueryCloudRepository(_m)
@@ -321,6 +394,12 @@ func (_m *Repository) String() string {
 	builder.WriteString("has_password=")
 	builder.WriteString(fmt.Sprintf("%v", _m.HasPassword))
 	builder.WriteString(", ")
+	builder.WriteString("volume_uuid=")
+	builder.WriteString(_m.VolumeUUID)
+	builder.WriteString(", ")
+	builder.WriteString("volume_label=")
+	builder.WriteString(_m.VolumeLabel)
+	builder.WriteString(", ")
 	if v := _m.LastQuickCheckAt; v != nil {
 		builder.WriteString("last_quick_check_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -337,6 +416,20 @@ func (_m *Repository) String() string {
 	builder.WriteString("full_check_error=")
 	builder.WriteString(fmt.Sprintf("%v", _m.FullCheckError))
 	builder.WriteString(", ")
+	builder.WriteString("quick_check_interval_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuickCheckIntervalDays))
+	builder.WriteString(", ")
+	builder.WriteString("full_check_interval_weeks=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FullCheckIntervalWeeks))
+	builder.WriteString(", ")
+	builder.WriteString("rolling_check_archives=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RollingCheckArchives))
+	builder.WriteString(", ")
+	if v := _m.CheckCycleStartedAt; v != nil {
+		builder.WriteString("check_cycle_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("stats_total_chunks=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StatsTotalChunks))
 	builder.WriteString(", ")
@@ -354,6 +447,9 @@ func (_m *Repository) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("stats_unique_csize=")
 	builder.WriteString(fmt.Sprintf("%v", _m.StatsUniqueCsize))
+	builder.WriteString(", ")
+	builder.WriteString("file_index_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FileIndexEnabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
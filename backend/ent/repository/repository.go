@@ -24,6 +24,10 @@ const (
 	FieldURL = "url"
 	// FieldHasPassword holds the string denoting the has_password field in the database.
 	FieldHasPassword = "has_password"
+	// FieldVolumeUUID holds the string denoting the volume_uuid field in the database.
+	FieldVolumeUUID = "volume_uuid"
+	// FieldVolumeLabel holds the string denoting the volume_label field in the database.
+	FieldVolumeLabel = "volume_label"
 	// FieldLastQuickCheckAt holds the string denoting the last_quick_check_at field in the database.
 	FieldLastQuickCheckAt = "last_quick_check_at"
 	// FieldQuickCheckError holds the string denoting the quick_check_error field in the database.
@@ -32,6 +36,14 @@ const (
 	FieldLastFullCheckAt = "last_full_check_at"
 	// FieldFullCheckError holds the string denoting the full_check_error field in the database.
 	FieldFullCheckError = "full_check_error"
+	// FieldQuickCheckIntervalDays holds the string denoting the quick_check_interval_days field in the database.
+	FieldQuickCheckIntervalDays = "quick_check_interval_days"
+	// FieldFullCheckIntervalWeeks holds the string denoting the full_check_interval_weeks field in the database.
+	FieldFullCheckIntervalWeeks = "full_check_interval_weeks"
+	// FieldRollingCheckArchives holds the string denoting the rolling_check_archives field in the database.
+	FieldRollingCheckArchives = "rolling_check_archives"
+	// FieldCheckCycleStartedAt holds the string denoting the check_cycle_started_at field in the database.
+	FieldCheckCycleStartedAt = "check_cycle_started_at"
 	// FieldStatsTotalChunks holds the string denoting the stats_total_chunks field in the database.
 	FieldStatsTotalChunks = "stats_total_chunks"
 	// FieldStatsTotalSize holds the string denoting the stats_total_size field in the database.
@@ -44,12 +56,16 @@ const (
 	FieldStatsUniqueSize = "stats_unique_size"
 	// FieldStatsUniqueCsize holds the string denoting the stats_unique_csize field in the database.
 	FieldStatsUniqueCsize = "stats_unique_csize"
+	// FieldFileIndexEnabled holds the string denoting the file_index_enabled field in the database.
+	FieldFileIndexEnabled = "file_index_enabled"
 	// EdgeBackupProfiles holds the string denoting the backup_profiles edge name in mutations.
 	EdgeBackupProfiles = "backup_profiles"
 	// EdgeArchives holds the string denoting the archives edge name in mutations.
 	EdgeArchives = "archives"
 	// EdgeNotifications holds the string denoting the notifications edge name in mutations.
 	EdgeNotifications = "notifications"
+	// EdgeStatsSnapshots holds the string denoting the stats_snapshots edge name in mutations.
+	EdgeStatsSnapshots = "stats_snapshots"
 	// EdgeCloudRepository holds the string denoting the cloud_repository edge name in mutations.
 	EdgeCloudRepository = "cloud_repository"
 	// Table holds the table name of the repository in the database.
@@ -73,6 +89,13 @@ const (
 	NotificationsInverseTable = "notifications"
 	// NotificationsColumn is the table column denoting the notifications relation/edge.
 	NotificationsColumn = "notification_repository"
+	// StatsSnapshotsTable is the table that holds the stats_snapshots relation/edge.
+	StatsSnapshotsTable = "repository_stats_snapshots"
+	// StatsSnapshotsInverseTable is the table name for the RepositoryStatsSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "repositorystatssnapshot" package.
+	StatsSnapshotsInverseTable = "repository_stats_snapshots"
+	// StatsSnapshotsColumn is the table column denoting the stats_snapshots relation/edge.
+	StatsSnapshotsColumn = "repository_stats_snapshot_repository"
 	// CloudRepositoryTable is the table that holds the cloud_repository relation/edge.
 	CloudRepositoryTable = "repositories"
 	// CloudRepositoryInverseTable is the table name for the CloudRepository entity.
@@ -90,16 +113,23 @@ var Columns = []string{
 	FieldName,
 	FieldURL,
 	FieldHasPassword,
+	FieldVolumeUUID,
+	FieldVolumeLabel,
 	FieldLastQuickCheckAt,
 	FieldQuickCheckError,
 	FieldLastFullCheckAt,
 	FieldFullCheckError,
+	FieldQuickCheckIntervalDays,
+	FieldFullCheckIntervalWeeks,
+	FieldRollingCheckArchives,
+	FieldCheckCycleStartedAt,
 	FieldStatsTotalChunks,
 	FieldStatsTotalSize,
 	FieldStatsTotalCsize,
 	FieldStatsTotalUniqueChunks,
 	FieldStatsUniqueSize,
 	FieldStatsUniqueCsize,
+	FieldFileIndexEnabled,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "repositories"
@@ -140,6 +170,18 @@ var (
 	NameValidator func(string) error
 	// DefaultHasPassword holds the default value on creation for the "has_password" field.
 	DefaultHasPassword bool
+	// DefaultQuickCheckIntervalDays holds the default value on creation for the "quick_check_interval_days" field.
+	DefaultQuickCheckIntervalDays int
+	// QuickCheckIntervalDaysValidator is a validator for the "quick_check_interval_days" field. It is called by the builders before save.
+	QuickCheckIntervalDaysValidator func(int) error
+	// DefaultFullCheckIntervalWeeks holds the default value on creation for the "full_check_interval_weeks" field.
+	DefaultFullCheckIntervalWeeks int
+	// FullCheckIntervalWeeksValidator is a validator for the "full_check_interval_weeks" field. It is called by the builders before save.
+	FullCheckIntervalWeeksValidator func(int) error
+	// DefaultRollingCheckArchives holds the default value on creation for the "rolling_check_archives" field.
+	DefaultRollingCheckArchives int
+	// RollingCheckArchivesValidator is a validator for the "rolling_check_archives" field. It is called by the builders before save.
+	RollingCheckArchivesValidator func(int) error
 	// DefaultStatsTotalChunks holds the default value on creation for the "stats_total_chunks" field.
 	DefaultStatsTotalChunks int
 	// DefaultStatsTotalSize holds the default value on creation for the "stats_total_size" field.
@@ -152,6 +194,8 @@ var (
 	DefaultStatsUniqueSize int
 	// DefaultStatsUniqueCsize holds the default value on creation for the "stats_unique_csize" field.
 	DefaultStatsUniqueCsize int
+	// DefaultFileIndexEnabled holds the default value on creation for the "file_index_enabled" field.
+	DefaultFileIndexEnabled bool
 )
 
 // OrderOption defines the ordering options for the Repository queries.
@@ -187,6 +231,16 @@ func ByHasPassword(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldHasPassword, opts...).ToFunc()
 }
 
+// ByVolumeUUID orders the results by the volume_uuid field.
+func ByVolumeUUID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolumeUUID, opts...).ToFunc()
+}
+
+// ByVolumeLabel orders the results by the volume_label field.
+func ByVolumeLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolumeLabel, opts...).ToFunc()
+}
+
 // ByLastQuickCheckAt orders the results by the last_quick_check_at field.
 func ByLastQuickCheckAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastQuickCheckAt, opts...).ToFunc()
@@ -197,6 +251,26 @@ func ByLastFullCheckAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastFullCheckAt, opts...).ToFunc()
 }
 
+// ByQuickCheckIntervalDays orders the results by the quick_check_interval_days field.
+func ByQuickCheckIntervalDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuickCheckIntervalDays, opts...).ToFunc()
+}
+
+// ByFullCheckIntervalWeeks orders the results by the full_check_interval_weeks field.
+func ByFullCheckIntervalWeeks(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFullCheckIntervalWeeks, opts...).ToFunc()
+}
+
+// ByRollingCheckArchives orders the results by the rolling_check_archives field.
+func ByRollingCheckArchives(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRollingCheckArchives, opts...).ToFunc()
+}
+
+// ByCheckCycleStartedAt orders the results by the check_cycle_started_at field.
+func ByCheckCycleStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckCycleStartedAt, opts...).ToFunc()
+}
+
 // ByStatsTotalChunks orders the results by the stats_total_chunks field.
 func ByStatsTotalChunks(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatsTotalChunks, opts...).ToFunc()
@@ -227,6 +301,11 @@ func ByStatsUniqueCsize(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatsUniqueCsize, opts...).ToFunc()
 }
 
+// ByFileIndexEnabled orders the results by the file_index_enabled field.
+func ByFileIndexEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFileIndexEnabled, opts...).ToFunc()
+}
+
 // ByBackupProfilesCount orders the results by backup_profiles count.
 func ByBackupProfilesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -269,6 +348,20 @@ func ByNotifications(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByStatsSnapshotsCount orders the results by stats_snapshots count.
+func ByStatsSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatsSnapshotsStep(), opts...)
+	}
+}
+
+// ByStatsSnapshots orders the results by stats_snapshots terms.
+func ByStatsSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatsSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByCloudRepositoryField orders the results by cloud_repository field.
 func ByCloudRepositoryField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -296,6 +389,13 @@ func newNotificationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, NotificationsTable, NotificationsColumn),
 	)
 }
+func newStatsSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatsSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, StatsSnapshotsTable, StatsSnapshotsColumn),
+	)
+}
 func newCloudRepositoryStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
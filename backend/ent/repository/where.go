@@ -80,6 +80,16 @@ func HasPassword(v bool) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldHasPassword, v))
 }
 
+// VolumeUUID applies equality check predicate on the "volume_uuid" field. It's identical to VolumeUUIDEQ.
+func VolumeUUID(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldVolumeUUID, v))
+}
+
+// VolumeLabel applies equality check predicate on the "volume_label" field. It's identical to VolumeLabelEQ.
+func VolumeLabel(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldVolumeLabel, v))
+}
+
 // LastQuickCheckAt applies equality check predicate on the "last_quick_check_at" field. It's identical to LastQuickCheckAtEQ.
 func LastQuickCheckAt(v time.Time) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldLastQuickCheckAt, v))
@@ -90,6 +100,26 @@ func LastFullCheckAt(v time.Time) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldLastFullCheckAt, v))
 }
 
+// QuickCheckIntervalDays applies equality check predicate on the "quick_check_interval_days" field. It's identical to QuickCheckIntervalDaysEQ.
+func QuickCheckIntervalDays(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldQuickCheckIntervalDays, v))
+}
+
+// FullCheckIntervalWeeks applies equality check predicate on the "full_check_interval_weeks" field. It's identical to FullCheckIntervalWeeksEQ.
+func FullCheckIntervalWeeks(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldFullCheckIntervalWeeks, v))
+}
+
+// RollingCheckArchives applies equality check predicate on the "rolling_check_archives" field. It's identical to RollingCheckArchivesEQ.
+func RollingCheckArchives(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldRollingCheckArchives, v))
+}
+
+// CheckCycleStartedAt applies equality check predicate on the "check_cycle_started_at" field. It's identical to CheckCycleStartedAtEQ.
+func CheckCycleStartedAt(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldCheckCycleStartedAt, v))
+}
+
 // StatsTotalChunks applies equality check predicate on the "stats_total_chunks" field. It's identical to StatsTotalChunksEQ.
 func StatsTotalChunks(v int) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldStatsTotalChunks, v))
@@ -120,6 +150,11 @@ func StatsUniqueCsize(v int) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldStatsUniqueCsize, v))
 }
 
+// FileIndexEnabled applies equality check predicate on the "file_index_enabled" field. It's identical to FileIndexEnabledEQ.
+func FileIndexEnabled(v bool) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldFileIndexEnabled, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldCreatedAt, v))
@@ -340,6 +375,156 @@ func HasPasswordNEQ(v bool) predicate.Repository {
 	return predicate.Repository(sql.FieldNEQ(FieldHasPassword, v))
 }
 
+// VolumeUUIDEQ applies the EQ predicate on the "volume_uuid" field.
+func VolumeUUIDEQ(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDNEQ applies the NEQ predicate on the "volume_uuid" field.
+func VolumeUUIDNEQ(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDIn applies the In predicate on the "volume_uuid" field.
+func VolumeUUIDIn(vs ...string) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldVolumeUUID, vs...))
+}
+
+// VolumeUUIDNotIn applies the NotIn predicate on the "volume_uuid" field.
+func VolumeUUIDNotIn(vs ...string) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldVolumeUUID, vs...))
+}
+
+// VolumeUUIDGT applies the GT predicate on the "volume_uuid" field.
+func VolumeUUIDGT(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDGTE applies the GTE predicate on the "volume_uuid" field.
+func VolumeUUIDGTE(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDLT applies the LT predicate on the "volume_uuid" field.
+func VolumeUUIDLT(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDLTE applies the LTE predicate on the "volume_uuid" field.
+func VolumeUUIDLTE(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDContains applies the Contains predicate on the "volume_uuid" field.
+func VolumeUUIDContains(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldContains(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDHasPrefix applies the HasPrefix predicate on the "volume_uuid" field.
+func VolumeUUIDHasPrefix(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldHasPrefix(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDHasSuffix applies the HasSuffix predicate on the "volume_uuid" field.
+func VolumeUUIDHasSuffix(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldHasSuffix(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDIsNil applies the IsNil predicate on the "volume_uuid" field.
+func VolumeUUIDIsNil() predicate.Repository {
+	return predicate.Repository(sql.FieldIsNull(FieldVolumeUUID))
+}
+
+// VolumeUUIDNotNil applies the NotNil predicate on the "volume_uuid" field.
+func VolumeUUIDNotNil() predicate.Repository {
+	return predicate.Repository(sql.FieldNotNull(FieldVolumeUUID))
+}
+
+// VolumeUUIDEqualFold applies the EqualFold predicate on the "volume_uuid" field.
+func VolumeUUIDEqualFold(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEqualFold(FieldVolumeUUID, v))
+}
+
+// VolumeUUIDContainsFold applies the ContainsFold predicate on the "volume_uuid" field.
+func VolumeUUIDContainsFold(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldContainsFold(FieldVolumeUUID, v))
+}
+
+// VolumeLabelEQ applies the EQ predicate on the "volume_label" field.
+func VolumeLabelEQ(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldVolumeLabel, v))
+}
+
+// VolumeLabelNEQ applies the NEQ predicate on the "volume_label" field.
+func VolumeLabelNEQ(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldVolumeLabel, v))
+}
+
+// VolumeLabelIn applies the In predicate on the "volume_label" field.
+func VolumeLabelIn(vs ...string) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldVolumeLabel, vs...))
+}
+
+// VolumeLabelNotIn applies the NotIn predicate on the "volume_label" field.
+func VolumeLabelNotIn(vs ...string) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldVolumeLabel, vs...))
+}
+
+// VolumeLabelGT applies the GT predicate on the "volume_label" field.
+func VolumeLabelGT(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldVolumeLabel, v))
+}
+
+// VolumeLabelGTE applies the GTE predicate on the "volume_label" field.
+func VolumeLabelGTE(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldVolumeLabel, v))
+}
+
+// VolumeLabelLT applies the LT predicate on the "volume_label" field.
+func VolumeLabelLT(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldVolumeLabel, v))
+}
+
+// VolumeLabelLTE applies the LTE predicate on the "volume_label" field.
+func VolumeLabelLTE(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldVolumeLabel, v))
+}
+
+// VolumeLabelContains applies the Contains predicate on the "volume_label" field.
+func VolumeLabelContains(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldContains(FieldVolumeLabel, v))
+}
+
+// VolumeLabelHasPrefix applies the HasPrefix predicate on the "volume_label" field.
+func VolumeLabelHasPrefix(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldHasPrefix(FieldVolumeLabel, v))
+}
+
+// VolumeLabelHasSuffix applies the HasSuffix predicate on the "volume_label" field.
+func VolumeLabelHasSuffix(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldHasSuffix(FieldVolumeLabel, v))
+}
+
+// VolumeLabelIsNil applies the IsNil predicate on the "volume_label" field.
+func VolumeLabelIsNil() predicate.Repository {
+	return predicate.Repository(sql.FieldIsNull(FieldVolumeLabel))
+}
+
+// VolumeLabelNotNil applies the NotNil predicate on the "volume_label" field.
+func VolumeLabelNotNil() predicate.Repository {
+	return predicate.Repository(sql.FieldNotNull(FieldVolumeLabel))
+}
+
+// VolumeLabelEqualFold applies the EqualFold predicate on the "volume_label" field.
+func VolumeLabelEqualFold(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldEqualFold(FieldVolumeLabel, v))
+}
+
+// VolumeLabelContainsFold applies the ContainsFold predicate on the "volume_label" field.
+func VolumeLabelContainsFold(v string) predicate.Repository {
+	return predicate.Repository(sql.FieldContainsFold(FieldVolumeLabel, v))
+}
+
 // LastQuickCheckAtEQ applies the EQ predicate on the "last_quick_check_at" field.
 func LastQuickCheckAtEQ(v time.Time) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldLastQuickCheckAt, v))
@@ -460,6 +645,176 @@ func FullCheckErrorNotNil() predicate.Repository {
 	return predicate.Repository(sql.FieldNotNull(FieldFullCheckError))
 }
 
+// QuickCheckIntervalDaysEQ applies the EQ predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldQuickCheckIntervalDays, v))
+}
+
+// QuickCheckIntervalDaysNEQ applies the NEQ predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysNEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldQuickCheckIntervalDays, v))
+}
+
+// QuickCheckIntervalDaysIn applies the In predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldQuickCheckIntervalDays, vs...))
+}
+
+// QuickCheckIntervalDaysNotIn applies the NotIn predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysNotIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldQuickCheckIntervalDays, vs...))
+}
+
+// QuickCheckIntervalDaysGT applies the GT predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysGT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldQuickCheckIntervalDays, v))
+}
+
+// QuickCheckIntervalDaysGTE applies the GTE predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysGTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldQuickCheckIntervalDays, v))
+}
+
+// QuickCheckIntervalDaysLT applies the LT predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysLT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldQuickCheckIntervalDays, v))
+}
+
+// QuickCheckIntervalDaysLTE applies the LTE predicate on the "quick_check_interval_days" field.
+func QuickCheckIntervalDaysLTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldQuickCheckIntervalDays, v))
+}
+
+// FullCheckIntervalWeeksEQ applies the EQ predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldFullCheckIntervalWeeks, v))
+}
+
+// FullCheckIntervalWeeksNEQ applies the NEQ predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksNEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldFullCheckIntervalWeeks, v))
+}
+
+// FullCheckIntervalWeeksIn applies the In predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldFullCheckIntervalWeeks, vs...))
+}
+
+// FullCheckIntervalWeeksNotIn applies the NotIn predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksNotIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldFullCheckIntervalWeeks, vs...))
+}
+
+// FullCheckIntervalWeeksGT applies the GT predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksGT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldFullCheckIntervalWeeks, v))
+}
+
+// FullCheckIntervalWeeksGTE applies the GTE predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksGTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldFullCheckIntervalWeeks, v))
+}
+
+// FullCheckIntervalWeeksLT applies the LT predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksLT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldFullCheckIntervalWeeks, v))
+}
+
+// FullCheckIntervalWeeksLTE applies the LTE predicate on the "full_check_interval_weeks" field.
+func FullCheckIntervalWeeksLTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldFullCheckIntervalWeeks, v))
+}
+
+// RollingCheckArchivesEQ applies the EQ predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldRollingCheckArchives, v))
+}
+
+// RollingCheckArchivesNEQ applies the NEQ predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesNEQ(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldRollingCheckArchives, v))
+}
+
+// RollingCheckArchivesIn applies the In predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldRollingCheckArchives, vs...))
+}
+
+// RollingCheckArchivesNotIn applies the NotIn predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesNotIn(vs ...int) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldRollingCheckArchives, vs...))
+}
+
+// RollingCheckArchivesGT applies the GT predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesGT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldRollingCheckArchives, v))
+}
+
+// RollingCheckArchivesGTE applies the GTE predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesGTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldRollingCheckArchives, v))
+}
+
+// RollingCheckArchivesLT applies the LT predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesLT(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldRollingCheckArchives, v))
+}
+
+// RollingCheckArchivesLTE applies the LTE predicate on the "rolling_check_archives" field.
+func RollingCheckArchivesLTE(v int) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldRollingCheckArchives, v))
+}
+
+// CheckCycleStartedAtEQ applies the EQ predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtEQ(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtNEQ applies the NEQ predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtNEQ(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtIn applies the In predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtIn(vs ...time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldIn(FieldCheckCycleStartedAt, vs...))
+}
+
+// CheckCycleStartedAtNotIn applies the NotIn predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtNotIn(vs ...time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldNotIn(FieldCheckCycleStartedAt, vs...))
+}
+
+// CheckCycleStartedAtGT applies the GT predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtGT(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldGT(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtGTE applies the GTE predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtGTE(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldGTE(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtLT applies the LT predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtLT(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldLT(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtLTE applies the LTE predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtLTE(v time.Time) predicate.Repository {
+	return predicate.Repository(sql.FieldLTE(FieldCheckCycleStartedAt, v))
+}
+
+// CheckCycleStartedAtIsNil applies the IsNil predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtIsNil() predicate.Repository {
+	return predicate.Repository(sql.FieldIsNull(FieldCheckCycleStartedAt))
+}
+
+// CheckCycleStartedAtNotNil applies the NotNil predicate on the "check_cycle_started_at" field.
+func CheckCycleStartedAtNotNil() predicate.Repository {
+	return predicate.Repository(sql.FieldNotNull(FieldCheckCycleStartedAt))
+}
+
 // StatsTotalChunksEQ applies the EQ predicate on the "stats_total_chunks" field.
 func StatsTotalChunksEQ(v int) predicate.Repository {
 	return predicate.Repository(sql.FieldEQ(FieldStatsTotalChunks, v))
@@ -700,6 +1055,16 @@ func StatsUniqueCsizeLTE(v int) predicate.Repository {
 	return predicate.Repository(sql.FieldLTE(FieldStatsUniqueCsize, v))
 }
 
+// FileIndexEnabledEQ applies the EQ predicate on the "file_index_enabled" field.
+func FileIndexEnabledEQ(v bool) predicate.Repository {
+	return predicate.Repository(sql.FieldEQ(FieldFileIndexEnabled, v))
+}
+
+// FileIndexEnabledNEQ applies the NEQ predicate on the "file_index_enabled" field.
+func FileIndexEnabledNEQ(v bool) predicate.Repository {
+	return predicate.Repository(sql.FieldNEQ(FieldFileIndexEnabled, v))
+}
+
 // HasBackupProfiles applies the HasEdge predicate on the "backup_profiles" edge.
 func HasBackupProfiles() predicate.Repository {
 	return predicate.Repository(func(s *sql.Selector) {
@@ -769,6 +1134,29 @@ func HasNotificationsWith(preds ...predicate.Notification) predicate.Repository
 	})
 }
 
+// HasStatsSnapshots applies the HasEdge predicate on the "stats_snapshots" edge.
+func HasStatsSnapshots() predicate.Repository {
+	return predicate.Repository(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, StatsSnapshotsTable, StatsSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatsSnapshotsWith applies the HasEdge predicate on the "stats_snapshots" edge with a given conditions (other predicates).
+func HasStatsSnapshotsWith(preds ...predicate.RepositoryStatsSnapshot) predicate.Repository {
+	return predicate.Repository(func(s *sql.Selector) {
+		step := newStatsSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasCloudRepository applies the HasEdge predicate on the "cloud_repository" edge.
 func HasCloudRepository() predicate.Repository {
 	return predicate.Repository(func(s *sql.Selector) {
@@ -15,6 +15,7 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 )
 
 // RepositoryCreate is the builder for creating a Repository entity.
@@ -78,6 +79,34 @@ func (_c *RepositoryCreate) SetNillableHasPassword(v *bool) *RepositoryCreate {
 	return _c
 }
 
+// SetVolumeUUID sets the "volume_uuid" field.
+func (_c *RepositoryCreate) SetVolumeUUID(v string) *RepositoryCreate {
+	_c.mutation.SetVolumeUUID(v)
+	return _c
+}
+
+// SetNillableVolumeUUID sets the "volume_uuid" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableVolumeUUID(v *string) *RepositoryCreate {
+	if v != nil {
+		_c.SetVolumeUUID(*v)
+	}
+	return _c
+}
+
+// SetVolumeLabel sets the "volume_label" field.
+func (_c *RepositoryCreate) SetVolumeLabel(v string) *RepositoryCreate {
+	_c.mutation.SetVolumeLabel(v)
+	return _c
+}
+
+// SetNillableVolumeLabel sets the "volume_label" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableVolumeLabel(v *string) *RepositoryCreate {
+	if v != nil {
+		_c.SetVolumeLabel(*v)
+	}
+	return _c
+}
+
 // SetLastQuickCheckAt sets the "last_quick_check_at" field.
 func (_c *RepositoryCreate) SetLastQuickCheckAt(v time.Time) *RepositoryCreate {
 	_c.mutation.SetLastQuickCheckAt(v)
@@ -118,6 +147,62 @@ func (_c *RepositoryCreate) SetFullCheckError(v []string) *RepositoryCreate {
 	return _c
 }
 
+// SetQuickCheckIntervalDays sets the "quick_check_interval_days" field.
+func (_c *RepositoryCreate) SetQuickCheckIntervalDays(v int) *RepositoryCreate {
+	_c.mutation.SetQuickCheckIntervalDays(v)
+	return _c
+}
+
+// SetNillableQuickCheckIntervalDays sets the "quick_check_interval_days" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableQuickCheckIntervalDays(v *int) *RepositoryCreate {
+	if v != nil {
+		_c.SetQuickCheckIntervalDays(*v)
+	}
+	return _c
+}
+
+// SetFullCheckIntervalWeeks sets the "full_check_interval_weeks" field.
+func (_c *RepositoryCreate) SetFullCheckIntervalWeeks(v int) *RepositoryCreate {
+	_c.mutation.SetFullCheckIntervalWeeks(v)
+	return _c
+}
+
+// SetNillableFullCheckIntervalWeeks sets the "full_check_interval_weeks" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableFullCheckIntervalWeeks(v *int) *RepositoryCreate {
+	if v != nil {
+		_c.SetFullCheckIntervalWeeks(*v)
+	}
+	return _c
+}
+
+// SetRollingCheckArchives sets the "rolling_check_archives" field.
+func (_c *RepositoryCreate) SetRollingCheckArchives(v int) *RepositoryCreate {
+	_c.mutation.SetRollingCheckArchives(v)
+	return _c
+}
+
+// SetNillableRollingCheckArchives sets the "rolling_check_archives" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableRollingCheckArchives(v *int) *RepositoryCreate {
+	if v != nil {
+		_c.SetRollingCheckArchives(*v)
+	}
+	return _c
+}
+
+// SetCheckCycleStartedAt sets the "check_cycle_started_at" field.
+func (_c *RepositoryCreate) SetCheckCycleStartedAt(v time.Time) *RepositoryCreate {
+	_c.mutation.SetCheckCycleStartedAt(v)
+	return _c
+}
+
+// SetNillableCheckCycleStartedAt sets the "check_cycle_started_at" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableCheckCycleStartedAt(v *time.Time) *RepositoryCreate {
+	if v != nil {
+		_c.SetCheckCycleStartedAt(*v)
+	}
+	return _c
+}
+
 // SetStatsTotalChunks sets the "stats_total_chunks" field.
 func (_c *RepositoryCreate) SetStatsTotalChunks(v int) *RepositoryCreate {
 	_c.mutation.SetStatsTotalChunks(v)
@@ -202,6 +287,20 @@ func (_c *RepositoryCreate) SetNillableStatsUniqueCsize(v *int) *RepositoryCreat
 	return _c
 }
 
+// SetFileIndexEnabled sets the "file_index_enabled" field.
+func (_c *RepositoryCreate) SetFileIndexEnabled(v bool) *RepositoryCreate {
+	_c.mutation.SetFileIndexEnabled(v)
+	return _c
+}
+
+// SetNillableFileIndexEnabled sets the "file_index_enabled" field if the given value is not nil.
+func (_c *RepositoryCreate) SetNillableFileIndexEnabled(v *bool) *RepositoryCreate {
+	if v != nil {
+		_c.SetFileIndexEnabled(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *RepositoryCreate) SetID(v int) *RepositoryCreate {
 	_c.mutation.SetID(v)
@@ -253,6 +352,21 @@ func (_c *RepositoryCreate) AddNotifications(v ...*Notification) *RepositoryCrea
 	return _c.AddNotificationIDs(ids...)
 }
 
+// AddStatsSnapshotIDs adds the "stats_snapshots" edge to the RepositoryStatsSnapshot entity by IDs.
+func (_c *RepositoryCreate) AddStatsSnapshotIDs(ids ...int) *RepositoryCreate {
+	_c.mutation.AddStatsSnapshotIDs(ids...)
+	return _c
+}
+
+// AddStatsSnapshots adds the "stats_snapshots" edges to the RepositoryStatsSnapshot entity.
+func (_c *RepositoryCreate) AddStatsSnapshots(v ...*RepositoryStatsSnapshot) *RepositoryCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatsSnapshotIDs(ids...)
+}
+
 // SetCloudRepositoryID sets the "cloud_repository" edge to the CloudRepository entity by ID.
 func (_c *RepositoryCreate) SetCloudRepositoryID(id int) *RepositoryCreate {
 	_c.mutation.SetCloudRepositoryID(id)
@@ -319,6 +433,18 @@ func (_c *RepositoryCreate) defaults() {
 		v := repository.DefaultHasPassword
 		_c.mutation.SetHasPassword(v)
 	}
+	if _, ok := _c.mutation.QuickCheckIntervalDays(); !ok {
+		v := repository.DefaultQuickCheckIntervalDays
+		_c.mutation.SetQuickCheckIntervalDays(v)
+	}
+	if _, ok := _c.mutation.FullCheckIntervalWeeks(); !ok {
+		v := repository.DefaultFullCheckIntervalWeeks
+		_c.mutation.SetFullCheckIntervalWeeks(v)
+	}
+	if _, ok := _c.mutation.RollingCheckArchives(); !ok {
+		v := repository.DefaultRollingCheckArchives
+		_c.mutation.SetRollingCheckArchives(v)
+	}
 	if _, ok := _c.mutation.StatsTotalChunks(); !ok {
 		v := repository.DefaultStatsTotalChunks
 		_c.mutation.SetStatsTotalChunks(v)
@@ -343,6 +469,10 @@ func (_c *RepositoryCreate) defaults() {
 		v := repository.DefaultStatsUniqueCsize
 		_c.mutation.SetStatsUniqueCsize(v)
 	}
+	if _, ok := _c.mutation.FileIndexEnabled(); !ok {
+		v := repository.DefaultFileIndexEnabled
+		_c.mutation.SetFileIndexEnabled(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -367,6 +497,30 @@ func (_c *RepositoryCreate) check() error {
 	if _, ok := _c.mutation.HasPassword(); !ok {
 		return &ValidationError{Name: "has_password", err: errors.New(`ent: missing required field "Repository.has_password"`)}
 	}
+	if _, ok := _c.mutation.QuickCheckIntervalDays(); !ok {
+		return &ValidationError{Name: "quick_check_interval_days", err: errors.New(`ent: missing required field "Repository.quick_check_interval_days"`)}
+	}
+	if v, ok := _c.mutation.QuickCheckIntervalDays(); ok {
+		if err := repository.QuickCheckIntervalDaysValidator(v); err != nil {
+			return &ValidationError{Name: "quick_check_interval_days", err: fmt.Errorf(`ent: validator failed for field "Repository.quick_check_interval_days": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FullCheckIntervalWeeks(); !ok {
+		return &ValidationError{Name: "full_check_interval_weeks", err: errors.New(`ent: missing required field "Repository.full_check_interval_weeks"`)}
+	}
+	if v, ok := _c.mutation.FullCheckIntervalWeeks(); ok {
+		if err := repository.FullCheckIntervalWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "full_check_interval_weeks", err: fmt.Errorf(`ent: validator failed for field "Repository.full_check_interval_weeks": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RollingCheckArchives(); !ok {
+		return &ValidationError{Name: "rolling_check_archives", err: errors.New(`ent: missing required field "Repository.rolling_check_archives"`)}
+	}
+	if v, ok := _c.mutation.RollingCheckArchives(); ok {
+		if err := repository.RollingCheckArchivesValidator(v); err != nil {
+			return &ValidationError{Name: "rolling_check_archives", err: fmt.Errorf(`ent: validator failed for field "Repository.rolling_check_archives": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.StatsTotalChunks(); !ok {
 		return &ValidationError{Name: "stats_total_chunks", err: errors.New(`ent: missing required field "Repository.stats_total_chunks"`)}
 	}
@@ -385,6 +539,9 @@ func (_c *RepositoryCreate) check() error {
 	if _, ok := _c.mutation.StatsUniqueCsize(); !ok {
 		return &ValidationError{Name: "stats_unique_csize", err: errors.New(`ent: missing required field "Repository.stats_unique_csize"`)}
 	}
+	if _, ok := _c.mutation.FileIndexEnabled(); !ok {
+		return &ValidationError{Name: "file_index_enabled", err: errors.New(`ent: missing required field "Repository.file_index_enabled"`)}
+	}
 	return nil
 }
 
@@ -437,6 +594,14 @@ func (_c *RepositoryCreate) createSpec() (*Repository, *sqlgraph.CreateSpec) {
 		_spec.SetField(repository.FieldHasPassword, field.TypeBool, value)
 		_node.HasPassword = value
 	}
+	if value, ok := _c.mutation.VolumeUUID(); ok {
+		_spec.SetField(repository.FieldVolumeUUID, field.TypeString, value)
+		_node.VolumeUUID = value
+	}
+	if value, ok := _c.mutation.VolumeLabel(); ok {
+		_spec.SetField(repository.FieldVolumeLabel, field.TypeString, value)
+		_node.VolumeLabel = value
+	}
 	if value, ok := _c.mutation.LastQuickCheckAt(); ok {
 		_spec.SetField(repository.FieldLastQuickCheckAt, field.TypeTime, value)
 		_node.LastQuickCheckAt = &value
@@ -453,6 +618,22 @@ func (_c *RepositoryCreate) createSpec() (*Repository, *sqlgraph.CreateSpec) {
 		_spec.SetField(repository.FieldFullCheckError, field.TypeJSON, value)
 		_node.FullCheckError = value
 	}
+	if value, ok := _c.mutation.QuickCheckIntervalDays(); ok {
+		_spec.SetField(repository.FieldQuickCheckIntervalDays, field.TypeInt, value)
+		_node.QuickCheckIntervalDays = value
+	}
+	if value, ok := _c.mutation.FullCheckIntervalWeeks(); ok {
+		_spec.SetField(repository.FieldFullCheckIntervalWeeks, field.TypeInt, value)
+		_node.FullCheckIntervalWeeks = value
+	}
+	if value, ok := _c.mutation.RollingCheckArchives(); ok {
+		_spec.SetField(repository.FieldRollingCheckArchives, field.TypeInt, value)
+		_node.RollingCheckArchives = value
+	}
+	if value, ok := _c.mutation.CheckCycleStartedAt(); ok {
+		_spec.SetField(repository.FieldCheckCycleStartedAt, field.TypeTime, value)
+		_node.CheckCycleStartedAt = &value
+	}
 	if value, ok := _c.mutation.StatsTotalChunks(); ok {
 		_spec.SetField(repository.FieldStatsTotalChunks, field.TypeInt, value)
 		_node.StatsTotalChunks = value
@@ -477,6 +658,10 @@ func (_c *RepositoryCreate) createSpec() (*Repository, *sqlgraph.CreateSpec) {
 		_spec.SetField(repository.FieldStatsUniqueCsize, field.TypeInt, value)
 		_node.StatsUniqueCsize = value
 	}
+	if value, ok := _c.mutation.FileIndexEnabled(); ok {
+		_spec.SetField(repository.FieldFileIndexEnabled, field.TypeBool, value)
+		_node.FileIndexEnabled = value
+	}
 	if nodes := _c.mutation.BackupProfilesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -525,6 +710,22 @@ func (_c *RepositoryCreate) createSpec() (*Repository, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.StatsSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.CloudRepositoryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
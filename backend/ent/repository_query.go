@@ -18,6 +18,7 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/predicate"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 )
 
 // RepositoryQuery is the builder for querying Repository entities.
@@ -30,6 +31,7 @@ type RepositoryQuery struct {
 	withBackupProfiles  *BackupProfileQuery
 	withArchives        *ArchiveQuery
 	withNotifications   *NotificationQuery
+	withStatsSnapshots  *RepositoryStatsSnapshotQuery
 	withCloudRepository *CloudRepositoryQuery
 	withFKs             bool
 	modifiers           []func(*sql.Selector)
@@ -135,6 +137,28 @@ func (_q *RepositoryQuery) QueryNotifications() *NotificationQuery {
 	return query
 }
 
+// QueryStatsSnapshots chains the current query on the "stats_snapshots" edge.
+func (_q *RepositoryQuery) QueryStatsSnapshots() *RepositoryStatsSnapshotQuery {
+	query := (&RepositoryStatsSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repository.Table, repository.FieldID, selector),
+			sqlgraph.To(repositorystatssnapshot.Table, repositorystatssnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, repository.StatsSnapshotsTable, repository.StatsSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryCloudRepository chains the current query on the "cloud_repository" edge.
 func (_q *RepositoryQuery) QueryCloudRepository() *CloudRepositoryQuery {
 	query := (&CloudRepositoryClient{config: _q.config}).Query()
@@ -352,6 +376,7 @@ func (_q *RepositoryQuery) Clone() *RepositoryQuery {
 		withBackupProfiles:  _q.withBackupProfiles.Clone(),
 		withArchives:        _q.withArchives.Clone(),
 		withNotifications:   _q.withNotifications.Clone(),
+		withStatsSnapshots:  _q.withStatsSnapshots.Clone(),
 		withCloudRepository: _q.withCloudRepository.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -393,6 +418,17 @@ func (_q *RepositoryQuery) WithNotifications(opts ...func(*NotificationQuery)) *
 	return _q
 }
 
+// WithStatsSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "stats_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RepositoryQuery) WithStatsSnapshots(opts ...func(*RepositoryStatsSnapshotQuery)) *RepositoryQuery {
+	query := (&RepositoryStatsSnapshotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatsSnapshots = query
+	return _q
+}
+
 // WithCloudRepository tells the query-builder to eager-load the nodes that are connected to
 // the "cloud_repository" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *RepositoryQuery) WithCloudRepository(opts ...func(*CloudRepositoryQuery)) *RepositoryQuery {
@@ -483,10 +519,11 @@ func (_q *RepositoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*R
 		nodes       = []*Repository{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withBackupProfiles != nil,
 			_q.withArchives != nil,
 			_q.withNotifications != nil,
+			_q.withStatsSnapshots != nil,
 			_q.withCloudRepository != nil,
 		}
 	)
@@ -538,6 +575,15 @@ func (_q *RepositoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*R
 			return nil, err
 		}
 	}
+	if query := _q.withStatsSnapshots; query != nil {
+		if err := _q.loadStatsSnapshots(ctx, query, nodes,
+			func(n *Repository) { n.Edges.StatsSnapshots = []*RepositoryStatsSnapshot{} },
+			func(n *Repository, e *RepositoryStatsSnapshot) {
+				n.Edges.StatsSnapshots = append(n.Edges.StatsSnapshots, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withCloudRepository; query != nil {
 		if err := _q.loadCloudRepository(ctx, query, nodes, nil,
 			func(n *Repository, e *CloudRepository) { n.Edges.CloudRepository = e }); err != nil {
@@ -670,6 +716,36 @@ func (_q *RepositoryQuery) loadNotifications(ctx context.Context, query *Notific
 	}
 	return nil
 }
+func (_q *RepositoryQuery) loadStatsSnapshots(ctx context.Context, query *RepositoryStatsSnapshotQuery, nodes []*Repository, init func(*Repository), assign func(*Repository, *RepositoryStatsSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Repository)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(repositorystatssnapshot.FieldRepositoryID)
+	}
+	query.Where(predicate.RepositoryStatsSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(repository.StatsSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RepositoryID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "repository_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *RepositoryQuery) loadCloudRepository(ctx context.Context, query *CloudRepositoryQuery, nodes []*Repository, init func(*Repository), assign func(*Repository, *CloudRepository)) error {
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*Repository)
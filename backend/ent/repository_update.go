@@ -18,6 +18,7 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/predicate"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 )
 
 // RepositoryUpdate is the builder for updating Repository entities.
@@ -82,6 +83,46 @@ func (_u *RepositoryUpdate) SetNillableHasPassword(v *bool) *RepositoryUpdate {
 	return _u
 }
 
+// SetVolumeUUID sets the "volume_uuid" field.
+func (_u *RepositoryUpdate) SetVolumeUUID(v string) *RepositoryUpdate {
+	_u.mutation.SetVolumeUUID(v)
+	return _u
+}
+
+// SetNillableVolumeUUID sets the "volume_uuid" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableVolumeUUID(v *string) *RepositoryUpdate {
+	if v != nil {
+		_u.SetVolumeUUID(*v)
+	}
+	return _u
+}
+
+// ClearVolumeUUID clears the value of the "volume_uuid" field.
+func (_u *RepositoryUpdate) ClearVolumeUUID() *RepositoryUpdate {
+	_u.mutation.ClearVolumeUUID()
+	return _u
+}
+
+// SetVolumeLabel sets the "volume_label" field.
+func (_u *RepositoryUpdate) SetVolumeLabel(v string) *RepositoryUpdate {
+	_u.mutation.SetVolumeLabel(v)
+	return _u
+}
+
+// SetNillableVolumeLabel sets the "volume_label" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableVolumeLabel(v *string) *RepositoryUpdate {
+	if v != nil {
+		_u.SetVolumeLabel(*v)
+	}
+	return _u
+}
+
+// ClearVolumeLabel clears the value of the "volume_label" field.
+func (_u *RepositoryUpdate) ClearVolumeLabel() *RepositoryUpdate {
+	_u.mutation.ClearVolumeLabel()
+	return _u
+}
+
 // SetLastQuickCheckAt sets the "last_quick_check_at" field.
 func (_u *RepositoryUpdate) SetLastQuickCheckAt(v time.Time) *RepositoryUpdate {
 	_u.mutation.SetLastQuickCheckAt(v)
@@ -158,6 +199,89 @@ func (_u *RepositoryUpdate) ClearFullCheckError() *RepositoryUpdate {
 	return _u
 }
 
+// SetQuickCheckIntervalDays sets the "quick_check_interval_days" field.
+func (_u *RepositoryUpdate) SetQuickCheckIntervalDays(v int) *RepositoryUpdate {
+	_u.mutation.ResetQuickCheckIntervalDays()
+	_u.mutation.SetQuickCheckIntervalDays(v)
+	return _u
+}
+
+// SetNillableQuickCheckIntervalDays sets the "quick_check_interval_days" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableQuickCheckIntervalDays(v *int) *RepositoryUpdate {
+	if v != nil {
+		_u.SetQuickCheckIntervalDays(*v)
+	}
+	return _u
+}
+
+// AddQuickCheckIntervalDays adds value to the "quick_check_interval_days" field.
+func (_u *RepositoryUpdate) AddQuickCheckIntervalDays(v int) *RepositoryUpdate {
+	_u.mutation.AddQuickCheckIntervalDays(v)
+	return _u
+}
+
+// SetFullCheckIntervalWeeks sets the "full_check_interval_weeks" field.
+func (_u *RepositoryUpdate) SetFullCheckIntervalWeeks(v int) *RepositoryUpdate {
+	_u.mutation.ResetFullCheckIntervalWeeks()
+	_u.mutation.SetFullCheckIntervalWeeks(v)
+	return _u
+}
+
+// SetNillableFullCheckIntervalWeeks sets the "full_check_interval_weeks" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableFullCheckIntervalWeeks(v *int) *RepositoryUpdate {
+	if v != nil {
+		_u.SetFullCheckIntervalWeeks(*v)
+	}
+	return _u
+}
+
+// AddFullCheckIntervalWeeks adds value to the "full_check_interval_weeks" field.
+func (_u *RepositoryUpdate) AddFullCheckIntervalWeeks(v int) *RepositoryUpdate {
+	_u.mutation.AddFullCheckIntervalWeeks(v)
+	return _u
+}
+
+// SetRollingCheckArchives sets the "rolling_check_archives" field.
+func (_u *RepositoryUpdate) SetRollingCheckArchives(v int) *RepositoryUpdate {
+	_u.mutation.ResetRollingCheckArchives()
+	_u.mutation.SetRollingCheckArchives(v)
+	return _u
+}
+
+// SetNillableRollingCheckArchives sets the "rolling_check_archives" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableRollingCheckArchives(v *int) *RepositoryUpdate {
+	if v != nil {
+		_u.SetRollingCheckArchives(*v)
+	}
+	return _u
+}
+
+// AddRollingCheckArchives adds value to the "rolling_check_archives" field.
+func (_u *RepositoryUpdate) AddRollingCheckArchives(v int) *RepositoryUpdate {
+	_u.mutation.AddRollingCheckArchives(v)
+	return _u
+}
+
+// SetCheckCycleStartedAt sets the "check_cycle_started_at" field.
+func (_u *RepositoryUpdate) SetCheckCycleStartedAt(v time.Time) *RepositoryUpdate {
+	_u.mutation.SetCheckCycleStartedAt(v)
+	return _u
+}
+
+// SetNillableCheckCycleStartedAt sets the "check_cycle_started_at" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableCheckCycleStartedAt(v *time.Time) *RepositoryUpdate {
+	if v != nil {
+		_u.SetCheckCycleStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckCycleStartedAt clears the value of the "check_cycle_started_at" field.
+func (_u *RepositoryUpdate) ClearCheckCycleStartedAt() *RepositoryUpdate {
+	_u.mutation.ClearCheckCycleStartedAt()
+	return _u
+}
+
 // SetStatsTotalChunks sets the "stats_total_chunks" field.
 func (_u *RepositoryUpdate) SetStatsTotalChunks(v int) *RepositoryUpdate {
 	_u.mutation.ResetStatsTotalChunks()
@@ -284,6 +408,20 @@ func (_u *RepositoryUpdate) AddStatsUniqueCsize(v int) *RepositoryUpdate {
 	return _u
 }
 
+// SetFileIndexEnabled sets the "file_index_enabled" field.
+func (_u *RepositoryUpdate) SetFileIndexEnabled(v bool) *RepositoryUpdate {
+	_u.mutation.SetFileIndexEnabled(v)
+	return _u
+}
+
+// SetNillableFileIndexEnabled sets the "file_index_enabled" field if the given value is not nil.
+func (_u *RepositoryUpdate) SetNillableFileIndexEnabled(v *bool) *RepositoryUpdate {
+	if v != nil {
+		_u.SetFileIndexEnabled(*v)
+	}
+	return _u
+}
+
 // AddBackupProfileIDs adds the "backup_profiles" edge to the BackupProfile entity by IDs.
 func (_u *RepositoryUpdate) AddBackupProfileIDs(ids ...int) *RepositoryUpdate {
 	_u.mutation.AddBackupProfileIDs(ids...)
@@ -329,6 +467,21 @@ func (_u *RepositoryUpdate) AddNotifications(v ...*Notification) *RepositoryUpda
 	return _u.AddNotificationIDs(ids...)
 }
 
+// AddStatsSnapshotIDs adds the "stats_snapshots" edge to the RepositoryStatsSnapshot entity by IDs.
+func (_u *RepositoryUpdate) AddStatsSnapshotIDs(ids ...int) *RepositoryUpdate {
+	_u.mutation.AddStatsSnapshotIDs(ids...)
+	return _u
+}
+
+// AddStatsSnapshots adds the "stats_snapshots" edges to the RepositoryStatsSnapshot entity.
+func (_u *RepositoryUpdate) AddStatsSnapshots(v ...*RepositoryStatsSnapshot) *RepositoryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatsSnapshotIDs(ids...)
+}
+
 // SetCloudRepositoryID sets the "cloud_repository" edge to the CloudRepository entity by ID.
 func (_u *RepositoryUpdate) SetCloudRepositoryID(id int) *RepositoryUpdate {
 	_u.mutation.SetCloudRepositoryID(id)
@@ -416,6 +569,27 @@ func (_u *RepositoryUpdate) RemoveNotifications(v ...*Notification) *RepositoryU
 	return _u.RemoveNotificationIDs(ids...)
 }
 
+// ClearStatsSnapshots clears all "stats_snapshots" edges to the RepositoryStatsSnapshot entity.
+func (_u *RepositoryUpdate) ClearStatsSnapshots() *RepositoryUpdate {
+	_u.mutation.ClearStatsSnapshots()
+	return _u
+}
+
+// RemoveStatsSnapshotIDs removes the "stats_snapshots" edge to RepositoryStatsSnapshot entities by IDs.
+func (_u *RepositoryUpdate) RemoveStatsSnapshotIDs(ids ...int) *RepositoryUpdate {
+	_u.mutation.RemoveStatsSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveStatsSnapshots removes "stats_snapshots" edges to RepositoryStatsSnapshot entities.
+func (_u *RepositoryUpdate) RemoveStatsSnapshots(v ...*RepositoryStatsSnapshot) *RepositoryUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatsSnapshotIDs(ids...)
+}
+
 // ClearCloudRepository clears the "cloud_repository" edge to the CloudRepository entity.
 func (_u *RepositoryUpdate) ClearCloudRepository() *RepositoryUpdate {
 	_u.mutation.ClearCloudRepository()
@@ -465,6 +639,21 @@ func (_u *RepositoryUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Repository.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.QuickCheckIntervalDays(); ok {
+		if err := repository.QuickCheckIntervalDaysValidator(v); err != nil {
+			return &ValidationError{Name: "quick_check_interval_days", err: fmt.Errorf(`ent: validator failed for field "Repository.quick_check_interval_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FullCheckIntervalWeeks(); ok {
+		if err := repository.FullCheckIntervalWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "full_check_interval_weeks", err: fmt.Errorf(`ent: validator failed for field "Repository.full_check_interval_weeks": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RollingCheckArchives(); ok {
+		if err := repository.RollingCheckArchivesValidator(v); err != nil {
+			return &ValidationError{Name: "rolling_check_archives", err: fmt.Errorf(`ent: validator failed for field "Repository.rolling_check_archives": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -498,6 +687,18 @@ func (_u *RepositoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.HasPassword(); ok {
 		_spec.SetField(repository.FieldHasPassword, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VolumeUUID(); ok {
+		_spec.SetField(repository.FieldVolumeUUID, field.TypeString, value)
+	}
+	if _u.mutation.VolumeUUIDCleared() {
+		_spec.ClearField(repository.FieldVolumeUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.VolumeLabel(); ok {
+		_spec.SetField(repository.FieldVolumeLabel, field.TypeString, value)
+	}
+	if _u.mutation.VolumeLabelCleared() {
+		_spec.ClearField(repository.FieldVolumeLabel, field.TypeString)
+	}
 	if value, ok := _u.mutation.LastQuickCheckAt(); ok {
 		_spec.SetField(repository.FieldLastQuickCheckAt, field.TypeTime, value)
 	}
@@ -532,6 +733,30 @@ func (_u *RepositoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.FullCheckErrorCleared() {
 		_spec.ClearField(repository.FieldFullCheckError, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.QuickCheckIntervalDays(); ok {
+		_spec.SetField(repository.FieldQuickCheckIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuickCheckIntervalDays(); ok {
+		_spec.AddField(repository.FieldQuickCheckIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FullCheckIntervalWeeks(); ok {
+		_spec.SetField(repository.FieldFullCheckIntervalWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFullCheckIntervalWeeks(); ok {
+		_spec.AddField(repository.FieldFullCheckIntervalWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RollingCheckArchives(); ok {
+		_spec.SetField(repository.FieldRollingCheckArchives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollingCheckArchives(); ok {
+		_spec.AddField(repository.FieldRollingCheckArchives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CheckCycleStartedAt(); ok {
+		_spec.SetField(repository.FieldCheckCycleStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckCycleStartedAtCleared() {
+		_spec.ClearField(repository.FieldCheckCycleStartedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.StatsTotalChunks(); ok {
 		_spec.SetField(repository.FieldStatsTotalChunks, field.TypeInt, value)
 	}
@@ -568,6 +793,9 @@ func (_u *RepositoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedStatsUniqueCsize(); ok {
 		_spec.AddField(repository.FieldStatsUniqueCsize, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FileIndexEnabled(); ok {
+		_spec.SetField(repository.FieldFileIndexEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.BackupProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -703,6 +931,51 @@ func (_u *RepositoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatsSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatsSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.StatsSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatsSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CloudRepositoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -802,6 +1075,46 @@ func (_u *RepositoryUpdateOne) SetNillableHasPassword(v *bool) *RepositoryUpdate
 	return _u
 }
 
+// SetVolumeUUID sets the "volume_uuid" field.
+func (_u *RepositoryUpdateOne) SetVolumeUUID(v string) *RepositoryUpdateOne {
+	_u.mutation.SetVolumeUUID(v)
+	return _u
+}
+
+// SetNillableVolumeUUID sets the "volume_uuid" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableVolumeUUID(v *string) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetVolumeUUID(*v)
+	}
+	return _u
+}
+
+// ClearVolumeUUID clears the value of the "volume_uuid" field.
+func (_u *RepositoryUpdateOne) ClearVolumeUUID() *RepositoryUpdateOne {
+	_u.mutation.ClearVolumeUUID()
+	return _u
+}
+
+// SetVolumeLabel sets the "volume_label" field.
+func (_u *RepositoryUpdateOne) SetVolumeLabel(v string) *RepositoryUpdateOne {
+	_u.mutation.SetVolumeLabel(v)
+	return _u
+}
+
+// SetNillableVolumeLabel sets the "volume_label" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableVolumeLabel(v *string) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetVolumeLabel(*v)
+	}
+	return _u
+}
+
+// ClearVolumeLabel clears the value of the "volume_label" field.
+func (_u *RepositoryUpdateOne) ClearVolumeLabel() *RepositoryUpdateOne {
+	_u.mutation.ClearVolumeLabel()
+	return _u
+}
+
 // SetLastQuickCheckAt sets the "last_quick_check_at" field.
 func (_u *RepositoryUpdateOne) SetLastQuickCheckAt(v time.Time) *RepositoryUpdateOne {
 	_u.mutation.SetLastQuickCheckAt(v)
@@ -878,6 +1191,89 @@ func (_u *RepositoryUpdateOne) ClearFullCheckError() *RepositoryUpdateOne {
 	return _u
 }
 
+// SetQuickCheckIntervalDays sets the "quick_check_interval_days" field.
+func (_u *RepositoryUpdateOne) SetQuickCheckIntervalDays(v int) *RepositoryUpdateOne {
+	_u.mutation.ResetQuickCheckIntervalDays()
+	_u.mutation.SetQuickCheckIntervalDays(v)
+	return _u
+}
+
+// SetNillableQuickCheckIntervalDays sets the "quick_check_interval_days" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableQuickCheckIntervalDays(v *int) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetQuickCheckIntervalDays(*v)
+	}
+	return _u
+}
+
+// AddQuickCheckIntervalDays adds value to the "quick_check_interval_days" field.
+func (_u *RepositoryUpdateOne) AddQuickCheckIntervalDays(v int) *RepositoryUpdateOne {
+	_u.mutation.AddQuickCheckIntervalDays(v)
+	return _u
+}
+
+// SetFullCheckIntervalWeeks sets the "full_check_interval_weeks" field.
+func (_u *RepositoryUpdateOne) SetFullCheckIntervalWeeks(v int) *RepositoryUpdateOne {
+	_u.mutation.ResetFullCheckIntervalWeeks()
+	_u.mutation.SetFullCheckIntervalWeeks(v)
+	return _u
+}
+
+// SetNillableFullCheckIntervalWeeks sets the "full_check_interval_weeks" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableFullCheckIntervalWeeks(v *int) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetFullCheckIntervalWeeks(*v)
+	}
+	return _u
+}
+
+// AddFullCheckIntervalWeeks adds value to the "full_check_interval_weeks" field.
+func (_u *RepositoryUpdateOne) AddFullCheckIntervalWeeks(v int) *RepositoryUpdateOne {
+	_u.mutation.AddFullCheckIntervalWeeks(v)
+	return _u
+}
+
+// SetRollingCheckArchives sets the "rolling_check_archives" field.
+func (_u *RepositoryUpdateOne) SetRollingCheckArchives(v int) *RepositoryUpdateOne {
+	_u.mutation.ResetRollingCheckArchives()
+	_u.mutation.SetRollingCheckArchives(v)
+	return _u
+}
+
+// SetNillableRollingCheckArchives sets the "rolling_check_archives" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableRollingCheckArchives(v *int) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetRollingCheckArchives(*v)
+	}
+	return _u
+}
+
+// AddRollingCheckArchives adds value to the "rolling_check_archives" field.
+func (_u *RepositoryUpdateOne) AddRollingCheckArchives(v int) *RepositoryUpdateOne {
+	_u.mutation.AddRollingCheckArchives(v)
+	return _u
+}
+
+// SetCheckCycleStartedAt sets the "check_cycle_started_at" field.
+func (_u *RepositoryUpdateOne) SetCheckCycleStartedAt(v time.Time) *RepositoryUpdateOne {
+	_u.mutation.SetCheckCycleStartedAt(v)
+	return _u
+}
+
+// SetNillableCheckCycleStartedAt sets the "check_cycle_started_at" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableCheckCycleStartedAt(v *time.Time) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetCheckCycleStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckCycleStartedAt clears the value of the "check_cycle_started_at" field.
+func (_u *RepositoryUpdateOne) ClearCheckCycleStartedAt() *RepositoryUpdateOne {
+	_u.mutation.ClearCheckCycleStartedAt()
+	return _u
+}
+
 // SetStatsTotalChunks sets the "stats_total_chunks" field.
 func (_u *RepositoryUpdateOne) SetStatsTotalChunks(v int) *RepositoryUpdateOne {
 	_u.mutation.ResetStatsTotalChunks()
@@ -1004,6 +1400,20 @@ func (_u *RepositoryUpdateOne) AddStatsUniqueCsize(v int) *RepositoryUpdateOne {
 	return _u
 }
 
+// SetFileIndexEnabled sets the "file_index_enabled" field.
+func (_u *RepositoryUpdateOne) SetFileIndexEnabled(v bool) *RepositoryUpdateOne {
+	_u.mutation.SetFileIndexEnabled(v)
+	return _u
+}
+
+// SetNillableFileIndexEnabled sets the "file_index_enabled" field if the given value is not nil.
+func (_u *RepositoryUpdateOne) SetNillableFileIndexEnabled(v *bool) *RepositoryUpdateOne {
+	if v != nil {
+		_u.SetFileIndexEnabled(*v)
+	}
+	return _u
+}
+
 // AddBackupProfileIDs adds the "backup_profiles" edge to the BackupProfile entity by IDs.
 func (_u *RepositoryUpdateOne) AddBackupProfileIDs(ids ...int) *RepositoryUpdateOne {
 	_u.mutation.AddBackupProfileIDs(ids...)
@@ -1049,6 +1459,21 @@ func (_u *RepositoryUpdateOne) AddNotifications(v ...*Notification) *RepositoryU
 	return _u.AddNotificationIDs(ids...)
 }
 
+// AddStatsSnapshotIDs adds the "stats_snapshots" edge to the RepositoryStatsSnapshot entity by IDs.
+func (_u *RepositoryUpdateOne) AddStatsSnapshotIDs(ids ...int) *RepositoryUpdateOne {
+	_u.mutation.AddStatsSnapshotIDs(ids...)
+	return _u
+}
+
+// AddStatsSnapshots adds the "stats_snapshots" edges to the RepositoryStatsSnapshot entity.
+func (_u *RepositoryUpdateOne) AddStatsSnapshots(v ...*RepositoryStatsSnapshot) *RepositoryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatsSnapshotIDs(ids...)
+}
+
 // SetCloudRepositoryID sets the "cloud_repository" edge to the CloudRepository entity by ID.
 func (_u *RepositoryUpdateOne) SetCloudRepositoryID(id int) *RepositoryUpdateOne {
 	_u.mutation.SetCloudRepositoryID(id)
@@ -1136,6 +1561,27 @@ func (_u *RepositoryUpdateOne) RemoveNotifications(v ...*Notification) *Reposito
 	return _u.RemoveNotificationIDs(ids...)
 }
 
+// ClearStatsSnapshots clears all "stats_snapshots" edges to the RepositoryStatsSnapshot entity.
+func (_u *RepositoryUpdateOne) ClearStatsSnapshots() *RepositoryUpdateOne {
+	_u.mutation.ClearStatsSnapshots()
+	return _u
+}
+
+// RemoveStatsSnapshotIDs removes the "stats_snapshots" edge to RepositoryStatsSnapshot entities by IDs.
+func (_u *RepositoryUpdateOne) RemoveStatsSnapshotIDs(ids ...int) *RepositoryUpdateOne {
+	_u.mutation.RemoveStatsSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveStatsSnapshots removes "stats_snapshots" edges to RepositoryStatsSnapshot entities.
+func (_u *RepositoryUpdateOne) RemoveStatsSnapshots(v ...*RepositoryStatsSnapshot) *RepositoryUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatsSnapshotIDs(ids...)
+}
+
 // ClearCloudRepository clears the "cloud_repository" edge to the CloudRepository entity.
 func (_u *RepositoryUpdateOne) ClearCloudRepository() *RepositoryUpdateOne {
 	_u.mutation.ClearCloudRepository()
@@ -1198,6 +1644,21 @@ func (_u *RepositoryUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Repository.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.QuickCheckIntervalDays(); ok {
+		if err := repository.QuickCheckIntervalDaysValidator(v); err != nil {
+			return &ValidationError{Name: "quick_check_interval_days", err: fmt.Errorf(`ent: validator failed for field "Repository.quick_check_interval_days": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.FullCheckIntervalWeeks(); ok {
+		if err := repository.FullCheckIntervalWeeksValidator(v); err != nil {
+			return &ValidationError{Name: "full_check_interval_weeks", err: fmt.Errorf(`ent: validator failed for field "Repository.full_check_interval_weeks": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RollingCheckArchives(); ok {
+		if err := repository.RollingCheckArchivesValidator(v); err != nil {
+			return &ValidationError{Name: "rolling_check_archives", err: fmt.Errorf(`ent: validator failed for field "Repository.rolling_check_archives": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1248,6 +1709,18 @@ func (_u *RepositoryUpdateOne) sqlSave(ctx context.Context) (_node *Repository,
 	if value, ok := _u.mutation.HasPassword(); ok {
 		_spec.SetField(repository.FieldHasPassword, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.VolumeUUID(); ok {
+		_spec.SetField(repository.FieldVolumeUUID, field.TypeString, value)
+	}
+	if _u.mutation.VolumeUUIDCleared() {
+		_spec.ClearField(repository.FieldVolumeUUID, field.TypeString)
+	}
+	if value, ok := _u.mutation.VolumeLabel(); ok {
+		_spec.SetField(repository.FieldVolumeLabel, field.TypeString, value)
+	}
+	if _u.mutation.VolumeLabelCleared() {
+		_spec.ClearField(repository.FieldVolumeLabel, field.TypeString)
+	}
 	if value, ok := _u.mutation.LastQuickCheckAt(); ok {
 		_spec.SetField(repository.FieldLastQuickCheckAt, field.TypeTime, value)
 	}
@@ -1282,6 +1755,30 @@ func (_u *RepositoryUpdateOne) sqlSave(ctx context.Context) (_node *Repository,
 	if _u.mutation.FullCheckErrorCleared() {
 		_spec.ClearField(repository.FieldFullCheckError, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.QuickCheckIntervalDays(); ok {
+		_spec.SetField(repository.FieldQuickCheckIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuickCheckIntervalDays(); ok {
+		_spec.AddField(repository.FieldQuickCheckIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FullCheckIntervalWeeks(); ok {
+		_spec.SetField(repository.FieldFullCheckIntervalWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFullCheckIntervalWeeks(); ok {
+		_spec.AddField(repository.FieldFullCheckIntervalWeeks, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RollingCheckArchives(); ok {
+		_spec.SetField(repository.FieldRollingCheckArchives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRollingCheckArchives(); ok {
+		_spec.AddField(repository.FieldRollingCheckArchives, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CheckCycleStartedAt(); ok {
+		_spec.SetField(repository.FieldCheckCycleStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckCycleStartedAtCleared() {
+		_spec.ClearField(repository.FieldCheckCycleStartedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.StatsTotalChunks(); ok {
 		_spec.SetField(repository.FieldStatsTotalChunks, field.TypeInt, value)
 	}
@@ -1318,6 +1815,9 @@ func (_u *RepositoryUpdateOne) sqlSave(ctx context.Context) (_node *Repository,
 	if value, ok := _u.mutation.AddedStatsUniqueCsize(); ok {
 		_spec.AddField(repository.FieldStatsUniqueCsize, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.FileIndexEnabled(); ok {
+		_spec.SetField(repository.FieldFileIndexEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.BackupProfilesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -1453,6 +1953,51 @@ func (_u *RepositoryUpdateOne) sqlSave(ctx context.Context) (_node *Repository,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.StatsSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatsSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.StatsSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatsSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   repository.StatsSnapshotsTable,
+			Columns: []string{repository.StatsSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.CloudRepositoryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+)
+
+// RepositoryStatsSnapshot is the model entity for the RepositoryStatsSnapshot schema.
+type RepositoryStatsSnapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"createdAt"`
+	// Total uncompressed size of all chunks multiplied by their reference counts
+	TotalSize int `json:"totalSize"`
+	// Total compressed size of all chunks multiplied by their reference counts
+	TotalCsize int `json:"totalCsize"`
+	// Uncompressed size of unique chunks only
+	UniqueSize int `json:"uniqueSize"`
+	// Compressed size of unique chunks only (actual storage consumed on disk)
+	UniqueCsize int `json:"uniqueCsize"`
+	// RepositoryID holds the value of the "repository_id" field.
+	RepositoryID int `json:"repositoryId"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RepositoryStatsSnapshotQuery when eager-loading is set.
+	Edges        RepositoryStatsSnapshotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RepositoryStatsSnapshotEdges holds the relations/edges for other nodes in the graph.
+type RepositoryStatsSnapshotEdges struct {
+	// Repository holds the value of the repository edge.
+	Repository *Repository `json:"repository,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RepositoryOrErr returns the Repository value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RepositoryStatsSnapshotEdges) RepositoryOrErr() (*Repository, error) {
+	if e.Repository != nil {
+		return e.Repository, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: repository.Label}
+	}
+	return nil, &NotLoadedError{edge: "repository"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RepositoryStatsSnapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case repositorystatssnapshot.FieldID, repositorystatssnapshot.FieldTotalSize, repositorystatssnapshot.FieldTotalCsize, repositorystatssnapshot.FieldUniqueSize, repositorystatssnapshot.FieldUniqueCsize, repositorystatssnapshot.FieldRepositoryID:
+			values[i] = new(sql.NullInt64)
+		case repositorystatssnapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RepositoryStatsSnapshot fields.
+func (_m *RepositoryStatsSnapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case repositorystatssnapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case repositorystatssnapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case repositorystatssnapshot.FieldTotalSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_size", values[i])
+			} else if value.Valid {
+				_m.TotalSize = int(value.Int64)
+			}
+		case repositorystatssnapshot.FieldTotalCsize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_csize", values[i])
+			} else if value.Valid {
+				_m.TotalCsize = int(value.Int64)
+			}
+		case repositorystatssnapshot.FieldUniqueSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_size", values[i])
+			} else if value.Valid {
+				_m.UniqueSize = int(value.Int64)
+			}
+		case repositorystatssnapshot.FieldUniqueCsize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field unique_csize", values[i])
+			} else if value.Valid {
+				_m.UniqueCsize = int(value.Int64)
+			}
+		case repositorystatssnapshot.FieldRepositoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field repository_id", values[i])
+			} else if value.Valid {
+				_m.RepositoryID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RepositoryStatsSnapshot.
+// This includes values selected through modifiers, order, etc.
+func (_m *RepositoryStatsSnapshot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRepository queries the "repository" edge of the RepositoryStatsSnapshot entity.
+func (_m *RepositoryStatsSnapshot) QueryRepository() *RepositoryQuery {
+	return NewRepositoryStatsSnapshotClient(_m.config).QueryRepository(_m)
+}
+
+// Update returns a builder for updating this RepositoryStatsSnapshot.
+// Note that you need to call RepositoryStatsSnapshot.Unwrap() before calling this method if this RepositoryStatsSnapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RepositoryStatsSnapshot) Update() *RepositoryStatsSnapshotUpdateOne {
+	return NewRepositoryStatsSnapshotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RepositoryStatsSnapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RepositoryStatsSnapshot) Unwrap() *RepositoryStatsSnapshot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RepositoryStatsSnapshot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RepositoryStatsSnapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("RepositoryStatsSnapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("total_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalSize))
+	builder.WriteString(", ")
+	builder.WriteString("total_csize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalCsize))
+	builder.WriteString(", ")
+	builder.WriteString("unique_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UniqueSize))
+	builder.WriteString(", ")
+	builder.WriteString("unique_csize=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UniqueCsize))
+	builder.WriteString(", ")
+	builder.WriteString("repository_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RepositoryID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RepositoryStatsSnapshots is a parsable slice of RepositoryStatsSnapshot.
+type RepositoryStatsSnapshots []*RepositoryStatsSnapshot
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repositorystatssnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the repositorystatssnapshot type in the database.
+	Label = "repository_stats_snapshot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldTotalSize holds the string denoting the total_size field in the database.
+	FieldTotalSize = "total_size"
+	// FieldTotalCsize holds the string denoting the total_csize field in the database.
+	FieldTotalCsize = "total_csize"
+	// FieldUniqueSize holds the string denoting the unique_size field in the database.
+	FieldUniqueSize = "unique_size"
+	// FieldUniqueCsize holds the string denoting the unique_csize field in the database.
+	FieldUniqueCsize = "unique_csize"
+	// FieldRepositoryID holds the string denoting the repository_id field in the database.
+	FieldRepositoryID = "repository_stats_snapshot_repository"
+	// EdgeRepository holds the string denoting the repository edge name in mutations.
+	EdgeRepository = "repository"
+	// Table holds the table name of the repositorystatssnapshot in the database.
+	Table = "repository_stats_snapshots"
+	// RepositoryTable is the table that holds the repository relation/edge.
+	RepositoryTable = "repository_stats_snapshots"
+	// RepositoryInverseTable is the table name for the Repository entity.
+	// It exists in this package in order to avoid circular dependency with the "repository" package.
+	RepositoryInverseTable = "repositories"
+	// RepositoryColumn is the table column denoting the repository relation/edge.
+	RepositoryColumn = "repository_stats_snapshot_repository"
+)
+
+// Columns holds all SQL columns for repositorystatssnapshot fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldTotalSize,
+	FieldTotalCsize,
+	FieldUniqueSize,
+	FieldUniqueCsize,
+	FieldRepositoryID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RepositoryStatsSnapshot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByTotalSize orders the results by the total_size field.
+func ByTotalSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalSize, opts...).ToFunc()
+}
+
+// ByTotalCsize orders the results by the total_csize field.
+func ByTotalCsize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalCsize, opts...).ToFunc()
+}
+
+// ByUniqueSize orders the results by the unique_size field.
+func ByUniqueSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUniqueSize, opts...).ToFunc()
+}
+
+// ByUniqueCsize orders the results by the unique_csize field.
+func ByUniqueCsize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUniqueCsize, opts...).ToFunc()
+}
+
+// ByRepositoryID orders the results by the repository_id field.
+func ByRepositoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepositoryID, opts...).ToFunc()
+}
+
+// ByRepositoryField orders the results by repository field.
+func ByRepositoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepositoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRepositoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RepositoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RepositoryTable, RepositoryColumn),
+	)
+}
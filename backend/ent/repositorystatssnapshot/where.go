@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package repositorystatssnapshot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// TotalSize applies equality check predicate on the "total_size" field. It's identical to TotalSizeEQ.
+func TotalSize(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldTotalSize, v))
+}
+
+// TotalCsize applies equality check predicate on the "total_csize" field. It's identical to TotalCsizeEQ.
+func TotalCsize(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldTotalCsize, v))
+}
+
+// UniqueSize applies equality check predicate on the "unique_size" field. It's identical to UniqueSizeEQ.
+func UniqueSize(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldUniqueSize, v))
+}
+
+// UniqueCsize applies equality check predicate on the "unique_csize" field. It's identical to UniqueCsizeEQ.
+func UniqueCsize(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldUniqueCsize, v))
+}
+
+// RepositoryID applies equality check predicate on the "repository_id" field. It's identical to RepositoryIDEQ.
+func RepositoryID(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldRepositoryID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// TotalSizeEQ applies the EQ predicate on the "total_size" field.
+func TotalSizeEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldTotalSize, v))
+}
+
+// TotalSizeNEQ applies the NEQ predicate on the "total_size" field.
+func TotalSizeNEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldTotalSize, v))
+}
+
+// TotalSizeIn applies the In predicate on the "total_size" field.
+func TotalSizeIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldTotalSize, vs...))
+}
+
+// TotalSizeNotIn applies the NotIn predicate on the "total_size" field.
+func TotalSizeNotIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldTotalSize, vs...))
+}
+
+// TotalSizeGT applies the GT predicate on the "total_size" field.
+func TotalSizeGT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldTotalSize, v))
+}
+
+// TotalSizeGTE applies the GTE predicate on the "total_size" field.
+func TotalSizeGTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldTotalSize, v))
+}
+
+// TotalSizeLT applies the LT predicate on the "total_size" field.
+func TotalSizeLT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldTotalSize, v))
+}
+
+// TotalSizeLTE applies the LTE predicate on the "total_size" field.
+func TotalSizeLTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldTotalSize, v))
+}
+
+// TotalCsizeEQ applies the EQ predicate on the "total_csize" field.
+func TotalCsizeEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldTotalCsize, v))
+}
+
+// TotalCsizeNEQ applies the NEQ predicate on the "total_csize" field.
+func TotalCsizeNEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldTotalCsize, v))
+}
+
+// TotalCsizeIn applies the In predicate on the "total_csize" field.
+func TotalCsizeIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldTotalCsize, vs...))
+}
+
+// TotalCsizeNotIn applies the NotIn predicate on the "total_csize" field.
+func TotalCsizeNotIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldTotalCsize, vs...))
+}
+
+// TotalCsizeGT applies the GT predicate on the "total_csize" field.
+func TotalCsizeGT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldTotalCsize, v))
+}
+
+// TotalCsizeGTE applies the GTE predicate on the "total_csize" field.
+func TotalCsizeGTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldTotalCsize, v))
+}
+
+// TotalCsizeLT applies the LT predicate on the "total_csize" field.
+func TotalCsizeLT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldTotalCsize, v))
+}
+
+// TotalCsizeLTE applies the LTE predicate on the "total_csize" field.
+func TotalCsizeLTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldTotalCsize, v))
+}
+
+// UniqueSizeEQ applies the EQ predicate on the "unique_size" field.
+func UniqueSizeEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldUniqueSize, v))
+}
+
+// UniqueSizeNEQ applies the NEQ predicate on the "unique_size" field.
+func UniqueSizeNEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldUniqueSize, v))
+}
+
+// UniqueSizeIn applies the In predicate on the "unique_size" field.
+func UniqueSizeIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldUniqueSize, vs...))
+}
+
+// UniqueSizeNotIn applies the NotIn predicate on the "unique_size" field.
+func UniqueSizeNotIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldUniqueSize, vs...))
+}
+
+// UniqueSizeGT applies the GT predicate on the "unique_size" field.
+func UniqueSizeGT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldUniqueSize, v))
+}
+
+// UniqueSizeGTE applies the GTE predicate on the "unique_size" field.
+func UniqueSizeGTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldUniqueSize, v))
+}
+
+// UniqueSizeLT applies the LT predicate on the "unique_size" field.
+func UniqueSizeLT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldUniqueSize, v))
+}
+
+// UniqueSizeLTE applies the LTE predicate on the "unique_size" field.
+func UniqueSizeLTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldUniqueSize, v))
+}
+
+// UniqueCsizeEQ applies the EQ predicate on the "unique_csize" field.
+func UniqueCsizeEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldUniqueCsize, v))
+}
+
+// UniqueCsizeNEQ applies the NEQ predicate on the "unique_csize" field.
+func UniqueCsizeNEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldUniqueCsize, v))
+}
+
+// UniqueCsizeIn applies the In predicate on the "unique_csize" field.
+func UniqueCsizeIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldUniqueCsize, vs...))
+}
+
+// UniqueCsizeNotIn applies the NotIn predicate on the "unique_csize" field.
+func UniqueCsizeNotIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldUniqueCsize, vs...))
+}
+
+// UniqueCsizeGT applies the GT predicate on the "unique_csize" field.
+func UniqueCsizeGT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGT(FieldUniqueCsize, v))
+}
+
+// UniqueCsizeGTE applies the GTE predicate on the "unique_csize" field.
+func UniqueCsizeGTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldGTE(FieldUniqueCsize, v))
+}
+
+// UniqueCsizeLT applies the LT predicate on the "unique_csize" field.
+func UniqueCsizeLT(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLT(FieldUniqueCsize, v))
+}
+
+// UniqueCsizeLTE applies the LTE predicate on the "unique_csize" field.
+func UniqueCsizeLTE(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldLTE(FieldUniqueCsize, v))
+}
+
+// RepositoryIDEQ applies the EQ predicate on the "repository_id" field.
+func RepositoryIDEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldEQ(FieldRepositoryID, v))
+}
+
+// RepositoryIDNEQ applies the NEQ predicate on the "repository_id" field.
+func RepositoryIDNEQ(v int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNEQ(FieldRepositoryID, v))
+}
+
+// RepositoryIDIn applies the In predicate on the "repository_id" field.
+func RepositoryIDIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldIn(FieldRepositoryID, vs...))
+}
+
+// RepositoryIDNotIn applies the NotIn predicate on the "repository_id" field.
+func RepositoryIDNotIn(vs ...int) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.FieldNotIn(FieldRepositoryID, vs...))
+}
+
+// HasRepository applies the HasEdge predicate on the "repository" edge.
+func HasRepository() predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RepositoryTable, RepositoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepositoryWith applies the HasEdge predicate on the "repository" edge with a given conditions (other predicates).
+func HasRepositoryWith(preds ...predicate.Repository) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(func(s *sql.Selector) {
+		step := newRepositoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RepositoryStatsSnapshot) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RepositoryStatsSnapshot) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RepositoryStatsSnapshot) predicate.RepositoryStatsSnapshot {
+	return predicate.RepositoryStatsSnapshot(sql.NotPredicates(p))
+}
@@ -0,0 +1,301 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+)
+
+// RepositoryStatsSnapshotCreate is the builder for creating a RepositoryStatsSnapshot entity.
+type RepositoryStatsSnapshotCreate struct {
+	config
+	mutation *RepositoryStatsSnapshotMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RepositoryStatsSnapshotCreate) SetCreatedAt(v time.Time) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RepositoryStatsSnapshotCreate) SetNillableCreatedAt(v *time.Time) *RepositoryStatsSnapshotCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetTotalSize sets the "total_size" field.
+func (_c *RepositoryStatsSnapshotCreate) SetTotalSize(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetTotalSize(v)
+	return _c
+}
+
+// SetTotalCsize sets the "total_csize" field.
+func (_c *RepositoryStatsSnapshotCreate) SetTotalCsize(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetTotalCsize(v)
+	return _c
+}
+
+// SetUniqueSize sets the "unique_size" field.
+func (_c *RepositoryStatsSnapshotCreate) SetUniqueSize(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetUniqueSize(v)
+	return _c
+}
+
+// SetUniqueCsize sets the "unique_csize" field.
+func (_c *RepositoryStatsSnapshotCreate) SetUniqueCsize(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetUniqueCsize(v)
+	return _c
+}
+
+// SetRepositoryID sets the "repository_id" field.
+func (_c *RepositoryStatsSnapshotCreate) SetRepositoryID(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetRepositoryID(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RepositoryStatsSnapshotCreate) SetID(v int) *RepositoryStatsSnapshotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetRepository sets the "repository" edge to the Repository entity.
+func (_c *RepositoryStatsSnapshotCreate) SetRepository(v *Repository) *RepositoryStatsSnapshotCreate {
+	return _c.SetRepositoryID(v.ID)
+}
+
+// Mutation returns the RepositoryStatsSnapshotMutation object of the builder.
+func (_c *RepositoryStatsSnapshotCreate) Mutation() *RepositoryStatsSnapshotMutation {
+	return _c.mutation
+}
+
+// Save creates the RepositoryStatsSnapshot in the database.
+func (_c *RepositoryStatsSnapshotCreate) Save(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RepositoryStatsSnapshotCreate) SaveX(ctx context.Context) *RepositoryStatsSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RepositoryStatsSnapshotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RepositoryStatsSnapshotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RepositoryStatsSnapshotCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := repositorystatssnapshot.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RepositoryStatsSnapshotCreate) check() error {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.created_at"`)}
+	}
+	if _, ok := _c.mutation.TotalSize(); !ok {
+		return &ValidationError{Name: "total_size", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.total_size"`)}
+	}
+	if _, ok := _c.mutation.TotalCsize(); !ok {
+		return &ValidationError{Name: "total_csize", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.total_csize"`)}
+	}
+	if _, ok := _c.mutation.UniqueSize(); !ok {
+		return &ValidationError{Name: "unique_size", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.unique_size"`)}
+	}
+	if _, ok := _c.mutation.UniqueCsize(); !ok {
+		return &ValidationError{Name: "unique_csize", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.unique_csize"`)}
+	}
+	if _, ok := _c.mutation.RepositoryID(); !ok {
+		return &ValidationError{Name: "repository_id", err: errors.New(`ent: missing required field "RepositoryStatsSnapshot.repository_id"`)}
+	}
+	if len(_c.mutation.RepositoryIDs()) == 0 {
+		return &ValidationError{Name: "repository", err: errors.New(`ent: missing required edge "RepositoryStatsSnapshot.repository"`)}
+	}
+	return nil
+}
+
+func (_c *RepositoryStatsSnapshotCreate) sqlSave(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RepositoryStatsSnapshotCreate) createSpec() (*RepositoryStatsSnapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RepositoryStatsSnapshot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(repositorystatssnapshot.Table, sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(repositorystatssnapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.TotalSize(); ok {
+		_spec.SetField(repositorystatssnapshot.FieldTotalSize, field.TypeInt, value)
+		_node.TotalSize = value
+	}
+	if value, ok := _c.mutation.TotalCsize(); ok {
+		_spec.SetField(repositorystatssnapshot.FieldTotalCsize, field.TypeInt, value)
+		_node.TotalCsize = value
+	}
+	if value, ok := _c.mutation.UniqueSize(); ok {
+		_spec.SetField(repositorystatssnapshot.FieldUniqueSize, field.TypeInt, value)
+		_node.UniqueSize = value
+	}
+	if value, ok := _c.mutation.UniqueCsize(); ok {
+		_spec.SetField(repositorystatssnapshot.FieldUniqueCsize, field.TypeInt, value)
+		_node.UniqueCsize = value
+	}
+	if nodes := _c.mutation.RepositoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   repositorystatssnapshot.RepositoryTable,
+			Columns: []string{repositorystatssnapshot.RepositoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(repository.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RepositoryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RepositoryStatsSnapshotCreateBulk is the builder for creating many RepositoryStatsSnapshot entities in bulk.
+type RepositoryStatsSnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*RepositoryStatsSnapshotCreate
+}
+
+// Save creates the RepositoryStatsSnapshot entities in the database.
+func (_c *RepositoryStatsSnapshotCreateBulk) Save(ctx context.Context) ([]*RepositoryStatsSnapshot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RepositoryStatsSnapshot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RepositoryStatsSnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RepositoryStatsSnapshotCreateBulk) SaveX(ctx context.Context) []*RepositoryStatsSnapshot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RepositoryStatsSnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RepositoryStatsSnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+)
+
+// RepositoryStatsSnapshotDelete is the builder for deleting a RepositoryStatsSnapshot entity.
+type RepositoryStatsSnapshotDelete struct {
+	config
+	hooks    []Hook
+	mutation *RepositoryStatsSnapshotMutation
+}
+
+// Where appends a list predicates to the RepositoryStatsSnapshotDelete builder.
+func (_d *RepositoryStatsSnapshotDelete) Where(ps ...predicate.RepositoryStatsSnapshot) *RepositoryStatsSnapshotDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RepositoryStatsSnapshotDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RepositoryStatsSnapshotDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RepositoryStatsSnapshotDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(repositorystatssnapshot.Table, sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RepositoryStatsSnapshotDeleteOne is the builder for deleting a single RepositoryStatsSnapshot entity.
+type RepositoryStatsSnapshotDeleteOne struct {
+	_d *RepositoryStatsSnapshotDelete
+}
+
+// Where appends a list predicates to the RepositoryStatsSnapshotDelete builder.
+func (_d *RepositoryStatsSnapshotDeleteOne) Where(ps ...predicate.RepositoryStatsSnapshot) *RepositoryStatsSnapshotDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RepositoryStatsSnapshotDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{repositorystatssnapshot.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RepositoryStatsSnapshotDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
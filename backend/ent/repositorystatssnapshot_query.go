@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+)
+
+// RepositoryStatsSnapshotQuery is the builder for querying RepositoryStatsSnapshot entities.
+type RepositoryStatsSnapshotQuery struct {
+	config
+	ctx            *QueryContext
+	order          []repositorystatssnapshot.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.RepositoryStatsSnapshot
+	withRepository *RepositoryQuery
+	modifiers      []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RepositoryStatsSnapshotQuery builder.
+func (_q *RepositoryStatsSnapshotQuery) Where(ps ...predicate.RepositoryStatsSnapshot) *RepositoryStatsSnapshotQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *RepositoryStatsSnapshotQuery) Limit(limit int) *RepositoryStatsSnapshotQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *RepositoryStatsSnapshotQuery) Offset(offset int) *RepositoryStatsSnapshotQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *RepositoryStatsSnapshotQuery) Unique(unique bool) *RepositoryStatsSnapshotQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *RepositoryStatsSnapshotQuery) Order(o ...repositorystatssnapshot.OrderOption) *RepositoryStatsSnapshotQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryRepository chains the current query on the "repository" edge.
+func (_q *RepositoryStatsSnapshotQuery) QueryRepository() *RepositoryQuery {
+	query := (&RepositoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(repositorystatssnapshot.Table, repositorystatssnapshot.FieldID, selector),
+			sqlgraph.To(repository.Table, repository.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, repositorystatssnapshot.RepositoryTable, repositorystatssnapshot.RepositoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first RepositoryStatsSnapshot entity from the query.
+// Returns a *NotFoundError when no RepositoryStatsSnapshot was found.
+func (_q *RepositoryStatsSnapshotQuery) First(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{repositorystatssnapshot.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) FirstX(ctx context.Context) *RepositoryStatsSnapshot {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RepositoryStatsSnapshot ID from the query.
+// Returns a *NotFoundError when no RepositoryStatsSnapshot ID was found.
+func (_q *RepositoryStatsSnapshotQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{repositorystatssnapshot.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) FirstIDX(ctx context.Context) int {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RepositoryStatsSnapshot entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RepositoryStatsSnapshot entity is found.
+// Returns a *NotFoundError when no RepositoryStatsSnapshot entities are found.
+func (_q *RepositoryStatsSnapshotQuery) Only(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{repositorystatssnapshot.Label}
+	default:
+		return nil, &NotSingularError{repositorystatssnapshot.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) OnlyX(ctx context.Context) *RepositoryStatsSnapshot {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RepositoryStatsSnapshot ID in the query.
+// Returns a *NotSingularError when more than one RepositoryStatsSnapshot ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *RepositoryStatsSnapshotQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{repositorystatssnapshot.Label}
+	default:
+		err = &NotSingularError{repositorystatssnapshot.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) OnlyIDX(ctx context.Context) int {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RepositoryStatsSnapshots.
+func (_q *RepositoryStatsSnapshotQuery) All(ctx context.Context) ([]*RepositoryStatsSnapshot, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RepositoryStatsSnapshot, *RepositoryStatsSnapshotQuery]()
+	return withInterceptors[[]*RepositoryStatsSnapshot](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) AllX(ctx context.Context) []*RepositoryStatsSnapshot {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RepositoryStatsSnapshot IDs.
+func (_q *RepositoryStatsSnapshotQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(repositorystatssnapshot.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) IDsX(ctx context.Context) []int {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *RepositoryStatsSnapshotQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*RepositoryStatsSnapshotQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *RepositoryStatsSnapshotQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *RepositoryStatsSnapshotQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RepositoryStatsSnapshotQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *RepositoryStatsSnapshotQuery) Clone() *RepositoryStatsSnapshotQuery {
+	if _q == nil {
+		return nil
+	}
+	return &RepositoryStatsSnapshotQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]repositorystatssnapshot.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.RepositoryStatsSnapshot{}, _q.predicates...),
+		withRepository: _q.withRepository.Clone(),
+		// clone intermediate query.
+		sql:       _q.sql.Clone(),
+		path:      _q.path,
+		modifiers: append([]func(*sql.Selector){}, _q.modifiers...),
+	}
+}
+
+// WithRepository tells the query-builder to eager-load the nodes that are connected to
+// the "repository" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RepositoryStatsSnapshotQuery) WithRepository(opts ...func(*RepositoryQuery)) *RepositoryStatsSnapshotQuery {
+	query := (&RepositoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRepository = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RepositoryStatsSnapshot.Query().
+//		GroupBy(repositorystatssnapshot.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *RepositoryStatsSnapshotQuery) GroupBy(field string, fields ...string) *RepositoryStatsSnapshotGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RepositoryStatsSnapshotGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = repositorystatssnapshot.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"createdAt"`
+//	}
+//
+//	client.RepositoryStatsSnapshot.Query().
+//		Select(repositorystatssnapshot.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (_q *RepositoryStatsSnapshotQuery) Select(fields ...string) *RepositoryStatsSnapshotSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &RepositoryStatsSnapshotSelect{RepositoryStatsSnapshotQuery: _q}
+	sbuild.label = repositorystatssnapshot.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RepositoryStatsSnapshotSelect configured with the given aggregations.
+func (_q *RepositoryStatsSnapshotQuery) Aggregate(fns ...AggregateFunc) *RepositoryStatsSnapshotSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *RepositoryStatsSnapshotQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !repositorystatssnapshot.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *RepositoryStatsSnapshotQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RepositoryStatsSnapshot, error) {
+	var (
+		nodes       = []*RepositoryStatsSnapshot{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withRepository != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RepositoryStatsSnapshot).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RepositoryStatsSnapshot{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withRepository; query != nil {
+		if err := _q.loadRepository(ctx, query, nodes, nil,
+			func(n *RepositoryStatsSnapshot, e *Repository) { n.Edges.Repository = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *RepositoryStatsSnapshotQuery) loadRepository(ctx context.Context, query *RepositoryQuery, nodes []*RepositoryStatsSnapshot, init func(*RepositoryStatsSnapshot), assign func(*RepositoryStatsSnapshot, *Repository)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*RepositoryStatsSnapshot)
+	for i := range nodes {
+		fk := nodes[i].RepositoryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(repository.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "repository_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *RepositoryStatsSnapshotQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *RepositoryStatsSnapshotQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(repositorystatssnapshot.Table, repositorystatssnapshot.Columns, sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, repositorystatssnapshot.FieldID)
+		for i := range fields {
+			if fields[i] != repositorystatssnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withRepository != nil {
+			_spec.Node.AddColumnOnce(repositorystatssnapshot.FieldRepositoryID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *RepositoryStatsSnapshotQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(repositorystatssnapshot.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = repositorystatssnapshot.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_q *RepositoryStatsSnapshotQuery) Modify(modifiers ...func(s *sql.Selector)) *RepositoryStatsSnapshotSelect {
+	_q.modifiers = append(_q.modifiers, modifiers...)
+	return _q.Select()
+}
+
+// RepositoryStatsSnapshotGroupBy is the group-by builder for RepositoryStatsSnapshot entities.
+type RepositoryStatsSnapshotGroupBy struct {
+	selector
+	build *RepositoryStatsSnapshotQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *RepositoryStatsSnapshotGroupBy) Aggregate(fns ...AggregateFunc) *RepositoryStatsSnapshotGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *RepositoryStatsSnapshotGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RepositoryStatsSnapshotQuery, *RepositoryStatsSnapshotGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *RepositoryStatsSnapshotGroupBy) sqlScan(ctx context.Context, root *RepositoryStatsSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RepositoryStatsSnapshotSelect is the builder for selecting fields of RepositoryStatsSnapshot entities.
+type RepositoryStatsSnapshotSelect struct {
+	*RepositoryStatsSnapshotQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *RepositoryStatsSnapshotSelect) Aggregate(fns ...AggregateFunc) *RepositoryStatsSnapshotSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *RepositoryStatsSnapshotSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RepositoryStatsSnapshotQuery, *RepositoryStatsSnapshotSelect](ctx, _s.RepositoryStatsSnapshotQuery, _s, _s.inters, v)
+}
+
+func (_s *RepositoryStatsSnapshotSelect) sqlScan(ctx context.Context, root *RepositoryStatsSnapshotQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (_s *RepositoryStatsSnapshotSelect) Modify(modifiers ...func(s *sql.Selector)) *RepositoryStatsSnapshotSelect {
+	_s.modifiers = append(_s.modifiers, modifiers...)
+	return _s
+}
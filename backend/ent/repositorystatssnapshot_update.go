@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/loomi-labs/arco/backend/ent/predicate"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
+)
+
+// RepositoryStatsSnapshotUpdate is the builder for updating RepositoryStatsSnapshot entities.
+type RepositoryStatsSnapshotUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *RepositoryStatsSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the RepositoryStatsSnapshotUpdate builder.
+func (_u *RepositoryStatsSnapshotUpdate) Where(ps ...predicate.RepositoryStatsSnapshot) *RepositoryStatsSnapshotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the RepositoryStatsSnapshotMutation object of the builder.
+func (_u *RepositoryStatsSnapshotUpdate) Mutation() *RepositoryStatsSnapshotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RepositoryStatsSnapshotUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RepositoryStatsSnapshotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RepositoryStatsSnapshotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RepositoryStatsSnapshotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RepositoryStatsSnapshotUpdate) check() error {
+	if _u.mutation.RepositoryCleared() && len(_u.mutation.RepositoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RepositoryStatsSnapshot.repository"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RepositoryStatsSnapshotUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RepositoryStatsSnapshotUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RepositoryStatsSnapshotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(repositorystatssnapshot.Table, repositorystatssnapshot.Columns, sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repositorystatssnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RepositoryStatsSnapshotUpdateOne is the builder for updating a single RepositoryStatsSnapshot entity.
+type RepositoryStatsSnapshotUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *RepositoryStatsSnapshotMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Mutation returns the RepositoryStatsSnapshotMutation object of the builder.
+func (_u *RepositoryStatsSnapshotUpdateOne) Mutation() *RepositoryStatsSnapshotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RepositoryStatsSnapshotUpdate builder.
+func (_u *RepositoryStatsSnapshotUpdateOne) Where(ps ...predicate.RepositoryStatsSnapshot) *RepositoryStatsSnapshotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RepositoryStatsSnapshotUpdateOne) Select(field string, fields ...string) *RepositoryStatsSnapshotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RepositoryStatsSnapshot entity.
+func (_u *RepositoryStatsSnapshotUpdateOne) Save(ctx context.Context) (*RepositoryStatsSnapshot, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RepositoryStatsSnapshotUpdateOne) SaveX(ctx context.Context) *RepositoryStatsSnapshot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RepositoryStatsSnapshotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RepositoryStatsSnapshotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RepositoryStatsSnapshotUpdateOne) check() error {
+	if _u.mutation.RepositoryCleared() && len(_u.mutation.RepositoryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RepositoryStatsSnapshot.repository"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *RepositoryStatsSnapshotUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *RepositoryStatsSnapshotUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *RepositoryStatsSnapshotUpdateOne) sqlSave(ctx context.Context) (_node *RepositoryStatsSnapshot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(repositorystatssnapshot.Table, repositorystatssnapshot.Columns, sqlgraph.NewFieldSpec(repositorystatssnapshot.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RepositoryStatsSnapshot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, repositorystatssnapshot.FieldID)
+		for _, f := range fields {
+			if !repositorystatssnapshot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != repositorystatssnapshot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &RepositoryStatsSnapshot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{repositorystatssnapshot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
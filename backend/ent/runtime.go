@@ -9,15 +9,18 @@ import (
 	"github.com/loomi-labs/arco/backend/ent/analyticsevent"
 	"github.com/loomi-labs/arco/backend/ent/archive"
 	"github.com/loomi-labs/arco/backend/ent/authsession"
+	"github.com/loomi-labs/arco/backend/ent/backuphook"
 	"github.com/loomi-labs/arco/backend/ent/backupprofile"
 	"github.com/loomi-labs/arco/backend/ent/backupschedule"
 	"github.com/loomi-labs/arco/backend/ent/cloudrepository"
 	"github.com/loomi-labs/arco/backend/ent/notification"
 	"github.com/loomi-labs/arco/backend/ent/pruningrule"
 	"github.com/loomi-labs/arco/backend/ent/repository"
+	"github.com/loomi-labs/arco/backend/ent/repositorystatssnapshot"
 	"github.com/loomi-labs/arco/backend/ent/schema"
 	"github.com/loomi-labs/arco/backend/ent/settings"
 	"github.com/loomi-labs/arco/backend/ent/user"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -74,6 +77,10 @@ func init() {
 	archiveDescComment := archiveFields[5].Descriptor()
 	// archive.DefaultComment holds the default value on creation for the comment field.
 	archive.DefaultComment = archiveDescComment.Default.(string)
+	// archiveDescStatsFailures is the schema descriptor for stats_failures field.
+	archiveDescStatsFailures := archiveFields[12].Descriptor()
+	// archive.DefaultStatsFailures holds the default value on creation for the stats_failures field.
+	archive.DefaultStatsFailures = archiveDescStatsFailures.Default.(int)
 	authsessionMixin := schema.AuthSession{}.Mixin()
 	authsessionMixinFields0 := authsessionMixin[0].Fields()
 	_ = authsessionMixinFields0
@@ -89,6 +96,41 @@ func init() {
 	authsession.DefaultUpdatedAt = authsessionDescUpdatedAt.Default.(func() time.Time)
 	// authsession.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	authsession.UpdateDefaultUpdatedAt = authsessionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	backuphookMixin := schema.BackupHook{}.Mixin()
+	backuphookMixinFields0 := backuphookMixin[0].Fields()
+	_ = backuphookMixinFields0
+	backuphookFields := schema.BackupHook{}.Fields()
+	_ = backuphookFields
+	// backuphookDescCreatedAt is the schema descriptor for created_at field.
+	backuphookDescCreatedAt := backuphookMixinFields0[0].Descriptor()
+	// backuphook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backuphook.DefaultCreatedAt = backuphookDescCreatedAt.Default.(func() time.Time)
+	// backuphookDescUpdatedAt is the schema descriptor for updated_at field.
+	backuphookDescUpdatedAt := backuphookMixinFields0[1].Descriptor()
+	// backuphook.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	backuphook.DefaultUpdatedAt = backuphookDescUpdatedAt.Default.(func() time.Time)
+	// backuphook.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	backuphook.UpdateDefaultUpdatedAt = backuphookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// backuphookDescCommand is the schema descriptor for command field.
+	backuphookDescCommand := backuphookFields[3].Descriptor()
+	// backuphook.CommandValidator is a validator for the "command" field. It is called by the builders before save.
+	backuphook.CommandValidator = backuphookDescCommand.Validators[0].(func(string) error)
+	// backuphookDescPosition is the schema descriptor for position field.
+	backuphookDescPosition := backuphookFields[4].Descriptor()
+	// backuphook.DefaultPosition holds the default value on creation for the position field.
+	backuphook.DefaultPosition = backuphookDescPosition.Default.(int)
+	// backuphook.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	backuphook.PositionValidator = backuphookDescPosition.Validators[0].(func(int) error)
+	// backuphookDescTimeoutSeconds is the schema descriptor for timeout_seconds field.
+	backuphookDescTimeoutSeconds := backuphookFields[5].Descriptor()
+	// backuphook.DefaultTimeoutSeconds holds the default value on creation for the timeout_seconds field.
+	backuphook.DefaultTimeoutSeconds = backuphookDescTimeoutSeconds.Default.(int)
+	// backuphook.TimeoutSecondsValidator is a validator for the "timeout_seconds" field. It is called by the builders before save.
+	backuphook.TimeoutSecondsValidator = backuphookDescTimeoutSeconds.Validators[0].(func(int) error)
+	// backuphookDescAbortOnFailure is the schema descriptor for abort_on_failure field.
+	backuphookDescAbortOnFailure := backuphookFields[6].Descriptor()
+	// backuphook.DefaultAbortOnFailure holds the default value on creation for the abort_on_failure field.
+	backuphook.DefaultAbortOnFailure = backuphookDescAbortOnFailure.Default.(bool)
 	backupprofileMixin := schema.BackupProfile{}.Mixin()
 	backupprofileMixinFields0 := backupprofileMixin[0].Fields()
 	_ = backupprofileMixinFields0
@@ -134,12 +176,32 @@ func init() {
 	backupprofileDescExcludePaths := backupprofileFields[4].Descriptor()
 	// backupprofile.DefaultExcludePaths holds the default value on creation for the exclude_paths field.
 	backupprofile.DefaultExcludePaths = backupprofileDescExcludePaths.Default.([]string)
+	// backupprofileDescPatterns is the schema descriptor for patterns field.
+	backupprofileDescPatterns := backupprofileFields[5].Descriptor()
+	// backupprofile.DefaultPatterns holds the default value on creation for the patterns field.
+	backupprofile.DefaultPatterns = backupprofileDescPatterns.Default.([]string)
 	// backupprofileDescExcludeCaches is the schema descriptor for exclude_caches field.
-	backupprofileDescExcludeCaches := backupprofileFields[5].Descriptor()
+	backupprofileDescExcludeCaches := backupprofileFields[6].Descriptor()
 	// backupprofile.DefaultExcludeCaches holds the default value on creation for the exclude_caches field.
 	backupprofile.DefaultExcludeCaches = backupprofileDescExcludeCaches.Default.(bool)
+	// backupprofileDescExcludeIfPresent is the schema descriptor for exclude_if_present field.
+	backupprofileDescExcludeIfPresent := backupprofileFields[7].Descriptor()
+	// backupprofile.DefaultExcludeIfPresent holds the default value on creation for the exclude_if_present field.
+	backupprofile.DefaultExcludeIfPresent = backupprofileDescExcludeIfPresent.Default.([]string)
+	// backupprofileDescUseIgnoreFiles is the schema descriptor for use_ignore_files field.
+	backupprofileDescUseIgnoreFiles := backupprofileFields[8].Descriptor()
+	// backupprofile.DefaultUseIgnoreFiles holds the default value on creation for the use_ignore_files field.
+	backupprofile.DefaultUseIgnoreFiles = backupprofileDescUseIgnoreFiles.Default.(bool)
+	// backupprofileDescContentCommand is the schema descriptor for content_command field.
+	backupprofileDescContentCommand := backupprofileFields[9].Descriptor()
+	// backupprofile.DefaultContentCommand holds the default value on creation for the content_command field.
+	backupprofile.DefaultContentCommand = backupprofileDescContentCommand.Default.(string)
+	// backupprofileDescContentStdinName is the schema descriptor for content_stdin_name field.
+	backupprofileDescContentStdinName := backupprofileFields[10].Descriptor()
+	// backupprofile.DefaultContentStdinName holds the default value on creation for the content_stdin_name field.
+	backupprofile.DefaultContentStdinName = backupprofileDescContentStdinName.Default.(string)
 	// backupprofileDescCompressionLevel is the schema descriptor for compression_level field.
-	backupprofileDescCompressionLevel := backupprofileFields[8].Descriptor()
+	backupprofileDescCompressionLevel := backupprofileFields[13].Descriptor()
 	// backupprofile.CompressionLevelValidator is a validator for the "compression_level" field. It is called by the builders before save.
 	backupprofile.CompressionLevelValidator = func() func(int) error {
 		validators := backupprofileDescCompressionLevel.Validators
@@ -156,16 +218,62 @@ func init() {
 			return nil
 		}
 	}()
+	// backupprofileDescOneFileSystem is the schema descriptor for one_file_system field.
+	backupprofileDescOneFileSystem := backupprofileFields[14].Descriptor()
+	// backupprofile.DefaultOneFileSystem holds the default value on creation for the one_file_system field.
+	backupprofile.DefaultOneFileSystem = backupprofileDescOneFileSystem.Default.(bool)
+	// backupprofileDescNumericIds is the schema descriptor for numeric_ids field.
+	backupprofileDescNumericIds := backupprofileFields[15].Descriptor()
+	// backupprofile.DefaultNumericIds holds the default value on creation for the numeric_ids field.
+	backupprofile.DefaultNumericIds = backupprofileDescNumericIds.Default.(bool)
+	// backupprofileDescNoFlags is the schema descriptor for no_flags field.
+	backupprofileDescNoFlags := backupprofileFields[16].Descriptor()
+	// backupprofile.DefaultNoFlags holds the default value on creation for the no_flags field.
+	backupprofile.DefaultNoFlags = backupprofileDescNoFlags.Default.(bool)
+	// backupprofileDescNoAcls is the schema descriptor for no_acls field.
+	backupprofileDescNoAcls := backupprofileFields[17].Descriptor()
+	// backupprofile.DefaultNoAcls holds the default value on creation for the no_acls field.
+	backupprofile.DefaultNoAcls = backupprofileDescNoAcls.Default.(bool)
+	// backupprofileDescNoXattrs is the schema descriptor for no_xattrs field.
+	backupprofileDescNoXattrs := backupprofileFields[18].Descriptor()
+	// backupprofile.DefaultNoXattrs holds the default value on creation for the no_xattrs field.
+	backupprofile.DefaultNoXattrs = backupprofileDescNoXattrs.Default.(bool)
+	// backupprofileDescSparse is the schema descriptor for sparse field.
+	backupprofileDescSparse := backupprofileFields[19].Descriptor()
+	// backupprofile.DefaultSparse holds the default value on creation for the sparse field.
+	backupprofile.DefaultSparse = backupprofileDescSparse.Default.(bool)
+	// backupprofileDescReadSpecial is the schema descriptor for read_special field.
+	backupprofileDescReadSpecial := backupprofileFields[20].Descriptor()
+	// backupprofile.DefaultReadSpecial holds the default value on creation for the read_special field.
+	backupprofile.DefaultReadSpecial = backupprofileDescReadSpecial.Default.(bool)
+	// backupprofileDescCheckpointInterval is the schema descriptor for checkpoint_interval field.
+	backupprofileDescCheckpointInterval := backupprofileFields[21].Descriptor()
+	// backupprofile.CheckpointIntervalValidator is a validator for the "checkpoint_interval" field. It is called by the builders before save.
+	backupprofile.CheckpointIntervalValidator = func() func(int) error {
+		validators := backupprofileDescCheckpointInterval.Validators
+		fns := [...]func(int) error{
+			validators[0].(func(int) error),
+			validators[1].(func(int) error),
+		}
+		return func(checkpoint_interval int) error {
+			for _, fn := range fns {
+				if err := fn(checkpoint_interval); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
 	// backupprofileDescDataSectionCollapsed is the schema descriptor for data_section_collapsed field.
-	backupprofileDescDataSectionCollapsed := backupprofileFields[9].Descriptor()
+	backupprofileDescDataSectionCollapsed := backupprofileFields[22].Descriptor()
 	// backupprofile.DefaultDataSectionCollapsed holds the default value on creation for the data_section_collapsed field.
 	backupprofile.DefaultDataSectionCollapsed = backupprofileDescDataSectionCollapsed.Default.(bool)
 	// backupprofileDescScheduleSectionCollapsed is the schema descriptor for schedule_section_collapsed field.
-	backupprofileDescScheduleSectionCollapsed := backupprofileFields[10].Descriptor()
+	backupprofileDescScheduleSectionCollapsed := backupprofileFields[23].Descriptor()
 	// backupprofile.DefaultScheduleSectionCollapsed holds the default value on creation for the schedule_section_collapsed field.
 	backupprofile.DefaultScheduleSectionCollapsed = backupprofileDescScheduleSectionCollapsed.Default.(bool)
 	// backupprofileDescAdvancedSectionCollapsed is the schema descriptor for advanced_section_collapsed field.
-	backupprofileDescAdvancedSectionCollapsed := backupprofileFields[11].Descriptor()
+	backupprofileDescAdvancedSectionCollapsed := backupprofileFields[24].Descriptor()
 	// backupprofile.DefaultAdvancedSectionCollapsed holds the default value on creation for the advanced_section_collapsed field.
 	backupprofile.DefaultAdvancedSectionCollapsed = backupprofileDescAdvancedSectionCollapsed.Default.(bool)
 	backupscheduleMixin := schema.BackupSchedule{}.Mixin()
@@ -191,6 +299,46 @@ func init() {
 	backupscheduleDescMonthday := backupscheduleFields[6].Descriptor()
 	// backupschedule.MonthdayValidator is a validator for the "monthday" field. It is called by the builders before save.
 	backupschedule.MonthdayValidator = backupscheduleDescMonthday.Validators[0].(func(uint8) error)
+	// backupscheduleDescCronExpressions is the schema descriptor for cron_expressions field.
+	backupscheduleDescCronExpressions := backupscheduleFields[8].Descriptor()
+	// backupschedule.DefaultCronExpressions holds the default value on creation for the cron_expressions field.
+	backupschedule.DefaultCronExpressions = backupscheduleDescCronExpressions.Default.([]string)
+	// backupscheduleDescCatchUpDelayMinutes is the schema descriptor for catch_up_delay_minutes field.
+	backupscheduleDescCatchUpDelayMinutes := backupscheduleFields[10].Descriptor()
+	// backupschedule.DefaultCatchUpDelayMinutes holds the default value on creation for the catch_up_delay_minutes field.
+	backupschedule.DefaultCatchUpDelayMinutes = backupscheduleDescCatchUpDelayMinutes.Default.(uint16)
+	// backupschedule.CatchUpDelayMinutesValidator is a validator for the "catch_up_delay_minutes" field. It is called by the builders before save.
+	backupschedule.CatchUpDelayMinutesValidator = backupscheduleDescCatchUpDelayMinutes.Validators[0].(func(uint16) error)
+	// backupscheduleDescJitterMinutes is the schema descriptor for jitter_minutes field.
+	backupscheduleDescJitterMinutes := backupscheduleFields[11].Descriptor()
+	// backupschedule.DefaultJitterMinutes holds the default value on creation for the jitter_minutes field.
+	backupschedule.DefaultJitterMinutes = backupscheduleDescJitterMinutes.Default.(uint16)
+	// backupschedule.JitterMinutesValidator is a validator for the "jitter_minutes" field. It is called by the builders before save.
+	backupschedule.JitterMinutesValidator = backupscheduleDescJitterMinutes.Validators[0].(func(uint16) error)
+	// backupscheduleDescAllowedHours is the schema descriptor for allowed_hours field.
+	backupscheduleDescAllowedHours := backupscheduleFields[12].Descriptor()
+	// backupschedule.DefaultAllowedHours holds the default value on creation for the allowed_hours field.
+	backupschedule.DefaultAllowedHours = backupscheduleDescAllowedHours.Default.([]types.TimeWindow)
+	// backupscheduleDescBlackoutPeriods is the schema descriptor for blackout_periods field.
+	backupscheduleDescBlackoutPeriods := backupscheduleFields[13].Descriptor()
+	// backupschedule.DefaultBlackoutPeriods holds the default value on creation for the blackout_periods field.
+	backupschedule.DefaultBlackoutPeriods = backupscheduleDescBlackoutPeriods.Default.([]types.TimeWindow)
+	// backupscheduleDescRequireAcPower is the schema descriptor for require_ac_power field.
+	backupscheduleDescRequireAcPower := backupscheduleFields[14].Descriptor()
+	// backupschedule.DefaultRequireAcPower holds the default value on creation for the require_ac_power field.
+	backupschedule.DefaultRequireAcPower = backupscheduleDescRequireAcPower.Default.(bool)
+	// backupscheduleDescRequireUnmeteredNetwork is the schema descriptor for require_unmetered_network field.
+	backupscheduleDescRequireUnmeteredNetwork := backupscheduleFields[15].Descriptor()
+	// backupschedule.DefaultRequireUnmeteredNetwork holds the default value on creation for the require_unmetered_network field.
+	backupschedule.DefaultRequireUnmeteredNetwork = backupscheduleDescRequireUnmeteredNetwork.Default.(bool)
+	// backupscheduleDescRequireReachableRepository is the schema descriptor for require_reachable_repository field.
+	backupscheduleDescRequireReachableRepository := backupscheduleFields[16].Descriptor()
+	// backupschedule.DefaultRequireReachableRepository holds the default value on creation for the require_reachable_repository field.
+	backupschedule.DefaultRequireReachableRepository = backupscheduleDescRequireReachableRepository.Default.(bool)
+	// backupscheduleDescRunOnDriveConnect is the schema descriptor for run_on_drive_connect field.
+	backupscheduleDescRunOnDriveConnect := backupscheduleFields[17].Descriptor()
+	// backupschedule.DefaultRunOnDriveConnect holds the default value on creation for the run_on_drive_connect field.
+	backupschedule.DefaultRunOnDriveConnect = backupscheduleDescRunOnDriveConnect.Default.(bool)
 	cloudrepositoryMixin := schema.CloudRepository{}.Mixin()
 	cloudrepositoryMixinFields0 := cloudrepositoryMixin[0].Fields()
 	_ = cloudrepositoryMixinFields0
@@ -281,30 +429,58 @@ func init() {
 	repositoryDescHasPassword := repositoryFields[3].Descriptor()
 	// repository.DefaultHasPassword holds the default value on creation for the has_password field.
 	repository.DefaultHasPassword = repositoryDescHasPassword.Default.(bool)
+	// repositoryDescQuickCheckIntervalDays is the schema descriptor for quick_check_interval_days field.
+	repositoryDescQuickCheckIntervalDays := repositoryFields[10].Descriptor()
+	// repository.DefaultQuickCheckIntervalDays holds the default value on creation for the quick_check_interval_days field.
+	repository.DefaultQuickCheckIntervalDays = repositoryDescQuickCheckIntervalDays.Default.(int)
+	// repository.QuickCheckIntervalDaysValidator is a validator for the "quick_check_interval_days" field. It is called by the builders before save.
+	repository.QuickCheckIntervalDaysValidator = repositoryDescQuickCheckIntervalDays.Validators[0].(func(int) error)
+	// repositoryDescFullCheckIntervalWeeks is the schema descriptor for full_check_interval_weeks field.
+	repositoryDescFullCheckIntervalWeeks := repositoryFields[11].Descriptor()
+	// repository.DefaultFullCheckIntervalWeeks holds the default value on creation for the full_check_interval_weeks field.
+	repository.DefaultFullCheckIntervalWeeks = repositoryDescFullCheckIntervalWeeks.Default.(int)
+	// repository.FullCheckIntervalWeeksValidator is a validator for the "full_check_interval_weeks" field. It is called by the builders before save.
+	repository.FullCheckIntervalWeeksValidator = repositoryDescFullCheckIntervalWeeks.Validators[0].(func(int) error)
+	// repositoryDescRollingCheckArchives is the schema descriptor for rolling_check_archives field.
+	repositoryDescRollingCheckArchives := repositoryFields[12].Descriptor()
+	// repository.DefaultRollingCheckArchives holds the default value on creation for the rolling_check_archives field.
+	repository.DefaultRollingCheckArchives = repositoryDescRollingCheckArchives.Default.(int)
+	// repository.RollingCheckArchivesValidator is a validator for the "rolling_check_archives" field. It is called by the builders before save.
+	repository.RollingCheckArchivesValidator = repositoryDescRollingCheckArchives.Validators[0].(func(int) error)
 	// repositoryDescStatsTotalChunks is the schema descriptor for stats_total_chunks field.
-	repositoryDescStatsTotalChunks := repositoryFields[8].Descriptor()
+	repositoryDescStatsTotalChunks := repositoryFields[14].Descriptor()
 	// repository.DefaultStatsTotalChunks holds the default value on creation for the stats_total_chunks field.
 	repository.DefaultStatsTotalChunks = repositoryDescStatsTotalChunks.Default.(int)
 	// repositoryDescStatsTotalSize is the schema descriptor for stats_total_size field.
-	repositoryDescStatsTotalSize := repositoryFields[9].Descriptor()
+	repositoryDescStatsTotalSize := repositoryFields[15].Descriptor()
 	// repository.DefaultStatsTotalSize holds the default value on creation for the stats_total_size field.
 	repository.DefaultStatsTotalSize = repositoryDescStatsTotalSize.Default.(int)
 	// repositoryDescStatsTotalCsize is the schema descriptor for stats_total_csize field.
-	repositoryDescStatsTotalCsize := repositoryFields[10].Descriptor()
+	repositoryDescStatsTotalCsize := repositoryFields[16].Descriptor()
 	// repository.DefaultStatsTotalCsize holds the default value on creation for the stats_total_csize field.
 	repository.DefaultStatsTotalCsize = repositoryDescStatsTotalCsize.Default.(int)
 	// repositoryDescStatsTotalUniqueChunks is the schema descriptor for stats_total_unique_chunks field.
-	repositoryDescStatsTotalUniqueChunks := repositoryFields[11].Descriptor()
+	repositoryDescStatsTotalUniqueChunks := repositoryFields[17].Descriptor()
 	// repository.DefaultStatsTotalUniqueChunks holds the default value on creation for the stats_total_unique_chunks field.
 	repository.DefaultStatsTotalUniqueChunks = repositoryDescStatsTotalUniqueChunks.Default.(int)
 	// repositoryDescStatsUniqueSize is the schema descriptor for stats_unique_size field.
-	repositoryDescStatsUniqueSize := repositoryFields[12].Descriptor()
+	repositoryDescStatsUniqueSize := repositoryFields[18].Descriptor()
 	// repository.DefaultStatsUniqueSize holds the default value on creation for the stats_unique_size field.
 	repository.DefaultStatsUniqueSize = repositoryDescStatsUniqueSize.Default.(int)
 	// repositoryDescStatsUniqueCsize is the schema descriptor for stats_unique_csize field.
-	repositoryDescStatsUniqueCsize := repositoryFields[13].Descriptor()
+	repositoryDescStatsUniqueCsize := repositoryFields[19].Descriptor()
 	// repository.DefaultStatsUniqueCsize holds the default value on creation for the stats_unique_csize field.
 	repository.DefaultStatsUniqueCsize = repositoryDescStatsUniqueCsize.Default.(int)
+	// repositoryDescFileIndexEnabled is the schema descriptor for file_index_enabled field.
+	repositoryDescFileIndexEnabled := repositoryFields[20].Descriptor()
+	// repository.DefaultFileIndexEnabled holds the default value on creation for the file_index_enabled field.
+	repository.DefaultFileIndexEnabled = repositoryDescFileIndexEnabled.Default.(bool)
+	repositorystatssnapshotFields := schema.RepositoryStatsSnapshot{}.Fields()
+	_ = repositorystatssnapshotFields
+	// repositorystatssnapshotDescCreatedAt is the schema descriptor for created_at field.
+	repositorystatssnapshotDescCreatedAt := repositorystatssnapshotFields[1].Descriptor()
+	// repositorystatssnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	repositorystatssnapshot.DefaultCreatedAt = repositorystatssnapshotDescCreatedAt.Default.(func() time.Time)
 	settingsMixin := schema.Settings{}.Mixin()
 	settingsMixinFields0 := settingsMixin[0].Fields()
 	_ = settingsMixinFields0
@@ -44,6 +44,40 @@ func (Archive) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Warning message from the backup operation that created this archive"),
+		field.Time("files_indexed_at").
+			StructTag(`json:"filesIndexedAt,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Timestamp when the files of this archive were added to the file index"),
+		field.Int("nfiles").
+			StructTag(`json:"nfiles,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Number of files in the archive (nil if the stats were not fetched yet)"),
+		field.Int("original_size").
+			StructTag(`json:"originalSize,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Uncompressed size of all files in the archive"),
+		field.Int("compressed_size").
+			StructTag(`json:"compressedSize,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Compressed size of all files in the archive"),
+		field.Int("deduplicated_size").
+			StructTag(`json:"deduplicatedSize,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Compressed size of the chunks that only this archive references (space added by this archive)"),
+		field.Int("stats_failures").
+			StructTag(`json:"statsFailures"`).
+			Default(0).
+			Comment("Number of failed attempts to fetch the stats (the stats are not fetched anymore after a few failures)"),
+		field.Time("data_verified_at").
+			StructTag(`json:"dataVerifiedAt,omitempty"`).
+			Optional().
+			Nillable().
+			Comment("Timestamp of the last full check (--verify-data) that covered this archive"),
 	}
 }
 
@@ -58,5 +92,8 @@ func (Archive) Edges() []ent.Edge {
 			Ref("archives").
 			StructTag(`json:"backupProfile,omitempty"`).
 			Unique(),
+		edge.From("files", ArchiveFile.Type).
+			StructTag(`json:"-"`).
+			Ref("archive"),
 	}
 }
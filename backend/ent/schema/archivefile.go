@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArchiveFile holds the schema definition for the ArchiveFile entity.
+// It is an entry of the local file index of an archive.
+// There can be millions of entries, so it has no timestamps.
+type ArchiveFile struct {
+	ent.Schema
+}
+
+// Fields of the ArchiveFile.
+func (ArchiveFile) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			StructTag(`json:"id"`),
+		field.String("path").
+			StructTag(`json:"path"`).
+			Immutable().
+			Comment("Path inside the archive (without leading slash)"),
+		field.Int64("size").
+			StructTag(`json:"size"`).
+			Immutable(),
+		field.Time("mtime").
+			StructTag(`json:"mtime"`).
+			Immutable(),
+	}
+}
+
+// Edges of the ArchiveFile.
+func (ArchiveFile) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("archive", Archive.Type).
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Unique(),
+	}
+}
+
+// Indexes of the ArchiveFile.
+func (ArchiveFile) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("archive"),
+		// Used to look up the versions of a file (see GetFileHistory)
+		index.Fields("path").Edges("archive"),
+	}
+}
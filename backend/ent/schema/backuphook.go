@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/loomi-labs/arco/backend/ent/schema/mixin"
+)
+
+// BackupHook holds the schema definition for the BackupHook entity.
+type BackupHook struct {
+	ent.Schema
+}
+
+func (BackupHook) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimestampMixin{},
+	}
+}
+
+// Fields of the BackupHook.
+func (BackupHook) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			StructTag(`json:"id"`),
+		field.Enum("type").
+			StructTag(`json:"type"`).
+			Values("pre_backup", "post_backup"),
+		field.Enum("run_on").
+			StructTag(`json:"runOn"`).
+			Values("success", "failure", "always").
+			Default("always").
+			Comment("Backup result for which a post-backup hook is run (ignored for pre-backup hooks)"),
+		field.String("command").
+			StructTag(`json:"command"`).
+			NotEmpty().
+			Comment("Shell command executed with sh -c"),
+		field.Int("position").
+			StructTag(`json:"position"`).
+			Default(0).
+			NonNegative().
+			Comment("Hooks of the same type are executed in ascending order"),
+		field.Int("timeout_seconds").
+			StructTag(`json:"timeoutSeconds"`).
+			Default(300).
+			Positive(),
+		field.Bool("abort_on_failure").
+			StructTag(`json:"abortOnFailure"`).
+			Default(false).
+			Comment("Abort the backup if this pre-backup hook fails"),
+
+		// Runtime fields
+		field.Time("last_run_at").
+			StructTag(`json:"lastRunAt"`).
+			Nillable().
+			Optional(),
+		field.Int("last_exit_code").
+			StructTag(`json:"lastExitCode"`).
+			Nillable().
+			Optional(),
+		field.Text("last_output").
+			StructTag(`json:"lastOutput"`).
+			Nillable().
+			Optional().
+			Comment("Combined stdout and stderr of the last run (truncated)"),
+	}
+}
+
+// Edges of the BackupHook.
+func (BackupHook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("backup_profile", BackupProfile.Type).
+			Ref("hooks").
+			StructTag(`json:"backupProfile,omitempty"`).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the BackupHook.
+func (BackupHook) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("type", "position").
+			Edges("backup_profile"),
+	}
+}
@@ -23,8 +23,10 @@ func (BackupProfile) Mixin() []ent.Mixin {
 }
 
 var (
-	ValBackupProfileMinNameLength = 3
-	ValBackupProfileMaxNameLength = 30
+	ValBackupProfileMinNameLength         = 3
+	ValBackupProfileMaxNameLength         = 30
+	ValBackupProfileMinCheckpointInterval = 60    // 1 minute
+	ValBackupProfileMaxCheckpointInterval = 86400 // 1 day
 )
 
 // Fields of the BackupProfile.
@@ -52,10 +54,32 @@ func (BackupProfile) Fields() []ent.Field {
 			StructTag(`json:"excludePaths"`).
 			Optional().
 			Default([]string{}),
+		field.Strings("patterns").
+			StructTag(`json:"patterns"`).
+			Optional().
+			Default([]string{}).
+			Comment("Borg pattern rules (e.g. '- sh:**/node_modules'), evaluated before the exclude paths"),
 		field.Bool("exclude_caches").
 			StructTag(`json:"excludeCaches"`).
 			Default(false).
 			Comment("Exclude directories containing CACHEDIR.TAG file"),
+		field.Strings("exclude_if_present").
+			StructTag(`json:"excludeIfPresent"`).
+			Optional().
+			Default([]string{}).
+			Comment("Exclude directories containing a file with one of these names (e.g. .nobackup)"),
+		field.Bool("use_ignore_files").
+			StructTag(`json:"useIgnoreFiles"`).
+			Default(false).
+			Comment("Honor gitignore-style .arcoignore files found in the backup paths. Every directory of the backup paths is checked for changes before each backup, which takes a while for very large trees"),
+		field.String("content_command").
+			StructTag(`json:"contentCommand"`).
+			Default("").
+			Comment("Command whose output is backed up as a single file (e.g. pg_dump), empty if disabled"),
+		field.String("content_stdin_name").
+			StructTag(`json:"contentStdinName"`).
+			Default("stdin").
+			Comment("Path of the command output in the archive"),
 		field.Enum("icon").
 			StructTag(`json:"icon"`).
 			Values("home", "briefcase", "book", "envelope", "camera", "fire"),
@@ -72,6 +96,43 @@ func (BackupProfile) Fields() []ent.Field {
 			Min(0).
 			Max(22),
 
+		// Advanced borg create options
+		field.Bool("one_file_system").
+			StructTag(`json:"oneFileSystem"`).
+			Default(false).
+			Comment("Stay in the same file system and don't back up mount points (--one-file-system)"),
+		field.Bool("numeric_ids").
+			StructTag(`json:"numericIds"`).
+			Default(false).
+			Comment("Only store numeric user and group identifiers (--numeric-ids)"),
+		field.Bool("no_flags").
+			StructTag(`json:"noFlags"`).
+			Default(false).
+			Comment("Don't store file flags like immutable or nodump (--noflags)"),
+		field.Bool("no_acls").
+			StructTag(`json:"noAcls"`).
+			Default(false).
+			Comment("Don't store access control lists (--noacls)"),
+		field.Bool("no_xattrs").
+			StructTag(`json:"noXattrs"`).
+			Default(false).
+			Comment("Don't store extended attributes (--noxattrs)"),
+		field.Bool("sparse").
+			StructTag(`json:"sparse"`).
+			Default(false).
+			Comment("Detect sparse holes in input files (--sparse)"),
+		field.Bool("read_special").
+			StructTag(`json:"readSpecial"`).
+			Default(false).
+			Comment("Back up the content of device files and FIFOs instead of the special files (--read-special)"),
+		field.Int("checkpoint_interval").
+			StructTag(`json:"checkpointInterval"`).
+			Optional().
+			Nillable().
+			Comment("Seconds between checkpoints of long running backups, borg's default (30 minutes) if not set").
+			Min(ValBackupProfileMinCheckpointInterval).
+			Max(ValBackupProfileMaxCheckpointInterval),
+
 		// UI States
 		field.Bool("data_section_collapsed").
 			StructTag(`json:"dataSectionCollapsed"`).
@@ -100,6 +161,9 @@ func (BackupProfile) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)).
 			StructTag(`json:"pruningRule,omitempty"`).
 			Unique(),
+		edge.To("hooks", BackupHook.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			StructTag(`json:"hooks,omitempty"`),
 		edge.From("notifications", Notification.Type).
 			StructTag(`json:"notifications,omitempty"`).
 			Ref("backup_profile"),
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/loomi-labs/arco/backend/ent/schema/mixin"
+	"github.com/loomi-labs/arco/backend/types"
 )
 
 // BackupSchedule holds the schema definition for the BackupSchedule entity.
@@ -26,7 +27,7 @@ func (BackupSchedule) Fields() []ent.Field {
 			StructTag(`json:"id"`),
 		field.Enum("mode").
 			StructTag(`json:"mode"`).
-			Values("disabled", "minute_interval", "daily", "weekly", "monthly").
+			Values("disabled", "minute_interval", "daily", "weekly", "monthly", "cron").
 			Default("disabled"),
 		field.Uint16("interval_minutes").
 			StructTag(`json:"intervalMinutes"`).
@@ -42,14 +43,79 @@ func (BackupSchedule) Fields() []ent.Field {
 			StructTag(`json:"weeklyAt"`),
 		field.Uint8("monthday").
 			StructTag(`json:"monthday"`).
-			Range(1, 30),
+			Range(1, 31).
+			Comment("Day of the month, the last day of the month is used in shorter months"),
 		field.Time("monthly_at").
 			StructTag(`json:"monthlyAt"`),
+		field.Strings("cron_expressions").
+			StructTag(`json:"cronExpressions"`).
+			Optional().
+			Default([]string{}).
+			Comment("Cron expressions of the cron mode (e.g. '0 12,18 * * mon-fri'), evaluated in local time"),
+
+		// Catch-up fields
+		field.Enum("catch_up_policy").
+			StructTag(`json:"catchUpPolicy"`).
+			Values("immediately", "delayed", "skip").
+			Default("immediately").
+			Comment("What to do with a run that was missed because the computer was asleep or Arco wasn't running"),
+		field.Uint16("catch_up_delay_minutes").
+			StructTag(`json:"catchUpDelayMinutes"`).
+			Range(1, 1440).
+			Default(10).
+			Comment("Minutes to wait before a missed run is caught up with the delayed policy"),
+
+		// Start window fields
+		field.Uint16("jitter_minutes").
+			StructTag(`json:"jitterMinutes"`).
+			Max(720).
+			Default(0).
+			Comment("Random delay of up to this many minutes added to every run, spreads the load of many clients on one server"),
+		field.JSON("allowed_hours", []types.TimeWindow{}).
+			StructTag(`json:"allowedHours"`).
+			Optional().
+			Default([]types.TimeWindow{}).
+			Comment("Backups only start within one of these windows, at any time if empty (local time)"),
+		field.JSON("blackout_periods", []types.TimeWindow{}).
+			StructTag(`json:"blackoutPeriods"`).
+			Optional().
+			Default([]types.TimeWindow{}).
+			Comment("Backups never start during these windows, queued backups are held until the period is over (local time)"),
+
+		// Run condition fields
+		field.Bool("require_ac_power").
+			StructTag(`json:"requireAcPower"`).
+			Default(false).
+			Comment("Scheduled backups wait while the computer runs on battery"),
+		field.Bool("require_unmetered_network").
+			StructTag(`json:"requireUnmeteredNetwork"`).
+			Default(false).
+			Comment("Scheduled backups wait while the network connection is metered"),
+		field.Bool("require_reachable_repository").
+			StructTag(`json:"requireReachableRepository"`).
+			Default(false).
+			Comment("Scheduled backups wait until the host or mount of the repository is reachable"),
+
+		// Drive trigger fields
+		field.Bool("run_on_drive_connect").
+			StructTag(`json:"runOnDriveConnect"`).
+			Default(false).
+			Comment("Run a backup to local repositories when the drive they are on is connected, independent of the mode"),
+		field.Enum("drive_action_after_backup").
+			StructTag(`json:"driveActionAfterBackup"`).
+			Values("keep", "unmount", "eject").
+			Default("keep").
+			Comment("What to do with the drive after the backups that were started by connecting it have finished"),
 
 		// Runtime fields
 		field.Time("next_run").
 			StructTag(`json:"nextRun"`).
 			Optional(),
+		field.Time("catch_up_at").
+			StructTag(`json:"catchUpAt"`).
+			Nillable().
+			Optional().
+			Comment("When the run that is past its next run time is started, set once the run is missed or deferred"),
 		field.Time("last_run").
 			StructTag(`json:"lastRun"`).
 			Nillable().
@@ -41,6 +41,16 @@ func (Repository) Fields() []ent.Field {
 			Default(false).
 			Comment("Whether this repository has a password stored in the keyring"),
 
+		// Volume identity of local repositories on removable drives
+		field.String("volume_uuid").
+			StructTag(`json:"volumeUuid"`).
+			Optional().
+			Comment("Filesystem UUID of the volume the repository was created on, used to recognize its drive when it is connected"),
+		field.String("volume_label").
+			StructTag(`json:"volumeLabel"`).
+			Optional().
+			Comment("Filesystem label of the volume the repository was created on, used if the filesystem has no UUID"),
+
 		// Quick check tracking
 		field.Time("last_quick_check_at").
 			StructTag(`json:"lastQuickCheckAt"`).
@@ -63,6 +73,28 @@ func (Repository) Fields() []ent.Field {
 			Optional().
 			Comment("Error messages from last full check, empty array if successful"),
 
+		// Check schedule
+		field.Int("quick_check_interval_days").
+			StructTag(`json:"quickCheckIntervalDays"`).
+			Default(0).
+			NonNegative().
+			Comment("Days between scheduled quick checks, 0 disables scheduled quick checks"),
+		field.Int("full_check_interval_weeks").
+			StructTag(`json:"fullCheckIntervalWeeks"`).
+			Default(0).
+			NonNegative().
+			Comment("Weeks between scheduled full checks (--verify-data), 0 disables scheduled full checks"),
+		field.Int("rolling_check_archives").
+			StructTag(`json:"rollingCheckArchives"`).
+			Default(0).
+			NonNegative().
+			Comment("Number of archives verified per scheduled full check, 0 verifies all archives at once"),
+		field.Time("check_cycle_started_at").
+			StructTag(`json:"checkCycleStartedAt"`).
+			Nillable().
+			Optional().
+			Comment("Start of the current verification cycle, archives verified before this are checked again"),
+
 		// Stats
 		// Borg repository statistics from cache stats.
 		// "total" metrics = aggregate capacity with reference counts (what would be restored)
@@ -92,6 +124,12 @@ func (Repository) Fields() []ent.Field {
 			Comment("Compressed size of unique chunks only (actual storage consumed on disk)").
 			Default(0).
 			StructTag(`json:"statsUniqueCsize"`),
+
+		// File index
+		field.Bool("file_index_enabled").
+			StructTag(`json:"fileIndexEnabled"`).
+			Default(false).
+			Comment("Whether the files of all archives are indexed locally for searching"),
 	}
 }
 
@@ -106,6 +144,9 @@ func (Repository) Edges() []ent.Edge {
 		edge.From("notifications", Notification.Type).
 			StructTag(`json:"notifications,omitempty"`).
 			Ref("repository"),
+		edge.From("stats_snapshots", RepositoryStatsSnapshot.Type).
+			StructTag(`json:"-"`).
+			Ref("repository"),
 		edge.From("cloud_repository", CloudRepository.Type).
 			StructTag(`json:"cloudRepository,omitempty"`).
 			Ref("repository").
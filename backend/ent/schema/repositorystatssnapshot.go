@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RepositoryStatsSnapshot holds the schema definition for the RepositoryStatsSnapshot entity.
+// It records the repository stats at a point in time (after backups and prunes) to track the growth of a repository.
+type RepositoryStatsSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the RepositoryStatsSnapshot.
+func (RepositoryStatsSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("id").
+			StructTag(`json:"id"`),
+		field.Time("created_at").
+			StructTag(`json:"createdAt"`).
+			Immutable().
+			Default(time.Now),
+		field.Int("total_size").
+			StructTag(`json:"totalSize"`).
+			Immutable().
+			Comment("Total uncompressed size of all chunks multiplied by their reference counts"),
+		field.Int("total_csize").
+			StructTag(`json:"totalCsize"`).
+			Immutable().
+			Comment("Total compressed size of all chunks multiplied by their reference counts"),
+		field.Int("unique_size").
+			StructTag(`json:"uniqueSize"`).
+			Immutable().
+			Comment("Uncompressed size of unique chunks only"),
+		field.Int("unique_csize").
+			StructTag(`json:"uniqueCsize"`).
+			Immutable().
+			Comment("Compressed size of unique chunks only (actual storage consumed on disk)"),
+		field.Int("repository_id").
+			StructTag(`json:"repositoryId"`).
+			StorageKey("repository_stats_snapshot_repository").
+			Immutable(),
+	}
+}
+
+// Edges of the RepositoryStatsSnapshot.
+func (RepositoryStatsSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("repository", Repository.Type).
+			Field("repository_id").
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Unique(),
+	}
+}
+
+// Indexes of the RepositoryStatsSnapshot.
+func (RepositoryStatsSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("repository_id", "created_at").
+			StorageKey("repositorystatssnapshot_repository_created_at"),
+	}
+}
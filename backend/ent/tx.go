@@ -16,8 +16,12 @@ type Tx struct {
 	AnalyticsEvent *AnalyticsEventClient
 	// Archive is the client for interacting with the Archive builders.
 	Archive *ArchiveClient
+	// ArchiveFile is the client for interacting with the ArchiveFile builders.
+	ArchiveFile *ArchiveFileClient
 	// AuthSession is the client for interacting with the AuthSession builders.
 	AuthSession *AuthSessionClient
+	// BackupHook is the client for interacting with the BackupHook builders.
+	BackupHook *BackupHookClient
 	// BackupProfile is the client for interacting with the BackupProfile builders.
 	BackupProfile *BackupProfileClient
 	// BackupSchedule is the client for interacting with the BackupSchedule builders.
@@ -30,6 +34,8 @@ type Tx struct {
 	PruningRule *PruningRuleClient
 	// Repository is the client for interacting with the Repository builders.
 	Repository *RepositoryClient
+	// RepositoryStatsSnapshot is the client for interacting with the RepositoryStatsSnapshot builders.
+	RepositoryStatsSnapshot *RepositoryStatsSnapshotClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
 	// User is the client for interacting with the User builders.
@@ -167,13 +173,16 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AnalyticsEvent = NewAnalyticsEventClient(tx.config)
 	tx.Archive = NewArchiveClient(tx.config)
+	tx.ArchiveFile = NewArchiveFileClient(tx.config)
 	tx.AuthSession = NewAuthSessionClient(tx.config)
+	tx.BackupHook = NewBackupHookClient(tx.config)
 	tx.BackupProfile = NewBackupProfileClient(tx.config)
 	tx.BackupSchedule = NewBackupScheduleClient(tx.config)
 	tx.CloudRepository = NewCloudRepositoryClient(tx.config)
 	tx.Notification = NewNotificationClient(tx.config)
 	tx.PruningRule = NewPruningRuleClient(tx.config)
 	tx.Repository = NewRepositoryClient(tx.config)
+	tx.RepositoryStatsSnapshot = NewRepositoryStatsSnapshotClient(tx.config)
 	tx.Settings = NewSettingsClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
@@ -0,0 +1,15 @@
+package platform
+
+import "context"
+
+// SystemConditions reports the power and network state of the system.
+// It is an interface so that the scheduler can be tested without a system bus.
+type SystemConditions interface {
+	// OnBattery returns true if the system runs on battery
+	OnBattery(ctx context.Context) (bool, error)
+	// Metered returns true if the primary network connection is metered
+	Metered(ctx context.Context) (bool, error)
+	// Watch reports on the returned channel whenever the power source or the network connection changes.
+	// It stops when the context is cancelled.
+	Watch(ctx context.Context) (<-chan struct{}, error)
+}
@@ -0,0 +1,140 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	propertiesInterface        = "org.freedesktop.DBus.Properties"
+	propertiesChangedMember    = "PropertiesChanged"
+	upowerDest                 = "org.freedesktop.UPower"
+	upowerPath                 = dbus.ObjectPath("/org/freedesktop/UPower")
+	upowerOnBattery            = "OnBattery"
+	networkManagerDest         = "org.freedesktop.NetworkManager"
+	networkManagerPath         = dbus.ObjectPath("/org/freedesktop/NetworkManager")
+	networkManagerMetered      = "Metered"
+	networkManagerMeteredYes   = uint32(1) // NM_METERED_YES
+	networkManagerMeteredGuess = uint32(3) // NM_METERED_GUESS_YES
+)
+
+// propertyReader reads a property of an object on the system bus
+type propertyReader interface {
+	GetProperty(dest string, path dbus.ObjectPath, property string) (dbus.Variant, error)
+}
+
+// systemBus reads properties over the shared system bus connection
+type systemBus struct{}
+
+func (systemBus) GetProperty(dest string, path dbus.ObjectPath, property string) (dbus.Variant, error) {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return dbus.Variant{}, fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+	return conn.Object(dest, path).GetProperty(dest + "." + property)
+}
+
+// dbusConditions reads the power source from UPower and the network connection from NetworkManager
+type dbusConditions struct {
+	bus propertyReader
+}
+
+// NewSystemConditions returns the conditions of the system as reported by UPower and NetworkManager
+func NewSystemConditions() SystemConditions {
+	return &dbusConditions{bus: systemBus{}}
+}
+
+func (c *dbusConditions) OnBattery(_ context.Context) (bool, error) {
+	value, err := c.bus.GetProperty(upowerDest, upowerPath, upowerOnBattery)
+	if err != nil {
+		return false, fmt.Errorf("failed to get power source from UPower: %w", err)
+	}
+	onBattery, ok := value.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("unexpected %s value %v from UPower", upowerOnBattery, value)
+	}
+	return onBattery, nil
+}
+
+func (c *dbusConditions) Metered(_ context.Context) (bool, error) {
+	value, err := c.bus.GetProperty(networkManagerDest, networkManagerPath, networkManagerMetered)
+	if err != nil {
+		return false, fmt.Errorf("failed to get network connection from NetworkManager: %w", err)
+	}
+	metered, ok := value.Value().(uint32)
+	if !ok {
+		return false, fmt.Errorf("unexpected %s value %v from NetworkManager", networkManagerMetered, value)
+	}
+	return metered == networkManagerMeteredYes || metered == networkManagerMeteredGuess, nil
+}
+
+func (c *dbusConditions) Watch(ctx context.Context) (<-chan struct{}, error) {
+	// Use a private connection so it can be closed without affecting other users of the shared system bus
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+
+	for _, path := range []dbus.ObjectPath{upowerPath, networkManagerPath} {
+		err = conn.AddMatchSignal(
+			dbus.WithMatchObjectPath(path),
+			dbus.WithMatchInterface(propertiesInterface),
+			dbus.WithMatchMember(propertiesChangedMember),
+		)
+		if err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("failed to subscribe to %s signal of %s: %w", propertiesChangedMember, path, err)
+		}
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+
+	changed := make(chan struct{}, 1)
+	go func() {
+		defer func() {
+			_ = conn.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case signal, ok := <-signals:
+				if !ok {
+					return
+				}
+				if !isConditionChangedSignal(signal) {
+					continue
+				}
+				// Don't block if the previous change has not been handled yet
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return changed, nil
+}
+
+// isConditionChangedSignal returns true if the signal reports a change of the power source or the metered state
+func isConditionChangedSignal(signal *dbus.Signal) bool {
+	if signal == nil || signal.Name != propertiesInterface+"."+propertiesChangedMember || len(signal.Body) < 2 {
+		return false
+	}
+	changed, ok := signal.Body[1].(map[string]dbus.Variant)
+	if !ok {
+		return false
+	}
+	switch signal.Path {
+	case upowerPath:
+		_, ok = changed[upowerOnBattery]
+	case networkManagerPath:
+		_, ok = changed[networkManagerMetered]
+	default:
+		ok = false
+	}
+	return ok
+}
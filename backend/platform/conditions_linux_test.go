@@ -0,0 +1,116 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// fakeBus returns fixed property values instead of reading them from the system bus
+type fakeBus struct {
+	properties map[string]dbus.Variant
+}
+
+func (b fakeBus) GetProperty(_ string, _ dbus.ObjectPath, property string) (dbus.Variant, error) {
+	value, ok := b.properties[property]
+	if !ok {
+		return dbus.Variant{}, errors.New("no such property")
+	}
+	return value, nil
+}
+
+func TestDbusConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		properties    map[string]dbus.Variant
+		wantOnBattery bool
+		wantMetered   bool
+		wantErr       bool
+	}{
+		{
+			name:          "on AC power and unmetered",
+			properties:    map[string]dbus.Variant{"OnBattery": dbus.MakeVariant(false), "Metered": dbus.MakeVariant(uint32(2))},
+			wantOnBattery: false,
+			wantMetered:   false,
+		},
+		{
+			name:          "on battery and metered",
+			properties:    map[string]dbus.Variant{"OnBattery": dbus.MakeVariant(true), "Metered": dbus.MakeVariant(uint32(1))},
+			wantOnBattery: true,
+			wantMetered:   true,
+		},
+		{
+			name:        "guessed metered",
+			properties:  map[string]dbus.Variant{"OnBattery": dbus.MakeVariant(false), "Metered": dbus.MakeVariant(uint32(3))},
+			wantMetered: true,
+		},
+		{
+			name:       "unknown metered state",
+			properties: map[string]dbus.Variant{"OnBattery": dbus.MakeVariant(false), "Metered": dbus.MakeVariant(uint32(0))},
+		},
+		{
+			name:       "services not available",
+			properties: map[string]dbus.Variant{},
+			wantErr:    true,
+		},
+		{
+			name:       "unexpected types",
+			properties: map[string]dbus.Variant{"OnBattery": dbus.MakeVariant("yes"), "Metered": dbus.MakeVariant(true)},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &dbusConditions{bus: fakeBus{properties: tt.properties}}
+
+			onBattery, err := c.OnBattery(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OnBattery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			metered, err := c.Metered(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Metered() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if onBattery != tt.wantOnBattery {
+				t.Errorf("OnBattery() = %v, want %v", onBattery, tt.wantOnBattery)
+			}
+			if metered != tt.wantMetered {
+				t.Errorf("Metered() = %v, want %v", metered, tt.wantMetered)
+			}
+		})
+	}
+}
+
+func TestIsConditionChangedSignal(t *testing.T) {
+	changed := func(path dbus.ObjectPath, property string) *dbus.Signal {
+		return &dbus.Signal{
+			Path: path,
+			Name: "org.freedesktop.DBus.Properties.PropertiesChanged",
+			Body: []interface{}{"iface", map[string]dbus.Variant{property: dbus.MakeVariant(true)}, []string{}},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		signal *dbus.Signal
+		want   bool
+	}{
+		{name: "power source changed", signal: changed("/org/freedesktop/UPower", "OnBattery"), want: true},
+		{name: "metered state changed", signal: changed("/org/freedesktop/NetworkManager", "Metered"), want: true},
+		{name: "other UPower property", signal: changed("/org/freedesktop/UPower", "LidIsClosed"), want: false},
+		{name: "other object", signal: changed("/org/freedesktop/UPower/devices/battery_BAT0", "OnBattery"), want: false},
+		{name: "other signal", signal: &dbus.Signal{Path: "/org/freedesktop/UPower", Name: "org.freedesktop.UPower.DeviceAdded", Body: []interface{}{"iface", map[string]dbus.Variant{}}}, want: false},
+		{name: "nil signal", signal: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isConditionChangedSignal(tt.signal); got != tt.want {
+				t.Errorf("isConditionChangedSignal(%v) = %v, want %v", tt.signal, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !linux
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// unsupportedConditions is used on platforms without UPower and NetworkManager
+type unsupportedConditions struct{}
+
+// NewSystemConditions returns conditions that can't be determined, because they are only supported on Linux
+func NewSystemConditions() SystemConditions {
+	return unsupportedConditions{}
+}
+
+func (unsupportedConditions) OnBattery(_ context.Context) (bool, error) {
+	return false, fmt.Errorf("checking the power source is not supported on %s", runtime.GOOS)
+}
+
+func (unsupportedConditions) Metered(_ context.Context) (bool, error) {
+	return false, fmt.Errorf("checking for metered connections is not supported on %s", runtime.GOOS)
+}
+
+func (unsupportedConditions) Watch(_ context.Context) (<-chan struct{}, error) {
+	return nil, fmt.Errorf("watching the power source and network connection is not supported on %s", runtime.GOOS)
+}
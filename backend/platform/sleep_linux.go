@@ -0,0 +1,70 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	logindManagerInterface = "org.freedesktop.login1.Manager"
+	prepareForSleepMember  = "PrepareForSleep"
+)
+
+// WatchResume reports on the returned channel whenever the system resumes from suspend or hibernation.
+// It listens to the PrepareForSleep signal of systemd-logind on the system bus until the context is cancelled.
+func WatchResume(ctx context.Context) (<-chan struct{}, error) {
+	// Use a private connection so it can be closed without affecting other users of the shared system bus
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+
+	err = conn.AddMatchSignal(
+		dbus.WithMatchInterface(logindManagerInterface),
+		dbus.WithMatchMember(prepareForSleepMember),
+	)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s signal: %w", prepareForSleepMember, err)
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+
+	resumed := make(chan struct{}, 1)
+	go func() {
+		defer func() {
+			_ = conn.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case signal, ok := <-signals:
+				if !ok {
+					return
+				}
+				if !isResumeSignal(signal) {
+					continue
+				}
+				// Don't block if the previous resume has not been handled yet
+				select {
+				case resumed <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}()
+	return resumed, nil
+}
+
+// isResumeSignal returns true for PrepareForSleep(false), which logind sends after the system woke up
+func isResumeSignal(signal *dbus.Signal) bool {
+	if signal == nil || signal.Name != logindManagerInterface+"."+prepareForSleepMember || len(signal.Body) != 1 {
+		return false
+	}
+	start, ok := signal.Body[0].(bool)
+	return ok && !start
+}
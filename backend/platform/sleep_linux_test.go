@@ -0,0 +1,49 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+func TestIsResumeSignal(t *testing.T) {
+	tests := []struct {
+		name   string
+		signal *dbus.Signal
+		want   bool
+	}{
+		{
+			name:   "resume",
+			signal: &dbus.Signal{Name: "org.freedesktop.login1.Manager.PrepareForSleep", Body: []interface{}{false}},
+			want:   true,
+		},
+		{
+			name:   "going to sleep",
+			signal: &dbus.Signal{Name: "org.freedesktop.login1.Manager.PrepareForSleep", Body: []interface{}{true}},
+			want:   false,
+		},
+		{
+			name:   "other signal",
+			signal: &dbus.Signal{Name: "org.freedesktop.login1.Manager.PrepareForShutdown", Body: []interface{}{false}},
+			want:   false,
+		},
+		{
+			name:   "unexpected body",
+			signal: &dbus.Signal{Name: "org.freedesktop.login1.Manager.PrepareForSleep", Body: []interface{}{"false"}},
+			want:   false,
+		},
+		{
+			name:   "nil signal",
+			signal: nil,
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isResumeSignal(tt.signal); got != tt.want {
+				t.Errorf("isResumeSignal(%v) = %v, want %v", tt.signal, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !linux
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// WatchResume is only supported on Linux, where systemd-logind reports when the system resumes from sleep
+func WatchResume(_ context.Context) (<-chan struct{}, error) {
+	return nil, fmt.Errorf("watching for resume from sleep is not supported on %s", runtime.GOOS)
+}
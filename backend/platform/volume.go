@@ -0,0 +1,41 @@
+package platform
+
+import "context"
+
+// Volume identifies a filesystem independent of where it is mounted
+type Volume struct {
+	UUID  string
+	Label string
+}
+
+// IsEmpty returns true if the filesystem has neither a UUID nor a label
+func (v Volume) IsEmpty() bool {
+	return v.UUID == "" && v.Label == ""
+}
+
+// Matches returns true if both volumes are the same filesystem.
+// The UUID is compared if the volume has one, otherwise the label.
+func (v Volume) Matches(other Volume) bool {
+	if v.UUID != "" {
+		return v.UUID == other.UUID
+	}
+	return v.Label != "" && v.Label == other.Label
+}
+
+// MountedVolume is a volume that has just been mounted
+type MountedVolume struct {
+	Volume
+	MountPoint string
+	Device     string // Device object of the volume, used to unmount or eject it
+}
+
+// Drives reports when volumes are mounted and unmounts or ejects them.
+// It is an interface so that the drive trigger can be tested without a system bus.
+type Drives interface {
+	// WatchMounts reports every volume that is mounted until the context is cancelled
+	WatchMounts(ctx context.Context) (<-chan MountedVolume, error)
+	// Unmount unmounts the volume
+	Unmount(ctx context.Context, volume MountedVolume) error
+	// Eject unmounts the volume and powers off or ejects its drive, so it can be removed safely
+	Eject(ctx context.Context, volume MountedVolume) error
+}
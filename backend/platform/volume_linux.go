@@ -0,0 +1,265 @@
+package platform
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const (
+	udisksDest                = "org.freedesktop.UDisks2"
+	udisksBlockDevicesPath    = "/org/freedesktop/UDisks2/block_devices"
+	udisksFilesystemInterface = "org.freedesktop.UDisks2.Filesystem"
+	udisksBlockInterface      = "org.freedesktop.UDisks2.Block"
+	udisksDriveInterface      = "org.freedesktop.UDisks2.Drive"
+	udisksMountPoints         = "MountPoints"
+)
+
+/***********************************/
+/********** Volume Identity ********/
+/***********************************/
+
+// VolumeOf returns the filesystem UUID and label of the volume the given path is on
+func VolumeOf(path string) (Volume, error) {
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		path = resolved
+	}
+
+	mounts, err := os.Open("/proc/self/mounts")
+	if err != nil {
+		return Volume{}, fmt.Errorf("failed to read mounts: %w", err)
+	}
+	defer mounts.Close()
+
+	device, err := mountDevice(mounts, path)
+	if err != nil {
+		return Volume{}, err
+	}
+	return Volume{
+		UUID:  findDiskLink("/dev/disk/by-uuid", device),
+		Label: findDiskLink("/dev/disk/by-label", device),
+	}, nil
+}
+
+// mountDevice returns the device of the mount point that contains the given path
+func mountDevice(mounts io.Reader, path string) (string, error) {
+	var device, mountPoint string
+	scanner := bufio.NewScanner(mounts)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		mp := unescapeMount(fields[1])
+		contained := path == mp || mp == "/" || strings.HasPrefix(path, mp+"/")
+		// The longest mount point wins, later mounts hide earlier ones on the same mount point
+		if contained && len(mp) >= len(mountPoint) {
+			device, mountPoint = fields[0], mp
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read mounts: %w", err)
+	}
+	if !strings.HasPrefix(device, "/dev/") {
+		return "", fmt.Errorf("%s is not on a block device", path)
+	}
+	return device, nil
+}
+
+// findDiskLink returns the name of the link in the given /dev/disk directory that points to the device
+func findDiskLink(dir, device string) string {
+	if resolved, err := filepath.EvalSymlinks(device); err == nil {
+		device = resolved
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, entry := range entries {
+		target, err := filepath.EvalSymlinks(filepath.Join(dir, entry.Name()))
+		if err == nil && target == device {
+			return unescapeUdev(entry.Name())
+		}
+	}
+	return ""
+}
+
+// unescapeMount decodes the octal escapes of /proc/self/mounts (e.g. "\040" for a space)
+func unescapeMount(s string) string {
+	return unescape(s, `\`, 3, 8)
+}
+
+// unescapeUdev decodes the hex escapes of udev link names (e.g. "\x20" for a space)
+func unescapeUdev(s string) string {
+	return unescape(s, `\x`, 2, 16)
+}
+
+func unescape(s, prefix string, digits, base int) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, prefix)
+		if i < 0 || i+len(prefix)+digits > len(s) {
+			b.WriteString(s)
+			return b.String()
+		}
+		code, err := strconv.ParseUint(s[i+len(prefix):i+len(prefix)+digits], base, 8)
+		if err != nil {
+			b.WriteString(s[:i+len(prefix)])
+			s = s[i+len(prefix):]
+			continue
+		}
+		b.WriteString(s[:i])
+		b.WriteByte(byte(code))
+		s = s[i+len(prefix)+digits:]
+	}
+}
+
+/***********************************/
+/********** UDisks2 ****************/
+/***********************************/
+
+// udisksDrives watches and controls drives through UDisks2 on the system bus
+type udisksDrives struct{}
+
+// NewDrives returns drives that are managed by UDisks2
+func NewDrives() Drives {
+	return udisksDrives{}
+}
+
+func (udisksDrives) WatchMounts(ctx context.Context) (<-chan MountedVolume, error) {
+	// Use a private connection so it can be closed without affecting other users of the shared system bus
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+
+	err = conn.AddMatchSignal(
+		dbus.WithMatchPathNamespace(udisksBlockDevicesPath),
+		dbus.WithMatchInterface(propertiesInterface),
+		dbus.WithMatchMember(propertiesChangedMember),
+		dbus.WithMatchArg(0, udisksFilesystemInterface),
+	)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to subscribe to mounts of UDisks2: %w", err)
+	}
+
+	signals := make(chan *dbus.Signal, 10)
+	conn.Signal(signals)
+
+	mounted := make(chan MountedVolume, 10)
+	go func() {
+		defer func() {
+			_ = conn.Close()
+		}()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case signal, ok := <-signals:
+				if !ok {
+					return
+				}
+				mountPoint, ok := mountPointFromSignal(signal)
+				if !ok {
+					continue
+				}
+				volume := MountedVolume{MountPoint: mountPoint, Device: string(signal.Path)}
+				volume.Volume = readVolume(conn.Object(udisksDest, signal.Path))
+				select {
+				case mounted <- volume:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return mounted, nil
+}
+
+// mountPointFromSignal returns the mount point if the signal reports that a filesystem has been mounted
+func mountPointFromSignal(signal *dbus.Signal) (string, bool) {
+	if signal == nil || signal.Name != propertiesInterface+"."+propertiesChangedMember || len(signal.Body) < 2 {
+		return "", false
+	}
+	if iface, ok := signal.Body[0].(string); !ok || iface != udisksFilesystemInterface {
+		return "", false
+	}
+	changed, ok := signal.Body[1].(map[string]dbus.Variant)
+	if !ok {
+		return "", false
+	}
+	value, ok := changed[udisksMountPoints]
+	if !ok {
+		return "", false
+	}
+	mountPoints, ok := value.Value().([][]byte)
+	if !ok || len(mountPoints) == 0 {
+		// No mount points means the filesystem has been unmounted
+		return "", false
+	}
+	// Mount points are null terminated byte strings
+	return strings.TrimRight(string(mountPoints[0]), "\x00"), true
+}
+
+// readVolume reads the UUID and label of a block device, missing values are left empty
+func readVolume(block dbus.BusObject) Volume {
+	var volume Volume
+	if value, err := block.GetProperty(udisksBlockInterface + ".IdUUID"); err == nil {
+		volume.UUID, _ = value.Value().(string)
+	}
+	if value, err := block.GetProperty(udisksBlockInterface + ".IdLabel"); err == nil {
+		volume.Label, _ = value.Value().(string)
+	}
+	return volume
+}
+
+func (udisksDrives) Unmount(ctx context.Context, volume MountedVolume) error {
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+	block := conn.Object(udisksDest, dbus.ObjectPath(volume.Device))
+	err = block.CallWithContext(ctx, udisksFilesystemInterface+".Unmount", 0, map[string]dbus.Variant{}).Err
+	if err != nil {
+		return fmt.Errorf("failed to unmount %s: %w", volume.MountPoint, err)
+	}
+	return nil
+}
+
+func (d udisksDrives) Eject(ctx context.Context, volume MountedVolume) error {
+	if err := d.Unmount(ctx, volume); err != nil {
+		return err
+	}
+
+	conn, err := dbus.SystemBus()
+	if err != nil {
+		return fmt.Errorf("failed to connect to D-Bus system bus: %w", err)
+	}
+	value, err := conn.Object(udisksDest, dbus.ObjectPath(volume.Device)).GetProperty(udisksBlockInterface + ".Drive")
+	if err != nil {
+		return fmt.Errorf("failed to get drive of %s: %w", volume.MountPoint, err)
+	}
+	drivePath, ok := value.Value().(dbus.ObjectPath)
+	if !ok || drivePath == "/" {
+		return fmt.Errorf("%s is not on a drive that can be ejected", volume.MountPoint)
+	}
+	drive := conn.Object(udisksDest, drivePath)
+
+	// Powering off is what file managers do to safely remove USB drives, media like optical discs are ejected
+	method := udisksDriveInterface + ".Eject"
+	if canPowerOff, err := drive.GetProperty(udisksDriveInterface + ".CanPowerOff"); err == nil && canPowerOff.Value() == true {
+		method = udisksDriveInterface + ".PowerOff"
+	}
+	if err := drive.CallWithContext(ctx, method, 0, map[string]dbus.Variant{}).Err; err != nil {
+		return fmt.Errorf("failed to eject %s: %w", volume.MountPoint, err)
+	}
+	return nil
+}
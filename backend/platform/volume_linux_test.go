@@ -0,0 +1,107 @@
+package platform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+)
+
+const testMounts = `/dev/nvme0n1p2 / ext4 rw,relatime 0 0
+proc /proc proc rw,nosuid,nodev,noexec,relatime 0 0
+/dev/nvme0n1p1 /boot vfat rw,relatime 0 0
+tmpfs /run tmpfs rw,nosuid,nodev 0 0
+/dev/sdb1 /run/media/user/Backup\040Drive exfat rw,nosuid,nodev 0 0
+`
+
+func TestMountDevice(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{name: "root filesystem", path: "/home/user/backups", want: "/dev/nvme0n1p2"},
+		{name: "nested mount", path: "/boot/efi", want: "/dev/nvme0n1p1"},
+		{name: "mount point with space", path: "/run/media/user/Backup Drive/borg", want: "/dev/sdb1"},
+		{name: "similar prefix is not the mount", path: "/bootloader", want: "/dev/nvme0n1p2"},
+		{name: "not on a block device", path: "/run/user/1000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mountDevice(strings.NewReader(testMounts), tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("mountDevice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("mountDevice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	if got := unescapeMount(`/media/My\040Disk\134x`); got != `/media/My Disk\x` {
+		t.Errorf("unescapeMount() = %q", got)
+	}
+	if got := unescapeUdev(`My\x20Disk\x2fA`); got != "My Disk/A" {
+		t.Errorf("unescapeUdev() = %q", got)
+	}
+	if got := unescapeUdev(`trailing\x2`); got != `trailing\x2` {
+		t.Errorf("unescapeUdev() = %q", got)
+	}
+}
+
+func TestMountPointFromSignal(t *testing.T) {
+	changed := func(iface string, mountPoints [][]byte) *dbus.Signal {
+		return &dbus.Signal{
+			Path: "/org/freedesktop/UDisks2/block_devices/sdb1",
+			Name: "org.freedesktop.DBus.Properties.PropertiesChanged",
+			Body: []interface{}{iface, map[string]dbus.Variant{"MountPoints": dbus.MakeVariant(mountPoints)}, []string{}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		signal    *dbus.Signal
+		want      string
+		wantMount bool
+	}{
+		{name: "mounted", signal: changed("org.freedesktop.UDisks2.Filesystem", [][]byte{[]byte("/run/media/user/Backup\x00")}), want: "/run/media/user/Backup", wantMount: true},
+		{name: "unmounted", signal: changed("org.freedesktop.UDisks2.Filesystem", [][]byte{}), wantMount: false},
+		{name: "other interface", signal: changed("org.freedesktop.UDisks2.Block", [][]byte{[]byte("/mnt\x00")}), wantMount: false},
+		{name: "nil signal", signal: nil, wantMount: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := mountPointFromSignal(tt.signal)
+			if ok != tt.wantMount || got != tt.want {
+				t.Errorf("mountPointFromSignal() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantMount)
+			}
+		})
+	}
+}
+
+func TestVolumeMatches(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     Volume
+		b     Volume
+		match bool
+	}{
+		{name: "same UUID", a: Volume{UUID: "1234-ABCD", Label: "Backup"}, b: Volume{UUID: "1234-ABCD", Label: "Renamed"}, match: true},
+		{name: "different UUID with same label", a: Volume{UUID: "1234-ABCD", Label: "Backup"}, b: Volume{UUID: "5678-EF01", Label: "Backup"}, match: false},
+		{name: "label without UUID", a: Volume{Label: "Backup"}, b: Volume{UUID: "5678-EF01", Label: "Backup"}, match: true},
+		{name: "empty volume", a: Volume{}, b: Volume{}, match: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Matches(tt.b); got != tt.match {
+				t.Errorf("Matches() = %v, want %v", got, tt.match)
+			}
+		})
+	}
+}
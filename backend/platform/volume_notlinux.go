@@ -0,0 +1,34 @@
+//go:build !linux
+
+package platform
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+)
+
+// VolumeOf is only supported on Linux, where the filesystems are identified through /dev/disk
+func VolumeOf(_ string) (Volume, error) {
+	return Volume{}, fmt.Errorf("identifying volumes is not supported on %s", runtime.GOOS)
+}
+
+// unsupportedDrives is used on platforms without UDisks2
+type unsupportedDrives struct{}
+
+// NewDrives returns drives that can't be watched, because UDisks2 is only available on Linux
+func NewDrives() Drives {
+	return unsupportedDrives{}
+}
+
+func (unsupportedDrives) WatchMounts(_ context.Context) (<-chan MountedVolume, error) {
+	return nil, fmt.Errorf("watching for connected drives is not supported on %s", runtime.GOOS)
+}
+
+func (unsupportedDrives) Unmount(_ context.Context, _ MountedVolume) error {
+	return fmt.Errorf("unmounting drives is not supported on %s", runtime.GOOS)
+}
+
+func (unsupportedDrives) Eject(_ context.Context, _ MountedVolume) error {
+	return fmt.Errorf("ejecting drives is not supported on %s", runtime.GOOS)
+}
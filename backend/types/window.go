@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeWindow is a recurring period of the day like from "22:00" to "06:00" on weekdays.
+// A window that ends before it starts ends on the next day, a window that ends when it starts lasts 24 hours.
+type TimeWindow struct {
+	Start    string         `json:"start"`    // Start time as "15:04"
+	End      string         `json:"end"`      // End time as "15:04"
+	Weekdays []time.Weekday `json:"weekdays"` // Days on which the window starts, every day if empty
+}
+
+// Validate checks the times and weekdays of the window
+func (w TimeWindow) Validate() error {
+	if _, _, err := ParseClock(w.Start); err != nil {
+		return fmt.Errorf("start of time window: %w", err)
+	}
+	if _, _, err := ParseClock(w.End); err != nil {
+		return fmt.Errorf("end of time window: %w", err)
+	}
+	for _, weekday := range w.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return fmt.Errorf("invalid weekday %d in time window", weekday)
+		}
+	}
+	return nil
+}
+
+// ParseClock parses a time of the day like "15:04" and returns its hour and minute
+func ParseClock(s string) (int, int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
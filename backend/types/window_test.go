@@ -0,0 +1,43 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+TEST CASES - window.go
+
+TestTimeWindowValidate
+* Valid window
+* Reject invalid start
+* Reject invalid end
+* Reject invalid weekday
+
+*/
+
+func TestTimeWindowValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  TimeWindow
+		wantErr bool
+	}{
+		{name: "Valid window", window: TimeWindow{Start: "22:00", End: "06:00", Weekdays: []time.Weekday{time.Monday, time.Friday}}},
+		{name: "Reject invalid start", window: TimeWindow{Start: "24:00", End: "06:00"}, wantErr: true},
+		{name: "Reject invalid end", window: TimeWindow{Start: "22:00", End: "6"}, wantErr: true},
+		{name: "Reject invalid weekday", window: TimeWindow{Start: "22:00", End: "06:00", Weekdays: []time.Weekday{7}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.window.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
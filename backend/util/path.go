@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 )
 
 // ExpandPath expands a path that starts with ~ to the user's home directory
@@ -18,3 +19,27 @@ func ExpandPath(path string) string {
 	home = strings.TrimRight(home, string(os.PathSeparator))
 	return filepath.Join(home, path[1:])
 }
+
+// DoesPathExist returns true if the (expanded) path exists
+func DoesPathExist(path string) bool {
+	_, err := os.Stat(ExpandPath(path))
+	return err == nil
+}
+
+// IsDirectory returns true if the (expanded) path exists and is a directory
+func IsDirectory(path string) bool {
+	info, err := os.Stat(ExpandPath(path))
+	if err != nil {
+		return false
+	}
+	return info.IsDir()
+}
+
+// FreeSpace returns the number of bytes available to unprivileged users on the file system of the (expanded) path
+func FreeSpace(path string) (int64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(ExpandPath(path), &stat); err != nil {
+		return 0, err
+	}
+	return int64(stat.Bavail) * int64(stat.Bsize), nil
+}
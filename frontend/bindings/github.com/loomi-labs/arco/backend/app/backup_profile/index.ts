@@ -7,13 +7,19 @@ export {
 };
 
 export {
+    BackupHook,
+    BackupPreview,
     BackupProfile,
     BackupProfileFilter,
     BackupSchedule,
     GetPruningOptionsResponse,
+    MatchedExclude,
+    PathEvaluation,
+    PreviewPath,
     PruningOption,
     PruningOptionName,
     PruningRule,
     RepositorySummary,
+    ScheduleValidation,
     SelectDirectoryData
 } from "./models.js";
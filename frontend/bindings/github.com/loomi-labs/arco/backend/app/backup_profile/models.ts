@@ -10,10 +10,148 @@ import { Create as $Create } from "@wailsio/runtime";
 import * as types$0 from "../types/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
+import * as backuphook$0 from "../../ent/backuphook/models.js";
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
 import * as backupprofile$0 from "../../ent/backupprofile/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
 import * as backupschedule$0 from "../../ent/backupschedule/models.js";
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as types$1 from "../../types/models.js";
+
+/**
+ * BackupHook is a standalone view of ent.BackupHook without back-edges
+ */
+export class BackupHook {
+    "id": number;
+    "createdAt": string;
+    "updatedAt": string;
+    "type": backuphook$0.Type;
+    "runOn": backuphook$0.RunOn;
+    "command": string;
+    "position": number;
+    "timeoutSeconds": number;
+    "abortOnFailure": boolean;
+    "lastRunAt": string | null;
+    "lastExitCode": number | null;
+    "lastOutput": string | null;
+
+    /** Creates a new BackupHook instance. */
+    constructor($$source: Partial<BackupHook> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("createdAt" in $$source)) {
+            this["createdAt"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("updatedAt" in $$source)) {
+            this["updatedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("type" in $$source)) {
+            this["type"] = backuphook$0.Type.$zero;
+        }
+        if (!("runOn" in $$source)) {
+            this["runOn"] = backuphook$0.RunOn.$zero;
+        }
+        if (!("command" in $$source)) {
+            this["command"] = "";
+        }
+        if (!("position" in $$source)) {
+            this["position"] = 0;
+        }
+        if (!("timeoutSeconds" in $$source)) {
+            this["timeoutSeconds"] = 0;
+        }
+        if (!("abortOnFailure" in $$source)) {
+            this["abortOnFailure"] = false;
+        }
+        if (!("lastRunAt" in $$source)) {
+            this["lastRunAt"] = null;
+        }
+        if (!("lastExitCode" in $$source)) {
+            this["lastExitCode"] = null;
+        }
+        if (!("lastOutput" in $$source)) {
+            this["lastOutput"] = null;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BackupHook instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BackupHook {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new BackupHook($$parsedSource as Partial<BackupHook>);
+    }
+}
+
+/**
+ * BackupPreview is the result of a dry-run of a backup profile
+ */
+export class BackupPreview {
+    "totalFiles": number;
+
+    /**
+     * Size of the files before compression and deduplication
+     */
+    "estimatedBytes": number;
+    "excludedPaths": number;
+
+    /**
+     * Only directories up to three levels below a backup path
+     */
+    "largestDirectories": PreviewPath[];
+    "largestFiles": PreviewPath[];
+    "matchedExcludes": MatchedExclude[];
+
+    /** Creates a new BackupPreview instance. */
+    constructor($$source: Partial<BackupPreview> = {}) {
+        if (!("totalFiles" in $$source)) {
+            this["totalFiles"] = 0;
+        }
+        if (!("estimatedBytes" in $$source)) {
+            this["estimatedBytes"] = 0;
+        }
+        if (!("excludedPaths" in $$source)) {
+            this["excludedPaths"] = 0;
+        }
+        if (!("largestDirectories" in $$source)) {
+            this["largestDirectories"] = [];
+        }
+        if (!("largestFiles" in $$source)) {
+            this["largestFiles"] = [];
+        }
+        if (!("matchedExcludes" in $$source)) {
+            this["matchedExcludes"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BackupPreview instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BackupPreview {
+        const $$createField3_0 = $$createType1;
+        const $$createField4_0 = $$createType1;
+        const $$createField5_0 = $$createType3;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("largestDirectories" in $$parsedSource) {
+            $$parsedSource["largestDirectories"] = $$createField3_0($$parsedSource["largestDirectories"]);
+        }
+        if ("largestFiles" in $$parsedSource) {
+            $$parsedSource["largestFiles"] = $$createField4_0($$parsedSource["largestFiles"]);
+        }
+        if ("matchedExcludes" in $$parsedSource) {
+            $$parsedSource["matchedExcludes"] = $$createField5_0($$parsedSource["matchedExcludes"]);
+        }
+        return new BackupPreview($$parsedSource as Partial<BackupPreview>);
+    }
+}
 
 /**
  * BackupProfile is a flattened view of ent.BackupProfile with edges as direct properties.
@@ -26,10 +164,23 @@ export class BackupProfile {
     "prefix": string;
     "backupPaths": string[];
     "excludePaths": string[];
+    "patterns": string[];
     "excludeCaches": boolean;
+    "excludeIfPresent": string[];
+    "useIgnoreFiles": boolean;
+    "contentCommand": string;
+    "contentStdinName": string;
     "icon": backupprofile$0.Icon;
     "compressionMode": backupprofile$0.CompressionMode;
     "compressionLevel": number | null;
+    "oneFileSystem": boolean;
+    "numericIds": boolean;
+    "noFlags": boolean;
+    "noAcls": boolean;
+    "noXattrs": boolean;
+    "sparse": boolean;
+    "readSpecial": boolean;
+    "checkpointInterval": number | null;
     "dataSectionCollapsed": boolean;
     "scheduleSectionCollapsed": boolean;
     "advancedSectionCollapsed": boolean;
@@ -40,6 +191,7 @@ export class BackupProfile {
     "repositories": RepositorySummary[];
     "backupSchedule": BackupSchedule | null;
     "pruningRule": PruningRule | null;
+    "hooks": BackupHook[];
 
     /**
      * Computed fields
@@ -71,9 +223,24 @@ export class BackupProfile {
         if (!("excludePaths" in $$source)) {
             this["excludePaths"] = [];
         }
+        if (!("patterns" in $$source)) {
+            this["patterns"] = [];
+        }
         if (!("excludeCaches" in $$source)) {
             this["excludeCaches"] = false;
         }
+        if (!("excludeIfPresent" in $$source)) {
+            this["excludeIfPresent"] = [];
+        }
+        if (!("useIgnoreFiles" in $$source)) {
+            this["useIgnoreFiles"] = false;
+        }
+        if (!("contentCommand" in $$source)) {
+            this["contentCommand"] = "";
+        }
+        if (!("contentStdinName" in $$source)) {
+            this["contentStdinName"] = "";
+        }
         if (!("icon" in $$source)) {
             this["icon"] = backupprofile$0.Icon.$zero;
         }
@@ -83,6 +250,30 @@ export class BackupProfile {
         if (!("compressionLevel" in $$source)) {
             this["compressionLevel"] = null;
         }
+        if (!("oneFileSystem" in $$source)) {
+            this["oneFileSystem"] = false;
+        }
+        if (!("numericIds" in $$source)) {
+            this["numericIds"] = false;
+        }
+        if (!("noFlags" in $$source)) {
+            this["noFlags"] = false;
+        }
+        if (!("noAcls" in $$source)) {
+            this["noAcls"] = false;
+        }
+        if (!("noXattrs" in $$source)) {
+            this["noXattrs"] = false;
+        }
+        if (!("sparse" in $$source)) {
+            this["sparse"] = false;
+        }
+        if (!("readSpecial" in $$source)) {
+            this["readSpecial"] = false;
+        }
+        if (!("checkpointInterval" in $$source)) {
+            this["checkpointInterval"] = null;
+        }
         if (!("dataSectionCollapsed" in $$source)) {
             this["dataSectionCollapsed"] = false;
         }
@@ -101,6 +292,9 @@ export class BackupProfile {
         if (!("pruningRule" in $$source)) {
             this["pruningRule"] = null;
         }
+        if (!("hooks" in $$source)) {
+            this["hooks"] = [];
+        }
         if (!("archiveCount" in $$source)) {
             this["archiveCount"] = 0;
         }
@@ -112,13 +306,16 @@ export class BackupProfile {
      * Creates a new BackupProfile instance from a string or object.
      */
     static createFrom($$source: any = {}): BackupProfile {
-        const $$createField5_0 = $$createType0;
-        const $$createField6_0 = $$createType0;
-        const $$createField14_0 = $$createType2;
-        const $$createField15_0 = $$createType4;
-        const $$createField16_0 = $$createType6;
-        const $$createField18_0 = $$createType8;
-        const $$createField19_0 = $$createType10;
+        const $$createField5_0 = $$createType4;
+        const $$createField6_0 = $$createType4;
+        const $$createField7_0 = $$createType4;
+        const $$createField9_0 = $$createType4;
+        const $$createField27_0 = $$createType6;
+        const $$createField28_0 = $$createType8;
+        const $$createField29_0 = $$createType10;
+        const $$createField30_0 = $$createType12;
+        const $$createField32_0 = $$createType14;
+        const $$createField33_0 = $$createType16;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backupPaths" in $$parsedSource) {
             $$parsedSource["backupPaths"] = $$createField5_0($$parsedSource["backupPaths"]);
@@ -126,20 +323,29 @@ export class BackupProfile {
         if ("excludePaths" in $$parsedSource) {
             $$parsedSource["excludePaths"] = $$createField6_0($$parsedSource["excludePaths"]);
         }
+        if ("patterns" in $$parsedSource) {
+            $$parsedSource["patterns"] = $$createField7_0($$parsedSource["patterns"]);
+        }
+        if ("excludeIfPresent" in $$parsedSource) {
+            $$parsedSource["excludeIfPresent"] = $$createField9_0($$parsedSource["excludeIfPresent"]);
+        }
         if ("repositories" in $$parsedSource) {
-            $$parsedSource["repositories"] = $$createField14_0($$parsedSource["repositories"]);
+            $$parsedSource["repositories"] = $$createField27_0($$parsedSource["repositories"]);
         }
         if ("backupSchedule" in $$parsedSource) {
-            $$parsedSource["backupSchedule"] = $$createField15_0($$parsedSource["backupSchedule"]);
+            $$parsedSource["backupSchedule"] = $$createField28_0($$parsedSource["backupSchedule"]);
         }
         if ("pruningRule" in $$parsedSource) {
-            $$parsedSource["pruningRule"] = $$createField16_0($$parsedSource["pruningRule"]);
+            $$parsedSource["pruningRule"] = $$createField29_0($$parsedSource["pruningRule"]);
+        }
+        if ("hooks" in $$parsedSource) {
+            $$parsedSource["hooks"] = $$createField30_0($$parsedSource["hooks"]);
         }
         if ("lastBackup" in $$parsedSource) {
-            $$parsedSource["lastBackup"] = $$createField18_0($$parsedSource["lastBackup"]);
+            $$parsedSource["lastBackup"] = $$createField32_0($$parsedSource["lastBackup"]);
         }
         if ("lastAttempt" in $$parsedSource) {
-            $$parsedSource["lastAttempt"] = $$createField19_0($$parsedSource["lastAttempt"]);
+            $$parsedSource["lastAttempt"] = $$createField33_0($$parsedSource["lastAttempt"]);
         }
         return new BackupProfile($$parsedSource as Partial<BackupProfile>);
     }
@@ -189,6 +395,17 @@ export class BackupSchedule {
     "weeklyAt": string;
     "monthday": number;
     "monthlyAt": string;
+    "cronExpressions": string[];
+    "catchUpPolicy": backupschedule$0.CatchUpPolicy;
+    "catchUpDelayMinutes": number;
+    "jitterMinutes": number;
+    "allowedHours": types$1.TimeWindow[];
+    "blackoutPeriods": types$1.TimeWindow[];
+    "requireAcPower": boolean;
+    "requireUnmeteredNetwork": boolean;
+    "requireReachableRepository": boolean;
+    "runOnDriveConnect": boolean;
+    "driveActionAfterBackup": backupschedule$0.DriveActionAfterBackup;
     "nextRun": string;
     "lastRun": string | null;
     "lastRunStatus": string | null;
@@ -225,6 +442,39 @@ export class BackupSchedule {
         if (!("monthlyAt" in $$source)) {
             this["monthlyAt"] = "0001-01-01T00:00:00.000Z";
         }
+        if (!("cronExpressions" in $$source)) {
+            this["cronExpressions"] = [];
+        }
+        if (!("catchUpPolicy" in $$source)) {
+            this["catchUpPolicy"] = backupschedule$0.CatchUpPolicy.$zero;
+        }
+        if (!("catchUpDelayMinutes" in $$source)) {
+            this["catchUpDelayMinutes"] = 0;
+        }
+        if (!("jitterMinutes" in $$source)) {
+            this["jitterMinutes"] = 0;
+        }
+        if (!("allowedHours" in $$source)) {
+            this["allowedHours"] = [];
+        }
+        if (!("blackoutPeriods" in $$source)) {
+            this["blackoutPeriods"] = [];
+        }
+        if (!("requireAcPower" in $$source)) {
+            this["requireAcPower"] = false;
+        }
+        if (!("requireUnmeteredNetwork" in $$source)) {
+            this["requireUnmeteredNetwork"] = false;
+        }
+        if (!("requireReachableRepository" in $$source)) {
+            this["requireReachableRepository"] = false;
+        }
+        if (!("runOnDriveConnect" in $$source)) {
+            this["runOnDriveConnect"] = false;
+        }
+        if (!("driveActionAfterBackup" in $$source)) {
+            this["driveActionAfterBackup"] = backupschedule$0.DriveActionAfterBackup.$zero;
+        }
         if (!("nextRun" in $$source)) {
             this["nextRun"] = "0001-01-01T00:00:00.000Z";
         }
@@ -242,7 +492,19 @@ export class BackupSchedule {
      * Creates a new BackupSchedule instance from a string or object.
      */
     static createFrom($$source: any = {}): BackupSchedule {
+        const $$createField10_0 = $$createType4;
+        const $$createField14_0 = $$createType18;
+        const $$createField15_0 = $$createType18;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("cronExpressions" in $$parsedSource) {
+            $$parsedSource["cronExpressions"] = $$createField10_0($$parsedSource["cronExpressions"]);
+        }
+        if ("allowedHours" in $$parsedSource) {
+            $$parsedSource["allowedHours"] = $$createField14_0($$parsedSource["allowedHours"]);
+        }
+        if ("blackoutPeriods" in $$parsedSource) {
+            $$parsedSource["blackoutPeriods"] = $$createField15_0($$parsedSource["blackoutPeriods"]);
+        }
         return new BackupSchedule($$parsedSource as Partial<BackupSchedule>);
     }
 }
@@ -263,7 +525,7 @@ export class GetPruningOptionsResponse {
      * Creates a new GetPruningOptionsResponse instance from a string or object.
      */
     static createFrom($$source: any = {}): GetPruningOptionsResponse {
-        const $$createField0_0 = $$createType12;
+        const $$createField0_0 = $$createType20;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("options" in $$parsedSource) {
             $$parsedSource["options"] = $$createField0_0($$parsedSource["options"]);
@@ -272,6 +534,114 @@ export class GetPruningOptionsResponse {
     }
 }
 
+/**
+ * MatchedExclude is a rule that excluded paths during a backup preview
+ */
+export class MatchedExclude {
+    "rule": string;
+    "count": number;
+    "examplePath": string;
+
+    /** Creates a new MatchedExclude instance. */
+    constructor($$source: Partial<MatchedExclude> = {}) {
+        if (!("rule" in $$source)) {
+            this["rule"] = "";
+        }
+        if (!("count" in $$source)) {
+            this["count"] = 0;
+        }
+        if (!("examplePath" in $$source)) {
+            this["examplePath"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new MatchedExclude instance from a string or object.
+     */
+    static createFrom($$source: any = {}): MatchedExclude {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new MatchedExclude($$parsedSource as Partial<MatchedExclude>);
+    }
+}
+
+/**
+ * PathEvaluation tells whether a path would be part of a backup and why
+ */
+export class PathEvaluation {
+    "path": string;
+    "included": boolean;
+
+    /**
+     * Rule that decided the result, empty if no rule matched
+     */
+    "rule": string;
+
+    /**
+     * Path matched by the rule, a parent directory if it was excluded with "!"
+     */
+    "rulePath": string;
+    "reason": string;
+
+    /** Creates a new PathEvaluation instance. */
+    constructor($$source: Partial<PathEvaluation> = {}) {
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("included" in $$source)) {
+            this["included"] = false;
+        }
+        if (!("rule" in $$source)) {
+            this["rule"] = "";
+        }
+        if (!("rulePath" in $$source)) {
+            this["rulePath"] = "";
+        }
+        if (!("reason" in $$source)) {
+            this["reason"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PathEvaluation instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PathEvaluation {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new PathEvaluation($$parsedSource as Partial<PathEvaluation>);
+    }
+}
+
+/**
+ * PreviewPath is a file or directory of a backup preview with its (cumulative) size
+ */
+export class PreviewPath {
+    "path": string;
+    "size": number;
+
+    /** Creates a new PreviewPath instance. */
+    constructor($$source: Partial<PreviewPath> = {}) {
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PreviewPath instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PreviewPath {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new PreviewPath($$parsedSource as Partial<PreviewPath>);
+    }
+}
+
 export class PruningOption {
     "name": PruningOptionName;
     "keepHourly": number;
@@ -425,6 +795,45 @@ export class RepositorySummary {
     }
 }
 
+/**
+ * ScheduleValidation is the result of validating a backup schedule
+ */
+export class ScheduleValidation {
+    /**
+     * Empty if the schedule is valid
+     */
+    "error": string;
+
+    /**
+     * Next run times of a valid schedule
+     */
+    "nextRuns": string[];
+
+    /** Creates a new ScheduleValidation instance. */
+    constructor($$source: Partial<ScheduleValidation> = {}) {
+        if (!("error" in $$source)) {
+            this["error"] = "";
+        }
+        if (!("nextRuns" in $$source)) {
+            this["nextRuns"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ScheduleValidation instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ScheduleValidation {
+        const $$createField1_0 = $$createType21;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("nextRuns" in $$parsedSource) {
+            $$parsedSource["nextRuns"] = $$createField1_0($$parsedSource["nextRuns"]);
+        }
+        return new ScheduleValidation($$parsedSource as Partial<ScheduleValidation>);
+    }
+}
+
 export class SelectDirectoryData {
     "title": string;
     "message": string;
@@ -455,16 +864,25 @@ export class SelectDirectoryData {
 }
 
 // Private type creation functions
-const $$createType0 = $Create.Array($Create.Any);
-const $$createType1 = RepositorySummary.createFrom;
-const $$createType2 = $Create.Array($$createType1);
-const $$createType3 = BackupSchedule.createFrom;
-const $$createType4 = $Create.Nullable($$createType3);
-const $$createType5 = PruningRule.createFrom;
-const $$createType6 = $Create.Nullable($$createType5);
-const $$createType7 = types$0.LastBackup.createFrom;
+const $$createType0 = PreviewPath.createFrom;
+const $$createType1 = $Create.Array($$createType0);
+const $$createType2 = MatchedExclude.createFrom;
+const $$createType3 = $Create.Array($$createType2);
+const $$createType4 = $Create.Array($Create.Any);
+const $$createType5 = RepositorySummary.createFrom;
+const $$createType6 = $Create.Array($$createType5);
+const $$createType7 = BackupSchedule.createFrom;
 const $$createType8 = $Create.Nullable($$createType7);
-const $$createType9 = types$0.LastAttempt.createFrom;
+const $$createType9 = PruningRule.createFrom;
 const $$createType10 = $Create.Nullable($$createType9);
-const $$createType11 = PruningOption.createFrom;
+const $$createType11 = BackupHook.createFrom;
 const $$createType12 = $Create.Array($$createType11);
+const $$createType13 = types$0.LastBackup.createFrom;
+const $$createType14 = $Create.Nullable($$createType13);
+const $$createType15 = types$0.LastAttempt.createFrom;
+const $$createType16 = $Create.Nullable($$createType15);
+const $$createType17 = types$1.TimeWindow.createFrom;
+const $$createType18 = $Create.Array($$createType17);
+const $$createType19 = PruningOption.createFrom;
+const $$createType20 = $Create.Array($$createType19);
+const $$createType21 = $Create.Array($Create.Any);
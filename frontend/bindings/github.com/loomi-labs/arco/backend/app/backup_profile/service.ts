@@ -39,6 +39,16 @@ export function DoesPathExist(path: string): $CancellablePromise<boolean> {
     return $Call.ByID(994840831, path);
 }
 
+/**
+ * EvaluatePath evaluates a path against the backup paths, pattern rules, exclude paths, ignore files and marker files
+ * of a backup profile. The profile does not have to be saved, so rules can be tested while they are edited.
+ */
+export function EvaluatePath(backup: $models.BackupProfile, p: string): $CancellablePromise<$models.PathEvaluation | null> {
+    return $Call.ByID(3283957614, backup, p).then(($result: any) => {
+        return $$createType3($result);
+    });
+}
+
 export function GetBackupProfile(id: number): $CancellablePromise<$models.BackupProfile | null> {
     return $Call.ByID(3013370265, id).then(($result: any) => {
         return $$createType1($result);
@@ -47,19 +57,19 @@ export function GetBackupProfile(id: number): $CancellablePromise<$models.Backup
 
 export function GetBackupProfileFilterOptions(repoId: number): $CancellablePromise<$models.BackupProfileFilter[]> {
     return $Call.ByID(3072685743, repoId).then(($result: any) => {
-        return $$createType3($result);
+        return $$createType5($result);
     });
 }
 
 export function GetBackupProfiles(): $CancellablePromise<($models.BackupProfile | null)[]> {
     return $Call.ByID(2838373214).then(($result: any) => {
-        return $$createType4($result);
+        return $$createType6($result);
     });
 }
 
 export function GetDirectorySuggestions(): $CancellablePromise<string[]> {
     return $Call.ByID(2763193742).then(($result: any) => {
-        return $$createType5($result);
+        return $$createType7($result);
     });
 }
 
@@ -69,7 +79,7 @@ export function GetPrefixSuggestion(name: string): $CancellablePromise<string> {
 
 export function GetPruningOptions(): $CancellablePromise<$models.GetPruningOptionsResponse> {
     return $Call.ByID(3242338265).then(($result: any) => {
-        return $$createType6($result);
+        return $$createType8($result);
     });
 }
 
@@ -87,6 +97,16 @@ export function NewBackupProfile(): $CancellablePromise<$models.BackupProfile |
     });
 }
 
+/**
+ * PreviewBackup runs a dry-run of the backup profile and returns the number of files, the estimated size, the largest
+ * files and directories and the rules that excluded paths. The profile does not have to be saved.
+ */
+export function PreviewBackup(backup: $models.BackupProfile): $CancellablePromise<$models.BackupPreview | null> {
+    return $Call.ByID(404696836, backup).then(($result: any) => {
+        return $$createType10($result);
+    });
+}
+
 /**
  * RemoveRepositoryFromBackupProfile removes a repository from a backup profile
  */
@@ -94,13 +114,24 @@ export function RemoveRepositoryFromBackupProfile(backupProfileId: number, repos
     return $Call.ByID(1486652365, backupProfileId, repositoryId, deleteArchives);
 }
 
+/**
+ * SaveBackupHooks replaces the hooks of a backup profile.
+ * Hooks of the same type are executed in the given order.
+ * Hooks that already exist (matched by ID) keep the result of their last run.
+ */
+export function SaveBackupHooks(backupProfileId: number, hooks: $models.BackupHook[]): $CancellablePromise<$models.BackupHook[]> {
+    return $Call.ByID(1503669527, backupProfileId, hooks).then(($result: any) => {
+        return $$createType12($result);
+    });
+}
+
 export function SaveBackupSchedule(backupProfileId: number, schedule: $models.BackupSchedule): $CancellablePromise<void> {
     return $Call.ByID(1589423160, backupProfileId, schedule);
 }
 
 export function SavePruningRule(backupId: number, rule: $models.PruningRule): $CancellablePromise<$models.PruningRule | null> {
     return $Call.ByID(2170098212, backupId, rule).then(($result: any) => {
-        return $$createType8($result);
+        return $$createType14($result);
     });
 }
 
@@ -128,13 +159,31 @@ export function ValidateBackupProfileName(name: string): $CancellablePromise<str
     return $Call.ByID(832939032, name);
 }
 
+/**
+ * ValidateBackupSchedule validates a backup schedule without saving it and returns its next count run times,
+ * so schedules like cron expressions can be previewed while they are edited.
+ */
+export function ValidateBackupSchedule(schedule: $models.BackupSchedule, count: number): $CancellablePromise<$models.ScheduleValidation | null> {
+    return $Call.ByID(2007883899, schedule, count).then(($result: any) => {
+        return $$createType16($result);
+    });
+}
+
 // Private type creation functions
 const $$createType0 = $models.BackupProfile.createFrom;
 const $$createType1 = $Create.Nullable($$createType0);
-const $$createType2 = $models.BackupProfileFilter.createFrom;
-const $$createType3 = $Create.Array($$createType2);
-const $$createType4 = $Create.Array($$createType1);
-const $$createType5 = $Create.Array($Create.Any);
-const $$createType6 = $models.GetPruningOptionsResponse.createFrom;
-const $$createType7 = $models.PruningRule.createFrom;
-const $$createType8 = $Create.Nullable($$createType7);
+const $$createType2 = $models.PathEvaluation.createFrom;
+const $$createType3 = $Create.Nullable($$createType2);
+const $$createType4 = $models.BackupProfileFilter.createFrom;
+const $$createType5 = $Create.Array($$createType4);
+const $$createType6 = $Create.Array($$createType1);
+const $$createType7 = $Create.Array($Create.Any);
+const $$createType8 = $models.GetPruningOptionsResponse.createFrom;
+const $$createType9 = $models.BackupPreview.createFrom;
+const $$createType10 = $Create.Nullable($$createType9);
+const $$createType11 = $models.BackupHook.createFrom;
+const $$createType12 = $Create.Array($$createType11);
+const $$createType13 = $models.PruningRule.createFrom;
+const $$createType14 = $Create.Nullable($$createType13);
+const $$createType15 = $models.ScheduleValidation.createFrom;
+const $$createType16 = $Create.Nullable($$createType15);
@@ -9,6 +9,11 @@ export {
 export {
     ArchiveDeleteStateType,
     ArchiveDeleteStateUnion,
+    ArchiveDiff,
+    ArchiveDiffRequest,
+    ArchiveDirectoryEntry,
+    ArchiveDirectoryRequest,
+    ArchiveDirectoryResponse,
     ArchiveEditStateType,
     ArchiveEditStateUnion,
     ArchiveWithPendingChanges,
@@ -16,6 +21,7 @@ export {
     BackupButtonStatus,
     BackupProfileFilter,
     ChangePassphraseResult,
+    CheckSchedule,
     Completed,
     DeleteActive,
     DeleteNone,
@@ -25,8 +31,14 @@ export {
     EditQueued,
     ExaminePruningResult,
     Expired,
+    ExportArchiveRequest,
     Failed,
+    FileHistory,
+    FileSearchResult,
+    FileVersion,
+    FileVersionRestoreRequest,
     FixStoredPasswordResult,
+    ImportTarRequest,
     Local,
     LocationType,
     LocationUnion,
@@ -35,16 +47,24 @@ export {
     OperationStatusUnion,
     PaginatedArchivesRequest,
     PaginatedArchivesResponse,
+    PartialCheckRequest,
     Progress,
     PruningDate,
     PruningDates,
     Queued,
     Remote,
     Repository,
+    RepositoryGrowth,
+    RepositoryStatsPoint,
     RepositoryWithQueue,
+    RestorePlan,
+    RestorePlanAction,
+    RestorePlanEntry,
+    RestoreRequest,
     Running,
     SerializableQueuedOperation,
     TestRepoConnectionResult,
     UpdateRequest,
-    ValidatePathChangeResult
+    ValidatePathChangeResult,
+    VerificationCoverage
 } from "./models.js";
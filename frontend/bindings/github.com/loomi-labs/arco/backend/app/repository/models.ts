@@ -10,7 +10,10 @@ import { Create as $Create } from "@wailsio/runtime";
 import * as statemachine$0 from "../statemachine/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
-import * as types$0 from "../types/models.js";
+import * as types$1 from "../types/models.js";
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as types$0 from "../../borg/types/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
 import * as ent$0 from "../../ent/models.js";
@@ -75,6 +78,260 @@ export class ArchiveDeleteStateUnion {
     }
 }
 
+/**
+ * ArchiveDiff represents a page of the changes between two archives.
+ * The counts and sizes cover all changes, the entries only the requested page.
+ */
+export class ArchiveDiff {
+    "archiveIdA": number;
+    "archiveIdB": number;
+    "entries": types$0.DiffEntry[];
+    "total": number;
+    "addedCount": number;
+    "removedCount": number;
+    "modifiedCount": number;
+    "totalAddedBytes": number;
+    "totalRemovedBytes": number;
+    "sizeDelta": number;
+
+    /** Creates a new ArchiveDiff instance. */
+    constructor($$source: Partial<ArchiveDiff> = {}) {
+        if (!("archiveIdA" in $$source)) {
+            this["archiveIdA"] = 0;
+        }
+        if (!("archiveIdB" in $$source)) {
+            this["archiveIdB"] = 0;
+        }
+        if (!("entries" in $$source)) {
+            this["entries"] = [];
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+        if (!("addedCount" in $$source)) {
+            this["addedCount"] = 0;
+        }
+        if (!("removedCount" in $$source)) {
+            this["removedCount"] = 0;
+        }
+        if (!("modifiedCount" in $$source)) {
+            this["modifiedCount"] = 0;
+        }
+        if (!("totalAddedBytes" in $$source)) {
+            this["totalAddedBytes"] = 0;
+        }
+        if (!("totalRemovedBytes" in $$source)) {
+            this["totalRemovedBytes"] = 0;
+        }
+        if (!("sizeDelta" in $$source)) {
+            this["sizeDelta"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveDiff instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveDiff {
+        const $$createField2_0 = $$createType7;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("entries" in $$parsedSource) {
+            $$parsedSource["entries"] = $$createField2_0($$parsedSource["entries"]);
+        }
+        return new ArchiveDiff($$parsedSource as Partial<ArchiveDiff>);
+    }
+}
+
+/**
+ * ArchiveDiffRequest represents a request for a page of the changes between two archives
+ */
+export class ArchiveDiffRequest {
+    /**
+     * Base archive (usually the older one)
+     */
+    "archiveIdA": number;
+
+    /**
+     * Archive that is compared with the base archive
+     */
+    "archiveIdB": number;
+    "page": number;
+    "pageSize": number;
+
+    /** Creates a new ArchiveDiffRequest instance. */
+    constructor($$source: Partial<ArchiveDiffRequest> = {}) {
+        if (!("archiveIdA" in $$source)) {
+            this["archiveIdA"] = 0;
+        }
+        if (!("archiveIdB" in $$source)) {
+            this["archiveIdB"] = 0;
+        }
+        if (!("page" in $$source)) {
+            this["page"] = 0;
+        }
+        if (!("pageSize" in $$source)) {
+            this["pageSize"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveDiffRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveDiffRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ArchiveDiffRequest($$parsedSource as Partial<ArchiveDiffRequest>);
+    }
+}
+
+/**
+ * ArchiveDirectoryEntry represents a file or directory inside an archive
+ */
+export class ArchiveDirectoryEntry {
+    "name": string;
+
+    /**
+     * Path inside the archive (without leading slash)
+     */
+    "path": string;
+    "isDir": boolean;
+
+    /**
+     * "d" = directory, "-" = regular file, "l" = symlink, ...
+     */
+    "type": string;
+    "mode": string;
+
+    /**
+     * For directories the size of all contents
+     */
+    "size": number;
+
+    /**
+     * For directories the number of contained items (recursive)
+     */
+    "itemCount": number;
+    "modTime": string;
+    "linkTarget"?: string;
+
+    /** Creates a new ArchiveDirectoryEntry instance. */
+    constructor($$source: Partial<ArchiveDirectoryEntry> = {}) {
+        if (!("name" in $$source)) {
+            this["name"] = "";
+        }
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("isDir" in $$source)) {
+            this["isDir"] = false;
+        }
+        if (!("type" in $$source)) {
+            this["type"] = "";
+        }
+        if (!("mode" in $$source)) {
+            this["mode"] = "";
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+        if (!("itemCount" in $$source)) {
+            this["itemCount"] = 0;
+        }
+        if (!("modTime" in $$source)) {
+            this["modTime"] = "0001-01-01T00:00:00.000Z";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveDirectoryEntry instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveDirectoryEntry {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ArchiveDirectoryEntry($$parsedSource as Partial<ArchiveDirectoryEntry>);
+    }
+}
+
+/**
+ * ArchiveDirectoryRequest represents a request for a page of a directory inside an archive
+ */
+export class ArchiveDirectoryRequest {
+    /**
+     * Required
+     */
+    "archiveId": number;
+    "page": number;
+    "pageSize": number;
+
+    /**
+     * Optional
+     * Directory inside the archive (root if empty)
+     */
+    "path"?: string;
+
+    /** Creates a new ArchiveDirectoryRequest instance. */
+    constructor($$source: Partial<ArchiveDirectoryRequest> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("page" in $$source)) {
+            this["page"] = 0;
+        }
+        if (!("pageSize" in $$source)) {
+            this["pageSize"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveDirectoryRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveDirectoryRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ArchiveDirectoryRequest($$parsedSource as Partial<ArchiveDirectoryRequest>);
+    }
+}
+
+/**
+ * ArchiveDirectoryResponse represents a page of a directory inside an archive
+ */
+export class ArchiveDirectoryResponse {
+    "path": string;
+    "entries": ArchiveDirectoryEntry[];
+    "total": number;
+
+    /** Creates a new ArchiveDirectoryResponse instance. */
+    constructor($$source: Partial<ArchiveDirectoryResponse> = {}) {
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("entries" in $$source)) {
+            this["entries"] = [];
+        }
+        if (!("total" in $$source)) {
+            this["total"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveDirectoryResponse instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveDirectoryResponse {
+        const $$createField1_0 = $$createType9;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("entries" in $$parsedSource) {
+            $$parsedSource["entries"] = $$createField1_0($$parsedSource["entries"]);
+        }
+        return new ArchiveDirectoryResponse($$parsedSource as Partial<ArchiveDirectoryResponse>);
+    }
+}
+
 /**
  * ArchiveEditStateType is the discriminator enum for ArchiveEditState
  */
@@ -118,9 +375,9 @@ export class ArchiveEditStateUnion {
      * Creates a new ArchiveEditStateUnion instance from a string or object.
      */
     static createFrom($$source: any = {}): ArchiveEditStateUnion {
-        const $$createField1_0 = $$createType7;
-        const $$createField2_0 = $$createType9;
-        const $$createField3_0 = $$createType11;
+        const $$createField1_0 = $$createType11;
+        const $$createField2_0 = $$createType13;
+        const $$createField3_0 = $$createType15;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("editNone" in $$parsedSource) {
             $$parsedSource["editNone"] = $$createField1_0($$parsedSource["editNone"]);
@@ -184,6 +441,41 @@ export class ArchiveWithPendingChanges {
      */
     "warningMessage"?: string | null;
 
+    /**
+     * Timestamp when the files of this archive were added to the file index
+     */
+    "filesIndexedAt"?: string | null;
+
+    /**
+     * Number of files in the archive (nil if the stats were not fetched yet)
+     */
+    "nfiles"?: number | null;
+
+    /**
+     * Uncompressed size of all files in the archive
+     */
+    "originalSize"?: number | null;
+
+    /**
+     * Compressed size of all files in the archive
+     */
+    "compressedSize"?: number | null;
+
+    /**
+     * Compressed size of the chunks that only this archive references (space added by this archive)
+     */
+    "deduplicatedSize"?: number | null;
+
+    /**
+     * Number of failed attempts to fetch the stats (the stats are not fetched anymore after a few failures)
+     */
+    "statsFailures": number;
+
+    /**
+     * Timestamp of the last full check (--verify-data) that covered this archive
+     */
+    "dataVerifiedAt"?: string | null;
+
     /**
      * Edges holds the relations/edges for other nodes in the graph.
      * The values are being populated by the ArchiveQuery when eager-loading is set.
@@ -226,6 +518,9 @@ export class ArchiveWithPendingChanges {
         if (!("comment" in $$source)) {
             this["comment"] = "";
         }
+        if (!("statsFailures" in $$source)) {
+            this["statsFailures"] = 0;
+        }
         if (!("edges" in $$source)) {
             this["edges"] = (new ent$0.ArchiveEdges());
         }
@@ -243,18 +538,18 @@ export class ArchiveWithPendingChanges {
      * Creates a new ArchiveWithPendingChanges instance from a string or object.
      */
     static createFrom($$source: any = {}): ArchiveWithPendingChanges {
-        const $$createField9_0 = $$createType12;
-        const $$createField10_0 = $$createType13;
-        const $$createField11_0 = $$createType14;
+        const $$createField16_0 = $$createType16;
+        const $$createField17_0 = $$createType17;
+        const $$createField18_0 = $$createType18;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("edges" in $$parsedSource) {
-            $$parsedSource["edges"] = $$createField9_0($$parsedSource["edges"]);
+            $$parsedSource["edges"] = $$createField16_0($$parsedSource["edges"]);
         }
         if ("editStateUnion" in $$parsedSource) {
-            $$parsedSource["editStateUnion"] = $$createField10_0($$parsedSource["editStateUnion"]);
+            $$parsedSource["editStateUnion"] = $$createField17_0($$parsedSource["editStateUnion"]);
         }
         if ("deleteStateUnion" in $$parsedSource) {
-            $$parsedSource["deleteStateUnion"] = $$createField11_0($$parsedSource["deleteStateUnion"]);
+            $$parsedSource["deleteStateUnion"] = $$createField18_0($$parsedSource["deleteStateUnion"]);
         }
         return new ArchiveWithPendingChanges($$parsedSource as Partial<ArchiveWithPendingChanges>);
     }
@@ -353,6 +648,59 @@ export class ChangePassphraseResult {
     }
 }
 
+/**
+ * CheckSchedule is the schedule of the automatic integrity checks of a repository
+ */
+export class CheckSchedule {
+    /**
+     * 0 disables scheduled quick checks
+     */
+    "quickCheckIntervalDays": number;
+
+    /**
+     * 0 disables scheduled full checks
+     */
+    "fullCheckIntervalWeeks": number;
+
+    /**
+     * When the next quick check is due
+     */
+    "nextQuickCheckAt"?: string | null;
+
+    /**
+     * When the next full check is due
+     */
+    "nextFullCheckAt"?: string | null;
+
+    /**
+     * Archives verified per scheduled full check, 0 for all
+     */
+    "rollingCheckArchives": number;
+
+    /** Creates a new CheckSchedule instance. */
+    constructor($$source: Partial<CheckSchedule> = {}) {
+        if (!("quickCheckIntervalDays" in $$source)) {
+            this["quickCheckIntervalDays"] = 0;
+        }
+        if (!("fullCheckIntervalWeeks" in $$source)) {
+            this["fullCheckIntervalWeeks"] = 0;
+        }
+        if (!("rollingCheckArchives" in $$source)) {
+            this["rollingCheckArchives"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new CheckSchedule instance from a string or object.
+     */
+    static createFrom($$source: any = {}): CheckSchedule {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new CheckSchedule($$parsedSource as Partial<CheckSchedule>);
+    }
+}
+
 export class Completed {
     "completedAt": string;
 
@@ -504,7 +852,7 @@ export class EditQueued {
  * ExaminePruningResult represents the result of examining pruning operations
  */
 export class ExaminePruningResult {
-    "backupId": types$0.BackupId;
+    "backupId": types$1.BackupId;
     "repositoryName": string;
     "cntArchivesToBeDeleted": number;
     "error"?: any;
@@ -512,7 +860,7 @@ export class ExaminePruningResult {
     /** Creates a new ExaminePruningResult instance. */
     constructor($$source: Partial<ExaminePruningResult> = {}) {
         if (!("backupId" in $$source)) {
-            this["backupId"] = (new types$0.BackupId());
+            this["backupId"] = (new types$1.BackupId());
         }
         if (!("repositoryName" in $$source)) {
             this["repositoryName"] = "";
@@ -528,7 +876,7 @@ export class ExaminePruningResult {
      * Creates a new ExaminePruningResult instance from a string or object.
      */
     static createFrom($$source: any = {}): ExaminePruningResult {
-        const $$createField0_0 = $$createType15;
+        const $$createField0_0 = $$createType19;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backupId" in $$parsedSource) {
             $$parsedSource["backupId"] = $$createField0_0($$parsedSource["backupId"]);
@@ -558,6 +906,65 @@ export class Expired {
     }
 }
 
+/**
+ * ExportArchiveRequest represents a request to export archive contents as tar file
+ */
+export class ExportArchiveRequest {
+    /**
+     * Required
+     */
+    "archiveId": number;
+
+    /**
+     * Path of the tar file that is created (must not exist yet)
+     */
+    "outputPath": string;
+
+    /**
+     * Optional
+     * Detected from the output path if empty (defaults to tar)
+     */
+    "format"?: types$0.TarFormat;
+
+    /**
+     * Archive paths to export (everything if empty)
+     */
+    "paths"?: string[];
+
+    /**
+     * Patterns that are not exported
+     */
+    "excludePaths"?: string[];
+
+    /** Creates a new ExportArchiveRequest instance. */
+    constructor($$source: Partial<ExportArchiveRequest> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("outputPath" in $$source)) {
+            this["outputPath"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ExportArchiveRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ExportArchiveRequest {
+        const $$createField3_0 = $$createType20;
+        const $$createField4_0 = $$createType20;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("paths" in $$parsedSource) {
+            $$parsedSource["paths"] = $$createField3_0($$parsedSource["paths"]);
+        }
+        if ("excludePaths" in $$parsedSource) {
+            $$parsedSource["excludePaths"] = $$createField4_0($$parsedSource["excludePaths"]);
+        }
+        return new ExportArchiveRequest($$parsedSource as Partial<ExportArchiveRequest>);
+    }
+}
+
 export class Failed {
     "error": string;
     "failedAt": string;
@@ -583,6 +990,188 @@ export class Failed {
     }
 }
 
+/**
+ * FileHistory represents all versions of a file across the archives of a repository
+ */
+export class FileHistory {
+    "path": string;
+
+    /**
+     * Sorted from oldest to newest archive
+     */
+    "versions": FileVersion[];
+    "distinctVersions": number;
+
+    /** Creates a new FileHistory instance. */
+    constructor($$source: Partial<FileHistory> = {}) {
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("versions" in $$source)) {
+            this["versions"] = [];
+        }
+        if (!("distinctVersions" in $$source)) {
+            this["distinctVersions"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FileHistory instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FileHistory {
+        const $$createField1_0 = $$createType22;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("versions" in $$parsedSource) {
+            $$parsedSource["versions"] = $$createField1_0($$parsedSource["versions"]);
+        }
+        return new FileHistory($$parsedSource as Partial<FileHistory>);
+    }
+}
+
+/**
+ * FileSearchResult represents an archive that contains files matching a search
+ */
+export class FileSearchResult {
+    "archive": ent$0.Archive | null;
+
+    /**
+     * Limited to the first matches (sorted by path)
+     */
+    "files": (ent$0.ArchiveFile | null)[];
+
+    /**
+     * Total number of matching files in the archive
+     */
+    "matchCount": number;
+
+    /** Creates a new FileSearchResult instance. */
+    constructor($$source: Partial<FileSearchResult> = {}) {
+        if (!("archive" in $$source)) {
+            this["archive"] = null;
+        }
+        if (!("files" in $$source)) {
+            this["files"] = [];
+        }
+        if (!("matchCount" in $$source)) {
+            this["matchCount"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FileSearchResult instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FileSearchResult {
+        const $$createField0_0 = $$createType24;
+        const $$createField1_0 = $$createType27;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("archive" in $$parsedSource) {
+            $$parsedSource["archive"] = $$createField0_0($$parsedSource["archive"]);
+        }
+        if ("files" in $$parsedSource) {
+            $$parsedSource["files"] = $$createField1_0($$parsedSource["files"]);
+        }
+        return new FileSearchResult($$parsedSource as Partial<FileSearchResult>);
+    }
+}
+
+/**
+ * FileVersion represents a file as it is stored in one archive
+ */
+export class FileVersion {
+    "archiveId": number;
+    "archiveName": string;
+    "archiveCreatedAt": string;
+    "size": number;
+    "modTime": string;
+
+    /**
+     * True if the file differs from the previous archive containing it (or is the first one)
+     */
+    "changed": boolean;
+
+    /** Creates a new FileVersion instance. */
+    constructor($$source: Partial<FileVersion> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("archiveName" in $$source)) {
+            this["archiveName"] = "";
+        }
+        if (!("archiveCreatedAt" in $$source)) {
+            this["archiveCreatedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+        if (!("modTime" in $$source)) {
+            this["modTime"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("changed" in $$source)) {
+            this["changed"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FileVersion instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FileVersion {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new FileVersion($$parsedSource as Partial<FileVersion>);
+    }
+}
+
+/**
+ * FileVersionRestoreRequest represents a request to restore a single file as it was in one archive
+ */
+export class FileVersionRestoreRequest {
+    /**
+     * Required
+     */
+    "archiveId": number;
+
+    /**
+     * Path of the file (with or without leading slash)
+     */
+    "path": string;
+
+    /**
+     * Optional
+     * Absolute directory the file is restored into (original location if empty)
+     */
+    "destination"?: string;
+
+    /**
+     * Defaults to overwrite
+     */
+    "conflictPolicy"?: statemachine$0.RestoreConflictPolicy;
+
+    /** Creates a new FileVersionRestoreRequest instance. */
+    constructor($$source: Partial<FileVersionRestoreRequest> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new FileVersionRestoreRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): FileVersionRestoreRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new FileVersionRestoreRequest($$parsedSource as Partial<FileVersionRestoreRequest>);
+    }
+}
+
 /**
  * FixStoredPasswordResult represents the result of fixing stored repository password
  */
@@ -608,6 +1197,56 @@ export class FixStoredPasswordResult {
     }
 }
 
+/**
+ * ImportTarRequest represents a request to create an archive from a tar file
+ */
+export class ImportTarRequest {
+    /**
+     * Required
+     * The archive is named with the prefix and compressed with the settings of the backup profile
+     */
+    "backupId": types$1.BackupId;
+
+    /**
+     * Path of the tar file (.tar, .tar.gz or .tar.zst)
+     */
+    "tarPath": string;
+
+    /**
+     * Optional
+     */
+    "comment"?: string;
+
+    /**
+     * Creation time of the archive (now if empty)
+     */
+    "timestamp"?: string | null;
+
+    /** Creates a new ImportTarRequest instance. */
+    constructor($$source: Partial<ImportTarRequest> = {}) {
+        if (!("backupId" in $$source)) {
+            this["backupId"] = (new types$1.BackupId());
+        }
+        if (!("tarPath" in $$source)) {
+            this["tarPath"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ImportTarRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ImportTarRequest {
+        const $$createField0_0 = $$createType19;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("backupId" in $$parsedSource) {
+            $$parsedSource["backupId"] = $$createField0_0($$parsedSource["backupId"]);
+        }
+        return new ImportTarRequest($$parsedSource as Partial<ImportTarRequest>);
+    }
+}
+
 /**
  * Repository type variants
  */
@@ -671,9 +1310,9 @@ export class LocationUnion {
      * Creates a new LocationUnion instance from a string or object.
      */
     static createFrom($$source: any = {}): LocationUnion {
-        const $$createField1_0 = $$createType17;
-        const $$createField2_0 = $$createType19;
-        const $$createField3_0 = $$createType21;
+        const $$createField1_0 = $$createType29;
+        const $$createField2_0 = $$createType31;
+        const $$createField3_0 = $$createType33;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("local" in $$parsedSource) {
             $$parsedSource["local"] = $$createField1_0($$parsedSource["local"]);
@@ -763,11 +1402,11 @@ export class OperationStatusUnion {
      * Creates a new OperationStatusUnion instance from a string or object.
      */
     static createFrom($$source: any = {}): OperationStatusUnion {
-        const $$createField1_0 = $$createType23;
-        const $$createField2_0 = $$createType25;
-        const $$createField3_0 = $$createType27;
-        const $$createField4_0 = $$createType29;
-        const $$createField5_0 = $$createType31;
+        const $$createField1_0 = $$createType35;
+        const $$createField2_0 = $$createType37;
+        const $$createField3_0 = $$createType39;
+        const $$createField4_0 = $$createType41;
+        const $$createField5_0 = $$createType43;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("queued" in $$parsedSource) {
             $$parsedSource["queued"] = $$createField1_0($$parsedSource["queued"]);
@@ -826,7 +1465,7 @@ export class PaginatedArchivesRequest {
      * Creates a new PaginatedArchivesRequest instance from a string or object.
      */
     static createFrom($$source: any = {}): PaginatedArchivesRequest {
-        const $$createField3_0 = $$createType33;
+        const $$createField3_0 = $$createType45;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backupProfileFilter" in $$parsedSource) {
             $$parsedSource["backupProfileFilter"] = $$createField3_0($$parsedSource["backupProfileFilter"]);
@@ -858,7 +1497,7 @@ export class PaginatedArchivesResponse {
      * Creates a new PaginatedArchivesResponse instance from a string or object.
      */
     static createFrom($$source: any = {}): PaginatedArchivesResponse {
-        const $$createField0_0 = $$createType36;
+        const $$createField0_0 = $$createType48;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("archives" in $$parsedSource) {
             $$parsedSource["archives"] = $$createField0_0($$parsedSource["archives"]);
@@ -867,6 +1506,41 @@ export class PaginatedArchivesResponse {
     }
 }
 
+/**
+ * PartialCheckRequest selects the archives whose data is verified by a partial full check
+ */
+export class PartialCheckRequest {
+    /**
+     * Only verify archives matching this shell-style pattern
+     */
+    "globArchives": string;
+
+    /**
+     * Only verify the newest N archives, 0 for no limit
+     */
+    "lastArchives": number;
+
+    /** Creates a new PartialCheckRequest instance. */
+    constructor($$source: Partial<PartialCheckRequest> = {}) {
+        if (!("globArchives" in $$source)) {
+            this["globArchives"] = "";
+        }
+        if (!("lastArchives" in $$source)) {
+            this["lastArchives"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new PartialCheckRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): PartialCheckRequest {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new PartialCheckRequest($$parsedSource as Partial<PartialCheckRequest>);
+    }
+}
+
 /**
  * Progress represents generic progress information
  */
@@ -943,7 +1617,7 @@ export class PruningDates {
      * Creates a new PruningDates instance from a string or object.
      */
     static createFrom($$source: any = {}): PruningDates {
-        const $$createField0_0 = $$createType38;
+        const $$createField0_0 = $$createType50;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("dates" in $$parsedSource) {
             $$parsedSource["dates"] = $$createField0_0($$parsedSource["dates"]);
@@ -1018,8 +1692,8 @@ export class Repository {
      * Metadata
      */
     "archiveCount": number;
-    "lastBackup"?: types$0.LastBackup | null;
-    "lastAttempt"?: types$0.LastAttempt | null;
+    "lastBackup"?: types$1.LastBackup | null;
+    "lastAttempt"?: types$1.LastAttempt | null;
 
     /**
      * Check tracking
@@ -1028,6 +1702,12 @@ export class Repository {
     "quickCheckError"?: string[];
     "lastFullCheckAt"?: string | null;
     "fullCheckError"?: string[];
+    "checkSchedule": CheckSchedule;
+
+    /**
+     * Archives verified in the current verification cycle
+     */
+    "checkCoverage": VerificationCoverage;
 
     /**
      * Storage Statistics
@@ -1051,6 +1731,12 @@ export class Repository {
      */
     "hasPassword": boolean;
 
+    /**
+     * File index
+     * Whether files of all archives are indexed for searching
+     */
+    "fileIndexEnabled": boolean;
+
     /** Creates a new Repository instance. */
     constructor($$source: Partial<Repository> = {}) {
         if (!("id" in $$source)) {
@@ -1071,6 +1757,12 @@ export class Repository {
         if (!("archiveCount" in $$source)) {
             this["archiveCount"] = 0;
         }
+        if (!("checkSchedule" in $$source)) {
+            this["checkSchedule"] = (new CheckSchedule());
+        }
+        if (!("checkCoverage" in $$source)) {
+            this["checkCoverage"] = (new VerificationCoverage());
+        }
         if (!("sizeOnDisk" in $$source)) {
             this["sizeOnDisk"] = 0;
         }
@@ -1080,6 +1772,9 @@ export class Repository {
         if (!("hasPassword" in $$source)) {
             this["hasPassword"] = false;
         }
+        if (!("fileIndexEnabled" in $$source)) {
+            this["fileIndexEnabled"] = false;
+        }
 
         Object.assign(this, $$source);
     }
@@ -1088,12 +1783,14 @@ export class Repository {
      * Creates a new Repository instance from a string or object.
      */
     static createFrom($$source: any = {}): Repository {
-        const $$createField3_0 = $$createType39;
-        const $$createField4_0 = $$createType40;
-        const $$createField6_0 = $$createType42;
-        const $$createField7_0 = $$createType44;
-        const $$createField9_0 = $$createType45;
-        const $$createField11_0 = $$createType45;
+        const $$createField3_0 = $$createType51;
+        const $$createField4_0 = $$createType52;
+        const $$createField6_0 = $$createType54;
+        const $$createField7_0 = $$createType56;
+        const $$createField9_0 = $$createType20;
+        const $$createField11_0 = $$createType20;
+        const $$createField12_0 = $$createType57;
+        const $$createField13_0 = $$createType58;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("type" in $$parsedSource) {
             $$parsedSource["type"] = $$createField3_0($$parsedSource["type"]);
@@ -1113,10 +1810,134 @@ export class Repository {
         if ("fullCheckError" in $$parsedSource) {
             $$parsedSource["fullCheckError"] = $$createField11_0($$parsedSource["fullCheckError"]);
         }
+        if ("checkSchedule" in $$parsedSource) {
+            $$parsedSource["checkSchedule"] = $$createField12_0($$parsedSource["checkSchedule"]);
+        }
+        if ("checkCoverage" in $$parsedSource) {
+            $$parsedSource["checkCoverage"] = $$createField13_0($$parsedSource["checkCoverage"]);
+        }
         return new Repository($$parsedSource as Partial<Repository>);
     }
 }
 
+/**
+ * RepositoryGrowth describes how the storage usage of a repository changed within a time window
+ */
+export class RepositoryGrowth {
+    "repositoryId": number;
+    "from": string;
+    "to": string;
+
+    /**
+     * Oldest first
+     */
+    "points": RepositoryStatsPoint[];
+
+    /**
+     * Change of the size on disk within the window
+     */
+    "growthBytes": number;
+
+    /**
+     * Linear trend of the size on disk
+     */
+    "growthBytesPerDay": number;
+
+    /**
+     * Free space on the target (only known for local repositories)
+     */
+    "freeSpaceBytes"?: number | null;
+
+    /**
+     * Projected date the target runs out of space (nil if unknown or not growing)
+     */
+    "fullBy"?: string | null;
+
+    /** Creates a new RepositoryGrowth instance. */
+    constructor($$source: Partial<RepositoryGrowth> = {}) {
+        if (!("repositoryId" in $$source)) {
+            this["repositoryId"] = 0;
+        }
+        if (!("from" in $$source)) {
+            this["from"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("to" in $$source)) {
+            this["to"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("points" in $$source)) {
+            this["points"] = [];
+        }
+        if (!("growthBytes" in $$source)) {
+            this["growthBytes"] = 0;
+        }
+        if (!("growthBytesPerDay" in $$source)) {
+            this["growthBytesPerDay"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RepositoryGrowth instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RepositoryGrowth {
+        const $$createField3_0 = $$createType60;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("points" in $$parsedSource) {
+            $$parsedSource["points"] = $$createField3_0($$parsedSource["points"]);
+        }
+        return new RepositoryGrowth($$parsedSource as Partial<RepositoryGrowth>);
+    }
+}
+
+/**
+ * RepositoryStatsPoint is the storage usage of a repository at a point in time
+ */
+export class RepositoryStatsPoint {
+    "timestamp": string;
+
+    /**
+     * Actual storage used (compressed + deduplicated)
+     */
+    "sizeOnDisk": number;
+
+    /**
+     * Deduplicated uncompressed size
+     */
+    "uniqueSize": number;
+
+    /**
+     * Uncompressed size of all archives
+     */
+    "totalSize": number;
+
+    /** Creates a new RepositoryStatsPoint instance. */
+    constructor($$source: Partial<RepositoryStatsPoint> = {}) {
+        if (!("timestamp" in $$source)) {
+            this["timestamp"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("sizeOnDisk" in $$source)) {
+            this["sizeOnDisk"] = 0;
+        }
+        if (!("uniqueSize" in $$source)) {
+            this["uniqueSize"] = 0;
+        }
+        if (!("totalSize" in $$source)) {
+            this["totalSize"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RepositoryStatsPoint instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RepositoryStatsPoint {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RepositoryStatsPoint($$parsedSource as Partial<RepositoryStatsPoint>);
+    }
+}
+
 /**
  * RepositoryWithQueue extends Repository with queue information for frontend
  */
@@ -1142,8 +1963,8 @@ export class RepositoryWithQueue {
      * Metadata
      */
     "archiveCount": number;
-    "lastBackup"?: types$0.LastBackup | null;
-    "lastAttempt"?: types$0.LastAttempt | null;
+    "lastBackup"?: types$1.LastBackup | null;
+    "lastAttempt"?: types$1.LastAttempt | null;
 
     /**
      * Check tracking
@@ -1152,6 +1973,12 @@ export class RepositoryWithQueue {
     "quickCheckError"?: string[];
     "lastFullCheckAt"?: string | null;
     "fullCheckError"?: string[];
+    "checkSchedule": CheckSchedule;
+
+    /**
+     * Archives verified in the current verification cycle
+     */
+    "checkCoverage": VerificationCoverage;
 
     /**
      * Storage Statistics
@@ -1174,6 +2001,12 @@ export class RepositoryWithQueue {
      * Whether repository has encryption passphrase
      */
     "hasPassword": boolean;
+
+    /**
+     * File index
+     * Whether files of all archives are indexed for searching
+     */
+    "fileIndexEnabled": boolean;
     "queuedOperations": (SerializableQueuedOperation | null)[];
     "activeOperation"?: SerializableQueuedOperation | null;
 
@@ -1197,6 +2030,12 @@ export class RepositoryWithQueue {
         if (!("archiveCount" in $$source)) {
             this["archiveCount"] = 0;
         }
+        if (!("checkSchedule" in $$source)) {
+            this["checkSchedule"] = (new CheckSchedule());
+        }
+        if (!("checkCoverage" in $$source)) {
+            this["checkCoverage"] = (new VerificationCoverage());
+        }
         if (!("sizeOnDisk" in $$source)) {
             this["sizeOnDisk"] = 0;
         }
@@ -1206,6 +2045,9 @@ export class RepositoryWithQueue {
         if (!("hasPassword" in $$source)) {
             this["hasPassword"] = false;
         }
+        if (!("fileIndexEnabled" in $$source)) {
+            this["fileIndexEnabled"] = false;
+        }
         if (!("queuedOperations" in $$source)) {
             this["queuedOperations"] = [];
         }
@@ -1217,14 +2059,16 @@ export class RepositoryWithQueue {
      * Creates a new RepositoryWithQueue instance from a string or object.
      */
     static createFrom($$source: any = {}): RepositoryWithQueue {
-        const $$createField3_0 = $$createType39;
-        const $$createField4_0 = $$createType40;
-        const $$createField6_0 = $$createType42;
-        const $$createField7_0 = $$createType44;
-        const $$createField9_0 = $$createType45;
-        const $$createField11_0 = $$createType45;
-        const $$createField16_0 = $$createType48;
-        const $$createField17_0 = $$createType47;
+        const $$createField3_0 = $$createType51;
+        const $$createField4_0 = $$createType52;
+        const $$createField6_0 = $$createType54;
+        const $$createField7_0 = $$createType56;
+        const $$createField9_0 = $$createType20;
+        const $$createField11_0 = $$createType20;
+        const $$createField12_0 = $$createType57;
+        const $$createField13_0 = $$createType58;
+        const $$createField19_0 = $$createType63;
+        const $$createField20_0 = $$createType62;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("type" in $$parsedSource) {
             $$parsedSource["type"] = $$createField3_0($$parsedSource["type"]);
@@ -1244,16 +2088,223 @@ export class RepositoryWithQueue {
         if ("fullCheckError" in $$parsedSource) {
             $$parsedSource["fullCheckError"] = $$createField11_0($$parsedSource["fullCheckError"]);
         }
+        if ("checkSchedule" in $$parsedSource) {
+            $$parsedSource["checkSchedule"] = $$createField12_0($$parsedSource["checkSchedule"]);
+        }
+        if ("checkCoverage" in $$parsedSource) {
+            $$parsedSource["checkCoverage"] = $$createField13_0($$parsedSource["checkCoverage"]);
+        }
         if ("queuedOperations" in $$parsedSource) {
-            $$parsedSource["queuedOperations"] = $$createField16_0($$parsedSource["queuedOperations"]);
+            $$parsedSource["queuedOperations"] = $$createField19_0($$parsedSource["queuedOperations"]);
         }
         if ("activeOperation" in $$parsedSource) {
-            $$parsedSource["activeOperation"] = $$createField17_0($$parsedSource["activeOperation"]);
+            $$parsedSource["activeOperation"] = $$createField20_0($$parsedSource["activeOperation"]);
         }
         return new RepositoryWithQueue($$parsedSource as Partial<RepositoryWithQueue>);
     }
 }
 
+/**
+ * RestorePlan represents the files a restore would create, overwrite or skip
+ */
+export class RestorePlan {
+    "entries": RestorePlanEntry[];
+    "createCount": number;
+    "overwriteCount": number;
+    "skipCount": number;
+    "keepBothCount": number;
+
+    /**
+     * Bytes that will be written
+     */
+    "totalSize": number;
+
+    /** Creates a new RestorePlan instance. */
+    constructor($$source: Partial<RestorePlan> = {}) {
+        if (!("entries" in $$source)) {
+            this["entries"] = [];
+        }
+        if (!("createCount" in $$source)) {
+            this["createCount"] = 0;
+        }
+        if (!("overwriteCount" in $$source)) {
+            this["overwriteCount"] = 0;
+        }
+        if (!("skipCount" in $$source)) {
+            this["skipCount"] = 0;
+        }
+        if (!("keepBothCount" in $$source)) {
+            this["keepBothCount"] = 0;
+        }
+        if (!("totalSize" in $$source)) {
+            this["totalSize"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RestorePlan instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RestorePlan {
+        const $$createField0_0 = $$createType65;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("entries" in $$parsedSource) {
+            $$parsedSource["entries"] = $$createField0_0($$parsedSource["entries"]);
+        }
+        return new RestorePlan($$parsedSource as Partial<RestorePlan>);
+    }
+}
+
+/**
+ * RestorePlanAction describes what a restore does with a single file
+ */
+export enum RestorePlanAction {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    RestorePlanActionCreate = "create",
+    RestorePlanActionOverwrite = "overwrite",
+    RestorePlanActionSkip = "skip",
+    RestorePlanActionKeepBoth = "keepBoth",
+};
+
+/**
+ * RestorePlanEntry represents the planned action for a single file of a restore
+ */
+export class RestorePlanEntry {
+    /**
+     * Path inside the archive
+     */
+    "archivePath": string;
+
+    /**
+     * Path the file is written to
+     */
+    "targetPath": string;
+
+    /**
+     * What happens with the file
+     */
+    "action": RestorePlanAction;
+
+    /**
+     * Size of the archived file in bytes
+     */
+    "size": number;
+
+    /**
+     * Modification time of the archived file
+     */
+    "archiveModTime": string;
+
+    /**
+     * Modification time of the file at the target path (if it exists)
+     */
+    "existingModTime"?: string | null;
+
+    /** Creates a new RestorePlanEntry instance. */
+    constructor($$source: Partial<RestorePlanEntry> = {}) {
+        if (!("archivePath" in $$source)) {
+            this["archivePath"] = "";
+        }
+        if (!("targetPath" in $$source)) {
+            this["targetPath"] = "";
+        }
+        if (!("action" in $$source)) {
+            this["action"] = RestorePlanAction.$zero;
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+        if (!("archiveModTime" in $$source)) {
+            this["archiveModTime"] = "0001-01-01T00:00:00.000Z";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RestorePlanEntry instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RestorePlanEntry {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RestorePlanEntry($$parsedSource as Partial<RestorePlanEntry>);
+    }
+}
+
+/**
+ * RestoreRequest represents a request to restore (extract) archive contents into a directory
+ */
+export class RestoreRequest {
+    /**
+     * Required
+     */
+    "archiveId": number;
+
+    /**
+     * Either a destination or the original location is required
+     * Absolute directory the archive contents are extracted into
+     */
+    "destination"?: string;
+
+    /**
+     * Restore to the original paths of the backup profile
+     */
+    "originalLocation": boolean;
+
+    /**
+     * Optional
+     * Archive paths to restore (everything if empty)
+     */
+    "paths"?: string[];
+
+    /**
+     * Patterns that are not restored
+     */
+    "excludePaths"?: string[];
+
+    /**
+     * Number of leading path elements to remove
+     */
+    "stripComponents"?: number;
+
+    /**
+     * Defaults to overwrite
+     */
+    "conflictPolicy"?: statemachine$0.RestoreConflictPolicy;
+
+    /** Creates a new RestoreRequest instance. */
+    constructor($$source: Partial<RestoreRequest> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("originalLocation" in $$source)) {
+            this["originalLocation"] = false;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RestoreRequest instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RestoreRequest {
+        const $$createField3_0 = $$createType20;
+        const $$createField4_0 = $$createType20;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("paths" in $$parsedSource) {
+            $$parsedSource["paths"] = $$createField3_0($$parsedSource["paths"]);
+        }
+        if ("excludePaths" in $$parsedSource) {
+            $$parsedSource["excludePaths"] = $$createField4_0($$parsedSource["excludePaths"]);
+        }
+        return new RestoreRequest($$parsedSource as Partial<RestoreRequest>);
+    }
+}
+
 export class Running {
     "progress"?: Progress | null;
     "startedAt": string;
@@ -1271,7 +2322,7 @@ export class Running {
      * Creates a new Running instance from a string or object.
      */
     static createFrom($$source: any = {}): Running {
-        const $$createField0_0 = $$createType50;
+        const $$createField0_0 = $$createType67;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("progress" in $$parsedSource) {
             $$parsedSource["progress"] = $$createField0_0($$parsedSource["progress"]);
@@ -1312,6 +2363,16 @@ export class SerializableQueuedOperation {
      */
     "immediate": boolean;
 
+    /**
+     * Why the operation can't start yet (e.g. blackout period), empty if it isn't held
+     */
+    "heldReason": string;
+
+    /**
+     * When a held operation can start
+     */
+    "heldUntil": string | null;
+
     /** Creates a new SerializableQueuedOperation instance. */
     constructor($$source: Partial<SerializableQueuedOperation> = {}) {
         if (!("id" in $$source)) {
@@ -1338,6 +2399,12 @@ export class SerializableQueuedOperation {
         if (!("immediate" in $$source)) {
             this["immediate"] = false;
         }
+        if (!("heldReason" in $$source)) {
+            this["heldReason"] = "";
+        }
+        if (!("heldUntil" in $$source)) {
+            this["heldUntil"] = null;
+        }
 
         Object.assign(this, $$source);
     }
@@ -1346,8 +2413,8 @@ export class SerializableQueuedOperation {
      * Creates a new SerializableQueuedOperation instance from a string or object.
      */
     static createFrom($$source: any = {}): SerializableQueuedOperation {
-        const $$createField3_0 = $$createType51;
-        const $$createField4_0 = $$createType52;
+        const $$createField3_0 = $$createType68;
+        const $$createField4_0 = $$createType69;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("operationUnion" in $$parsedSource) {
             $$parsedSource["operationUnion"] = $$createField3_0($$parsedSource["operationUnion"]);
@@ -1458,6 +2525,36 @@ export class ValidatePathChangeResult {
     }
 }
 
+/**
+ * VerificationCoverage describes how many archives had their data verified in the current verification cycle.
+ * A cycle starts with a full check of all archives or when a rolling check has verified every archive.
+ */
+export class VerificationCoverage {
+    "verifiedArchives": number;
+    "totalArchives": number;
+    "cycleStartedAt"?: string | null;
+
+    /** Creates a new VerificationCoverage instance. */
+    constructor($$source: Partial<VerificationCoverage> = {}) {
+        if (!("verifiedArchives" in $$source)) {
+            this["verifiedArchives"] = 0;
+        }
+        if (!("totalArchives" in $$source)) {
+            this["totalArchives"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new VerificationCoverage instance from a string or object.
+     */
+    static createFrom($$source: any = {}): VerificationCoverage {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new VerificationCoverage($$parsedSource as Partial<VerificationCoverage>);
+    }
+}
+
 // Private type creation functions
 const $$createType0 = DeleteNone.createFrom;
 const $$createType1 = $Create.Nullable($$createType0);
@@ -1465,50 +2562,67 @@ const $$createType2 = DeleteQueued.createFrom;
 const $$createType3 = $Create.Nullable($$createType2);
 const $$createType4 = DeleteActive.createFrom;
 const $$createType5 = $Create.Nullable($$createType4);
-const $$createType6 = EditNone.createFrom;
-const $$createType7 = $Create.Nullable($$createType6);
-const $$createType8 = EditQueued.createFrom;
-const $$createType9 = $Create.Nullable($$createType8);
-const $$createType10 = EditActive.createFrom;
+const $$createType6 = types$0.DiffEntry.createFrom;
+const $$createType7 = $Create.Array($$createType6);
+const $$createType8 = ArchiveDirectoryEntry.createFrom;
+const $$createType9 = $Create.Array($$createType8);
+const $$createType10 = EditNone.createFrom;
 const $$createType11 = $Create.Nullable($$createType10);
-const $$createType12 = ent$0.ArchiveEdges.createFrom;
-const $$createType13 = ArchiveEditStateUnion.createFrom;
-const $$createType14 = ArchiveDeleteStateUnion.createFrom;
-const $$createType15 = types$0.BackupId.createFrom;
-const $$createType16 = Local.createFrom;
-const $$createType17 = $Create.Nullable($$createType16);
-const $$createType18 = Remote.createFrom;
-const $$createType19 = $Create.Nullable($$createType18);
-const $$createType20 = ArcoCloud.createFrom;
-const $$createType21 = $Create.Nullable($$createType20);
-const $$createType22 = Queued.createFrom;
-const $$createType23 = $Create.Nullable($$createType22);
-const $$createType24 = Running.createFrom;
-const $$createType25 = $Create.Nullable($$createType24);
-const $$createType26 = Completed.createFrom;
-const $$createType27 = $Create.Nullable($$createType26);
-const $$createType28 = Failed.createFrom;
+const $$createType12 = EditQueued.createFrom;
+const $$createType13 = $Create.Nullable($$createType12);
+const $$createType14 = EditActive.createFrom;
+const $$createType15 = $Create.Nullable($$createType14);
+const $$createType16 = ent$0.ArchiveEdges.createFrom;
+const $$createType17 = ArchiveEditStateUnion.createFrom;
+const $$createType18 = ArchiveDeleteStateUnion.createFrom;
+const $$createType19 = types$1.BackupId.createFrom;
+const $$createType20 = $Create.Array($Create.Any);
+const $$createType21 = FileVersion.createFrom;
+const $$createType22 = $Create.Array($$createType21);
+const $$createType23 = ent$0.Archive.createFrom;
+const $$createType24 = $Create.Nullable($$createType23);
+const $$createType25 = ent$0.ArchiveFile.createFrom;
+const $$createType26 = $Create.Nullable($$createType25);
+const $$createType27 = $Create.Array($$createType26);
+const $$createType28 = Local.createFrom;
 const $$createType29 = $Create.Nullable($$createType28);
-const $$createType30 = Expired.createFrom;
+const $$createType30 = Remote.createFrom;
 const $$createType31 = $Create.Nullable($$createType30);
-const $$createType32 = BackupProfileFilter.createFrom;
+const $$createType32 = ArcoCloud.createFrom;
 const $$createType33 = $Create.Nullable($$createType32);
-const $$createType34 = ArchiveWithPendingChanges.createFrom;
+const $$createType34 = Queued.createFrom;
 const $$createType35 = $Create.Nullable($$createType34);
-const $$createType36 = $Create.Array($$createType35);
-const $$createType37 = PruningDate.createFrom;
-const $$createType38 = $Create.Array($$createType37);
-const $$createType39 = LocationUnion.createFrom;
-const $$createType40 = statemachine$0.RepositoryStateUnion.createFrom;
-const $$createType41 = types$0.LastBackup.createFrom;
-const $$createType42 = $Create.Nullable($$createType41);
-const $$createType43 = types$0.LastAttempt.createFrom;
-const $$createType44 = $Create.Nullable($$createType43);
-const $$createType45 = $Create.Array($Create.Any);
-const $$createType46 = SerializableQueuedOperation.createFrom;
+const $$createType36 = Running.createFrom;
+const $$createType37 = $Create.Nullable($$createType36);
+const $$createType38 = Completed.createFrom;
+const $$createType39 = $Create.Nullable($$createType38);
+const $$createType40 = Failed.createFrom;
+const $$createType41 = $Create.Nullable($$createType40);
+const $$createType42 = Expired.createFrom;
+const $$createType43 = $Create.Nullable($$createType42);
+const $$createType44 = BackupProfileFilter.createFrom;
+const $$createType45 = $Create.Nullable($$createType44);
+const $$createType46 = ArchiveWithPendingChanges.createFrom;
 const $$createType47 = $Create.Nullable($$createType46);
 const $$createType48 = $Create.Array($$createType47);
-const $$createType49 = Progress.createFrom;
-const $$createType50 = $Create.Nullable($$createType49);
-const $$createType51 = statemachine$0.OperationUnion.createFrom;
-const $$createType52 = OperationStatusUnion.createFrom;
+const $$createType49 = PruningDate.createFrom;
+const $$createType50 = $Create.Array($$createType49);
+const $$createType51 = LocationUnion.createFrom;
+const $$createType52 = statemachine$0.RepositoryStateUnion.createFrom;
+const $$createType53 = types$1.LastBackup.createFrom;
+const $$createType54 = $Create.Nullable($$createType53);
+const $$createType55 = types$1.LastAttempt.createFrom;
+const $$createType56 = $Create.Nullable($$createType55);
+const $$createType57 = CheckSchedule.createFrom;
+const $$createType58 = VerificationCoverage.createFrom;
+const $$createType59 = RepositoryStatsPoint.createFrom;
+const $$createType60 = $Create.Array($$createType59);
+const $$createType61 = SerializableQueuedOperation.createFrom;
+const $$createType62 = $Create.Nullable($$createType61);
+const $$createType63 = $Create.Array($$createType62);
+const $$createType64 = RestorePlanEntry.createFrom;
+const $$createType65 = $Create.Array($$createType64);
+const $$createType66 = Progress.createFrom;
+const $$createType67 = $Create.Nullable($$createType66);
+const $$createType68 = statemachine$0.OperationUnion.createFrom;
+const $$createType69 = OperationStatusUnion.createFrom;
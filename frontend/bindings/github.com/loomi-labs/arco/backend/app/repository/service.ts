@@ -104,12 +104,22 @@ export function Delete(id: number): $CancellablePromise<void> {
     return $Call.ByID(1592048265, id);
 }
 
+/**
+ * DiffArchives compares two archives of the same repository and returns a page of the changed paths.
+ * The changes are streamed from borg, so only the entries of the requested page are kept in memory.
+ */
+export function DiffArchives(req: $models.ArchiveDiffRequest | null): $CancellablePromise<$models.ArchiveDiff | null> {
+    return $Call.ByID(313179178, req).then(($result: any) => {
+        return $$createType5($result);
+    });
+}
+
 /**
  * ExaminePrunes analyzes what would be pruned with given rules
  */
 export function ExaminePrunes(backupProfileId: number, pruningRule: backup_profile$0.PruningRule | null, saveResults: boolean): $CancellablePromise<$models.ExaminePruningResult[]> {
     return $Call.ByID(166629156, backupProfileId, pruningRule, saveResults).then(($result: any) => {
-        return $$createType5($result);
+        return $$createType7($result);
     });
 }
 
@@ -118,7 +128,7 @@ export function ExaminePrunes(backupProfileId: number, pruningRule: backup_profi
  */
 export function FixStoredPassword(repoId: number, password: string): $CancellablePromise<$models.FixStoredPasswordResult> {
     return $Call.ByID(2845614759, repoId, password).then(($result: any) => {
-        return $$createType6($result);
+        return $$createType8($result);
     });
 }
 
@@ -133,7 +143,17 @@ export function Get(repoId: number): $CancellablePromise<$models.Repository | nu
 
 export function GetActiveOperation(repoId: number, operationType: statemachine$0.OperationType | null): $CancellablePromise<$models.SerializableQueuedOperation | null> {
     return $Call.ByID(3370809829, repoId, operationType).then(($result: any) => {
-        return $$createType8($result);
+        return $$createType10($result);
+    });
+}
+
+/**
+ * GetArchiveDirectory returns a page of the files and directories inside a directory of an archive.
+ * This works without mounting the archive, so it can be used on systems without FUSE.
+ */
+export function GetArchiveDirectory(req: $models.ArchiveDirectoryRequest | null): $CancellablePromise<$models.ArchiveDirectoryResponse | null> {
+    return $Call.ByID(567359283, req).then(($result: any) => {
+        return $$createType12($result);
     });
 }
 
@@ -144,12 +164,20 @@ export function GetBackupButtonStatus(backupIds: types$0.BackupId[]): $Cancellab
     return $Call.ByID(1281128506, backupIds);
 }
 
+/**
+ * GetBackupHoldReason returns why a queued backup of the given backup IDs can't start yet (e.g. a blackout period).
+ * It returns an empty string if none of the backups is held.
+ */
+export function GetBackupHoldReason(backupIds: types$0.BackupId[]): $CancellablePromise<string> {
+    return $Call.ByID(1040874221, backupIds);
+}
+
 /**
  * GetBackupProfilesThatHaveOnlyRepo gets backup profiles that only have this repo
  */
 export function GetBackupProfilesThatHaveOnlyRepo(repoId: number): $CancellablePromise<(ent$0.BackupProfile | null)[]> {
     return $Call.ByID(2432944859, repoId).then(($result: any) => {
-        return $$createType11($result);
+        return $$createType15($result);
     });
 }
 
@@ -158,7 +186,7 @@ export function GetBackupProfilesThatHaveOnlyRepo(repoId: number): $CancellableP
  */
 export function GetBackupState(backupId: types$0.BackupId): $CancellablePromise<statemachine$0.Backup | null> {
     return $Call.ByID(2620182497, backupId).then(($result: any) => {
-        return $$createType13($result);
+        return $$createType17($result);
     });
 }
 
@@ -167,7 +195,7 @@ export function GetBackupState(backupId: types$0.BackupId): $CancellablePromise<
  */
 export function GetCombinedBackupProgress(backupIds: types$0.BackupId[]): $CancellablePromise<types$1.BackupProgress | null> {
     return $Call.ByID(3581877548, backupIds).then(($result: any) => {
-        return $$createType15($result);
+        return $$createType19($result);
     });
 }
 
@@ -176,7 +204,18 @@ export function GetCombinedBackupProgress(backupIds: types$0.BackupId[]): $Cance
  */
 export function GetConnectedRemoteHosts(): $CancellablePromise<string[]> {
     return $Call.ByID(423138286).then(($result: any) => {
-        return $$createType16($result);
+        return $$createType20($result);
+    });
+}
+
+/**
+ * GetFileHistory returns every version of a file across the archives of a backup profile in a repository.
+ * The file index is used for indexed archives, all other archives are listed with borg (one call per archive).
+ * The call can be canceled by the frontend while the archives are listed.
+ */
+export function GetFileHistory(backupId: types$0.BackupId, path: string): $CancellablePromise<$models.FileHistory | null> {
+    return $Call.ByID(3482988508, backupId, path).then(($result: any) => {
+        return $$createType22($result);
     });
 }
 
@@ -186,7 +225,7 @@ export function GetConnectedRemoteHosts(): $CancellablePromise<string[]> {
  */
 export function GetFilteredArchiveIds(req: $models.PaginatedArchivesRequest | null): $CancellablePromise<number[]> {
     return $Call.ByID(2154529177, req).then(($result: any) => {
-        return $$createType17($result);
+        return $$createType23($result);
     });
 }
 
@@ -195,7 +234,7 @@ export function GetFilteredArchiveIds(req: $models.PaginatedArchivesRequest | nu
  */
 export function GetLastArchiveByBackupId(backupId: types$0.BackupId): $CancellablePromise<ent$0.Archive | null> {
     return $Call.ByID(2844713878, backupId).then(($result: any) => {
-        return $$createType19($result);
+        return $$createType25($result);
     });
 }
 
@@ -204,7 +243,7 @@ export function GetLastArchiveByBackupId(backupId: types$0.BackupId): $Cancellab
  */
 export function GetLastArchiveByRepoId(repoId: number): $CancellablePromise<ent$0.Archive | null> {
     return $Call.ByID(3556071828, repoId).then(($result: any) => {
-        return $$createType19($result);
+        return $$createType25($result);
     });
 }
 
@@ -213,7 +252,7 @@ export function GetLastArchiveByRepoId(repoId: number): $CancellablePromise<ent$
  */
 export function GetPaginatedArchives(req: $models.PaginatedArchivesRequest | null): $CancellablePromise<$models.PaginatedArchivesResponse | null> {
     return $Call.ByID(3644900762, req).then(($result: any) => {
-        return $$createType21($result);
+        return $$createType27($result);
     });
 }
 
@@ -222,7 +261,7 @@ export function GetPaginatedArchives(req: $models.PaginatedArchivesRequest | nul
  */
 export function GetPruningDates(archiveIds: number[]): $CancellablePromise<$models.PruningDates> {
     return $Call.ByID(2102076250, archiveIds).then(($result: any) => {
-        return $$createType22($result);
+        return $$createType28($result);
     });
 }
 
@@ -231,7 +270,26 @@ export function GetPruningDates(archiveIds: number[]): $CancellablePromise<$mode
  */
 export function GetQueuedOperations(repoId: number, operationType: statemachine$0.OperationType | null): $CancellablePromise<($models.SerializableQueuedOperation | null)[]> {
     return $Call.ByID(1376269121, repoId, operationType).then(($result: any) => {
-        return $$createType23($result);
+        return $$createType29($result);
+    });
+}
+
+/**
+ * GetRepositoryGrowth returns the storage usage history of a repository within the last days
+ * and projects when the target runs out of space (only for local repositories)
+ */
+export function GetRepositoryGrowth(repoId: number, days: number): $CancellablePromise<$models.RepositoryGrowth | null> {
+    return $Call.ByID(2788170481, repoId, days).then(($result: any) => {
+        return $$createType31($result);
+    });
+}
+
+/**
+ * GetRestorePlan returns what a restore would do with every file without restoring anything
+ */
+export function GetRestorePlan(req: $models.RestoreRequest | null): $CancellablePromise<$models.RestorePlan | null> {
+    return $Call.ByID(1323157539, req).then(($result: any) => {
+        return $$createType33($result);
     });
 }
 
@@ -240,7 +298,7 @@ export function GetQueuedOperations(repoId: number, operationType: statemachine$
  */
 export function GetWithQueue(repoId: number): $CancellablePromise<$models.RepositoryWithQueue | null> {
     return $Call.ByID(144266353, repoId).then(($result: any) => {
-        return $$createType25($result);
+        return $$createType35($result);
     });
 }
 
@@ -256,7 +314,7 @@ export function IsBorgRepository(path: string): $CancellablePromise<boolean> {
  */
 export function Mount(repoId: number): $CancellablePromise<$models.MountResult | null> {
     return $Call.ByID(967190463, repoId).then(($result: any) => {
-        return $$createType27($result);
+        return $$createType37($result);
     });
 }
 
@@ -265,7 +323,7 @@ export function Mount(repoId: number): $CancellablePromise<$models.MountResult |
  */
 export function MountArchive(archiveId: number): $CancellablePromise<$models.MountResult | null> {
     return $Call.ByID(1226599023, archiveId).then(($result: any) => {
-        return $$createType27($result);
+        return $$createType37($result);
     });
 }
 
@@ -291,7 +349,7 @@ export function QueueArchiveRename(archiveId: number, name: string): $Cancellabl
 }
 
 /**
- * QueueBackup queues a backup operation
+ * QueueBackup queues a backup operation that doesn't wait for the allowed hours and blackout periods of its schedule
  */
 export function QueueBackup(backupId: types$0.BackupId): $CancellablePromise<string> {
     return $Call.ByID(875725915, backupId);
@@ -302,7 +360,7 @@ export function QueueBackup(backupId: types$0.BackupId): $CancellablePromise<str
  */
 export function QueueBackups(backupIds: types$0.BackupId[]): $CancellablePromise<string[]> {
     return $Call.ByID(1401293560, backupIds).then(($result: any) => {
-        return $$createType16($result);
+        return $$createType20($result);
     });
 }
 
@@ -313,6 +371,36 @@ export function QueueCheck(repoId: number, quickVerification: boolean): $Cancell
     return $Call.ByID(4176731931, repoId, quickVerification);
 }
 
+/**
+ * QueueExportArchive queues an export operation that writes archive contents into a tar file
+ */
+export function QueueExportArchive(req: $models.ExportArchiveRequest | null): $CancellablePromise<string> {
+    return $Call.ByID(1490451855, req);
+}
+
+/**
+ * QueueFileVersionRestore queues the restore of a single file as it was stored in an archive.
+ * Without a destination the file is restored to its original location.
+ */
+export function QueueFileVersionRestore(req: $models.FileVersionRestoreRequest | null): $CancellablePromise<string> {
+    return $Call.ByID(1998243013, req);
+}
+
+/**
+ * QueueImportTar queues an import operation that creates an archive from a tar file.
+ * The archive belongs to the backup profile (through its prefix) and is therefore also pruned with its rules.
+ */
+export function QueueImportTar(req: $models.ImportTarRequest | null): $CancellablePromise<string> {
+    return $Call.ByID(2719527615, req);
+}
+
+/**
+ * QueuePartialCheck queues a full check (--verify-data) that only verifies the data of the selected archives
+ */
+export function QueuePartialCheck(repoId: number, req: $models.PartialCheckRequest | null): $CancellablePromise<string> {
+    return $Call.ByID(3583474102, repoId, req);
+}
+
 /**
  * QueuePrune queues a prune operation
  */
@@ -320,6 +408,13 @@ export function QueuePrune(backupId: types$0.BackupId): $CancellablePromise<stri
     return $Call.ByID(295202139, backupId);
 }
 
+/**
+ * QueueRestore queues a restore operation that extracts archive contents into a directory or back to their original location
+ */
+export function QueueRestore(req: $models.RestoreRequest | null): $CancellablePromise<string> {
+    return $Call.ByID(1520049565, req);
+}
+
 /**
  * RefreshArchives refreshes all archives of a repository
  */
@@ -341,6 +436,34 @@ export function Remove(id: number): $CancellablePromise<void> {
     return $Call.ByID(2560302714, id);
 }
 
+/**
+ * SearchFiles searches the file index of a repository and returns every archive that contains matching files.
+ * Query matches any part of the path (case-insensitive) and glob is a shell-style pattern (e.g. "*.docx").
+ * At least one of them is required. Results are sorted from newest to oldest archive.
+ */
+export function SearchFiles(repoId: number, query: string, glob: string): $CancellablePromise<($models.FileSearchResult | null)[]> {
+    return $Call.ByID(3146690113, repoId, query, glob).then(($result: any) => {
+        return $$createType40($result);
+    });
+}
+
+/**
+ * SetCheckSchedule sets how often a repository is checked automatically.
+ * An interval of 0 disables the scheduled check of that type.
+ * If rollingCheckArchives is set, each scheduled full check only verifies that many archives (the least recently verified first).
+ */
+export function SetCheckSchedule(repoId: number, quickCheckIntervalDays: number, fullCheckIntervalWeeks: number, rollingCheckArchives: number): $CancellablePromise<void> {
+    return $Call.ByID(2686290323, repoId, quickCheckIntervalDays, fullCheckIntervalWeeks, rollingCheckArchives);
+}
+
+/**
+ * SetFileIndexEnabled enables or disables the local file index of a repository.
+ * Enabling queues the indexing of all existing archives (one archive per operation), disabling removes the index.
+ */
+export function SetFileIndexEnabled(repoId: number, enabled: boolean): $CancellablePromise<void> {
+    return $Call.ByID(2440593229, repoId, enabled);
+}
+
 /**
  * TestPathConnection tests if a path can connect to a valid borg repository.
  * Requires repository to be in Idle state (for borg call).
@@ -351,7 +474,7 @@ export function Remove(id: number): $CancellablePromise<void> {
  */
 export function TestPathConnection(repoId: number, newPath: string, password: string): $CancellablePromise<$models.ValidatePathChangeResult | null> {
     return $Call.ByID(971940321, repoId, newPath, password).then(($result: any) => {
-        return $$createType29($result);
+        return $$createType42($result);
     });
 }
 
@@ -360,7 +483,7 @@ export function TestPathConnection(repoId: number, newPath: string, password: st
  */
 export function TestRepoConnection(path: string, password: string): $CancellablePromise<$models.TestRepoConnectionResult> {
     return $Call.ByID(1151269054, path, password).then(($result: any) => {
-        return $$createType30($result);
+        return $$createType43($result);
     });
 }
 
@@ -376,7 +499,7 @@ export function Unmount(repoId: number): $CancellablePromise<string> {
  */
 export function UnmountAllForRepos(repoIds: number[]): $CancellablePromise<any[]> {
     return $Call.ByID(1105783937, repoIds).then(($result: any) => {
-        return $$createType31($result);
+        return $$createType44($result);
     });
 }
 
@@ -411,7 +534,7 @@ export function ValidateArchiveName(archiveId: number, name: string): $Cancellab
  */
 export function ValidatePathChange(repoId: number, newPath: string): $CancellablePromise<$models.ValidatePathChangeResult | null> {
     return $Call.ByID(2418041363, repoId, newPath).then(($result: any) => {
-        return $$createType29($result);
+        return $$createType42($result);
     });
 }
 
@@ -434,31 +557,44 @@ const $$createType0 = $models.Repository.createFrom;
 const $$createType1 = $Create.Nullable($$createType0);
 const $$createType2 = $Create.Array($$createType1);
 const $$createType3 = $models.ChangePassphraseResult.createFrom;
-const $$createType4 = $models.ExaminePruningResult.createFrom;
-const $$createType5 = $Create.Array($$createType4);
-const $$createType6 = $models.FixStoredPasswordResult.createFrom;
-const $$createType7 = $models.SerializableQueuedOperation.createFrom;
-const $$createType8 = $Create.Nullable($$createType7);
-const $$createType9 = ent$0.BackupProfile.createFrom;
+const $$createType4 = $models.ArchiveDiff.createFrom;
+const $$createType5 = $Create.Nullable($$createType4);
+const $$createType6 = $models.ExaminePruningResult.createFrom;
+const $$createType7 = $Create.Array($$createType6);
+const $$createType8 = $models.FixStoredPasswordResult.createFrom;
+const $$createType9 = $models.SerializableQueuedOperation.createFrom;
 const $$createType10 = $Create.Nullable($$createType9);
-const $$createType11 = $Create.Array($$createType10);
-const $$createType12 = statemachine$0.Backup.createFrom;
-const $$createType13 = $Create.Nullable($$createType12);
-const $$createType14 = types$1.BackupProgress.createFrom;
-const $$createType15 = $Create.Nullable($$createType14);
-const $$createType16 = $Create.Array($Create.Any);
-const $$createType17 = $Create.Array($Create.Any);
-const $$createType18 = ent$0.Archive.createFrom;
+const $$createType11 = $models.ArchiveDirectoryResponse.createFrom;
+const $$createType12 = $Create.Nullable($$createType11);
+const $$createType13 = ent$0.BackupProfile.createFrom;
+const $$createType14 = $Create.Nullable($$createType13);
+const $$createType15 = $Create.Array($$createType14);
+const $$createType16 = statemachine$0.Backup.createFrom;
+const $$createType17 = $Create.Nullable($$createType16);
+const $$createType18 = types$1.BackupProgress.createFrom;
 const $$createType19 = $Create.Nullable($$createType18);
-const $$createType20 = $models.PaginatedArchivesResponse.createFrom;
-const $$createType21 = $Create.Nullable($$createType20);
-const $$createType22 = $models.PruningDates.createFrom;
-const $$createType23 = $Create.Array($$createType8);
-const $$createType24 = $models.RepositoryWithQueue.createFrom;
+const $$createType20 = $Create.Array($Create.Any);
+const $$createType21 = $models.FileHistory.createFrom;
+const $$createType22 = $Create.Nullable($$createType21);
+const $$createType23 = $Create.Array($Create.Any);
+const $$createType24 = ent$0.Archive.createFrom;
 const $$createType25 = $Create.Nullable($$createType24);
-const $$createType26 = $models.MountResult.createFrom;
+const $$createType26 = $models.PaginatedArchivesResponse.createFrom;
 const $$createType27 = $Create.Nullable($$createType26);
-const $$createType28 = $models.ValidatePathChangeResult.createFrom;
-const $$createType29 = $Create.Nullable($$createType28);
-const $$createType30 = $models.TestRepoConnectionResult.createFrom;
-const $$createType31 = $Create.Array($Create.Any);
+const $$createType28 = $models.PruningDates.createFrom;
+const $$createType29 = $Create.Array($$createType10);
+const $$createType30 = $models.RepositoryGrowth.createFrom;
+const $$createType31 = $Create.Nullable($$createType30);
+const $$createType32 = $models.RestorePlan.createFrom;
+const $$createType33 = $Create.Nullable($$createType32);
+const $$createType34 = $models.RepositoryWithQueue.createFrom;
+const $$createType35 = $Create.Nullable($$createType34);
+const $$createType36 = $models.MountResult.createFrom;
+const $$createType37 = $Create.Nullable($$createType36);
+const $$createType38 = $models.FileSearchResult.createFrom;
+const $$createType39 = $Create.Nullable($$createType38);
+const $$createType40 = $Create.Array($$createType39);
+const $$createType41 = $models.ValidatePathChangeResult.createFrom;
+const $$createType42 = $Create.Nullable($$createType41);
+const $$createType43 = $models.TestRepoConnectionResult.createFrom;
+const $$createType44 = $Create.Array($Create.Any);
@@ -16,7 +16,12 @@ export {
     ErrorAction,
     ErrorType,
     ExaminePrune,
+    ExportArchive,
+    Exporting,
     Idle,
+    ImportTar,
+    Importing,
+    IndexFiles,
     Mount,
     MountArchive,
     MountInfo,
@@ -31,6 +36,9 @@ export {
     Refreshing,
     RepositoryStateType,
     RepositoryStateUnion,
+    Restore,
+    RestoreConflictPolicy,
+    Restoring,
     Unmount,
     UnmountArchive
 } from "./models.js";
@@ -170,6 +170,21 @@ export class Check {
     "repositoryId": number;
     "quickVerification": boolean;
 
+    /**
+     * Only verify the data of archives matching this pattern (full checks only)
+     */
+    "globArchives": string;
+
+    /**
+     * Only verify the data of the newest N archives (full checks only)
+     */
+    "lastArchives": number;
+
+    /**
+     * Verify the data of the next N archives of the verification cycle (full checks only)
+     */
+    "rollingArchives": number;
+
     /** Creates a new Check instance. */
     constructor($$source: Partial<Check> = {}) {
         if (!("repositoryId" in $$source)) {
@@ -178,6 +193,15 @@ export class Check {
         if (!("quickVerification" in $$source)) {
             this["quickVerification"] = false;
         }
+        if (!("globArchives" in $$source)) {
+            this["globArchives"] = "";
+        }
+        if (!("lastArchives" in $$source)) {
+            this["lastArchives"] = 0;
+        }
+        if (!("rollingArchives" in $$source)) {
+            this["rollingArchives"] = 0;
+        }
 
         Object.assign(this, $$source);
     }
@@ -358,6 +382,97 @@ export class ExaminePrune {
     }
 }
 
+export class ExportArchive {
+    "archiveId": number;
+
+    /**
+     * Absolute path of the tar file that is created
+     */
+    "outputPath": string;
+
+    /**
+     * Tar format (and compression) of the output file
+     */
+    "format": types$1.TarFormat;
+
+    /**
+     * Archive paths to export (everything if empty)
+     */
+    "paths": string[];
+
+    /**
+     * Patterns that are not exported
+     */
+    "excludePaths": string[];
+    "progress"?: types$1.ExtractProgress | null;
+
+    /** Creates a new ExportArchive instance. */
+    constructor($$source: Partial<ExportArchive> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("outputPath" in $$source)) {
+            this["outputPath"] = "";
+        }
+        if (!("format" in $$source)) {
+            this["format"] = types$1.TarFormat.$zero;
+        }
+        if (!("paths" in $$source)) {
+            this["paths"] = [];
+        }
+        if (!("excludePaths" in $$source)) {
+            this["excludePaths"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ExportArchive instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ExportArchive {
+        const $$createField3_0 = $$createType6;
+        const $$createField4_0 = $$createType6;
+        const $$createField5_0 = $$createType8;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("paths" in $$parsedSource) {
+            $$parsedSource["paths"] = $$createField3_0($$parsedSource["paths"]);
+        }
+        if ("excludePaths" in $$parsedSource) {
+            $$parsedSource["excludePaths"] = $$createField4_0($$parsedSource["excludePaths"]);
+        }
+        if ("progress" in $$parsedSource) {
+            $$parsedSource["progress"] = $$createField5_0($$parsedSource["progress"]);
+        }
+        return new ExportArchive($$parsedSource as Partial<ExportArchive>);
+    }
+}
+
+export class Exporting {
+    "archiveId": number;
+    "startedAt": string;
+
+    /** Creates a new Exporting instance. */
+    constructor($$source: Partial<Exporting> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new Exporting instance from a string or object.
+     */
+    static createFrom($$source: any = {}): Exporting {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new Exporting($$parsedSource as Partial<Exporting>);
+    }
+}
+
 /**
  * State variant structs
  */
@@ -378,6 +493,108 @@ export class Idle {
     }
 }
 
+export class ImportTar {
+    /**
+     * Backup profile (prefix, compression) and repository of the new archive
+     */
+    "backupId": types$0.BackupId;
+
+    /**
+     * Absolute path of the tar file that is imported
+     */
+    "tarPath": string;
+
+    /**
+     * Comment of the new archive
+     */
+    "comment": string;
+
+    /**
+     * Creation time of the new archive (now if nil)
+     */
+    "timestamp"?: string | null;
+
+    /** Creates a new ImportTar instance. */
+    constructor($$source: Partial<ImportTar> = {}) {
+        if (!("backupId" in $$source)) {
+            this["backupId"] = (new types$0.BackupId());
+        }
+        if (!("tarPath" in $$source)) {
+            this["tarPath"] = "";
+        }
+        if (!("comment" in $$source)) {
+            this["comment"] = "";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ImportTar instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ImportTar {
+        const $$createField0_0 = $$createType1;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("backupId" in $$parsedSource) {
+            $$parsedSource["backupId"] = $$createField0_0($$parsedSource["backupId"]);
+        }
+        return new ImportTar($$parsedSource as Partial<ImportTar>);
+    }
+}
+
+export class Importing {
+    "backupId": types$0.BackupId;
+    "startedAt": string;
+
+    /** Creates a new Importing instance. */
+    constructor($$source: Partial<Importing> = {}) {
+        if (!("backupId" in $$source)) {
+            this["backupId"] = (new types$0.BackupId());
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new Importing instance from a string or object.
+     */
+    static createFrom($$source: any = {}): Importing {
+        const $$createField0_0 = $$createType1;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("backupId" in $$parsedSource) {
+            $$parsedSource["backupId"] = $$createField0_0($$parsedSource["backupId"]);
+        }
+        return new Importing($$parsedSource as Partial<Importing>);
+    }
+}
+
+export class IndexFiles {
+    /**
+     * Adds the files of the newest archive that is not indexed yet to the file index
+     */
+    "repositoryId": number;
+
+    /** Creates a new IndexFiles instance. */
+    constructor($$source: Partial<IndexFiles> = {}) {
+        if (!("repositoryId" in $$source)) {
+            this["repositoryId"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new IndexFiles instance from a string or object.
+     */
+    static createFrom($$source: any = {}): IndexFiles {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new IndexFiles($$parsedSource as Partial<IndexFiles>);
+    }
+}
+
 export class Mount {
     "repositoryId": number;
     "mountPath": string;
@@ -486,7 +703,7 @@ export class Mounted {
      * Creates a new Mounted instance from a string or object.
      */
     static createFrom($$source: any = {}): Mounted {
-        const $$createField0_0 = $$createType7;
+        const $$createField0_0 = $$createType10;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("mounts" in $$parsedSource) {
             $$parsedSource["mounts"] = $$createField0_0($$parsedSource["mounts"]);
@@ -534,9 +751,13 @@ export enum OperationType {
     OperationTypeCheck = "Check",
     OperationTypeDelete = "Delete",
     OperationTypeExaminePrune = "ExaminePrune",
+    OperationTypeExportArchive = "ExportArchive",
+    OperationTypeImportTar = "ImportTar",
+    OperationTypeIndexFiles = "IndexFiles",
     OperationTypeMount = "Mount",
     OperationTypeMountArchive = "MountArchive",
     OperationTypePrune = "Prune",
+    OperationTypeRestore = "Restore",
     OperationTypeUnmount = "Unmount",
     OperationTypeUnmountArchive = "UnmountArchive",
 };
@@ -566,6 +787,10 @@ export class OperationUnion {
     "unmountArchive"?: UnmountArchive | null;
     "examinePrune"?: ExaminePrune | null;
     "check"?: Check | null;
+    "restore"?: Restore | null;
+    "exportArchive"?: ExportArchive | null;
+    "importTar"?: ImportTar | null;
+    "indexFiles"?: IndexFiles | null;
 
     /** Creates a new OperationUnion instance. */
     constructor($$source: Partial<OperationUnion> = {}) {
@@ -580,19 +805,23 @@ export class OperationUnion {
      * Creates a new OperationUnion instance from a string or object.
      */
     static createFrom($$source: any = {}): OperationUnion {
-        const $$createField1_0 = $$createType8;
-        const $$createField2_0 = $$createType10;
-        const $$createField3_0 = $$createType12;
-        const $$createField4_0 = $$createType14;
-        const $$createField5_0 = $$createType16;
-        const $$createField6_0 = $$createType18;
-        const $$createField7_0 = $$createType20;
-        const $$createField8_0 = $$createType22;
-        const $$createField9_0 = $$createType24;
-        const $$createField10_0 = $$createType26;
-        const $$createField11_0 = $$createType28;
-        const $$createField12_0 = $$createType30;
-        const $$createField13_0 = $$createType32;
+        const $$createField1_0 = $$createType11;
+        const $$createField2_0 = $$createType13;
+        const $$createField3_0 = $$createType15;
+        const $$createField4_0 = $$createType17;
+        const $$createField5_0 = $$createType19;
+        const $$createField6_0 = $$createType21;
+        const $$createField7_0 = $$createType23;
+        const $$createField8_0 = $$createType25;
+        const $$createField9_0 = $$createType27;
+        const $$createField10_0 = $$createType29;
+        const $$createField11_0 = $$createType31;
+        const $$createField12_0 = $$createType33;
+        const $$createField13_0 = $$createType35;
+        const $$createField14_0 = $$createType37;
+        const $$createField15_0 = $$createType39;
+        const $$createField16_0 = $$createType41;
+        const $$createField17_0 = $$createType43;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backup" in $$parsedSource) {
             $$parsedSource["backup"] = $$createField1_0($$parsedSource["backup"]);
@@ -633,6 +862,18 @@ export class OperationUnion {
         if ("check" in $$parsedSource) {
             $$parsedSource["check"] = $$createField13_0($$parsedSource["check"]);
         }
+        if ("restore" in $$parsedSource) {
+            $$parsedSource["restore"] = $$createField14_0($$parsedSource["restore"]);
+        }
+        if ("exportArchive" in $$parsedSource) {
+            $$parsedSource["exportArchive"] = $$createField15_0($$parsedSource["exportArchive"]);
+        }
+        if ("importTar" in $$parsedSource) {
+            $$parsedSource["importTar"] = $$createField16_0($$parsedSource["importTar"]);
+        }
+        if ("indexFiles" in $$parsedSource) {
+            $$parsedSource["indexFiles"] = $$createField17_0($$parsedSource["indexFiles"]);
+        }
         return new OperationUnion($$parsedSource as Partial<OperationUnion>);
     }
 }
@@ -707,7 +948,7 @@ export class Queued {
      * Creates a new Queued instance from a string or object.
      */
     static createFrom($$source: any = {}): Queued {
-        const $$createField0_0 = $$createType33;
+        const $$createField0_0 = $$createType44;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("nextOperation" in $$parsedSource) {
             $$parsedSource["nextOperation"] = $$createField0_0($$parsedSource["nextOperation"]);
@@ -750,12 +991,15 @@ export enum RepositoryStateType {
     RepositoryStateTypeChecking = "Checking",
     RepositoryStateTypeDeleting = "Deleting",
     RepositoryStateTypeError = "Error",
+    RepositoryStateTypeExporting = "Exporting",
     RepositoryStateTypeIdle = "Idle",
+    RepositoryStateTypeImporting = "Importing",
     RepositoryStateTypeMounted = "Mounted",
     RepositoryStateTypeMounting = "Mounting",
     RepositoryStateTypePruning = "Pruning",
     RepositoryStateTypeQueued = "Queued",
     RepositoryStateTypeRefreshing = "Refreshing",
+    RepositoryStateTypeRestoring = "Restoring",
 };
 
 /**
@@ -777,6 +1021,9 @@ export class RepositoryStateUnion {
     "deleting"?: Deleting | null;
     "refreshing"?: Refreshing | null;
     "checking"?: Checking | null;
+    "restoring"?: Restoring | null;
+    "exporting"?: Exporting | null;
+    "importing"?: Importing | null;
     "mounting"?: Mounting | null;
     "mounted"?: Mounted | null;
     "error"?: Error | null;
@@ -794,16 +1041,19 @@ export class RepositoryStateUnion {
      * Creates a new RepositoryStateUnion instance from a string or object.
      */
     static createFrom($$source: any = {}): RepositoryStateUnion {
-        const $$createField1_0 = $$createType35;
-        const $$createField2_0 = $$createType37;
-        const $$createField3_0 = $$createType39;
-        const $$createField4_0 = $$createType41;
-        const $$createField5_0 = $$createType43;
-        const $$createField6_0 = $$createType45;
-        const $$createField7_0 = $$createType47;
-        const $$createField8_0 = $$createType49;
-        const $$createField9_0 = $$createType51;
-        const $$createField10_0 = $$createType53;
+        const $$createField1_0 = $$createType46;
+        const $$createField2_0 = $$createType48;
+        const $$createField3_0 = $$createType50;
+        const $$createField4_0 = $$createType52;
+        const $$createField5_0 = $$createType54;
+        const $$createField6_0 = $$createType56;
+        const $$createField7_0 = $$createType58;
+        const $$createField8_0 = $$createType60;
+        const $$createField9_0 = $$createType62;
+        const $$createField10_0 = $$createType64;
+        const $$createField11_0 = $$createType66;
+        const $$createField12_0 = $$createType68;
+        const $$createField13_0 = $$createType70;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("idle" in $$parsedSource) {
             $$parsedSource["idle"] = $$createField1_0($$parsedSource["idle"]);
@@ -826,19 +1076,157 @@ export class RepositoryStateUnion {
         if ("checking" in $$parsedSource) {
             $$parsedSource["checking"] = $$createField7_0($$parsedSource["checking"]);
         }
+        if ("restoring" in $$parsedSource) {
+            $$parsedSource["restoring"] = $$createField8_0($$parsedSource["restoring"]);
+        }
+        if ("exporting" in $$parsedSource) {
+            $$parsedSource["exporting"] = $$createField9_0($$parsedSource["exporting"]);
+        }
+        if ("importing" in $$parsedSource) {
+            $$parsedSource["importing"] = $$createField10_0($$parsedSource["importing"]);
+        }
         if ("mounting" in $$parsedSource) {
-            $$parsedSource["mounting"] = $$createField8_0($$parsedSource["mounting"]);
+            $$parsedSource["mounting"] = $$createField11_0($$parsedSource["mounting"]);
         }
         if ("mounted" in $$parsedSource) {
-            $$parsedSource["mounted"] = $$createField9_0($$parsedSource["mounted"]);
+            $$parsedSource["mounted"] = $$createField12_0($$parsedSource["mounted"]);
         }
         if ("error" in $$parsedSource) {
-            $$parsedSource["error"] = $$createField10_0($$parsedSource["error"]);
+            $$parsedSource["error"] = $$createField13_0($$parsedSource["error"]);
         }
         return new RepositoryStateUnion($$parsedSource as Partial<RepositoryStateUnion>);
     }
 }
 
+export class Restore {
+    "archiveId": number;
+
+    /**
+     * Directory the archive contents are extracted into ("/" for the original location)
+     */
+    "destination": string;
+
+    /**
+     * Archive paths to restore (everything if empty)
+     */
+    "paths": string[];
+
+    /**
+     * Patterns that are not restored
+     */
+    "excludePaths": string[];
+
+    /**
+     * Number of leading path elements to remove
+     */
+    "stripComponents": number;
+
+    /**
+     * How to handle files that already exist at the destination
+     */
+    "conflictPolicy": RestoreConflictPolicy;
+    "progress"?: types$1.ExtractProgress | null;
+
+    /** Creates a new Restore instance. */
+    constructor($$source: Partial<Restore> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("destination" in $$source)) {
+            this["destination"] = "";
+        }
+        if (!("paths" in $$source)) {
+            this["paths"] = [];
+        }
+        if (!("excludePaths" in $$source)) {
+            this["excludePaths"] = [];
+        }
+        if (!("stripComponents" in $$source)) {
+            this["stripComponents"] = 0;
+        }
+        if (!("conflictPolicy" in $$source)) {
+            this["conflictPolicy"] = RestoreConflictPolicy.$zero;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new Restore instance from a string or object.
+     */
+    static createFrom($$source: any = {}): Restore {
+        const $$createField2_0 = $$createType6;
+        const $$createField3_0 = $$createType6;
+        const $$createField6_0 = $$createType8;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("paths" in $$parsedSource) {
+            $$parsedSource["paths"] = $$createField2_0($$parsedSource["paths"]);
+        }
+        if ("excludePaths" in $$parsedSource) {
+            $$parsedSource["excludePaths"] = $$createField3_0($$parsedSource["excludePaths"]);
+        }
+        if ("progress" in $$parsedSource) {
+            $$parsedSource["progress"] = $$createField6_0($$parsedSource["progress"]);
+        }
+        return new Restore($$parsedSource as Partial<Restore>);
+    }
+}
+
+/**
+ * RestoreConflictPolicy defines how a restore handles files that already exist at the destination
+ */
+export enum RestoreConflictPolicy {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * Replace existing files
+     */
+    RestoreConflictPolicyOverwrite = "overwrite",
+
+    /**
+     * Keep existing files untouched
+     */
+    RestoreConflictPolicySkip = "skip",
+
+    /**
+     * Restore next to existing files with a suffix
+     */
+    RestoreConflictPolicyKeepBoth = "keepBoth",
+
+    /**
+     * Replace existing files only if the archived version is newer
+     */
+    RestoreConflictPolicyOnlyIfNewer = "onlyIfNewer",
+};
+
+export class Restoring {
+    "archiveId": number;
+    "startedAt": string;
+
+    /** Creates a new Restoring instance. */
+    constructor($$source: Partial<Restoring> = {}) {
+        if (!("archiveId" in $$source)) {
+            this["archiveId"] = 0;
+        }
+        if (!("startedAt" in $$source)) {
+            this["startedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new Restoring instance from a string or object.
+     */
+    static createFrom($$source: any = {}): Restoring {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new Restoring($$parsedSource as Partial<Restoring>);
+    }
+}
+
 export class Unmount {
     "repositoryId": number;
     "mountPath": string;
@@ -896,51 +1284,68 @@ const $$createType2 = types$1.BackupProgress.createFrom;
 const $$createType3 = $Create.Nullable($$createType2);
 const $$createType4 = ent$0.PruningRule.createFrom;
 const $$createType5 = $Create.Nullable($$createType4);
-const $$createType6 = MountInfo.createFrom;
-const $$createType7 = $Create.Array($$createType6);
-const $$createType8 = $Create.Nullable($$createType0);
-const $$createType9 = Prune.createFrom;
-const $$createType10 = $Create.Nullable($$createType9);
-const $$createType11 = Delete.createFrom;
-const $$createType12 = $Create.Nullable($$createType11);
-const $$createType13 = ArchiveRefresh.createFrom;
-const $$createType14 = $Create.Nullable($$createType13);
-const $$createType15 = ArchiveDelete.createFrom;
-const $$createType16 = $Create.Nullable($$createType15);
-const $$createType17 = ArchiveRename.createFrom;
-const $$createType18 = $Create.Nullable($$createType17);
-const $$createType19 = ArchiveComment.createFrom;
-const $$createType20 = $Create.Nullable($$createType19);
-const $$createType21 = Mount.createFrom;
-const $$createType22 = $Create.Nullable($$createType21);
-const $$createType23 = MountArchive.createFrom;
-const $$createType24 = $Create.Nullable($$createType23);
-const $$createType25 = Unmount.createFrom;
-const $$createType26 = $Create.Nullable($$createType25);
-const $$createType27 = UnmountArchive.createFrom;
-const $$createType28 = $Create.Nullable($$createType27);
-const $$createType29 = ExaminePrune.createFrom;
-const $$createType30 = $Create.Nullable($$createType29);
-const $$createType31 = Check.createFrom;
-const $$createType32 = $Create.Nullable($$createType31);
-const $$createType33 = OperationUnion.createFrom;
-const $$createType34 = Idle.createFrom;
+const $$createType6 = $Create.Array($Create.Any);
+const $$createType7 = types$1.ExtractProgress.createFrom;
+const $$createType8 = $Create.Nullable($$createType7);
+const $$createType9 = MountInfo.createFrom;
+const $$createType10 = $Create.Array($$createType9);
+const $$createType11 = $Create.Nullable($$createType0);
+const $$createType12 = Prune.createFrom;
+const $$createType13 = $Create.Nullable($$createType12);
+const $$createType14 = Delete.createFrom;
+const $$createType15 = $Create.Nullable($$createType14);
+const $$createType16 = ArchiveRefresh.createFrom;
+const $$createType17 = $Create.Nullable($$createType16);
+const $$createType18 = ArchiveDelete.createFrom;
+const $$createType19 = $Create.Nullable($$createType18);
+const $$createType20 = ArchiveRename.createFrom;
+const $$createType21 = $Create.Nullable($$createType20);
+const $$createType22 = ArchiveComment.createFrom;
+const $$createType23 = $Create.Nullable($$createType22);
+const $$createType24 = Mount.createFrom;
+const $$createType25 = $Create.Nullable($$createType24);
+const $$createType26 = MountArchive.createFrom;
+const $$createType27 = $Create.Nullable($$createType26);
+const $$createType28 = Unmount.createFrom;
+const $$createType29 = $Create.Nullable($$createType28);
+const $$createType30 = UnmountArchive.createFrom;
+const $$createType31 = $Create.Nullable($$createType30);
+const $$createType32 = ExaminePrune.createFrom;
+const $$createType33 = $Create.Nullable($$createType32);
+const $$createType34 = Check.createFrom;
 const $$createType35 = $Create.Nullable($$createType34);
-const $$createType36 = Queued.createFrom;
+const $$createType36 = Restore.createFrom;
 const $$createType37 = $Create.Nullable($$createType36);
-const $$createType38 = BackingUp.createFrom;
+const $$createType38 = ExportArchive.createFrom;
 const $$createType39 = $Create.Nullable($$createType38);
-const $$createType40 = Pruning.createFrom;
+const $$createType40 = ImportTar.createFrom;
 const $$createType41 = $Create.Nullable($$createType40);
-const $$createType42 = Deleting.createFrom;
+const $$createType42 = IndexFiles.createFrom;
 const $$createType43 = $Create.Nullable($$createType42);
-const $$createType44 = Refreshing.createFrom;
-const $$createType45 = $Create.Nullable($$createType44);
-const $$createType46 = Checking.createFrom;
-const $$createType47 = $Create.Nullable($$createType46);
-const $$createType48 = Mounting.createFrom;
-const $$createType49 = $Create.Nullable($$createType48);
-const $$createType50 = Mounted.createFrom;
-const $$createType51 = $Create.Nullable($$createType50);
-const $$createType52 = Error.createFrom;
-const $$createType53 = $Create.Nullable($$createType52);
+const $$createType44 = OperationUnion.createFrom;
+const $$createType45 = Idle.createFrom;
+const $$createType46 = $Create.Nullable($$createType45);
+const $$createType47 = Queued.createFrom;
+const $$createType48 = $Create.Nullable($$createType47);
+const $$createType49 = BackingUp.createFrom;
+const $$createType50 = $Create.Nullable($$createType49);
+const $$createType51 = Pruning.createFrom;
+const $$createType52 = $Create.Nullable($$createType51);
+const $$createType53 = Deleting.createFrom;
+const $$createType54 = $Create.Nullable($$createType53);
+const $$createType55 = Refreshing.createFrom;
+const $$createType56 = $Create.Nullable($$createType55);
+const $$createType57 = Checking.createFrom;
+const $$createType58 = $Create.Nullable($$createType57);
+const $$createType59 = Restoring.createFrom;
+const $$createType60 = $Create.Nullable($$createType59);
+const $$createType61 = Exporting.createFrom;
+const $$createType62 = $Create.Nullable($$createType61);
+const $$createType63 = Importing.createFrom;
+const $$createType64 = $Create.Nullable($$createType63);
+const $$createType65 = Mounting.createFrom;
+const $$createType66 = $Create.Nullable($$createType65);
+const $$createType67 = Mounted.createFrom;
+const $$createType68 = $Create.Nullable($$createType67);
+const $$createType69 = Error.createFrom;
+const $$createType70 = $Create.Nullable($$createType69);
@@ -54,6 +54,8 @@ export enum Event {
     EventNotificationAvailable = "notificationAvailable",
     EventBackupStateChanged = "backupStateChanged",
     EventPruneStateChanged = "pruneStateChanged",
+    EventRestoreStateChanged = "restoreStateChanged",
+    EventExportStateChanged = "exportStateChanged",
     EventRepoStateChanged = "repoStateChanged",
     EventArchivesChanged = "archivesChanged",
     EventBackupProfileCreated = "backupProfileCreated",
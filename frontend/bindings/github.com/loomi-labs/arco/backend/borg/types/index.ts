@@ -2,5 +2,9 @@
 // This file is automatically generated. DO NOT EDIT
 
 export {
-    BackupProgress
+    BackupProgress,
+    DiffChangeType,
+    DiffEntry,
+    ExtractProgress,
+    TarFormat
 } from "./models.js";
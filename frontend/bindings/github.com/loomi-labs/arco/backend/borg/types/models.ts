@@ -6,9 +6,33 @@
 import { Create as $Create } from "@wailsio/runtime";
 
 export class BackupProgress {
+    /**
+     * Estimated number of files, 0 if unknown
+     */
     "totalFiles": number;
     "processedFiles": number;
 
+    /**
+     * Estimated original size, 0 if unknown
+     */
+    "totalBytes": number;
+    "processedBytes": number;
+
+    /**
+     * Smoothed throughput, 0 if unknown
+     */
+    "filesPerSecond": number;
+
+    /**
+     * Smoothed throughput, 0 if unknown
+     */
+    "bytesPerSecond": number;
+
+    /**
+     * Estimated time until the backup is done, nil if unknown
+     */
+    "remainingSeconds"?: number | null;
+
     /** Creates a new BackupProgress instance. */
     constructor($$source: Partial<BackupProgress> = {}) {
         if (!("totalFiles" in $$source)) {
@@ -17,6 +41,18 @@ export class BackupProgress {
         if (!("processedFiles" in $$source)) {
             this["processedFiles"] = 0;
         }
+        if (!("totalBytes" in $$source)) {
+            this["totalBytes"] = 0;
+        }
+        if (!("processedBytes" in $$source)) {
+            this["processedBytes"] = 0;
+        }
+        if (!("filesPerSecond" in $$source)) {
+            this["filesPerSecond"] = 0;
+        }
+        if (!("bytesPerSecond" in $$source)) {
+            this["bytesPerSecond"] = 0;
+        }
 
         Object.assign(this, $$source);
     }
@@ -29,3 +65,129 @@ export class BackupProgress {
         return new BackupProgress($$parsedSource as Partial<BackupProgress>);
     }
 }
+
+export enum DiffChangeType {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    DiffChangeTypeAdded = "added",
+    DiffChangeTypeRemoved = "removed",
+    DiffChangeTypeModified = "modified",
+};
+
+/**
+ * DiffEntry describes how a single path changed between two archives
+ */
+export class DiffEntry {
+    "path": string;
+    "changeType": DiffChangeType;
+    "isDir": boolean;
+    "isLink": boolean;
+    "addedBytes": number;
+    "removedBytes": number;
+
+    /**
+     * AddedBytes - RemovedBytes
+     */
+    "sizeDelta": number;
+
+    /**
+     * e.g. mode, owner, mtime
+     */
+    "metadataChanges"?: string[];
+
+    /** Creates a new DiffEntry instance. */
+    constructor($$source: Partial<DiffEntry> = {}) {
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("changeType" in $$source)) {
+            this["changeType"] = DiffChangeType.$zero;
+        }
+        if (!("isDir" in $$source)) {
+            this["isDir"] = false;
+        }
+        if (!("isLink" in $$source)) {
+            this["isLink"] = false;
+        }
+        if (!("addedBytes" in $$source)) {
+            this["addedBytes"] = 0;
+        }
+        if (!("removedBytes" in $$source)) {
+            this["removedBytes"] = 0;
+        }
+        if (!("sizeDelta" in $$source)) {
+            this["sizeDelta"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new DiffEntry instance from a string or object.
+     */
+    static createFrom($$source: any = {}): DiffEntry {
+        const $$createField7_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("metadataChanges" in $$parsedSource) {
+            $$parsedSource["metadataChanges"] = $$createField7_0($$parsedSource["metadataChanges"]);
+        }
+        return new DiffEntry($$parsedSource as Partial<DiffEntry>);
+    }
+}
+
+export class ExtractProgress {
+    "totalBytes": number;
+    "processedBytes": number;
+    "currentPath"?: string;
+
+    /** Creates a new ExtractProgress instance. */
+    constructor($$source: Partial<ExtractProgress> = {}) {
+        if (!("totalBytes" in $$source)) {
+            this["totalBytes"] = 0;
+        }
+        if (!("processedBytes" in $$source)) {
+            this["processedBytes"] = 0;
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ExtractProgress instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ExtractProgress {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ExtractProgress($$parsedSource as Partial<ExtractProgress>);
+    }
+}
+
+/**
+ * TarFormat is the output format of `borg export-tar`
+ */
+export enum TarFormat {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * Uncompressed tar
+     */
+    TarFormatTar = "tar",
+
+    /**
+     * Tar compressed with gzip
+     */
+    TarFormatTarGz = "tar.gz",
+
+    /**
+     * Tar compressed with zstd
+     */
+    TarFormatTarZst = "tar.zst",
+};
+
+// Private type creation functions
+const $$createType0 = $Create.Array($Create.Any);
@@ -0,0 +1,44 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import { Create as $Create } from "@wailsio/runtime";
+
+/**
+ * RunOn defines the type for the "run_on" enum field.
+ */
+export enum RunOn {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * RunOnAlways is the default value of the RunOn enum.
+     */
+    DefaultRunOn = "always",
+
+    /**
+     * RunOn values.
+     */
+    RunOnSuccess = "success",
+    RunOnFailure = "failure",
+    RunOnAlways = "always",
+};
+
+/**
+ * Type defines the type for the "type" enum field.
+ */
+export enum Type {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * Type values.
+     */
+    TypePreBackup = "pre_backup",
+    TypePostBackup = "post_backup",
+};
@@ -2,6 +2,8 @@
 // This file is automatically generated. DO NOT EDIT
 
 export {
+    CatchUpPolicy,
+    DriveActionAfterBackup,
     Mode,
     Weekday
 } from "./models.js";
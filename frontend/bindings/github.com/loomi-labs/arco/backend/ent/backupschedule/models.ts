@@ -5,6 +5,50 @@
 // @ts-ignore: Unused imports
 import { Create as $Create } from "@wailsio/runtime";
 
+/**
+ * CatchUpPolicy defines the type for the "catch_up_policy" enum field.
+ */
+export enum CatchUpPolicy {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * CatchUpPolicyImmediately is the default value of the CatchUpPolicy enum.
+     */
+    DefaultCatchUpPolicy = "immediately",
+
+    /**
+     * CatchUpPolicy values.
+     */
+    CatchUpPolicyImmediately = "immediately",
+    CatchUpPolicyDelayed = "delayed",
+    CatchUpPolicySkip = "skip",
+};
+
+/**
+ * DriveActionAfterBackup defines the type for the "drive_action_after_backup" enum field.
+ */
+export enum DriveActionAfterBackup {
+    /**
+     * The Go zero value for the underlying type of the enum.
+     */
+    $zero = "",
+
+    /**
+     * DriveActionAfterBackupKeep is the default value of the DriveActionAfterBackup enum.
+     */
+    DefaultDriveActionAfterBackup = "keep",
+
+    /**
+     * DriveActionAfterBackup values.
+     */
+    DriveActionAfterBackupKeep = "keep",
+    DriveActionAfterBackupUnmount = "unmount",
+    DriveActionAfterBackupEject = "eject",
+};
+
 /**
  * Mode defines the type for the "mode" enum field.
  */
@@ -27,6 +71,7 @@ export enum Mode {
     ModeDaily = "daily",
     ModeWeekly = "weekly",
     ModeMonthly = "monthly",
+    ModeCron = "cron",
 };
 
 /**
@@ -6,7 +6,13 @@ export {
     Archive,
     ArchiveClient,
     ArchiveEdges,
+    ArchiveFile,
+    ArchiveFileClient,
+    ArchiveFileEdges,
     AuthSessionClient,
+    BackupHook,
+    BackupHookClient,
+    BackupHookEdges,
     BackupProfile,
     BackupProfileClient,
     BackupProfileEdges,
@@ -26,6 +32,7 @@ export {
     Repository,
     RepositoryClient,
     RepositoryEdges,
+    RepositoryStatsSnapshotClient,
     Settings,
     SettingsClient,
     UserClient
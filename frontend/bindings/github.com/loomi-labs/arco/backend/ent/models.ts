@@ -10,6 +10,9 @@ import { Create as $Create } from "@wailsio/runtime";
 import * as uuid$0 from "../../../../google/uuid/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
+import * as backuphook$0 from "./backuphook/models.js";
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
 import * as backupprofile$0 from "./backupprofile/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
@@ -26,6 +29,9 @@ import * as notification$0 from "./notification/models.js";
 // eslint-disable-next-line @typescript-eslint/ban-ts-comment
 // @ts-ignore: Unused imports
 import * as settings$0 from "./settings/models.js";
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as types$0 from "../types/models.js";
 
 /**
  * AnalyticsEventClient is a client for the AnalyticsEvent schema.
@@ -96,6 +102,41 @@ export class Archive {
      */
     "warningMessage"?: string | null;
 
+    /**
+     * Timestamp when the files of this archive were added to the file index
+     */
+    "filesIndexedAt"?: string | null;
+
+    /**
+     * Number of files in the archive (nil if the stats were not fetched yet)
+     */
+    "nfiles"?: number | null;
+
+    /**
+     * Uncompressed size of all files in the archive
+     */
+    "originalSize"?: number | null;
+
+    /**
+     * Compressed size of all files in the archive
+     */
+    "compressedSize"?: number | null;
+
+    /**
+     * Compressed size of the chunks that only this archive references (space added by this archive)
+     */
+    "deduplicatedSize"?: number | null;
+
+    /**
+     * Number of failed attempts to fetch the stats (the stats are not fetched anymore after a few failures)
+     */
+    "statsFailures": number;
+
+    /**
+     * Timestamp of the last full check (--verify-data) that covered this archive
+     */
+    "dataVerifiedAt"?: string | null;
+
     /**
      * Edges holds the relations/edges for other nodes in the graph.
      * The values are being populated by the ArchiveQuery when eager-loading is set.
@@ -128,6 +169,9 @@ export class Archive {
         if (!("comment" in $$source)) {
             this["comment"] = "";
         }
+        if (!("statsFailures" in $$source)) {
+            this["statsFailures"] = 0;
+        }
         if (!("edges" in $$source)) {
             this["edges"] = (new ArchiveEdges());
         }
@@ -139,10 +183,10 @@ export class Archive {
      * Creates a new Archive instance from a string or object.
      */
     static createFrom($$source: any = {}): Archive {
-        const $$createField9_0 = $$createType0;
+        const $$createField16_0 = $$createType0;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("edges" in $$parsedSource) {
-            $$parsedSource["edges"] = $$createField9_0($$parsedSource["edges"]);
+            $$parsedSource["edges"] = $$createField16_0($$parsedSource["edges"]);
         }
         return new Archive($$parsedSource as Partial<Archive>);
     }
@@ -205,6 +249,118 @@ export class ArchiveEdges {
     }
 }
 
+/**
+ * ArchiveFile is the model entity for the ArchiveFile schema.
+ */
+export class ArchiveFile {
+    /**
+     * ID of the ent.
+     */
+    "id": number;
+
+    /**
+     * Path inside the archive (without leading slash)
+     */
+    "path": string;
+
+    /**
+     * Size holds the value of the "size" field.
+     */
+    "size": number;
+
+    /**
+     * Mtime holds the value of the "mtime" field.
+     */
+    "mtime": string;
+
+    /**
+     * Edges holds the relations/edges for other nodes in the graph.
+     * The values are being populated by the ArchiveFileQuery when eager-loading is set.
+     */
+    "edges": ArchiveFileEdges;
+
+    /** Creates a new ArchiveFile instance. */
+    constructor($$source: Partial<ArchiveFile> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("path" in $$source)) {
+            this["path"] = "";
+        }
+        if (!("size" in $$source)) {
+            this["size"] = 0;
+        }
+        if (!("mtime" in $$source)) {
+            this["mtime"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("edges" in $$source)) {
+            this["edges"] = (new ArchiveFileEdges());
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveFile instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveFile {
+        const $$createField4_0 = $$createType5;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("edges" in $$parsedSource) {
+            $$parsedSource["edges"] = $$createField4_0($$parsedSource["edges"]);
+        }
+        return new ArchiveFile($$parsedSource as Partial<ArchiveFile>);
+    }
+}
+
+/**
+ * ArchiveFileClient is a client for the ArchiveFile schema.
+ */
+export class ArchiveFileClient {
+
+    /** Creates a new ArchiveFileClient instance. */
+    constructor($$source: Partial<ArchiveFileClient> = {}) {
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveFileClient instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveFileClient {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new ArchiveFileClient($$parsedSource as Partial<ArchiveFileClient>);
+    }
+}
+
+/**
+ * ArchiveFileEdges holds the relations/edges for other nodes in the graph.
+ */
+export class ArchiveFileEdges {
+    /**
+     * Archive holds the value of the archive edge.
+     */
+    "archive"?: Archive | null;
+
+    /** Creates a new ArchiveFileEdges instance. */
+    constructor($$source: Partial<ArchiveFileEdges> = {}) {
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new ArchiveFileEdges instance from a string or object.
+     */
+    static createFrom($$source: any = {}): ArchiveFileEdges {
+        const $$createField0_0 = $$createType7;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("archive" in $$parsedSource) {
+            $$parsedSource["archive"] = $$createField0_0($$parsedSource["archive"]);
+        }
+        return new ArchiveFileEdges($$parsedSource as Partial<ArchiveFileEdges>);
+    }
+}
+
 /**
  * AuthSessionClient is a client for the AuthSession schema.
  */
@@ -225,6 +381,182 @@ export class AuthSessionClient {
     }
 }
 
+/**
+ * BackupHook is the model entity for the BackupHook schema.
+ */
+export class BackupHook {
+    /**
+     * ID of the ent.
+     */
+    "id": number;
+
+    /**
+     * CreatedAt holds the value of the "created_at" field.
+     */
+    "createdAt": string;
+
+    /**
+     * UpdatedAt holds the value of the "updated_at" field.
+     */
+    "updatedAt": string;
+
+    /**
+     * Type holds the value of the "type" field.
+     */
+    "type": backuphook$0.Type;
+
+    /**
+     * Backup result for which a post-backup hook is run (ignored for pre-backup hooks)
+     */
+    "runOn": backuphook$0.RunOn;
+
+    /**
+     * Shell command executed with sh -c
+     */
+    "command": string;
+
+    /**
+     * Hooks of the same type are executed in ascending order
+     */
+    "position": number;
+
+    /**
+     * TimeoutSeconds holds the value of the "timeout_seconds" field.
+     */
+    "timeoutSeconds": number;
+
+    /**
+     * Abort the backup if this pre-backup hook fails
+     */
+    "abortOnFailure": boolean;
+
+    /**
+     * LastRunAt holds the value of the "last_run_at" field.
+     */
+    "lastRunAt": string | null;
+
+    /**
+     * LastExitCode holds the value of the "last_exit_code" field.
+     */
+    "lastExitCode": number | null;
+
+    /**
+     * Combined stdout and stderr of the last run (truncated)
+     */
+    "lastOutput": string | null;
+
+    /**
+     * Edges holds the relations/edges for other nodes in the graph.
+     * The values are being populated by the BackupHookQuery when eager-loading is set.
+     */
+    "edges": BackupHookEdges;
+
+    /** Creates a new BackupHook instance. */
+    constructor($$source: Partial<BackupHook> = {}) {
+        if (!("id" in $$source)) {
+            this["id"] = 0;
+        }
+        if (!("createdAt" in $$source)) {
+            this["createdAt"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("updatedAt" in $$source)) {
+            this["updatedAt"] = "0001-01-01T00:00:00.000Z";
+        }
+        if (!("type" in $$source)) {
+            this["type"] = backuphook$0.Type.$zero;
+        }
+        if (!("runOn" in $$source)) {
+            this["runOn"] = backuphook$0.RunOn.$zero;
+        }
+        if (!("command" in $$source)) {
+            this["command"] = "";
+        }
+        if (!("position" in $$source)) {
+            this["position"] = 0;
+        }
+        if (!("timeoutSeconds" in $$source)) {
+            this["timeoutSeconds"] = 0;
+        }
+        if (!("abortOnFailure" in $$source)) {
+            this["abortOnFailure"] = false;
+        }
+        if (!("lastRunAt" in $$source)) {
+            this["lastRunAt"] = null;
+        }
+        if (!("lastExitCode" in $$source)) {
+            this["lastExitCode"] = null;
+        }
+        if (!("lastOutput" in $$source)) {
+            this["lastOutput"] = null;
+        }
+        if (!("edges" in $$source)) {
+            this["edges"] = (new BackupHookEdges());
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BackupHook instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BackupHook {
+        const $$createField12_0 = $$createType8;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("edges" in $$parsedSource) {
+            $$parsedSource["edges"] = $$createField12_0($$parsedSource["edges"]);
+        }
+        return new BackupHook($$parsedSource as Partial<BackupHook>);
+    }
+}
+
+/**
+ * BackupHookClient is a client for the BackupHook schema.
+ */
+export class BackupHookClient {
+
+    /** Creates a new BackupHookClient instance. */
+    constructor($$source: Partial<BackupHookClient> = {}) {
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BackupHookClient instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BackupHookClient {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new BackupHookClient($$parsedSource as Partial<BackupHookClient>);
+    }
+}
+
+/**
+ * BackupHookEdges holds the relations/edges for other nodes in the graph.
+ */
+export class BackupHookEdges {
+    /**
+     * BackupProfile holds the value of the backup_profile edge.
+     */
+    "backupProfile"?: BackupProfile | null;
+
+    /** Creates a new BackupHookEdges instance. */
+    constructor($$source: Partial<BackupHookEdges> = {}) {
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new BackupHookEdges instance from a string or object.
+     */
+    static createFrom($$source: any = {}): BackupHookEdges {
+        const $$createField0_0 = $$createType4;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("backupProfile" in $$parsedSource) {
+            $$parsedSource["backupProfile"] = $$createField0_0($$parsedSource["backupProfile"]);
+        }
+        return new BackupHookEdges($$parsedSource as Partial<BackupHookEdges>);
+    }
+}
+
 /**
  * BackupProfile is the model entity for the BackupProfile schema.
  */
@@ -264,11 +596,36 @@ export class BackupProfile {
      */
     "excludePaths": string[];
 
+    /**
+     * Borg pattern rules (e.g. '- sh:** /node_modules'), evaluated before the exclude paths
+     */
+    "patterns": string[];
+
     /**
      * Exclude directories containing CACHEDIR.TAG file
      */
     "excludeCaches": boolean;
 
+    /**
+     * Exclude directories containing a file with one of these names (e.g. .nobackup)
+     */
+    "excludeIfPresent": string[];
+
+    /**
+     * Honor gitignore-style .arcoignore files found in the backup paths. Every directory of the backup paths is checked for changes before each backup, which takes a while for very large trees
+     */
+    "useIgnoreFiles": boolean;
+
+    /**
+     * Command whose output is backed up as a single file (e.g. pg_dump), empty if disabled
+     */
+    "contentCommand": string;
+
+    /**
+     * Path of the command output in the archive
+     */
+    "contentStdinName": string;
+
     /**
      * Icon holds the value of the "icon" field.
      */
@@ -284,6 +641,46 @@ export class BackupProfile {
      */
     "compressionLevel": number | null;
 
+    /**
+     * Stay in the same file system and don't back up mount points (--one-file-system)
+     */
+    "oneFileSystem": boolean;
+
+    /**
+     * Only store numeric user and group identifiers (--numeric-ids)
+     */
+    "numericIds": boolean;
+
+    /**
+     * Don't store file flags like immutable or nodump (--noflags)
+     */
+    "noFlags": boolean;
+
+    /**
+     * Don't store access control lists (--noacls)
+     */
+    "noAcls": boolean;
+
+    /**
+     * Don't store extended attributes (--noxattrs)
+     */
+    "noXattrs": boolean;
+
+    /**
+     * Detect sparse holes in input files (--sparse)
+     */
+    "sparse": boolean;
+
+    /**
+     * Back up the content of device files and FIFOs instead of the special files (--read-special)
+     */
+    "readSpecial": boolean;
+
+    /**
+     * Seconds between checkpoints of long running backups, borg's default (30 minutes) if not set
+     */
+    "checkpointInterval": number | null;
+
     /**
      * DataSectionCollapsed holds the value of the "data_section_collapsed" field.
      */
@@ -328,9 +725,24 @@ export class BackupProfile {
         if (!("excludePaths" in $$source)) {
             this["excludePaths"] = [];
         }
+        if (!("patterns" in $$source)) {
+            this["patterns"] = [];
+        }
         if (!("excludeCaches" in $$source)) {
             this["excludeCaches"] = false;
         }
+        if (!("excludeIfPresent" in $$source)) {
+            this["excludeIfPresent"] = [];
+        }
+        if (!("useIgnoreFiles" in $$source)) {
+            this["useIgnoreFiles"] = false;
+        }
+        if (!("contentCommand" in $$source)) {
+            this["contentCommand"] = "";
+        }
+        if (!("contentStdinName" in $$source)) {
+            this["contentStdinName"] = "";
+        }
         if (!("icon" in $$source)) {
             this["icon"] = backupprofile$0.Icon.$zero;
         }
@@ -340,6 +752,30 @@ export class BackupProfile {
         if (!("compressionLevel" in $$source)) {
             this["compressionLevel"] = null;
         }
+        if (!("oneFileSystem" in $$source)) {
+            this["oneFileSystem"] = false;
+        }
+        if (!("numericIds" in $$source)) {
+            this["numericIds"] = false;
+        }
+        if (!("noFlags" in $$source)) {
+            this["noFlags"] = false;
+        }
+        if (!("noAcls" in $$source)) {
+            this["noAcls"] = false;
+        }
+        if (!("noXattrs" in $$source)) {
+            this["noXattrs"] = false;
+        }
+        if (!("sparse" in $$source)) {
+            this["sparse"] = false;
+        }
+        if (!("readSpecial" in $$source)) {
+            this["readSpecial"] = false;
+        }
+        if (!("checkpointInterval" in $$source)) {
+            this["checkpointInterval"] = null;
+        }
         if (!("dataSectionCollapsed" in $$source)) {
             this["dataSectionCollapsed"] = false;
         }
@@ -360,9 +796,11 @@ export class BackupProfile {
      * Creates a new BackupProfile instance from a string or object.
      */
     static createFrom($$source: any = {}): BackupProfile {
-        const $$createField5_0 = $$createType5;
-        const $$createField6_0 = $$createType5;
-        const $$createField14_0 = $$createType6;
+        const $$createField5_0 = $$createType9;
+        const $$createField6_0 = $$createType9;
+        const $$createField7_0 = $$createType9;
+        const $$createField9_0 = $$createType9;
+        const $$createField27_0 = $$createType10;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backupPaths" in $$parsedSource) {
             $$parsedSource["backupPaths"] = $$createField5_0($$parsedSource["backupPaths"]);
@@ -370,8 +808,14 @@ export class BackupProfile {
         if ("excludePaths" in $$parsedSource) {
             $$parsedSource["excludePaths"] = $$createField6_0($$parsedSource["excludePaths"]);
         }
+        if ("patterns" in $$parsedSource) {
+            $$parsedSource["patterns"] = $$createField7_0($$parsedSource["patterns"]);
+        }
+        if ("excludeIfPresent" in $$parsedSource) {
+            $$parsedSource["excludeIfPresent"] = $$createField9_0($$parsedSource["excludeIfPresent"]);
+        }
         if ("edges" in $$parsedSource) {
-            $$parsedSource["edges"] = $$createField14_0($$parsedSource["edges"]);
+            $$parsedSource["edges"] = $$createField27_0($$parsedSource["edges"]);
         }
         return new BackupProfile($$parsedSource as Partial<BackupProfile>);
     }
@@ -421,6 +865,11 @@ export class BackupProfileEdges {
      */
     "pruningRule"?: PruningRule | null;
 
+    /**
+     * Hooks holds the value of the hooks edge.
+     */
+    "hooks"?: (BackupHook | null)[];
+
     /**
      * Notifications holds the value of the notifications edge.
      */
@@ -436,11 +885,12 @@ export class BackupProfileEdges {
      * Creates a new BackupProfileEdges instance from a string or object.
      */
     static createFrom($$source: any = {}): BackupProfileEdges {
-        const $$createField0_0 = $$createType7;
-        const $$createField1_0 = $$createType10;
-        const $$createField2_0 = $$createType12;
-        const $$createField3_0 = $$createType14;
-        const $$createField4_0 = $$createType17;
+        const $$createField0_0 = $$createType11;
+        const $$createField1_0 = $$createType12;
+        const $$createField2_0 = $$createType14;
+        const $$createField3_0 = $$createType16;
+        const $$createField4_0 = $$createType19;
+        const $$createField5_0 = $$createType22;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("repositories" in $$parsedSource) {
             $$parsedSource["repositories"] = $$createField0_0($$parsedSource["repositories"]);
@@ -454,8 +904,11 @@ export class BackupProfileEdges {
         if ("pruningRule" in $$parsedSource) {
             $$parsedSource["pruningRule"] = $$createField3_0($$parsedSource["pruningRule"]);
         }
+        if ("hooks" in $$parsedSource) {
+            $$parsedSource["hooks"] = $$createField4_0($$parsedSource["hooks"]);
+        }
         if ("notifications" in $$parsedSource) {
-            $$parsedSource["notifications"] = $$createField4_0($$parsedSource["notifications"]);
+            $$parsedSource["notifications"] = $$createField5_0($$parsedSource["notifications"]);
         }
         return new BackupProfileEdges($$parsedSource as Partial<BackupProfileEdges>);
     }
@@ -506,7 +959,7 @@ export class BackupSchedule {
     "weeklyAt": string;
 
     /**
-     * Monthday holds the value of the "monthday" field.
+     * Day of the month, the last day of the month is used in shorter months
      */
     "monthday": number;
 
@@ -515,11 +968,71 @@ export class BackupSchedule {
      */
     "monthlyAt": string;
 
+    /**
+     * Cron expressions of the cron mode (e.g. '0 12,18 * * mon-fri'), evaluated in local time
+     */
+    "cronExpressions": string[];
+
+    /**
+     * What to do with a run that was missed because the computer was asleep or Arco wasn't running
+     */
+    "catchUpPolicy": backupschedule$0.CatchUpPolicy;
+
+    /**
+     * Minutes to wait before a missed run is caught up with the delayed policy
+     */
+    "catchUpDelayMinutes": number;
+
+    /**
+     * Random delay of up to this many minutes added to every run, spreads the load of many clients on one server
+     */
+    "jitterMinutes": number;
+
+    /**
+     * Backups only start within one of these windows, at any time if empty (local time)
+     */
+    "allowedHours": types$0.TimeWindow[];
+
+    /**
+     * Backups never start during these windows, queued backups are held until the period is over (local time)
+     */
+    "blackoutPeriods": types$0.TimeWindow[];
+
+    /**
+     * Scheduled backups wait while the computer runs on battery
+     */
+    "requireAcPower": boolean;
+
+    /**
+     * Scheduled backups wait while the network connection is metered
+     */
+    "requireUnmeteredNetwork": boolean;
+
+    /**
+     * Scheduled backups wait until the host or mount of the repository is reachable
+     */
+    "requireReachableRepository": boolean;
+
+    /**
+     * Run a backup to local repositories when the drive they are on is connected, independent of the mode
+     */
+    "runOnDriveConnect": boolean;
+
+    /**
+     * What to do with the drive after the backups that were started by connecting it have finished
+     */
+    "driveActionAfterBackup": backupschedule$0.DriveActionAfterBackup;
+
     /**
      * NextRun holds the value of the "next_run" field.
      */
     "nextRun": string;
 
+    /**
+     * When the run that is past its next run time is started, set once the run is missed or deferred
+     */
+    "catchUpAt": string | null;
+
     /**
      * LastRun holds the value of the "last_run" field.
      */
@@ -568,9 +1081,45 @@ export class BackupSchedule {
         if (!("monthlyAt" in $$source)) {
             this["monthlyAt"] = "0001-01-01T00:00:00.000Z";
         }
+        if (!("cronExpressions" in $$source)) {
+            this["cronExpressions"] = [];
+        }
+        if (!("catchUpPolicy" in $$source)) {
+            this["catchUpPolicy"] = backupschedule$0.CatchUpPolicy.$zero;
+        }
+        if (!("catchUpDelayMinutes" in $$source)) {
+            this["catchUpDelayMinutes"] = 0;
+        }
+        if (!("jitterMinutes" in $$source)) {
+            this["jitterMinutes"] = 0;
+        }
+        if (!("allowedHours" in $$source)) {
+            this["allowedHours"] = [];
+        }
+        if (!("blackoutPeriods" in $$source)) {
+            this["blackoutPeriods"] = [];
+        }
+        if (!("requireAcPower" in $$source)) {
+            this["requireAcPower"] = false;
+        }
+        if (!("requireUnmeteredNetwork" in $$source)) {
+            this["requireUnmeteredNetwork"] = false;
+        }
+        if (!("requireReachableRepository" in $$source)) {
+            this["requireReachableRepository"] = false;
+        }
+        if (!("runOnDriveConnect" in $$source)) {
+            this["runOnDriveConnect"] = false;
+        }
+        if (!("driveActionAfterBackup" in $$source)) {
+            this["driveActionAfterBackup"] = backupschedule$0.DriveActionAfterBackup.$zero;
+        }
         if (!("nextRun" in $$source)) {
             this["nextRun"] = "0001-01-01T00:00:00.000Z";
         }
+        if (!("catchUpAt" in $$source)) {
+            this["catchUpAt"] = null;
+        }
         if (!("lastRun" in $$source)) {
             this["lastRun"] = null;
         }
@@ -588,10 +1137,22 @@ export class BackupSchedule {
      * Creates a new BackupSchedule instance from a string or object.
      */
     static createFrom($$source: any = {}): BackupSchedule {
-        const $$createField13_0 = $$createType18;
+        const $$createField10_0 = $$createType9;
+        const $$createField14_0 = $$createType24;
+        const $$createField15_0 = $$createType24;
+        const $$createField25_0 = $$createType25;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("cronExpressions" in $$parsedSource) {
+            $$parsedSource["cronExpressions"] = $$createField10_0($$parsedSource["cronExpressions"]);
+        }
+        if ("allowedHours" in $$parsedSource) {
+            $$parsedSource["allowedHours"] = $$createField14_0($$parsedSource["allowedHours"]);
+        }
+        if ("blackoutPeriods" in $$parsedSource) {
+            $$parsedSource["blackoutPeriods"] = $$createField15_0($$parsedSource["blackoutPeriods"]);
+        }
         if ("edges" in $$parsedSource) {
-            $$parsedSource["edges"] = $$createField13_0($$parsedSource["edges"]);
+            $$parsedSource["edges"] = $$createField25_0($$parsedSource["edges"]);
         }
         return new BackupSchedule($$parsedSource as Partial<BackupSchedule>);
     }
@@ -664,11 +1225,21 @@ export class Client {
      */
     "Archive": ArchiveClient | null;
 
+    /**
+     * ArchiveFile is the client for interacting with the ArchiveFile builders.
+     */
+    "ArchiveFile": ArchiveFileClient | null;
+
     /**
      * AuthSession is the client for interacting with the AuthSession builders.
      */
     "AuthSession": AuthSessionClient | null;
 
+    /**
+     * BackupHook is the client for interacting with the BackupHook builders.
+     */
+    "BackupHook": BackupHookClient | null;
+
     /**
      * BackupProfile is the client for interacting with the BackupProfile builders.
      */
@@ -699,6 +1270,11 @@ export class Client {
      */
     "Repository": RepositoryClient | null;
 
+    /**
+     * RepositoryStatsSnapshot is the client for interacting with the RepositoryStatsSnapshot builders.
+     */
+    "RepositoryStatsSnapshot": RepositoryStatsSnapshotClient | null;
+
     /**
      * Settings is the client for interacting with the Settings builders.
      */
@@ -720,9 +1296,15 @@ export class Client {
         if (!("Archive" in $$source)) {
             this["Archive"] = null;
         }
+        if (!("ArchiveFile" in $$source)) {
+            this["ArchiveFile"] = null;
+        }
         if (!("AuthSession" in $$source)) {
             this["AuthSession"] = null;
         }
+        if (!("BackupHook" in $$source)) {
+            this["BackupHook"] = null;
+        }
         if (!("BackupProfile" in $$source)) {
             this["BackupProfile"] = null;
         }
@@ -741,6 +1323,9 @@ export class Client {
         if (!("Repository" in $$source)) {
             this["Repository"] = null;
         }
+        if (!("RepositoryStatsSnapshot" in $$source)) {
+            this["RepositoryStatsSnapshot"] = null;
+        }
         if (!("Settings" in $$source)) {
             this["Settings"] = null;
         }
@@ -755,18 +1340,21 @@ export class Client {
      * Creates a new Client instance from a string or object.
      */
     static createFrom($$source: any = {}): Client {
-        const $$createField0_0 = $$createType20;
-        const $$createField1_0 = $$createType22;
-        const $$createField2_0 = $$createType24;
-        const $$createField3_0 = $$createType26;
-        const $$createField4_0 = $$createType28;
-        const $$createField5_0 = $$createType30;
-        const $$createField6_0 = $$createType32;
-        const $$createField7_0 = $$createType34;
-        const $$createField8_0 = $$createType36;
-        const $$createField9_0 = $$createType38;
-        const $$createField10_0 = $$createType40;
-        const $$createField11_0 = $$createType42;
+        const $$createField0_0 = $$createType27;
+        const $$createField1_0 = $$createType29;
+        const $$createField2_0 = $$createType31;
+        const $$createField3_0 = $$createType33;
+        const $$createField4_0 = $$createType35;
+        const $$createField5_0 = $$createType37;
+        const $$createField6_0 = $$createType39;
+        const $$createField7_0 = $$createType41;
+        const $$createField8_0 = $$createType43;
+        const $$createField9_0 = $$createType45;
+        const $$createField10_0 = $$createType47;
+        const $$createField11_0 = $$createType49;
+        const $$createField12_0 = $$createType51;
+        const $$createField13_0 = $$createType53;
+        const $$createField14_0 = $$createType55;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("Schema" in $$parsedSource) {
             $$parsedSource["Schema"] = $$createField0_0($$parsedSource["Schema"]);
@@ -777,32 +1365,41 @@ export class Client {
         if ("Archive" in $$parsedSource) {
             $$parsedSource["Archive"] = $$createField2_0($$parsedSource["Archive"]);
         }
+        if ("ArchiveFile" in $$parsedSource) {
+            $$parsedSource["ArchiveFile"] = $$createField3_0($$parsedSource["ArchiveFile"]);
+        }
         if ("AuthSession" in $$parsedSource) {
-            $$parsedSource["AuthSession"] = $$createField3_0($$parsedSource["AuthSession"]);
+            $$parsedSource["AuthSession"] = $$createField4_0($$parsedSource["AuthSession"]);
+        }
+        if ("BackupHook" in $$parsedSource) {
+            $$parsedSource["BackupHook"] = $$createField5_0($$parsedSource["BackupHook"]);
         }
         if ("BackupProfile" in $$parsedSource) {
-            $$parsedSource["BackupProfile"] = $$createField4_0($$parsedSource["BackupProfile"]);
+            $$parsedSource["BackupProfile"] = $$createField6_0($$parsedSource["BackupProfile"]);
         }
         if ("BackupSchedule" in $$parsedSource) {
-            $$parsedSource["BackupSchedule"] = $$createField5_0($$parsedSource["BackupSchedule"]);
+            $$parsedSource["BackupSchedule"] = $$createField7_0($$parsedSource["BackupSchedule"]);
         }
         if ("CloudRepository" in $$parsedSource) {
-            $$parsedSource["CloudRepository"] = $$createField6_0($$parsedSource["CloudRepository"]);
+            $$parsedSource["CloudRepository"] = $$createField8_0($$parsedSource["CloudRepository"]);
         }
         if ("Notification" in $$parsedSource) {
-            $$parsedSource["Notification"] = $$createField7_0($$parsedSource["Notification"]);
+            $$parsedSource["Notification"] = $$createField9_0($$parsedSource["Notification"]);
         }
         if ("PruningRule" in $$parsedSource) {
-            $$parsedSource["PruningRule"] = $$createField8_0($$parsedSource["PruningRule"]);
+            $$parsedSource["PruningRule"] = $$createField10_0($$parsedSource["PruningRule"]);
         }
         if ("Repository" in $$parsedSource) {
-            $$parsedSource["Repository"] = $$createField9_0($$parsedSource["Repository"]);
+            $$parsedSource["Repository"] = $$createField11_0($$parsedSource["Repository"]);
+        }
+        if ("RepositoryStatsSnapshot" in $$parsedSource) {
+            $$parsedSource["RepositoryStatsSnapshot"] = $$createField12_0($$parsedSource["RepositoryStatsSnapshot"]);
         }
         if ("Settings" in $$parsedSource) {
-            $$parsedSource["Settings"] = $$createField10_0($$parsedSource["Settings"]);
+            $$parsedSource["Settings"] = $$createField13_0($$parsedSource["Settings"]);
         }
         if ("User" in $$parsedSource) {
-            $$parsedSource["User"] = $$createField11_0($$parsedSource["User"]);
+            $$parsedSource["User"] = $$createField14_0($$parsedSource["User"]);
         }
         return new Client($$parsedSource as Partial<Client>);
     }
@@ -879,7 +1476,7 @@ export class CloudRepository {
      * Creates a new CloudRepository instance from a string or object.
      */
     static createFrom($$source: any = {}): CloudRepository {
-        const $$createField6_0 = $$createType43;
+        const $$createField6_0 = $$createType56;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("edges" in $$parsedSource) {
             $$parsedSource["edges"] = $$createField6_0($$parsedSource["edges"]);
@@ -1018,7 +1615,7 @@ export class Notification {
      * Creates a new Notification instance from a string or object.
      */
     static createFrom($$source: any = {}): Notification {
-        const $$createField7_0 = $$createType44;
+        const $$createField7_0 = $$createType57;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("edges" in $$parsedSource) {
             $$parsedSource["edges"] = $$createField7_0($$parsedSource["edges"]);
@@ -1211,7 +1808,7 @@ export class PruningRule {
      * Creates a new PruningRule instance from a string or object.
      */
     static createFrom($$source: any = {}): PruningRule {
-        const $$createField13_0 = $$createType45;
+        const $$createField13_0 = $$createType58;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("edges" in $$parsedSource) {
             $$parsedSource["edges"] = $$createField13_0($$parsedSource["edges"]);
@@ -1302,6 +1899,16 @@ export class Repository {
      */
     "hasPassword": boolean;
 
+    /**
+     * Filesystem UUID of the volume the repository was created on, used to recognize its drive when it is connected
+     */
+    "volumeUuid": string;
+
+    /**
+     * Filesystem label of the volume the repository was created on, used if the filesystem has no UUID
+     */
+    "volumeLabel": string;
+
     /**
      * Timestamp of last quick check (--repository-only)
      */
@@ -1322,6 +1929,26 @@ export class Repository {
      */
     "fullCheckError": string[];
 
+    /**
+     * Days between scheduled quick checks, 0 disables scheduled quick checks
+     */
+    "quickCheckIntervalDays": number;
+
+    /**
+     * Weeks between scheduled full checks (--verify-data), 0 disables scheduled full checks
+     */
+    "fullCheckIntervalWeeks": number;
+
+    /**
+     * Number of archives verified per scheduled full check, 0 verifies all archives at once
+     */
+    "rollingCheckArchives": number;
+
+    /**
+     * Start of the current verification cycle, archives verified before this are checked again
+     */
+    "checkCycleStartedAt": string | null;
+
     /**
      * Total number of all chunks across all archives (including duplicates)
      */
@@ -1352,6 +1979,11 @@ export class Repository {
      */
     "statsUniqueCsize": number;
 
+    /**
+     * Whether the files of all archives are indexed locally for searching
+     */
+    "fileIndexEnabled": boolean;
+
     /**
      * Edges holds the relations/edges for other nodes in the graph.
      * The values are being populated by the RepositoryQuery when eager-loading is set.
@@ -1378,6 +2010,12 @@ export class Repository {
         if (!("hasPassword" in $$source)) {
             this["hasPassword"] = false;
         }
+        if (!("volumeUuid" in $$source)) {
+            this["volumeUuid"] = "";
+        }
+        if (!("volumeLabel" in $$source)) {
+            this["volumeLabel"] = "";
+        }
         if (!("lastQuickCheckAt" in $$source)) {
             this["lastQuickCheckAt"] = null;
         }
@@ -1390,6 +2028,18 @@ export class Repository {
         if (!("fullCheckError" in $$source)) {
             this["fullCheckError"] = [];
         }
+        if (!("quickCheckIntervalDays" in $$source)) {
+            this["quickCheckIntervalDays"] = 0;
+        }
+        if (!("fullCheckIntervalWeeks" in $$source)) {
+            this["fullCheckIntervalWeeks"] = 0;
+        }
+        if (!("rollingCheckArchives" in $$source)) {
+            this["rollingCheckArchives"] = 0;
+        }
+        if (!("checkCycleStartedAt" in $$source)) {
+            this["checkCycleStartedAt"] = null;
+        }
         if (!("statsTotalChunks" in $$source)) {
             this["statsTotalChunks"] = 0;
         }
@@ -1408,6 +2058,9 @@ export class Repository {
         if (!("statsUniqueCsize" in $$source)) {
             this["statsUniqueCsize"] = 0;
         }
+        if (!("fileIndexEnabled" in $$source)) {
+            this["fileIndexEnabled"] = false;
+        }
         if (!("edges" in $$source)) {
             this["edges"] = (new RepositoryEdges());
         }
@@ -1419,18 +2072,18 @@ export class Repository {
      * Creates a new Repository instance from a string or object.
      */
     static createFrom($$source: any = {}): Repository {
-        const $$createField7_0 = $$createType5;
-        const $$createField9_0 = $$createType5;
-        const $$createField16_0 = $$createType46;
+        const $$createField9_0 = $$createType9;
+        const $$createField11_0 = $$createType9;
+        const $$createField23_0 = $$createType59;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("quickCheckError" in $$parsedSource) {
-            $$parsedSource["quickCheckError"] = $$createField7_0($$parsedSource["quickCheckError"]);
+            $$parsedSource["quickCheckError"] = $$createField9_0($$parsedSource["quickCheckError"]);
         }
         if ("fullCheckError" in $$parsedSource) {
-            $$parsedSource["fullCheckError"] = $$createField9_0($$parsedSource["fullCheckError"]);
+            $$parsedSource["fullCheckError"] = $$createField11_0($$parsedSource["fullCheckError"]);
         }
         if ("edges" in $$parsedSource) {
-            $$parsedSource["edges"] = $$createField16_0($$parsedSource["edges"]);
+            $$parsedSource["edges"] = $$createField23_0($$parsedSource["edges"]);
         }
         return new Repository($$parsedSource as Partial<Repository>);
     }
@@ -1490,10 +2143,10 @@ export class RepositoryEdges {
      * Creates a new RepositoryEdges instance from a string or object.
      */
     static createFrom($$source: any = {}): RepositoryEdges {
-        const $$createField0_0 = $$createType47;
-        const $$createField1_0 = $$createType10;
-        const $$createField2_0 = $$createType17;
-        const $$createField3_0 = $$createType49;
+        const $$createField0_0 = $$createType60;
+        const $$createField1_0 = $$createType12;
+        const $$createField2_0 = $$createType22;
+        const $$createField3_0 = $$createType62;
         let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
         if ("backupProfiles" in $$parsedSource) {
             $$parsedSource["backupProfiles"] = $$createField0_0($$parsedSource["backupProfiles"]);
@@ -1511,6 +2164,26 @@ export class RepositoryEdges {
     }
 }
 
+/**
+ * RepositoryStatsSnapshotClient is a client for the RepositoryStatsSnapshot schema.
+ */
+export class RepositoryStatsSnapshotClient {
+
+    /** Creates a new RepositoryStatsSnapshotClient instance. */
+    constructor($$source: Partial<RepositoryStatsSnapshotClient> = {}) {
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new RepositoryStatsSnapshotClient instance from a string or object.
+     */
+    static createFrom($$source: any = {}): RepositoryStatsSnapshotClient {
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        return new RepositoryStatsSnapshotClient($$parsedSource as Partial<RepositoryStatsSnapshotClient>);
+    }
+}
+
 /**
  * Settings is the model entity for the Settings schema.
  */
@@ -1682,48 +2355,61 @@ const $$createType1 = Repository.createFrom;
 const $$createType2 = $Create.Nullable($$createType1);
 const $$createType3 = BackupProfile.createFrom;
 const $$createType4 = $Create.Nullable($$createType3);
-const $$createType5 = $Create.Array($Create.Any);
-const $$createType6 = BackupProfileEdges.createFrom;
-const $$createType7 = $Create.Array($$createType2);
-const $$createType8 = Archive.createFrom;
-const $$createType9 = $Create.Nullable($$createType8);
-const $$createType10 = $Create.Array($$createType9);
-const $$createType11 = BackupSchedule.createFrom;
-const $$createType12 = $Create.Nullable($$createType11);
-const $$createType13 = PruningRule.createFrom;
+const $$createType5 = ArchiveFileEdges.createFrom;
+const $$createType6 = Archive.createFrom;
+const $$createType7 = $Create.Nullable($$createType6);
+const $$createType8 = BackupHookEdges.createFrom;
+const $$createType9 = $Create.Array($Create.Any);
+const $$createType10 = BackupProfileEdges.createFrom;
+const $$createType11 = $Create.Array($$createType2);
+const $$createType12 = $Create.Array($$createType7);
+const $$createType13 = BackupSchedule.createFrom;
 const $$createType14 = $Create.Nullable($$createType13);
-const $$createType15 = Notification.createFrom;
+const $$createType15 = PruningRule.createFrom;
 const $$createType16 = $Create.Nullable($$createType15);
-const $$createType17 = $Create.Array($$createType16);
-const $$createType18 = BackupScheduleEdges.createFrom;
-const $$createType19 = migrate$0.Schema.createFrom;
-const $$createType20 = $Create.Nullable($$createType19);
-const $$createType21 = AnalyticsEventClient.createFrom;
-const $$createType22 = $Create.Nullable($$createType21);
-const $$createType23 = ArchiveClient.createFrom;
-const $$createType24 = $Create.Nullable($$createType23);
-const $$createType25 = AuthSessionClient.createFrom;
-const $$createType26 = $Create.Nullable($$createType25);
-const $$createType27 = BackupProfileClient.createFrom;
-const $$createType28 = $Create.Nullable($$createType27);
-const $$createType29 = BackupScheduleClient.createFrom;
-const $$createType30 = $Create.Nullable($$createType29);
-const $$createType31 = CloudRepositoryClient.createFrom;
-const $$createType32 = $Create.Nullable($$createType31);
-const $$createType33 = NotificationClient.createFrom;
-const $$createType34 = $Create.Nullable($$createType33);
-const $$createType35 = PruningRuleClient.createFrom;
-const $$createType36 = $Create.Nullable($$createType35);
-const $$createType37 = RepositoryClient.createFrom;
-const $$createType38 = $Create.Nullable($$createType37);
-const $$createType39 = SettingsClient.createFrom;
-const $$createType40 = $Create.Nullable($$createType39);
-const $$createType41 = UserClient.createFrom;
-const $$createType42 = $Create.Nullable($$createType41);
-const $$createType43 = CloudRepositoryEdges.createFrom;
-const $$createType44 = NotificationEdges.createFrom;
-const $$createType45 = PruningRuleEdges.createFrom;
-const $$createType46 = RepositoryEdges.createFrom;
-const $$createType47 = $Create.Array($$createType4);
-const $$createType48 = CloudRepository.createFrom;
+const $$createType17 = BackupHook.createFrom;
+const $$createType18 = $Create.Nullable($$createType17);
+const $$createType19 = $Create.Array($$createType18);
+const $$createType20 = Notification.createFrom;
+const $$createType21 = $Create.Nullable($$createType20);
+const $$createType22 = $Create.Array($$createType21);
+const $$createType23 = types$0.TimeWindow.createFrom;
+const $$createType24 = $Create.Array($$createType23);
+const $$createType25 = BackupScheduleEdges.createFrom;
+const $$createType26 = migrate$0.Schema.createFrom;
+const $$createType27 = $Create.Nullable($$createType26);
+const $$createType28 = AnalyticsEventClient.createFrom;
+const $$createType29 = $Create.Nullable($$createType28);
+const $$createType30 = ArchiveClient.createFrom;
+const $$createType31 = $Create.Nullable($$createType30);
+const $$createType32 = ArchiveFileClient.createFrom;
+const $$createType33 = $Create.Nullable($$createType32);
+const $$createType34 = AuthSessionClient.createFrom;
+const $$createType35 = $Create.Nullable($$createType34);
+const $$createType36 = BackupHookClient.createFrom;
+const $$createType37 = $Create.Nullable($$createType36);
+const $$createType38 = BackupProfileClient.createFrom;
+const $$createType39 = $Create.Nullable($$createType38);
+const $$createType40 = BackupScheduleClient.createFrom;
+const $$createType41 = $Create.Nullable($$createType40);
+const $$createType42 = CloudRepositoryClient.createFrom;
+const $$createType43 = $Create.Nullable($$createType42);
+const $$createType44 = NotificationClient.createFrom;
+const $$createType45 = $Create.Nullable($$createType44);
+const $$createType46 = PruningRuleClient.createFrom;
+const $$createType47 = $Create.Nullable($$createType46);
+const $$createType48 = RepositoryClient.createFrom;
 const $$createType49 = $Create.Nullable($$createType48);
+const $$createType50 = RepositoryStatsSnapshotClient.createFrom;
+const $$createType51 = $Create.Nullable($$createType50);
+const $$createType52 = SettingsClient.createFrom;
+const $$createType53 = $Create.Nullable($$createType52);
+const $$createType54 = UserClient.createFrom;
+const $$createType55 = $Create.Nullable($$createType54);
+const $$createType56 = CloudRepositoryEdges.createFrom;
+const $$createType57 = NotificationEdges.createFrom;
+const $$createType58 = PruningRuleEdges.createFrom;
+const $$createType59 = RepositoryEdges.createFrom;
+const $$createType60 = $Create.Array($$createType4);
+const $$createType61 = CloudRepository.createFrom;
+const $$createType62 = $Create.Nullable($$createType61);
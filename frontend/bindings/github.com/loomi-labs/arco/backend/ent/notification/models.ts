@@ -39,4 +39,11 @@ export enum Type {
     TypeFailedFullCheck = "failed_full_check",
     TypeWarningQuickCheck = "warning_quick_check",
     TypeWarningFullCheck = "warning_full_check",
+    TypeFailedRestoreRun = "failed_restore_run",
+    TypeWarningRestoreRun = "warning_restore_run",
+    TypeFailedExportRun = "failed_export_run",
+    TypeWarningExportRun = "warning_export_run",
+    TypeFailedImportRun = "failed_import_run",
+    TypeOverdueQuickCheck = "overdue_quick_check",
+    TypeOverdueFullCheck = "overdue_full_check",
 };
@@ -0,0 +1,61 @@
+// Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
+// This file is automatically generated. DO NOT EDIT
+
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import { Create as $Create } from "@wailsio/runtime";
+
+// eslint-disable-next-line @typescript-eslint/ban-ts-comment
+// @ts-ignore: Unused imports
+import * as time$0 from "../../../../../time/models.js";
+
+/**
+ * TimeWindow is a recurring period of the day like from "22:00" to "06:00" on weekdays.
+ * A window that ends before it starts ends on the next day, a window that ends when it starts lasts 24 hours.
+ */
+export class TimeWindow {
+    /**
+     * Start time as "15:04"
+     */
+    "start": string;
+
+    /**
+     * End time as "15:04"
+     */
+    "end": string;
+
+    /**
+     * Days on which the window starts, every day if empty
+     */
+    "weekdays": time$0.Weekday[];
+
+    /** Creates a new TimeWindow instance. */
+    constructor($$source: Partial<TimeWindow> = {}) {
+        if (!("start" in $$source)) {
+            this["start"] = "";
+        }
+        if (!("end" in $$source)) {
+            this["end"] = "";
+        }
+        if (!("weekdays" in $$source)) {
+            this["weekdays"] = [];
+        }
+
+        Object.assign(this, $$source);
+    }
+
+    /**
+     * Creates a new TimeWindow instance from a string or object.
+     */
+    static createFrom($$source: any = {}): TimeWindow {
+        const $$createField2_0 = $$createType0;
+        let $$parsedSource = typeof $$source === 'string' ? JSON.parse($$source) : $$source;
+        if ("weekdays" in $$parsedSource) {
+            $$parsedSource["weekdays"] = $$createField2_0($$parsedSource["weekdays"]);
+        }
+        return new TimeWindow($$parsedSource as Partial<TimeWindow>);
+    }
+}
+
+// Private type creation functions
+const $$createType0 = $Create.Array($Create.Any);